@@ -311,6 +311,7 @@ type App struct {
 
 	TransferStack    *ibchooks.IBCMiddleware
 	Ics20WasmHooks   *ibchooks.WasmHooks
+	Ics20MarkerHooks *ibchooks.MarkerHooks
 	HooksICS4Wrapper ibchooks.ICS4Middleware
 
 	// the module manager
@@ -452,7 +453,6 @@ func New(
 		appCodec, keys[msgfeestypes.StoreKey], app.GetSubspace(msgfeestypes.ModuleName), authtypes.FeeCollectorName, pioconfig.GetProvenanceConfig().FeeDenom, app.Simulate, encodingConfig.TxConfig.TxDecoder(), interfaceRegistry)
 
 	pioMsgFeesRouter := app.MsgServiceRouter().(*piohandlers.PioMsgServiceRouter)
-	pioMsgFeesRouter.SetMsgFeesKeeper(app.MsgFeesKeeper)
 
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
 	restrictHooks := piohandlers.NewStakingRestrictionHooks(&app.StakingKeeper, *piohandlers.DefaultRestrictionOptions)
@@ -486,9 +486,12 @@ func New(
 	addrPrefix := sdk.GetConfig().GetBech32AccountAddrPrefix()        // We use this approach so running tests which use "cosmos" will work while we use "pb"
 	wasmHooks := ibchooks.NewWasmHooks(&hooksKeeper, nil, addrPrefix) // The contract keeper needs to be set later
 	app.Ics20WasmHooks = &wasmHooks
+	markerHooks := ibchooks.NewMarkerHooks(nil) // The marker keeper needs to be set later
+	app.Ics20MarkerHooks = &markerHooks
 	app.HooksICS4Wrapper = ibchooks.NewICS4Middleware(
 		app.IBCKeeper.ChannelKeeper,
 		app.Ics20WasmHooks,
+		app.Ics20MarkerHooks,
 	)
 
 	// Create Transfer Keepers
@@ -518,6 +521,7 @@ func New(
 
 	app.MetadataKeeper = metadatakeeper.NewKeeper(
 		appCodec, keys[metadatatypes.StoreKey], app.GetSubspace(metadatatypes.ModuleName), app.AccountKeeper, app.AuthzKeeper, app.AttributeKeeper,
+		app.BankKeeper,
 	)
 
 	markerReqAttrBypassAddrs := []sdk.AccAddress{
@@ -535,6 +539,13 @@ func New(
 		app.AttributeKeeper, app.NameKeeper, app.TransferKeeper, markerReqAttrBypassAddrs,
 	)
 
+	// Marker net asset values are used to convert fees denominated in marker coins.
+	app.MsgFeesKeeper = app.MsgFeesKeeper.WithMarkerKeeper(app.MarkerKeeper)
+	pioMsgFeesRouter.SetMsgFeesKeeper(app.MsgFeesKeeper)
+
+	// The IBC policies of restricted markers are enforced by the ibc hooks middleware.
+	app.Ics20MarkerHooks.MarkerKeeper = app.MarkerKeeper
+
 	pioMessageRouter := MessageRouterFunc(func(msg sdk.Msg) baseapp.MsgServiceHandler {
 		return pioMsgFeesRouter.Handler(msg)
 	})
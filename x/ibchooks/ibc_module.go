@@ -7,6 +7,9 @@ import (
 	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v6/modules/core/05-port/types"
 	ibcexported "github.com/cosmos/ibc-go/v6/modules/core/exported"
+
+	"github.com/provenance-io/provenance/x/ibchooks/osmoutils"
+	"github.com/provenance-io/provenance/x/ibchooks/types"
 )
 
 var _ porttypes.Middleware = &IBCMiddleware{}
@@ -170,6 +173,10 @@ func (im IBCMiddleware) OnRecvPacket(
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) ibcexported.Acknowledgement {
+	if err := im.ICS4Middleware.MarkerHooks.ValidateRecvPacket(ctx, packet); err != nil {
+		return osmoutils.NewEmitErrorAcknowledgement(ctx, types.ErrMarkerIbcPolicy, err.Error())
+	}
+
 	if hook, ok := im.ICS4Middleware.Hooks.(OnRecvPacketOverrideHooks); ok {
 		return hook.OnRecvPacketOverride(im, ctx, packet, relayer)
 	}
@@ -15,12 +15,16 @@ type ICS4Middleware struct {
 
 	// Hooks
 	Hooks Hooks
+
+	// MarkerHooks enforces the IBC policies of restricted markers regardless of the other hooks.
+	MarkerHooks *MarkerHooks
 }
 
-func NewICS4Middleware(channel porttypes.ICS4Wrapper, hooks Hooks) ICS4Middleware {
+func NewICS4Middleware(channel porttypes.ICS4Wrapper, hooks Hooks, markerHooks *MarkerHooks) ICS4Middleware {
 	return ICS4Middleware{
-		channel: channel,
-		Hooks:   hooks,
+		channel:     channel,
+		Hooks:       hooks,
+		MarkerHooks: markerHooks,
 	}
 }
 
@@ -33,6 +37,10 @@ func (i ICS4Middleware) SendPacket(
 	timeoutTimestamp uint64,
 	data []byte,
 ) (sequence uint64, err error) {
+	if err = i.MarkerHooks.ValidateSendPacket(ctx, sourceChannel, data); err != nil {
+		return 0, err
+	}
+
 	if hook, ok := i.Hooks.(SendPacketOverrideHooks); ok {
 		return hook.SendPacketOverride(i, ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
 	}
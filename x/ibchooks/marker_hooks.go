@@ -0,0 +1,68 @@
+package ibchooks
+
+import (
+	"encoding/json"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+
+	"github.com/provenance-io/provenance/x/ibchooks/types"
+)
+
+// MarkerHooks enforces the IBC policies of restricted markers on ICS20 packets.
+// Outgoing packets must use a channel and receiver allowed by the marker's policy. Incoming packets of
+// a restricted marker's denom, either native coin returning to this chain or a voucher for coin from
+// another chain, must come through an allowed channel to a receiver that meets the marker's required
+// attributes.
+type MarkerHooks struct {
+	MarkerKeeper types.MarkerKeeper
+}
+
+func NewMarkerHooks(markerKeeper types.MarkerKeeper) MarkerHooks {
+	return MarkerHooks{
+		MarkerKeeper: markerKeeper,
+	}
+}
+
+func (h *MarkerHooks) ProperlyConfigured() bool {
+	return h != nil && h.MarkerKeeper != nil
+}
+
+// ValidateSendPacket returns an error if the packet data is an ICS20 transfer that is not allowed by
+// the IBC policy of the denom's marker. The packet has the full trace path of a voucher's denom, so
+// the policy checked is the one on the voucher's IBC denom.
+func (h *MarkerHooks) ValidateSendPacket(ctx sdktypes.Context, sourceChannel string, data []byte) error {
+	if !h.ProperlyConfigured() {
+		return nil
+	}
+	var packetData transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(data, &packetData); err != nil {
+		return nil
+	}
+	denom := transfertypes.ParseDenomTrace(packetData.Denom).IBCDenom()
+	return h.MarkerKeeper.ValidateIbcSend(ctx, denom, sourceChannel, packetData.Receiver)
+}
+
+// ValidateRecvPacket returns an error if the packet is an ICS20 transfer of a restricted marker's denom
+// that is not allowed by the marker's IBC policy and required attributes. The denom checked is the one
+// the receiver would be credited with: the native denom (or, for a multi-hop voucher, the IBC denom)
+// for coin returning to this chain, or the IBC voucher denom for coin from another chain.
+func (h *MarkerHooks) ValidateRecvPacket(ctx sdktypes.Context, packet channeltypes.Packet) error {
+	if !h.ProperlyConfigured() {
+		return nil
+	}
+	isIcs20, data := isIcs20Packet(packet)
+	if !isIcs20 {
+		return nil
+	}
+	var denom string
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		voucherPrefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		denom = transfertypes.ParseDenomTrace(data.Denom[len(voucherPrefix):]).IBCDenom()
+	} else {
+		sourcePrefix := transfertypes.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel())
+		denom = transfertypes.ParseDenomTrace(sourcePrefix + data.Denom).IBCDenom()
+	}
+	return h.MarkerKeeper.ValidateIbcReceive(ctx, denom, packet.GetDestChannel(), data.Receiver)
+}
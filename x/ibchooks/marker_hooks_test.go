@@ -0,0 +1,136 @@
+package ibchooks_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/ibchooks"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+// mockMarkerKeeper records the calls made to it and returns the configured error.
+type mockMarkerKeeper struct {
+	calls []string
+	err   error
+}
+
+func (k *mockMarkerKeeper) ValidateIbcSend(_ sdk.Context, denom, sourceChannel, receiver string) error {
+	k.calls = append(k.calls, "send "+denom+" "+sourceChannel+" "+receiver)
+	return k.err
+}
+
+func (k *mockMarkerKeeper) ValidateIbcReceive(_ sdk.Context, denom, destChannel, receiver string) error {
+	k.calls = append(k.calls, "receive "+denom+" "+destChannel+" "+receiver)
+	return k.err
+}
+
+func TestMarkerHooksValidateSendPacket(t *testing.T) {
+	data := transfertypes.NewFungibleTokenPacketData("restrictedcoin", "10", "sender", "receiver", "").GetBytes()
+
+	var unconfigured *ibchooks.MarkerHooks
+	assert.NoError(t, unconfigured.ValidateSendPacket(sdk.Context{}, "channel-0", data), "nil hooks")
+
+	keeper := &mockMarkerKeeper{}
+	hooks := ibchooks.NewMarkerHooks(keeper)
+	require.NoError(t, hooks.ValidateSendPacket(sdk.Context{}, "channel-0", data), "allowed send")
+	require.NoError(t, hooks.ValidateSendPacket(sdk.Context{}, "channel-0", []byte("not json")), "non-ics20 packet")
+	voucherData := transfertypes.NewFungibleTokenPacketData("transfer/channel-3/uatom", "10", "sender", "receiver", "").GetBytes()
+	require.NoError(t, hooks.ValidateSendPacket(sdk.Context{}, "channel-3", voucherData), "voucher send")
+	assert.Equal(t, []string{
+		"send restrictedcoin channel-0 receiver",
+		"send " + transfertypes.ParseDenomTrace("transfer/channel-3/uatom").IBCDenom() + " channel-3 receiver",
+	}, keeper.calls, "keeper calls")
+
+	keeper.err = errors.New("not allowed")
+	assert.EqualError(t, hooks.ValidateSendPacket(sdk.Context{}, "channel-0", data), "not allowed", "denied send")
+}
+
+func TestMarkerHooksValidateRecvPacket(t *testing.T) {
+	newPacket := func(denom string) channeltypes.Packet {
+		data := transfertypes.NewFungibleTokenPacketData(denom, "10", "sender", "receiver", "").GetBytes()
+		return channeltypes.NewPacket(data, 1, "transfer", "channel-7", "transfer", "channel-2", clienttypes.NewHeight(0, 100), 0)
+	}
+
+	tests := []struct {
+		name     string
+		packet   channeltypes.Packet
+		expCalls []string
+	}{
+		{
+			name:     "returning native coin",
+			packet:   newPacket("transfer/channel-7/restrictedcoin"),
+			expCalls: []string{"receive restrictedcoin channel-2 receiver"},
+		},
+		{
+			name:     "voucher returning to this chain",
+			packet:   newPacket("transfer/channel-7/transfer/channel-5/uatom"),
+			expCalls: []string{"receive " + transfertypes.ParseDenomTrace("transfer/channel-5/uatom").IBCDenom() + " channel-2 receiver"},
+		},
+		{
+			name:     "voucher for a coin from the counterparty chain",
+			packet:   newPacket("uatom"),
+			expCalls: []string{"receive " + transfertypes.ParseDenomTrace("transfer/channel-2/uatom").IBCDenom() + " channel-2 receiver"},
+		},
+		{
+			name:   "coin that left through a different channel",
+			packet: newPacket("transfer/channel-8/restrictedcoin"),
+			expCalls: []string{"receive " + transfertypes.ParseDenomTrace("transfer/channel-2/transfer/channel-8/restrictedcoin").IBCDenom() +
+				" channel-2 receiver"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			keeper := &mockMarkerKeeper{}
+			hooks := ibchooks.NewMarkerHooks(keeper)
+			require.NoError(t, hooks.ValidateRecvPacket(sdk.Context{}, tc.packet), "ValidateRecvPacket")
+			assert.Equal(t, tc.expCalls, keeper.calls, "keeper calls")
+		})
+	}
+
+	keeper := &mockMarkerKeeper{err: errors.New("missing attributes")}
+	hooks := ibchooks.NewMarkerHooks(keeper)
+	assert.EqualError(t, hooks.ValidateRecvPacket(sdk.Context{}, newPacket("transfer/channel-7/restrictedcoin")),
+		"missing attributes", "denied receive")
+	assert.EqualError(t, hooks.ValidateRecvPacket(sdk.Context{}, newPacket("uatom")),
+		"missing attributes", "denied voucher receive")
+}
+
+func TestMarkerHooksRestrictedVoucher(t *testing.T) {
+	pioApp := app.Setup(t)
+	ctx := pioApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	trace := "transfer/channel-3/uatom"
+	voucherDenom := transfertypes.ParseDenomTrace(trace).IBCDenom()
+	acct := authtypes.NewBaseAccount(markertypes.MustGetMarkerAddress(voucherDenom), nil, 0, 0)
+	pioApp.MarkerKeeper.SetMarker(ctx, markertypes.NewMarkerAccount(acct, sdk.NewInt64Coin(voucherDenom, 1000),
+		sdk.AccAddress("manager_____________"), nil, markertypes.StatusActive, markertypes.MarkerType_RestrictedCoin,
+		false, false, false, nil))
+	pioApp.MarkerKeeper.SetIbcPolicy(ctx, markertypes.NewIbcPolicy(voucherDenom, []string{"channel-3"}, nil))
+	hooks := ibchooks.NewMarkerHooks(pioApp.MarkerKeeper)
+
+	send := func(denom string) []byte {
+		return transfertypes.NewFungibleTokenPacketData(denom, "10", "sender", "receiver", "").GetBytes()
+	}
+	assert.NoError(t, hooks.ValidateSendPacket(ctx, "channel-3", send(trace)), "send over the allowed channel")
+	assert.EqualError(t, hooks.ValidateSendPacket(ctx, "channel-0", send(trace)),
+		voucherDenom+" cannot be sent over channel channel-0", "send over another channel")
+
+	// The voucher returns to this chain from a chain it was sent to through channel-2.
+	packet := channeltypes.NewPacket(send("transfer/channel-7/"+trace), 1, "transfer", "channel-7", "transfer", "channel-2",
+		clienttypes.NewHeight(0, 100), 0)
+	assert.EqualError(t, hooks.ValidateRecvPacket(ctx, packet),
+		voucherDenom+" cannot be received through channel channel-2", "voucher returning through another channel")
+}
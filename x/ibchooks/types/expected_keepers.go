@@ -14,3 +14,9 @@ type ChannelKeeper interface {
 	LookupModuleByChannel(ctx sdk.Context, portID, channelID string) (string, *capabilitytypes.Capability, error)
 	WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet exported.PacketI, acknowledgement exported.Acknowledgement) error
 }
+
+// MarkerKeeper defines the marker keeper functions used to enforce the IBC policies of restricted markers.
+type MarkerKeeper interface {
+	ValidateIbcSend(ctx sdk.Context, denom, sourceChannel, receiver string) error
+	ValidateIbcReceive(ctx sdk.Context, denom, destChannel, receiver string) error
+}
@@ -14,42 +14,70 @@ import (
 	"github.com/provenance-io/provenance/x/marker/types"
 )
 
-// BeginBlocker returns the begin blocker for the marker module.
+// BeginBlocker returns the begin blocker for the marker module. Only the markers that were flagged since the
+// last block (see the dirty marker and pending destroy indexes) are checked; the supply invariant remains in
+// place as a safety net for any supply changes that do not go through the marker module. Expired transfer volume is
+// pruned, and cancelled markers are reinstated once their reinstate delay has passed.
 func BeginBlocker(ctx sdk.Context, _ abci.RequestBeginBlock, k keeper.Keeper, bk bankkeeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
-	// Iterate through all marker accounts and check for supply above or below expected targets.
-	var err error
-	k.IterateMarkers(ctx, func(record types.MarkerAccountI) bool {
+	// Check the supply of dirty markers against their expected targets.
+	dirtyMarkers := k.GetDirtyMarkers(ctx)
+	for _, addr := range dirtyMarkers {
+		record, err := k.GetMarker(ctx, addr)
+		if err != nil {
+			panic(err)
+		}
 		// Supply checks are only done against active markers with a fixed supply.
-		if record.GetStatus() == types.StatusActive && record.HasFixedSupply() {
-			requiredSupply := record.GetSupply()
-			currentSupply := bk.GetSupply(ctx, record.GetDenom())
-
-			// If the current amount of marker coin in circulation doesn't match configured supply, make adjustments
-			if !requiredSupply.IsEqual(currentSupply) {
-				ctx.Logger().Error(
-					fmt.Sprintf("Current %s supply is NOT at the required amount, adjusting %s to required supply level",
-						record.GetDenom(), currentSupply))
-				err = k.AdjustCirculation(ctx, record, requiredSupply)
+		if record == nil || record.GetStatus() != types.StatusActive || !record.HasFixedSupply() {
+			continue
+		}
+		requiredSupply := record.GetSupply()
+		currentSupply := bk.GetSupply(ctx, record.GetDenom())
+
+		// If the current amount of marker coin in circulation doesn't match configured supply, make adjustments
+		if !requiredSupply.IsEqual(currentSupply) {
+			ctx.Logger().Error(
+				fmt.Sprintf("Current %s supply is NOT at the required amount, adjusting %s to required supply level",
+					record.GetDenom(), currentSupply))
+			// We have no way of dealing with an error here and the invariant will fail soon from mismatch halting the chain.
+			if err = k.AdjustCirculation(ctx, record, requiredSupply); err != nil {
+				panic(err)
 			}
-			// else supply is equal, nothing to do here.
+			changeType := types.SupplyChangeCirculationIncrease
+			if requiredSupply.IsLT(currentSupply) {
+				changeType = types.SupplyChangeCirculationDecrease
+			}
+			offset := requiredSupply.Amount.Sub(currentSupply.Amount).Abs()
+			k.RecordSupplyChange(ctx, record.GetDenom(), changeType, offset, requiredSupply.Amount, "")
+		}
+		// else supply is equal, nothing to do here.
+	}
+	// The flags are cleared after the checks so that the corrections made above do not flag the markers again.
+	for _, addr := range dirtyMarkers {
+		k.ClearDirty(ctx, addr)
+	}
+
+	// Reinstate the cancelled markers that have waited long enough.
+	k.ProcessPendingReinstatements(ctx)
+
+	// Clear out markers that are in the destroyed status
+	for _, addr := range k.GetPendingDestroyMarkers(ctx) {
+		k.ClearPendingDestroy(ctx, addr)
+		record, err := k.GetMarker(ctx, addr)
+		if err != nil {
+			panic(err)
 		}
-		// Clear out markers that are in the destroyed status
-		if record.GetStatus() == types.StatusDestroyed {
-			k.RemoveMarker(ctx, record)
-			ctx.EventManager().EmitEvent(
-				sdk.NewEvent(
-					"beginblock",
-					sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-					sdk.NewAttribute(sdk.AttributeKeyAction, types.EventTypeDestroy),
-					sdk.NewAttribute(types.EventAttributeDenomKey, record.GetDenom()),
-				),
-			)
+		if record == nil || record.GetStatus() != types.StatusDestroyed {
+			continue
 		}
-		return err != nil
-	})
-	// We have no way of dealing with this and the invariant will fail soon from mismatch halting the chain.
-	if err != nil {
-		panic(err)
+		k.RemoveMarker(ctx, record)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"beginblock",
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(sdk.AttributeKeyAction, types.EventTypeDestroy),
+				sdk.NewAttribute(types.EventAttributeDenomKey, record.GetDenom()),
+			),
+		)
 	}
 }
@@ -49,6 +49,18 @@ func TestBeginBlocker(t *testing.T) {
 	// Post begin block the supply must be 0
 	require.Equal(t, app.BankKeeper.GetSupply(ctx, "testmint").Amount, sdk.NewInt(50))
 
+	// Both corrections should be in the supply history.
+	history, err := app.MarkerKeeper.SupplyHistory(sdk.WrapSDKContext(ctx), &types.QuerySupplyHistoryRequest{Id: "testmint"})
+	require.NoError(t, err)
+	require.Len(t, history.Entries, 2)
+	require.Equal(t, types.SupplyChangeCirculationIncrease, history.Entries[0].ChangeType)
+	require.Equal(t, sdk.NewInt(100), history.Entries[0].Amount)
+	require.Equal(t, sdk.NewInt(100), history.Entries[0].Supply)
+	require.Equal(t, types.SupplyChangeCirculationDecrease, history.Entries[1].ChangeType)
+	require.Equal(t, sdk.NewInt(50), history.Entries[1].Amount)
+	require.Equal(t, sdk.NewInt(50), history.Entries[1].Supply)
+	require.Empty(t, history.Entries[1].Authority)
+
 	// Cancel marker and zero out supply
 	testmint.Status = types.StatusDestroyed
 	require.NoError(t, app.MarkerKeeper.AdjustCirculation(ctx, testmint, sdk.NewCoin(testmint.Denom, sdk.ZeroInt())))
@@ -67,3 +79,32 @@ func TestBeginBlocker(t *testing.T) {
 	require.NoError(t, err)
 	require.Nil(t, deleted)
 }
+
+func TestBeginBlockerOnlyChecksDirtyMarkers(t *testing.T) {
+	app := app.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	testmint := &types.MarkerAccount{
+		BaseAccount: &authtypes.BaseAccount{
+			AccountNumber: 1,
+			Address:       types.MustGetMarkerAddress("testmint").String(),
+		},
+		Status:      types.StatusActive,
+		SupplyFixed: true,
+		Denom:       "testmint",
+		Supply:      sdk.NewInt(100),
+	}
+	app.MarkerKeeper.SetMarker(ctx, testmint)
+	require.True(t, app.MarkerKeeper.IsDirty(ctx, testmint.GetAddress()), "marker should be dirty after SetMarker")
+
+	// A marker that is not flagged as dirty is not checked.
+	app.MarkerKeeper.ClearDirty(ctx, testmint.GetAddress())
+	marker.BeginBlocker(ctx, abci.RequestBeginBlock{}, app.MarkerKeeper, app.BankKeeper)
+	require.Equal(t, sdk.NewInt(0), app.BankKeeper.GetSupply(ctx, "testmint").Amount)
+
+	// Once flagged, the supply is adjusted and the flag is cleared.
+	app.MarkerKeeper.MarkDirty(ctx, testmint.GetAddress())
+	marker.BeginBlocker(ctx, abci.RequestBeginBlock{}, app.MarkerKeeper, app.BankKeeper)
+	require.Equal(t, sdk.NewInt(100), app.BankKeeper.GetSupply(ctx, "testmint").Amount)
+	require.False(t, app.MarkerKeeper.IsDirty(ctx, testmint.GetAddress()), "marker should not be dirty after BeginBlocker")
+}
@@ -445,7 +445,7 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
 			[]string{
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
-			`{"max_total_supply":"1000000","enable_governance":true,"unrestricted_denom_regex":""}`,
+			`{"max_total_supply":"1000000","enable_governance":true,"unrestricted_denom_regex":"","max_supply_history_entries":0,"reinstate_delay_blocks":"0"}`,
 		},
 		{
 			"get testcoin marker json",
@@ -454,7 +454,7 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
 				"testcoin",
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
-			`{"marker":{"@type":"/provenance.marker.v1.MarkerAccount","base_account":{"address":"cosmos1p3sl9tll0ygj3flwt5r2w0n6fx9p5ngq2tu6mq","pub_key":null,"account_number":"8","sequence":"0"},"manager":"","access_control":[],"status":"MARKER_STATUS_ACTIVE","denom":"testcoin","supply":"1000","marker_type":"MARKER_TYPE_COIN","supply_fixed":true,"allow_governance_control":false,"allow_forced_transfer":false,"required_attributes":[]}}`,
+			`{"marker":{"@type":"/provenance.marker.v1.MarkerAccount","base_account":{"address":"cosmos1p3sl9tll0ygj3flwt5r2w0n6fx9p5ngq2tu6mq","pub_key":null,"account_number":"8","sequence":"0"},"manager":"","access_control":[],"status":"MARKER_STATUS_ACTIVE","denom":"testcoin","supply":"1000","marker_type":"MARKER_TYPE_COIN","supply_fixed":true,"allow_governance_control":false,"allow_forced_transfer":false,"required_attributes":[],"max_supply":"0"},"net_asset_values":[]}`,
 		},
 		{
 			"get testcoin marker test",
@@ -476,10 +476,12 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
   denom: testcoin
   manager: ""
   marker_type: MARKER_TYPE_COIN
+  max_supply: "0"
   required_attributes: []
   status: MARKER_STATUS_ACTIVE
   supply: "1000"
-  supply_fixed: true`,
+  supply_fixed: true
+net_asset_values: []`,
 		},
 		{
 			"query non existent marker",
@@ -496,7 +498,7 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
 				"lockedcoin",
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
-			`{"marker":{"@type":"/provenance.marker.v1.MarkerAccount","base_account":{"address":"cosmos16437wt0xtqtuw0pn4vt8rlf8gr2plz2det0mt2","pub_key":null,"account_number":"9","sequence":"0"},"manager":"","access_control":[],"status":"MARKER_STATUS_ACTIVE","denom":"lockedcoin","supply":"1000","marker_type":"MARKER_TYPE_RESTRICTED","supply_fixed":true,"allow_governance_control":false,"allow_forced_transfer":false,"required_attributes":[]}}`,
+			`{"marker":{"@type":"/provenance.marker.v1.MarkerAccount","base_account":{"address":"cosmos16437wt0xtqtuw0pn4vt8rlf8gr2plz2det0mt2","pub_key":null,"account_number":"9","sequence":"0"},"manager":"","access_control":[],"status":"MARKER_STATUS_ACTIVE","denom":"lockedcoin","supply":"1000","marker_type":"MARKER_TYPE_RESTRICTED","supply_fixed":true,"allow_governance_control":false,"allow_forced_transfer":false,"required_attributes":[],"max_supply":"0"},"net_asset_values":[]}`,
 		},
 		{
 			"get restricted coin marker with forced transfer",
@@ -518,10 +520,12 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
   denom: hodlercoin
   manager: ""
   marker_type: MARKER_TYPE_RESTRICTED
+  max_supply: "0"
   required_attributes: []
   status: MARKER_STATUS_ACTIVE
   supply: "3000"
-  supply_fixed: false`,
+  supply_fixed: false
+net_asset_values: []`,
 		},
 		{
 			"query access",
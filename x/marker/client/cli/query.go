@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -32,6 +33,17 @@ func GetQueryCmd() *cobra.Command {
 		MarkerEscrowCmd(),
 		MarkerSupplyCmd(),
 		AccountDataCmd(),
+		TransferAllowanceCmd(),
+		SupplyHistoryCmd(),
+		NetAssetValuesCmd(),
+		IbcPolicyCmd(),
+		TransferFeeCmd(),
+		EscrowHoldersCmd(),
+		MarkersByManagerCmd(),
+		MarkersByAccessHolderCmd(),
+		MarkersByTypeCmd(),
+		MarkersByForcedTransferCmd(),
+		MarkersByRequiredAttributeCmd(),
 	)
 	return queryCmd
 }
@@ -301,3 +313,405 @@ func AccountDataCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// TransferAllowanceCmd is the CLI command for querying the transfer limits and remaining allowance of a marker.
+func TransferAllowanceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "transfer-allowance <address|denom> [holder address]",
+		Short:   "Get the transfer limits of a restricted marker and the amounts that can still be transferred",
+		Aliases: []string{"ta", "transfer-limit"},
+		Example: fmt.Sprintf(`$ %[1]s query marker transfer-allowance hotdogcoin
+$ %[1]s query marker transfer-allowance hotdogcoin pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk`, version.AppName),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryTransferAllowanceRequest{Id: strings.TrimSpace(args[0])}
+			if len(args) > 1 {
+				req.Address = strings.TrimSpace(args[1])
+			}
+
+			resp, err := queryClient.TransferAllowance(context.Background(), req)
+			if err != nil {
+				return fmt.Errorf("failed to query transfer allowance for marker %q: %w", req.Id, err)
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// SupplyHistoryCmd is the CLI command for querying the supply history of a marker.
+func SupplyHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "supply-history <address|denom>",
+		Short:   "Get the history of changes made to the supply of a marker",
+		Aliases: []string{"sh", "supplyhistory"},
+		Example: fmt.Sprintf(`$ %[1]s query marker supply-history nhash
+$ %[1]s query marker supply-history nhash --reverse --limit 10`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &types.QuerySupplyHistoryRequest{
+				Id:         strings.TrimSpace(args[0]),
+				Pagination: pageReq,
+			}
+
+			resp, err := queryClient.SupplyHistory(context.Background(), req)
+			if err != nil {
+				return fmt.Errorf("failed to query supply history for marker %q: %w", req.Id, err)
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "supply history")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// IbcPolicyCmd is the CLI command for querying the IBC policy of a restricted marker.
+func IbcPolicyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "ibc-policy <address|denom>",
+		Short:   "Get the IBC channel and destination policy of a restricted marker",
+		Aliases: []string{"ip", "ibcpolicy"},
+		Example: fmt.Sprintf(`$ %[1]s query marker ibc-policy hotdogcoin`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryIbcPolicyRequest{Id: strings.TrimSpace(args[0])}
+
+			resp, err := queryClient.IbcPolicy(context.Background(), req)
+			if err != nil {
+				return fmt.Errorf("failed to query ibc policy for marker %q: %w", req.Id, err)
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// TransferFeeCmd is the CLI command for querying the transfer fee of a restricted marker.
+func TransferFeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "transfer-fee <address|denom>",
+		Short:   "Get the fee charged on every transfer of a restricted marker's coin",
+		Aliases: []string{"tf", "transferfee"},
+		Example: fmt.Sprintf(`$ %[1]s query marker transfer-fee hotdogcoin`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryTransferFeeRequest{Id: strings.TrimSpace(args[0])}
+
+			resp, err := queryClient.TransferFee(context.Background(), req)
+			if err != nil {
+				return fmt.Errorf("failed to query transfer fee for marker %q: %w", req.Id, err)
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// EscrowHoldersCmd is the CLI command for querying the markers that hold a denom in escrow.
+func EscrowHoldersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "escrow-holders <denom>",
+		Short:   "Get the markers that hold a denom in escrow",
+		Aliases: []string{"eh", "escrowholders"},
+		Example: fmt.Sprintf(`$ %[1]s query marker escrow-holders hotdogcoin`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &types.QueryEscrowHoldersRequest{
+				Denom:      strings.TrimSpace(args[0]),
+				Pagination: pageReq,
+			}
+
+			resp, err := queryClient.EscrowHolders(context.Background(), req)
+			if err != nil {
+				return fmt.Errorf("failed to query escrow holders of %q: %w", req.Denom, err)
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "escrow holders")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// NetAssetValuesCmd is the CLI command for querying the net asset value history of a marker.
+func NetAssetValuesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "net-asset-values <address|denom>",
+		Short:   "Get the net asset value history of a marker",
+		Aliases: []string{"navs", "netassetvalues"},
+		Example: fmt.Sprintf(`$ %[1]s query marker net-asset-values hotdogcoin
+$ %[1]s query marker net-asset-values hotdogcoin --reverse --limit 10`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &types.QueryNetAssetValuesRequest{
+				Id:         strings.TrimSpace(args[0]),
+				Pagination: pageReq,
+			}
+
+			resp, err := queryClient.NetAssetValues(context.Background(), req)
+			if err != nil {
+				return fmt.Errorf("failed to query net asset values for marker %q: %w", req.Id, err)
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "net asset values")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// MarkersByManagerCmd is the CLI command for listing the markers managed by an address.
+func MarkersByManagerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "by-manager <address>",
+		Short:   "List the markers managed by an address",
+		Example: fmt.Sprintf(`$ %s query marker by-manager pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &types.QueryMarkersByManagerRequest{
+				Manager:    strings.TrimSpace(args[0]),
+				Pagination: pageReq,
+			}
+
+			resp, err := queryClient.MarkersByManager(context.Background(), req)
+			if err != nil {
+				return fmt.Errorf("failed to query markers managed by %q: %w", req.Manager, err)
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "markers")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// MarkersByAccessHolderCmd is the CLI command for listing the markers that grant access to an address.
+func MarkersByAccessHolderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "by-access-holder <address> [access]",
+		Short: "List the markers that grant access to an address, optionally limited to a single access permission",
+		Example: fmt.Sprintf(`$ %[1]s query marker by-access-holder pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+$ %[1]s query marker by-access-holder pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk mint`, version.AppName),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &types.QueryMarkersByAccessHolderRequest{
+				Address:    strings.TrimSpace(args[0]),
+				Pagination: pageReq,
+			}
+			if len(args) > 1 {
+				req.Access = types.AccessByName(args[1])
+				if req.Access == types.Access_Unknown {
+					return fmt.Errorf("unknown access %q", args[1])
+				}
+			}
+
+			resp, err := queryClient.MarkersByAccessHolder(context.Background(), req)
+			if err != nil {
+				return fmt.Errorf("failed to query markers granting access to %q: %w", req.Address, err)
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "markers")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// MarkersByTypeCmd is the CLI command for listing the markers of a marker type.
+func MarkersByTypeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "by-type <coin|restricted>",
+		Short: "List the markers of a marker type",
+		Example: fmt.Sprintf(`$ %[1]s query marker by-type coin
+$ %[1]s query marker by-type restricted`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			markerType, err := types.MarkerTypeFromString(strings.TrimSpace(args[0]))
+			if err != nil {
+				return err
+			}
+			req := &types.QueryMarkersByTypeRequest{
+				MarkerType: markerType,
+				Pagination: pageReq,
+			}
+
+			resp, err := queryClient.MarkersByType(context.Background(), req)
+			if err != nil {
+				return fmt.Errorf("failed to query markers of type %s: %w", req.MarkerType, err)
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "markers")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// MarkersByForcedTransferCmd is the CLI command for listing the markers that allow (or disallow) forced transfers.
+func MarkersByForcedTransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "by-forced-transfer <true|false>",
+		Short: "List the markers that allow (true) or do not allow (false) forced transfers",
+		Example: fmt.Sprintf(`$ %[1]s query marker by-forced-transfer true
+$ %[1]s query marker by-forced-transfer false`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			allowed, err := strconv.ParseBool(strings.TrimSpace(args[0]))
+			if err != nil {
+				return fmt.Errorf("invalid allow forced transfer value %q: %w", args[0], err)
+			}
+			req := &types.QueryMarkersByForcedTransferRequest{
+				AllowForcedTransfer: allowed,
+				Pagination:          pageReq,
+			}
+
+			resp, err := queryClient.MarkersByForcedTransfer(context.Background(), req)
+			if err != nil {
+				return fmt.Errorf("failed to query markers by forced transfer: %w", err)
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "markers")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// MarkersByRequiredAttributeCmd is the CLI command for listing the markers that require an attribute.
+func MarkersByRequiredAttributeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "by-required-attribute <attribute>",
+		Short:   "List the markers that list an attribute in their required attributes",
+		Example: fmt.Sprintf(`$ %s query marker by-required-attribute kyc.provenance.io`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &types.QueryMarkersByRequiredAttributeRequest{
+				Attribute:  strings.TrimSpace(args[0]),
+				Pagination: pageReq,
+			}
+
+			resp, err := queryClient.MarkersByRequiredAttribute(context.Background(), req)
+			if err != nil {
+				return fmt.Errorf("failed to query markers requiring attribute %q: %w", req.Attribute, err)
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "markers")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -21,6 +22,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -52,6 +54,11 @@ const (
 	FlagAdd                    = "add"
 	FlagRemove                 = "remove"
 	FlagGovProposal            = "gov-proposal"
+	FlagAllowedChannels        = "allowed-channels"
+	FlagAllowedDestinations    = "allowed-destinations"
+	FlagBasisPoints            = "basis-points"
+	FlagFlatFees               = "flat-fees"
+	FlagManager                = "manager"
 )
 
 // NewTxCmd returns the top-level command for marker CLI transactions.
@@ -85,6 +92,23 @@ func NewTxCmd() *cobra.Command {
 		GetCmdUpdateForcedTransfer(),
 		GetCmdSetAccountData(),
 		GetCmdUpdateSendDenyListRequest(),
+		GetCmdSetTransferLimit(),
+		GetCmdSupplyDecreaseProposal(),
+		GetCmdSetAdministratorProposal(),
+		GetCmdRemoveAdministratorProposal(),
+		GetCmdChangeStatusProposal(),
+		GetCmdWithdrawEscrowProposal(),
+		GetCmdSetDenomMetadataProposal(),
+		GetCmdAddNetAssetValues(),
+		GetCmdChangeMarkerType(),
+		GetCmdSetMaxSupply(),
+		GetCmdBatchTransfer(),
+		GetCmdRecoverAccount(),
+		GetCmdSetIbcPolicy(),
+		GetCmdSetTransferFee(),
+		GetCmdMultiWithdraw(),
+		GetCmdReinstateMarker(),
+		GetCmdReclaimEscrow(),
 	)
 	return txCmd
 }
@@ -1137,6 +1161,263 @@ $ %[1]s tx marker account-data hotdogcoin --%[4]s`,
 	return cmd
 }
 
+// GetCmdSetTransferLimit returns a CLI command for setting the transfer limits of a restricted marker.
+func GetCmdSetTransferLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-transfer-limit <denom> <window> <marker limit> <holder limit>",
+		Aliases: []string{"stl", "transfer-limit"},
+		Args:    cobra.ExactArgs(4),
+		Short:   "Set the rolling window transfer limits of a restricted marker",
+		Long: strings.TrimSpace(`Set the rolling window transfer limits of a restricted marker.
+The window is a duration (e.g. 24h). The marker limit caps the amount transferred by all holders combined
+during the window, and the holder limit caps the amount transferred by any single holder during the window.
+A limit of 0 indicates there is no limit of that kind. Setting both limits to 0 removes the transfer limits.
+`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker set-transfer-limit hotdogcoin 24h 1000000 10000
+$ %[1]s tx marker set-transfer-limit hotdogcoin 24h 0 0`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+
+			msg := &types.MsgSetTransferLimitRequest{Denom: strings.TrimSpace(args[0])}
+
+			msg.Window, err = time.ParseDuration(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid window %q: %w", args[1], err)
+			}
+
+			var ok bool
+			msg.MarkerLimit, ok = sdk.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid marker limit %q", args[2])
+			}
+
+			msg.HolderLimit, ok = sdk.NewIntFromString(args[3])
+			if !ok {
+				return fmt.Errorf("invalid holder limit %q", args[3])
+			}
+
+			authSetter := func(authority string) {
+				msg.Authority = authority
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, flagSet, authSetter, msg)
+		},
+	}
+
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSupplyDecreaseProposal returns a CLI command for submitting a governance proposal to decrease a marker's supply.
+func GetCmdSupplyDecreaseProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "supply-decrease-proposal <amount>",
+		Aliases: []string{"sdp"},
+		Short:   "Submit a governance proposal to decrease the supply of a marker by burning coin held in the marker",
+		Example: fmt.Sprintf("$ %s tx marker supply-decrease-proposal 1000hotdogcoin", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid amount %q: %w", args[0], err)
+			}
+
+			msg := types.NewMsgSupplyDecreaseProposalRequest(amount, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return govcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	govcli.AddGovPropFlagsToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetAdministratorProposal returns a CLI command for submitting a governance proposal to grant access on a marker.
+func GetCmdSetAdministratorProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-administrator-proposal <denom> <access grants>",
+		Aliases: []string{"sap"},
+		Short:   "Submit a governance proposal to grant access on a marker",
+		Long: strings.TrimSpace(`Submit a governance proposal to grant access on a marker.
+The access grants are provided as a semicolon separated list of an address followed by its comma separated permissions.
+`),
+		Example: fmt.Sprintf(`$ %s tx marker set-administrator-proposal hotdogcoin "pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk,mint,burn;pb1r4dr6v5kfj5q5j2qr5jrwvx7ytrcpt5ppkpj8k,withdraw"`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grants := ParseAccessGrantFromString(args[1])
+			msg := types.NewMsgSetAdministratorProposalRequest(args[0], grants, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return govcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	govcli.AddGovPropFlagsToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdRemoveAdministratorProposal returns a CLI command for submitting a governance proposal to remove all access
+// of addresses on a marker.
+func GetCmdRemoveAdministratorProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-administrator-proposal <denom> <address> [<address> ...]",
+		Aliases: []string{"rap"},
+		Short:   "Submit a governance proposal to remove all access of addresses on a marker",
+		Example: fmt.Sprintf("$ %s tx marker remove-administrator-proposal hotdogcoin pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk", version.AppName),
+		Args:    cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveAdministratorProposalRequest(args[0], args[1:], authtypes.NewModuleAddress(govtypes.ModuleName).String())
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return govcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	govcli.AddGovPropFlagsToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdChangeStatusProposal returns a CLI command for submitting a governance proposal to change a marker's status.
+func GetCmdChangeStatusProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "change-status-proposal <denom> <status>",
+		Aliases: []string{"csp"},
+		Short:   "Submit a governance proposal to change the status of a marker",
+		Long: strings.TrimSpace(`Submit a governance proposal to change the status of a marker.
+The status is one of proposed, finalized, active, cancelled, or destroyed.
+`),
+		Example: fmt.Sprintf("$ %s tx marker change-status-proposal hotdogcoin active", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			status, err := types.MarkerStatusFromString(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgChangeStatusProposalRequest(args[0], status, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return govcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	govcli.AddGovPropFlagsToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdWithdrawEscrowProposal returns a CLI command for submitting a governance proposal to withdraw coins held by
+// a marker.
+func GetCmdWithdrawEscrowProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "withdraw-escrow-proposal <denom> <amount> <target address>",
+		Aliases: []string{"wep"},
+		Short:   "Submit a governance proposal to withdraw coins held by a marker to an address",
+		Example: fmt.Sprintf("$ %s tx marker withdraw-escrow-proposal hotdogcoin 100hotdogcoin pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid amount %q: %w", args[1], err)
+			}
+
+			msg := types.NewMsgWithdrawEscrowProposalRequest(args[0], amount, args[2], authtypes.NewModuleAddress(govtypes.ModuleName).String())
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return govcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	govcli.AddGovPropFlagsToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetDenomMetadataProposal returns a CLI command for submitting a governance proposal to set the metadata of
+// a marker's denom.
+func GetCmdSetDenomMetadataProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-denom-metadata-proposal <metadata json file>",
+		Aliases: []string{"sdmp"},
+		Short:   "Submit a governance proposal to set the denom metadata of a marker",
+		Long: strings.TrimSpace(`Submit a governance proposal to set the denom metadata of a marker.
+The metadata is provided as a json file containing a cosmos.bank.v1beta1.Metadata.
+`),
+		Example: fmt.Sprintf("$ %s tx marker set-denom-metadata-proposal metadata.json", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var metadata banktypes.Metadata
+			if err = clientCtx.Codec.UnmarshalJSON(contents, &metadata); err != nil {
+				return fmt.Errorf("invalid denom metadata in %s: %w", args[0], err)
+			}
+
+			msg := types.NewMsgSetDenomMetadataProposalRequest(metadata, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return govcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	govcli.AddGovPropFlagsToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func getPeriodReset(duration int64) time.Time {
 	return time.Now().Add(getPeriod(duration))
 }
@@ -1274,3 +1555,460 @@ func generateOrBroadcastOptGovProp(clientCtx client.Context, flagSet *pflag.Flag
 	}
 	return tx.GenerateOrBroadcastTxCLI(clientCtx, flagSet, msg)
 }
+
+// GetCmdAddNetAssetValues returns a CLI command for recording net asset values of a marker.
+func GetCmdAddNetAssetValues() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "add-net-asset-values <denom> <price>,<volume>[,<source>] [<price>,<volume>[,<source>] ...]",
+		Aliases: []string{"add-navs", "anav"},
+		Args:    cobra.MinimumNArgs(2),
+		Short:   "Record net asset values for a marker",
+		Long: strings.TrimSpace(`Record net asset values for a marker.
+Each net asset value is the price of a volume of the marker's coin (in its base denomination) along with an
+optional source. Prices in usd are given in mils (thousandths of a dollar).
+`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker add-net-asset-values hotdogcoin 1000usd,1,fund-admin
+$ %[1]s tx marker add-net-asset-values hotdogcoin 1000usd,1 50000000nhash,10`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+
+			msg := &types.MsgAddNetAssetValuesRequest{Denom: strings.TrimSpace(args[0])}
+			for _, arg := range args[1:] {
+				var nav types.NetAssetValue
+				nav, err = ParseNetAssetValueString(arg)
+				if err != nil {
+					return err
+				}
+				msg.NetAssetValues = append(msg.NetAssetValues, nav)
+			}
+
+			authSetter := func(authority string) {
+				msg.Administrator = authority
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, flagSet, authSetter, msg)
+		},
+	}
+
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdChangeMarkerType returns a CLI command for switching an active marker between the coin and restricted types.
+func GetCmdChangeMarkerType() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "change-marker-type <denom> <coin|restricted> [<other administrator> ...]",
+		Aliases: []string{"cmt"},
+		Args:    cobra.MinimumNArgs(2),
+		Short:   "Switch an active marker between the coin and restricted coin types",
+		Long: strings.TrimSpace(`Switch an active marker between the coin and restricted coin types.
+A marker can only be changed to the coin type if it does not allow forced transfers, has no required attributes,
+has no transfer limits, and has no access grants that are only supported on restricted markers (e.g. transfer).
+The request must be signed by a majority of the marker's admins (the --from account and any other administrators
+provided), or be submitted as a governance proposal.
+`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker change-marker-type hotdogcoin restricted
+$ %[1]s tx marker change-marker-type hotdogcoin restricted pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --generate-only
+$ %[1]s tx marker change-marker-type hotdogcoin coin --%[2]s`, version.AppName, FlagGovProposal),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+
+			markerType, err := types.MarkerTypeFromString(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid marker type %q: %w", args[1], err)
+			}
+			msg := types.NewMsgChangeMarkerTypeRequest(strings.TrimSpace(args[0]), markerType)
+
+			authSetter := func(authority string) {
+				msg.Administrators = append([]string{authority}, args[2:]...)
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, flagSet, authSetter, msg)
+		},
+	}
+
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetMaxSupply returns a CLI command for setting the maximum supply of a marker.
+func GetCmdSetMaxSupply() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-max-supply <denom> <max supply> [<other administrator> ...]",
+		Aliases: []string{"sms", "max-supply"},
+		Args:    cobra.MinimumNArgs(2),
+		Short:   "Set the maximum supply of a marker",
+		Long: strings.TrimSpace(`Set the maximum supply of a marker. A max supply of 0 removes the per-marker cap.
+The request must be signed by a majority of the marker's admins (the --from account and any other administrators
+provided), or be submitted as a governance proposal.
+`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker set-max-supply hotdogcoin 1000000
+$ %[1]s tx marker set-max-supply hotdogcoin 1000000 pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --generate-only
+$ %[1]s tx marker set-max-supply hotdogcoin 0 --%[2]s`, version.AppName, FlagGovProposal),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+
+			maxSupply, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid max supply %q", args[1])
+			}
+			msg := types.NewMsgSetMaxSupplyRequest(strings.TrimSpace(args[0]), maxSupply)
+
+			authSetter := func(authority string) {
+				msg.Administrators = append([]string{authority}, args[2:]...)
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, flagSet, authSetter, msg)
+		},
+	}
+
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdBatchTransfer returns a CLI command for transferring restricted coin between many pairs of accounts.
+func GetCmdBatchTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "batch-transfer <denom> <from>,<to>,<amount> [<from>,<to>,<amount> ...]",
+		Aliases: []string{"bt"},
+		Args:    cobra.MinimumNArgs(2),
+		Short:   "Transfer restricted coin between many pairs of accounts",
+		Long: strings.TrimSpace(`Transfer restricted coin between many pairs of accounts.
+Either all of the transfers are made, or none of them are. Each transfer follows the same rules as the transfer command.
+`),
+		Example: fmt.Sprintf(`$ %s tx marker batch-transfer hotdogcoin tp1jypkeck8vywptdltjnwspwzulkqu7jv6ey90dx,tp1z6403t8z42fpl760zguuf2pc24g5gq96sez0k4,100 tp1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk,tp1z6403t8z42fpl760zguuf2pc24g5gq96sez0k4,50 --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			transfers := make([]types.BatchTransferEntry, len(args)-1)
+			for i, arg := range args[1:] {
+				transfers[i], err = ParseBatchTransferEntry(arg)
+				if err != nil {
+					return err
+				}
+			}
+			msg := types.NewMsgBatchTransferRequest(strings.TrimSpace(args[0]), clientCtx.GetFromAddress().String(), transfers...)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// ParseBatchTransferEntry parses a batch transfer entry from a string formatted as <from>,<to>,<amount>.
+func ParseBatchTransferEntry(value string) (types.BatchTransferEntry, error) {
+	parts := strings.Split(strings.TrimSpace(value), ",")
+	if len(parts) != 3 {
+		return types.BatchTransferEntry{}, fmt.Errorf("invalid transfer %q: expected <from>,<to>,<amount>", value)
+	}
+	from := strings.TrimSpace(parts[0])
+	if _, err := sdk.AccAddressFromBech32(from); err != nil {
+		return types.BatchTransferEntry{}, cerrs.Wrapf(err, "invalid from address %s", from)
+	}
+	to := strings.TrimSpace(parts[1])
+	if _, err := sdk.AccAddressFromBech32(to); err != nil {
+		return types.BatchTransferEntry{}, cerrs.Wrapf(err, "invalid recipient address %s", to)
+	}
+	amount, ok := sdk.NewIntFromString(strings.TrimSpace(parts[2]))
+	if !ok {
+		return types.BatchTransferEntry{}, fmt.Errorf("invalid transfer amount %q", parts[2])
+	}
+	return types.NewBatchTransferEntry(from, to, amount), nil
+}
+
+// GetCmdRecoverAccount returns a CLI command for moving all of a marker's coin out of a compromised account.
+func GetCmdRecoverAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "recover-account <denom> <compromised address> <replacement address>",
+		Aliases: []string{"ra"},
+		Args:    cobra.ExactArgs(3),
+		Short:   "Move all of a restricted marker's coin from a compromised account to a replacement account",
+		Example: fmt.Sprintf(`$ %s tx marker recover-account hotdogcoin tp1jypkeck8vywptdltjnwspwzulkqu7jv6ey90dx tp1z6403t8z42fpl760zguuf2pc24g5gq96sez0k4 --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			if _, err = sdk.AccAddressFromBech32(args[1]); err != nil {
+				return cerrs.Wrapf(err, "invalid compromised address %s", args[1])
+			}
+			if _, err = sdk.AccAddressFromBech32(args[2]); err != nil {
+				return cerrs.Wrapf(err, "invalid replacement address %s", args[2])
+			}
+			msg := types.NewMsgRecoverAccountRequest(strings.TrimSpace(args[0]), args[1], args[2], clientCtx.GetFromAddress().String())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetIbcPolicy returns a CLI command for setting the IBC policy of a restricted marker.
+func GetCmdSetIbcPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-ibc-policy <denom>",
+		Aliases: []string{"sip", "ibc-policy"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Set the IBC channel and destination policy of a restricted marker",
+		Long: strings.TrimSpace(`Set the IBC channel and destination policy of a restricted marker.
+The allowed channels are the channels on this chain that the marker's coin can be sent out on and returned through.
+The allowed destinations are the receiver addresses on counterparty chains that the marker's coin can be sent to.
+An empty list allows any channel or destination. Providing neither flag removes the IBC policy.
+Coin returning to this chain must also go to a receiver that has the marker's required attributes.
+`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker set-ibc-policy hotdogcoin --%[2]s channel-0,channel-3
+$ %[1]s tx marker set-ibc-policy hotdogcoin --%[2]s channel-0 --%[3]s cosmos1jypkeck8vywptdltjnwspwzulkqu7jv6w8tqw3
+$ %[1]s tx marker set-ibc-policy hotdogcoin`, version.AppName, FlagAllowedChannels, FlagAllowedDestinations),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+
+			allowedChannels, err := flagSet.GetStringSlice(FlagAllowedChannels)
+			if err != nil {
+				return err
+			}
+			allowedDestinations, err := flagSet.GetStringSlice(FlagAllowedDestinations)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgSetIbcPolicyRequest(strings.TrimSpace(args[0]), allowedChannels, allowedDestinations, "")
+
+			authSetter := func(authority string) {
+				msg.Authority = authority
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, flagSet, authSetter, msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagAllowedChannels, []string{}, "comma delimited list of channels the marker's coin can be sent out on and returned through")
+	cmd.Flags().StringSlice(FlagAllowedDestinations, []string{}, "comma delimited list of counterparty receiver addresses the marker's coin can be sent to")
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetTransferFee returns a CLI command for setting the transfer fee of a restricted marker.
+func GetCmdSetTransferFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-transfer-fee <denom> [<recipient>]",
+		Aliases: []string{"stf", "transfer-fee"},
+		Args:    cobra.RangeArgs(1, 2),
+		Short:   "Set the fee charged on every transfer of a restricted marker's coin",
+		Long: strings.TrimSpace(`Set the fee charged on every transfer of a restricted marker's coin.
+The fee is paid by the sender to the recipient, on top of the amount being transferred.
+The basis points are the portion of each transferred amount, in hundredths of a percent, charged in the marker's coin.
+The flat fees are charged on every transfer regardless of the amount.
+Providing neither flag (and no recipient) removes the transfer fee.
+`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker set-transfer-fee hotdogcoin pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --%[2]s 25
+$ %[1]s tx marker set-transfer-fee hotdogcoin pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --%[2]s 10 --%[3]s 100nhash
+$ %[1]s tx marker set-transfer-fee hotdogcoin`, version.AppName, FlagBasisPoints, FlagFlatFees),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+
+			var recipient string
+			if len(args) > 1 {
+				recipient = strings.TrimSpace(args[1])
+			}
+			basisPoints, err := flagSet.GetUint32(FlagBasisPoints)
+			if err != nil {
+				return err
+			}
+			flatFeesStr, err := flagSet.GetString(FlagFlatFees)
+			if err != nil {
+				return err
+			}
+			flatFees, err := sdk.ParseCoinsNormalized(flatFeesStr)
+			if err != nil {
+				return fmt.Errorf("invalid %s: %w", FlagFlatFees, err)
+			}
+			msg := types.NewMsgSetTransferFeeRequest(strings.TrimSpace(args[0]), recipient, basisPoints, flatFees, "")
+
+			authSetter := func(authority string) {
+				msg.Authority = authority
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, flagSet, authSetter, msg)
+		},
+	}
+
+	cmd.Flags().Uint32(FlagBasisPoints, 0, "portion of each transferred amount, in hundredths of a percent, to charge")
+	cmd.Flags().String(FlagFlatFees, "", "fixed fees to charge on every transfer")
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdMultiWithdraw returns a CLI command for withdrawing coins from a marker's escrow to many recipients.
+func GetCmdMultiWithdraw() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "multi-withdraw <denom> <to>=<coins> [<to>=<coins> ...]",
+		Aliases: []string{"mw"},
+		Args:    cobra.MinimumNArgs(2),
+		Short:   "Withdraw coins from the marker to many recipients",
+		Long: strings.TrimSpace(`Withdraw coins from the marker escrow account to many recipients.
+Either all of the recipients are paid, or none of them are. Must be called by a user with withdraw access on the marker.
+`),
+		Example: fmt.Sprintf(`$ %s tx marker multi-withdraw coindenom tp1jypkeck8vywptdltjnwspwzulkqu7jv6ey90dx=100coindenom tp1z6403t8z42fpl760zguuf2pc24g5gq96sez0k4=50coindenom,10nhash --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			recipients := make([]types.WithdrawRecipient, len(args)-1)
+			for i, arg := range args[1:] {
+				recipients[i], err = ParseWithdrawRecipient(arg)
+				if err != nil {
+					return err
+				}
+			}
+			msg := types.NewMsgMultiWithdrawRequest(strings.TrimSpace(args[0]), clientCtx.GetFromAddress().String(), recipients...)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// ParseWithdrawRecipient parses a withdraw recipient from a string formatted as <to>=<coins>.
+func ParseWithdrawRecipient(value string) (types.WithdrawRecipient, error) {
+	parts := strings.Split(strings.TrimSpace(value), "=")
+	if len(parts) != 2 {
+		return types.WithdrawRecipient{}, fmt.Errorf("invalid recipient %q: expected <to>=<coins>", value)
+	}
+	to := strings.TrimSpace(parts[0])
+	if _, err := sdk.AccAddressFromBech32(to); err != nil {
+		return types.WithdrawRecipient{}, cerrs.Wrapf(err, "invalid recipient address %s", to)
+	}
+	coins, err := sdk.ParseCoinsNormalized(strings.TrimSpace(parts[1]))
+	if err != nil {
+		return types.WithdrawRecipient{}, sdkErrors.ErrInvalidCoins.Wrapf("invalid coins %s", parts[1])
+	}
+	return types.NewWithdrawRecipient(to, coins), nil
+}
+
+// ParseNetAssetValueString parses a net asset value from a string formatted as <price>,<volume>[,<source>].
+func ParseNetAssetValueString(value string) (types.NetAssetValue, error) {
+	parts := strings.SplitN(strings.TrimSpace(value), ",", 3)
+	if len(parts) < 2 {
+		return types.NetAssetValue{}, fmt.Errorf("invalid net asset value %q: expected <price>,<volume>[,<source>]", value)
+	}
+	price, err := sdk.ParseCoinNormalized(strings.TrimSpace(parts[0]))
+	if err != nil {
+		return types.NetAssetValue{}, fmt.Errorf("invalid net asset value price %q: %w", parts[0], err)
+	}
+	volume, err := strconv.ParseUint(strings.TrimSpace(parts[1]), 10, 64)
+	if err != nil {
+		return types.NetAssetValue{}, fmt.Errorf("invalid net asset value volume %q: %w", parts[1], err)
+	}
+	source := ""
+	if len(parts) == 3 {
+		source = strings.TrimSpace(parts[2])
+	}
+	return types.NewNetAssetValue(price, volume, source), nil
+}
+
+// GetCmdReinstateMarker returns a CLI command for returning a cancelled marker to the proposed or finalized status.
+func GetCmdReinstateMarker() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "reinstate <denom> {proposed|finalized}",
+		Aliases: []string{"reinstate-marker"},
+		Args:    cobra.ExactArgs(2),
+		Short:   "Return a cancelled marker to the proposed or finalized status",
+		Long: strings.TrimSpace(`Return a cancelled marker to the proposed or finalized status.
+The marker is reinstated once the reinstate delay (a marker module param) has passed.
+The reinstated marker is managed by the --manager address, or by its current manager if no --manager is provided.
+`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker reinstate hotdogcoin proposed --%[2]s pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+$ %[1]s tx marker reinstate hotdogcoin finalized`, version.AppName, FlagManager),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+
+			status, err := types.MarkerStatusFromString(strings.TrimSpace(args[1]))
+			if err != nil {
+				return err
+			}
+			manager, err := flagSet.GetString(FlagManager)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgReinstateMarkerRequest(strings.TrimSpace(args[0]), status, manager, "")
+
+			authSetter := func(authority string) {
+				msg.Authority = authority
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, flagSet, authSetter, msg)
+		},
+	}
+
+	cmd.Flags().String(FlagManager, "", "address that will manage the marker once it is reinstated")
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdReclaimEscrow returns a CLI command for reclaiming the coins held in a cancelled marker's escrow.
+func GetCmdReclaimEscrow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "reclaim-escrow <denom> <to>",
+		Aliases: []string{"re"},
+		Args:    cobra.ExactArgs(2),
+		Short:   "Send the coins held in a cancelled marker's escrow to an address",
+		Long: strings.TrimSpace(`Send the coins held in a cancelled marker's escrow to an address.
+All coins other than the marker's own coin are sent, so that the marker can then be deleted.
+`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker reclaim-escrow hotdogcoin pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgReclaimEscrowRequest(strings.TrimSpace(args[0]), strings.TrimSpace(args[1]), "")
+
+			authSetter := func(authority string) {
+				msg.Authority = authority
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, cmd.Flags(), authSetter, msg)
+		},
+	}
+
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
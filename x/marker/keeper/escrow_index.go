@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// IsEscrowHolder returns true if the marker is indexed as holding the denom in escrow.
+func (k Keeper) IsEscrowHolder(ctx sdk.Context, denom string, markerAddr sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.EscrowHolderIndexKey(denom, markerAddr))
+}
+
+// GetEscrowHolders returns the addresses of all markers indexed as holding the denom in escrow.
+func (k Keeper) GetEscrowHolders(ctx sdk.Context, denom string) []sdk.AccAddress {
+	indexPrefix := types.EscrowHolderIndexPrefix(denom)
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), indexPrefix)
+	defer iterator.Close()
+
+	var addrs []sdk.AccAddress
+	for ; iterator.Valid(); iterator.Next() {
+		addrs = append(addrs, types.SplitMarkerSearchIndexKey(iterator.Key()[len(indexPrefix):]))
+	}
+	return addrs
+}
+
+// indexMarkerEscrow adds index entries for all of the denoms currently held in a marker's escrow.
+func (k Keeper) indexMarkerEscrow(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	for _, coin := range k.bankKeeper.GetAllBalances(ctx, markerAddr) {
+		store.Set(types.EscrowHolderIndexKey(coin.Denom, markerAddr), []byte{})
+	}
+}
+
+// updateEscrowHolderIndex updates the escrow holder index for a send that is about to happen. Markers receiving
+// coins are added to the index for those denoms, and markers sending away their entire balance of a denom are
+// removed from the index for that denom. It is called from the SendRestrictionFn, before the balances change.
+func (k Keeper) updateEscrowHolderIndex(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) {
+	if fromAddr.Equals(toAddr) {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(types.MarkerStoreKey(fromAddr)) {
+		for _, coin := range amt {
+			if k.bankKeeper.GetBalance(ctx, fromAddr, coin.Denom).Amount.LTE(coin.Amount) {
+				store.Delete(types.EscrowHolderIndexKey(coin.Denom, fromAddr))
+			}
+		}
+	}
+	if store.Has(types.MarkerStoreKey(toAddr)) {
+		for _, coin := range amt {
+			if coin.Amount.IsPositive() {
+				store.Set(types.EscrowHolderIndexKey(coin.Denom, toAddr), []byte{})
+			}
+		}
+	}
+}
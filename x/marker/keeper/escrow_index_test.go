@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestMultiWithdrawAndEscrowHolders(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	server := markerkeeper.NewMsgServerImpl(app.MarkerKeeper)
+
+	admin := sdk.AccAddress("admin_account_______")
+	other := sdk.AccAddress("other_account_______")
+	user := sdk.AccAddress("user_account________")
+
+	newMarker := func(denom string, supply int64) sdk.AccAddress {
+		mac := types.NewMarkerAccount(
+			authtypes.NewBaseAccount(types.MustGetMarkerAddress(denom), nil, 0, 0),
+			sdk.NewInt64Coin(denom, supply),
+			admin,
+			[]types.AccessGrant{{Address: admin.String(), Permissions: []types.Access{types.Access_Withdraw, types.Access_Admin}}},
+			types.StatusProposed,
+			types.MarkerType_Coin,
+			true,
+			false,
+			false,
+			[]string{},
+		)
+		require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, mac), "AddFinalizeAndActivateMarker(%s)", denom)
+		return mac.GetAddress()
+	}
+	assetAddr := newMarker("asset", 1000)
+	vault1Addr := newMarker("vault1", 1)
+	vault2Addr := newMarker("vault2", 1)
+
+	asset := func(amt int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin("asset", amt))
+	}
+	holders := func() map[string]string {
+		resp, err := app.MarkerKeeper.EscrowHolders(sdk.WrapSDKContext(ctx), &types.QueryEscrowHoldersRequest{Denom: "asset"})
+		require.NoError(t, err, "EscrowHolders")
+		rv := make(map[string]string)
+		for _, h := range resp.Holders {
+			rv[h.MarkerDenom] = h.Amount.String()
+		}
+		return rv
+	}
+
+	assert.Equal(t, map[string]string{"asset": "1000asset"}, holders(), "holders after marker creation")
+
+	// A failed multi-withdraw does not pay any of the recipients.
+	cacheCtx, _ := ctx.CacheContext()
+	_, err := server.MultiWithdraw(sdk.WrapSDKContext(cacheCtx), types.NewMsgMultiWithdrawRequest("asset", admin.String(),
+		types.NewWithdrawRecipient(vault1Addr.String(), asset(500)),
+		types.NewWithdrawRecipient(vault2Addr.String(), asset(600)),
+	))
+	assert.ErrorContains(t, err, "withdraw 1 to "+vault2Addr.String()+" failed: spendable balance 500asset is smaller than 600asset", "MultiWithdraw more than the escrow")
+
+	_, err = server.MultiWithdraw(sdk.WrapSDKContext(ctx), types.NewMsgMultiWithdrawRequest("asset", other.String(),
+		types.NewWithdrawRecipient(vault1Addr.String(), asset(500)),
+	))
+	assert.ErrorContains(t, err, other.String()+" does not have ACCESS_WITHDRAW on asset markeraccount", "MultiWithdraw without withdraw access")
+
+	_, err = server.MultiWithdraw(sdk.WrapSDKContext(ctx), types.NewMsgMultiWithdrawRequest("asset", admin.String(),
+		types.NewWithdrawRecipient(vault1Addr.String(), asset(300)),
+		types.NewWithdrawRecipient(vault2Addr.String(), asset(200)),
+		types.NewWithdrawRecipient(user.String(), asset(100)),
+	))
+	require.NoError(t, err, "MultiWithdraw")
+	assert.Equal(t, asset(100), app.BankKeeper.GetAllBalances(ctx, user), "user balance")
+	assert.Equal(t, map[string]string{"asset": "400asset", "vault1": "300asset", "vault2": "200asset"}, holders(), "holders after MultiWithdraw")
+	assert.ElementsMatch(t, []sdk.AccAddress{assetAddr, vault1Addr, vault2Addr}, app.MarkerKeeper.GetEscrowHolders(ctx, "asset"), "GetEscrowHolders")
+
+	// Withdrawing the entire balance removes the marker from the index, sending to a marker adds it back.
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, admin, user, "vault1", asset(300)), "WithdrawCoins all from vault1")
+	assert.False(t, app.MarkerKeeper.IsEscrowHolder(ctx, "asset", vault1Addr), "vault1 is escrow holder after withdrawing all")
+	assert.Equal(t, map[string]string{"asset": "400asset", "vault2": "200asset"}, holders(), "holders after emptying vault1")
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, user, vault1Addr, asset(50)), "SendCoins to vault1")
+	assert.True(t, app.MarkerKeeper.IsEscrowHolder(ctx, "asset", vault1Addr), "vault1 is escrow holder after send")
+
+	// The index can be rebuilt from the balances.
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	for _, addr := range []sdk.AccAddress{assetAddr, vault1Addr, vault2Addr} {
+		store.Delete(types.EscrowHolderIndexKey("asset", addr))
+	}
+	assert.Empty(t, app.MarkerKeeper.GetEscrowHolders(ctx, "asset"), "GetEscrowHolders after clearing the index")
+	require.NoError(t, markerkeeper.NewMigrator(app.MarkerKeeper).Migrate4to5(ctx), "Migrate4to5")
+	assert.Equal(t, map[string]string{"asset": "400asset", "vault1": "50asset", "vault2": "200asset"}, holders(), "holders after Migrate4to5")
+
+	_, err = app.MarkerKeeper.EscrowHolders(sdk.WrapSDKContext(ctx), &types.QueryEscrowHoldersRequest{Denom: ""})
+	assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid denom: ", "EscrowHolders without a denom")
+}
@@ -22,6 +22,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 		if m, ok := acc[i].(types.MarkerAccountI); ok {
 			if err := m.Validate(); err == nil {
 				store.Set(types.MarkerStoreKey(m.GetAddress()), m.GetAddress())
+				k.setMarkerSearchIndexes(ctx, m)
+				k.indexMarker(ctx, m)
+				k.indexMarkerEscrow(ctx, m.GetAddress())
 			}
 		}
 	}
@@ -43,6 +46,33 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 			k.SetMarker(ctx, &data.Markers[i])
 		}
 	}
+
+	for _, limit := range data.TransferLimits {
+		k.SetTransferLimit(ctx, limit)
+	}
+
+	for _, entry := range data.SupplyHistory {
+		k.setSupplyHistoryEntry(ctx, entry)
+	}
+
+	for _, navs := range data.NetAssetValues {
+		markerAddr := sdk.MustAccAddressFromBech32(navs.Address)
+		for _, nav := range navs.NetAssetValues {
+			k.setNetAssetValue(ctx, markerAddr, nav)
+		}
+	}
+
+	for _, policy := range data.IbcPolicies {
+		k.SetIbcPolicy(ctx, policy)
+	}
+
+	for _, fee := range data.TransferFees {
+		k.SetTransferFee(ctx, fee)
+	}
+
+	for _, reinstatement := range data.PendingReinstatements {
+		k.SetPendingReinstatement(ctx, reinstatement)
+	}
 }
 
 // ExportGenesis exports the current keeper state of the marker module.ExportGenesis
@@ -63,6 +93,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 			Status:                 marker.GetStatus(),
 			Denom:                  marker.GetDenom(),
 			Supply:                 marker.GetSupply().Amount,
+			MaxSupply:              marker.GetMaxSupply(),
 			MarkerType:             marker.GetMarkerType(),
 			SupplyFixed:            marker.HasFixedSupply(),
 			AllowGovernanceControl: marker.HasGovernanceEnabled(),
@@ -73,5 +104,36 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 	}
 
 	k.IterateMarkers(ctx, appendToMarkers)
-	return types.NewGenesisState(params, markers)
+
+	genState := types.NewGenesisState(params, markers)
+	k.IterateTransferLimits(ctx, func(limit types.TransferLimit) bool {
+		genState.TransferLimits = append(genState.TransferLimits, limit)
+		return false
+	})
+	k.IterateSupplyHistory(ctx, func(entry types.SupplyHistoryEntry) bool {
+		genState.SupplyHistory = append(genState.SupplyHistory, entry)
+		return false
+	})
+	k.IterateNetAssetValues(ctx, func(markerAddr sdk.AccAddress, nav types.NetAssetValue) bool {
+		last := len(genState.NetAssetValues) - 1
+		if last < 0 || genState.NetAssetValues[last].Address != markerAddr.String() {
+			genState.NetAssetValues = append(genState.NetAssetValues, types.MarkerNetAssetValues{Address: markerAddr.String()})
+			last++
+		}
+		genState.NetAssetValues[last].NetAssetValues = append(genState.NetAssetValues[last].NetAssetValues, nav)
+		return false
+	})
+	k.IterateIbcPolicies(ctx, func(policy types.IbcPolicy) bool {
+		genState.IbcPolicies = append(genState.IbcPolicies, policy)
+		return false
+	})
+	k.IterateTransferFees(ctx, func(fee types.TransferFee) bool {
+		genState.TransferFees = append(genState.TransferFees, fee)
+		return false
+	})
+	k.IteratePendingReinstatements(ctx, func(reinstatement types.PendingReinstatement) bool {
+		genState.PendingReinstatements = append(genState.PendingReinstatements, reinstatement)
+		return false
+	})
+	return genState
 }
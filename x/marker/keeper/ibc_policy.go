@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// GetIbcPolicy returns the IBC policy configured on a marker and whether one was found.
+func (k Keeper) GetIbcPolicy(ctx sdk.Context, markerAddr sdk.AccAddress) (types.IbcPolicy, bool) {
+	var policy types.IbcPolicy
+	bz := ctx.KVStore(k.storeKey).Get(types.IbcPolicyKey(markerAddr))
+	if len(bz) == 0 {
+		return policy, false
+	}
+	k.cdc.MustUnmarshal(bz, &policy)
+	return policy, true
+}
+
+// SetIbcPolicy stores the IBC policy of a marker. If the policy does not have any rules, the IBC policy
+// is removed from the marker instead.
+func (k Keeper) SetIbcPolicy(ctx sdk.Context, policy types.IbcPolicy) {
+	markerAddr := types.MustGetMarkerAddress(policy.Denom)
+	if !policy.HasRules() {
+		k.RemoveIbcPolicy(ctx, markerAddr)
+		return
+	}
+	ctx.KVStore(k.storeKey).Set(types.IbcPolicyKey(markerAddr), k.cdc.MustMarshal(&policy))
+}
+
+// RemoveIbcPolicy removes the IBC policy of a marker.
+func (k Keeper) RemoveIbcPolicy(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.IbcPolicyKey(markerAddr))
+}
+
+// IterateIbcPolicies iterates over all the IBC policies configured on markers.
+func (k Keeper) IterateIbcPolicies(ctx sdk.Context, cb func(policy types.IbcPolicy) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.IbcPolicyKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var policy types.IbcPolicy
+		k.cdc.MustUnmarshal(iterator.Value(), &policy)
+		if cb(policy) {
+			break
+		}
+	}
+}
+
+// ValidateIbcSend returns an error if the denom is not allowed to be sent over the source channel to the receiver
+// on the counterparty chain. Only restricted markers are checked; any other denom is allowed.
+func (k Keeper) ValidateIbcSend(ctx sdk.Context, denom, sourceChannel, receiver string) error {
+	marker := k.getRestrictedMarker(ctx, denom)
+	if marker == nil {
+		return nil
+	}
+	policy, found := k.GetIbcPolicy(ctx, marker.GetAddress())
+	if !found {
+		return nil
+	}
+	if !policy.AllowsChannel(sourceChannel) {
+		return fmt.Errorf("%s cannot be sent over channel %s", denom, sourceChannel)
+	}
+	if !policy.AllowsDestination(receiver) {
+		return fmt.Errorf("%s cannot be sent to %s", denom, receiver)
+	}
+	return nil
+}
+
+// ValidateIbcReceive returns an error if the denom is not allowed to be received on this chain through the
+// destination channel to the receiver. The denom is either native coin returning to this chain or an IBC voucher. The receiver must meet the marker's required attributes unless it has
+// transfer access or is a required attribute bypass account. Only restricted markers are checked.
+func (k Keeper) ValidateIbcReceive(ctx sdk.Context, denom, destChannel, receiver string) error {
+	marker := k.getRestrictedMarker(ctx, denom)
+	if marker == nil {
+		return nil
+	}
+	if policy, found := k.GetIbcPolicy(ctx, marker.GetAddress()); found && !policy.AllowsChannel(destChannel) {
+		return fmt.Errorf("%s cannot be received through channel %s", denom, destChannel)
+	}
+
+	toAddr, err := sdk.AccAddressFromBech32(receiver)
+	if err != nil {
+		return fmt.Errorf("invalid receiver address %q: %w", receiver, err)
+	}
+	reqAttr := marker.GetRequiredAttributes()
+	if len(reqAttr) == 0 || marker.AddressHasAccess(toAddr, types.Access_Transfer) || k.IsReqAttrBypassAddr(toAddr) {
+		return nil
+	}
+	return k.validateRequiredAttributes(ctx, denom, reqAttr, toAddr)
+}
+
+// getRestrictedMarker returns the restricted marker for the denom, or nil if the denom is not a restricted marker.
+func (k Keeper) getRestrictedMarker(ctx sdk.Context, denom string) types.MarkerAccountI {
+	markerAddr, err := types.MarkerAddress(denom)
+	if err != nil {
+		return nil
+	}
+	marker, err := k.GetMarker(ctx, markerAddr)
+	if err != nil || marker == nil || marker.GetMarkerType() != types.MarkerType_RestrictedCoin {
+		return nil
+	}
+	return marker
+}
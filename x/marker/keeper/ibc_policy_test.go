@@ -0,0 +1,236 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	attrTypes "github.com/provenance-io/provenance/x/attribute/types"
+	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestSetIbcPolicy(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	server := markerkeeper.NewMsgServerImpl(app.MarkerKeeper)
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	authUser := testUserAddress("test")
+	notAuthUser := testUserAddress("test1").String()
+
+	notRestrictedMarker := types.NewEmptyMarkerAccount(
+		"not-restricted-marker",
+		authUser.String(),
+		[]types.AccessGrant{})
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, notRestrictedMarker))
+
+	rMarkerDenom := "restricted-marker"
+	rMarkerAcct := authtypes.NewBaseAccount(types.MustGetMarkerAddress(rMarkerDenom), nil, 0, 0)
+	app.MarkerKeeper.SetMarker(ctx, types.NewMarkerAccount(rMarkerAcct, sdk.NewInt64Coin(rMarkerDenom, 1000), authUser, []types.AccessGrant{{Address: authUser.String(), Permissions: []types.Access{types.Access_Transfer}}}, types.StatusFinalized, types.MarkerType_RestrictedCoin, true, false, false, []string{}))
+
+	rMarkerGovDenom := "restricted-marker-gov"
+	rMarkerGovAcct := authtypes.NewBaseAccount(types.MustGetMarkerAddress(rMarkerGovDenom), nil, 0, 0)
+	app.MarkerKeeper.SetMarker(ctx, types.NewMarkerAccount(rMarkerGovAcct, sdk.NewInt64Coin(rMarkerGovDenom, 1000), authUser, []types.AccessGrant{{Address: authUser.String(), Permissions: []types.Access{}}}, types.StatusFinalized, types.MarkerType_RestrictedCoin, true, true, false, []string{}))
+
+	channels := []string{"channel-0", "channel-3"}
+	dests := []string{"cosmos1receiver"}
+
+	testCases := []struct {
+		name      string
+		msg       *types.MsgSetIbcPolicyRequest
+		expErr    string
+		expPolicy *types.IbcPolicy
+	}{
+		{
+			name:   "should fail, cannot find marker",
+			msg:    types.NewMsgSetIbcPolicyRequest("blah", channels, nil, authUser.String()),
+			expErr: "marker not found for blah: marker blah not found for address: cosmos1psw3a97ywtr595qa4295lw07cz9665hynnfpee",
+		},
+		{
+			name:   "should fail, not a restricted marker",
+			msg:    types.NewMsgSetIbcPolicyRequest(notRestrictedMarker.Denom, channels, nil, authUser.String()),
+			expErr: "marker not-restricted-marker is not a restricted marker",
+		},
+		{
+			name:   "should fail, signer does not have transfer access",
+			msg:    types.NewMsgSetIbcPolicyRequest(rMarkerDenom, channels, nil, notAuthUser),
+			expErr: notAuthUser + " does not have transfer authority for restricted-marker marker",
+		},
+		{
+			name:   "should fail, gov not enabled for restricted marker",
+			msg:    types.NewMsgSetIbcPolicyRequest(rMarkerDenom, channels, nil, authority),
+			expErr: "restricted-marker marker does not allow governance control",
+		},
+		{
+			name:   "should fail, no ibc policy to remove",
+			msg:    types.NewMsgSetIbcPolicyRequest(rMarkerDenom, nil, nil, authUser.String()),
+			expErr: "restricted-marker marker does not have an ibc policy to remove",
+		},
+		{
+			name:      "should succeed to set ibc policy",
+			msg:       types.NewMsgSetIbcPolicyRequest(rMarkerDenom, channels, nil, authUser.String()),
+			expPolicy: &types.IbcPolicy{Denom: rMarkerDenom, AllowedChannels: channels},
+		},
+		{
+			name:      "should succeed to update ibc policy",
+			msg:       types.NewMsgSetIbcPolicyRequest(rMarkerDenom, nil, dests, authUser.String()),
+			expPolicy: &types.IbcPolicy{Denom: rMarkerDenom, AllowedDestinations: dests},
+		},
+		{
+			name: "should succeed to remove ibc policy",
+			msg:  types.NewMsgSetIbcPolicyRequest(rMarkerDenom, nil, nil, authUser.String()),
+		},
+		{
+			name:      "should succeed gov allowed for marker",
+			msg:       types.NewMsgSetIbcPolicyRequest(rMarkerGovDenom, channels, dests, authority),
+			expPolicy: &types.IbcPolicy{Denom: rMarkerGovDenom, AllowedChannels: channels, AllowedDestinations: dests},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := server.SetIbcPolicy(sdk.WrapSDKContext(ctx), tc.msg)
+
+			if len(tc.expErr) > 0 {
+				assert.Nil(t, res)
+				assert.EqualError(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, &types.MsgSetIbcPolicyResponse{}, res)
+			policy, found := app.MarkerKeeper.GetIbcPolicy(ctx, types.MustGetMarkerAddress(tc.msg.Denom))
+			if tc.expPolicy == nil {
+				assert.False(t, found, "ibc policy should not be found")
+			} else {
+				assert.True(t, found, "ibc policy should be found")
+				assert.Equal(t, *tc.expPolicy, policy, "ibc policy")
+			}
+		})
+	}
+}
+
+func TestValidateIbcSendAndReceive(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	owner := sdk.AccAddress("owner_address_______")
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, owner))
+	require.NoError(t, app.NameKeeper.SetNameRecord(ctx, "kyc.provenance.io", owner, false), "SetNameRecord kyc.provenance.io")
+
+	addrWithAttrs := sdk.AccAddress("addr_with_attributes")
+	require.NoError(t, app.AttributeKeeper.SetAttribute(ctx,
+		attrTypes.Attribute{
+			Name:          "kyc.provenance.io",
+			Value:         []byte("string value"),
+			Address:       addrWithAttrs.String(),
+			AttributeType: attrTypes.AttributeType_String,
+		},
+		owner,
+	), "SetAttribute kyc.provenance.io")
+	addrWithoutAttrs := sdk.AccAddress("addr_without_attribs")
+	addrWithTransfer := sdk.AccAddress("addr_with_transfer__")
+
+	newMarker := func(denom string, markerType types.MarkerType, reqAttrs ...string) {
+		var grants []types.AccessGrant
+		if markerType == types.MarkerType_RestrictedCoin {
+			grants = append(grants, types.AccessGrant{Address: addrWithTransfer.String(), Permissions: []types.Access{types.Access_Transfer}})
+		}
+		acct := authtypes.NewBaseAccount(types.MustGetMarkerAddress(denom), nil, 0, 0)
+		app.MarkerKeeper.SetMarker(ctx, types.NewMarkerAccount(acct, sdk.NewInt64Coin(denom, 1000), owner,
+			grants, types.StatusActive, markerType, true, false, false, reqAttrs))
+	}
+	newMarker("coinpolicy", types.MarkerType_Coin)
+	newMarker("nopolicy", types.MarkerType_RestrictedCoin, "kyc.provenance.io")
+	newMarker("policy", types.MarkerType_RestrictedCoin, "kyc.provenance.io")
+	newMarker("noattrs", types.MarkerType_RestrictedCoin)
+	app.MarkerKeeper.SetIbcPolicy(ctx, types.NewIbcPolicy("policy", []string{"channel-1"}, []string{"cosmos1allowed"}))
+	app.MarkerKeeper.SetIbcPolicy(ctx, types.NewIbcPolicy("noattrs", []string{"channel-1"}, nil))
+	// A policy on a coin marker (e.g. from before a type change) is not enforced.
+	app.MarkerKeeper.SetIbcPolicy(ctx, types.NewIbcPolicy("coinpolicy", []string{"channel-1"}, nil))
+
+	t.Run("send", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			denom    string
+			channel  string
+			receiver string
+			expErr   string
+		}{
+			{name: "not a marker", denom: "transfer/channel-9/uatom", channel: "channel-0", receiver: "cosmos1other"},
+			{name: "coin marker", denom: "coinpolicy", channel: "channel-0", receiver: "cosmos1other"},
+			{name: "restricted marker without policy", denom: "nopolicy", channel: "channel-0", receiver: "cosmos1other"},
+			{name: "allowed channel and destination", denom: "policy", channel: "channel-1", receiver: "cosmos1allowed"},
+			{
+				name: "channel not allowed", denom: "policy", channel: "channel-0", receiver: "cosmos1allowed",
+				expErr: "policy cannot be sent over channel channel-0",
+			},
+			{
+				name: "destination not allowed", denom: "policy", channel: "channel-1", receiver: "cosmos1other",
+				expErr: "policy cannot be sent to cosmos1other",
+			},
+			{name: "any destination allowed", denom: "noattrs", channel: "channel-1", receiver: "cosmos1other"},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				err := app.MarkerKeeper.ValidateIbcSend(ctx, tc.denom, tc.channel, tc.receiver)
+				if len(tc.expErr) > 0 {
+					assert.EqualError(t, err, tc.expErr, "ValidateIbcSend")
+				} else {
+					assert.NoError(t, err, "ValidateIbcSend")
+				}
+			})
+		}
+	})
+
+	t.Run("receive", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			denom    string
+			channel  string
+			receiver string
+			expErr   string
+		}{
+			{name: "coin marker", denom: "coinpolicy", channel: "channel-0", receiver: addrWithoutAttrs.String()},
+			{name: "receiver with required attributes", denom: "nopolicy", channel: "channel-0", receiver: addrWithAttrs.String()},
+			{
+				name: "receiver without required attributes", denom: "nopolicy", channel: "channel-0", receiver: addrWithoutAttrs.String(),
+				expErr: fmt.Sprintf("address %s does not contain the \"nopolicy\" required attribute: \"kyc.provenance.io\"", addrWithoutAttrs),
+			},
+			{name: "receiver with transfer access", denom: "nopolicy", channel: "channel-0", receiver: addrWithTransfer.String()},
+			{
+				name: "channel not allowed", denom: "policy", channel: "channel-0", receiver: addrWithAttrs.String(),
+				expErr: "policy cannot be received through channel channel-0",
+			},
+			{name: "allowed channel", denom: "policy", channel: "channel-1", receiver: addrWithAttrs.String()},
+			{name: "marker without required attributes", denom: "noattrs", channel: "channel-1", receiver: addrWithoutAttrs.String()},
+			{
+				name: "invalid receiver", denom: "noattrs", channel: "channel-1", receiver: "cosmos1other",
+				expErr: "invalid receiver address \"cosmos1other\": decoding bech32 failed: invalid separator index 6",
+			},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				err := app.MarkerKeeper.ValidateIbcReceive(ctx, tc.denom, tc.channel, tc.receiver)
+				if len(tc.expErr) > 0 {
+					assert.EqualError(t, err, tc.expErr, "ValidateIbcReceive")
+				} else {
+					assert.NoError(t, err, "ValidateIbcReceive")
+				}
+			})
+		}
+	})
+}
@@ -154,24 +154,44 @@ func (k Keeper) GetMarker(ctx sdk.Context, address sdk.AccAddress) (types.Marker
 }
 
 // SetMarker sets a marker in the auth account store will panic if the marker account is not valid or
-// if the auth module account keeper fails to marshall the account.
+// if the auth module account keeper fails to marshall the account. The marker search indexes are updated, and
+// markers that need to be checked by the BeginBlocker (active with a fixed supply, or destroyed) are added to
+// the corresponding index. New markers have any coins already held at their address added to the escrow holder index.
 func (k Keeper) SetMarker(ctx sdk.Context, marker types.MarkerAccountI) {
 	store := ctx.KVStore(k.storeKey)
 
 	if err := marker.Validate(); err != nil {
 		panic(err)
 	}
+	var previous types.MarkerAccountI
+	if existing, ok := k.authKeeper.GetAccount(ctx, marker.GetAddress()).(types.MarkerAccountI); ok {
+		previous = existing
+	}
 	k.authKeeper.SetAccount(ctx, marker)
 	store.Set(types.MarkerStoreKey(marker.GetAddress()), marker.GetAddress())
+	k.updateMarkerSearchIndexes(ctx, previous, marker)
+	k.indexMarker(ctx, marker)
+	if previous == nil {
+		k.indexMarkerEscrow(ctx, marker.GetAddress())
+	}
 }
 
 // RemoveMarker removes a marker from the auth account store. Note: if the account holds coins this will
 // likely cause an invariant constraint violation for the coin supply
 func (k Keeper) RemoveMarker(ctx sdk.Context, marker types.MarkerAccountI) {
 	store := ctx.KVStore(k.storeKey)
+	if existing, ok := k.authKeeper.GetAccount(ctx, marker.GetAddress()).(types.MarkerAccountI); ok {
+		k.removeMarkerSearchIndexes(ctx, existing)
+	}
+	for _, coin := range k.bankKeeper.GetAllBalances(ctx, marker.GetAddress()) {
+		store.Delete(types.EscrowHolderIndexKey(coin.Denom, marker.GetAddress()))
+	}
 	k.authKeeper.RemoveAccount(ctx, marker)
 
 	store.Delete(types.MarkerStoreKey(marker.GetAddress()))
+	store.Delete(types.DirtyMarkerKey(marker.GetAddress()))
+	store.Delete(types.PendingDestroyKey(marker.GetAddress()))
+	k.RemovePendingReinstatement(ctx, marker.GetAddress())
 }
 
 // IterateMarkers  iterates all markers with the given handler function.
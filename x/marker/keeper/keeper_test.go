@@ -1165,6 +1165,74 @@ func TestMsgSupplyIncreaseProposalRequest(t *testing.T) {
 	}
 }
 
+func TestGovProposalMsgs(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	goCtx := sdk.WrapSDKContext(ctx)
+	server := markerkeeper.NewMsgServerImpl(app.MarkerKeeper)
+
+	authority := app.MarkerKeeper.GetAuthority()
+	user := testUserAddress("test")
+	admin := testUserAddress("admin")
+	denom := "govcoin"
+
+	govMarker := types.NewEmptyMarkerAccount(denom, user.String(), []types.AccessGrant{})
+	require.NoError(t, govMarker.SetSupply(sdk.NewInt64Coin(denom, 1000)), "SetSupply")
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, govMarker), "AddMarkerAccount")
+
+	_, err := server.ChangeStatusProposal(goCtx, types.NewMsgChangeStatusProposalRequest(denom, types.StatusActive, user.String()))
+	require.EqualError(t, err, fmt.Sprintf("expected %s got %s: expected gov account as only signer for proposal message", authority, user), "ChangeStatusProposal invalid authority")
+
+	_, err = server.ChangeStatusProposal(goCtx, types.NewMsgChangeStatusProposalRequest(denom, types.StatusActive, authority))
+	require.NoError(t, err, "ChangeStatusProposal")
+	m, err := app.MarkerKeeper.GetMarkerByDenom(ctx, denom)
+	require.NoError(t, err, "GetMarkerByDenom")
+	require.Equal(t, types.StatusActive, m.GetStatus(), "marker status")
+	require.Equal(t, sdk.NewInt(1000), app.BankKeeper.GetSupply(ctx, denom).Amount, "supply after activation")
+
+	_, err = server.SetAdministratorProposal(goCtx, types.NewMsgSetAdministratorProposalRequest(denom,
+		[]types.AccessGrant{*types.NewAccessGrant(admin, types.AccessList{types.Access_Mint, types.Access_Burn})}, authority))
+	require.NoError(t, err, "SetAdministratorProposal")
+	m, err = app.MarkerKeeper.GetMarkerByDenom(ctx, denom)
+	require.NoError(t, err, "GetMarkerByDenom")
+	require.True(t, m.AddressHasAccess(admin, types.Access_Mint), "admin should have mint access")
+
+	_, err = server.RemoveAdministratorProposal(goCtx, types.NewMsgRemoveAdministratorProposalRequest(denom, []string{admin.String()}, authority))
+	require.NoError(t, err, "RemoveAdministratorProposal")
+	m, err = app.MarkerKeeper.GetMarkerByDenom(ctx, denom)
+	require.NoError(t, err, "GetMarkerByDenom")
+	require.False(t, m.AddressHasAccess(admin, types.Access_Mint), "admin should not have mint access")
+
+	_, err = server.SupplyDecreaseProposal(goCtx, types.NewMsgSupplyDecreaseProposalRequest(sdk.NewInt64Coin(denom, 100), authority))
+	require.NoError(t, err, "SupplyDecreaseProposal")
+	require.Equal(t, sdk.NewInt(900), app.BankKeeper.GetSupply(ctx, denom).Amount, "supply after decrease")
+
+	_, err = server.WithdrawEscrowProposal(goCtx, types.NewMsgWithdrawEscrowProposalRequest(denom, sdk.NewCoins(sdk.NewInt64Coin(denom, 50)), user.String(), authority))
+	require.NoError(t, err, "WithdrawEscrowProposal")
+	require.Equal(t, sdk.NewInt(50), app.BankKeeper.GetBalance(ctx, user, denom).Amount, "user balance after withdraw")
+
+	metadata := banktypes.Metadata{
+		Description: "the gov coin",
+		DenomUnits:  []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+		Base:        denom,
+		Display:     denom,
+		Name:        "Gov Coin",
+		Symbol:      "GOV",
+	}
+	_, err = server.SetDenomMetadataProposal(goCtx, types.NewMsgSetDenomMetadataProposalRequest(metadata, authority))
+	require.NoError(t, err, "SetDenomMetadataProposal")
+	actual, found := app.BankKeeper.GetDenomMetaData(ctx, denom)
+	require.True(t, found, "denom metadata should be found")
+	require.Equal(t, metadata.Description, actual.Description, "denom metadata description")
+
+	// Markers that do not allow governance control cannot be changed through these messages.
+	noGov := types.NewEmptyMarkerAccount("nogovcoin", user.String(), []types.AccessGrant{})
+	noGov.AllowGovernanceControl = false
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, noGov), "AddMarkerAccount nogov")
+	_, err = server.ChangeStatusProposal(goCtx, types.NewMsgChangeStatusProposalRequest("nogovcoin", types.StatusFinalized, authority))
+	require.EqualError(t, err, "nogovcoin marker does not allow governance control", "ChangeStatusProposal no gov")
+}
+
 func TestMsgUpdateRequiredAttributesRequest(t *testing.T) {
 	app := simapp.Setup(t)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
@@ -1735,3 +1803,428 @@ func TestBypassAddrsLocked(t *testing.T) {
 	act00 := kAddrs[0][0]
 	assert.Equal(t, orig00, act00, "first byte of first address returned by GetReqAttrBypassAddrs")
 }
+
+func TestChangeMarkerType(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	server := markerkeeper.NewMsgServerImpl(app.MarkerKeeper)
+	authority := app.MarkerKeeper.GetAuthority()
+	admin := sdk.AccAddress("adminAddr___________")
+	holder := sdk.AccAddress("holderAddr__________")
+	other := sdk.AccAddress("otherAddr___________")
+
+	newMarker := func(denom string, markerType types.MarkerType, status types.MarkerStatus, allowGov bool, perms ...types.Access) *types.MarkerAccount {
+		mac := types.NewMarkerAccount(
+			authtypes.NewBaseAccountWithAddress(types.MustGetMarkerAddress(denom)),
+			sdk.NewInt64Coin(denom, 1000), admin,
+			[]types.AccessGrant{*types.NewAccessGrant(admin, append(types.AccessList{types.Access_Admin, types.Access_Mint}, perms...))},
+			status, markerType, true, allowGov, false, []string{},
+		)
+		app.MarkerKeeper.SetMarker(ctx, mac)
+		return mac
+	}
+
+	coinMarker := newMarker("changecoin", types.MarkerType_Coin, types.StatusActive, false)
+	govMarker := newMarker("changegov", types.MarkerType_RestrictedCoin, types.StatusActive, true)
+	noGovMarker := newMarker("changenogov", types.MarkerType_RestrictedCoin, types.StatusActive, false)
+	proposedMarker := newMarker("changeproposed", types.MarkerType_Coin, types.StatusProposed, true)
+	transferMarker := newMarker("changetransfer", types.MarkerType_RestrictedCoin, types.StatusActive, true, types.Access_Transfer)
+	forcedMarker := newMarker("changeforced", types.MarkerType_RestrictedCoin, types.StatusActive, true)
+	forcedMarker.AllowForcedTransfer = true
+	app.MarkerKeeper.SetMarker(ctx, forcedMarker)
+	reqAttrMarker := newMarker("changereqattr", types.MarkerType_RestrictedCoin, types.StatusActive, true)
+	reqAttrMarker.RequiredAttributes = []string{"kyc.provenance.io"}
+	app.MarkerKeeper.SetMarker(ctx, reqAttrMarker)
+	limitMarker := newMarker("changelimit", types.MarkerType_RestrictedCoin, types.StatusActive, true)
+	app.MarkerKeeper.SetTransferLimit(ctx, types.NewTransferLimit(limitMarker.Denom, time.Hour, sdk.NewInt(100), sdk.NewInt(10)))
+	admin2 := sdk.AccAddress("admin2Addr__________")
+	admin3 := sdk.AccAddress("admin3Addr__________")
+	multiMarker := newMarker("changemulti", types.MarkerType_Coin, types.StatusActive, true)
+	multiMarker.AccessControl = append(multiMarker.AccessControl,
+		*types.NewAccessGrant(admin2, types.AccessList{types.Access_Admin}),
+		*types.NewAccessGrant(admin3, types.AccessList{types.Access_Admin}),
+	)
+	app.MarkerKeeper.SetMarker(ctx, multiMarker)
+
+	tests := []struct {
+		name    string
+		msg     *types.MsgChangeMarkerTypeRequest
+		expErr  string
+		expFrom types.MarkerType
+	}{
+		{
+			name:   "unknown marker",
+			msg:    types.NewMsgChangeMarkerTypeRequest("unknowncoin", types.MarkerType_RestrictedCoin, admin.String()),
+			expErr: "could not get unknowncoin marker",
+		},
+		{
+			name:   "signer without admin access",
+			msg:    types.NewMsgChangeMarkerTypeRequest(coinMarker.Denom, types.MarkerType_RestrictedCoin, other.String()),
+			expErr: other.String() + " does not have admin access for changecoin marker",
+		},
+		{
+			name:   "authority with an admin",
+			msg:    types.NewMsgChangeMarkerTypeRequest(govMarker.Denom, types.MarkerType_Coin, authority, admin.String()),
+			expErr: authority + " does not have admin access for changegov marker",
+		},
+		{
+			name:   "minority of admins",
+			msg:    types.NewMsgChangeMarkerTypeRequest(multiMarker.Denom, types.MarkerType_RestrictedCoin, admin.String()),
+			expErr: "1 of 3 changemulti marker admins signed, a majority is required",
+		},
+		{
+			name:   "authority without governance control",
+			msg:    types.NewMsgChangeMarkerTypeRequest(noGovMarker.Denom, types.MarkerType_Coin, authority),
+			expErr: "changenogov marker does not allow governance control",
+		},
+		{
+			name:   "marker not active",
+			msg:    types.NewMsgChangeMarkerTypeRequest(proposedMarker.Denom, types.MarkerType_RestrictedCoin, authority),
+			expErr: "cannot change the type of changeproposed marker with status proposed",
+		},
+		{
+			name:   "same type",
+			msg:    types.NewMsgChangeMarkerTypeRequest(coinMarker.Denom, types.MarkerType_Coin, admin.String()),
+			expErr: "marker changecoin is already of type MARKER_TYPE_COIN",
+		},
+		{
+			name:   "forced transfers allowed",
+			msg:    types.NewMsgChangeMarkerTypeRequest(forcedMarker.Denom, types.MarkerType_Coin, authority),
+			expErr: "cannot change changeforced marker to MARKER_TYPE_COIN while forced transfers are allowed",
+		},
+		{
+			name:   "required attributes",
+			msg:    types.NewMsgChangeMarkerTypeRequest(reqAttrMarker.Denom, types.MarkerType_Coin, authority),
+			expErr: "cannot change changereqattr marker to MARKER_TYPE_COIN while it has required attributes",
+		},
+		{
+			name:   "transfer limits",
+			msg:    types.NewMsgChangeMarkerTypeRequest(limitMarker.Denom, types.MarkerType_Coin, authority),
+			expErr: "cannot change changelimit marker to MARKER_TYPE_COIN while it has transfer limits",
+		},
+		{
+			name:   "transfer access granted",
+			msg:    types.NewMsgChangeMarkerTypeRequest(transferMarker.Denom, types.MarkerType_Coin, admin.String()),
+			expErr: "cannot change changetransfer marker to MARKER_TYPE_COIN: ACCESS_TRANSFER is not supported for marker type MARKER_TYPE_COIN",
+		},
+		{
+			name:    "admin changes coin to restricted",
+			msg:     types.NewMsgChangeMarkerTypeRequest(coinMarker.Denom, types.MarkerType_RestrictedCoin, admin.String()),
+			expFrom: types.MarkerType_Coin,
+		},
+		{
+			name:    "majority of admins changes coin to restricted",
+			msg:     types.NewMsgChangeMarkerTypeRequest(multiMarker.Denom, types.MarkerType_RestrictedCoin, admin.String(), admin3.String()),
+			expFrom: types.MarkerType_Coin,
+		},
+		{
+			name:    "authority changes restricted to coin",
+			msg:     types.NewMsgChangeMarkerTypeRequest(govMarker.Denom, types.MarkerType_Coin, authority),
+			expFrom: types.MarkerType_RestrictedCoin,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			em := sdk.NewEventManager()
+			_, err := server.ChangeMarkerType(sdk.WrapSDKContext(ctx.WithEventManager(em)), tc.msg)
+			if len(tc.expErr) > 0 {
+				assert.ErrorContains(t, err, tc.expErr, "ChangeMarkerType error")
+				return
+			}
+			require.NoError(t, err, "ChangeMarkerType error")
+			marker, err := app.MarkerKeeper.GetMarkerByDenom(ctx, tc.msg.Denom)
+			require.NoError(t, err, "GetMarkerByDenom")
+			assert.Equal(t, tc.msg.MarkerType, marker.GetMarkerType(), "marker type")
+			expEvent, err := sdk.TypedEventToEvent(types.NewEventMarkerChangeType(tc.msg.Denom, tc.expFrom, tc.msg.MarkerType, tc.msg.Administrators))
+			require.NoError(t, err, "TypedEventToEvent")
+			assert.Contains(t, em.Events(), expEvent, "emitted events")
+		})
+	}
+
+	// The send restrictions apply as soon as a marker becomes restricted, and stop applying once it is unrestricted.
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(coinMarker.Denom, 10), sdk.NewInt64Coin(govMarker.Denom, 10))), "MintCoins")
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, holder, sdk.NewCoins(sdk.NewInt64Coin(coinMarker.Denom, 10), sdk.NewInt64Coin(govMarker.Denom, 10))), "SendCoinsFromModuleToAccount")
+	err := app.BankKeeper.SendCoins(ctx, holder, other, sdk.NewCoins(sdk.NewInt64Coin(coinMarker.Denom, 1)))
+	assert.ErrorContains(t, err, "does not have transfer permissions", "send of newly restricted coin")
+	err = app.BankKeeper.SendCoins(ctx, holder, other, sdk.NewCoins(sdk.NewInt64Coin(govMarker.Denom, 1)))
+	assert.NoError(t, err, "send of newly unrestricted coin")
+}
+
+func TestSetMaxSupply(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	server := markerkeeper.NewMsgServerImpl(app.MarkerKeeper)
+	authority := app.MarkerKeeper.GetAuthority()
+	admin1 := sdk.AccAddress("admin1Addr__________")
+	admin2 := sdk.AccAddress("admin2Addr__________")
+	admin3 := sdk.AccAddress("admin3Addr__________")
+	minter := sdk.AccAddress("minterAddr__________")
+
+	denom := "cappedcoin"
+	grants := []types.AccessGrant{
+		*types.NewAccessGrant(admin1, types.AccessList{types.Access_Admin}),
+		*types.NewAccessGrant(admin2, types.AccessList{types.Access_Admin}),
+		*types.NewAccessGrant(admin3, types.AccessList{types.Access_Admin}),
+		*types.NewAccessGrant(minter, types.AccessList{types.Access_Mint}),
+	}
+	mac := types.NewMarkerAccount(authtypes.NewBaseAccountWithAddress(types.MustGetMarkerAddress(denom)),
+		sdk.NewInt64Coin(denom, 1000), nil, grants, types.StatusActive, types.MarkerType_Coin, false, true, false, []string{})
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac), "AddMarkerAccount")
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, types.CoinPoolName, sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))), "MintCoins")
+
+	noGovDenom := "cappednogov"
+	noGovMac := types.NewMarkerAccount(authtypes.NewBaseAccountWithAddress(types.MustGetMarkerAddress(noGovDenom)),
+		sdk.NewInt64Coin(noGovDenom, 1000), nil, grants, types.StatusActive, types.MarkerType_Coin, false, false, false, []string{})
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, noGovMac), "AddMarkerAccount no gov")
+
+	tests := []struct {
+		name   string
+		msg    *types.MsgSetMaxSupplyRequest
+		expErr string
+		expMax int64
+	}{
+		{
+			name:   "unknown marker",
+			msg:    types.NewMsgSetMaxSupplyRequest("unknowncoin", sdk.NewInt(2000), admin1.String()),
+			expErr: "could not get unknowncoin marker",
+		},
+		{
+			name:   "signer without admin access",
+			msg:    types.NewMsgSetMaxSupplyRequest(denom, sdk.NewInt(2000), admin1.String(), minter.String()),
+			expErr: minter.String() + " does not have admin access for cappedcoin marker",
+		},
+		{
+			name:   "minority of admins",
+			msg:    types.NewMsgSetMaxSupplyRequest(denom, sdk.NewInt(2000), admin1.String()),
+			expErr: "1 of 3 cappedcoin marker admins signed, a majority is required",
+		},
+		{
+			name:   "authority without governance control",
+			msg:    types.NewMsgSetMaxSupplyRequest(noGovDenom, sdk.NewInt(2000), authority),
+			expErr: "cappednogov marker does not allow governance control",
+		},
+		{
+			name:   "below current supply",
+			msg:    types.NewMsgSetMaxSupplyRequest(denom, sdk.NewInt(999), admin1.String(), admin3.String()),
+			expErr: "max supply 999 cannot be less than cappedcoin marker supply 1000",
+		},
+		{
+			name:   "majority of admins",
+			msg:    types.NewMsgSetMaxSupplyRequest(denom, sdk.NewInt(1500), admin1.String(), admin3.String()),
+			expMax: 1500,
+		},
+		{
+			name:   "authority",
+			msg:    types.NewMsgSetMaxSupplyRequest(denom, sdk.NewInt(1200), authority),
+			expMax: 1200,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := server.SetMaxSupply(sdk.WrapSDKContext(ctx), tc.msg)
+			if len(tc.expErr) > 0 {
+				assert.ErrorContains(t, err, tc.expErr, "SetMaxSupply error")
+				return
+			}
+			require.NoError(t, err, "SetMaxSupply error")
+			marker, err := app.MarkerKeeper.GetMarkerByDenom(ctx, tc.msg.Denom)
+			require.NoError(t, err, "GetMarkerByDenom")
+			assert.Equal(t, sdk.NewInt(tc.expMax), marker.GetMaxSupply(), "max supply")
+		})
+	}
+
+	// Minting and supply increases cannot go beyond the cap.
+	require.NoError(t, app.MarkerKeeper.MintCoin(ctx, minter, sdk.NewInt64Coin(denom, 200)), "MintCoin up to max supply")
+	err := app.MarkerKeeper.MintCoin(ctx, minter, sdk.NewInt64Coin(denom, 1))
+	assert.EqualError(t, err, "requested supply 1201 exceeds cappedcoin marker max supply 1200", "MintCoin beyond max supply")
+	err = markerkeeper.HandleSupplyIncreaseProposal(ctx, app.MarkerKeeper, &types.SupplyIncreaseProposal{Amount: sdk.NewInt64Coin(denom, 1)})
+	assert.EqualError(t, err, "requested supply 1201 exceeds cappedcoin marker max supply 1200", "SupplyIncreaseProposal beyond max supply")
+
+	// A max supply of zero removes the cap.
+	_, err = server.SetMaxSupply(sdk.WrapSDKContext(ctx), types.NewMsgSetMaxSupplyRequest(denom, sdk.ZeroInt(), admin2.String(), admin3.String()))
+	require.NoError(t, err, "SetMaxSupply zero")
+	assert.NoError(t, app.MarkerKeeper.MintCoin(ctx, minter, sdk.NewInt64Coin(denom, 1)), "MintCoin without max supply")
+
+	// The max supply of markers that have not been activated yet limits their configured supply.
+	proposed := types.NewEmptyMarkerAccount("cappedproposed", admin1.String(), []types.AccessGrant{*types.NewAccessGrant(admin1, types.AccessList{types.Access_Admin, types.Access_Mint})})
+	require.NoError(t, proposed.SetMaxSupply(sdk.NewInt(100)), "SetMaxSupply proposed")
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, proposed), "AddMarkerAccount proposed")
+	require.NoError(t, app.MarkerKeeper.MintCoin(ctx, admin1, sdk.NewInt64Coin("cappedproposed", 100)), "MintCoin proposed up to max supply")
+	err = app.MarkerKeeper.MintCoin(ctx, admin1, sdk.NewInt64Coin("cappedproposed", 1))
+	assert.EqualError(t, err, "total supply 101 cannot exceed max supply 100", "MintCoin proposed beyond max supply")
+}
+
+func TestBatchTransferAndRecoverAccount(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := markerkeeper.NewMsgServerImpl(app.MarkerKeeper)
+
+	setAcc := func(addr sdk.AccAddress, sequence uint64) {
+		acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+		require.NoError(t, acc.SetSequence(sequence), "%s.SetSequence(%d)", string(addr), sequence)
+		app.AccountKeeper.SetAccount(ctx, acc)
+	}
+
+	admin := sdk.AccAddress("admin_account_______")
+	holder1 := sdk.AccAddress("holder_1____________")
+	holder2 := sdk.AccAddress("holder_2____________")
+	recipient := sdk.AccAddress("recipient___________")
+	seq0 := sdk.AccAddress("sequence_0__________")
+	setAcc(admin, 1)
+	setAcc(holder1, 1)
+	setAcc(holder2, 1)
+	setAcc(seq0, 0)
+
+	newMarker := func(denom string, allowForcedTransfer bool) {
+		mac := types.NewMarkerAccount(
+			authtypes.NewBaseAccount(types.MustGetMarkerAddress(denom), nil, 0, 0),
+			sdk.NewInt64Coin(denom, 1000),
+			admin,
+			[]types.AccessGrant{{
+				Address:     admin.String(),
+				Permissions: []types.Access{types.Access_Transfer, types.Access_Withdraw, types.Access_Admin},
+			}},
+			types.StatusProposed,
+			types.MarkerType_RestrictedCoin,
+			true,
+			true,
+			allowForcedTransfer,
+			[]string{},
+		)
+		require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, mac), "AddFinalizeAndActivateMarker(%s)", denom)
+		for _, addr := range []sdk.AccAddress{holder1, holder2, seq0} {
+			require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, admin, addr, denom, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))),
+				"WithdrawCoins(%s) to %s", denom, string(addr))
+		}
+	}
+	newMarker("forcecoin", true)
+	newMarker("noforcecoin", false)
+	newMarker("limitcoin", true)
+	app.MarkerKeeper.SetTransferLimit(ctx, types.NewTransferLimit("limitcoin", time.Hour, sdk.NewInt(20), sdk.NewInt(10)))
+
+	balance := func(addr sdk.AccAddress, denom string) int64 {
+		return app.BankKeeper.GetBalance(ctx, addr, denom).Amount.Int64()
+	}
+	entry := func(from, to sdk.AccAddress, amount int64) types.BatchTransferEntry {
+		return types.NewBatchTransferEntry(from.String(), to.String(), sdk.NewInt(amount))
+	}
+
+	t.Run("batch transfer", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			msg    *types.MsgBatchTransferRequest
+			expErr string
+		}{
+			{
+				name:   "signer without transfer access",
+				msg:    types.NewMsgBatchTransferRequest("forcecoin", holder1.String(), entry(holder2, recipient, 10)),
+				expErr: fmt.Sprintf("transfer 0 from %s to %s failed: %s is not allowed to broker transfers", holder2, recipient, holder1),
+			},
+			{
+				name:   "marker without forced transfer",
+				msg:    types.NewMsgBatchTransferRequest("noforcecoin", admin.String(), entry(holder1, recipient, 10)),
+				expErr: fmt.Sprintf("transfer 0 from %s to %s failed: %s account has not been granted authority to withdraw from %s account", holder1, recipient, admin, holder1),
+			},
+			{
+				name: "later transfer from sequence 0 account",
+				msg: types.NewMsgBatchTransferRequest("forcecoin", admin.String(),
+					entry(holder1, recipient, 10), entry(seq0, recipient, 10)),
+				expErr: fmt.Sprintf("transfer 1 from %s to %s failed: funds are not allowed to be removed from %s", seq0, recipient, seq0),
+			},
+			{
+				name: "transfer over the transfer limit",
+				msg: types.NewMsgBatchTransferRequest("limitcoin", admin.String(),
+					entry(holder1, recipient, 10), entry(holder1, recipient, 1)),
+				expErr: fmt.Sprintf("transfer 1 from %s to %s failed: cannot transfer 1limitcoin from %s: holder limit of 10limitcoin per 1h0m0s would be exceeded, remaining: 0limitcoin: transfer limit exceeded", holder1, recipient, holder1),
+			},
+			{
+				name: "many to one and one to many",
+				msg: types.NewMsgBatchTransferRequest("forcecoin", admin.String(),
+					entry(holder1, recipient, 30), entry(holder2, recipient, 20), entry(holder1, holder2, 5)),
+			},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				cacheCtx, _ := ctx.CacheContext()
+				_, err := msgServer.BatchTransfer(sdk.WrapSDKContext(cacheCtx), tc.msg)
+				if len(tc.expErr) > 0 {
+					require.EqualError(t, err, tc.expErr, "BatchTransfer")
+					return
+				}
+				require.NoError(t, err, "BatchTransfer")
+				assert.Equal(t, int64(65), app.BankKeeper.GetBalance(cacheCtx, holder1, "forcecoin").Amount.Int64(), "holder1 balance")
+				assert.Equal(t, int64(85), app.BankKeeper.GetBalance(cacheCtx, holder2, "forcecoin").Amount.Int64(), "holder2 balance")
+				assert.Equal(t, int64(50), app.BankKeeper.GetBalance(cacheCtx, recipient, "forcecoin").Amount.Int64(), "recipient balance")
+
+				var batchEvents, transferEvents int
+				for _, event := range cacheCtx.EventManager().Events() {
+					switch event.Type {
+					case "provenance.marker.v1.EventMarkerBatchTransfer":
+						batchEvents++
+					case "provenance.marker.v1.EventMarkerTransfer":
+						transferEvents++
+					}
+				}
+				assert.Equal(t, 1, batchEvents, "EventMarkerBatchTransfer count")
+				assert.Equal(t, 3, transferEvents, "EventMarkerTransfer count")
+			})
+		}
+	})
+
+	t.Run("recover account", func(t *testing.T) {
+		tests := []struct {
+			name      string
+			msg       *types.MsgRecoverAccountRequest
+			expErr    string
+			expAmount string
+		}{
+			{
+				name:   "signer without transfer access",
+				msg:    types.NewMsgRecoverAccountRequest("forcecoin", holder1.String(), recipient.String(), holder2.String()),
+				expErr: fmt.Sprintf("%s is not allowed to broker transfers", holder2),
+			},
+			{
+				name:   "nothing to recover",
+				msg:    types.NewMsgRecoverAccountRequest("forcecoin", recipient.String(), holder1.String(), admin.String()),
+				expErr: fmt.Sprintf("%s does not hold any forcecoin", recipient),
+			},
+			{
+				name:   "marker without forced transfer",
+				msg:    types.NewMsgRecoverAccountRequest("noforcecoin", holder1.String(), recipient.String(), admin.String()),
+				expErr: fmt.Sprintf("%s account has not been granted authority to withdraw from %s account", admin, holder1),
+			},
+			{
+				name:      "all holdings moved",
+				msg:       types.NewMsgRecoverAccountRequest("forcecoin", holder2.String(), recipient.String(), admin.String()),
+				expAmount: "100forcecoin",
+			},
+			{
+				name:      "holdings over the transfer limits moved",
+				msg:       types.NewMsgRecoverAccountRequest("limitcoin", holder2.String(), recipient.String(), admin.String()),
+				expAmount: "100limitcoin",
+			},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				cacheCtx, _ := ctx.CacheContext()
+				resp, err := msgServer.RecoverAccount(sdk.WrapSDKContext(cacheCtx), tc.msg)
+				if len(tc.expErr) > 0 {
+					require.EqualError(t, err, tc.expErr, "RecoverAccount")
+					return
+				}
+				require.NoError(t, err, "RecoverAccount")
+				assert.Equal(t, tc.expAmount, resp.Amount.String(), "recovered amount")
+				assert.True(t, app.BankKeeper.GetBalance(cacheCtx, holder2, tc.msg.Denom).IsZero(), "compromised balance")
+				assert.Equal(t, resp.Amount, app.BankKeeper.GetBalance(cacheCtx, recipient, tc.msg.Denom), "replacement balance")
+				assert.Equal(t, int64(100), app.BankKeeper.GetBalance(cacheCtx, holder2, "noforcecoin").Amount.Int64(), "other marker balance")
+			})
+		}
+	})
+
+	assert.Equal(t, int64(100), balance(holder1, "forcecoin"), "holder1 balance after all tests")
+	assert.Equal(t, int64(0), balance(recipient, "forcecoin"), "recipient balance after all tests")
+}
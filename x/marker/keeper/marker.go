@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -189,30 +190,66 @@ func (k Keeper) WithdrawCoins(
 ) error {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "withdraw_coins")
 
+	m, err := k.getWithdrawableMarker(ctx, caller, denom)
+	if err != nil {
+		return err
+	}
+
+	if recipient.Empty() {
+		recipient = caller
+	}
+
+	return k.withdrawCoins(ctx, m, caller, recipient, coins)
+}
+
+// MultiWithdrawCoins removes the specified coins from the MarkerAccount and sends them to each of the recipients using
+// the same rules as WithdrawCoins. Either all of the recipients are paid, or an error is returned.
+func (k Keeper) MultiWithdrawCoins(ctx sdk.Context, caller sdk.AccAddress, denom string, recipients []types.WithdrawRecipient) error {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "multi_withdraw_coins")
+
+	m, err := k.getWithdrawableMarker(ctx, caller, denom)
+	if err != nil {
+		return err
+	}
+
+	for i, r := range recipients {
+		recipient, err := sdk.AccAddressFromBech32(r.ToAddress)
+		if err != nil {
+			return fmt.Errorf("invalid recipient %d address %q: %w", i, r.ToAddress, err)
+		}
+		if err = k.withdrawCoins(ctx, m, caller, recipient, r.Amount); err != nil {
+			return errors.Wrapf(err, "withdraw %d to %s failed", i, r.ToAddress)
+		}
+	}
+	return nil
+}
+
+// getWithdrawableMarker returns the marker of the denom if the caller is allowed to withdraw coins from it.
+func (k Keeper) getWithdrawableMarker(ctx sdk.Context, caller sdk.AccAddress, denom string) (types.MarkerAccountI, error) {
 	// (if marker does not exist then fail)
 	m, err := k.GetMarkerByDenom(ctx, denom)
 	if err != nil {
-		return fmt.Errorf("marker not found for %s: %w", denom, err)
+		return nil, fmt.Errorf("marker not found for %s: %w", denom, err)
 	}
 	if !m.AddressHasAccess(caller, types.Access_Withdraw) {
-		return fmt.Errorf("%s does not have %s on %s markeraccount", caller, types.Access_Withdraw, m.GetDenom())
+		return nil, fmt.Errorf("%s does not have %s on %s markeraccount", caller, types.Access_Withdraw, m.GetDenom())
 	}
 
 	// check to see if marker is active (the coins created by a marker can only be withdrawn when it is active)
 	// any other coins that may be present (collateralized assets?) can be transferred
 	if m.GetStatus() != types.StatusActive {
-		return fmt.Errorf("cannot withdraw marker created coins from a marker that is not in Active status")
-	}
-
-	if recipient.Empty() {
-		recipient = caller
+		return nil, fmt.Errorf("cannot withdraw marker created coins from a marker that is not in Active status")
 	}
+	return m, nil
+}
 
+// withdrawCoins sends coins from the marker's escrow to the recipient.
+func (k Keeper) withdrawCoins(ctx sdk.Context, m types.MarkerAccountI, caller, recipient sdk.AccAddress, coins sdk.Coins) error {
 	if err := k.bankKeeper.SendCoins(types.WithBypass(ctx), m.GetAddress(), recipient, coins); err != nil {
 		return err
 	}
 
-	markerWithdrawEvent := types.NewEventMarkerWithdraw(coins.String(), denom, caller.String(), recipient.String())
+	markerWithdrawEvent := types.NewEventMarkerWithdraw(coins.String(), m.GetDenom(), caller.String(), recipient.String())
 
 	return ctx.EventManager().EmitTypedEvent(markerWithdrawEvent)
 }
@@ -254,6 +291,8 @@ func (k Keeper) MintCoin(ctx sdk.Context, caller sdk.AccAddress, coin sdk.Coin)
 		}
 	}
 
+	k.RecordSupplyChange(ctx, coin.Denom, types.SupplyChangeMint, coin.Amount, k.recordedSupply(ctx, m), caller.String())
+
 	markerMintEvent := types.NewEventMarkerMint(coin.Amount.String(), coin.Denom, caller.String())
 
 	return ctx.EventManager().EmitTypedEvent(markerMintEvent)
@@ -293,6 +332,8 @@ func (k Keeper) BurnCoin(ctx sdk.Context, caller sdk.AccAddress, coin sdk.Coin)
 		}
 	}
 
+	k.RecordSupplyChange(ctx, coin.Denom, types.SupplyChangeBurn, coin.Amount, k.recordedSupply(ctx, m), caller.String())
+
 	markerBurnEvent := types.NewEventMarkerBurn(coin.Amount.String(), coin.Denom, caller.String())
 
 	return ctx.EventManager().EmitTypedEvent(markerBurnEvent)
@@ -345,6 +386,10 @@ func (k Keeper) AdjustCirculation(ctx sdk.Context, marker types.MarkerAccountI,
 			return fmt.Errorf("could not burn coin %v %w", offset, err)
 		}
 	}
+	// The bank supply was changed, so the marker needs to be checked again in the next BeginBlocker.
+	if !desiredSupply.Amount.Equal(currentSupply) {
+		k.markSupplyDirty(ctx, marker)
+	}
 	return nil
 }
 
@@ -359,6 +404,10 @@ func (k Keeper) IncreaseSupply(ctx sdk.Context, marker types.MarkerAccountI, coi
 		return fmt.Errorf(
 			"requested supply %d exceeds maximum allowed value %d", total.Amount, maxAllowed.Amount)
 	}
+	if maxSupply := marker.GetMaxSupply(); maxSupply.IsPositive() && total.Amount.GT(maxSupply) {
+		return fmt.Errorf(
+			"requested supply %s exceeds %s marker max supply %s", total.Amount, marker.GetDenom(), maxSupply)
+	}
 
 	// If the marker has a fixed supply then adjust the supply to match the new total
 	if marker.HasFixedSupply() {
@@ -613,6 +662,7 @@ func (k Keeper) DeleteMarker(ctx sdk.Context, caller sdk.AccAddress, denom strin
 		return err
 	}
 	k.SetMarker(ctx, m)
+	k.RemovePendingReinstatement(ctx, m.GetAddress())
 
 	markerDeleteEvent := types.NewEventMarkerDelete(denom, caller.String())
 
@@ -620,9 +670,16 @@ func (k Keeper) DeleteMarker(ctx sdk.Context, caller sdk.AccAddress, denom strin
 }
 
 // TransferCoin transfers restricted coins between to accounts when the administrator account holds the transfer
-// access right and the marker type is restricted_coin
+// access right and the marker type is restricted_coin. Any transfer fee configured on the marker is charged to the
+// from account.
 func (k Keeper) TransferCoin(ctx sdk.Context, from, to, admin sdk.AccAddress, amount sdk.Coin) error {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "transfer_coin")
+	return k.transferCoin(ctx, from, to, admin, amount, false)
+}
+
+// transferCoin implements TransferCoin. An account recovery (isRecovery) moves the whole balance of an account that can
+// no longer be used, so it is neither charged the marker's transfer fee nor counted against its transfer limits.
+func (k Keeper) transferCoin(ctx sdk.Context, from, to, admin sdk.AccAddress, amount sdk.Coin, isRecovery bool) error {
 
 	m, err := k.GetMarkerByDenom(ctx, amount.Denom)
 	if err != nil {
@@ -648,6 +705,14 @@ func (k Keeper) TransferCoin(ctx sdk.Context, from, to, admin sdk.AccAddress, am
 	if k.bankKeeper.BlockedAddr(to) {
 		return fmt.Errorf("%s is not allowed to receive funds", to)
 	}
+	if !isRecovery {
+		if err = k.applyTransferLimits(ctx, from, amount); err != nil {
+			return err
+		}
+		if err = k.applyTransferFee(ctx, from, to, amount); err != nil {
+			return err
+		}
+	}
 	// set context to having access to bypass attribute restriction test
 	// send the coins between accounts (does not check send_enabled on coin denom)
 	if err = k.bankKeeper.SendCoins(types.WithBypass(ctx), from, to, sdk.NewCoins(amount)); err != nil {
@@ -665,6 +730,22 @@ func (k Keeper) TransferCoin(ctx sdk.Context, from, to, admin sdk.AccAddress, am
 	return ctx.EventManager().EmitTypedEvent(markerTransferEvent)
 }
 
+// RecoverAccount transfers all of the marker's coin held by the compromised account to the replacement account
+// using the same rules as TransferCoin, except that no transfer fee is charged and the marker's transfer limits do not
+// apply. The amount moved is returned.
+func (k Keeper) RecoverAccount(ctx sdk.Context, denom string, compromised, replacement, admin sdk.AccAddress) (sdk.Coin, error) {
+	balance := k.bankKeeper.GetBalance(ctx, compromised, denom)
+	if !balance.IsPositive() {
+		return sdk.Coin{}, fmt.Errorf("%s does not hold any %s", compromised, denom)
+	}
+	if err := k.transferCoin(ctx, compromised, replacement, admin, balance, true); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	recoverEvent := types.NewEventMarkerRecoverAccount(balance, compromised.String(), replacement.String(), admin.String())
+	return balance, ctx.EventManager().EmitTypedEvent(recoverEvent)
+}
+
 // canForceTransferFrom returns true if funds can be forcefully transferred out of the provided address.
 func (k Keeper) canForceTransferFrom(ctx sdk.Context, from sdk.AccAddress) bool {
 	acc := k.authKeeper.GetAccount(ctx, from)
@@ -699,6 +780,9 @@ func (k Keeper) IbcTransferCoin(
 	if !m.AddressHasAccess(admin, types.Access_Transfer) {
 		return fmt.Errorf("%s is not allowed to broker transfers", admin.String())
 	}
+	if err = k.ValidateIbcSend(ctx, token.Denom, sourceChannel, receiver); err != nil {
+		return err
+	}
 	to, err := sdk.AccAddressFromBech32(receiver)
 	if err != nil {
 		return err
@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// MarkDirty flags a marker as needing its supply checked in the next BeginBlocker.
+func (k Keeper) MarkDirty(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Set(types.DirtyMarkerKey(markerAddr), []byte{})
+}
+
+// IsDirty returns true if the marker needs its supply checked in the next BeginBlocker.
+func (k Keeper) IsDirty(ctx sdk.Context, markerAddr sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.DirtyMarkerKey(markerAddr))
+}
+
+// ClearDirty removes a marker from the dirty marker index.
+func (k Keeper) ClearDirty(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.DirtyMarkerKey(markerAddr))
+}
+
+// GetDirtyMarkers returns the addresses of all markers that need their supply checked.
+func (k Keeper) GetDirtyMarkers(ctx sdk.Context) []sdk.AccAddress {
+	return k.getIndexedMarkers(ctx, types.DirtyMarkerKeyPrefix)
+}
+
+// MarkPendingDestroy flags a destroyed marker as needing to be removed in the next BeginBlocker.
+func (k Keeper) MarkPendingDestroy(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Set(types.PendingDestroyKey(markerAddr), []byte{})
+}
+
+// IsPendingDestroy returns true if the marker is waiting to be removed in the next BeginBlocker.
+func (k Keeper) IsPendingDestroy(ctx sdk.Context, markerAddr sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.PendingDestroyKey(markerAddr))
+}
+
+// ClearPendingDestroy removes a marker from the pending destroy index.
+func (k Keeper) ClearPendingDestroy(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.PendingDestroyKey(markerAddr))
+}
+
+// GetPendingDestroyMarkers returns the addresses of all destroyed markers that are waiting to be removed.
+func (k Keeper) GetPendingDestroyMarkers(ctx sdk.Context) []sdk.AccAddress {
+	return k.getIndexedMarkers(ctx, types.PendingDestroyKeyPrefix)
+}
+
+// getIndexedMarkers returns the marker addresses stored under one of the marker index prefixes.
+func (k Keeper) getIndexedMarkers(ctx sdk.Context, keyPrefix []byte) []sdk.AccAddress {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), keyPrefix)
+	defer iterator.Close()
+
+	var addrs []sdk.AccAddress
+	for ; iterator.Valid(); iterator.Next() {
+		addrs = append(addrs, types.SplitMarkerIndexKey(iterator.Key()))
+	}
+	return addrs
+}
+
+// indexMarker adds a marker to the dirty or pending destroy index if its state requires the
+// BeginBlocker to look at it. Active markers with a fixed supply are checked against the bank
+// supply and destroyed markers are removed.
+func (k Keeper) indexMarker(ctx sdk.Context, marker types.MarkerAccountI) {
+	if marker.GetStatus() == types.StatusDestroyed {
+		k.MarkPendingDestroy(ctx, marker.GetAddress())
+		return
+	}
+	k.markSupplyDirty(ctx, marker)
+}
+
+// markSupplyDirty flags a marker as dirty if it is an active marker with a fixed supply. It is called
+// whenever the bank supply of a marker's denom or the coin held in its escrow changes.
+func (k Keeper) markSupplyDirty(ctx sdk.Context, marker types.MarkerAccountI) {
+	if marker.GetStatus() == types.StatusActive && marker.HasFixedSupply() {
+		k.MarkDirty(ctx, marker.GetAddress())
+	}
+}
+
+// markEscrowChangeDirty flags the markers that have coin of their own denom sent into or out of their escrow.
+// The escrow is what the BeginBlocker burns from to correct a marker's supply. Sends between other accounts
+// do not change the supply, so only the marker address of each denom is compared and no state is read for them.
+func (k Keeper) markEscrowChangeDirty(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) {
+	for _, coin := range amt {
+		markerAddr, err := types.MarkerAddress(coin.Denom)
+		if err != nil || (!markerAddr.Equals(fromAddr) && !markerAddr.Equals(toAddr)) {
+			continue
+		}
+		marker, err := k.GetMarker(ctx, markerAddr)
+		if err != nil || marker == nil {
+			continue
+		}
+		k.markSupplyDirty(ctx, marker)
+	}
+}
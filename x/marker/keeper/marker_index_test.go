@@ -0,0 +1,166 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestMarkerIndexes(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	app.MarkerKeeper.SetParams(ctx, types.DefaultParams())
+
+	user := testUserAddress("test")
+	other := testUserAddress("other")
+	denom := "indexcoin"
+	markerAddr := types.MustGetMarkerAddress(denom)
+
+	clearIndexes := func() {
+		for _, addr := range app.MarkerKeeper.GetDirtyMarkers(ctx) {
+			app.MarkerKeeper.ClearDirty(ctx, addr)
+		}
+		for _, addr := range app.MarkerKeeper.GetPendingDestroyMarkers(ctx) {
+			app.MarkerKeeper.ClearPendingDestroy(ctx, addr)
+		}
+	}
+
+	getMarker := func() types.MarkerAccountI {
+		m, err := app.MarkerKeeper.GetMarker(ctx, markerAddr)
+		require.NoError(t, err, "GetMarker")
+		return m
+	}
+	coins := func(amt int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(denom, amt))
+	}
+
+	// The steps build on each other, and the indexes are cleared before each one.
+	steps := []struct {
+		name              string
+		action            func() error
+		expDirty          bool
+		expPendingDestroy bool
+	}{
+		{
+			name: "proposed marker is not dirty",
+			action: func() error {
+				mac := types.NewEmptyMarkerAccount(denom, user.String(), []types.AccessGrant{*types.NewAccessGrant(user,
+					[]types.Access{types.Access_Mint, types.Access_Burn, types.Access_Withdraw, types.Access_Delete})})
+				if err := mac.SetManager(user); err != nil {
+					return err
+				}
+				if err := mac.SetSupply(sdk.NewInt64Coin(denom, 1000)); err != nil {
+					return err
+				}
+				return app.MarkerKeeper.AddMarkerAccount(ctx, mac)
+			},
+		},
+		{
+			name: "activated marker is dirty",
+			action: func() error {
+				if err := app.MarkerKeeper.FinalizeMarker(ctx, user, denom); err != nil {
+					return err
+				}
+				return app.MarkerKeeper.ActivateMarker(ctx, user, denom)
+			},
+			expDirty: true,
+		},
+		{
+			name:     "mint",
+			action:   func() error { return app.MarkerKeeper.MintCoin(ctx, user, sdk.NewInt64Coin(denom, 50)) },
+			expDirty: true,
+		},
+		{
+			name:     "burn",
+			action:   func() error { return app.MarkerKeeper.BurnCoin(ctx, user, sdk.NewInt64Coin(denom, 50)) },
+			expDirty: true,
+		},
+		{
+			name:     "withdraw from the escrow",
+			action:   func() error { return app.MarkerKeeper.WithdrawCoins(ctx, user, user, denom, coins(20)) },
+			expDirty: true,
+		},
+		{
+			name:   "send between holders",
+			action: func() error { return app.BankKeeper.SendCoins(ctx, user, other, coins(10)) },
+		},
+		{
+			name:     "send into the escrow",
+			action:   func() error { return app.BankKeeper.SendCoins(ctx, other, markerAddr, coins(10)) },
+			expDirty: true,
+		},
+		{
+			name: "circulation adjusted by the marker module",
+			action: func() error {
+				return app.MarkerKeeper.AdjustCirculation(ctx, getMarker(), sdk.NewInt64Coin(denom, 1010))
+			},
+			expDirty: true,
+		},
+		{
+			name:     "migration flags the active marker again",
+			action:   func() error { return keeper.NewMigrator(app.MarkerKeeper).Migrate2to3(ctx) },
+			expDirty: true,
+		},
+		{
+			name: "entire supply returned to the escrow",
+			action: func() error {
+				if err := app.MarkerKeeper.AdjustCirculation(ctx, getMarker(), sdk.NewInt64Coin(denom, 1000)); err != nil {
+					return err
+				}
+				return app.BankKeeper.SendCoins(ctx, user, markerAddr, coins(10))
+			},
+			expDirty: true,
+		},
+		{
+			name: "deleted marker is pending destroy",
+			action: func() error {
+				if err := app.MarkerKeeper.CancelMarker(ctx, user, denom); err != nil {
+					return err
+				}
+				return app.MarkerKeeper.DeleteMarker(ctx, user, denom)
+			},
+			expPendingDestroy: true,
+		},
+		{
+			name:              "migration flags the destroyed marker again",
+			action:            func() error { return keeper.NewMigrator(app.MarkerKeeper).Migrate2to3(ctx) },
+			expPendingDestroy: true,
+		},
+		{
+			name: "removed marker is not indexed",
+			action: func() error {
+				app.MarkerKeeper.MarkDirty(ctx, markerAddr)
+				app.MarkerKeeper.MarkPendingDestroy(ctx, markerAddr)
+				app.MarkerKeeper.RemoveMarker(ctx, getMarker())
+				return nil
+			},
+		},
+	}
+
+	for _, tc := range steps {
+		t.Run(tc.name, func(t *testing.T) {
+			clearIndexes()
+			require.NoError(t, tc.action(), "action")
+			assert.Equal(t, tc.expDirty, app.MarkerKeeper.IsDirty(ctx, markerAddr), "IsDirty")
+			assert.Equal(t, tc.expPendingDestroy, app.MarkerKeeper.IsPendingDestroy(ctx, markerAddr), "IsPendingDestroy")
+			var expDirty, expPendingDestroy []sdk.AccAddress
+			if tc.expDirty {
+				expDirty = []sdk.AccAddress{markerAddr}
+			}
+			if tc.expPendingDestroy {
+				expPendingDestroy = []sdk.AccAddress{markerAddr}
+			}
+			assert.Equal(t, expDirty, app.MarkerKeeper.GetDirtyMarkers(ctx), "GetDirtyMarkers")
+			assert.Equal(t, expPendingDestroy, app.MarkerKeeper.GetPendingDestroyMarkers(ctx), "GetPendingDestroyMarkers")
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"bytes"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// updateMarkerSearchIndexes updates the index entries used to look up a marker by its manager, access holders,
+// marker type, allow forced transfer flag, and required attributes. The entries of the previous version of the
+// marker (if provided) that no longer apply are removed, and only new or changed entries are written.
+func (k Keeper) updateMarkerSearchIndexes(ctx sdk.Context, previous, marker types.MarkerAccountI) {
+	store := ctx.KVStore(k.storeKey)
+	oldEntries := markerSearchIndexEntries(previous)
+	newEntries := markerSearchIndexEntries(marker)
+	for _, key := range sortedKeys(oldEntries) {
+		if _, keep := newEntries[key]; !keep {
+			store.Delete([]byte(key))
+		}
+	}
+	for _, key := range sortedKeys(newEntries) {
+		if oldValue, found := oldEntries[key]; !found || !bytes.Equal(oldValue, newEntries[key]) {
+			store.Set([]byte(key), newEntries[key])
+		}
+	}
+}
+
+// setMarkerSearchIndexes writes all the search index entries of the provided marker.
+func (k Keeper) setMarkerSearchIndexes(ctx sdk.Context, marker types.MarkerAccountI) {
+	k.updateMarkerSearchIndexes(ctx, nil, marker)
+}
+
+// removeMarkerSearchIndexes deletes the search index entries written for the provided marker.
+func (k Keeper) removeMarkerSearchIndexes(ctx sdk.Context, marker types.MarkerAccountI) {
+	k.updateMarkerSearchIndexes(ctx, marker, nil)
+}
+
+// markerSearchIndexEntries returns the search index entries (key to value) of a marker.
+func markerSearchIndexEntries(marker types.MarkerAccountI) map[string][]byte {
+	entries := make(map[string][]byte)
+	if marker == nil {
+		return entries
+	}
+	markerAddr := marker.GetAddress()
+
+	if manager := marker.GetManager(); len(manager) > 0 {
+		entries[string(types.MarkerManagerIndexKey(manager, markerAddr))] = []byte{}
+	}
+	for _, grant := range marker.GetAccessList() {
+		holder, err := sdk.AccAddressFromBech32(grant.Address)
+		if err != nil {
+			continue
+		}
+		entries[string(types.MarkerAccessIndexKey(holder, markerAddr))] = accessListBytes(grant.Permissions)
+	}
+	entries[string(types.MarkerTypeIndexKey(marker.GetMarkerType(), markerAddr))] = []byte{}
+	entries[string(types.MarkerForcedTransferIndexKey(marker.AllowsForcedTransfer(), markerAddr))] = []byte{}
+	for _, attr := range marker.GetRequiredAttributes() {
+		entries[string(types.MarkerRequiredAttributeIndexKey(attr, markerAddr))] = []byte{}
+	}
+	return entries
+}
+
+// sortedKeys returns the keys of the provided index entries in sorted order so that store operations are deterministic.
+func sortedKeys(entries map[string][]byte) []string {
+	keys := make([]string, 0, len(entries))
+	for key := range entries {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// accessListBytes converts the permissions of an access grant into the value stored in the access index.
+func accessListBytes(permissions types.AccessList) []byte {
+	bz := make([]byte, len(permissions))
+	for i, access := range permissions {
+		bz[i] = byte(access)
+	}
+	return bz
+}
+
+// accessBytesContain returns true if the value stored in the access index contains the provided access.
+func accessBytesContain(bz []byte, access types.Access) bool {
+	for _, b := range bz {
+		if types.Access(b) == access {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestMarkerSearchQueries(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	goCtx := sdk.WrapSDKContext(ctx)
+	app.MarkerKeeper.SetParams(ctx, types.DefaultParams())
+
+	manager := testUserAddress("manager")
+	minter := testUserAddress("minter")
+	other := testUserAddress("other")
+
+	coinMarker := types.NewEmptyMarkerAccount("searchcoin", manager.String(), []types.AccessGrant{
+		*types.NewAccessGrant(minter, []types.Access{types.Access_Mint, types.Access_Burn}),
+	})
+	restrictedMarker := types.NewEmptyMarkerAccount("searchrestricted", manager.String(), []types.AccessGrant{
+		*types.NewAccessGrant(minter, []types.Access{types.Access_Transfer}),
+		*types.NewAccessGrant(other, []types.Access{types.Access_Withdraw}),
+	})
+	restrictedMarker.MarkerType = types.MarkerType_RestrictedCoin
+	restrictedMarker.AllowForcedTransfer = true
+	restrictedMarker.RequiredAttributes = []string{"kyc.provenance.io", "*.accredited.pb"}
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, coinMarker), "AddMarkerAccount coin")
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, restrictedMarker), "AddMarkerAccount restricted")
+
+	toDenoms := func(t *testing.T, anys []*codectypes.Any) []string {
+		var denoms []string
+		for _, a := range anys {
+			var m types.MarkerAccountI
+			require.NoError(t, app.InterfaceRegistry().UnpackAny(a, &m), "UnpackAny")
+			denoms = append(denoms, m.GetDenom())
+		}
+		return denoms
+	}
+
+	byManager, err := app.MarkerKeeper.MarkersByManager(goCtx, &types.QueryMarkersByManagerRequest{Manager: manager.String()})
+	require.NoError(t, err, "MarkersByManager")
+	assert.ElementsMatch(t, []string{"searchcoin", "searchrestricted"}, toDenoms(t, byManager.Markers), "MarkersByManager")
+
+	byHolder, err := app.MarkerKeeper.MarkersByAccessHolder(goCtx, &types.QueryMarkersByAccessHolderRequest{Address: minter.String()})
+	require.NoError(t, err, "MarkersByAccessHolder any")
+	assert.ElementsMatch(t, []string{"searchcoin", "searchrestricted"}, toDenoms(t, byHolder.Markers), "MarkersByAccessHolder any")
+
+	byHolder, err = app.MarkerKeeper.MarkersByAccessHolder(goCtx, &types.QueryMarkersByAccessHolderRequest{Address: minter.String(), Access: types.Access_Transfer})
+	require.NoError(t, err, "MarkersByAccessHolder transfer")
+	assert.Equal(t, []string{"searchrestricted"}, toDenoms(t, byHolder.Markers), "MarkersByAccessHolder transfer")
+
+	byType, err := app.MarkerKeeper.MarkersByType(goCtx, &types.QueryMarkersByTypeRequest{MarkerType: types.MarkerType_Coin})
+	require.NoError(t, err, "MarkersByType")
+	assert.Contains(t, toDenoms(t, byType.Markers), "searchcoin", "MarkersByType coin")
+	assert.NotContains(t, toDenoms(t, byType.Markers), "searchrestricted", "MarkersByType coin")
+	_, err = app.MarkerKeeper.MarkersByType(goCtx, &types.QueryMarkersByTypeRequest{MarkerType: types.MarkerType_Unknown})
+	require.Error(t, err, "MarkersByType unknown")
+
+	byForced, err := app.MarkerKeeper.MarkersByForcedTransfer(goCtx, &types.QueryMarkersByForcedTransferRequest{AllowForcedTransfer: true})
+	require.NoError(t, err, "MarkersByForcedTransfer")
+	assert.Equal(t, []string{"searchrestricted"}, toDenoms(t, byForced.Markers), "MarkersByForcedTransfer")
+
+	byAttr, err := app.MarkerKeeper.MarkersByRequiredAttribute(goCtx, &types.QueryMarkersByRequiredAttributeRequest{Attribute: "*.accredited.pb"})
+	require.NoError(t, err, "MarkersByRequiredAttribute")
+	assert.Equal(t, []string{"searchrestricted"}, toDenoms(t, byAttr.Markers), "MarkersByRequiredAttribute")
+	_, err = app.MarkerKeeper.MarkersByRequiredAttribute(goCtx, &types.QueryMarkersByRequiredAttributeRequest{})
+	require.Error(t, err, "MarkersByRequiredAttribute empty")
+
+	// Updating a marker replaces its old index entries.
+	updated, err := app.MarkerKeeper.GetMarkerByDenom(ctx, "searchrestricted")
+	require.NoError(t, err, "GetMarkerByDenom")
+	restrictedMarker, ok := updated.(*types.MarkerAccount)
+	require.True(t, ok, "marker account type")
+	restrictedMarker.Manager = other.String()
+	restrictedMarker.AccessControl = restrictedMarker.AccessControl[1:]
+	restrictedMarker.RequiredAttributes = []string{"kyc.provenance.io"}
+	app.MarkerKeeper.SetMarker(ctx, restrictedMarker)
+
+	byManager, err = app.MarkerKeeper.MarkersByManager(goCtx, &types.QueryMarkersByManagerRequest{Manager: manager.String()})
+	require.NoError(t, err, "MarkersByManager after update")
+	assert.Equal(t, []string{"searchcoin"}, toDenoms(t, byManager.Markers), "MarkersByManager after update")
+	byHolder, err = app.MarkerKeeper.MarkersByAccessHolder(goCtx, &types.QueryMarkersByAccessHolderRequest{Address: minter.String()})
+	require.NoError(t, err, "MarkersByAccessHolder after update")
+	assert.Equal(t, []string{"searchcoin"}, toDenoms(t, byHolder.Markers), "MarkersByAccessHolder after update")
+	byAttr, err = app.MarkerKeeper.MarkersByRequiredAttribute(goCtx, &types.QueryMarkersByRequiredAttributeRequest{Attribute: "*.accredited.pb"})
+	require.NoError(t, err, "MarkersByRequiredAttribute after update")
+	assert.Empty(t, byAttr.Markers, "MarkersByRequiredAttribute after update")
+
+	// Removing a marker removes its index entries.
+	app.MarkerKeeper.RemoveMarker(ctx, restrictedMarker)
+	byForced, err = app.MarkerKeeper.MarkersByForcedTransfer(goCtx, &types.QueryMarkersByForcedTransferRequest{AllowForcedTransfer: true})
+	require.NoError(t, err, "MarkersByForcedTransfer after remove")
+	assert.Empty(t, byForced.Markers, "MarkersByForcedTransfer after remove")
+
+	// The migration backfills the indexes of existing markers.
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	store.Delete(types.MarkerManagerIndexKey(manager, coinMarker.GetAddress()))
+	byManager, err = app.MarkerKeeper.MarkersByManager(goCtx, &types.QueryMarkersByManagerRequest{Manager: manager.String()})
+	require.NoError(t, err, "MarkersByManager after delete")
+	assert.Empty(t, byManager.Markers, "MarkersByManager after delete")
+	require.NoError(t, keeper.NewMigrator(app.MarkerKeeper).Migrate3to4(ctx), "Migrate3to4")
+	byManager, err = app.MarkerKeeper.MarkersByManager(goCtx, &types.QueryMarkersByManagerRequest{Manager: manager.String()})
+	require.NoError(t, err, "MarkersByManager after migration")
+	assert.Equal(t, []string{"searchcoin"}, toDenoms(t, byManager.Markers), "MarkersByManager after migration")
+}
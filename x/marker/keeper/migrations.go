@@ -1,5 +1,11 @@
 package keeper
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
 	keeper Keeper
@@ -9,3 +15,33 @@ type Migrator struct {
 func NewMigrator(keeper Keeper) Migrator {
 	return Migrator{keeper: keeper}
 }
+
+// Migrate2to3 builds the dirty marker and pending destroy indexes used by the BeginBlocker. Every active marker
+// with a fixed supply is flagged as dirty so that it gets checked once, and every destroyed marker is flagged
+// for removal.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.IterateMarkers(ctx, func(marker types.MarkerAccountI) bool {
+		m.keeper.indexMarker(ctx, marker)
+		return false
+	})
+	return nil
+}
+
+// Migrate3to4 builds the marker search indexes (manager, access holder, marker type, allow forced transfer,
+// and required attribute) for all existing markers.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	m.keeper.IterateMarkers(ctx, func(marker types.MarkerAccountI) bool {
+		m.keeper.setMarkerSearchIndexes(ctx, marker)
+		return false
+	})
+	return nil
+}
+
+// Migrate4to5 builds the escrow holder index from the balances currently held by all existing markers.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	m.keeper.IterateMarkers(ctx, func(marker types.MarkerAccountI) bool {
+		m.keeper.indexMarkerEscrow(ctx, marker.GetAddress())
+		return false
+	})
+	return nil
+}
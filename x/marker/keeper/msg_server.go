@@ -632,6 +632,125 @@ func (k msgServer) SupplyIncreaseProposal(goCtx context.Context, msg *types.MsgS
 	return &types.MsgSupplyIncreaseProposalResponse{}, nil
 }
 
+// SupplyDecreaseProposal can only be called via gov proposal
+func (k msgServer) SupplyDecreaseProposal(goCtx context.Context, msg *types.MsgSupplyDecreaseProposalRequest) (*types.MsgSupplyDecreaseProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetAuthority() != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	proposal := types.SupplyDecreaseProposal{
+		Amount: msg.Amount,
+	}
+
+	err := HandleSupplyDecreaseProposal(ctx, k.Keeper, &proposal)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgSupplyDecreaseProposalResponse{}, nil
+}
+
+// SetAdministratorProposal can only be called via gov proposal
+func (k msgServer) SetAdministratorProposal(goCtx context.Context, msg *types.MsgSetAdministratorProposalRequest) (*types.MsgSetAdministratorProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetAuthority() != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	proposal := types.SetAdministratorProposal{
+		Denom:  msg.Denom,
+		Access: msg.Access,
+	}
+
+	err := HandleSetAdministratorProposal(ctx, k.Keeper, &proposal)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgSetAdministratorProposalResponse{}, nil
+}
+
+// RemoveAdministratorProposal can only be called via gov proposal
+func (k msgServer) RemoveAdministratorProposal(goCtx context.Context, msg *types.MsgRemoveAdministratorProposalRequest) (*types.MsgRemoveAdministratorProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetAuthority() != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	proposal := types.RemoveAdministratorProposal{
+		Denom:          msg.Denom,
+		RemovedAddress: msg.RemovedAddress,
+	}
+
+	err := HandleRemoveAdministratorProposal(ctx, k.Keeper, &proposal)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgRemoveAdministratorProposalResponse{}, nil
+}
+
+// ChangeStatusProposal can only be called via gov proposal
+func (k msgServer) ChangeStatusProposal(goCtx context.Context, msg *types.MsgChangeStatusProposalRequest) (*types.MsgChangeStatusProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetAuthority() != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	proposal := types.ChangeStatusProposal{
+		Denom:     msg.Denom,
+		NewStatus: msg.NewStatus,
+	}
+
+	err := HandleChangeStatusProposal(ctx, k.Keeper, &proposal)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgChangeStatusProposalResponse{}, nil
+}
+
+// WithdrawEscrowProposal can only be called via gov proposal
+func (k msgServer) WithdrawEscrowProposal(goCtx context.Context, msg *types.MsgWithdrawEscrowProposalRequest) (*types.MsgWithdrawEscrowProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetAuthority() != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	proposal := types.WithdrawEscrowProposal{
+		Denom:         msg.Denom,
+		Amount:        msg.Amount,
+		TargetAddress: msg.TargetAddress,
+	}
+
+	err := HandleWithdrawEscrowProposal(ctx, k.Keeper, &proposal)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgWithdrawEscrowProposalResponse{}, nil
+}
+
+// SetDenomMetadataProposal can only be called via gov proposal
+func (k msgServer) SetDenomMetadataProposal(goCtx context.Context, msg *types.MsgSetDenomMetadataProposalRequest) (*types.MsgSetDenomMetadataProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetAuthority() != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	proposal := types.SetDenomMetadataProposal{
+		Metadata: msg.Metadata,
+	}
+
+	err := HandleSetDenomMetadataProposal(ctx, k.Keeper, &proposal)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgSetDenomMetadataProposalResponse{}, nil
+}
+
 // UpdateRequiredAttributes will only succeed if signer has transfer authority
 func (k msgServer) UpdateRequiredAttributes(goCtx context.Context, msg *types.MsgUpdateRequiredAttributesRequest) (*types.MsgUpdateRequiredAttributesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -808,3 +927,482 @@ func (k msgServer) UpdateSendDenyList(goCtx context.Context, msg *types.MsgUpdat
 
 	return &types.MsgUpdateSendDenyListResponse{}, nil
 }
+
+// SetTransferLimit sets or removes the rolling window transfer limits of a restricted marker.
+// Signer must have transfer authority or be the governance module account.
+func (k msgServer) SetTransferLimit(goCtx context.Context, msg *types.MsgSetTransferLimitRequest) (*types.MsgSetTransferLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, fmt.Errorf("marker not found for %s: %w", msg.Denom, err)
+	}
+
+	if marker.GetMarkerType() != types.MarkerType_RestrictedCoin {
+		return nil, fmt.Errorf("marker %s is not a restricted marker", msg.Denom)
+	}
+
+	if msg.Authority == k.GetAuthority() {
+		if !marker.HasGovernanceEnabled() {
+			return nil, fmt.Errorf("%s marker does not allow governance control", msg.Denom)
+		}
+	} else {
+		if !marker.HasAccess(msg.Authority, types.Access_Transfer) {
+			return nil, fmt.Errorf("%s does not have transfer authority for %s marker", msg.Authority, msg.Denom)
+		}
+	}
+
+	limit := types.NewTransferLimit(msg.Denom, msg.Window, msg.MarkerLimit, msg.HolderLimit)
+	if limit.HasLimits() {
+		if err = limit.Validate(); err != nil {
+			return nil, err
+		}
+		k.Keeper.SetTransferLimit(ctx, limit)
+	} else {
+		if _, found := k.GetTransferLimit(ctx, marker.GetAddress()); !found {
+			return nil, fmt.Errorf("%s marker does not have a transfer limit to remove", msg.Denom)
+		}
+		k.RemoveTransferLimit(ctx, marker.GetAddress())
+	}
+
+	if err = ctx.EventManager().EmitTypedEvent(types.NewEventMarkerSetTransferLimit(limit, msg.Authority)); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgSetTransferLimitResponse{}, nil
+}
+
+// AddNetAssetValues records net asset values for a marker. Signer must have admin access or be the governance
+// module account when the marker allows governance control.
+func (k msgServer) AddNetAssetValues(goCtx context.Context, msg *types.MsgAddNetAssetValuesRequest) (*types.MsgAddNetAssetValuesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, fmt.Errorf("could not get %s marker: %w", msg.Denom, err)
+	}
+
+	if msg.Administrator == k.GetAuthority() {
+		if !marker.HasGovernanceEnabled() {
+			return nil, fmt.Errorf("%s marker does not allow governance control", msg.Denom)
+		}
+	} else {
+		if !marker.HasAccess(msg.Administrator, types.Access_Admin) {
+			return nil, fmt.Errorf("%s does not have admin access for %s marker", msg.Administrator, msg.Denom)
+		}
+	}
+
+	for _, nav := range msg.NetAssetValues {
+		nav = k.AddNetAssetValue(ctx, marker.GetAddress(), nav)
+		if err = ctx.EventManager().EmitTypedEvent(types.NewEventMarkerAddNetAssetValue(msg.Denom, nav, msg.Administrator)); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgAddNetAssetValuesResponse{}, nil
+}
+
+// ChangeMarkerType switches an active marker between the coin and restricted coin types. Signers must be a majority
+// of the marker's admins or only the governance module account when the marker allows governance control.
+func (k msgServer) ChangeMarkerType(goCtx context.Context, msg *types.MsgChangeMarkerTypeRequest) (*types.MsgChangeMarkerTypeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, fmt.Errorf("could not get %s marker: %w", msg.Denom, err)
+	}
+
+	if err = k.validateGovOrAdminMajority(marker, msg.Administrators); err != nil {
+		return nil, err
+	}
+
+	if marker.GetStatus() != types.StatusActive {
+		return nil, fmt.Errorf("cannot change the type of %s marker with status %s", msg.Denom, marker.GetStatus())
+	}
+
+	oldType := marker.GetMarkerType()
+	if oldType == msg.MarkerType {
+		return nil, fmt.Errorf("marker %s is already of type %s", msg.Denom, msg.MarkerType)
+	}
+
+	if msg.MarkerType == types.MarkerType_Coin {
+		if marker.AllowsForcedTransfer() {
+			return nil, fmt.Errorf("cannot change %s marker to %s while forced transfers are allowed", msg.Denom, msg.MarkerType)
+		}
+		if len(marker.GetRequiredAttributes()) > 0 {
+			return nil, fmt.Errorf("cannot change %s marker to %s while it has required attributes", msg.Denom, msg.MarkerType)
+		}
+		if _, found := k.GetTransferLimit(ctx, marker.GetAddress()); found {
+			return nil, fmt.Errorf("cannot change %s marker to %s while it has transfer limits", msg.Denom, msg.MarkerType)
+		}
+	}
+
+	if err = types.ValidateGrantsForMarkerType(msg.MarkerType, marker.GetAccessList()...); err != nil {
+		return nil, fmt.Errorf("cannot change %s marker to %s: %w", msg.Denom, msg.MarkerType, err)
+	}
+
+	marker.SetMarkerType(msg.MarkerType)
+	if err = marker.Validate(); err != nil {
+		return nil, fmt.Errorf("cannot change %s marker to %s: %w", msg.Denom, msg.MarkerType, err)
+	}
+	k.SetMarker(ctx, marker)
+
+	if err = ctx.EventManager().EmitTypedEvent(types.NewEventMarkerChangeType(msg.Denom, oldType, msg.MarkerType, msg.Administrators)); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgChangeMarkerTypeResponse{}, nil
+}
+
+// SetMaxSupply sets the maximum supply of a marker. Signers must be a majority of the marker's admins or only the
+// governance module account when the marker allows governance control.
+func (k msgServer) SetMaxSupply(goCtx context.Context, msg *types.MsgSetMaxSupplyRequest) (*types.MsgSetMaxSupplyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, fmt.Errorf("could not get %s marker: %w", msg.Denom, err)
+	}
+
+	if err = k.validateGovOrAdminMajority(marker, msg.Administrators); err != nil {
+		return nil, err
+	}
+
+	if supply := k.recordedSupply(ctx, marker); msg.MaxSupply.IsPositive() && supply.GT(msg.MaxSupply) {
+		return nil, fmt.Errorf("max supply %s cannot be less than %s marker supply %s", msg.MaxSupply, msg.Denom, supply)
+	}
+
+	if err = marker.SetMaxSupply(msg.MaxSupply); err != nil {
+		return nil, err
+	}
+	if err = marker.Validate(); err != nil {
+		return nil, err
+	}
+	k.SetMarker(ctx, marker)
+
+	if err = ctx.EventManager().EmitTypedEvent(types.NewEventMarkerSetMaxSupply(msg.Denom, msg.MaxSupply, msg.Administrators)); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgSetMaxSupplyResponse{}, nil
+}
+
+// validateGovOrAdminMajority checks that the administrators are either only the governance module account, when the
+// marker allows governance control, or a majority of the addresses with admin access on the marker.
+func (k msgServer) validateGovOrAdminMajority(marker types.MarkerAccountI, administrators []string) error {
+	denom := marker.GetDenom()
+	if len(administrators) == 1 && administrators[0] == k.GetAuthority() {
+		if !marker.HasGovernanceEnabled() {
+			return fmt.Errorf("%s marker does not allow governance control", denom)
+		}
+		return nil
+	}
+	for _, admin := range administrators {
+		if !marker.HasAccess(admin, types.Access_Admin) {
+			return fmt.Errorf("%s does not have admin access for %s marker", admin, denom)
+		}
+	}
+	admins := marker.AddressListForPermission(types.Access_Admin)
+	if len(administrators)*2 <= len(admins) {
+		return fmt.Errorf("%d of %d %s marker admins signed, a majority is required", len(administrators), len(admins), denom)
+	}
+	return nil
+}
+
+// BatchTransfer handles a message to transfer restricted coin between many pairs of accounts.
+func (k msgServer) BatchTransfer(goCtx context.Context, msg *types.MsgBatchTransferRequest) (*types.MsgBatchTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	admin, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		return nil, err
+	}
+
+	// If any transfer fails, the whole message fails and none of the transfers are kept.
+	total := sdk.ZeroInt()
+	for i, transfer := range msg.Transfers {
+		from, err := sdk.AccAddressFromBech32(transfer.FromAddress)
+		if err != nil {
+			return nil, err
+		}
+		to, err := sdk.AccAddressFromBech32(transfer.ToAddress)
+		if err != nil {
+			return nil, err
+		}
+		if err = k.TransferCoin(ctx, from, to, admin, sdk.NewCoin(msg.Denom, transfer.Amount)); err != nil {
+			return nil, errors.Wrapf(err, "transfer %d from %s to %s failed", i, transfer.FromAddress, transfer.ToAddress)
+		}
+		total = total.Add(transfer.Amount)
+	}
+
+	batchEvent := types.NewEventMarkerBatchTransfer(msg.Denom, total, uint32(len(msg.Transfers)), msg.Administrator)
+	if err = ctx.EventManager().EmitTypedEvent(batchEvent); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgBatchTransferResponse{}, nil
+}
+
+// RecoverAccount handles a message to move all of a marker's coin out of a compromised account.
+func (k msgServer) RecoverAccount(goCtx context.Context, msg *types.MsgRecoverAccountRequest) (*types.MsgRecoverAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	compromised, err := sdk.AccAddressFromBech32(msg.CompromisedAddress)
+	if err != nil {
+		return nil, err
+	}
+	replacement, err := sdk.AccAddressFromBech32(msg.ReplacementAddress)
+	if err != nil {
+		return nil, err
+	}
+	admin, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := k.Keeper.RecoverAccount(ctx, msg.Denom, compromised, replacement, admin)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgRecoverAccountResponse{Amount: amount}, nil
+}
+
+// SetIbcPolicy sets or removes the IBC channel and destination policy of a restricted marker.
+func (k msgServer) SetIbcPolicy(goCtx context.Context, msg *types.MsgSetIbcPolicyRequest) (*types.MsgSetIbcPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, fmt.Errorf("marker not found for %s: %w", msg.Denom, err)
+	}
+
+	if marker.GetMarkerType() != types.MarkerType_RestrictedCoin {
+		return nil, fmt.Errorf("marker %s is not a restricted marker", msg.Denom)
+	}
+
+	if msg.Authority == k.GetAuthority() {
+		if !marker.HasGovernanceEnabled() {
+			return nil, fmt.Errorf("%s marker does not allow governance control", msg.Denom)
+		}
+	} else {
+		if !marker.HasAccess(msg.Authority, types.Access_Transfer) {
+			return nil, fmt.Errorf("%s does not have transfer authority for %s marker", msg.Authority, msg.Denom)
+		}
+	}
+
+	policy := types.NewIbcPolicy(msg.Denom, msg.AllowedChannels, msg.AllowedDestinations)
+	if policy.HasRules() {
+		if err = policy.Validate(); err != nil {
+			return nil, err
+		}
+		k.Keeper.SetIbcPolicy(ctx, policy)
+	} else {
+		if _, found := k.GetIbcPolicy(ctx, marker.GetAddress()); !found {
+			return nil, fmt.Errorf("%s marker does not have an ibc policy to remove", msg.Denom)
+		}
+		k.RemoveIbcPolicy(ctx, marker.GetAddress())
+	}
+
+	if err = ctx.EventManager().EmitTypedEvent(types.NewEventMarkerSetIbcPolicy(policy, msg.Authority)); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgSetIbcPolicyResponse{}, nil
+}
+
+// SetTransferFee sets or removes the fee charged on every transfer of a restricted marker's denom.
+func (k msgServer) SetTransferFee(goCtx context.Context, msg *types.MsgSetTransferFeeRequest) (*types.MsgSetTransferFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, fmt.Errorf("marker not found for %s: %w", msg.Denom, err)
+	}
+
+	if marker.GetMarkerType() != types.MarkerType_RestrictedCoin {
+		return nil, fmt.Errorf("marker %s is not a restricted marker", msg.Denom)
+	}
+
+	if msg.Authority == k.GetAuthority() {
+		if !marker.HasGovernanceEnabled() {
+			return nil, fmt.Errorf("%s marker does not allow governance control", msg.Denom)
+		}
+	} else {
+		if !marker.HasAccess(msg.Authority, types.Access_Admin) {
+			return nil, fmt.Errorf("%s does not have admin access for %s marker", msg.Authority, msg.Denom)
+		}
+	}
+
+	fee := types.NewTransferFee(msg.Denom, msg.Recipient, msg.BasisPoints, msg.FlatFees)
+	if fee.HasFees() {
+		if err = fee.Validate(); err != nil {
+			return nil, err
+		}
+		k.Keeper.SetTransferFee(ctx, fee)
+	} else {
+		if _, found := k.GetTransferFee(ctx, marker.GetAddress()); !found {
+			return nil, fmt.Errorf("%s marker does not have a transfer fee to remove", msg.Denom)
+		}
+		k.RemoveTransferFee(ctx, marker.GetAddress())
+	}
+
+	if err = ctx.EventManager().EmitTypedEvent(types.NewEventMarkerSetTransferFee(fee, msg.Authority)); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgSetTransferFeeResponse{}, nil
+}
+
+// MultiWithdraw handles a message to withdraw coins held in a marker's escrow to many recipients.
+func (k msgServer) MultiWithdraw(goCtx context.Context, msg *types.MsgMultiWithdrawRequest) (*types.MsgMultiWithdrawResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	admin, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		return nil, err
+	}
+
+	// If any withdrawal fails, the whole message fails and none of the recipients are paid.
+	if err = k.Keeper.MultiWithdrawCoins(ctx, admin, msg.Denom, msg.Recipients); err != nil {
+		ctx.Logger().Error("unable to withdraw coins from marker", "err", err)
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgMultiWithdrawResponse{}, nil
+}
+
+// ReinstateMarker schedules a cancelled marker to be returned to the proposed or finalized status.
+func (k msgServer) ReinstateMarker(goCtx context.Context, msg *types.MsgReinstateMarkerRequest) (*types.MsgReinstateMarkerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateCancelledMarkerAuthority(ctx, msg.Denom, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	reinstatement, err := k.Keeper.ReinstateMarker(ctx, msg.Authority, msg.Denom, msg.Status, msg.Manager)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgReinstateMarkerResponse{ReinstateHeight: reinstatement.ReinstateHeight}, nil
+}
+
+// ReclaimEscrow sends the coins held in a cancelled marker's escrow, other than the marker's own denom, to an address.
+func (k msgServer) ReclaimEscrow(goCtx context.Context, msg *types.MsgReclaimEscrowRequest) (*types.MsgReclaimEscrowResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateCancelledMarkerAuthority(ctx, msg.Denom, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	reclaimed, err := k.Keeper.ReclaimEscrow(ctx, msg.Authority, msg.Denom, to)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgReclaimEscrowResponse{Amount: reclaimed}, nil
+}
+
+// validateCancelledMarkerAuthority returns an error if the authority is not allowed to reinstate, reclaim the escrow
+// of, or delete a cancelled marker. The same accounts that can delete the marker (its manager and the accounts with
+// delete access) are allowed, as is the governance module account when the marker allows governance control.
+func (k msgServer) validateCancelledMarkerAuthority(ctx sdk.Context, denom string, authority string) error {
+	marker, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return fmt.Errorf("marker not found for %s: %w", denom, err)
+	}
+
+	if authority == k.GetAuthority() {
+		if !marker.HasGovernanceEnabled() {
+			return fmt.Errorf("%s marker does not allow governance control", denom)
+		}
+		return nil
+	}
+	if marker.GetManager().String() != authority && !marker.HasAccess(authority, types.Access_Delete) {
+		return fmt.Errorf("%s does not have %s on %s markeraccount", authority, types.Access_Delete, denom)
+	}
+	return nil
+}
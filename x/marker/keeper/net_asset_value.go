@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// AddNetAssetValue records a net asset value for a marker. The value is appended to the marker's net asset value
+// history and becomes the latest value for its price denomination.
+func (k Keeper) AddNetAssetValue(ctx sdk.Context, markerAddr sdk.AccAddress, nav types.NetAssetValue) types.NetAssetValue {
+	nav.Sequence = k.getLastNetAssetValueSequence(ctx, markerAddr) + 1
+	nav.UpdatedBlockHeight = ctx.BlockHeight()
+	k.setNetAssetValue(ctx, markerAddr, nav)
+	return nav
+}
+
+// GetNetAssetValue returns the latest net asset value of a marker in the provided price denomination.
+// A nil value is returned if the marker has no net asset value in that denomination.
+func (k Keeper) GetNetAssetValue(ctx sdk.Context, markerDenom, priceDenom string) (*types.NetAssetValue, error) {
+	markerAddr, err := types.MarkerAddress(markerDenom)
+	if err != nil {
+		return nil, fmt.Errorf("could not get marker %q address: %w", markerDenom, err)
+	}
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LatestNetAssetValueKey(markerAddr, priceDenom))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	bz = store.Get(types.NetAssetValueKey(markerAddr, sdk.BigEndianToUint64(bz)))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var nav types.NetAssetValue
+	if err = k.cdc.Unmarshal(bz, &nav); err != nil {
+		return nil, err
+	}
+	return &nav, nil
+}
+
+// GetLatestNetAssetValues returns the latest net asset value of a marker in each of its price denominations,
+// ordered by price denomination.
+func (k Keeper) GetLatestNetAssetValues(ctx sdk.Context, markerAddr sdk.AccAddress) []types.NetAssetValue {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.LatestNetAssetValuePrefix(markerAddr))
+
+	defer iterator.Close()
+	var navs []types.NetAssetValue
+	for ; iterator.Valid(); iterator.Next() {
+		bz := store.Get(types.NetAssetValueKey(markerAddr, sdk.BigEndianToUint64(iterator.Value())))
+		if len(bz) == 0 {
+			continue
+		}
+		var nav types.NetAssetValue
+		k.cdc.MustUnmarshal(bz, &nav)
+		navs = append(navs, nav)
+	}
+	return navs
+}
+
+// IterateNetAssetValues iterates over the net asset value history of all markers.
+func (k Keeper) IterateNetAssetValues(ctx sdk.Context, cb func(markerAddr sdk.AccAddress, nav types.NetAssetValue) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.NetAssetValueKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		// key is [prefix][address length][address][sequence]
+		key := iterator.Key()
+		markerAddr := sdk.AccAddress(key[2 : 2+int(key[1])])
+		var nav types.NetAssetValue
+		k.cdc.MustUnmarshal(iterator.Value(), &nav)
+		if cb(markerAddr, nav) {
+			break
+		}
+	}
+}
+
+// netAssetValueHistoryStore returns a store of the net asset value history of a marker keyed by sequence.
+func (k Keeper) netAssetValueHistoryStore(ctx sdk.Context, markerAddr sdk.AccAddress) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.NetAssetValuePrefix(markerAddr))
+}
+
+// setNetAssetValue stores a net asset value history entry and updates the last sequence used for the marker and
+// the latest value of the price denomination if needed.
+func (k Keeper) setNetAssetValue(ctx sdk.Context, markerAddr sdk.AccAddress, nav types.NetAssetValue) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NetAssetValueKey(markerAddr, nav.Sequence), k.cdc.MustMarshal(&nav))
+	if nav.Sequence > k.getLastNetAssetValueSequence(ctx, markerAddr) {
+		store.Set(types.NetAssetValueSequenceKey(markerAddr), sdk.Uint64ToBigEndian(nav.Sequence))
+	}
+	latestKey := types.LatestNetAssetValueKey(markerAddr, nav.Price.Denom)
+	if bz := store.Get(latestKey); len(bz) == 0 || sdk.BigEndianToUint64(bz) < nav.Sequence {
+		store.Set(latestKey, sdk.Uint64ToBigEndian(nav.Sequence))
+	}
+}
+
+// getLastNetAssetValueSequence returns the last sequence used in the net asset value history of a marker.
+func (k Keeper) getLastNetAssetValueSequence(ctx sdk.Context, markerAddr sdk.AccAddress) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NetAssetValueSequenceKey(markerAddr))
+	if len(bz) == 0 {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
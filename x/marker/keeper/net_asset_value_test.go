@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestNetAssetValues(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 7})
+	app.MarkerKeeper.SetParams(ctx, types.DefaultParams())
+	server := keeper.NewMsgServerImpl(app.MarkerKeeper)
+
+	admin := testUserAddress("admin")
+	other := testUserAddress("other")
+	authority := app.MarkerKeeper.GetAuthority()
+	denom := "navcoin"
+
+	mac := types.NewEmptyMarkerAccount(denom, admin.String(), []types.AccessGrant{*types.NewAccessGrant(admin,
+		[]types.Access{types.Access_Admin, types.Access_Mint})})
+	require.NoError(t, mac.SetManager(admin), "SetManager")
+	require.NoError(t, mac.SetSupply(sdk.NewInt64Coin(denom, 1000)), "SetSupply")
+	mac.AllowGovernanceControl = false
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac), "AddMarkerAccount")
+
+	usdNav := types.NewNetAssetValue(sdk.NewInt64Coin("usd", 1500), 10, "fund-admin")
+	hashNav := types.NewNetAssetValue(sdk.NewInt64Coin("nhash", 400), 2, "exchange")
+
+	_, err := server.AddNetAssetValues(sdk.WrapSDKContext(ctx), types.NewMsgAddNetAssetValuesRequest(denom, other.String(), []types.NetAssetValue{usdNav}))
+	assert.EqualError(t, err, other.String()+" does not have admin access for navcoin marker", "AddNetAssetValues without admin access")
+	_, err = server.AddNetAssetValues(sdk.WrapSDKContext(ctx), types.NewMsgAddNetAssetValuesRequest(denom, authority, []types.NetAssetValue{usdNav}))
+	assert.EqualError(t, err, "navcoin marker does not allow governance control", "AddNetAssetValues by authority without governance control")
+	_, err = server.AddNetAssetValues(sdk.WrapSDKContext(ctx), types.NewMsgAddNetAssetValuesRequest("nocoin", admin.String(), []types.NetAssetValue{usdNav}))
+	assert.ErrorContains(t, err, "could not get nocoin marker", "AddNetAssetValues unknown marker")
+
+	_, err = server.AddNetAssetValues(sdk.WrapSDKContext(ctx), types.NewMsgAddNetAssetValuesRequest(denom, admin.String(), []types.NetAssetValue{usdNav, hashNav}))
+	require.NoError(t, err, "AddNetAssetValues")
+	newUsdNav := types.NewNetAssetValue(sdk.NewInt64Coin("usd", 1600), 10, "fund-admin")
+	ctx = ctx.WithBlockHeight(8)
+	_, err = server.AddNetAssetValues(sdk.WrapSDKContext(ctx), types.NewMsgAddNetAssetValuesRequest(denom, admin.String(), []types.NetAssetValue{newUsdNav}))
+	require.NoError(t, err, "AddNetAssetValues update")
+
+	recorded := func(nav types.NetAssetValue, seq uint64, height int64) types.NetAssetValue {
+		nav.Sequence = seq
+		nav.UpdatedBlockHeight = height
+		return nav
+	}
+	expected := []types.NetAssetValue{recorded(usdNav, 1, 7), recorded(hashNav, 2, 7), recorded(newUsdNav, 3, 8)}
+
+	resp, err := app.MarkerKeeper.NetAssetValues(sdk.WrapSDKContext(ctx), &types.QueryNetAssetValuesRequest{Id: denom})
+	require.NoError(t, err, "NetAssetValues")
+	assert.Equal(t, expected, resp.NetAssetValues, "NetAssetValues history")
+
+	resp, err = app.MarkerKeeper.NetAssetValues(sdk.WrapSDKContext(ctx), &types.QueryNetAssetValuesRequest{
+		Id:         types.MustGetMarkerAddress(denom).String(),
+		Pagination: &query.PageRequest{Limit: 1, Reverse: true},
+	})
+	require.NoError(t, err, "NetAssetValues by address reversed")
+	assert.Equal(t, []types.NetAssetValue{expected[2]}, resp.NetAssetValues, "NetAssetValues reversed")
+
+	_, err = app.MarkerKeeper.NetAssetValues(sdk.WrapSDKContext(ctx), &types.QueryNetAssetValuesRequest{Id: "x"})
+	assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid denom or address", "NetAssetValues invalid id")
+
+	// The marker query includes the latest value of each price denom.
+	markerResp, err := app.MarkerKeeper.Marker(sdk.WrapSDKContext(ctx), &types.QueryMarkerRequest{Id: denom})
+	require.NoError(t, err, "Marker")
+	assert.Equal(t, []types.NetAssetValue{expected[1], expected[2]}, markerResp.NetAssetValues, "Marker latest net asset values")
+
+	nav, err := app.MarkerKeeper.GetNetAssetValue(ctx, denom, "usd")
+	require.NoError(t, err, "GetNetAssetValue usd")
+	assert.Equal(t, &expected[2], nav, "GetNetAssetValue usd")
+	nav, err = app.MarkerKeeper.GetNetAssetValue(ctx, denom, "other")
+	require.NoError(t, err, "GetNetAssetValue other")
+	assert.Nil(t, nav, "GetNetAssetValue other")
+
+	// The history survives a genesis export and import.
+	genState := app.MarkerKeeper.ExportGenesis(ctx)
+	require.Len(t, genState.NetAssetValues, 1, "exported net asset values")
+	assert.Equal(t, types.MarkerNetAssetValues{Address: mac.GetAddress().String(), NetAssetValues: expected}, genState.NetAssetValues[0], "exported net asset values")
+
+	app2 := simapp.Setup(t)
+	ctx2 := app2.BaseApp.NewContext(false, tmproto.Header{})
+	app2.MarkerKeeper.InitGenesis(ctx2, genState)
+	markerResp, err = app2.MarkerKeeper.Marker(sdk.WrapSDKContext(ctx2), &types.QueryMarkerRequest{Id: denom})
+	require.NoError(t, err, "Marker after import")
+	assert.Equal(t, []types.NetAssetValue{expected[1], expected[2]}, markerResp.NetAssetValues, "Marker latest net asset values after import")
+}
@@ -12,9 +12,11 @@ import (
 // GetParams returns the total set of distribution parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	return types.Params{
-		MaxTotalSupply:         k.GetMaxTotalSupply(ctx),
-		EnableGovernance:       k.GetEnableGovernance(ctx),
-		UnrestrictedDenomRegex: k.GetUnrestrictedDenomRegex(ctx),
+		MaxTotalSupply:          k.GetMaxTotalSupply(ctx),
+		EnableGovernance:        k.GetEnableGovernance(ctx),
+		UnrestrictedDenomRegex:  k.GetUnrestrictedDenomRegex(ctx),
+		MaxSupplyHistoryEntries: k.GetMaxSupplyHistoryEntries(ctx),
+		ReinstateDelayBlocks:    k.GetReinstateDelayBlocks(ctx),
 	}
 }
 
@@ -54,6 +56,26 @@ func (k Keeper) GetUnrestrictedDenomRegex(ctx sdk.Context) (regex string) {
 	return
 }
 
+// GetMaxSupplyHistoryEntries returns the current parameter value for the number of supply history entries to retain
+// for each marker (or default if unset)
+func (k Keeper) GetMaxSupplyHistoryEntries(ctx sdk.Context) (max uint32) {
+	max = types.DefaultMaxSupplyHistoryEntries
+	if k.paramSpace.Has(ctx, types.ParamStoreKeyMaxSupplyHistoryEntries) {
+		k.paramSpace.Get(ctx, types.ParamStoreKeyMaxSupplyHistoryEntries, &max)
+	}
+	return
+}
+
+// GetReinstateDelayBlocks returns the current parameter value for the number of blocks a cancelled marker waits
+// before it is reinstated (or default if unset)
+func (k Keeper) GetReinstateDelayBlocks(ctx sdk.Context) (delay uint64) {
+	delay = types.DefaultReinstateDelayBlocks
+	if k.paramSpace.Has(ctx, types.ParamStoreKeyReinstateDelayBlocks) {
+		k.paramSpace.Get(ctx, types.ParamStoreKeyReinstateDelayBlocks, &delay)
+	}
+	return
+}
+
 // ValidateUnrestictedDenom checks if the supplied denom is valid based on the module params
 func (k Keeper) ValidateUnrestictedDenom(ctx sdk.Context, denom string) error {
 	// Anchors are enforced on the denom validation expression.  Similar to how the SDK does hits.
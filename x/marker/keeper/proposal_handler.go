@@ -35,6 +35,7 @@ func HandleSupplyIncreaseProposal(ctx sdk.Context, k Keeper, c *types.SupplyIncr
 			return err
 		}
 		k.SetMarker(ctx, m)
+		k.RecordSupplyChange(ctx, c.Amount.Denom, types.SupplyChangeGovIncrease, c.Amount.Amount, total.Amount, k.GetAuthority())
 		logger.Info("marker configured supply increased", "marker", c.Amount.Denom, "amount", c.Amount.Amount.String())
 		return nil
 	} else if m.GetStatus() != types.StatusActive {
@@ -44,6 +45,7 @@ func HandleSupplyIncreaseProposal(ctx sdk.Context, k Keeper, c *types.SupplyIncr
 	if err := k.IncreaseSupply(ctx, m, c.Amount); err != nil {
 		return err
 	}
+	k.RecordSupplyChange(ctx, c.Amount.Denom, types.SupplyChangeGovIncrease, c.Amount.Amount, k.recordedSupply(ctx, m), k.GetAuthority())
 
 	logger.Info("marker total supply increased", "marker", c.Amount.Denom, "amount", c.Amount.Amount.String())
 
@@ -83,6 +85,7 @@ func HandleSupplyDecreaseProposal(ctx sdk.Context, k Keeper, c *types.SupplyDecr
 	if err := k.DecreaseSupply(ctx, m, c.Amount); err != nil {
 		return err
 	}
+	k.RecordSupplyChange(ctx, c.Amount.Denom, types.SupplyChangeGovDecrease, c.Amount.Amount, k.recordedSupply(ctx, m), k.GetAuthority())
 
 	logger := k.Logger(ctx)
 	logger.Info("marker total supply reduced", "marker", c.Amount.Denom, "amount", c.Amount.Amount.String())
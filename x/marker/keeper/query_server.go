@@ -2,10 +2,13 @@ package keeper
 
 import (
 	"context"
+	"strings"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	sdkmath "cosmossdk.io/math"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -66,7 +69,10 @@ func (k Keeper) Marker(c context.Context, req *types.QueryMarkerRequest) (*types
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
-	return &types.QueryMarkerResponse{Marker: anyMsg}, nil
+	return &types.QueryMarkerResponse{
+		Marker:         anyMsg,
+		NetAssetValues: k.GetLatestNetAssetValues(ctx, marker.GetAddress()),
+	}, nil
 }
 
 // Holding query for all accounts holding the given marker coins
@@ -180,3 +186,313 @@ func (k Keeper) AccountData(c context.Context, req *types.QueryAccountDataReques
 
 	return &types.QueryAccountDataResponse{Value: value}, nil
 }
+
+// TransferAllowance query for the transfer limits of a restricted marker and the amounts that can still be transferred
+func (k Keeper) TransferAllowance(c context.Context, req *types.QueryTransferAllowanceRequest) (*types.QueryTransferAllowanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var holderAddr sdk.AccAddress
+	if len(req.Address) > 0 {
+		holderAddr, err = sdk.AccAddressFromBech32(req.Address)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+		}
+	}
+
+	markerAddr := marker.GetAddress()
+	limit, found := k.GetTransferLimit(ctx, markerAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no transfer limit found for %s", marker.GetDenom())
+	}
+
+	resp := &types.QueryTransferAllowanceResponse{
+		Limit:      limit,
+		MarkerUsed: k.GetTransferVolume(ctx, markerAddr, nil),
+	}
+	if limit.HasMarkerLimit() {
+		remaining := sdkmath.MaxInt(limit.MarkerLimit.Sub(resp.MarkerUsed), sdkmath.ZeroInt())
+		resp.MarkerRemaining = &remaining
+	}
+	if len(holderAddr) > 0 {
+		used := k.GetTransferVolume(ctx, markerAddr, holderAddr)
+		resp.HolderUsed = &used
+		if limit.HasHolderLimit() {
+			remaining := sdkmath.MaxInt(limit.HolderLimit.Sub(used), sdkmath.ZeroInt())
+			resp.HolderRemaining = &remaining
+		}
+	}
+	return resp, nil
+}
+
+// SupplyHistory query for the history of changes made to the supply of a marker
+func (k Keeper) SupplyHistory(c context.Context, req *types.QuerySupplyHistoryRequest) (*types.QuerySupplyHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	// The marker might no longer exist, so the history is looked up directly by address or denom.
+	markerAddr, err := sdk.AccAddressFromBech32(req.Id)
+	if err != nil {
+		markerAddr, err = types.MarkerAddress(req.Id)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid denom or address")
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	entries := make([]types.SupplyHistoryEntry, 0)
+	historyStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SupplyHistoryPrefix(markerAddr))
+	pageRes, err := query.Paginate(historyStore, req.Pagination, func(_ []byte, value []byte) error {
+		var entry types.SupplyHistoryEntry
+		if err := k.cdc.Unmarshal(value, &entry); err != nil {
+			return err
+		}
+		entries = append(entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySupplyHistoryResponse{Entries: entries, Pagination: pageRes}, nil
+}
+
+// NetAssetValues query for the net asset value history of a marker
+func (k Keeper) NetAssetValues(c context.Context, req *types.QueryNetAssetValuesRequest) (*types.QueryNetAssetValuesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	markerAddr, err := sdk.AccAddressFromBech32(req.Id)
+	if err != nil {
+		markerAddr, err = types.MarkerAddress(req.Id)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid denom or address")
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	navs := make([]types.NetAssetValue, 0)
+	pageRes, err := query.Paginate(k.netAssetValueHistoryStore(ctx, markerAddr), req.Pagination, func(_ []byte, value []byte) error {
+		var nav types.NetAssetValue
+		if err := k.cdc.Unmarshal(value, &nav); err != nil {
+			return err
+		}
+		navs = append(navs, nav)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryNetAssetValuesResponse{NetAssetValues: navs, Pagination: pageRes}, nil
+}
+
+// MarkersByManager returns the markers managed by an address
+func (k Keeper) MarkersByManager(c context.Context, req *types.QueryMarkersByManagerRequest) (*types.QueryMarkersByManagerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	manager, err := sdk.AccAddressFromBech32(req.Manager)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid manager address: %v", err)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	markers, pageRes, err := k.paginateMarkerIndex(ctx, types.MarkerManagerIndexPrefix(manager), req.Pagination, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryMarkersByManagerResponse{Markers: markers, Pagination: pageRes}, nil
+}
+
+// MarkersByAccessHolder returns the markers that grant access to an address
+func (k Keeper) MarkersByAccessHolder(c context.Context, req *types.QueryMarkersByAccessHolderRequest) (*types.QueryMarkersByAccessHolderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	holder, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+	}
+	if _, known := types.Access_name[int32(req.Access)]; !known {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid access: %d", req.Access)
+	}
+	var filter func(value []byte) bool
+	if req.Access != types.Access_Unknown {
+		filter = func(value []byte) bool {
+			return accessBytesContain(value, req.Access)
+		}
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	markers, pageRes, err := k.paginateMarkerIndex(ctx, types.MarkerAccessIndexPrefix(holder), req.Pagination, filter)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryMarkersByAccessHolderResponse{Markers: markers, Pagination: pageRes}, nil
+}
+
+// MarkersByType returns the markers of a marker type
+func (k Keeper) MarkersByType(c context.Context, req *types.QueryMarkersByTypeRequest) (*types.QueryMarkersByTypeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, known := types.MarkerType_name[int32(req.MarkerType)]; !known || req.MarkerType == types.MarkerType_Unknown {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid marker type: %d", req.MarkerType)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	markers, pageRes, err := k.paginateMarkerIndex(ctx, types.MarkerTypeIndexPrefix(req.MarkerType), req.Pagination, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryMarkersByTypeResponse{Markers: markers, Pagination: pageRes}, nil
+}
+
+// MarkersByForcedTransfer returns the markers that allow (or disallow) forced transfers
+func (k Keeper) MarkersByForcedTransfer(c context.Context, req *types.QueryMarkersByForcedTransferRequest) (*types.QueryMarkersByForcedTransferResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	markers, pageRes, err := k.paginateMarkerIndex(ctx, types.MarkerForcedTransferIndexPrefix(req.AllowForcedTransfer), req.Pagination, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryMarkersByForcedTransferResponse{Markers: markers, Pagination: pageRes}, nil
+}
+
+// MarkersByRequiredAttribute returns the markers that list an attribute in their required attributes
+func (k Keeper) MarkersByRequiredAttribute(c context.Context, req *types.QueryMarkersByRequiredAttributeRequest) (*types.QueryMarkersByRequiredAttributeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	attribute := strings.TrimSpace(req.Attribute)
+	if len(attribute) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "attribute cannot be empty")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	markers, pageRes, err := k.paginateMarkerIndex(ctx, types.MarkerRequiredAttributeIndexPrefix(attribute), req.Pagination, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryMarkersByRequiredAttributeResponse{Markers: markers, Pagination: pageRes}, nil
+}
+
+// paginateMarkerIndex pages through the markers stored under a marker search index prefix. If a filter is
+// provided, only the entries whose value passes the filter are included.
+func (k Keeper) paginateMarkerIndex(ctx sdk.Context, indexPrefix []byte, pageReq *query.PageRequest, filter func(value []byte) bool) ([]*codectypes.Any, *query.PageResponse, error) {
+	markers := make([]*codectypes.Any, 0)
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), indexPrefix)
+	pageRes, err := query.FilteredPaginate(indexStore, pageReq, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		if filter != nil && !filter(value) {
+			return false, nil
+		}
+		if !accumulate {
+			return true, nil
+		}
+		marker, err := k.GetMarker(ctx, types.SplitMarkerSearchIndexKey(key))
+		if err != nil {
+			return false, status.Error(codes.Internal, err.Error())
+		}
+		if marker == nil {
+			return false, nil
+		}
+		anyMsg, err := codectypes.NewAnyWithValue(marker)
+		if err != nil {
+			return false, status.Error(codes.Internal, err.Error())
+		}
+		markers = append(markers, anyMsg)
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return markers, pageRes, nil
+}
+
+// IbcPolicy query for the IBC policy of a restricted marker
+func (k Keeper) IbcPolicy(c context.Context, req *types.QueryIbcPolicyRequest) (*types.QueryIbcPolicyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, found := k.GetIbcPolicy(ctx, marker.GetAddress())
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no ibc policy found for %s", marker.GetDenom())
+	}
+
+	return &types.QueryIbcPolicyResponse{Policy: policy}, nil
+}
+
+// TransferFee query for the transfer fee of a restricted marker
+func (k Keeper) TransferFee(c context.Context, req *types.QueryTransferFeeRequest) (*types.QueryTransferFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	fee, found := k.GetTransferFee(ctx, marker.GetAddress())
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no transfer fee found for %s", marker.GetDenom())
+	}
+
+	return &types.QueryTransferFeeResponse{Fee: fee}, nil
+}
+
+// EscrowHolders query for the markers that hold a denom in escrow
+func (k Keeper) EscrowHolders(c context.Context, req *types.QueryEscrowHoldersRequest) (*types.QueryEscrowHoldersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	denom := strings.TrimSpace(req.Denom)
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	holders := make([]types.EscrowHolder, 0)
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.EscrowHolderIndexPrefix(denom))
+	pageRes, err := query.FilteredPaginate(indexStore, req.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		markerAddr := types.SplitMarkerSearchIndexKey(key)
+		balance := k.bankKeeper.GetBalance(ctx, markerAddr, denom)
+		if !balance.IsPositive() {
+			return false, nil
+		}
+		marker, err := k.GetMarker(ctx, markerAddr)
+		if err != nil {
+			return false, status.Error(codes.Internal, err.Error())
+		}
+		if marker == nil {
+			return false, nil
+		}
+		if !accumulate {
+			return true, nil
+		}
+		holders = append(holders, types.EscrowHolder{
+			MarkerAddress: markerAddr.String(),
+			MarkerDenom:   marker.GetDenom(),
+			Amount:        balance,
+		})
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEscrowHoldersResponse{Holders: holders, Pagination: pageRes}, nil
+}
@@ -0,0 +1,201 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// GetPendingReinstatement returns the pending reinstatement of a cancelled marker and whether one was found.
+func (k Keeper) GetPendingReinstatement(ctx sdk.Context, markerAddr sdk.AccAddress) (types.PendingReinstatement, bool) {
+	var reinstatement types.PendingReinstatement
+	bz := ctx.KVStore(k.storeKey).Get(types.PendingReinstatementKey(markerAddr))
+	if len(bz) == 0 {
+		return reinstatement, false
+	}
+	k.cdc.MustUnmarshal(bz, &reinstatement)
+	return reinstatement, true
+}
+
+// SetPendingReinstatement stores the pending reinstatement of a cancelled marker and indexes it by reinstate height.
+func (k Keeper) SetPendingReinstatement(ctx sdk.Context, reinstatement types.PendingReinstatement) {
+	markerAddr := types.MustGetMarkerAddress(reinstatement.Denom)
+	k.RemovePendingReinstatement(ctx, markerAddr)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PendingReinstatementKey(markerAddr), k.cdc.MustMarshal(&reinstatement))
+	store.Set(types.PendingReinstatementHeightIndexKey(reinstatement.ReinstateHeight, markerAddr), []byte{})
+}
+
+// RemovePendingReinstatement removes the pending reinstatement of a marker and its reinstate height index entry.
+func (k Keeper) RemovePendingReinstatement(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	existing, found := k.GetPendingReinstatement(ctx, markerAddr)
+	if !found {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PendingReinstatementHeightIndexKey(existing.ReinstateHeight, markerAddr))
+	store.Delete(types.PendingReinstatementKey(markerAddr))
+}
+
+// IteratePendingReinstatements iterates over all the cancelled markers that are waiting to be reinstated.
+func (k Keeper) IteratePendingReinstatements(ctx sdk.Context, cb func(reinstatement types.PendingReinstatement) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PendingReinstatementKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var reinstatement types.PendingReinstatement
+		k.cdc.MustUnmarshal(iterator.Value(), &reinstatement)
+		if cb(reinstatement) {
+			break
+		}
+	}
+}
+
+// ReinstateMarker schedules a cancelled marker to be returned to the proposed or finalized status once the
+// reinstate delay has passed. The marker is managed by the provided manager once it is reinstated; if no manager
+// is provided, the marker's current manager is kept.
+func (k Keeper) ReinstateMarker(
+	ctx sdk.Context,
+	administrator string,
+	denom string,
+	status types.MarkerStatus,
+	manager string,
+) (types.PendingReinstatement, error) {
+	m, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return types.PendingReinstatement{}, fmt.Errorf("marker not found for %s: %w", denom, err)
+	}
+	if m.GetStatus() != types.StatusCancelled {
+		return types.PendingReinstatement{}, fmt.Errorf("can only reinstate markeraccounts in the Cancelled status")
+	}
+	if _, found := k.GetPendingReinstatement(ctx, m.GetAddress()); found {
+		return types.PendingReinstatement{}, fmt.Errorf("%s marker is already waiting to be reinstated", denom)
+	}
+	if len(manager) == 0 {
+		manager = m.GetManager().String()
+	}
+	if len(manager) == 0 {
+		return types.PendingReinstatement{}, fmt.Errorf("a manager is required to reinstate %s marker", denom)
+	}
+
+	delay := k.GetReinstateDelayBlocks(ctx)
+	reinstatement := types.NewPendingReinstatement(denom, status, manager, ctx.BlockHeight()+int64(delay))
+	if err = reinstatement.Validate(); err != nil {
+		return types.PendingReinstatement{}, err
+	}
+	// Make sure the marker can be reinstated now so that problems are reported to the requester.
+	// The marker is a freshly loaded copy, so the changes made to it here are not saved.
+	if err = applyReinstatement(m, reinstatement); err != nil {
+		return types.PendingReinstatement{}, err
+	}
+
+	k.SetPendingReinstatement(ctx, reinstatement)
+
+	return reinstatement, ctx.EventManager().EmitTypedEvent(types.NewEventMarkerReinstate(reinstatement, administrator))
+}
+
+// ProcessPendingReinstatements reinstates the cancelled markers whose reinstate delay has passed. Only the entries
+// of the reinstate height index up to the current block height are read. A marker that can no longer be reinstated
+// (e.g. because it was deleted in the meantime) is left as it is.
+func (k Keeper) ProcessPendingReinstatements(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.PendingReinstatementHeightIndexKeyPrefix, types.PendingReinstatementHeightIndexPrefix(ctx.BlockHeight()+1))
+	var due []sdk.AccAddress
+	for ; iterator.Valid(); iterator.Next() {
+		_, markerAddr := types.SplitPendingReinstatementHeightIndexKey(iterator.Key())
+		due = append(due, markerAddr)
+	}
+	iterator.Close()
+
+	for _, markerAddr := range due {
+		reinstatement, found := k.GetPendingReinstatement(ctx, markerAddr)
+		if !found {
+			continue
+		}
+		k.RemovePendingReinstatement(ctx, markerAddr)
+		if err := k.completeReinstatement(ctx, markerAddr, reinstatement); err != nil {
+			ctx.Logger().Error("unable to reinstate marker", "denom", reinstatement.Denom, "err", err)
+		}
+	}
+}
+
+// completeReinstatement returns a cancelled marker to the status of its pending reinstatement.
+func (k Keeper) completeReinstatement(ctx sdk.Context, markerAddr sdk.AccAddress, reinstatement types.PendingReinstatement) error {
+	m, err := k.GetMarker(ctx, markerAddr)
+	if err != nil {
+		return err
+	}
+	if m == nil {
+		return fmt.Errorf("marker not found for %s", reinstatement.Denom)
+	}
+	if err = applyReinstatement(m, reinstatement); err != nil {
+		return err
+	}
+	k.SetMarker(ctx, m)
+
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerReinstated(reinstatement))
+}
+
+// applyReinstatement updates a cancelled marker to the status and manager of the reinstatement.
+func applyReinstatement(m types.MarkerAccountI, reinstatement types.PendingReinstatement) error {
+	if m.GetStatus() != types.StatusCancelled {
+		return fmt.Errorf("can only reinstate markeraccounts in the Cancelled status")
+	}
+	ma, ok := m.(*types.MarkerAccount)
+	if !ok {
+		return fmt.Errorf("unable to reinstate marker of type %T", m)
+	}
+	manager, err := sdk.AccAddressFromBech32(reinstatement.Manager)
+	if err != nil {
+		return fmt.Errorf("invalid manager: %w", err)
+	}
+
+	// The manager can only be set on proposed markers, so the marker passes through the proposed status.
+	if err = ma.SetStatus(types.StatusProposed); err != nil {
+		return fmt.Errorf("could not update marker status: %w", err)
+	}
+	if err = ma.SetManager(manager); err != nil {
+		return fmt.Errorf("could not update marker manager: %w", err)
+	}
+	if err = ma.SetStatus(reinstatement.Status); err != nil {
+		return fmt.Errorf("could not update marker status: %w", err)
+	}
+	if err = ma.Validate(); err != nil {
+		return fmt.Errorf("invalid marker, cannot be reinstated: %w", err)
+	}
+	return nil
+}
+
+// ReclaimEscrow sends all coins held in a cancelled marker's escrow, other than the marker's own denom, to the
+// provided address. This recovers coins that were sent to the marker account so that the marker can be deleted.
+func (k Keeper) ReclaimEscrow(ctx sdk.Context, administrator string, denom string, to sdk.AccAddress) (sdk.Coins, error) {
+	m, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return nil, fmt.Errorf("marker not found for %s: %w", denom, err)
+	}
+	if m.GetStatus() != types.StatusCancelled {
+		return nil, fmt.Errorf("can only reclaim escrow from markeraccounts in the Cancelled status")
+	}
+	if k.bankKeeper.BlockedAddr(to) {
+		return nil, fmt.Errorf("%s is not allowed to receive funds", to)
+	}
+
+	var reclaimed sdk.Coins
+	for _, coin := range k.bankKeeper.GetAllBalances(ctx, m.GetAddress()) {
+		if coin.Denom != denom {
+			reclaimed = append(reclaimed, coin)
+		}
+	}
+	if reclaimed.IsZero() {
+		return nil, fmt.Errorf("%s marker does not have any escrow to reclaim", denom)
+	}
+
+	if err = k.bankKeeper.SendCoins(types.WithBypass(ctx), m.GetAddress(), to, reclaimed); err != nil {
+		return nil, fmt.Errorf("could not reclaim escrow of %s marker: %w", denom, err)
+	}
+
+	return reclaimed, ctx.EventManager().EmitTypedEvent(types.NewEventMarkerReclaimEscrow(denom, to.String(), reclaimed, administrator))
+}
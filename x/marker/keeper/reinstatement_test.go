@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestReinstateMarkerAndReclaimEscrow(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+	server := markerkeeper.NewMsgServerImpl(app.MarkerKeeper)
+
+	params := app.MarkerKeeper.GetParams(ctx)
+	params.ReinstateDelayBlocks = 5
+	app.MarkerKeeper.SetParams(ctx, params)
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	admin := testUserAddress("admin")
+	other := testUserAddress("other")
+	newManager := testUserAddress("newManager")
+
+	denom := "reinstatecoin"
+	markerAddr := types.MustGetMarkerAddress(denom)
+	_, err := server.AddMarker(sdk.WrapSDKContext(ctx), types.NewMsgAddMarkerRequest(denom, sdk.NewInt(100), admin, admin, types.MarkerType_Coin, true, false, false, []string{}))
+	require.NoError(t, err, "AddMarker")
+	_, err = server.AddAccess(sdk.WrapSDKContext(ctx), types.NewMsgAddAccessRequest(denom, admin, *types.NewAccessGrant(admin, []types.Access{types.Access_Delete, types.Access_Mint})))
+	require.NoError(t, err, "AddAccess")
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, admin, denom), "FinalizeMarker")
+	require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, admin, denom), "ActivateMarker")
+
+	// Coins sent to the marker account by mistake.
+	stray := sdk.NewCoins(sdk.NewInt64Coin("straycoin", 25), sdk.NewInt64Coin("otherstray", 3))
+	require.NoError(t, testutil.FundAccount(app.BankKeeper, ctx, markerAddr, stray), "FundAccount")
+
+	_, err = server.ReinstateMarker(sdk.WrapSDKContext(ctx), types.NewMsgReinstateMarkerRequest(denom, types.StatusProposed, "", admin.String()))
+	assert.EqualError(t, err, "can only reinstate markeraccounts in the Cancelled status", "ReinstateMarker active marker")
+	_, err = server.ReclaimEscrow(sdk.WrapSDKContext(ctx), types.NewMsgReclaimEscrowRequest(denom, admin.String(), admin.String()))
+	assert.EqualError(t, err, "can only reclaim escrow from markeraccounts in the Cancelled status", "ReclaimEscrow active marker")
+
+	require.NoError(t, app.MarkerKeeper.CancelMarker(ctx, admin, denom), "CancelMarker")
+
+	// Reclaiming the escrow of a cancelled marker.
+	_, err = server.ReclaimEscrow(sdk.WrapSDKContext(ctx), types.NewMsgReclaimEscrowRequest(denom, other.String(), other.String()))
+	assert.EqualError(t, err, other.String()+" does not have ACCESS_DELETE on reinstatecoin markeraccount", "ReclaimEscrow without access")
+
+	resp, err := server.ReclaimEscrow(sdk.WrapSDKContext(ctx), types.NewMsgReclaimEscrowRequest(denom, other.String(), authority.String()))
+	require.NoError(t, err, "ReclaimEscrow by gov")
+	assert.Equal(t, stray.String(), resp.Amount.String(), "ReclaimEscrow reclaimed amount")
+	assert.Equal(t, stray.String(), app.BankKeeper.GetAllBalances(ctx, other).String(), "balance of reclaim recipient")
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denom, 100)).String(), app.BankKeeper.GetAllBalances(ctx, markerAddr).String(), "marker balance after ReclaimEscrow")
+
+	_, err = server.ReclaimEscrow(sdk.WrapSDKContext(ctx), types.NewMsgReclaimEscrowRequest(denom, other.String(), admin.String()))
+	assert.EqualError(t, err, "reinstatecoin marker does not have any escrow to reclaim", "ReclaimEscrow nothing left")
+
+	// Reinstating the cancelled marker.
+	_, err = server.ReinstateMarker(sdk.WrapSDKContext(ctx), types.NewMsgReinstateMarkerRequest(denom, types.StatusProposed, "", other.String()))
+	assert.EqualError(t, err, other.String()+" does not have ACCESS_DELETE on reinstatecoin markeraccount", "ReinstateMarker without access")
+	_, err = server.ReinstateMarker(sdk.WrapSDKContext(ctx), types.NewMsgReinstateMarkerRequest(denom, types.StatusProposed, "", admin.String()))
+	assert.EqualError(t, err, "a manager is required to reinstate reinstatecoin marker", "ReinstateMarker without a manager")
+
+	reinstateResp, err := server.ReinstateMarker(sdk.WrapSDKContext(ctx), types.NewMsgReinstateMarkerRequest(denom, types.StatusFinalized, newManager.String(), admin.String()))
+	require.NoError(t, err, "ReinstateMarker")
+	assert.Equal(t, int64(15), reinstateResp.ReinstateHeight, "ReinstateMarker reinstate height")
+
+	_, err = server.ReinstateMarker(sdk.WrapSDKContext(ctx), types.NewMsgReinstateMarkerRequest(denom, types.StatusProposed, newManager.String(), admin.String()))
+	assert.EqualError(t, err, "reinstatecoin marker is already waiting to be reinstated", "ReinstateMarker twice")
+
+	pending, found := app.MarkerKeeper.GetPendingReinstatement(ctx, markerAddr)
+	require.True(t, found, "GetPendingReinstatement found")
+	assert.Equal(t, types.NewPendingReinstatement(denom, types.StatusFinalized, newManager.String(), 15), pending, "pending reinstatement")
+	genesis := app.MarkerKeeper.ExportGenesis(ctx)
+	assert.Equal(t, []types.PendingReinstatement{pending}, genesis.PendingReinstatements, "exported pending reinstatements")
+
+	// The marker stays cancelled until the delay has passed.
+	ctx = ctx.WithBlockHeight(14)
+	app.MarkerKeeper.ProcessPendingReinstatements(ctx)
+	m, err := app.MarkerKeeper.GetMarker(ctx, markerAddr)
+	require.NoError(t, err, "GetMarker")
+	assert.Equal(t, types.StatusCancelled, m.GetStatus(), "marker status before the reinstate height")
+
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	assert.True(t, store.Has(types.PendingReinstatementHeightIndexKey(15, markerAddr)), "reinstate height index entry before the reinstate height")
+
+	ctx = ctx.WithBlockHeight(15)
+	app.MarkerKeeper.ProcessPendingReinstatements(ctx)
+	m, err = app.MarkerKeeper.GetMarker(ctx, markerAddr)
+	require.NoError(t, err, "GetMarker")
+	assert.Equal(t, types.StatusFinalized, m.GetStatus(), "marker status after the reinstate height")
+	assert.Equal(t, newManager, m.GetManager(), "marker manager after the reinstate height")
+	_, found = app.MarkerKeeper.GetPendingReinstatement(ctx, markerAddr)
+	assert.False(t, found, "GetPendingReinstatement found after reinstatement")
+	assert.False(t, store.Has(types.PendingReinstatementHeightIndexKey(15, markerAddr)), "reinstate height index entry after reinstatement")
+
+	// The new manager can bring the marker back to active, and the existing supply is kept.
+	require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, newManager, denom), "ActivateMarker after reinstatement")
+	assert.Equal(t, sdk.NewInt64Coin(denom, 100), app.BankKeeper.GetSupply(ctx, denom), "supply after reactivation")
+
+	// A pending reinstatement is dropped when the marker is deleted.
+	require.NoError(t, app.MarkerKeeper.CancelMarker(ctx, admin, denom), "CancelMarker again")
+	_, err = server.ReinstateMarker(sdk.WrapSDKContext(ctx), types.NewMsgReinstateMarkerRequest(denom, types.StatusProposed, newManager.String(), admin.String()))
+	require.NoError(t, err, "ReinstateMarker again")
+	require.NoError(t, app.MarkerKeeper.DeleteMarker(ctx, admin, denom), "DeleteMarker")
+	_, found = app.MarkerKeeper.GetPendingReinstatement(ctx, markerAddr)
+	assert.False(t, found, "GetPendingReinstatement found after DeleteMarker")
+	assert.False(t, store.Has(types.PendingReinstatementHeightIndexKey(20, markerAddr)), "reinstate height index entry after DeleteMarker")
+}
@@ -16,6 +16,10 @@ const AddressHasAccessKey = "address_has_access"
 var _ banktypes.SendRestrictionFn = Keeper{}.SendRestrictionFn
 
 func (k Keeper) SendRestrictionFn(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	// The escrow holder index and dirty markers are kept up to date for every send, including the ones that bypass the restrictions.
+	k.updateEscrowHolderIndex(ctx, fromAddr, toAddr, amt)
+	k.markEscrowChangeDirty(ctx, fromAddr, toAddr, amt)
+
 	// In some cases, it might not be possible to add a bypass to the context.
 	// If it's from either the Marker or IBC Transfer module accounts, assume proper validation has been done elsewhere.
 	if types.HasBypass(ctx) || fromAddr.Equals(k.markerModuleAddr) || fromAddr.Equals(k.ibcTransferModuleAddr) {
@@ -26,6 +30,12 @@ func (k Keeper) SendRestrictionFn(ctx sdk.Context, fromAddr, toAddr sdk.AccAddre
 		if err := k.validateSendDenom(ctx, fromAddr, toAddr, coin.Denom); err != nil {
 			return nil, err
 		}
+		if err := k.applyTransferLimits(ctx, fromAddr, coin); err != nil {
+			return nil, err
+		}
+		if err := k.applyTransferFee(ctx, fromAddr, toAddr, coin); err != nil {
+			return nil, err
+		}
 	}
 
 	return toAddr, nil
@@ -94,6 +104,11 @@ func (k Keeper) validateSendDenom(ctx sdk.Context, fromAddr, toAddr sdk.AccAddre
 		return nil
 	}
 
+	return k.validateRequiredAttributes(ctx, denom, reqAttr, toAddr)
+}
+
+// validateRequiredAttributes returns an error if the toAddr does not have all of the required attributes.
+func (k Keeper) validateRequiredAttributes(ctx sdk.Context, denom string, reqAttr []string, toAddr sdk.AccAddress) error {
 	attributes, err := k.attrKeeper.GetAllAttributesAddr(ctx, toAddr)
 	if err != nil {
 		return fmt.Errorf("could not get attributes for %s: %w", toAddr.String(), err)
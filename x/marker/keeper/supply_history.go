@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// RecordSupplyChange adds an entry to the supply history of a marker and prunes the oldest entries beyond the
+// number that are to be retained. Nothing is recorded if the supply history is disabled.
+func (k Keeper) RecordSupplyChange(ctx sdk.Context, denom string, changeType types.SupplyChangeType, amount, supply sdkmath.Int, authority string) {
+	maxEntries := uint64(k.GetMaxSupplyHistoryEntries(ctx))
+	if maxEntries == 0 {
+		return
+	}
+
+	markerAddr := types.MustGetMarkerAddress(denom)
+	entry := types.NewSupplyHistoryEntry(denom, changeType, amount, supply, authority, ctx.BlockHeight(), ctx.BlockTime())
+	entry.Sequence = k.getLastSupplyHistorySequence(ctx, markerAddr) + 1
+	k.setSupplyHistoryEntry(ctx, entry)
+
+	if entry.Sequence > maxEntries {
+		k.PruneSupplyHistory(ctx, markerAddr, entry.Sequence-maxEntries)
+	}
+}
+
+// PruneSupplyHistory deletes the supply history entries of a marker with a sequence less than or equal to the one provided.
+func (k Keeper) PruneSupplyHistory(ctx sdk.Context, markerAddr sdk.AccAddress, throughSequence uint64) {
+	historyStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SupplyHistoryPrefix(markerAddr))
+	iterator := historyStore.Iterator(nil, sdk.Uint64ToBigEndian(throughSequence+1))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		historyStore.Delete(key)
+	}
+}
+
+// IterateSupplyHistory iterates over the retained supply history entries of all markers.
+func (k Keeper) IterateSupplyHistory(ctx sdk.Context, cb func(entry types.SupplyHistoryEntry) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.SupplyHistoryKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.SupplyHistoryEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &entry)
+		if cb(entry) {
+			break
+		}
+	}
+}
+
+// setSupplyHistoryEntry stores a supply history entry and updates the last sequence used for the marker if needed.
+func (k Keeper) setSupplyHistoryEntry(ctx sdk.Context, entry types.SupplyHistoryEntry) {
+	markerAddr := types.MustGetMarkerAddress(entry.Denom)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.SupplyHistoryKey(markerAddr, entry.Sequence), k.cdc.MustMarshal(&entry))
+	if entry.Sequence > k.getLastSupplyHistorySequence(ctx, markerAddr) {
+		store.Set(types.SupplyHistorySequenceKey(markerAddr), sdk.Uint64ToBigEndian(entry.Sequence))
+	}
+}
+
+// getLastSupplyHistorySequence returns the last sequence used in the supply history of a marker.
+func (k Keeper) getLastSupplyHistorySequence(ctx sdk.Context, markerAddr sdk.AccAddress) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.SupplyHistorySequenceKey(markerAddr))
+	if len(bz) == 0 {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// recordedSupply returns the supply to record in the supply history of a marker. The configured supply is used for
+// markers that have not been activated yet since their coin has not been minted.
+func (k Keeper) recordedSupply(ctx sdk.Context, marker types.MarkerAccountI) sdkmath.Int {
+	if marker.GetStatus() == types.StatusProposed || marker.GetStatus() == types.StatusFinalized {
+		return marker.GetSupply().Amount
+	}
+	return k.CurrentCirculation(ctx, marker)
+}
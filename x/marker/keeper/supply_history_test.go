@@ -0,0 +1,201 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestSupplyHistory(t *testing.T) {
+	app := simapp.Setup(t)
+	blockTime := time.Now().UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 5, Time: blockTime})
+	app.MarkerKeeper.SetParams(ctx, types.DefaultParams())
+
+	user := testUserAddress("test")
+	authority := app.MarkerKeeper.GetAuthority()
+	denom := "historycoin"
+
+	mac := types.NewEmptyMarkerAccount(denom, user.String(), []types.AccessGrant{*types.NewAccessGrant(user,
+		[]types.Access{types.Access_Mint, types.Access_Burn, types.Access_Withdraw, types.Access_Delete})})
+	require.NoError(t, mac.SetManager(user), "SetManager")
+	require.NoError(t, mac.SetSupply(sdk.NewInt64Coin(denom, 1000)), "SetSupply")
+	mac.AllowGovernanceControl = true
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac), "AddMarkerAccount")
+
+	entry := func(seq uint64, changeType types.SupplyChangeType, amount, supply int64, auth string) types.SupplyHistoryEntry {
+		e := types.NewSupplyHistoryEntry(denom, changeType, sdk.NewInt(amount), sdk.NewInt(supply), auth, 5, blockTime)
+		e.Sequence = seq
+		return e
+	}
+	newEntry := func(seq uint64, changeType types.SupplyChangeType, amount, supply int64, auth string) *types.SupplyHistoryEntry {
+		e := entry(seq, changeType, amount, supply, auth)
+		return &e
+	}
+	getHistory := func() []types.SupplyHistoryEntry {
+		resp, err := app.MarkerKeeper.SupplyHistory(sdk.WrapSDKContext(ctx), &types.QuerySupplyHistoryRequest{Id: denom})
+		require.NoError(t, err, "SupplyHistory")
+		return resp.Entries
+	}
+
+	// The changes are made in order, each one adding to the history recorded by the previous ones.
+	var history []types.SupplyHistoryEntry
+	changes := []struct {
+		name     string
+		change   func() error
+		expErr   bool
+		expEntry *types.SupplyHistoryEntry
+	}{
+		{
+			name: "mint while proposed is recorded with the configured supply",
+			change: func() error {
+				return app.MarkerKeeper.MintCoin(ctx, user, sdk.NewInt64Coin(denom, 100))
+			},
+			expEntry: newEntry(1, types.SupplyChangeMint, 100, 1100, user.String()),
+		},
+		{
+			name: "finalize and activate are not recorded",
+			change: func() error {
+				if err := app.MarkerKeeper.FinalizeMarker(ctx, user, denom); err != nil {
+					return err
+				}
+				return app.MarkerKeeper.ActivateMarker(ctx, user, denom)
+			},
+		},
+		{
+			name: "mint while active",
+			change: func() error {
+				return app.MarkerKeeper.MintCoin(ctx, user, sdk.NewInt64Coin(denom, 50))
+			},
+			expEntry: newEntry(2, types.SupplyChangeMint, 50, 1150, user.String()),
+		},
+		{
+			name: "burn while active",
+			change: func() error {
+				return app.MarkerKeeper.BurnCoin(ctx, user, sdk.NewInt64Coin(denom, 20))
+			},
+			expEntry: newEntry(3, types.SupplyChangeBurn, 20, 1130, user.String()),
+		},
+		{
+			name: "supply increase proposal",
+			change: func() error {
+				return keeper.HandleSupplyIncreaseProposal(ctx, app.MarkerKeeper, &types.SupplyIncreaseProposal{Amount: sdk.NewInt64Coin(denom, 300)})
+			},
+			expEntry: newEntry(4, types.SupplyChangeGovIncrease, 300, 1430, authority),
+		},
+		{
+			name: "supply decrease proposal",
+			change: func() error {
+				return keeper.HandleSupplyDecreaseProposal(ctx, app.MarkerKeeper, &types.SupplyDecreaseProposal{Amount: sdk.NewInt64Coin(denom, 30)})
+			},
+			expEntry: newEntry(5, types.SupplyChangeGovDecrease, 30, 1400, authority),
+		},
+		{
+			name: "failed burn is not recorded",
+			change: func() error {
+				return app.MarkerKeeper.BurnCoin(ctx, user, sdk.NewInt64Coin(denom, 100000))
+			},
+			expErr: true,
+		},
+	}
+
+	for _, tc := range changes {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.change()
+			if tc.expErr {
+				require.Error(t, err, "change")
+			} else {
+				require.NoError(t, err, "change")
+			}
+			if tc.expEntry != nil {
+				history = append(history, *tc.expEntry)
+			}
+			assert.Equal(t, history, getHistory(), "SupplyHistory entries")
+		})
+	}
+
+	queries := []struct {
+		name       string
+		req        *types.QuerySupplyHistoryRequest
+		expErr     string
+		expEntries []types.SupplyHistoryEntry
+		expNextKey bool
+	}{
+		{
+			name:       "by denom",
+			req:        &types.QuerySupplyHistoryRequest{Id: denom},
+			expEntries: history,
+		},
+		{
+			name: "by address reversed with a limit",
+			req: &types.QuerySupplyHistoryRequest{
+				Id:         types.MustGetMarkerAddress(denom).String(),
+				Pagination: &query.PageRequest{Limit: 2, Reverse: true},
+			},
+			expEntries: []types.SupplyHistoryEntry{history[4], history[3]},
+			expNextKey: true,
+		},
+		{
+			name:   "invalid id",
+			req:    &types.QuerySupplyHistoryRequest{Id: "x"},
+			expErr: "rpc error: code = InvalidArgument desc = invalid denom or address",
+		},
+	}
+
+	for _, tc := range queries {
+		t.Run("query "+tc.name, func(t *testing.T) {
+			resp, err := app.MarkerKeeper.SupplyHistory(sdk.WrapSDKContext(ctx), tc.req)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "SupplyHistory")
+				return
+			}
+			require.NoError(t, err, "SupplyHistory")
+			assert.Equal(t, tc.expEntries, resp.Entries, "SupplyHistory entries")
+			assert.Equal(t, tc.expNextKey, len(resp.Pagination.NextKey) > 0, "SupplyHistory has next key")
+		})
+	}
+
+	// Each retention case mints 10 more coin after setting the max number of entries.
+	retention := []struct {
+		name       string
+		maxEntries uint32
+		expEntries []types.SupplyHistoryEntry
+	}{
+		{
+			name:       "lowering the max prunes the oldest entries",
+			maxEntries: 2,
+			expEntries: []types.SupplyHistoryEntry{history[4], entry(6, types.SupplyChangeMint, 10, 1410, user.String())},
+		},
+		{
+			name:       "a max of zero disables the history",
+			maxEntries: 0,
+			expEntries: []types.SupplyHistoryEntry{history[4], entry(6, types.SupplyChangeMint, 10, 1410, user.String())},
+		},
+	}
+
+	for _, tc := range retention {
+		t.Run(tc.name, func(t *testing.T) {
+			params := app.MarkerKeeper.GetParams(ctx)
+			params.MaxSupplyHistoryEntries = tc.maxEntries
+			app.MarkerKeeper.SetParams(ctx, params)
+			require.NoError(t, app.MarkerKeeper.MintCoin(ctx, user, sdk.NewInt64Coin(denom, 10)), "MintCoin")
+			assert.Equal(t, tc.expEntries, getHistory(), "SupplyHistory entries")
+		})
+	}
+
+	t.Run("retained history is exported", func(t *testing.T) {
+		genState := app.MarkerKeeper.ExportGenesis(ctx)
+		assert.Equal(t, getHistory(), genState.SupplyHistory, "exported supply history")
+	})
+}
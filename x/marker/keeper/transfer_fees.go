@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// GetTransferFee returns the transfer fee configured on a marker and whether one was found.
+func (k Keeper) GetTransferFee(ctx sdk.Context, markerAddr sdk.AccAddress) (types.TransferFee, bool) {
+	var fee types.TransferFee
+	bz := ctx.KVStore(k.storeKey).Get(types.TransferFeeKey(markerAddr))
+	if len(bz) == 0 {
+		return fee, false
+	}
+	k.cdc.MustUnmarshal(bz, &fee)
+	return fee, true
+}
+
+// SetTransferFee stores the transfer fee of a marker. If the fee does not have any basis points or flat fees,
+// the transfer fee is removed from the marker instead.
+func (k Keeper) SetTransferFee(ctx sdk.Context, fee types.TransferFee) {
+	markerAddr := types.MustGetMarkerAddress(fee.Denom)
+	if !fee.HasFees() {
+		k.RemoveTransferFee(ctx, markerAddr)
+		return
+	}
+	ctx.KVStore(k.storeKey).Set(types.TransferFeeKey(markerAddr), k.cdc.MustMarshal(&fee))
+}
+
+// RemoveTransferFee removes the transfer fee of a marker.
+func (k Keeper) RemoveTransferFee(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.TransferFeeKey(markerAddr))
+}
+
+// IterateTransferFees iterates over all the transfer fees configured on markers.
+func (k Keeper) IterateTransferFees(ctx sdk.Context, cb func(fee types.TransferFee) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.TransferFeeKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var fee types.TransferFee
+		k.cdc.MustUnmarshal(iterator.Value(), &fee)
+		if cb(fee) {
+			break
+		}
+	}
+}
+
+// CalculateTransferFee returns the fees owed for a transfer of the given coin between the provided addresses
+// along with the address they are paid to. No fees are owed if the marker does not have a transfer fee, or if
+// either address is the marker, the fee recipient, or one of the bypass (module) accounts.
+func (k Keeper) CalculateTransferFee(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, coin sdk.Coin) (sdk.Coins, sdk.AccAddress, error) {
+	if !coin.Amount.IsPositive() {
+		return nil, nil, nil
+	}
+	markerAddr, err := types.MarkerAddress(coin.Denom)
+	if err != nil {
+		return nil, nil, err
+	}
+	fee, found := k.GetTransferFee(ctx, markerAddr)
+	if !found {
+		return nil, nil, nil
+	}
+	recipient, err := sdk.AccAddressFromBech32(fee.Recipient)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid transfer fee recipient for %s: %w", coin.Denom, err)
+	}
+
+	for _, addr := range []sdk.AccAddress{fromAddr, toAddr} {
+		if addr.Equals(markerAddr) || addr.Equals(recipient) || k.IsReqAttrBypassAddr(addr) {
+			return nil, nil, nil
+		}
+	}
+
+	return fee.Calculate(coin.Amount), recipient, nil
+}
+
+// applyTransferFee charges the holder the transfer fee owed for a transfer of the given coin to the toAddr.
+// The fees are sent directly to the recipient of the marker's transfer fee.
+func (k Keeper) applyTransferFee(ctx sdk.Context, holderAddr, toAddr sdk.AccAddress, coin sdk.Coin) error {
+	fees, recipient, err := k.CalculateTransferFee(ctx, holderAddr, toAddr, coin)
+	if err != nil || fees.IsZero() {
+		return err
+	}
+	if err = k.bankKeeper.SendCoins(types.WithBypass(ctx), holderAddr, recipient, fees); err != nil {
+		return fmt.Errorf("could not pay %s transfer fee of %s: %w", coin.Denom, fees, err)
+	}
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerTransferFee(coin.Denom, holderAddr.String(), recipient.String(), fees))
+}
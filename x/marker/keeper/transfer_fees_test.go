@@ -0,0 +1,212 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestSetTransferFee(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	server := markerkeeper.NewMsgServerImpl(app.MarkerKeeper)
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	authUser := testUserAddress("test")
+	notAuthUser := testUserAddress("test1")
+	issuer := testUserAddress("issuer").String()
+
+	notRestrictedMarker := types.NewEmptyMarkerAccount(
+		"not-restricted-marker",
+		authUser.String(),
+		[]types.AccessGrant{})
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, notRestrictedMarker))
+
+	rMarkerDenom := "restricted-marker"
+	rMarkerAcct := authtypes.NewBaseAccount(types.MustGetMarkerAddress(rMarkerDenom), nil, 0, 0)
+	app.MarkerKeeper.SetMarker(ctx, types.NewMarkerAccount(rMarkerAcct, sdk.NewInt64Coin(rMarkerDenom, 1000), authUser, []types.AccessGrant{{Address: authUser.String(), Permissions: []types.Access{types.Access_Admin}}}, types.StatusFinalized, types.MarkerType_RestrictedCoin, true, false, false, []string{}))
+
+	rMarkerGovDenom := "restricted-marker-gov"
+	rMarkerGovAcct := authtypes.NewBaseAccount(types.MustGetMarkerAddress(rMarkerGovDenom), nil, 0, 0)
+	app.MarkerKeeper.SetMarker(ctx, types.NewMarkerAccount(rMarkerGovAcct, sdk.NewInt64Coin(rMarkerGovDenom, 1000), authUser, []types.AccessGrant{{Address: authUser.String(), Permissions: []types.Access{}}}, types.StatusFinalized, types.MarkerType_RestrictedCoin, true, true, false, []string{}))
+
+	flatFees := sdk.NewCoins(sdk.NewInt64Coin("nhash", 100))
+
+	testCases := []struct {
+		name   string
+		msg    types.MsgSetTransferFeeRequest
+		expErr string
+		expFee *types.TransferFee
+	}{
+		{
+			name:   "should fail, cannot find marker",
+			msg:    *types.NewMsgSetTransferFeeRequest("blah", issuer, 25, nil, authUser.String()),
+			expErr: "marker not found for blah: marker blah not found for address: cosmos1psw3a97ywtr595qa4295lw07cz9665hynnfpee",
+		},
+		{
+			name:   "should fail, not a restricted marker",
+			msg:    *types.NewMsgSetTransferFeeRequest(notRestrictedMarker.Denom, issuer, 25, nil, authUser.String()),
+			expErr: "marker not-restricted-marker is not a restricted marker",
+		},
+		{
+			name:   "should fail, signer does not have admin access",
+			msg:    *types.NewMsgSetTransferFeeRequest(rMarkerDenom, issuer, 25, nil, notAuthUser.String()),
+			expErr: notAuthUser.String() + " does not have admin access for restricted-marker marker",
+		},
+		{
+			name:   "should fail, gov not enabled for restricted marker",
+			msg:    *types.NewMsgSetTransferFeeRequest(rMarkerDenom, issuer, 25, nil, authority.String()),
+			expErr: "restricted-marker marker does not allow governance control",
+		},
+		{
+			name:   "should fail, no transfer fee to remove",
+			msg:    *types.NewMsgSetTransferFeeRequest(rMarkerDenom, "", 0, nil, authUser.String()),
+			expErr: "restricted-marker marker does not have a transfer fee to remove",
+		},
+		{
+			name:   "should succeed to set transfer fee",
+			msg:    *types.NewMsgSetTransferFeeRequest(rMarkerDenom, issuer, 25, nil, authUser.String()),
+			expFee: &types.TransferFee{Denom: rMarkerDenom, Recipient: issuer, BasisPoints: 25},
+		},
+		{
+			name:   "should succeed to update transfer fee",
+			msg:    *types.NewMsgSetTransferFeeRequest(rMarkerDenom, issuer, 0, flatFees, authUser.String()),
+			expFee: &types.TransferFee{Denom: rMarkerDenom, Recipient: issuer, FlatFees: flatFees},
+		},
+		{
+			name: "should succeed to remove transfer fee",
+			msg:  *types.NewMsgSetTransferFeeRequest(rMarkerDenom, "", 0, nil, authUser.String()),
+		},
+		{
+			name:   "should succeed gov allowed for marker",
+			msg:    *types.NewMsgSetTransferFeeRequest(rMarkerGovDenom, issuer, 10, flatFees, authority.String()),
+			expFee: &types.TransferFee{Denom: rMarkerGovDenom, Recipient: issuer, BasisPoints: 10, FlatFees: flatFees},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := server.SetTransferFee(sdk.WrapSDKContext(ctx), &tc.msg)
+
+			if len(tc.expErr) > 0 {
+				assert.Nil(t, res)
+				assert.EqualError(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, &types.MsgSetTransferFeeResponse{}, res)
+			fee, found := app.MarkerKeeper.GetTransferFee(ctx, types.MustGetMarkerAddress(tc.msg.Denom))
+			if tc.expFee == nil {
+				assert.False(t, found, "transfer fee should not be found")
+			} else {
+				assert.True(t, found, "transfer fee should be found")
+				assert.Equal(t, *tc.expFee, fee, "transfer fee")
+			}
+		})
+	}
+}
+
+func TestTransferFees(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	admin := sdk.AccAddress("admin_account_______")
+	holder := sdk.AccAddress("holder______________")
+	other := sdk.AccAddress("other_account_______")
+	issuer := sdk.AccAddress("issuer______________")
+	for _, addr := range []sdk.AccAddress{admin, holder, other, issuer} {
+		acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+		require.NoError(t, acc.SetSequence(1), "%s.SetSequence(1)", string(addr))
+		app.AccountKeeper.SetAccount(ctx, acc)
+	}
+
+	denom := "feecoin"
+	coin := func(amt int64) sdk.Coin {
+		return sdk.NewInt64Coin(denom, amt)
+	}
+	markerAddr := types.MustGetMarkerAddress(denom)
+	mac := types.NewMarkerAccount(
+		authtypes.NewBaseAccount(markerAddr, nil, 0, 0),
+		coin(10000),
+		admin,
+		[]types.AccessGrant{
+			{Address: admin.String(), Permissions: []types.Access{types.Access_Transfer, types.Access_Withdraw, types.Access_Admin}},
+			{Address: holder.String(), Permissions: []types.Access{types.Access_Transfer}},
+		},
+		types.StatusProposed,
+		types.MarkerType_RestrictedCoin,
+		true,
+		false,
+		true,
+		[]string{},
+	)
+	require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, mac), "AddFinalizeAndActivateMarker")
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, admin, holder, denom, sdk.NewCoins(coin(1000))), "WithdrawCoins to holder")
+
+	app.MarkerKeeper.SetTransferFee(ctx, types.NewTransferFee(denom, issuer.String(), 100, sdk.NewCoins(coin(2))))
+
+	balance := func(addr sdk.AccAddress) int64 {
+		return app.BankKeeper.GetBalance(ctx, addr, denom).Amount.Int64()
+	}
+
+	// The withdrawal from the marker did not charge a fee.
+	assert.Equal(t, int64(0), balance(issuer), "issuer balance after withdraw")
+
+	// A send of 500 is charged the 2 flat plus 1% (5).
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, holder, other, sdk.NewCoins(coin(500))), "send 500")
+	assert.Equal(t, int64(493), balance(holder), "holder balance after send")
+	assert.Equal(t, int64(500), balance(other), "other balance after send")
+	assert.Equal(t, int64(7), balance(issuer), "issuer balance after send")
+
+	// TransferCoin charges the from account too.
+	require.NoError(t, app.MarkerKeeper.TransferCoin(ctx, other, holder, admin, coin(100)), "TransferCoin 100")
+	assert.Equal(t, int64(397), balance(other), "other balance after TransferCoin")
+	assert.Equal(t, int64(10), balance(issuer), "issuer balance after TransferCoin")
+
+	// The fees are owed on top of the amount transferred.
+	cacheCtx, _ := ctx.CacheContext()
+	err := app.MarkerKeeper.TransferCoin(cacheCtx, other, holder, admin, coin(397))
+	assert.EqualError(t, err, "spendable balance 392feecoin is smaller than 397feecoin: insufficient funds", "TransferCoin entire balance")
+	assert.Equal(t, int64(397), balance(other), "other balance after failed send")
+
+	// Sends to and from the issuer and the bypass (module) accounts are not charged.
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, holder, issuer, sdk.NewCoins(coin(10))), "send to issuer")
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, holder, feeCollector, sdk.NewCoins(coin(10))), "send to fee collector")
+	assert.Equal(t, int64(20), balance(issuer), "issuer balance after exempt sends")
+
+	fees, recipient, err := app.MarkerKeeper.CalculateTransferFee(ctx, holder, other, coin(1000))
+	require.NoError(t, err, "CalculateTransferFee")
+	assert.Equal(t, sdk.NewCoins(coin(12)).String(), fees.String(), "calculated fees")
+	assert.Equal(t, issuer, recipient, "calculated fee recipient")
+
+	// Recovering an account moves the entire balance without a fee.
+	amount, err := app.MarkerKeeper.RecoverAccount(ctx, denom, other, holder, admin)
+	require.NoError(t, err, "RecoverAccount")
+	assert.Equal(t, coin(397), amount, "recovered amount")
+	assert.Equal(t, int64(20), balance(issuer), "issuer balance after RecoverAccount")
+
+	resp, err := app.MarkerKeeper.TransferFee(sdk.WrapSDKContext(ctx), &types.QueryTransferFeeRequest{Id: denom})
+	require.NoError(t, err, "TransferFee query")
+	assert.Equal(t, types.NewTransferFee(denom, issuer.String(), 100, sdk.NewCoins(coin(2))), resp.Fee, "queried transfer fee")
+
+	// Removing the fee stops it from being charged.
+	app.MarkerKeeper.RemoveTransferFee(ctx, markerAddr)
+	_, err = app.MarkerKeeper.TransferFee(sdk.WrapSDKContext(ctx), &types.QueryTransferFeeRequest{Id: denom})
+	assert.EqualError(t, err, "rpc error: code = NotFound desc = no transfer fee found for feecoin", "TransferFee query after removal")
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, holder, other, sdk.NewCoins(coin(100))), "send after removal")
+	assert.Equal(t, int64(20), balance(issuer), "issuer balance after removal")
+}
@@ -0,0 +1,208 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// GetTransferLimit returns the transfer limit configured on a marker and whether one was found.
+func (k Keeper) GetTransferLimit(ctx sdk.Context, markerAddr sdk.AccAddress) (types.TransferLimit, bool) {
+	var limit types.TransferLimit
+	bz := ctx.KVStore(k.storeKey).Get(types.TransferLimitKey(markerAddr))
+	if len(bz) == 0 {
+		return limit, false
+	}
+	k.cdc.MustUnmarshal(bz, &limit)
+	return limit, true
+}
+
+// SetTransferLimit stores the transfer limit of a marker. If the limit does not have any limits set, the transfer
+// limit is removed from the marker instead.
+func (k Keeper) SetTransferLimit(ctx sdk.Context, limit types.TransferLimit) {
+	markerAddr := types.MustGetMarkerAddress(limit.Denom)
+	if !limit.HasLimits() {
+		k.RemoveTransferLimit(ctx, markerAddr)
+		return
+	}
+	ctx.KVStore(k.storeKey).Set(types.TransferLimitKey(markerAddr), k.cdc.MustMarshal(&limit))
+}
+
+// RemoveTransferLimit removes the transfer limit of a marker along with all of its recorded transfer volume.
+func (k Keeper) RemoveTransferLimit(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.TransferLimitKey(markerAddr))
+	deleteAll(prefix.NewStore(store, types.TransferVolumeMarkerPrefix(markerAddr)))
+	deleteAll(prefix.NewStore(store, types.TransferVolumeTotalMarkerPrefix(markerAddr)))
+}
+
+// deleteAll deletes every entry in the provided store.
+func deleteAll(store prefix.Store) {
+	iterator := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// IterateTransferLimits iterates over all the transfer limits configured on markers.
+func (k Keeper) IterateTransferLimits(ctx sdk.Context, cb func(limit types.TransferLimit) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.TransferLimitKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var limit types.TransferLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &limit)
+		if cb(limit) {
+			break
+		}
+	}
+}
+
+// GetTransferVolume returns the amount of a marker's denom transferred by a holder during the marker's transfer limit
+// window. An empty holder address returns the amount transferred by all holders combined.
+func (k Keeper) GetTransferVolume(ctx sdk.Context, markerAddr, holderAddr sdk.AccAddress) sdkmath.Int {
+	bz := ctx.KVStore(k.storeKey).Get(types.TransferVolumeTotalKey(markerAddr, holderAddr))
+	if len(bz) == 0 {
+		return sdkmath.ZeroInt()
+	}
+	return mustUnmarshalInt(bz)
+}
+
+// setTransferVolume stores the transfer volume total of a holder (or the marker), removing it when it is zero.
+func (k Keeper) setTransferVolume(ctx sdk.Context, markerAddr, holderAddr sdk.AccAddress, total sdkmath.Int) {
+	key := types.TransferVolumeTotalKey(markerAddr, holderAddr)
+	if !total.IsPositive() {
+		ctx.KVStore(k.storeKey).Delete(key)
+		return
+	}
+	ctx.KVStore(k.storeKey).Set(key, mustMarshalInt(total))
+}
+
+// pruneTransferVolume removes the transfer volume entries of a marker that are not after the start of the window
+// ending at the current block time, and subtracts them from the running totals. Since the entries are ordered by
+// block time, only the expired entries are read.
+func (k Keeper) pruneTransferVolume(ctx sdk.Context, markerAddr sdk.AccAddress, window time.Duration) {
+	cutoff := ctx.BlockTime().Add(-window)
+	volumeStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.TransferVolumeMarkerPrefix(markerAddr))
+
+	var keys [][]byte
+	expired := make(map[string]sdkmath.Int)
+	var holders []sdk.AccAddress
+	iterator := volumeStore.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		blockTime, holderAddr, err := types.SplitTransferVolumeKey(iterator.Key())
+		if err != nil {
+			panic(fmt.Errorf("invalid transfer volume key: %w", err))
+		}
+		if blockTime.After(cutoff) {
+			break
+		}
+		keys = append(keys, iterator.Key())
+		amount, seen := expired[string(holderAddr)]
+		if !seen {
+			holders = append(holders, holderAddr)
+			amount = sdkmath.ZeroInt()
+		}
+		expired[string(holderAddr)] = amount.Add(mustUnmarshalInt(iterator.Value()))
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		volumeStore.Delete(key)
+	}
+	for _, holderAddr := range holders {
+		total := k.GetTransferVolume(ctx, markerAddr, holderAddr).Sub(expired[string(holderAddr)])
+		k.setTransferVolume(ctx, markerAddr, holderAddr, total)
+	}
+}
+
+// recordTransferVolume adds the provided amount to the entry of a holder (or the marker) for the current block time
+// and to its running total.
+func (k Keeper) recordTransferVolume(ctx sdk.Context, markerAddr, holderAddr sdk.AccAddress, amount sdkmath.Int) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.TransferVolumeKey(markerAddr, holderAddr, ctx.BlockTime())
+	entry := amount
+	if bz := store.Get(key); len(bz) != 0 {
+		entry = entry.Add(mustUnmarshalInt(bz))
+	}
+	store.Set(key, mustMarshalInt(entry))
+	k.setTransferVolume(ctx, markerAddr, holderAddr, k.GetTransferVolume(ctx, markerAddr, holderAddr).Add(amount))
+}
+
+// mustUnmarshalInt unmarshals a stored transfer volume amount, panicking if it is invalid.
+func mustUnmarshalInt(bz []byte) sdkmath.Int {
+	var amount sdkmath.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(fmt.Errorf("invalid transfer volume amount: %w", err))
+	}
+	return amount
+}
+
+// mustMarshalInt marshals a transfer volume amount to be stored, panicking if it cannot be.
+func mustMarshalInt(amount sdkmath.Int) []byte {
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(fmt.Errorf("could not marshal transfer volume amount: %w", err))
+	}
+	return bz
+}
+
+// applyTransferLimits checks that a transfer of the given coin by the holder does not exceed the transfer limits
+// configured on the coin's marker. Transfer limits are only enforced on restricted markers. If the transfer is allowed,
+// the transferred amount is recorded against the limits.
+func (k Keeper) applyTransferLimits(ctx sdk.Context, holderAddr sdk.AccAddress, coin sdk.Coin) error {
+	if !coin.Amount.IsPositive() {
+		return nil
+	}
+	markerAddr, err := types.MarkerAddress(coin.Denom)
+	if err != nil {
+		return err
+	}
+	limit, found := k.GetTransferLimit(ctx, markerAddr)
+	if !found {
+		return nil
+	}
+	marker, err := k.GetMarker(ctx, markerAddr)
+	if err != nil {
+		return err
+	}
+	if marker == nil || marker.GetMarkerType() != types.MarkerType_RestrictedCoin {
+		return nil
+	}
+
+	k.pruneTransferVolume(ctx, markerAddr, limit.Window)
+	if limit.HasMarkerLimit() {
+		used := k.GetTransferVolume(ctx, markerAddr, nil)
+		if used.Add(coin.Amount).GT(limit.MarkerLimit) {
+			return types.ErrTransferLimitExceeded.Wrapf("cannot transfer %s: marker limit of %s%s per %s would be exceeded, remaining: %s%s",
+				coin, limit.MarkerLimit, coin.Denom, limit.Window, sdkmath.MaxInt(limit.MarkerLimit.Sub(used), sdkmath.ZeroInt()), coin.Denom)
+		}
+	}
+	if limit.HasHolderLimit() {
+		used := k.GetTransferVolume(ctx, markerAddr, holderAddr)
+		if used.Add(coin.Amount).GT(limit.HolderLimit) {
+			return types.ErrTransferLimitExceeded.Wrapf("cannot transfer %s from %s: holder limit of %s%s per %s would be exceeded, remaining: %s%s",
+				coin, holderAddr, limit.HolderLimit, coin.Denom, limit.Window, sdkmath.MaxInt(limit.HolderLimit.Sub(used), sdkmath.ZeroInt()), coin.Denom)
+		}
+	}
+
+	if limit.HasMarkerLimit() {
+		k.recordTransferVolume(ctx, markerAddr, nil, coin.Amount)
+	}
+	if limit.HasHolderLimit() {
+		k.recordTransferVolume(ctx, markerAddr, holderAddr, coin.Amount)
+	}
+	return nil
+}
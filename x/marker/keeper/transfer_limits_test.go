@@ -0,0 +1,335 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestSetTransferLimit(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	server := markerkeeper.NewMsgServerImpl(app.MarkerKeeper)
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	authUser := testUserAddress("test")
+	notAuthUser := testUserAddress("test1")
+
+	notRestrictedMarker := types.NewEmptyMarkerAccount(
+		"not-restricted-marker",
+		authUser.String(),
+		[]types.AccessGrant{})
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, notRestrictedMarker))
+
+	rMarkerDenom := "restricted-marker"
+	rMarkerAcct := authtypes.NewBaseAccount(types.MustGetMarkerAddress(rMarkerDenom), nil, 0, 0)
+	app.MarkerKeeper.SetMarker(ctx, types.NewMarkerAccount(rMarkerAcct, sdk.NewInt64Coin(rMarkerDenom, 1000), authUser, []types.AccessGrant{{Address: authUser.String(), Permissions: []types.Access{types.Access_Transfer}}}, types.StatusFinalized, types.MarkerType_RestrictedCoin, true, false, false, []string{}))
+
+	rMarkerGovDenom := "restricted-marker-gov"
+	rMarkerGovAcct := authtypes.NewBaseAccount(types.MustGetMarkerAddress(rMarkerGovDenom), nil, 0, 0)
+	app.MarkerKeeper.SetMarker(ctx, types.NewMarkerAccount(rMarkerGovAcct, sdk.NewInt64Coin(rMarkerGovDenom, 1000), authUser, []types.AccessGrant{{Address: authUser.String(), Permissions: []types.Access{}}}, types.StatusFinalized, types.MarkerType_RestrictedCoin, true, true, false, []string{}))
+
+	day := 24 * time.Hour
+
+	testCases := []struct {
+		name     string
+		msg      types.MsgSetTransferLimitRequest
+		expErr   string
+		expLimit *types.TransferLimit
+	}{
+		{
+			name:   "should fail, cannot find marker",
+			msg:    *types.NewMsgSetTransferLimitRequest("blah", day, sdk.NewInt(100), sdk.NewInt(10), authUser),
+			expErr: "marker not found for blah: marker blah not found for address: cosmos1psw3a97ywtr595qa4295lw07cz9665hynnfpee",
+		},
+		{
+			name:   "should fail, not a restricted marker",
+			msg:    *types.NewMsgSetTransferLimitRequest(notRestrictedMarker.Denom, day, sdk.NewInt(100), sdk.NewInt(10), authUser),
+			expErr: "marker not-restricted-marker is not a restricted marker",
+		},
+		{
+			name:   "should fail, signer does not have transfer access",
+			msg:    *types.NewMsgSetTransferLimitRequest(rMarkerDenom, day, sdk.NewInt(100), sdk.NewInt(10), notAuthUser),
+			expErr: notAuthUser.String() + " does not have transfer authority for restricted-marker marker",
+		},
+		{
+			name:   "should fail, gov not enabled for restricted marker",
+			msg:    *types.NewMsgSetTransferLimitRequest(rMarkerDenom, day, sdk.NewInt(100), sdk.NewInt(10), authority),
+			expErr: "restricted-marker marker does not allow governance control",
+		},
+		{
+			name:   "should fail, no transfer limit to remove",
+			msg:    *types.NewMsgSetTransferLimitRequest(rMarkerDenom, 0, sdk.ZeroInt(), sdk.ZeroInt(), authUser),
+			expErr: "restricted-marker marker does not have a transfer limit to remove",
+		},
+		{
+			name:     "should succeed to set transfer limit",
+			msg:      *types.NewMsgSetTransferLimitRequest(rMarkerDenom, day, sdk.NewInt(100), sdk.NewInt(10), authUser),
+			expLimit: &types.TransferLimit{Denom: rMarkerDenom, Window: day, MarkerLimit: sdk.NewInt(100), HolderLimit: sdk.NewInt(10)},
+		},
+		{
+			name:     "should succeed to update transfer limit",
+			msg:      *types.NewMsgSetTransferLimitRequest(rMarkerDenom, time.Hour, sdk.ZeroInt(), sdk.NewInt(50), authUser),
+			expLimit: &types.TransferLimit{Denom: rMarkerDenom, Window: time.Hour, MarkerLimit: sdk.ZeroInt(), HolderLimit: sdk.NewInt(50)},
+		},
+		{
+			name: "should succeed to remove transfer limit",
+			msg:  *types.NewMsgSetTransferLimitRequest(rMarkerDenom, 0, sdk.ZeroInt(), sdk.ZeroInt(), authUser),
+		},
+		{
+			name:     "should succeed gov allowed for marker",
+			msg:      *types.NewMsgSetTransferLimitRequest(rMarkerGovDenom, day, sdk.NewInt(1000), sdk.ZeroInt(), authority),
+			expLimit: &types.TransferLimit{Denom: rMarkerGovDenom, Window: day, MarkerLimit: sdk.NewInt(1000), HolderLimit: sdk.ZeroInt()},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := server.SetTransferLimit(sdk.WrapSDKContext(ctx), &tc.msg)
+
+			if len(tc.expErr) > 0 {
+				assert.Nil(t, res)
+				assert.EqualError(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, &types.MsgSetTransferLimitResponse{}, res)
+			limit, found := app.MarkerKeeper.GetTransferLimit(ctx, types.MustGetMarkerAddress(tc.msg.Denom))
+			if tc.expLimit == nil {
+				assert.False(t, found, "transfer limit should not be found")
+			} else {
+				assert.True(t, found, "transfer limit should be found")
+				assert.Equal(t, *tc.expLimit, limit, "transfer limit")
+			}
+		})
+	}
+}
+
+func TestTransferLimits(t *testing.T) {
+	app := simapp.Setup(t)
+	startTime := time.Now().UTC().Truncate(time.Second)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: startTime})
+
+	admin := sdk.AccAddress("admin_account_______")
+	holder1 := sdk.AccAddress("holder_1____________")
+	holder2 := sdk.AccAddress("holder_2____________")
+	other := sdk.AccAddress("other_account_______")
+	for _, addr := range []sdk.AccAddress{admin, holder1, holder2, other} {
+		acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+		require.NoError(t, acc.SetSequence(1), "%s.SetSequence(1)", string(addr))
+		app.AccountKeeper.SetAccount(ctx, acc)
+	}
+
+	denom := "limitcoin"
+	coin := func(amt int64) sdk.Coin {
+		return sdk.NewInt64Coin(denom, amt)
+	}
+	markerAddr := types.MustGetMarkerAddress(denom)
+	mac := types.NewMarkerAccount(
+		authtypes.NewBaseAccount(markerAddr, nil, 0, 0),
+		coin(1000),
+		admin,
+		[]types.AccessGrant{
+			{Address: admin.String(), Permissions: []types.Access{types.Access_Transfer, types.Access_Withdraw, types.Access_Admin}},
+			{Address: holder1.String(), Permissions: []types.Access{types.Access_Transfer}},
+			{Address: holder2.String(), Permissions: []types.Access{types.Access_Transfer}},
+		},
+		types.StatusProposed,
+		types.MarkerType_RestrictedCoin,
+		true,
+		false,
+		true,
+		[]string{},
+	)
+	require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, mac), "AddFinalizeAndActivateMarker")
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, admin, holder1, denom, sdk.NewCoins(coin(200))), "WithdrawCoins to holder1")
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, admin, holder2, denom, sdk.NewCoins(coin(200))), "WithdrawCoins to holder2")
+
+	app.MarkerKeeper.SetTransferLimit(ctx, types.NewTransferLimit(denom, time.Hour, sdk.NewInt(100), sdk.NewInt(60)))
+
+	send := func(from sdk.AccAddress, amt int64) func(sdk.Context) error {
+		return func(ctx sdk.Context) error {
+			return app.BankKeeper.SendCoins(ctx, from, other, sdk.NewCoins(coin(amt)))
+		}
+	}
+	transferCoin := func(from sdk.AccAddress, amt int64) func(sdk.Context) error {
+		return func(ctx sdk.Context) error {
+			return app.MarkerKeeper.TransferCoin(ctx, from, other, admin, coin(amt))
+		}
+	}
+
+	// The steps build on each other, each one starting with the state left by the previous ones.
+	steps := []struct {
+		name       string
+		elapsed    time.Duration
+		action     func(sdk.Context) error
+		expErr     string
+		expMarker  int64
+		expHolder1 int64
+		expHolder2 int64
+	}{
+		{
+			name:       "send within the limits",
+			action:     send(holder1, 50),
+			expMarker:  50,
+			expHolder1: 50,
+		},
+		{
+			name:       "send over the holder limit",
+			action:     send(holder1, 20),
+			expErr:     "cannot transfer 20limitcoin from " + holder1.String() + ": holder limit of 60limitcoin per 1h0m0s would be exceeded, remaining: 10limitcoin: transfer limit exceeded",
+			expMarker:  50,
+			expHolder1: 50,
+		},
+		{
+			name:       "send up to the holder limit",
+			action:     send(holder1, 10),
+			expMarker:  60,
+			expHolder1: 60,
+		},
+		{
+			name:       "transfer coin over the marker limit",
+			elapsed:    30 * time.Minute,
+			action:     transferCoin(holder2, 50),
+			expErr:     "cannot transfer 50limitcoin: marker limit of 100limitcoin per 1h0m0s would be exceeded, remaining: 40limitcoin: transfer limit exceeded",
+			expMarker:  60,
+			expHolder1: 60,
+		},
+		{
+			name:       "transfer coin up to the marker limit",
+			elapsed:    30 * time.Minute,
+			action:     transferCoin(holder2, 40),
+			expMarker:  100,
+			expHolder1: 60,
+			expHolder2: 40,
+		},
+		{
+			name:       "send once the first transfers leave the window",
+			elapsed:    time.Hour,
+			action:     send(holder1, 60),
+			expMarker:  100,
+			expHolder1: 60,
+			expHolder2: 40,
+		},
+		{
+			name:       "send while the marker limit is used",
+			elapsed:    time.Hour,
+			action:     send(holder2, 1),
+			expErr:     "cannot transfer 1limitcoin: marker limit of 100limitcoin per 1h0m0s would be exceeded, remaining: 0limitcoin: transfer limit exceeded",
+			expMarker:  100,
+			expHolder1: 60,
+			expHolder2: 40,
+		},
+		{
+			name:    "send that bypasses the restrictions",
+			elapsed: time.Hour,
+			action: func(ctx sdk.Context) error {
+				return app.BankKeeper.SendCoins(types.WithBypass(ctx), holder2, other, sdk.NewCoins(coin(100)))
+			},
+			expMarker:  100,
+			expHolder1: 60,
+			expHolder2: 40,
+		},
+		{
+			name:       "send prunes the volume that left the window",
+			elapsed:    90 * time.Minute,
+			action:     send(holder2, 10),
+			expMarker:  70,
+			expHolder1: 60,
+			expHolder2: 10,
+		},
+	}
+
+	for _, tc := range steps {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx = ctx.WithBlockTime(startTime.Add(tc.elapsed))
+			err := tc.action(ctx)
+			if len(tc.expErr) > 0 {
+				require.ErrorIs(t, err, types.ErrTransferLimitExceeded, "action error")
+				assert.EqualError(t, err, tc.expErr, "action error")
+			} else {
+				require.NoError(t, err, "action")
+			}
+			assert.Equal(t, sdk.NewInt(tc.expMarker), app.MarkerKeeper.GetTransferVolume(ctx, markerAddr, nil), "marker volume")
+			assert.Equal(t, sdk.NewInt(tc.expHolder1), app.MarkerKeeper.GetTransferVolume(ctx, markerAddr, holder1), "holder1 volume")
+			assert.Equal(t, sdk.NewInt(tc.expHolder2), app.MarkerKeeper.GetTransferVolume(ctx, markerAddr, holder2), "holder2 volume")
+		})
+	}
+
+	t.Run("transfer allowance", func(t *testing.T) {
+		resp, err := app.MarkerKeeper.TransferAllowance(sdk.WrapSDKContext(ctx), &types.QueryTransferAllowanceRequest{Id: denom, Address: holder1.String()})
+		require.NoError(t, err, "TransferAllowance with address")
+		assert.Equal(t, sdk.NewInt(70), resp.MarkerUsed, "marker used")
+		if assert.NotNil(t, resp.MarkerRemaining, "marker remaining") {
+			assert.Equal(t, sdk.NewInt(30), *resp.MarkerRemaining, "marker remaining")
+		}
+		if assert.NotNil(t, resp.HolderUsed, "holder used") {
+			assert.Equal(t, sdk.NewInt(60), *resp.HolderUsed, "holder used")
+		}
+		if assert.NotNil(t, resp.HolderRemaining, "holder remaining") {
+			assert.Equal(t, sdk.ZeroInt(), *resp.HolderRemaining, "holder remaining")
+		}
+
+		resp, err = app.MarkerKeeper.TransferAllowance(sdk.WrapSDKContext(ctx), &types.QueryTransferAllowanceRequest{Id: denom})
+		require.NoError(t, err, "TransferAllowance without address")
+		assert.Nil(t, resp.HolderUsed, "holder used without address")
+		assert.Nil(t, resp.HolderRemaining, "holder remaining without address")
+	})
+
+	t.Run("remove transfer limit", func(t *testing.T) {
+		app.MarkerKeeper.RemoveTransferLimit(ctx, markerAddr)
+		_, found := app.MarkerKeeper.GetTransferLimit(ctx, markerAddr)
+		assert.False(t, found, "transfer limit should be removed")
+		assert.Equal(t, sdk.ZeroInt(), app.MarkerKeeper.GetTransferVolume(ctx, markerAddr, nil), "marker volume after removal")
+		assert.Equal(t, sdk.ZeroInt(), app.MarkerKeeper.GetTransferVolume(ctx, markerAddr, holder1), "holder1 volume after removal")
+		_, err := app.MarkerKeeper.TransferAllowance(sdk.WrapSDKContext(ctx), &types.QueryTransferAllowanceRequest{Id: denom})
+		assert.EqualError(t, err, "rpc error: code = NotFound desc = no transfer limit found for limitcoin", "TransferAllowance after removal")
+		require.NoError(t, send(holder1, 80)(ctx), "holder1 send after removal")
+	})
+}
+
+func TestTransferLimitsOnlyRestricted(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+
+	admin := sdk.AccAddress("admin_account_______")
+	holder := sdk.AccAddress("holder______________")
+	other := sdk.AccAddress("other_account_______")
+
+	denom := "unrestrictedlimitcoin"
+	markerAddr := types.MustGetMarkerAddress(denom)
+	mac := types.NewMarkerAccount(
+		authtypes.NewBaseAccount(markerAddr, nil, 0, 0),
+		sdk.NewInt64Coin(denom, 1000),
+		admin,
+		[]types.AccessGrant{{Address: admin.String(), Permissions: []types.Access{types.Access_Withdraw, types.Access_Admin}}},
+		types.StatusProposed,
+		types.MarkerType_Coin,
+		true,
+		false,
+		false,
+		[]string{},
+	)
+	require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, mac), "AddFinalizeAndActivateMarker")
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, admin, holder, denom, sdk.NewCoins(sdk.NewInt64Coin(denom, 200))), "WithdrawCoins")
+	app.MarkerKeeper.SetTransferLimit(ctx, types.NewTransferLimit(denom, time.Hour, sdk.NewInt(100), sdk.NewInt(60)))
+
+	err := app.BankKeeper.SendCoins(ctx, holder, other, sdk.NewCoins(sdk.NewInt64Coin(denom, 150)))
+	require.NoError(t, err, "SendCoins over the limits of a coin marker")
+	assert.Equal(t, sdk.ZeroInt(), app.MarkerKeeper.GetTransferVolume(ctx, markerAddr, nil), "marker volume")
+	assert.Equal(t, sdk.ZeroInt(), app.MarkerKeeper.GetTransferVolume(ctx, markerAddr, holder), "holder volume")
+}
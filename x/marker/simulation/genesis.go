@@ -16,9 +16,11 @@ import (
 
 // Simulation parameter constants
 const (
-	MaxTotalSupply         = "max_total_supply"
-	EnableGovernance       = "enable_governance"
-	UnrestrictedDenomRegex = "unresticted_denom_regex"
+	MaxTotalSupply          = "max_total_supply"
+	EnableGovernance        = "enable_governance"
+	UnrestrictedDenomRegex  = "unresticted_denom_regex"
+	MaxSupplyHistoryEntries = "max_supply_history_entries"
+	ReinstateDelayBlocks    = "reinstate_delay_blocks"
 )
 
 // GenMaxTotalSupply randomized Maximum amount of supply to allow for markers
@@ -38,6 +40,16 @@ func GenUnrestrictedDenomRegex(r *rand.Rand) string {
 	return fmt.Sprintf(`[a-zA-Z][a-zA-Z0-9\\-\\.]{%d,%d}`, min, max)
 }
 
+// GenMaxSupplyHistoryEntries returns a randomized number of supply history entries to retain for each marker
+func GenMaxSupplyHistoryEntries(r *rand.Rand) uint32 {
+	return uint32(r.Int31n(2000))
+}
+
+// GenReinstateDelayBlocks returns a randomized number of blocks a cancelled marker waits before it is reinstated
+func GenReinstateDelayBlocks(r *rand.Rand) uint64 {
+	return uint64(r.Int63n(1000))
+}
+
 // RandomizedGenState generates a random GenesisState for marker
 func RandomizedGenState(simState *module.SimulationState) {
 	var maxTotalSupply uint64
@@ -58,11 +70,25 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { unrestrictedDenomRegex = GenUnrestrictedDenomRegex(r) },
 	)
 
+	var maxSupplyHistoryEntries uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxSupplyHistoryEntries, &maxSupplyHistoryEntries, simState.Rand,
+		func(r *rand.Rand) { maxSupplyHistoryEntries = GenMaxSupplyHistoryEntries(r) },
+	)
+
+	var reinstateDelayBlocks uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ReinstateDelayBlocks, &reinstateDelayBlocks, simState.Rand,
+		func(r *rand.Rand) { reinstateDelayBlocks = GenReinstateDelayBlocks(r) },
+	)
+
 	markerGenesis := types.GenesisState{
 		Params: types.Params{
-			MaxTotalSupply:         maxTotalSupply,
-			EnableGovernance:       enableGovernance,
-			UnrestrictedDenomRegex: unrestrictedDenomRegex,
+			MaxTotalSupply:          maxTotalSupply,
+			EnableGovernance:        enableGovernance,
+			UnrestrictedDenomRegex:  unrestrictedDenomRegex,
+			MaxSupplyHistoryEntries: maxSupplyHistoryEntries,
+			ReinstateDelayBlocks:    reinstateDelayBlocks,
 		},
 		Markers: []types.MarkerAccount{
 			{
@@ -44,6 +44,8 @@ func TestRandomizedGenState(t *testing.T) {
 	require.Equal(t, true, markerGenesis.Params.EnableGovernance)
 	require.Equal(t, uint64(0x9408d2ac22c4d294), markerGenesis.Params.MaxTotalSupply)
 	require.Equal(t, `[a-zA-Z][a-zA-Z0-9\\-\\.]{7,60}`, markerGenesis.Params.UnrestrictedDenomRegex)
+	require.Equal(t, uint32(511), markerGenesis.Params.MaxSupplyHistoryEntries)
+	require.Equal(t, uint64(574), markerGenesis.Params.ReinstateDelayBlocks)
 }
 
 // TestRandomizedGenState tests abnormal scenarios of applying RandomizedGenState.
@@ -13,9 +13,11 @@ import (
 )
 
 const (
-	keyMaxTotalSupply         = "MaxTotalSupply"
-	keyEnableGovernance       = "EnableGovernance"
-	keyUnrestrictedDenomRegex = "UnrestrictedDenomRegex"
+	keyMaxTotalSupply          = "MaxTotalSupply"
+	keyEnableGovernance        = "EnableGovernance"
+	keyUnrestrictedDenomRegex  = "UnrestrictedDenomRegex"
+	keyMaxSupplyHistoryEntries = "MaxSupplyHistoryEntries"
+	keyReinstateDelayBlocks    = "ReinstateDelayBlocks"
 )
 
 // ParamChanges defines the parameters that can be modified by param change proposals
@@ -38,5 +40,15 @@ func ParamChanges(_ *rand.Rand) []simtypes.ParamChange {
 				return fmt.Sprintf("\"%s\"", GenUnrestrictedDenomRegex(r))
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, keyMaxSupplyHistoryEntries,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenMaxSupplyHistoryEntries(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keyReinstateDelayBlocks,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenReinstateDelayBlocks(r))
+			},
+		),
 	}
 }
@@ -34,11 +34,21 @@ func TestParamChanges(t *testing.T) {
 			key:         "UnrestrictedDenomRegex",
 			subspace:    markertypes.ModuleName,
 		},
+		{
+			composedKey: "marker/MaxSupplyHistoryEntries",
+			key:         "MaxSupplyHistoryEntries",
+			subspace:    markertypes.ModuleName,
+		},
+		{
+			composedKey: "marker/ReinstateDelayBlocks",
+			key:         "ReinstateDelayBlocks",
+			subspace:    markertypes.ModuleName,
+		},
 	}
 
 	paramChanges := simulation.ParamChanges(r)
 
-	require.Len(t, paramChanges, 3)
+	require.Len(t, paramChanges, 5)
 
 	for i, p := range paramChanges {
 		require.Equal(t, expected[i].composedKey, p.ComposedKey())
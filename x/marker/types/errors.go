@@ -13,4 +13,5 @@ var (
 	ErrAccessTypeNotGranted    = cerrs.Register(ModuleName, 6, "access type not granted")
 	ErrMarkerNotFound          = cerrs.Register(ModuleName, 7, "marker not found")
 	ErrDuplicateEntry          = cerrs.Register(ModuleName, 8, "duplicate entry")
+	ErrTransferLimitExceeded   = cerrs.Register(ModuleName, 9, "transfer limit exceeded")
 )
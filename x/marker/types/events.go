@@ -3,6 +3,9 @@ package types
 import (
 	"fmt"
 
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -169,3 +172,114 @@ func NewEventMarkerSetDenomMetadata(metadata banktypes.Metadata, administrator s
 		Administrator:       administrator,
 	}
 }
+
+func NewEventMarkerSetTransferLimit(limit TransferLimit, administrator string) *EventMarkerSetTransferLimit {
+	return &EventMarkerSetTransferLimit{
+		Denom:         limit.Denom,
+		Window:        limit.Window.String(),
+		MarkerLimit:   limit.MarkerLimit.String(),
+		HolderLimit:   limit.HolderLimit.String(),
+		Administrator: administrator,
+	}
+}
+
+func NewEventMarkerAddNetAssetValue(denom string, nav NetAssetValue, administrator string) *EventMarkerAddNetAssetValue {
+	return &EventMarkerAddNetAssetValue{
+		Denom:         denom,
+		Price:         nav.Price.String(),
+		Volume:        nav.Volume,
+		Source:        nav.Source,
+		Administrator: administrator,
+	}
+}
+
+func NewEventMarkerChangeType(denom string, oldType, newType MarkerType, administrators []string) *EventMarkerChangeType {
+	return &EventMarkerChangeType{
+		Denom:          denom,
+		OldType:        oldType.String(),
+		NewType:        newType.String(),
+		Administrators: administrators,
+	}
+}
+
+func NewEventMarkerSetMaxSupply(denom string, maxSupply sdkmath.Int, administrators []string) *EventMarkerSetMaxSupply {
+	return &EventMarkerSetMaxSupply{
+		Denom:          denom,
+		MaxSupply:      maxSupply.String(),
+		Administrators: administrators,
+	}
+}
+
+func NewEventMarkerBatchTransfer(denom string, totalAmount sdkmath.Int, transferCount uint32, administrator string) *EventMarkerBatchTransfer {
+	return &EventMarkerBatchTransfer{
+		Denom:         denom,
+		TotalAmount:   totalAmount.String(),
+		TransferCount: transferCount,
+		Administrator: administrator,
+	}
+}
+
+func NewEventMarkerRecoverAccount(amount sdk.Coin, compromised, replacement, administrator string) *EventMarkerRecoverAccount {
+	return &EventMarkerRecoverAccount{
+		Amount:             amount.Amount.String(),
+		Denom:              amount.Denom,
+		CompromisedAddress: compromised,
+		ReplacementAddress: replacement,
+		Administrator:      administrator,
+	}
+}
+
+func NewEventMarkerSetIbcPolicy(policy IbcPolicy, administrator string) *EventMarkerSetIbcPolicy {
+	return &EventMarkerSetIbcPolicy{
+		Denom:               policy.Denom,
+		AllowedChannels:     policy.AllowedChannels,
+		AllowedDestinations: policy.AllowedDestinations,
+		Administrator:       administrator,
+	}
+}
+
+func NewEventMarkerSetTransferFee(fee TransferFee, administrator string) *EventMarkerSetTransferFee {
+	return &EventMarkerSetTransferFee{
+		Denom:         fee.Denom,
+		Recipient:     fee.Recipient,
+		BasisPoints:   fee.BasisPoints,
+		FlatFees:      fee.FlatFees.String(),
+		Administrator: administrator,
+	}
+}
+
+func NewEventMarkerTransferFee(denom, fromAddress, recipient string, fees sdk.Coins) *EventMarkerTransferFee {
+	return &EventMarkerTransferFee{
+		Denom:       denom,
+		FromAddress: fromAddress,
+		Recipient:   recipient,
+		Fees:        fees.String(),
+	}
+}
+
+func NewEventMarkerReinstate(reinstatement PendingReinstatement, administrator string) *EventMarkerReinstate {
+	return &EventMarkerReinstate{
+		Denom:           reinstatement.Denom,
+		Status:          reinstatement.Status.String(),
+		Manager:         reinstatement.Manager,
+		ReinstateHeight: reinstatement.ReinstateHeight,
+		Administrator:   administrator,
+	}
+}
+
+func NewEventMarkerReinstated(reinstatement PendingReinstatement) *EventMarkerReinstated {
+	return &EventMarkerReinstated{
+		Denom:   reinstatement.Denom,
+		Status:  reinstatement.Status.String(),
+		Manager: reinstatement.Manager,
+	}
+}
+
+func NewEventMarkerReclaimEscrow(denom, toAddress string, amount sdk.Coins, administrator string) *EventMarkerReclaimEscrow {
+	return &EventMarkerReclaimEscrow{
+		Denom:         denom,
+		ToAddress:     toAddress,
+		Amount:        amount.String(),
+		Administrator: administrator,
+	}
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 )
@@ -21,6 +22,69 @@ func (state GenesisState) Validate() error {
 			return err
 		}
 	}
+	seen := make(map[string]bool, len(state.TransferLimits))
+	for _, l := range state.TransferLimits {
+		if err := l.Validate(); err != nil {
+			return err
+		}
+		if seen[l.Denom] {
+			return fmt.Errorf("duplicate transfer limit for %s", l.Denom)
+		}
+		seen[l.Denom] = true
+	}
+	seenSeq := make(map[string]map[uint64]bool)
+	for _, e := range state.SupplyHistory {
+		if err := e.Validate(); err != nil {
+			return err
+		}
+		if seenSeq[e.Denom] == nil {
+			seenSeq[e.Denom] = make(map[uint64]bool)
+		}
+		if seenSeq[e.Denom][e.Sequence] {
+			return fmt.Errorf("duplicate supply history sequence %d for %s", e.Sequence, e.Denom)
+		}
+		seenSeq[e.Denom][e.Sequence] = true
+	}
+	seenNav := make(map[string]bool, len(state.NetAssetValues))
+	for _, navs := range state.NetAssetValues {
+		if err := navs.Validate(); err != nil {
+			return err
+		}
+		if seenNav[navs.Address] {
+			return fmt.Errorf("duplicate net asset values for %s", navs.Address)
+		}
+		seenNav[navs.Address] = true
+	}
+	seenPolicy := make(map[string]bool, len(state.IbcPolicies))
+	for _, p := range state.IbcPolicies {
+		if err := p.Validate(); err != nil {
+			return err
+		}
+		if seenPolicy[p.Denom] {
+			return fmt.Errorf("duplicate ibc policy for %s", p.Denom)
+		}
+		seenPolicy[p.Denom] = true
+	}
+	seenFee := make(map[string]bool, len(state.TransferFees))
+	for _, f := range state.TransferFees {
+		if err := f.Validate(); err != nil {
+			return err
+		}
+		if seenFee[f.Denom] {
+			return fmt.Errorf("duplicate transfer fee for %s", f.Denom)
+		}
+		seenFee[f.Denom] = true
+	}
+	seenReinstatement := make(map[string]bool, len(state.PendingReinstatements))
+	for _, r := range state.PendingReinstatements {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+		if seenReinstatement[r.Denom] {
+			return fmt.Errorf("duplicate pending reinstatement for %s", r.Denom)
+		}
+		seenReinstatement[r.Denom] = true
+	}
 	return nil
 }
 
@@ -29,6 +29,18 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// A collection of marker accounts to create on start
 	Markers []MarkerAccount `protobuf:"bytes,2,rep,name=markers,proto3" json:"markers"`
+	// list of transfer limits that are configured on restricted markers
+	TransferLimits []TransferLimit `protobuf:"bytes,3,rep,name=transfer_limits,json=transferLimits,proto3" json:"transfer_limits"`
+	// list of retained supply history entries of markers
+	SupplyHistory []SupplyHistoryEntry `protobuf:"bytes,4,rep,name=supply_history,json=supplyHistory,proto3" json:"supply_history"`
+	// list of the net asset value histories of markers
+	NetAssetValues []MarkerNetAssetValues `protobuf:"bytes,5,rep,name=net_asset_values,json=netAssetValues,proto3" json:"net_asset_values"`
+	// list of IBC policies that are configured on restricted markers
+	IbcPolicies []IbcPolicy `protobuf:"bytes,6,rep,name=ibc_policies,json=ibcPolicies,proto3" json:"ibc_policies"`
+	// list of transfer fees that are configured on restricted markers
+	TransferFees []TransferFee `protobuf:"bytes,7,rep,name=transfer_fees,json=transferFees,proto3" json:"transfer_fees"`
+	// list of cancelled markers that are waiting to be reinstated
+	PendingReinstatements []PendingReinstatement `protobuf:"bytes,8,rep,name=pending_reinstatements,json=pendingReinstatements,proto3" json:"pending_reinstatements"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -64,8 +76,64 @@ func (m *GenesisState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
+// MarkerNetAssetValues is the net asset value history of a single marker.
+type MarkerNetAssetValues struct {
+	// address is the address of the marker.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// net_asset_values are the recorded net asset values of the marker, oldest first.
+	NetAssetValues []NetAssetValue `protobuf:"bytes,2,rep,name=net_asset_values,json=netAssetValues,proto3" json:"net_asset_values"`
+}
+
+func (m *MarkerNetAssetValues) Reset()         { *m = MarkerNetAssetValues{} }
+func (m *MarkerNetAssetValues) String() string { return proto.CompactTextString(m) }
+func (*MarkerNetAssetValues) ProtoMessage()    {}
+func (*MarkerNetAssetValues) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5dcc4ab7c9d2f78f, []int{1}
+}
+func (m *MarkerNetAssetValues) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarkerNetAssetValues) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarkerNetAssetValues.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarkerNetAssetValues) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarkerNetAssetValues.Merge(m, src)
+}
+func (m *MarkerNetAssetValues) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarkerNetAssetValues) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarkerNetAssetValues.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarkerNetAssetValues proto.InternalMessageInfo
+
+func (m *MarkerNetAssetValues) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MarkerNetAssetValues) GetNetAssetValues() []NetAssetValue {
+	if m != nil {
+		return m.NetAssetValues
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "provenance.marker.v1.GenesisState")
+	proto.RegisterType((*MarkerNetAssetValues)(nil), "provenance.marker.v1.MarkerNetAssetValues")
 }
 
 func init() {
@@ -73,23 +141,38 @@ func init() {
 }
 
 var fileDescriptor_5dcc4ab7c9d2f78f = []byte{
-	// 251 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2a, 0x28, 0xca, 0x2f,
-	0x4b, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0xcf, 0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0xd2, 0x2f, 0x33,
-	0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
-	0x41, 0xa8, 0xd1, 0x83, 0xa8, 0xd1, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b,
-	0xd0, 0x07, 0xb1, 0x20, 0x6a, 0xa5, 0x14, 0xb1, 0x9a, 0x07, 0xd5, 0x05, 0x56, 0xa2, 0x34, 0x93,
-	0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x41, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x15, 0x17, 0x5b, 0x41,
-	0x62, 0x51, 0x62, 0x6e, 0xb1, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x8c, 0x1e, 0x36, 0x0b,
-	0xf5, 0x02, 0xc0, 0x6a, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0xea, 0x10, 0x72, 0xe6,
-	0x62, 0x87, 0xa8, 0x28, 0x96, 0x60, 0x52, 0x60, 0xd6, 0xe0, 0x36, 0x52, 0xc6, 0xae, 0xd9, 0x17,
-	0xcc, 0x72, 0x4c, 0x4e, 0xce, 0x2f, 0xcd, 0x2b, 0x81, 0x9a, 0x01, 0xd3, 0x69, 0xc5, 0xd1, 0xb1,
-	0x40, 0x9e, 0xe1, 0xc5, 0x02, 0x79, 0x06, 0xa7, 0xf4, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92,
-	0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c,
-	0x96, 0x63, 0xe0, 0x12, 0xcf, 0xcc, 0xc7, 0x6a, 0x72, 0x00, 0x63, 0x94, 0x51, 0x7a, 0x66, 0x49,
-	0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0x42, 0x89, 0x6e, 0x66, 0x3e, 0x12, 0x4f, 0xbf,
-	0x02, 0x16, 0x1c, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0xb0, 0x30, 0x06, 0x04, 0x00,
-	0x00, 0xff, 0xff, 0xa8, 0x55, 0x2c, 0xc0, 0x80, 0x01, 0x00, 0x00,
+	// 486 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0x87, 0x13, 0xb6, 0xb5, 0xc3, 0xed, 0x06, 0xb2, 0x0a, 0x44, 0x13, 0x4a, 0xb7, 0x72, 0xa9,
+	0x90, 0x48, 0xb4, 0x72, 0xdb, 0x6d, 0x43, 0xc0, 0x90, 0x06, 0xaa, 0x5a, 0xe0, 0xb0, 0x4b, 0x94,
+	0xa6, 0xef, 0x32, 0x8b, 0xc6, 0x8e, 0xfc, 0xba, 0x15, 0xfd, 0x00, 0x48, 0x1c, 0xf9, 0x08, 0xe3,
+	0xdb, 0xec, 0xb8, 0x23, 0x27, 0x84, 0xda, 0x0b, 0x1f, 0x03, 0xc5, 0x49, 0x68, 0x2b, 0x59, 0xb9,
+	0xd9, 0xaf, 0x9f, 0xdf, 0xe3, 0xff, 0xa4, 0x93, 0x4a, 0x31, 0x03, 0x1e, 0xf2, 0x08, 0xfc, 0x24,
+	0x94, 0x5f, 0x40, 0xfa, 0xb3, 0x63, 0x3f, 0x06, 0x0e, 0xc8, 0xd0, 0x4b, 0xa5, 0x50, 0x82, 0xb6,
+	0x56, 0x8c, 0x97, 0x33, 0xde, 0xec, 0xf8, 0xa0, 0x15, 0x8b, 0x58, 0x68, 0xc0, 0xcf, 0x5a, 0x39,
+	0x7b, 0x70, 0x64, 0xf4, 0x15, 0x29, 0x8d, 0x74, 0x7e, 0xee, 0x90, 0xe6, 0xdb, 0x7c, 0x82, 0xa1,
+	0x0a, 0x15, 0xd0, 0x13, 0x52, 0x4b, 0x43, 0x19, 0x26, 0xe8, 0xd8, 0x87, 0x76, 0xb7, 0xd1, 0x7b,
+	0xea, 0x99, 0x26, 0xf4, 0xfa, 0x9a, 0x39, 0xdb, 0xbe, 0xfd, 0xdd, 0xb6, 0x06, 0x45, 0x82, 0xbe,
+	0x22, 0xf5, 0x9c, 0x40, 0xe7, 0xde, 0xe1, 0x56, 0xb7, 0xd1, 0x7b, 0x66, 0x0e, 0xbf, 0xd7, 0xad,
+	0xd3, 0x28, 0x12, 0x53, 0xae, 0x0a, 0x47, 0x99, 0xa4, 0x03, 0xf2, 0x40, 0xc9, 0x90, 0xe3, 0x15,
+	0xc8, 0x60, 0xc2, 0x12, 0xa6, 0xd0, 0xd9, 0xaa, 0x92, 0x7d, 0x2c, 0xe0, 0x8b, 0x8c, 0x2d, 0x64,
+	0xfb, 0x6a, 0xbd, 0x88, 0xf4, 0x13, 0xd9, 0xc7, 0x69, 0x9a, 0x4e, 0xe6, 0xc1, 0x35, 0x43, 0x25,
+	0xe4, 0xdc, 0xd9, 0xd6, 0xca, 0xae, 0x59, 0x39, 0xd4, 0xec, 0x79, 0x8e, 0xbe, 0xe6, 0x4a, 0xce,
+	0x0b, 0xef, 0x1e, 0xae, 0x8f, 0xd0, 0x4b, 0xf2, 0x90, 0x83, 0x0a, 0x42, 0x44, 0x50, 0xc1, 0x2c,
+	0x9c, 0x4c, 0x01, 0x9d, 0x1d, 0x2d, 0x7e, 0x5e, 0xb5, 0xf1, 0x0f, 0xa0, 0x4e, 0xb3, 0xc8, 0x67,
+	0x9d, 0x28, 0x97, 0xcc, 0x37, 0xaa, 0xf4, 0x9c, 0x34, 0xd9, 0x28, 0x0a, 0x52, 0x31, 0x61, 0x11,
+	0x03, 0x74, 0x6a, 0xda, 0xdb, 0x36, 0x7b, 0xdf, 0x8d, 0xa2, 0x7e, 0x06, 0x96, 0xeb, 0x6c, 0xb0,
+	0xa2, 0xc0, 0x00, 0xe9, 0x05, 0xd9, 0xfb, 0x7f, 0xa0, 0x57, 0x00, 0xe8, 0xd4, 0xb5, 0xea, 0xa8,
+	0xfa, 0x38, 0xdf, 0x00, 0x14, 0xb2, 0xa6, 0x5a, 0x95, 0x90, 0xc6, 0xe4, 0x71, 0x0a, 0x7c, 0xcc,
+	0x78, 0x1c, 0x48, 0x60, 0x1c, 0xb3, 0x47, 0x93, 0x00, 0x57, 0xe8, 0xec, 0x56, 0xed, 0xbc, 0x9f,
+	0x67, 0x06, 0xeb, 0x91, 0xc2, 0xff, 0x28, 0x35, 0x8c, 0xe1, 0xc9, 0xee, 0xf7, 0x9b, 0xb6, 0xf5,
+	0xf7, 0xa6, 0x6d, 0x75, 0xbe, 0xd9, 0xa4, 0x65, 0x3a, 0x39, 0xea, 0x90, 0x7a, 0x38, 0x1e, 0x4b,
+	0xc0, 0xfc, 0xb1, 0xde, 0x1f, 0x94, 0x5d, 0x3a, 0x34, 0xdc, 0x4c, 0xe5, 0x93, 0xdc, 0x30, 0x9b,
+	0xaf, 0xe4, 0x2c, 0xbe, 0x5d, 0xb8, 0xf6, 0xdd, 0xc2, 0xb5, 0xff, 0x2c, 0x5c, 0xfb, 0xc7, 0xd2,
+	0xb5, 0xee, 0x96, 0xae, 0xf5, 0x6b, 0xe9, 0x5a, 0xe4, 0x09, 0x13, 0x46, 0x6d, 0xdf, 0xbe, 0xec,
+	0xc5, 0x4c, 0x5d, 0x4f, 0x47, 0x5e, 0x24, 0x12, 0x7f, 0x85, 0xbc, 0x60, 0x62, 0xad, 0xe7, 0x7f,
+	0x2d, 0xbf, 0xa7, 0x9a, 0xa7, 0x80, 0xa3, 0x9a, 0xfe, 0x9b, 0x2f, 0xff, 0x0d, 0x00, 0xf9, 0xed,
+	0x83, 0xaa, 0x10, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -112,6 +195,90 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingReinstatements) > 0 {
+		for iNdEx := len(m.PendingReinstatements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingReinstatements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.TransferFees) > 0 {
+		for iNdEx := len(m.TransferFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.IbcPolicies) > 0 {
+		for iNdEx := len(m.IbcPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IbcPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.NetAssetValues) > 0 {
+		for iNdEx := len(m.NetAssetValues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NetAssetValues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.SupplyHistory) > 0 {
+		for iNdEx := len(m.SupplyHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SupplyHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TransferLimits) > 0 {
+		for iNdEx := len(m.TransferLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Markers) > 0 {
 		for iNdEx := len(m.Markers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -139,6 +306,50 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MarkerNetAssetValues) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarkerNetAssetValues) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarkerNetAssetValues) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NetAssetValues) > 0 {
+		for iNdEx := len(m.NetAssetValues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NetAssetValues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -164,6 +375,61 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.TransferLimits) > 0 {
+		for _, e := range m.TransferLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SupplyHistory) > 0 {
+		for _, e := range m.SupplyHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.NetAssetValues) > 0 {
+		for _, e := range m.NetAssetValues {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IbcPolicies) > 0 {
+		for _, e := range m.IbcPolicies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TransferFees) > 0 {
+		for _, e := range m.TransferFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingReinstatements) > 0 {
+		for _, e := range m.PendingReinstatements {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MarkerNetAssetValues) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.NetAssetValues) > 0 {
+		for _, e := range m.NetAssetValues {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -269,6 +535,326 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferLimits = append(m.TransferLimits, TransferLimit{})
+			if err := m.TransferLimits[len(m.TransferLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupplyHistory = append(m.SupplyHistory, SupplyHistoryEntry{})
+			if err := m.SupplyHistory[len(m.SupplyHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAssetValues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetAssetValues = append(m.NetAssetValues, MarkerNetAssetValues{})
+			if err := m.NetAssetValues[len(m.NetAssetValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcPolicies = append(m.IbcPolicies, IbcPolicy{})
+			if err := m.IbcPolicies[len(m.IbcPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferFees = append(m.TransferFees, TransferFee{})
+			if err := m.TransferFees[len(m.TransferFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingReinstatements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingReinstatements = append(m.PendingReinstatements, PendingReinstatement{})
+			if err := m.PendingReinstatements[len(m.PendingReinstatements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarkerNetAssetValues) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarkerNetAssetValues: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarkerNetAssetValues: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAssetValues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetAssetValues = append(m.NetAssetValues, NetAssetValue{})
+			if err := m.NetAssetValues[len(m.NetAssetValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
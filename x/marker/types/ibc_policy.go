@@ -0,0 +1,83 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+)
+
+// NewIbcPolicy creates a new IbcPolicy for the given denom.
+func NewIbcPolicy(denom string, allowedChannels, allowedDestinations []string) IbcPolicy {
+	return IbcPolicy{
+		Denom:               denom,
+		AllowedChannels:     allowedChannels,
+		AllowedDestinations: allowedDestinations,
+	}
+}
+
+// Validate performs basic sanity checks on an IbcPolicy.
+func (p IbcPolicy) Validate() error {
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return err
+	}
+	if err := ValidateIbcPolicyLists(p.AllowedChannels, p.AllowedDestinations); err != nil {
+		return err
+	}
+	if !p.HasRules() {
+		return fmt.Errorf("ibc policy for %s must have allowed channels or allowed destinations", p.Denom)
+	}
+	return nil
+}
+
+// HasRules returns true if either the allowed channels or allowed destinations are set.
+func (p IbcPolicy) HasRules() bool {
+	return len(p.AllowedChannels) > 0 || len(p.AllowedDestinations) > 0
+}
+
+// AllowsChannel returns true if the denom can be sent out on, or returned through, the provided channel.
+func (p IbcPolicy) AllowsChannel(channel string) bool {
+	return len(p.AllowedChannels) == 0 || containsString(p.AllowedChannels, channel)
+}
+
+// AllowsDestination returns true if the denom can be sent to the provided receiver on a counterparty chain.
+func (p IbcPolicy) AllowsDestination(receiver string) bool {
+	return len(p.AllowedDestinations) == 0 || containsString(p.AllowedDestinations, receiver)
+}
+
+// ValidateIbcPolicyLists checks the allowed channels and destinations of an IBC policy.
+// Both lists being empty is allowed; it indicates that the IBC policy is to be removed.
+func ValidateIbcPolicyLists(allowedChannels, allowedDestinations []string) error {
+	seen := make(map[string]bool, len(allowedChannels))
+	for _, channel := range allowedChannels {
+		if err := host.ChannelIdentifierValidator(channel); err != nil {
+			return fmt.Errorf("invalid allowed channel %q: %w", channel, err)
+		}
+		if seen[channel] {
+			return fmt.Errorf("duplicate allowed channel %s", channel)
+		}
+		seen[channel] = true
+	}
+	seen = make(map[string]bool, len(allowedDestinations))
+	for _, dest := range allowedDestinations {
+		// Destinations are addresses on other chains, so they can't be validated with this chain's bech32 prefix.
+		if len(dest) == 0 {
+			return fmt.Errorf("allowed destination cannot be empty")
+		}
+		if seen[dest] {
+			return fmt.Errorf("duplicate allowed destination %s", dest)
+		}
+		seen[dest] = true
+	}
+	return nil
+}
+
+// containsString returns true if the provided value is in the list.
+func containsString(list []string, value string) bool {
+	for _, entry := range list {
+		if entry == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIbcPolicyValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy IbcPolicy
+		exp    string
+	}{
+		{
+			name:   "channels and destinations",
+			policy: NewIbcPolicy("policycoin", []string{"channel-0", "channel-12"}, []string{"cosmos1receiver"}),
+			exp:    "",
+		},
+		{
+			name:   "only destinations",
+			policy: NewIbcPolicy("policycoin", nil, []string{"cosmos1receiver", "osmo1receiver"}),
+			exp:    "",
+		},
+		{
+			name:   "invalid denom",
+			policy: NewIbcPolicy("x", []string{"channel-0"}, nil),
+			exp:    "invalid denom: x",
+		},
+		{
+			name:   "no rules",
+			policy: NewIbcPolicy("policycoin", nil, []string{}),
+			exp:    "ibc policy for policycoin must have allowed channels or allowed destinations",
+		},
+		{
+			name:   "invalid channel",
+			policy: NewIbcPolicy("policycoin", []string{"channel-0", "a"}, nil),
+			exp:    `invalid allowed channel "a": identifier a has invalid length: 1, must be between 8-64 characters: invalid identifier`,
+		},
+		{
+			name:   "duplicate channel",
+			policy: NewIbcPolicy("policycoin", []string{"channel-0", "channel-0"}, nil),
+			exp:    "duplicate allowed channel channel-0",
+		},
+		{
+			name:   "empty destination",
+			policy: NewIbcPolicy("policycoin", nil, []string{""}),
+			exp:    "allowed destination cannot be empty",
+		},
+		{
+			name:   "duplicate destination",
+			policy: NewIbcPolicy("policycoin", nil, []string{"cosmos1receiver", "cosmos1receiver"}),
+			exp:    "duplicate allowed destination cosmos1receiver",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.Validate()
+			if len(tc.exp) > 0 {
+				// The ibc identifier errors include the location they were created at, so only the start is checked.
+				assert.ErrorContains(t, err, tc.exp, "Validate")
+			} else {
+				assert.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
+
+func TestIbcPolicyAllows(t *testing.T) {
+	empty := NewIbcPolicy("policycoin", nil, nil)
+	assert.True(t, empty.AllowsChannel("channel-0"), "empty policy AllowsChannel")
+	assert.True(t, empty.AllowsDestination("cosmos1receiver"), "empty policy AllowsDestination")
+
+	policy := NewIbcPolicy("policycoin", []string{"channel-1"}, []string{"cosmos1receiver"})
+	assert.True(t, policy.AllowsChannel("channel-1"), "AllowsChannel(channel-1)")
+	assert.False(t, policy.AllowsChannel("channel-0"), "AllowsChannel(channel-0)")
+	assert.True(t, policy.AllowsDestination("cosmos1receiver"), "AllowsDestination(cosmos1receiver)")
+	assert.False(t, policy.AllowsDestination("cosmos1other"), "AllowsDestination(cosmos1other)")
+}
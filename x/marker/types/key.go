@@ -1,7 +1,9 @@
 package types
 
 import (
+	"crypto/sha256"
 	"fmt"
+	"time"
 
 	"github.com/tendermint/tendermint/crypto"
 
@@ -35,6 +37,66 @@ var (
 
 	// DenySendKeyPrefix prefix for adding addresses that are denied send functionality on restricted markers
 	DenySendKeyPrefix = []byte{0x03}
+
+	// TransferLimitKeyPrefix prefix for the transfer limits configured on restricted markers
+	TransferLimitKeyPrefix = []byte{0x04}
+
+	// TransferVolumeKeyPrefix prefix for the amounts transferred of restricted markers, used to enforce transfer limits
+	TransferVolumeKeyPrefix = []byte{0x05}
+
+	// SupplyHistoryKeyPrefix prefix for the supply history entries of markers
+	SupplyHistoryKeyPrefix = []byte{0x06}
+
+	// SupplyHistorySequenceKeyPrefix prefix for the last supply history sequence used for each marker
+	SupplyHistorySequenceKeyPrefix = []byte{0x07}
+
+	// DirtyMarkerKeyPrefix prefix for the markers that need their supply checked in the next BeginBlocker
+	DirtyMarkerKeyPrefix = []byte{0x08}
+
+	// PendingDestroyKeyPrefix prefix for the destroyed markers that need to be removed in the next BeginBlocker
+	PendingDestroyKeyPrefix = []byte{0x09}
+
+	// MarkerManagerIndexKeyPrefix prefix for the index of markers by manager address
+	MarkerManagerIndexKeyPrefix = []byte{0x0A}
+
+	// MarkerAccessIndexKeyPrefix prefix for the index of markers by the addresses that have been granted access
+	MarkerAccessIndexKeyPrefix = []byte{0x0B}
+
+	// MarkerTypeIndexKeyPrefix prefix for the index of markers by marker type
+	MarkerTypeIndexKeyPrefix = []byte{0x0C}
+
+	// MarkerForcedTransferIndexKeyPrefix prefix for the index of markers by their allow forced transfer flag
+	MarkerForcedTransferIndexKeyPrefix = []byte{0x0D}
+
+	// MarkerRequiredAttributeIndexKeyPrefix prefix for the index of markers by required attribute
+	MarkerRequiredAttributeIndexKeyPrefix = []byte{0x0E}
+
+	// NetAssetValueKeyPrefix prefix for the net asset value history entries of markers
+	NetAssetValueKeyPrefix = []byte{0x0F}
+
+	// NetAssetValueSequenceKeyPrefix prefix for the last net asset value sequence used for each marker
+	NetAssetValueSequenceKeyPrefix = []byte{0x10}
+
+	// LatestNetAssetValueKeyPrefix prefix for the sequence of the latest net asset value of a marker in each price denom
+	LatestNetAssetValueKeyPrefix = []byte{0x11}
+
+	// IbcPolicyKeyPrefix prefix for the IBC policies of restricted markers
+	IbcPolicyKeyPrefix = []byte{0x12}
+
+	// TransferFeeKeyPrefix prefix for the transfer fees of restricted markers
+	TransferFeeKeyPrefix = []byte{0x13}
+
+	// EscrowHolderIndexKeyPrefix prefix for the index of markers by the denoms they hold in escrow
+	EscrowHolderIndexKeyPrefix = []byte{0x14}
+
+	// PendingReinstatementKeyPrefix prefix for the cancelled markers that are waiting to be reinstated
+	PendingReinstatementKeyPrefix = []byte{0x15}
+
+	// TransferVolumeTotalKeyPrefix prefix for the running totals of the transfer volume entries still within the window
+	TransferVolumeTotalKeyPrefix = []byte{0x16}
+
+	// PendingReinstatementHeightIndexKeyPrefix prefix for the index of pending reinstatements by reinstate height
+	PendingReinstatementHeightIndexKeyPrefix = []byte{0x17}
 )
 
 // MarkerAddress returns the module account address for the given denomination
@@ -70,3 +132,246 @@ func DenySendKey(markerAddr sdk.AccAddress, denyAddr sdk.AccAddress) []byte {
 	key = append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
 	return append(key, address.MustLengthPrefix(denyAddr.Bytes())...)
 }
+
+// TransferLimitKey returns a key [prefix][denom addr] for the transfer limit of a restricted marker
+func TransferLimitKey(markerAddr sdk.AccAddress) []byte {
+	key := TransferLimitKeyPrefix
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// TransferVolumeMarkerPrefix returns a key prefix [prefix][denom addr] for all transfer volume entries of a marker
+func TransferVolumeMarkerPrefix(markerAddr sdk.AccAddress) []byte {
+	key := TransferVolumeKeyPrefix
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// TransferVolumeKey returns a key [prefix][denom addr][block time][holder addr] for a transfer volume entry. The
+// entries of a marker are ordered by block time so that the expired ones can be pruned without reading the rest.
+func TransferVolumeKey(markerAddr sdk.AccAddress, holderAddr sdk.AccAddress, blockTime time.Time) []byte {
+	key := TransferVolumeMarkerPrefix(markerAddr)
+	key = append(key, sdk.FormatTimeBytes(blockTime)...)
+	return append(key, transferVolumeHolderBytes(holderAddr)...)
+}
+
+// SplitTransferVolumeKey returns the block time and holder address of a transfer volume entry given its key with
+// the [prefix][denom addr] portion removed. An empty holder address is returned for marker-wide entries.
+func SplitTransferVolumeKey(key []byte) (time.Time, sdk.AccAddress, error) {
+	timeLen := len(sdk.FormatTimeBytes(time.Time{}))
+	if len(key) <= timeLen {
+		return time.Time{}, nil, fmt.Errorf("transfer volume key too short: %d", len(key))
+	}
+	blockTime, err := sdk.ParseTimeBytes(key[:timeLen])
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+	holderBz := key[timeLen:]
+	if int(holderBz[0]) != len(holderBz)-1 {
+		return time.Time{}, nil, fmt.Errorf("invalid transfer volume holder address length: %d", holderBz[0])
+	}
+	if holderBz[0] == 0 {
+		return blockTime, nil, nil
+	}
+	return blockTime, sdk.AccAddress(holderBz[1:]), nil
+}
+
+// TransferVolumeTotalMarkerPrefix returns a key prefix [prefix][denom addr] for all transfer volume totals of a marker
+func TransferVolumeTotalMarkerPrefix(markerAddr sdk.AccAddress) []byte {
+	key := TransferVolumeTotalKeyPrefix
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// TransferVolumeTotalKey returns a key [prefix][denom addr][holder addr] for the transfer volume total of a holder
+// of a marker.
+func TransferVolumeTotalKey(markerAddr sdk.AccAddress, holderAddr sdk.AccAddress) []byte {
+	return append(TransferVolumeTotalMarkerPrefix(markerAddr), transferVolumeHolderBytes(holderAddr)...)
+}
+
+// transferVolumeHolderBytes returns the length prefixed holder address used in the transfer volume keys. An empty
+// holder address is used for the marker-wide transfer volume and is stored as a single zero length byte so that it
+// cannot be confused with a holder address.
+func transferVolumeHolderBytes(holderAddr sdk.AccAddress) []byte {
+	if len(holderAddr) == 0 {
+		return []byte{0x00}
+	}
+	return address.MustLengthPrefix(holderAddr.Bytes())
+}
+
+// SupplyHistoryPrefix returns a key prefix [prefix][denom addr] for the supply history entries of a marker
+func SupplyHistoryPrefix(markerAddr sdk.AccAddress) []byte {
+	key := SupplyHistoryKeyPrefix
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// SupplyHistoryKey returns a key [prefix][denom addr][sequence] for a supply history entry of a marker
+func SupplyHistoryKey(markerAddr sdk.AccAddress, sequence uint64) []byte {
+	return append(SupplyHistoryPrefix(markerAddr), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// SupplyHistorySequenceKey returns a key [prefix][denom addr] for the last supply history sequence used for a marker
+func SupplyHistorySequenceKey(markerAddr sdk.AccAddress) []byte {
+	key := SupplyHistorySequenceKeyPrefix
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// NetAssetValuePrefix returns a key prefix [prefix][denom addr] for the net asset value history of a marker
+func NetAssetValuePrefix(markerAddr sdk.AccAddress) []byte {
+	key := NetAssetValueKeyPrefix
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// NetAssetValueKey returns a key [prefix][denom addr][sequence] for a net asset value history entry of a marker
+func NetAssetValueKey(markerAddr sdk.AccAddress, sequence uint64) []byte {
+	return append(NetAssetValuePrefix(markerAddr), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// NetAssetValueSequenceKey returns a key [prefix][denom addr] for the last net asset value sequence used for a marker
+func NetAssetValueSequenceKey(markerAddr sdk.AccAddress) []byte {
+	key := NetAssetValueSequenceKeyPrefix
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// LatestNetAssetValuePrefix returns a key prefix [prefix][denom addr] for the latest net asset values of a marker
+func LatestNetAssetValuePrefix(markerAddr sdk.AccAddress) []byte {
+	key := LatestNetAssetValueKeyPrefix
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// LatestNetAssetValueKey returns a key [prefix][denom addr][price denom] for the latest net asset value of a marker
+// in a price denom
+func LatestNetAssetValueKey(markerAddr sdk.AccAddress, priceDenom string) []byte {
+	return append(LatestNetAssetValuePrefix(markerAddr), priceDenom...)
+}
+
+// IbcPolicyKey returns a key [prefix][denom addr] for the IBC policy of a restricted marker
+func IbcPolicyKey(markerAddr sdk.AccAddress) []byte {
+	key := IbcPolicyKeyPrefix
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// TransferFeeKey returns a key [prefix][denom addr] for the transfer fee of a restricted marker
+func TransferFeeKey(markerAddr sdk.AccAddress) []byte {
+	key := TransferFeeKeyPrefix
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// PendingReinstatementKey returns a key [prefix][denom addr] for the pending reinstatement of a cancelled marker
+func PendingReinstatementKey(markerAddr sdk.AccAddress) []byte {
+	key := PendingReinstatementKeyPrefix
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// PendingReinstatementHeightIndexPrefix returns a key prefix [prefix][height] for the pending reinstatements due at a
+// reinstate height
+func PendingReinstatementHeightIndexPrefix(height int64) []byte {
+	key := PendingReinstatementHeightIndexKeyPrefix
+	return append(key, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// PendingReinstatementHeightIndexKey returns a key [prefix][height][denom addr] for the pending reinstatement of a
+// cancelled marker due at a reinstate height
+func PendingReinstatementHeightIndexKey(height int64, markerAddr sdk.AccAddress) []byte {
+	return append(PendingReinstatementHeightIndexPrefix(height), address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// SplitPendingReinstatementHeightIndexKey returns the reinstate height and marker address given a pending
+// reinstatement height index key
+func SplitPendingReinstatementHeightIndexKey(key []byte) (int64, sdk.AccAddress) {
+	height := int64(sdk.BigEndianToUint64(key[1:9]))
+	return height, sdk.AccAddress(key[10 : key[9]+10])
+}
+
+// DirtyMarkerKey returns a key [prefix][denom addr] for a marker that needs its supply checked
+func DirtyMarkerKey(markerAddr sdk.AccAddress) []byte {
+	key := DirtyMarkerKeyPrefix
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// PendingDestroyKey returns a key [prefix][denom addr] for a destroyed marker that needs to be removed
+func PendingDestroyKey(markerAddr sdk.AccAddress) []byte {
+	key := PendingDestroyKeyPrefix
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// SplitMarkerIndexKey returns the marker address given a dirty marker or pending destroy key,
+// uses the length prefix to determine length of AccAddress
+func SplitMarkerIndexKey(key []byte) sdk.AccAddress {
+	return sdk.AccAddress(key[2 : key[1]+2])
+}
+
+// MarkerManagerIndexPrefix returns a key prefix [prefix][manager addr] for the markers managed by an address
+func MarkerManagerIndexPrefix(managerAddr sdk.AccAddress) []byte {
+	key := MarkerManagerIndexKeyPrefix
+	return append(key, address.MustLengthPrefix(managerAddr.Bytes())...)
+}
+
+// MarkerManagerIndexKey returns a key [prefix][manager addr][denom addr] for a marker managed by an address
+func MarkerManagerIndexKey(managerAddr, markerAddr sdk.AccAddress) []byte {
+	return append(MarkerManagerIndexPrefix(managerAddr), address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// MarkerAccessIndexPrefix returns a key prefix [prefix][holder addr] for the markers that grant access to an address
+func MarkerAccessIndexPrefix(holderAddr sdk.AccAddress) []byte {
+	key := MarkerAccessIndexKeyPrefix
+	return append(key, address.MustLengthPrefix(holderAddr.Bytes())...)
+}
+
+// MarkerAccessIndexKey returns a key [prefix][holder addr][denom addr] for a marker that grants access to an address
+func MarkerAccessIndexKey(holderAddr, markerAddr sdk.AccAddress) []byte {
+	return append(MarkerAccessIndexPrefix(holderAddr), address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// MarkerTypeIndexPrefix returns a key prefix [prefix][marker type] for the markers of a marker type
+func MarkerTypeIndexPrefix(markerType MarkerType) []byte {
+	key := MarkerTypeIndexKeyPrefix
+	return append(key, byte(markerType))
+}
+
+// MarkerTypeIndexKey returns a key [prefix][marker type][denom addr] for a marker of a marker type
+func MarkerTypeIndexKey(markerType MarkerType, markerAddr sdk.AccAddress) []byte {
+	return append(MarkerTypeIndexPrefix(markerType), address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// MarkerForcedTransferIndexPrefix returns a key prefix [prefix][allowed] for the markers that allow (or disallow)
+// forced transfers
+func MarkerForcedTransferIndexPrefix(allowed bool) []byte {
+	key := MarkerForcedTransferIndexKeyPrefix
+	if allowed {
+		return append(key, 0x01)
+	}
+	return append(key, 0x00)
+}
+
+// MarkerForcedTransferIndexKey returns a key [prefix][allowed][denom addr] for a marker's allow forced transfer flag
+func MarkerForcedTransferIndexKey(allowed bool, markerAddr sdk.AccAddress) []byte {
+	return append(MarkerForcedTransferIndexPrefix(allowed), address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// MarkerRequiredAttributeIndexPrefix returns a key prefix [prefix][attribute hash] for the markers that require an
+// attribute. The attribute name is hashed since names can be longer than a length prefix allows.
+func MarkerRequiredAttributeIndexPrefix(attribute string) []byte {
+	key := MarkerRequiredAttributeIndexKeyPrefix
+	hash := sha256.Sum256([]byte(attribute))
+	return append(key, hash[:]...)
+}
+
+// MarkerRequiredAttributeIndexKey returns a key [prefix][attribute hash][denom addr] for a marker requiring an attribute
+func MarkerRequiredAttributeIndexKey(attribute string, markerAddr sdk.AccAddress) []byte {
+	return append(MarkerRequiredAttributeIndexPrefix(attribute), address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// EscrowHolderIndexPrefix returns a key prefix [prefix][escrow denom] for the markers holding a denom in escrow
+func EscrowHolderIndexPrefix(denom string) []byte {
+	key := EscrowHolderIndexKeyPrefix
+	return append(key, address.MustLengthPrefix([]byte(denom))...)
+}
+
+// EscrowHolderIndexKey returns a key [prefix][escrow denom][denom addr] for a marker holding a denom in escrow
+func EscrowHolderIndexKey(denom string, markerAddr sdk.AccAddress) []byte {
+	return append(EscrowHolderIndexPrefix(denom), address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// SplitMarkerSearchIndexKey returns the marker address given a marker search index key with the index prefix
+// portion removed, uses the length prefix to determine length of AccAddress
+func SplitMarkerSearchIndexKey(key []byte) sdk.AccAddress {
+	return sdk.AccAddress(key[1 : key[0]+1])
+}
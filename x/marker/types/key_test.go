@@ -1,7 +1,9 @@
 package types
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
@@ -34,3 +36,97 @@ func TestDenySendKey(t *testing.T) {
 	assert.Equal(t, denyAddr.Bytes(), denyKey[denomArrLen+3:denomArrLen+3+denyAddrLen], "should match deny key")
 	assert.Len(t, denyKey, int(3+denomArrLen+denyAddrLen), "should have key of length of sum 1 for prefix 2 length bytes and length of denom and deny address")
 }
+
+func TestTransferVolumeKey(t *testing.T) {
+	addr, err := MarkerAddress("nhash")
+	require.NoError(t, err)
+	holderAddr := sdk.AccAddress("holder_address______")
+	blockTime := time.Date(2023, 3, 14, 15, 9, 26, 535897932, time.UTC)
+	timeLen := len(sdk.FormatTimeBytes(blockTime))
+
+	holderKey := TransferVolumeKey(addr, holderAddr, blockTime)
+	assert.Equal(t, uint8(5), holderKey[0], "should have correct prefix for transfer volume key")
+	assert.Equal(t, addr.Bytes(), holderKey[2:22], "should match denom key")
+	assert.Equal(t, TransferVolumeMarkerPrefix(addr), holderKey[:22], "should start with the marker prefix")
+	assert.Equal(t, uint8(20), holderKey[22+timeLen], "should have holder address length")
+	parsedTime, parsedHolder, err := SplitTransferVolumeKey(holderKey[22:])
+	require.NoError(t, err, "SplitTransferVolumeKey holder key")
+	assert.Equal(t, blockTime, parsedTime, "holder key block time")
+	assert.Equal(t, holderAddr, parsedHolder, "holder key holder address")
+
+	markerKey := TransferVolumeKey(addr, nil, blockTime)
+	assert.Equal(t, uint8(0), markerKey[22+timeLen], "should have zero length byte for marker-wide entries")
+	parsedTime, parsedHolder, err = SplitTransferVolumeKey(markerKey[22:])
+	require.NoError(t, err, "SplitTransferVolumeKey marker key")
+	assert.Equal(t, blockTime, parsedTime, "marker key block time")
+	assert.Empty(t, parsedHolder, "marker key holder address")
+
+	laterKey := TransferVolumeKey(addr, nil, blockTime.Add(time.Second))
+	assert.Less(t, string(holderKey), string(laterKey), "entries should be ordered by block time")
+
+	_, _, err = SplitTransferVolumeKey(markerKey[22 : 22+timeLen])
+	assert.EqualError(t, err, fmt.Sprintf("transfer volume key too short: %d", timeLen), "SplitTransferVolumeKey without holder")
+	_, _, err = SplitTransferVolumeKey(holderKey[22 : len(holderKey)-1])
+	assert.EqualError(t, err, "invalid transfer volume holder address length: 20", "SplitTransferVolumeKey truncated holder")
+
+	holderTotalKey := TransferVolumeTotalKey(addr, holderAddr)
+	assert.Equal(t, uint8(0x16), holderTotalKey[0], "should have correct prefix for transfer volume total key")
+	assert.Equal(t, TransferVolumeTotalMarkerPrefix(addr), holderTotalKey[:22], "should start with the marker total prefix")
+	assert.Equal(t, holderAddr.Bytes(), holderTotalKey[23:], "should match holder key")
+	assert.Equal(t, append(TransferVolumeTotalMarkerPrefix(addr), 0x00), TransferVolumeTotalKey(addr, nil), "marker-wide total key")
+}
+
+func TestMarkerIndexKeys(t *testing.T) {
+	addr, err := MarkerAddress("nhash")
+	require.NoError(t, err)
+
+	dirtyKey := DirtyMarkerKey(addr)
+	assert.Equal(t, uint8(8), dirtyKey[0], "should have correct prefix for dirty marker key")
+	assert.Equal(t, addr, SplitMarkerIndexKey(dirtyKey), "should parse marker address from dirty marker key")
+
+	destroyKey := PendingDestroyKey(addr)
+	assert.Equal(t, uint8(9), destroyKey[0], "should have correct prefix for pending destroy key")
+	assert.Equal(t, addr, SplitMarkerIndexKey(destroyKey), "should parse marker address from pending destroy key")
+
+	reinstateKey := PendingReinstatementKey(addr)
+	assert.Equal(t, uint8(0x15), reinstateKey[0], "should have correct prefix for pending reinstatement key")
+	assert.Equal(t, addr, SplitMarkerIndexKey(reinstateKey), "should parse marker address from pending reinstatement key")
+
+	reinstateHeightKey := PendingReinstatementHeightIndexKey(15, addr)
+	assert.Equal(t, uint8(0x17), reinstateHeightKey[0], "should have correct prefix for pending reinstatement height index key")
+	assert.Equal(t, PendingReinstatementHeightIndexPrefix(15), reinstateHeightKey[:9], "should start with the reinstate height prefix")
+	height, heightAddr := SplitPendingReinstatementHeightIndexKey(reinstateHeightKey)
+	assert.Equal(t, int64(15), height, "should parse reinstate height from pending reinstatement height index key")
+	assert.Equal(t, addr, heightAddr, "should parse marker address from pending reinstatement height index key")
+}
+
+func TestMarkerSearchIndexKeys(t *testing.T) {
+	addr, err := MarkerAddress("nhash")
+	require.NoError(t, err)
+	holderAddr := sdk.AccAddress("holder_address______")
+
+	managerKey := MarkerManagerIndexKey(holderAddr, addr)
+	assert.Equal(t, uint8(0x0A), managerKey[0], "should have correct prefix for manager index key")
+	assert.Equal(t, addr, SplitMarkerSearchIndexKey(managerKey[len(MarkerManagerIndexPrefix(holderAddr)):]), "manager index marker address")
+
+	accessKey := MarkerAccessIndexKey(holderAddr, addr)
+	assert.Equal(t, uint8(0x0B), accessKey[0], "should have correct prefix for access index key")
+	assert.Equal(t, addr, SplitMarkerSearchIndexKey(accessKey[len(MarkerAccessIndexPrefix(holderAddr)):]), "access index marker address")
+
+	typeKey := MarkerTypeIndexKey(MarkerType_RestrictedCoin, addr)
+	assert.Equal(t, []byte{0x0C, byte(MarkerType_RestrictedCoin)}, typeKey[:2], "should have correct prefix for type index key")
+	assert.Equal(t, addr, SplitMarkerSearchIndexKey(typeKey[2:]), "type index marker address")
+
+	assert.Equal(t, []byte{0x0D, 0x01}, MarkerForcedTransferIndexPrefix(true), "forced transfer allowed prefix")
+	assert.Equal(t, []byte{0x0D, 0x00}, MarkerForcedTransferIndexPrefix(false), "forced transfer disallowed prefix")
+	assert.Equal(t, addr, SplitMarkerSearchIndexKey(MarkerForcedTransferIndexKey(true, addr)[2:]), "forced transfer index marker address")
+
+	attrPrefix := MarkerRequiredAttributeIndexPrefix("kyc.provenance.io")
+	assert.Len(t, attrPrefix, 33, "required attribute prefix should be the prefix byte and a 32 byte hash")
+	assert.NotEqual(t, attrPrefix, MarkerRequiredAttributeIndexPrefix("*.provenance.io"), "different attributes should have different prefixes")
+	assert.Equal(t, addr, SplitMarkerSearchIndexKey(MarkerRequiredAttributeIndexKey("kyc.provenance.io", addr)[33:]), "required attribute index marker address")
+
+	escrowPrefix := EscrowHolderIndexPrefix("hotdog")
+	assert.Equal(t, append([]byte{0x14, 6}, "hotdog"...), escrowPrefix, "escrow holder index prefix")
+	assert.Equal(t, addr, SplitMarkerSearchIndexKey(EscrowHolderIndexKey("hotdog", addr)[len(escrowPrefix):]), "escrow holder index marker address")
+}
@@ -31,6 +31,7 @@ type MarkerAccountI interface {
 	GetDenom() string
 	GetManager() sdk.AccAddress
 	GetMarkerType() MarkerType
+	SetMarkerType(MarkerType)
 
 	GetStatus() MarkerStatus
 	SetStatus(MarkerStatus) error
@@ -39,6 +40,9 @@ type MarkerAccountI interface {
 	SetSupply(sdk.Coin) error
 	HasFixedSupply() bool
 
+	GetMaxSupply() sdk.Int
+	SetMaxSupply(sdk.Int) error
+
 	GrantAccess(AccessGrantI) error
 	RevokeAccess(sdk.AccAddress) error
 	GetAccessList() []AccessGrant
@@ -65,6 +69,7 @@ func NewEmptyMarkerAccount(denom, manager string, grants []AccessGrant) *MarkerA
 		Denom:                  denom,
 		Manager:                manager,
 		Supply:                 sdk.ZeroInt(),
+		MaxSupply:              sdk.ZeroInt(),
 		Status:                 StatusProposed,
 		MarkerType:             MarkerType_Coin,
 		SupplyFixed:            true,
@@ -93,6 +98,7 @@ func NewMarkerAccount(
 		Denom:                  totalSupply.Denom,
 		Manager:                manager.String(),
 		Supply:                 totalSupply.Amount,
+		MaxSupply:              sdk.ZeroInt(),
 		AccessControl:          accessControls,
 		Status:                 status,
 		MarkerType:             markerType,
@@ -199,6 +205,13 @@ func (ma MarkerAccount) Validate() error {
 	if ma.AllowForcedTransfer && ma.MarkerType != MarkerType_RestrictedCoin {
 		return fmt.Errorf("forced transfers can only be allowed on restricted markers")
 	}
+	maxSupply := ma.GetMaxSupply()
+	if maxSupply.IsNegative() {
+		return fmt.Errorf("max supply must be greater than or equal to zero")
+	}
+	if maxSupply.IsPositive() && ma.Supply.GT(maxSupply) {
+		return fmt.Errorf("total supply %s cannot exceed max supply %s", ma.Supply, maxSupply)
+	}
 	return ma.BaseAccount.Validate()
 }
 
@@ -303,6 +316,11 @@ func (ma MarkerAccount) GetMarkerType() MarkerType {
 	return ma.MarkerType
 }
 
+// SetMarkerType sets the type of the marker account.
+func (ma *MarkerAccount) SetMarkerType(markerType MarkerType) {
+	ma.MarkerType = markerType
+}
+
 // GetAddress returns the address of the marker account.
 func (ma MarkerAccount) GetAddress() sdk.AccAddress {
 	addr, err := sdk.AccAddressFromBech32(ma.Address)
@@ -352,6 +370,23 @@ func (ma MarkerAccount) GetSupply() sdk.Coin {
 	return sdk.NewCoin(ma.Denom, ma.Supply)
 }
 
+// GetMaxSupply returns the maximum supply of the marker. Zero indicates there is no per-marker cap.
+func (ma MarkerAccount) GetMaxSupply() sdk.Int {
+	if ma.MaxSupply.IsNil() {
+		return sdk.ZeroInt()
+	}
+	return ma.MaxSupply
+}
+
+// SetMaxSupply sets the maximum supply of the marker. Zero removes the per-marker cap.
+func (ma *MarkerAccount) SetMaxSupply(maxSupply sdk.Int) error {
+	if maxSupply.IsNil() || maxSupply.IsNegative() {
+		return fmt.Errorf("max supply must be greater than or equal to zero")
+	}
+	ma.MaxSupply = maxSupply
+	return nil
+}
+
 // GrantAccess appends the access grant to the marker account.
 func (ma *MarkerAccount) GrantAccess(access AccessGrantI) error {
 	if err := access.Validate(); err != nil {
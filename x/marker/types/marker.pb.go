@@ -7,18 +7,24 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/x/auth/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -26,6 +32,56 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// SupplyChangeType defines the kinds of changes that are recorded in a marker's supply history.
+type SupplyChangeType int32
+
+const (
+	// SUPPLY_CHANGE_TYPE_UNSPECIFIED is an invalid/unknown supply change type.
+	SupplyChangeUnspecified SupplyChangeType = 0
+	// SUPPLY_CHANGE_TYPE_MINT is a supply increase made with a Mint request.
+	SupplyChangeMint SupplyChangeType = 1
+	// SUPPLY_CHANGE_TYPE_BURN is a supply decrease made with a Burn request.
+	SupplyChangeBurn SupplyChangeType = 2
+	// SUPPLY_CHANGE_TYPE_GOV_INCREASE is a supply increase made through governance.
+	SupplyChangeGovIncrease SupplyChangeType = 3
+	// SUPPLY_CHANGE_TYPE_GOV_DECREASE is a supply decrease made through governance.
+	SupplyChangeGovDecrease SupplyChangeType = 4
+	// SUPPLY_CHANGE_TYPE_CIRCULATION_INCREASE is a correction made by the module to bring the amount in circulation up
+	// to a fixed supply.
+	SupplyChangeCirculationIncrease SupplyChangeType = 5
+	// SUPPLY_CHANGE_TYPE_CIRCULATION_DECREASE is a correction made by the module to bring the amount in circulation down
+	// to a fixed supply.
+	SupplyChangeCirculationDecrease SupplyChangeType = 6
+)
+
+var SupplyChangeType_name = map[int32]string{
+	0: "SUPPLY_CHANGE_TYPE_UNSPECIFIED",
+	1: "SUPPLY_CHANGE_TYPE_MINT",
+	2: "SUPPLY_CHANGE_TYPE_BURN",
+	3: "SUPPLY_CHANGE_TYPE_GOV_INCREASE",
+	4: "SUPPLY_CHANGE_TYPE_GOV_DECREASE",
+	5: "SUPPLY_CHANGE_TYPE_CIRCULATION_INCREASE",
+	6: "SUPPLY_CHANGE_TYPE_CIRCULATION_DECREASE",
+}
+
+var SupplyChangeType_value = map[string]int32{
+	"SUPPLY_CHANGE_TYPE_UNSPECIFIED":          0,
+	"SUPPLY_CHANGE_TYPE_MINT":                 1,
+	"SUPPLY_CHANGE_TYPE_BURN":                 2,
+	"SUPPLY_CHANGE_TYPE_GOV_INCREASE":         3,
+	"SUPPLY_CHANGE_TYPE_GOV_DECREASE":         4,
+	"SUPPLY_CHANGE_TYPE_CIRCULATION_INCREASE": 5,
+	"SUPPLY_CHANGE_TYPE_CIRCULATION_DECREASE": 6,
+}
+
+func (x SupplyChangeType) String() string {
+	return proto.EnumName(SupplyChangeType_name, int32(x))
+}
+
+func (SupplyChangeType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{0}
+}
+
 // MarkerType defines the types of marker
 type MarkerType int32
 
@@ -55,7 +111,7 @@ func (x MarkerType) String() string {
 }
 
 func (MarkerType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{0}
+	return fileDescriptor_f7e2c25c71db7f99, []int{1}
 }
 
 // MarkerStatus defines the various states a marker account can be in.
@@ -96,7 +152,7 @@ var MarkerStatus_value = map[string]int32{
 }
 
 func (MarkerStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{1}
+	return fileDescriptor_f7e2c25c71db7f99, []int{2}
 }
 
 // Params defines the set of params for the account module.
@@ -108,6 +164,10 @@ type Params struct {
 	// a regular expression used to validate marker denom values from normal create requests (governance
 	// requests are only subject to platform coin validation denom expression)
 	UnrestrictedDenomRegex string `protobuf:"bytes,3,opt,name=unrestricted_denom_regex,json=unrestrictedDenomRegex,proto3" json:"unrestricted_denom_regex,omitempty"`
+	// maximum number of supply history entries to retain for each marker (zero disables the supply history)
+	MaxSupplyHistoryEntries uint32 `protobuf:"varint,4,opt,name=max_supply_history_entries,json=maxSupplyHistoryEntries,proto3" json:"max_supply_history_entries,omitempty"`
+	// number of blocks to wait before a cancelled marker is reinstated
+	ReinstateDelayBlocks uint64 `protobuf:"varint,5,opt,name=reinstate_delay_blocks,json=reinstateDelayBlocks,proto3" json:"reinstate_delay_blocks,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -156,6 +216,20 @@ func (m *Params) GetUnrestrictedDenomRegex() string {
 	return ""
 }
 
+func (m *Params) GetMaxSupplyHistoryEntries() uint32 {
+	if m != nil {
+		return m.MaxSupplyHistoryEntries
+	}
+	return 0
+}
+
+func (m *Params) GetReinstateDelayBlocks() uint64 {
+	if m != nil {
+		return m.ReinstateDelayBlocks
+	}
+	return 0
+}
+
 // MarkerAccount holds the marker configuration information in addition to a base account structure.
 type MarkerAccount struct {
 	// base cosmos account information including address and coin holdings.
@@ -183,6 +257,10 @@ type MarkerAccount struct {
 	// list of required attributes on restricted marker in order to send and receive transfers if sender does not have
 	// transfer authority
 	RequiredAttributes []string `protobuf:"bytes,11,rep,name=required_attributes,json=requiredAttributes,proto3" json:"required_attributes,omitempty"`
+	// the maximum supply the marker can be increased to by minting or a supply increase. Zero indicates there is no
+	// per-marker cap (the max_total_supply param still applies). It can only be changed through governance or by a
+	// majority of the marker's admins.
+	MaxSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,12,opt,name=max_supply,json=maxSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_supply" yaml:"max_supply"`
 }
 
 func (m *MarkerAccount) Reset()      { *m = MarkerAccount{} }
@@ -217,28 +295,33 @@ func (m *MarkerAccount) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MarkerAccount proto.InternalMessageInfo
 
-// EventMarkerAdd event emitted when marker is added
-type EventMarkerAdd struct {
-	Denom      string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	Amount     string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Status     string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	Manager    string `protobuf:"bytes,4,opt,name=manager,proto3" json:"manager,omitempty"`
-	MarkerType string `protobuf:"bytes,5,opt,name=marker_type,json=markerType,proto3" json:"marker_type,omitempty"`
-	Address    string `protobuf:"bytes,6,opt,name=address,proto3" json:"address,omitempty"`
-}
-
-func (m *EventMarkerAdd) Reset()         { *m = EventMarkerAdd{} }
-func (m *EventMarkerAdd) String() string { return proto.CompactTextString(m) }
-func (*EventMarkerAdd) ProtoMessage()    {}
-func (*EventMarkerAdd) Descriptor() ([]byte, []int) {
+// TransferLimit defines caps on the amount of a restricted marker's denom that can be transferred during a rolling
+// window of time.
+type TransferLimit struct {
+	// denom is the denomination of the marker these limits apply to.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// window is the length of the rolling window that transfer volume is measured over.
+	Window time.Duration `protobuf:"bytes,2,opt,name=window,proto3,stdduration" json:"window"`
+	// marker_limit is the maximum amount that can be transferred by all holders combined during the window.
+	// Zero indicates that there is no marker-wide limit.
+	MarkerLimit github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=marker_limit,json=markerLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"marker_limit"`
+	// holder_limit is the maximum amount that any single holder can transfer during the window.
+	// Zero indicates that there is no per-holder limit.
+	HolderLimit github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=holder_limit,json=holderLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"holder_limit"`
+}
+
+func (m *TransferLimit) Reset()         { *m = TransferLimit{} }
+func (m *TransferLimit) String() string { return proto.CompactTextString(m) }
+func (*TransferLimit) ProtoMessage()    {}
+func (*TransferLimit) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f7e2c25c71db7f99, []int{2}
 }
-func (m *EventMarkerAdd) XXX_Unmarshal(b []byte) error {
+func (m *TransferLimit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarkerAdd) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TransferLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarkerAdd.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TransferLimit.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -248,79 +331,56 @@ func (m *EventMarkerAdd) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *EventMarkerAdd) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarkerAdd.Merge(m, src)
+func (m *TransferLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferLimit.Merge(m, src)
 }
-func (m *EventMarkerAdd) XXX_Size() int {
+func (m *TransferLimit) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarkerAdd) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarkerAdd.DiscardUnknown(m)
+func (m *TransferLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferLimit.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarkerAdd proto.InternalMessageInfo
+var xxx_messageInfo_TransferLimit proto.InternalMessageInfo
 
-func (m *EventMarkerAdd) GetDenom() string {
+func (m *TransferLimit) GetDenom() string {
 	if m != nil {
 		return m.Denom
 	}
 	return ""
 }
 
-func (m *EventMarkerAdd) GetAmount() string {
-	if m != nil {
-		return m.Amount
-	}
-	return ""
-}
-
-func (m *EventMarkerAdd) GetStatus() string {
-	if m != nil {
-		return m.Status
-	}
-	return ""
-}
-
-func (m *EventMarkerAdd) GetManager() string {
-	if m != nil {
-		return m.Manager
-	}
-	return ""
-}
-
-func (m *EventMarkerAdd) GetMarkerType() string {
-	if m != nil {
-		return m.MarkerType
-	}
-	return ""
-}
-
-func (m *EventMarkerAdd) GetAddress() string {
+func (m *TransferLimit) GetWindow() time.Duration {
 	if m != nil {
-		return m.Address
+		return m.Window
 	}
-	return ""
+	return 0
 }
 
-// EventMarkerAddAccess event emitted when marker access is added
-type EventMarkerAddAccess struct {
-	Access        EventMarkerAccess `protobuf:"bytes,1,opt,name=access,proto3" json:"access"`
-	Denom         string            `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	Administrator string            `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
+// IbcPolicy defines the rules for moving a restricted marker's denom over IBC.
+type IbcPolicy struct {
+	// denom is the denomination of the marker this policy applies to.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// allowed_channels are the channels on this chain that the denom can be sent out on and returned through.
+	// An empty list allows any channel.
+	AllowedChannels []string `protobuf:"bytes,2,rep,name=allowed_channels,json=allowedChannels,proto3" json:"allowed_channels,omitempty"`
+	// allowed_destinations are the receiver addresses on the counterparty chains that the denom can be sent to.
+	// An empty list allows any receiver.
+	AllowedDestinations []string `protobuf:"bytes,3,rep,name=allowed_destinations,json=allowedDestinations,proto3" json:"allowed_destinations,omitempty"`
 }
 
-func (m *EventMarkerAddAccess) Reset()         { *m = EventMarkerAddAccess{} }
-func (m *EventMarkerAddAccess) String() string { return proto.CompactTextString(m) }
-func (*EventMarkerAddAccess) ProtoMessage()    {}
-func (*EventMarkerAddAccess) Descriptor() ([]byte, []int) {
+func (m *IbcPolicy) Reset()         { *m = IbcPolicy{} }
+func (m *IbcPolicy) String() string { return proto.CompactTextString(m) }
+func (*IbcPolicy) ProtoMessage()    {}
+func (*IbcPolicy) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f7e2c25c71db7f99, []int{3}
 }
-func (m *EventMarkerAddAccess) XXX_Unmarshal(b []byte) error {
+func (m *IbcPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarkerAddAccess) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *IbcPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarkerAddAccess.Marshal(b, m, deterministic)
+		return xxx_messageInfo_IbcPolicy.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -330,57 +390,63 @@ func (m *EventMarkerAddAccess) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *EventMarkerAddAccess) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarkerAddAccess.Merge(m, src)
+func (m *IbcPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IbcPolicy.Merge(m, src)
 }
-func (m *EventMarkerAddAccess) XXX_Size() int {
+func (m *IbcPolicy) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarkerAddAccess) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarkerAddAccess.DiscardUnknown(m)
+func (m *IbcPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_IbcPolicy.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarkerAddAccess proto.InternalMessageInfo
+var xxx_messageInfo_IbcPolicy proto.InternalMessageInfo
 
-func (m *EventMarkerAddAccess) GetAccess() EventMarkerAccess {
+func (m *IbcPolicy) GetDenom() string {
 	if m != nil {
-		return m.Access
+		return m.Denom
 	}
-	return EventMarkerAccess{}
+	return ""
 }
 
-func (m *EventMarkerAddAccess) GetDenom() string {
+func (m *IbcPolicy) GetAllowedChannels() []string {
 	if m != nil {
-		return m.Denom
+		return m.AllowedChannels
 	}
-	return ""
+	return nil
 }
 
-func (m *EventMarkerAddAccess) GetAdministrator() string {
+func (m *IbcPolicy) GetAllowedDestinations() []string {
 	if m != nil {
-		return m.Administrator
+		return m.AllowedDestinations
 	}
-	return ""
+	return nil
 }
 
-// EventMarkerAccess event access permissions for address
-type EventMarkerAccess struct {
-	Address     string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Permissions []string `protobuf:"bytes,2,rep,name=permissions,proto3" json:"permissions,omitempty"`
+// TransferFee defines the fees charged to the sender on every transfer of a restricted marker's denom.
+type TransferFee struct {
+	// denom is the denomination of the marker this fee applies to.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// recipient is the address that receives the fees, usually the issuer of the marker.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// basis_points is the portion of each transferred amount, in hundredths of a percent, charged in the marker's denom.
+	BasisPoints uint32 `protobuf:"varint,3,opt,name=basis_points,json=basisPoints,proto3" json:"basis_points,omitempty"`
+	// flat_fees are the fixed fees charged on each transfer.
+	FlatFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=flat_fees,json=flatFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"flat_fees"`
 }
 
-func (m *EventMarkerAccess) Reset()         { *m = EventMarkerAccess{} }
-func (m *EventMarkerAccess) String() string { return proto.CompactTextString(m) }
-func (*EventMarkerAccess) ProtoMessage()    {}
-func (*EventMarkerAccess) Descriptor() ([]byte, []int) {
+func (m *TransferFee) Reset()         { *m = TransferFee{} }
+func (m *TransferFee) String() string { return proto.CompactTextString(m) }
+func (*TransferFee) ProtoMessage()    {}
+func (*TransferFee) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f7e2c25c71db7f99, []int{4}
 }
-func (m *EventMarkerAccess) XXX_Unmarshal(b []byte) error {
+func (m *TransferFee) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarkerAccess) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TransferFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarkerAccess.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TransferFee.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -390,51 +456,70 @@ func (m *EventMarkerAccess) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *EventMarkerAccess) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarkerAccess.Merge(m, src)
+func (m *TransferFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferFee.Merge(m, src)
 }
-func (m *EventMarkerAccess) XXX_Size() int {
+func (m *TransferFee) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarkerAccess) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarkerAccess.DiscardUnknown(m)
+func (m *TransferFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferFee.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarkerAccess proto.InternalMessageInfo
+var xxx_messageInfo_TransferFee proto.InternalMessageInfo
 
-func (m *EventMarkerAccess) GetAddress() string {
+func (m *TransferFee) GetDenom() string {
 	if m != nil {
-		return m.Address
+		return m.Denom
 	}
 	return ""
 }
 
-func (m *EventMarkerAccess) GetPermissions() []string {
+func (m *TransferFee) GetRecipient() string {
 	if m != nil {
-		return m.Permissions
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *TransferFee) GetBasisPoints() uint32 {
+	if m != nil {
+		return m.BasisPoints
+	}
+	return 0
+}
+
+func (m *TransferFee) GetFlatFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FlatFees
 	}
 	return nil
 }
 
-// EventMarkerDeleteAccess event emitted when marker access is revoked
-type EventMarkerDeleteAccess struct {
-	RemoveAddress string `protobuf:"bytes,1,opt,name=remove_address,json=removeAddress,proto3" json:"remove_address,omitempty"`
-	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
+// PendingReinstatement is a request to return a cancelled marker to the proposed or finalized status.
+type PendingReinstatement struct {
+	// denom is the denomination of the cancelled marker.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// status is the status the marker will be returned to, either proposed or finalized.
+	Status MarkerStatus `protobuf:"varint,2,opt,name=status,proto3,enum=provenance.marker.v1.MarkerStatus" json:"status,omitempty"`
+	// manager is the address that will manage the marker once it is reinstated.
+	Manager string `protobuf:"bytes,3,opt,name=manager,proto3" json:"manager,omitempty"`
+	// reinstate_height is the block height at which the marker will be reinstated.
+	ReinstateHeight int64 `protobuf:"varint,4,opt,name=reinstate_height,json=reinstateHeight,proto3" json:"reinstate_height,omitempty"`
 }
 
-func (m *EventMarkerDeleteAccess) Reset()         { *m = EventMarkerDeleteAccess{} }
-func (m *EventMarkerDeleteAccess) String() string { return proto.CompactTextString(m) }
-func (*EventMarkerDeleteAccess) ProtoMessage()    {}
-func (*EventMarkerDeleteAccess) Descriptor() ([]byte, []int) {
+func (m *PendingReinstatement) Reset()         { *m = PendingReinstatement{} }
+func (m *PendingReinstatement) String() string { return proto.CompactTextString(m) }
+func (*PendingReinstatement) ProtoMessage()    {}
+func (*PendingReinstatement) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f7e2c25c71db7f99, []int{5}
 }
-func (m *EventMarkerDeleteAccess) XXX_Unmarshal(b []byte) error {
+func (m *PendingReinstatement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarkerDeleteAccess) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PendingReinstatement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarkerDeleteAccess.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PendingReinstatement.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -444,57 +529,78 @@ func (m *EventMarkerDeleteAccess) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *EventMarkerDeleteAccess) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarkerDeleteAccess.Merge(m, src)
+func (m *PendingReinstatement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingReinstatement.Merge(m, src)
 }
-func (m *EventMarkerDeleteAccess) XXX_Size() int {
+func (m *PendingReinstatement) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarkerDeleteAccess) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarkerDeleteAccess.DiscardUnknown(m)
+func (m *PendingReinstatement) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingReinstatement.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarkerDeleteAccess proto.InternalMessageInfo
+var xxx_messageInfo_PendingReinstatement proto.InternalMessageInfo
 
-func (m *EventMarkerDeleteAccess) GetRemoveAddress() string {
+func (m *PendingReinstatement) GetDenom() string {
 	if m != nil {
-		return m.RemoveAddress
+		return m.Denom
 	}
 	return ""
 }
 
-func (m *EventMarkerDeleteAccess) GetDenom() string {
+func (m *PendingReinstatement) GetStatus() MarkerStatus {
 	if m != nil {
-		return m.Denom
+		return m.Status
 	}
-	return ""
+	return StatusUndefined
 }
 
-func (m *EventMarkerDeleteAccess) GetAdministrator() string {
+func (m *PendingReinstatement) GetManager() string {
 	if m != nil {
-		return m.Administrator
+		return m.Manager
 	}
 	return ""
 }
 
-// EventMarkerFinalize event emitted when marker is finalized
-type EventMarkerFinalize struct {
-	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
-}
-
-func (m *EventMarkerFinalize) Reset()         { *m = EventMarkerFinalize{} }
-func (m *EventMarkerFinalize) String() string { return proto.CompactTextString(m) }
-func (*EventMarkerFinalize) ProtoMessage()    {}
-func (*EventMarkerFinalize) Descriptor() ([]byte, []int) {
+func (m *PendingReinstatement) GetReinstateHeight() int64 {
+	if m != nil {
+		return m.ReinstateHeight
+	}
+	return 0
+}
+
+// SupplyHistoryEntry is a record of a change made to the supply of a marker.
+type SupplyHistoryEntry struct {
+	// denom is the denomination of the marker whose supply changed.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// sequence is the position of this entry in the marker's supply history (starting at 1).
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// change_type is the kind of supply change that was made.
+	ChangeType SupplyChangeType `protobuf:"varint,3,opt,name=change_type,json=changeType,proto3,enum=provenance.marker.v1.SupplyChangeType" json:"change_type,omitempty"`
+	// amount is the amount the supply was changed by.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// supply is the supply of the marker after the change.
+	Supply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=supply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"supply"`
+	// authority is the address that requested the change. It is empty for adjustments made by the module.
+	Authority string `protobuf:"bytes,6,opt,name=authority,proto3" json:"authority,omitempty"`
+	// block_height is the height of the block the change was made in.
+	BlockHeight int64 `protobuf:"varint,7,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// block_time is the time of the block the change was made in.
+	BlockTime time.Time `protobuf:"bytes,8,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
+}
+
+func (m *SupplyHistoryEntry) Reset()         { *m = SupplyHistoryEntry{} }
+func (m *SupplyHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*SupplyHistoryEntry) ProtoMessage()    {}
+func (*SupplyHistoryEntry) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f7e2c25c71db7f99, []int{6}
 }
-func (m *EventMarkerFinalize) XXX_Unmarshal(b []byte) error {
+func (m *SupplyHistoryEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarkerFinalize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SupplyHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarkerFinalize.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SupplyHistoryEntry.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -504,50 +610,87 @@ func (m *EventMarkerFinalize) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EventMarkerFinalize) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarkerFinalize.Merge(m, src)
+func (m *SupplyHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplyHistoryEntry.Merge(m, src)
 }
-func (m *EventMarkerFinalize) XXX_Size() int {
+func (m *SupplyHistoryEntry) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarkerFinalize) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarkerFinalize.DiscardUnknown(m)
+func (m *SupplyHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplyHistoryEntry.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarkerFinalize proto.InternalMessageInfo
+var xxx_messageInfo_SupplyHistoryEntry proto.InternalMessageInfo
 
-func (m *EventMarkerFinalize) GetDenom() string {
+func (m *SupplyHistoryEntry) GetDenom() string {
 	if m != nil {
 		return m.Denom
 	}
 	return ""
 }
 
-func (m *EventMarkerFinalize) GetAdministrator() string {
+func (m *SupplyHistoryEntry) GetSequence() uint64 {
 	if m != nil {
-		return m.Administrator
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *SupplyHistoryEntry) GetChangeType() SupplyChangeType {
+	if m != nil {
+		return m.ChangeType
+	}
+	return SupplyChangeUnspecified
+}
+
+func (m *SupplyHistoryEntry) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
 	return ""
 }
 
-// EventMarkerActivate event emitted when marker is activated
-type EventMarkerActivate struct {
-	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
+func (m *SupplyHistoryEntry) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
 }
 
-func (m *EventMarkerActivate) Reset()         { *m = EventMarkerActivate{} }
-func (m *EventMarkerActivate) String() string { return proto.CompactTextString(m) }
-func (*EventMarkerActivate) ProtoMessage()    {}
-func (*EventMarkerActivate) Descriptor() ([]byte, []int) {
+func (m *SupplyHistoryEntry) GetBlockTime() time.Time {
+	if m != nil {
+		return m.BlockTime
+	}
+	return time.Time{}
+}
+
+// NetAssetValue is the value of a volume of a marker's coin, as reported by a marker administrator.
+type NetAssetValue struct {
+	// price is the complete value of the volume of the marker's coin.
+	Price types1.Coin `protobuf:"bytes,1,opt,name=price,proto3" json:"price"`
+	// volume is the amount of the marker's coin (in its base denomination) that the price is for.
+	Volume uint64 `protobuf:"varint,2,opt,name=volume,proto3" json:"volume,omitempty"`
+	// source identifies where the value came from (e.g. a fund administrator, an oracle, or an exchange).
+	Source string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	// sequence is the position of this value in the marker's net asset value history (starting at 1).
+	// It is set by the module when the value is recorded.
+	Sequence uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// updated_block_height is the height of the block the value was recorded in. It is set by the module.
+	UpdatedBlockHeight int64 `protobuf:"varint,5,opt,name=updated_block_height,json=updatedBlockHeight,proto3" json:"updated_block_height,omitempty"`
+}
+
+func (m *NetAssetValue) Reset()         { *m = NetAssetValue{} }
+func (m *NetAssetValue) String() string { return proto.CompactTextString(m) }
+func (*NetAssetValue) ProtoMessage()    {}
+func (*NetAssetValue) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f7e2c25c71db7f99, []int{7}
 }
-func (m *EventMarkerActivate) XXX_Unmarshal(b []byte) error {
+func (m *NetAssetValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarkerActivate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *NetAssetValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarkerActivate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_NetAssetValue.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -557,103 +700,157 @@ func (m *EventMarkerActivate) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EventMarkerActivate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarkerActivate.Merge(m, src)
+func (m *NetAssetValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetAssetValue.Merge(m, src)
 }
-func (m *EventMarkerActivate) XXX_Size() int {
+func (m *NetAssetValue) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarkerActivate) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarkerActivate.DiscardUnknown(m)
+func (m *NetAssetValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetAssetValue.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarkerActivate proto.InternalMessageInfo
+var xxx_messageInfo_NetAssetValue proto.InternalMessageInfo
 
-func (m *EventMarkerActivate) GetDenom() string {
+func (m *NetAssetValue) GetPrice() types1.Coin {
 	if m != nil {
-		return m.Denom
+		return m.Price
 	}
-	return ""
+	return types1.Coin{}
 }
 
-func (m *EventMarkerActivate) GetAdministrator() string {
+func (m *NetAssetValue) GetVolume() uint64 {
 	if m != nil {
-		return m.Administrator
+		return m.Volume
 	}
-	return ""
+	return 0
 }
 
-// EventMarkerCancel event emitted when marker is cancelled
-type EventMarkerCancel struct {
-	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
+func (m *NetAssetValue) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
 }
 
-func (m *EventMarkerCancel) Reset()         { *m = EventMarkerCancel{} }
-func (m *EventMarkerCancel) String() string { return proto.CompactTextString(m) }
-func (*EventMarkerCancel) ProtoMessage()    {}
-func (*EventMarkerCancel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{8}
-}
-func (m *EventMarkerCancel) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+func (m *NetAssetValue) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
 }
-func (m *EventMarkerCancel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_EventMarkerCancel.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *NetAssetValue) GetUpdatedBlockHeight() int64 {
+	if m != nil {
+		return m.UpdatedBlockHeight
 	}
+	return 0
 }
-func (m *EventMarkerCancel) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarkerCancel.Merge(m, src)
+
+// EventMarkerAdd event emitted when marker is added
+type EventMarkerAdd struct {
+	Denom      string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount     string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Status     string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Manager    string `protobuf:"bytes,4,opt,name=manager,proto3" json:"manager,omitempty"`
+	MarkerType string `protobuf:"bytes,5,opt,name=marker_type,json=markerType,proto3" json:"marker_type,omitempty"`
+	Address    string `protobuf:"bytes,6,opt,name=address,proto3" json:"address,omitempty"`
 }
-func (m *EventMarkerCancel) XXX_Size() int {
+
+func (m *EventMarkerAdd) Reset()         { *m = EventMarkerAdd{} }
+func (m *EventMarkerAdd) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerAdd) ProtoMessage()    {}
+func (*EventMarkerAdd) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{8}
+}
+func (m *EventMarkerAdd) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerAdd) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerAdd.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMarkerAdd) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerAdd.Merge(m, src)
+}
+func (m *EventMarkerAdd) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarkerCancel) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarkerCancel.DiscardUnknown(m)
+func (m *EventMarkerAdd) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerAdd.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarkerCancel proto.InternalMessageInfo
+var xxx_messageInfo_EventMarkerAdd proto.InternalMessageInfo
 
-func (m *EventMarkerCancel) GetDenom() string {
+func (m *EventMarkerAdd) GetDenom() string {
 	if m != nil {
 		return m.Denom
 	}
 	return ""
 }
 
-func (m *EventMarkerCancel) GetAdministrator() string {
+func (m *EventMarkerAdd) GetAmount() string {
 	if m != nil {
-		return m.Administrator
+		return m.Amount
 	}
 	return ""
 }
 
-// EventMarkerDelete event emitted when marker is deleted
-type EventMarkerDelete struct {
-	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
+func (m *EventMarkerAdd) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
 }
 
-func (m *EventMarkerDelete) Reset()         { *m = EventMarkerDelete{} }
-func (m *EventMarkerDelete) String() string { return proto.CompactTextString(m) }
-func (*EventMarkerDelete) ProtoMessage()    {}
-func (*EventMarkerDelete) Descriptor() ([]byte, []int) {
+func (m *EventMarkerAdd) GetManager() string {
+	if m != nil {
+		return m.Manager
+	}
+	return ""
+}
+
+func (m *EventMarkerAdd) GetMarkerType() string {
+	if m != nil {
+		return m.MarkerType
+	}
+	return ""
+}
+
+func (m *EventMarkerAdd) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// EventMarkerAddAccess event emitted when marker access is added
+type EventMarkerAddAccess struct {
+	Access        EventMarkerAccess `protobuf:"bytes,1,opt,name=access,proto3" json:"access"`
+	Denom         string            `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Administrator string            `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *EventMarkerAddAccess) Reset()         { *m = EventMarkerAddAccess{} }
+func (m *EventMarkerAddAccess) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerAddAccess) ProtoMessage()    {}
+func (*EventMarkerAddAccess) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f7e2c25c71db7f99, []int{9}
 }
-func (m *EventMarkerDelete) XXX_Unmarshal(b []byte) error {
+func (m *EventMarkerAddAccess) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarkerDelete) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventMarkerAddAccess) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarkerDelete.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventMarkerAddAccess.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -663,51 +860,57 @@ func (m *EventMarkerDelete) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *EventMarkerDelete) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarkerDelete.Merge(m, src)
+func (m *EventMarkerAddAccess) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerAddAccess.Merge(m, src)
 }
-func (m *EventMarkerDelete) XXX_Size() int {
+func (m *EventMarkerAddAccess) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarkerDelete) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarkerDelete.DiscardUnknown(m)
+func (m *EventMarkerAddAccess) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerAddAccess.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarkerDelete proto.InternalMessageInfo
+var xxx_messageInfo_EventMarkerAddAccess proto.InternalMessageInfo
 
-func (m *EventMarkerDelete) GetDenom() string {
+func (m *EventMarkerAddAccess) GetAccess() EventMarkerAccess {
+	if m != nil {
+		return m.Access
+	}
+	return EventMarkerAccess{}
+}
+
+func (m *EventMarkerAddAccess) GetDenom() string {
 	if m != nil {
 		return m.Denom
 	}
 	return ""
 }
 
-func (m *EventMarkerDelete) GetAdministrator() string {
+func (m *EventMarkerAddAccess) GetAdministrator() string {
 	if m != nil {
 		return m.Administrator
 	}
 	return ""
 }
 
-// EventMarkerMint event emitted when additional marker supply is minted
-type EventMarkerMint struct {
-	Amount        string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
+// EventMarkerAccess event access permissions for address
+type EventMarkerAccess struct {
+	Address     string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Permissions []string `protobuf:"bytes,2,rep,name=permissions,proto3" json:"permissions,omitempty"`
 }
 
-func (m *EventMarkerMint) Reset()         { *m = EventMarkerMint{} }
-func (m *EventMarkerMint) String() string { return proto.CompactTextString(m) }
-func (*EventMarkerMint) ProtoMessage()    {}
-func (*EventMarkerMint) Descriptor() ([]byte, []int) {
+func (m *EventMarkerAccess) Reset()         { *m = EventMarkerAccess{} }
+func (m *EventMarkerAccess) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerAccess) ProtoMessage()    {}
+func (*EventMarkerAccess) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f7e2c25c71db7f99, []int{10}
 }
-func (m *EventMarkerMint) XXX_Unmarshal(b []byte) error {
+func (m *EventMarkerAccess) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarkerMint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventMarkerAccess) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarkerMint.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventMarkerAccess.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -717,58 +920,51 @@ func (m *EventMarkerMint) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *EventMarkerMint) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarkerMint.Merge(m, src)
+func (m *EventMarkerAccess) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerAccess.Merge(m, src)
 }
-func (m *EventMarkerMint) XXX_Size() int {
+func (m *EventMarkerAccess) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarkerMint) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarkerMint.DiscardUnknown(m)
+func (m *EventMarkerAccess) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerAccess.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarkerMint proto.InternalMessageInfo
-
-func (m *EventMarkerMint) GetAmount() string {
-	if m != nil {
-		return m.Amount
-	}
-	return ""
-}
+var xxx_messageInfo_EventMarkerAccess proto.InternalMessageInfo
 
-func (m *EventMarkerMint) GetDenom() string {
+func (m *EventMarkerAccess) GetAddress() string {
 	if m != nil {
-		return m.Denom
+		return m.Address
 	}
 	return ""
 }
 
-func (m *EventMarkerMint) GetAdministrator() string {
+func (m *EventMarkerAccess) GetPermissions() []string {
 	if m != nil {
-		return m.Administrator
+		return m.Permissions
 	}
-	return ""
+	return nil
 }
 
-// EventMarkerBurn event emitted when coin is burned from marker
-type EventMarkerBurn struct {
-	Amount        string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+// EventMarkerDeleteAccess event emitted when marker access is revoked
+type EventMarkerDeleteAccess struct {
+	RemoveAddress string `protobuf:"bytes,1,opt,name=remove_address,json=removeAddress,proto3" json:"remove_address,omitempty"`
 	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (m *EventMarkerBurn) Reset()         { *m = EventMarkerBurn{} }
-func (m *EventMarkerBurn) String() string { return proto.CompactTextString(m) }
-func (*EventMarkerBurn) ProtoMessage()    {}
-func (*EventMarkerBurn) Descriptor() ([]byte, []int) {
+func (m *EventMarkerDeleteAccess) Reset()         { *m = EventMarkerDeleteAccess{} }
+func (m *EventMarkerDeleteAccess) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerDeleteAccess) ProtoMessage()    {}
+func (*EventMarkerDeleteAccess) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f7e2c25c71db7f99, []int{11}
 }
-func (m *EventMarkerBurn) XXX_Unmarshal(b []byte) error {
+func (m *EventMarkerDeleteAccess) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarkerBurn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventMarkerDeleteAccess) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarkerBurn.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventMarkerDeleteAccess.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -778,59 +974,57 @@ func (m *EventMarkerBurn) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *EventMarkerBurn) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarkerBurn.Merge(m, src)
+func (m *EventMarkerDeleteAccess) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerDeleteAccess.Merge(m, src)
 }
-func (m *EventMarkerBurn) XXX_Size() int {
+func (m *EventMarkerDeleteAccess) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarkerBurn) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarkerBurn.DiscardUnknown(m)
+func (m *EventMarkerDeleteAccess) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerDeleteAccess.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarkerBurn proto.InternalMessageInfo
+var xxx_messageInfo_EventMarkerDeleteAccess proto.InternalMessageInfo
 
-func (m *EventMarkerBurn) GetAmount() string {
+func (m *EventMarkerDeleteAccess) GetRemoveAddress() string {
 	if m != nil {
-		return m.Amount
+		return m.RemoveAddress
 	}
 	return ""
 }
 
-func (m *EventMarkerBurn) GetDenom() string {
+func (m *EventMarkerDeleteAccess) GetDenom() string {
 	if m != nil {
 		return m.Denom
 	}
 	return ""
 }
 
-func (m *EventMarkerBurn) GetAdministrator() string {
+func (m *EventMarkerDeleteAccess) GetAdministrator() string {
 	if m != nil {
 		return m.Administrator
 	}
 	return ""
 }
 
-// EventMarkerWithdraw event emitted when coins are withdrew from marker
-type EventMarkerWithdraw struct {
-	Coins         string `protobuf:"bytes,1,opt,name=coins,proto3" json:"coins,omitempty"`
-	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
-	ToAddress     string `protobuf:"bytes,4,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+// EventMarkerFinalize event emitted when marker is finalized
+type EventMarkerFinalize struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (m *EventMarkerWithdraw) Reset()         { *m = EventMarkerWithdraw{} }
-func (m *EventMarkerWithdraw) String() string { return proto.CompactTextString(m) }
-func (*EventMarkerWithdraw) ProtoMessage()    {}
-func (*EventMarkerWithdraw) Descriptor() ([]byte, []int) {
+func (m *EventMarkerFinalize) Reset()         { *m = EventMarkerFinalize{} }
+func (m *EventMarkerFinalize) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerFinalize) ProtoMessage()    {}
+func (*EventMarkerFinalize) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f7e2c25c71db7f99, []int{12}
 }
-func (m *EventMarkerWithdraw) XXX_Unmarshal(b []byte) error {
+func (m *EventMarkerFinalize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarkerWithdraw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventMarkerFinalize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarkerWithdraw.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventMarkerFinalize.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -840,67 +1034,50 @@ func (m *EventMarkerWithdraw) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EventMarkerWithdraw) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarkerWithdraw.Merge(m, src)
+func (m *EventMarkerFinalize) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerFinalize.Merge(m, src)
 }
-func (m *EventMarkerWithdraw) XXX_Size() int {
+func (m *EventMarkerFinalize) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarkerWithdraw) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarkerWithdraw.DiscardUnknown(m)
+func (m *EventMarkerFinalize) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerFinalize.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarkerWithdraw proto.InternalMessageInfo
+var xxx_messageInfo_EventMarkerFinalize proto.InternalMessageInfo
 
-func (m *EventMarkerWithdraw) GetCoins() string {
+func (m *EventMarkerFinalize) GetDenom() string {
 	if m != nil {
-		return m.Coins
+		return m.Denom
 	}
 	return ""
 }
 
-func (m *EventMarkerWithdraw) GetDenom() string {
-	if m != nil {
-		return m.Denom
-	}
-	return ""
-}
-
-func (m *EventMarkerWithdraw) GetAdministrator() string {
+func (m *EventMarkerFinalize) GetAdministrator() string {
 	if m != nil {
 		return m.Administrator
 	}
 	return ""
 }
 
-func (m *EventMarkerWithdraw) GetToAddress() string {
-	if m != nil {
-		return m.ToAddress
-	}
-	return ""
-}
-
-// EventMarkerTransfer event emitted when coins are transfered to from account to another
-type EventMarkerTransfer struct {
-	Amount        string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
-	ToAddress     string `protobuf:"bytes,4,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
-	FromAddress   string `protobuf:"bytes,5,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+// EventMarkerActivate event emitted when marker is activated
+type EventMarkerActivate struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (m *EventMarkerTransfer) Reset()         { *m = EventMarkerTransfer{} }
-func (m *EventMarkerTransfer) String() string { return proto.CompactTextString(m) }
-func (*EventMarkerTransfer) ProtoMessage()    {}
-func (*EventMarkerTransfer) Descriptor() ([]byte, []int) {
+func (m *EventMarkerActivate) Reset()         { *m = EventMarkerActivate{} }
+func (m *EventMarkerActivate) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerActivate) ProtoMessage()    {}
+func (*EventMarkerActivate) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f7e2c25c71db7f99, []int{13}
 }
-func (m *EventMarkerTransfer) XXX_Unmarshal(b []byte) error {
+func (m *EventMarkerActivate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarkerTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventMarkerActivate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarkerTransfer.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventMarkerActivate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -910,76 +1087,103 @@ func (m *EventMarkerTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EventMarkerTransfer) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarkerTransfer.Merge(m, src)
+func (m *EventMarkerActivate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerActivate.Merge(m, src)
 }
-func (m *EventMarkerTransfer) XXX_Size() int {
+func (m *EventMarkerActivate) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarkerTransfer) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarkerTransfer.DiscardUnknown(m)
+func (m *EventMarkerActivate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerActivate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarkerTransfer proto.InternalMessageInfo
+var xxx_messageInfo_EventMarkerActivate proto.InternalMessageInfo
 
-func (m *EventMarkerTransfer) GetAmount() string {
+func (m *EventMarkerActivate) GetDenom() string {
 	if m != nil {
-		return m.Amount
+		return m.Denom
 	}
 	return ""
 }
 
-func (m *EventMarkerTransfer) GetDenom() string {
+func (m *EventMarkerActivate) GetAdministrator() string {
 	if m != nil {
-		return m.Denom
+		return m.Administrator
 	}
 	return ""
 }
 
-func (m *EventMarkerTransfer) GetAdministrator() string {
-	if m != nil {
-		return m.Administrator
+// EventMarkerCancel event emitted when marker is cancelled
+type EventMarkerCancel struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *EventMarkerCancel) Reset()         { *m = EventMarkerCancel{} }
+func (m *EventMarkerCancel) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerCancel) ProtoMessage()    {}
+func (*EventMarkerCancel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{14}
+}
+func (m *EventMarkerCancel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerCancel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerCancel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *EventMarkerCancel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerCancel.Merge(m, src)
+}
+func (m *EventMarkerCancel) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerCancel) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerCancel.DiscardUnknown(m)
 }
 
-func (m *EventMarkerTransfer) GetToAddress() string {
+var xxx_messageInfo_EventMarkerCancel proto.InternalMessageInfo
+
+func (m *EventMarkerCancel) GetDenom() string {
 	if m != nil {
-		return m.ToAddress
+		return m.Denom
 	}
 	return ""
 }
 
-func (m *EventMarkerTransfer) GetFromAddress() string {
+func (m *EventMarkerCancel) GetAdministrator() string {
 	if m != nil {
-		return m.FromAddress
+		return m.Administrator
 	}
 	return ""
 }
 
-// EventMarkerSetDenomMetadata event emitted when metadata is set on marker with denom
-type EventMarkerSetDenomMetadata struct {
-	MetadataBase        string            `protobuf:"bytes,1,opt,name=metadata_base,json=metadataBase,proto3" json:"metadata_base,omitempty"`
-	MetadataDescription string            `protobuf:"bytes,2,opt,name=metadata_description,json=metadataDescription,proto3" json:"metadata_description,omitempty"`
-	MetadataDisplay     string            `protobuf:"bytes,3,opt,name=metadata_display,json=metadataDisplay,proto3" json:"metadata_display,omitempty"`
-	MetadataDenomUnits  []*EventDenomUnit `protobuf:"bytes,4,rep,name=metadata_denom_units,json=metadataDenomUnits,proto3" json:"metadata_denom_units,omitempty"`
-	Administrator       string            `protobuf:"bytes,5,opt,name=administrator,proto3" json:"administrator,omitempty"`
-	MetadataName        string            `protobuf:"bytes,6,opt,name=metadata_name,json=metadataName,proto3" json:"metadata_name,omitempty"`
-	MetadataSymbol      string            `protobuf:"bytes,7,opt,name=metadata_symbol,json=metadataSymbol,proto3" json:"metadata_symbol,omitempty"`
+// EventMarkerDelete event emitted when marker is deleted
+type EventMarkerDelete struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (m *EventMarkerSetDenomMetadata) Reset()         { *m = EventMarkerSetDenomMetadata{} }
-func (m *EventMarkerSetDenomMetadata) String() string { return proto.CompactTextString(m) }
-func (*EventMarkerSetDenomMetadata) ProtoMessage()    {}
-func (*EventMarkerSetDenomMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{14}
+func (m *EventMarkerDelete) Reset()         { *m = EventMarkerDelete{} }
+func (m *EventMarkerDelete) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerDelete) ProtoMessage()    {}
+func (*EventMarkerDelete) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{15}
 }
-func (m *EventMarkerSetDenomMetadata) XXX_Unmarshal(b []byte) error {
+func (m *EventMarkerDelete) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarkerSetDenomMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventMarkerDelete) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarkerSetDenomMetadata.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventMarkerDelete.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -989,86 +1193,112 @@ func (m *EventMarkerSetDenomMetadata) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *EventMarkerSetDenomMetadata) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarkerSetDenomMetadata.Merge(m, src)
+func (m *EventMarkerDelete) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerDelete.Merge(m, src)
 }
-func (m *EventMarkerSetDenomMetadata) XXX_Size() int {
+func (m *EventMarkerDelete) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarkerSetDenomMetadata) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarkerSetDenomMetadata.DiscardUnknown(m)
+func (m *EventMarkerDelete) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerDelete.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarkerSetDenomMetadata proto.InternalMessageInfo
+var xxx_messageInfo_EventMarkerDelete proto.InternalMessageInfo
 
-func (m *EventMarkerSetDenomMetadata) GetMetadataBase() string {
+func (m *EventMarkerDelete) GetDenom() string {
 	if m != nil {
-		return m.MetadataBase
+		return m.Denom
 	}
 	return ""
 }
 
-func (m *EventMarkerSetDenomMetadata) GetMetadataDescription() string {
+func (m *EventMarkerDelete) GetAdministrator() string {
 	if m != nil {
-		return m.MetadataDescription
+		return m.Administrator
 	}
 	return ""
 }
 
-func (m *EventMarkerSetDenomMetadata) GetMetadataDisplay() string {
-	if m != nil {
-		return m.MetadataDisplay
-	}
-	return ""
+// EventMarkerMint event emitted when additional marker supply is minted
+type EventMarkerMint struct {
+	Amount        string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (m *EventMarkerSetDenomMetadata) GetMetadataDenomUnits() []*EventDenomUnit {
-	if m != nil {
-		return m.MetadataDenomUnits
+func (m *EventMarkerMint) Reset()         { *m = EventMarkerMint{} }
+func (m *EventMarkerMint) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerMint) ProtoMessage()    {}
+func (*EventMarkerMint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{16}
+}
+func (m *EventMarkerMint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerMint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerMint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *EventMarkerMint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerMint.Merge(m, src)
+}
+func (m *EventMarkerMint) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerMint) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerMint.DiscardUnknown(m)
 }
 
-func (m *EventMarkerSetDenomMetadata) GetAdministrator() string {
+var xxx_messageInfo_EventMarkerMint proto.InternalMessageInfo
+
+func (m *EventMarkerMint) GetAmount() string {
 	if m != nil {
-		return m.Administrator
+		return m.Amount
 	}
 	return ""
 }
 
-func (m *EventMarkerSetDenomMetadata) GetMetadataName() string {
+func (m *EventMarkerMint) GetDenom() string {
 	if m != nil {
-		return m.MetadataName
+		return m.Denom
 	}
 	return ""
 }
 
-func (m *EventMarkerSetDenomMetadata) GetMetadataSymbol() string {
+func (m *EventMarkerMint) GetAdministrator() string {
 	if m != nil {
-		return m.MetadataSymbol
+		return m.Administrator
 	}
 	return ""
 }
 
-// EventDenomUnit denom units for set denom metadata event
-type EventDenomUnit struct {
-	Denom    string   `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	Exponent string   `protobuf:"bytes,2,opt,name=exponent,proto3" json:"exponent,omitempty"`
-	Aliases  []string `protobuf:"bytes,3,rep,name=aliases,proto3" json:"aliases,omitempty"`
+// EventMarkerBurn event emitted when coin is burned from marker
+type EventMarkerBurn struct {
+	Amount        string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (m *EventDenomUnit) Reset()         { *m = EventDenomUnit{} }
-func (m *EventDenomUnit) String() string { return proto.CompactTextString(m) }
-func (*EventDenomUnit) ProtoMessage()    {}
-func (*EventDenomUnit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{15}
+func (m *EventMarkerBurn) Reset()         { *m = EventMarkerBurn{} }
+func (m *EventMarkerBurn) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerBurn) ProtoMessage()    {}
+func (*EventMarkerBurn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{17}
 }
-func (m *EventDenomUnit) XXX_Unmarshal(b []byte) error {
+func (m *EventMarkerBurn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventDenomUnit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventMarkerBurn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventDenomUnit.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventMarkerBurn.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1078,1394 +1308,6990 @@ func (m *EventDenomUnit) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *EventDenomUnit) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventDenomUnit.Merge(m, src)
+func (m *EventMarkerBurn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerBurn.Merge(m, src)
 }
-func (m *EventDenomUnit) XXX_Size() int {
+func (m *EventMarkerBurn) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventDenomUnit) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventDenomUnit.DiscardUnknown(m)
+func (m *EventMarkerBurn) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerBurn.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventDenomUnit proto.InternalMessageInfo
+var xxx_messageInfo_EventMarkerBurn proto.InternalMessageInfo
 
-func (m *EventDenomUnit) GetDenom() string {
+func (m *EventMarkerBurn) GetAmount() string {
 	if m != nil {
-		return m.Denom
+		return m.Amount
 	}
 	return ""
 }
 
-func (m *EventDenomUnit) GetExponent() string {
+func (m *EventMarkerBurn) GetDenom() string {
 	if m != nil {
-		return m.Exponent
+		return m.Denom
 	}
 	return ""
 }
 
-func (m *EventDenomUnit) GetAliases() []string {
+func (m *EventMarkerBurn) GetAdministrator() string {
 	if m != nil {
-		return m.Aliases
+		return m.Administrator
 	}
-	return nil
+	return ""
 }
 
-func init() {
-	proto.RegisterEnum("provenance.marker.v1.MarkerType", MarkerType_name, MarkerType_value)
-	proto.RegisterEnum("provenance.marker.v1.MarkerStatus", MarkerStatus_name, MarkerStatus_value)
-	proto.RegisterType((*Params)(nil), "provenance.marker.v1.Params")
-	proto.RegisterType((*MarkerAccount)(nil), "provenance.marker.v1.MarkerAccount")
-	proto.RegisterType((*EventMarkerAdd)(nil), "provenance.marker.v1.EventMarkerAdd")
-	proto.RegisterType((*EventMarkerAddAccess)(nil), "provenance.marker.v1.EventMarkerAddAccess")
-	proto.RegisterType((*EventMarkerAccess)(nil), "provenance.marker.v1.EventMarkerAccess")
-	proto.RegisterType((*EventMarkerDeleteAccess)(nil), "provenance.marker.v1.EventMarkerDeleteAccess")
-	proto.RegisterType((*EventMarkerFinalize)(nil), "provenance.marker.v1.EventMarkerFinalize")
-	proto.RegisterType((*EventMarkerActivate)(nil), "provenance.marker.v1.EventMarkerActivate")
-	proto.RegisterType((*EventMarkerCancel)(nil), "provenance.marker.v1.EventMarkerCancel")
-	proto.RegisterType((*EventMarkerDelete)(nil), "provenance.marker.v1.EventMarkerDelete")
-	proto.RegisterType((*EventMarkerMint)(nil), "provenance.marker.v1.EventMarkerMint")
-	proto.RegisterType((*EventMarkerBurn)(nil), "provenance.marker.v1.EventMarkerBurn")
-	proto.RegisterType((*EventMarkerWithdraw)(nil), "provenance.marker.v1.EventMarkerWithdraw")
-	proto.RegisterType((*EventMarkerTransfer)(nil), "provenance.marker.v1.EventMarkerTransfer")
-	proto.RegisterType((*EventMarkerSetDenomMetadata)(nil), "provenance.marker.v1.EventMarkerSetDenomMetadata")
-	proto.RegisterType((*EventDenomUnit)(nil), "provenance.marker.v1.EventDenomUnit")
+// EventMarkerWithdraw event emitted when coins are withdrew from marker
+type EventMarkerWithdraw struct {
+	Coins         string `protobuf:"bytes,1,opt,name=coins,proto3" json:"coins,omitempty"`
+	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
+	ToAddress     string `protobuf:"bytes,4,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
 }
 
-func init() { proto.RegisterFile("provenance/marker/v1/marker.proto", fileDescriptor_f7e2c25c71db7f99) }
+func (m *EventMarkerWithdraw) Reset()         { *m = EventMarkerWithdraw{} }
+func (m *EventMarkerWithdraw) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerWithdraw) ProtoMessage()    {}
+func (*EventMarkerWithdraw) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{18}
+}
+func (m *EventMarkerWithdraw) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerWithdraw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerWithdraw.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMarkerWithdraw) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerWithdraw.Merge(m, src)
+}
+func (m *EventMarkerWithdraw) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerWithdraw) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerWithdraw.DiscardUnknown(m)
+}
 
-var fileDescriptor_f7e2c25c71db7f99 = []byte{
-	// 1441 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x3b, 0x6f, 0x1b, 0xc7,
-	0x16, 0xe6, 0xea, 0x41, 0x49, 0x43, 0x89, 0xa6, 0x47, 0xba, 0xf2, 0x9a, 0xf6, 0x25, 0xd7, 0xbc,
-	0xbe, 0xb6, 0xe2, 0xc4, 0x64, 0xa4, 0x04, 0x86, 0xa1, 0x8e, 0x2f, 0x19, 0x44, 0xac, 0x47, 0x96,
-	0x94, 0x03, 0x1b, 0x01, 0x36, 0x43, 0xee, 0x88, 0xde, 0x78, 0x77, 0x86, 0xde, 0x19, 0xd2, 0x62,
-	0x90, 0x26, 0x8d, 0x61, 0xa8, 0x4a, 0x99, 0x14, 0x02, 0x0c, 0x24, 0x45, 0x80, 0x94, 0x49, 0x9d,
-	0xda, 0x48, 0xe5, 0x32, 0x48, 0x21, 0x04, 0x76, 0x93, 0x22, 0x95, 0x7e, 0x41, 0xb0, 0x33, 0xc3,
-	0xe5, 0x32, 0xa2, 0xed, 0x42, 0x71, 0xc5, 0x9d, 0x73, 0xbe, 0x73, 0xe6, 0x3c, 0xbe, 0x99, 0x39,
-	0x04, 0x97, 0x3a, 0x3e, 0xed, 0x61, 0x82, 0x48, 0x0b, 0x17, 0x3c, 0xe4, 0x3f, 0xc0, 0x7e, 0xa1,
-	0xb7, 0xaa, 0xbe, 0xf2, 0x1d, 0x9f, 0x72, 0x0a, 0x97, 0x86, 0x90, 0xbc, 0x52, 0xf4, 0x56, 0xd3,
-	0x4b, 0x6d, 0xda, 0xa6, 0x02, 0x50, 0x08, 0xbe, 0x24, 0x36, 0x9d, 0x69, 0x51, 0xe6, 0x51, 0x56,
-	0x40, 0x5d, 0x7e, 0xbf, 0xd0, 0x5b, 0x6d, 0x62, 0x8e, 0x56, 0xc5, 0x42, 0xe9, 0xcf, 0x4b, 0xbd,
-	0x25, 0x0d, 0xe5, 0x42, 0xa9, 0xae, 0x8c, 0x8d, 0x04, 0xb5, 0x5a, 0x98, 0xb1, 0xb6, 0x8f, 0x08,
-	0x97, 0xb8, 0xdc, 0x4f, 0x1a, 0x88, 0xef, 0x20, 0x1f, 0x79, 0x0c, 0xde, 0x04, 0x29, 0x0f, 0xed,
-	0x5b, 0x9c, 0x72, 0xe4, 0x5a, 0xac, 0xdb, 0xe9, 0xb8, 0x7d, 0x5d, 0x33, 0xb4, 0x95, 0xa9, 0x52,
-	0xf2, 0xd9, 0x51, 0x36, 0xf6, 0xfb, 0x51, 0x36, 0xde, 0x75, 0x08, 0xbf, 0xf1, 0xa1, 0x99, 0xf4,
-	0xd0, 0x7e, 0x23, 0x80, 0xd5, 0x05, 0x0a, 0xbe, 0x0b, 0xce, 0x62, 0x82, 0x9a, 0x2e, 0xb6, 0xda,
-	0xb4, 0x87, 0x7d, 0xb1, 0xab, 0x3e, 0x61, 0x68, 0x2b, 0xb3, 0x66, 0x4a, 0x2a, 0x6e, 0x85, 0x72,
-	0x78, 0x13, 0xe8, 0x5d, 0xe2, 0x63, 0xc6, 0x7d, 0xa7, 0xc5, 0xb1, 0x6d, 0xd9, 0x98, 0x50, 0xcf,
-	0xf2, 0x71, 0x1b, 0xef, 0xeb, 0x93, 0x86, 0xb6, 0x32, 0x67, 0x2e, 0x47, 0xf5, 0x95, 0x40, 0x6d,
-	0x06, 0xda, 0xf5, 0xd9, 0x6f, 0x9e, 0x66, 0x63, 0x7f, 0x3e, 0xcd, 0xc6, 0x72, 0x5f, 0xc5, 0xc1,
-	0xc2, 0xa6, 0xc8, 0xaa, 0xd8, 0x6a, 0xd1, 0x2e, 0xe1, 0xf0, 0x33, 0x30, 0xdf, 0x44, 0x0c, 0x5b,
-	0x48, 0xae, 0x45, 0xe0, 0x89, 0x35, 0x23, 0xaf, 0x8a, 0x22, 0x8a, 0xa6, 0x2a, 0x98, 0x2f, 0x21,
-	0x86, 0x95, 0x5d, 0xe9, 0xc2, 0xf3, 0xa3, 0xac, 0x76, 0x7c, 0x94, 0x5d, 0xec, 0x23, 0xcf, 0x5d,
-	0xcf, 0x45, 0x7d, 0xe4, 0xcc, 0x44, 0x73, 0x88, 0x84, 0x37, 0xc0, 0x8c, 0x87, 0x08, 0x6a, 0x63,
-	0x5f, 0xa4, 0x36, 0x57, 0xba, 0x78, 0x7c, 0x94, 0xd5, 0x3f, 0x67, 0x94, 0xac, 0xe7, 0x94, 0xe2,
-	0x3d, 0xea, 0x39, 0x1c, 0x7b, 0x1d, 0xde, 0xcf, 0x99, 0x03, 0x30, 0xdc, 0x02, 0x49, 0x59, 0x76,
-	0xab, 0x45, 0x09, 0xf7, 0xa9, 0xab, 0x4f, 0x1a, 0x93, 0x2b, 0x89, 0xb5, 0x4b, 0xf9, 0x71, 0x4c,
-	0xc8, 0x17, 0x05, 0xf6, 0x56, 0xd0, 0xa2, 0xd2, 0x54, 0x50, 0x77, 0x73, 0x41, 0x9a, 0x97, 0xa5,
-	0x35, 0x5c, 0x07, 0x71, 0xc6, 0x11, 0xef, 0x32, 0x7d, 0xca, 0xd0, 0x56, 0x92, 0x6b, 0xb9, 0xf1,
-	0x7e, 0x64, 0x79, 0xea, 0x02, 0x69, 0x2a, 0x0b, 0xb8, 0x04, 0xa6, 0x45, 0xb9, 0xf5, 0x69, 0x51,
-	0x68, 0xb9, 0x80, 0x0f, 0x41, 0x5c, 0xb5, 0x3b, 0x2e, 0x12, 0xbb, 0xab, 0xda, 0x7d, 0xa5, 0xed,
-	0xf0, 0xfb, 0xdd, 0x66, 0xbe, 0x45, 0x3d, 0x45, 0x2e, 0xf5, 0x73, 0x9d, 0xd9, 0x0f, 0x0a, 0xbc,
-	0xdf, 0xc1, 0x2c, 0x5f, 0x23, 0xfc, 0xf8, 0x28, 0x7b, 0x55, 0x96, 0x21, 0x4a, 0x9d, 0x9c, 0x21,
-	0x2b, 0x3a, 0x22, 0x33, 0xd5, 0x46, 0xb0, 0x05, 0x12, 0x32, 0x54, 0x2b, 0x70, 0xa3, 0xcf, 0x88,
-	0x4c, 0x8c, 0xd7, 0x65, 0xd2, 0xe8, 0x77, 0x70, 0xc9, 0x38, 0x3e, 0xca, 0x5e, 0x1c, 0x94, 0x3c,
-	0x34, 0x8f, 0x96, 0x1d, 0x78, 0x21, 0x1a, 0x5e, 0x02, 0xf3, 0x72, 0x3b, 0x6b, 0xcf, 0xd9, 0xc7,
-	0xb6, 0x3e, 0x2b, 0x18, 0x99, 0x90, 0xb2, 0x8d, 0x40, 0x14, 0x90, 0x11, 0xb9, 0x2e, 0x7d, 0x14,
-	0x21, 0x6e, 0xd8, 0xa6, 0x39, 0x01, 0x5f, 0x16, 0xfa, 0x21, 0x7f, 0x07, 0x6d, 0x58, 0x03, 0xff,
-	0x91, 0x96, 0x7b, 0xd4, 0x6f, 0x61, 0xdb, 0xe2, 0x3e, 0x22, 0x6c, 0x0f, 0xfb, 0x3a, 0x10, 0x66,
-	0x8b, 0x42, 0xb9, 0x21, 0x74, 0x0d, 0xa5, 0x82, 0x05, 0xb0, 0xe8, 0xe3, 0x87, 0x5d, 0xc7, 0xc7,
-	0xb6, 0x85, 0x38, 0xf7, 0x9d, 0x66, 0x97, 0x63, 0xa6, 0x27, 0x8c, 0xc9, 0x95, 0x39, 0x13, 0x0e,
-	0x54, 0xc5, 0x50, 0xb3, 0x9e, 0x7e, 0xf2, 0x34, 0x1b, 0x0b, 0x58, 0xff, 0xeb, 0xcf, 0xd7, 0x93,
-	0x23, 0x84, 0xaf, 0xe5, 0x7e, 0xd4, 0x40, 0xb2, 0xda, 0xc3, 0x84, 0x2b, 0xb9, 0x6d, 0x0f, 0xdb,
-	0xab, 0x45, 0xdb, 0xbb, 0x0c, 0xe2, 0xc8, 0x13, 0x87, 0x42, 0xf0, 0xd6, 0x54, 0xab, 0x40, 0xae,
-	0x88, 0x24, 0x8f, 0xdd, 0x80, 0x24, 0xfa, 0x90, 0xe8, 0x53, 0x42, 0x11, 0x52, 0x39, 0x3b, 0xda,
-	0x35, 0x49, 0xa2, 0x68, 0xc5, 0x75, 0x30, 0x83, 0x6c, 0xdb, 0xc7, 0x8c, 0x49, 0x2a, 0x99, 0x83,
-	0x65, 0xee, 0x5b, 0x0d, 0x2c, 0x8d, 0x46, 0x2b, 0x89, 0x0e, 0xab, 0x20, 0x2e, 0xf9, 0xad, 0x8e,
-	0xec, 0xd5, 0xf1, 0x24, 0x88, 0xda, 0x0a, 0xb8, 0x3a, 0x1c, 0xca, 0x78, 0x98, 0xfa, 0x44, 0x34,
-	0xf5, 0xcb, 0x60, 0x01, 0xd9, 0x9e, 0x43, 0x1c, 0xc6, 0x7d, 0xc4, 0xa9, 0xaf, 0x32, 0x1d, 0x15,
-	0xe6, 0xb6, 0xc1, 0xd9, 0x13, 0xee, 0xa3, 0xa9, 0x68, 0x23, 0xa9, 0x40, 0x03, 0x24, 0x3a, 0xd8,
-	0xf7, 0x1c, 0xc6, 0x1c, 0x4a, 0x98, 0x3e, 0x21, 0xba, 0x17, 0x15, 0xe5, 0xbe, 0x04, 0xe7, 0x22,
-	0x0e, 0x2b, 0xd8, 0xc5, 0x1c, 0x2b, 0xb7, 0xff, 0x07, 0x49, 0x1f, 0x7b, 0xb4, 0x87, 0xad, 0x51,
-	0xef, 0x0b, 0x52, 0x5a, 0x54, 0x7b, 0x9c, 0x26, 0x9d, 0x8f, 0xc1, 0x62, 0x64, 0xf7, 0x0d, 0x87,
-	0x20, 0xd7, 0xf9, 0x02, 0xbf, 0x82, 0x1c, 0x27, 0x5c, 0x4e, 0xbc, 0xd9, 0x65, 0xb1, 0xc5, 0x9d,
-	0x1e, 0xe2, 0xa7, 0x73, 0x39, 0x5a, 0xf4, 0x72, 0xd0, 0x6e, 0xf7, 0x5f, 0x74, 0x28, 0x8b, 0x7e,
-	0x2a, 0x87, 0x18, 0x9c, 0x89, 0x38, 0xdc, 0x74, 0xe4, 0x91, 0x51, 0x47, 0x49, 0x1b, 0x39, 0x4a,
-	0xa7, 0x69, 0xd7, 0xe8, 0x36, 0xa5, 0xae, 0x4f, 0xde, 0xca, 0x36, 0x8f, 0xb5, 0x91, 0x1e, 0x7e,
-	0xe2, 0xf0, 0xfb, 0xb6, 0x8f, 0x1e, 0x05, 0x3e, 0x5b, 0xd4, 0x21, 0x03, 0x1e, 0xca, 0xc5, 0x69,
-	0x76, 0x82, 0xff, 0x05, 0x80, 0xd3, 0x90, 0xde, 0xf2, 0x0a, 0x99, 0xe3, 0x54, 0x51, 0x3b, 0xb8,
-	0xb7, 0xa2, 0x81, 0x84, 0x97, 0xe3, 0x5b, 0x48, 0xfa, 0x0d, 0xa1, 0x04, 0x0f, 0xc4, 0x9e, 0x4f,
-	0xbd, 0x10, 0x20, 0x2f, 0xb4, 0x44, 0x20, 0x1b, 0x44, 0xfb, 0xd7, 0x04, 0xb8, 0x10, 0x89, 0xb6,
-	0x8e, 0xb9, 0x18, 0x48, 0x36, 0x31, 0x47, 0x36, 0xe2, 0x08, 0xfe, 0x0f, 0x2c, 0x78, 0xea, 0xdb,
-	0x0a, 0xa6, 0x05, 0x15, 0xfc, 0xfc, 0x40, 0x18, 0xcc, 0x1a, 0x70, 0x15, 0x2c, 0x85, 0x20, 0x1b,
-	0xb3, 0x96, 0xef, 0x74, 0xb8, 0x43, 0x89, 0xca, 0x68, 0x71, 0xa0, 0xab, 0x0c, 0x55, 0xf0, 0x1d,
-	0x90, 0x1a, 0x9a, 0x38, 0xac, 0xe3, 0xa2, 0xbe, 0x4a, 0xf1, 0x4c, 0x08, 0x97, 0x62, 0x78, 0x67,
-	0xc4, 0x7b, 0x30, 0x4c, 0x75, 0x89, 0xc3, 0x83, 0x74, 0x83, 0x31, 0xe3, 0xf2, 0x6b, 0xee, 0x53,
-	0x91, 0xca, 0x2e, 0x71, 0xb8, 0x09, 0x87, 0x31, 0x28, 0x11, 0x3b, 0x59, 0xe2, 0xe9, 0x71, 0x25,
-	0x8e, 0x16, 0x80, 0x20, 0x0f, 0xab, 0x8b, 0x3f, 0x2c, 0xc0, 0x16, 0xf2, 0x30, 0xbc, 0x0a, 0xc2,
-	0xa8, 0x2d, 0xd6, 0xf7, 0x9a, 0xd4, 0x15, 0x4f, 0xfe, 0x9c, 0x99, 0x1c, 0x88, 0xeb, 0x42, 0x9a,
-	0xfb, 0x54, 0xbd, 0x69, 0x61, 0x18, 0xaf, 0x38, 0xc1, 0x69, 0x30, 0x8b, 0xf7, 0x3b, 0x94, 0xe0,
-	0xf0, 0x55, 0x0b, 0xd7, 0xe2, 0xe6, 0x76, 0x1d, 0xc4, 0x30, 0x13, 0x93, 0x56, 0x70, 0x73, 0xcb,
-	0xe5, 0xb5, 0xc7, 0x1a, 0x00, 0xc3, 0x69, 0x02, 0xae, 0x80, 0x73, 0x9b, 0x45, 0xf3, 0xa3, 0xaa,
-	0x69, 0x35, 0xee, 0xee, 0x54, 0xad, 0xdd, 0xad, 0xfa, 0x4e, 0xb5, 0x5c, 0xdb, 0xa8, 0x55, 0x2b,
-	0xa9, 0x58, 0x3a, 0x71, 0x70, 0x68, 0xcc, 0xec, 0x92, 0x07, 0x84, 0x3e, 0x22, 0x30, 0x03, 0x52,
-	0x51, 0x64, 0x79, 0xbb, 0xb6, 0x95, 0xd2, 0xd2, 0xb3, 0x07, 0x87, 0xc6, 0x54, 0x99, 0x3a, 0x04,
-	0xe6, 0xc1, 0x72, 0x54, 0x6f, 0x56, 0xeb, 0x0d, 0xb3, 0x56, 0x6e, 0x54, 0x2b, 0xa9, 0x89, 0x34,
-	0x3c, 0x38, 0x34, 0x92, 0x66, 0x38, 0xcf, 0x06, 0xf8, 0x6b, 0xbf, 0x4c, 0x80, 0xf9, 0xe8, 0x80,
-	0x06, 0xd7, 0xc0, 0x79, 0xe5, 0xa0, 0xde, 0x28, 0x36, 0x76, 0xeb, 0xff, 0x08, 0x66, 0xf1, 0xe0,
-	0xd0, 0x38, 0x23, 0xa1, 0xbb, 0xc4, 0xc6, 0x7b, 0x0e, 0xc1, 0x76, 0x64, 0x53, 0x65, 0xb3, 0x63,
-	0x6e, 0xef, 0x6c, 0xd7, 0xab, 0x95, 0x94, 0x26, 0x37, 0x95, 0x06, 0x3b, 0x3e, 0xed, 0x50, 0x86,
-	0x6d, 0xf8, 0x7e, 0x98, 0xae, 0xc2, 0x6f, 0xd4, 0xb6, 0x8a, 0xb7, 0x6b, 0xf7, 0x44, 0x94, 0x91,
-	0x1d, 0x06, 0x2f, 0x86, 0x0d, 0xaf, 0x81, 0xa5, 0x51, 0x8b, 0x62, 0xb9, 0x51, 0xbb, 0x53, 0x4d,
-	0x4d, 0xa6, 0x53, 0x07, 0x87, 0xc6, 0xbc, 0x84, 0x8b, 0xd7, 0x00, 0x9f, 0xf4, 0x5e, 0x2e, 0x6e,
-	0x95, 0xab, 0xb7, 0x6f, 0x57, 0x2b, 0xa9, 0xa9, 0xa8, 0x77, 0x79, 0xd3, 0xbb, 0xe3, 0xe2, 0xa9,
-	0x04, 0x65, 0xdb, 0xbe, 0x5b, 0xad, 0xa4, 0xa6, 0xa3, 0x16, 0x95, 0xa0, 0x76, 0xb4, 0x8f, 0xed,
-	0xf4, 0xec, 0x93, 0xef, 0x32, 0xb1, 0x1f, 0xbe, 0xcf, 0xc4, 0x4a, 0xed, 0x67, 0x2f, 0x32, 0xda,
-	0xf3, 0x17, 0x19, 0xed, 0x8f, 0x17, 0x19, 0xed, 0xeb, 0x97, 0x99, 0xd8, 0xf3, 0x97, 0x99, 0xd8,
-	0x6f, 0x2f, 0x33, 0x31, 0x70, 0xce, 0xa1, 0x63, 0x19, 0xbf, 0xa3, 0xdd, 0x5b, 0x8b, 0xcc, 0xb3,
-	0x43, 0xc8, 0x75, 0x87, 0x46, 0x56, 0x85, 0xfd, 0xc1, 0xdf, 0x25, 0x31, 0xdf, 0x36, 0xe3, 0xe2,
-	0x6f, 0xd2, 0x07, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x26, 0x1d, 0xde, 0x81, 0xda, 0x0d, 0x00,
-	0x00,
+var xxx_messageInfo_EventMarkerWithdraw proto.InternalMessageInfo
+
+func (m *EventMarkerWithdraw) GetCoins() string {
+	if m != nil {
+		return m.Coins
+	}
+	return ""
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarkerWithdraw) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarkerWithdraw) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
+	}
+	return ""
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UnrestrictedDenomRegex) > 0 {
-		i -= len(m.UnrestrictedDenomRegex)
-		copy(dAtA[i:], m.UnrestrictedDenomRegex)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.UnrestrictedDenomRegex)))
-		i--
-		dAtA[i] = 0x1a
+func (m *EventMarkerWithdraw) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
 	}
-	if m.EnableGovernance {
-		i--
-		if m.EnableGovernance {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return ""
+}
+
+// EventMarkerTransfer event emitted when coins are transfered to from account to another
+type EventMarkerTransfer struct {
+	Amount        string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
+	ToAddress     string `protobuf:"bytes,4,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	FromAddress   string `protobuf:"bytes,5,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+}
+
+func (m *EventMarkerTransfer) Reset()         { *m = EventMarkerTransfer{} }
+func (m *EventMarkerTransfer) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerTransfer) ProtoMessage()    {}
+func (*EventMarkerTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{19}
+}
+func (m *EventMarkerTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x10
+		return b[:n], nil
 	}
-	if m.MaxTotalSupply != 0 {
-		i = encodeVarintMarker(dAtA, i, uint64(m.MaxTotalSupply))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *EventMarkerTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerTransfer.Merge(m, src)
+}
+func (m *EventMarkerTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerTransfer proto.InternalMessageInfo
+
+func (m *EventMarkerTransfer) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MarkerAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarkerTransfer) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MarkerAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarkerTransfer) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
+	}
+	return ""
 }
 
-func (m *MarkerAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RequiredAttributes) > 0 {
-		for iNdEx := len(m.RequiredAttributes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RequiredAttributes[iNdEx])
-			copy(dAtA[i:], m.RequiredAttributes[iNdEx])
-			i = encodeVarintMarker(dAtA, i, uint64(len(m.RequiredAttributes[iNdEx])))
-			i--
-			dAtA[i] = 0x5a
-		}
+func (m *EventMarkerTransfer) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
 	}
-	if m.AllowForcedTransfer {
-		i--
-		if m.AllowForcedTransfer {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
+	return ""
+}
+
+func (m *EventMarkerTransfer) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
 	}
-	if m.AllowGovernanceControl {
-		i--
-		if m.AllowGovernanceControl {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return ""
+}
+
+// EventMarkerSetDenomMetadata event emitted when metadata is set on marker with denom
+type EventMarkerSetDenomMetadata struct {
+	MetadataBase        string            `protobuf:"bytes,1,opt,name=metadata_base,json=metadataBase,proto3" json:"metadata_base,omitempty"`
+	MetadataDescription string            `protobuf:"bytes,2,opt,name=metadata_description,json=metadataDescription,proto3" json:"metadata_description,omitempty"`
+	MetadataDisplay     string            `protobuf:"bytes,3,opt,name=metadata_display,json=metadataDisplay,proto3" json:"metadata_display,omitempty"`
+	MetadataDenomUnits  []*EventDenomUnit `protobuf:"bytes,4,rep,name=metadata_denom_units,json=metadataDenomUnits,proto3" json:"metadata_denom_units,omitempty"`
+	Administrator       string            `protobuf:"bytes,5,opt,name=administrator,proto3" json:"administrator,omitempty"`
+	MetadataName        string            `protobuf:"bytes,6,opt,name=metadata_name,json=metadataName,proto3" json:"metadata_name,omitempty"`
+	MetadataSymbol      string            `protobuf:"bytes,7,opt,name=metadata_symbol,json=metadataSymbol,proto3" json:"metadata_symbol,omitempty"`
+}
+
+func (m *EventMarkerSetDenomMetadata) Reset()         { *m = EventMarkerSetDenomMetadata{} }
+func (m *EventMarkerSetDenomMetadata) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerSetDenomMetadata) ProtoMessage()    {}
+func (*EventMarkerSetDenomMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{20}
+}
+func (m *EventMarkerSetDenomMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerSetDenomMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerSetDenomMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x48
+		return b[:n], nil
 	}
-	if m.SupplyFixed {
-		i--
-		if m.SupplyFixed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.MarkerType != 0 {
-		i = encodeVarintMarker(dAtA, i, uint64(m.MarkerType))
-		i--
-		dAtA[i] = 0x38
-	}
-	{
-		size := m.Supply.Size()
-		i -= size
-		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMarker(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Status != 0 {
-		i = encodeVarintMarker(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.AccessControl) > 0 {
-		for iNdEx := len(m.AccessControl) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AccessControl[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMarker(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Manager) > 0 {
-		i -= len(m.Manager)
-		copy(dAtA[i:], m.Manager)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Manager)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.BaseAccount != nil {
-		{
-			size, err := m.BaseAccount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMarker(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *EventMarkerSetDenomMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerSetDenomMetadata.Merge(m, src)
+}
+func (m *EventMarkerSetDenomMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerSetDenomMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerSetDenomMetadata.DiscardUnknown(m)
 }
 
-func (m *EventMarkerAdd) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventMarkerSetDenomMetadata proto.InternalMessageInfo
+
+func (m *EventMarkerSetDenomMetadata) GetMetadataBase() string {
+	if m != nil {
+		return m.MetadataBase
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarkerAdd) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarkerSetDenomMetadata) GetMetadataDescription() string {
+	if m != nil {
+		return m.MetadataDescription
+	}
+	return ""
 }
 
-func (m *EventMarkerAdd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.MarkerType) > 0 {
-		i -= len(m.MarkerType)
-		copy(dAtA[i:], m.MarkerType)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.MarkerType)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Manager) > 0 {
-		i -= len(m.Manager)
-		copy(dAtA[i:], m.Manager)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Manager)))
-		i--
-		dAtA[i] = 0x22
+func (m *EventMarkerSetDenomMetadata) GetMetadataDisplay() string {
+	if m != nil {
+		return m.MetadataDisplay
 	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *EventMarkerSetDenomMetadata) GetMetadataDenomUnits() []*EventDenomUnit {
+	if m != nil {
+		return m.MetadataDenomUnits
 	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *EventMarkerSetDenomMetadata) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventMarkerSetDenomMetadata) GetMetadataName() string {
+	if m != nil {
+		return m.MetadataName
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventMarkerAddAccess) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarkerSetDenomMetadata) GetMetadataSymbol() string {
+	if m != nil {
+		return m.MetadataSymbol
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarkerAddAccess) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventDenomUnit denom units for set denom metadata event
+type EventDenomUnit struct {
+	Denom    string   `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Exponent string   `protobuf:"bytes,2,opt,name=exponent,proto3" json:"exponent,omitempty"`
+	Aliases  []string `protobuf:"bytes,3,rep,name=aliases,proto3" json:"aliases,omitempty"`
 }
 
-func (m *EventMarkerAddAccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.Access.MarshalToSizedBuffer(dAtA[:i])
+func (m *EventDenomUnit) Reset()         { *m = EventDenomUnit{} }
+func (m *EventDenomUnit) String() string { return proto.CompactTextString(m) }
+func (*EventDenomUnit) ProtoMessage()    {}
+func (*EventDenomUnit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{21}
+}
+func (m *EventDenomUnit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDenomUnit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDenomUnit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintMarker(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *EventDenomUnit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDenomUnit.Merge(m, src)
+}
+func (m *EventDenomUnit) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDenomUnit) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDenomUnit.DiscardUnknown(m)
 }
 
-func (m *EventMarkerAccess) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventDenomUnit proto.InternalMessageInfo
+
+func (m *EventDenomUnit) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarkerAccess) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventDenomUnit) GetExponent() string {
+	if m != nil {
+		return m.Exponent
+	}
+	return ""
 }
 
-func (m *EventMarkerAccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Permissions) > 0 {
-		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Permissions[iNdEx])
-			copy(dAtA[i:], m.Permissions[iNdEx])
-			i = encodeVarintMarker(dAtA, i, uint64(len(m.Permissions[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventDenomUnit) GetAliases() []string {
+	if m != nil {
+		return m.Aliases
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *EventMarkerDeleteAccess) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// EventMarkerSetTransferLimit event emitted when the transfer limits of a restricted marker are set or removed
+type EventMarkerSetTransferLimit struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Window        string `protobuf:"bytes,2,opt,name=window,proto3" json:"window,omitempty"`
+	MarkerLimit   string `protobuf:"bytes,3,opt,name=marker_limit,json=markerLimit,proto3" json:"marker_limit,omitempty"`
+	HolderLimit   string `protobuf:"bytes,4,opt,name=holder_limit,json=holderLimit,proto3" json:"holder_limit,omitempty"`
+	Administrator string `protobuf:"bytes,5,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *EventMarkerSetTransferLimit) Reset()         { *m = EventMarkerSetTransferLimit{} }
+func (m *EventMarkerSetTransferLimit) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerSetTransferLimit) ProtoMessage()    {}
+func (*EventMarkerSetTransferLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{22}
+}
+func (m *EventMarkerSetTransferLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerSetTransferLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerSetTransferLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *EventMarkerSetTransferLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerSetTransferLimit.Merge(m, src)
+}
+func (m *EventMarkerSetTransferLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerSetTransferLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerSetTransferLimit.DiscardUnknown(m)
 }
 
-func (m *EventMarkerDeleteAccess) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_EventMarkerSetTransferLimit proto.InternalMessageInfo
+
+func (m *EventMarkerSetTransferLimit) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func (m *EventMarkerDeleteAccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x1a
+func (m *EventMarkerSetTransferLimit) GetWindow() string {
+	if m != nil {
+		return m.Window
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *EventMarkerSetTransferLimit) GetMarkerLimit() string {
+	if m != nil {
+		return m.MarkerLimit
 	}
-	if len(m.RemoveAddress) > 0 {
-		i -= len(m.RemoveAddress)
-		copy(dAtA[i:], m.RemoveAddress)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.RemoveAddress)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventMarkerSetTransferLimit) GetHolderLimit() string {
+	if m != nil {
+		return m.HolderLimit
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventMarkerFinalize) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarkerSetTransferLimit) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarkerFinalize) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarkerAddNetAssetValue event emitted when a net asset value is recorded for a marker
+type EventMarkerAddNetAssetValue struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Price         string `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	Volume        uint64 `protobuf:"varint,3,opt,name=volume,proto3" json:"volume,omitempty"`
+	Source        string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	Administrator string `protobuf:"bytes,5,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (m *EventMarkerFinalize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventMarkerAddNetAssetValue) Reset()         { *m = EventMarkerAddNetAssetValue{} }
+func (m *EventMarkerAddNetAssetValue) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerAddNetAssetValue) ProtoMessage()    {}
+func (*EventMarkerAddNetAssetValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{23}
+}
+func (m *EventMarkerAddNetAssetValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerAddNetAssetValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerAddNetAssetValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventMarkerAddNetAssetValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerAddNetAssetValue.Merge(m, src)
+}
+func (m *EventMarkerAddNetAssetValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerAddNetAssetValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerAddNetAssetValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerAddNetAssetValue proto.InternalMessageInfo
+
+func (m *EventMarkerAddNetAssetValue) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventMarkerActivate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarkerAddNetAssetValue) GetPrice() string {
+	if m != nil {
+		return m.Price
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarkerActivate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarkerAddNetAssetValue) GetVolume() uint64 {
+	if m != nil {
+		return m.Volume
+	}
+	return 0
 }
 
-func (m *EventMarkerActivate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventMarkerAddNetAssetValue) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventMarkerAddNetAssetValue) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventMarkerCancel) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// EventMarkerChangeType event emitted when the type of a marker is changed
+type EventMarkerChangeType struct {
+	Denom          string   `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	OldType        string   `protobuf:"bytes,2,opt,name=old_type,json=oldType,proto3" json:"old_type,omitempty"`
+	NewType        string   `protobuf:"bytes,3,opt,name=new_type,json=newType,proto3" json:"new_type,omitempty"`
+	Administrators []string `protobuf:"bytes,4,rep,name=administrators,proto3" json:"administrators,omitempty"`
+}
+
+func (m *EventMarkerChangeType) Reset()         { *m = EventMarkerChangeType{} }
+func (m *EventMarkerChangeType) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerChangeType) ProtoMessage()    {}
+func (*EventMarkerChangeType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{24}
+}
+func (m *EventMarkerChangeType) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerChangeType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerChangeType.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *EventMarkerChangeType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerChangeType.Merge(m, src)
+}
+func (m *EventMarkerChangeType) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerChangeType) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerChangeType.DiscardUnknown(m)
 }
 
-func (m *EventMarkerCancel) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_EventMarkerChangeType proto.InternalMessageInfo
+
+func (m *EventMarkerChangeType) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func (m *EventMarkerCancel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventMarkerChangeType) GetOldType() string {
+	if m != nil {
+		return m.OldType
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventMarkerChangeType) GetNewType() string {
+	if m != nil {
+		return m.NewType
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventMarkerDelete) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarkerChangeType) GetAdministrators() []string {
+	if m != nil {
+		return m.Administrators
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventMarkerDelete) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarkerSetMaxSupply event emitted when the maximum supply of a marker is changed
+type EventMarkerSetMaxSupply struct {
+	Denom          string   `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	MaxSupply      string   `protobuf:"bytes,2,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
+	Administrators []string `protobuf:"bytes,3,rep,name=administrators,proto3" json:"administrators,omitempty"`
 }
 
-func (m *EventMarkerDelete) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventMarkerSetMaxSupply) Reset()         { *m = EventMarkerSetMaxSupply{} }
+func (m *EventMarkerSetMaxSupply) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerSetMaxSupply) ProtoMessage()    {}
+func (*EventMarkerSetMaxSupply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{25}
+}
+func (m *EventMarkerSetMaxSupply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerSetMaxSupply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerSetMaxSupply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventMarkerSetMaxSupply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerSetMaxSupply.Merge(m, src)
+}
+func (m *EventMarkerSetMaxSupply) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerSetMaxSupply) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerSetMaxSupply.DiscardUnknown(m)
 }
 
-func (m *EventMarkerMint) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventMarkerSetMaxSupply proto.InternalMessageInfo
+
+func (m *EventMarkerSetMaxSupply) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarkerMint) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarkerSetMaxSupply) GetMaxSupply() string {
+	if m != nil {
+		return m.MaxSupply
+	}
+	return ""
 }
 
-func (m *EventMarkerMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventMarkerSetMaxSupply) GetAdministrators() []string {
+	if m != nil {
+		return m.Administrators
 	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+// EventMarkerBatchTransfer event emitted when a batch of transfers is made.
+// An EventMarkerTransfer is also emitted for each transfer in the batch.
+type EventMarkerBatchTransfer struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	TotalAmount   string `protobuf:"bytes,2,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	TransferCount uint32 `protobuf:"varint,3,opt,name=transfer_count,json=transferCount,proto3" json:"transfer_count,omitempty"`
+	Administrator string `protobuf:"bytes,4,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *EventMarkerBatchTransfer) Reset()         { *m = EventMarkerBatchTransfer{} }
+func (m *EventMarkerBatchTransfer) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerBatchTransfer) ProtoMessage()    {}
+func (*EventMarkerBatchTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{26}
+}
+func (m *EventMarkerBatchTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerBatchTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerBatchTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventMarkerBatchTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerBatchTransfer.Merge(m, src)
+}
+func (m *EventMarkerBatchTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerBatchTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerBatchTransfer.DiscardUnknown(m)
 }
 
-func (m *EventMarkerBurn) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventMarkerBatchTransfer proto.InternalMessageInfo
+
+func (m *EventMarkerBatchTransfer) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarkerBurn) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarkerBatchTransfer) GetTotalAmount() string {
+	if m != nil {
+		return m.TotalAmount
+	}
+	return ""
 }
 
-func (m *EventMarkerBurn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x1a
+func (m *EventMarkerBatchTransfer) GetTransferCount() uint32 {
+	if m != nil {
+		return m.TransferCount
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *EventMarkerBatchTransfer) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+// EventMarkerRecoverAccount event emitted when the funds of a compromised account are moved to a replacement account
+type EventMarkerRecoverAccount struct {
+	Amount             string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Denom              string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	CompromisedAddress string `protobuf:"bytes,3,opt,name=compromised_address,json=compromisedAddress,proto3" json:"compromised_address,omitempty"`
+	ReplacementAddress string `protobuf:"bytes,4,opt,name=replacement_address,json=replacementAddress,proto3" json:"replacement_address,omitempty"`
+	Administrator      string `protobuf:"bytes,5,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *EventMarkerRecoverAccount) Reset()         { *m = EventMarkerRecoverAccount{} }
+func (m *EventMarkerRecoverAccount) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerRecoverAccount) ProtoMessage()    {}
+func (*EventMarkerRecoverAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{27}
+}
+func (m *EventMarkerRecoverAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerRecoverAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerRecoverAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventMarkerRecoverAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerRecoverAccount.Merge(m, src)
+}
+func (m *EventMarkerRecoverAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerRecoverAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerRecoverAccount.DiscardUnknown(m)
 }
 
-func (m *EventMarkerWithdraw) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventMarkerRecoverAccount proto.InternalMessageInfo
+
+func (m *EventMarkerRecoverAccount) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarkerWithdraw) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarkerRecoverAccount) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func (m *EventMarkerWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ToAddress) > 0 {
-		i -= len(m.ToAddress)
-		copy(dAtA[i:], m.ToAddress)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.ToAddress)))
-		i--
-		dAtA[i] = 0x22
+func (m *EventMarkerRecoverAccount) GetCompromisedAddress() string {
+	if m != nil {
+		return m.CompromisedAddress
 	}
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *EventMarkerRecoverAccount) GetReplacementAddress() string {
+	if m != nil {
+		return m.ReplacementAddress
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *EventMarkerRecoverAccount) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	if len(m.Coins) > 0 {
-		i -= len(m.Coins)
-		copy(dAtA[i:], m.Coins)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Coins)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+// EventMarkerSetIbcPolicy event emitted when the IBC policy of a restricted marker is set or removed
+type EventMarkerSetIbcPolicy struct {
+	Denom               string   `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	AllowedChannels     []string `protobuf:"bytes,2,rep,name=allowed_channels,json=allowedChannels,proto3" json:"allowed_channels,omitempty"`
+	AllowedDestinations []string `protobuf:"bytes,3,rep,name=allowed_destinations,json=allowedDestinations,proto3" json:"allowed_destinations,omitempty"`
+	Administrator       string   `protobuf:"bytes,4,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *EventMarkerSetIbcPolicy) Reset()         { *m = EventMarkerSetIbcPolicy{} }
+func (m *EventMarkerSetIbcPolicy) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerSetIbcPolicy) ProtoMessage()    {}
+func (*EventMarkerSetIbcPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{28}
+}
+func (m *EventMarkerSetIbcPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerSetIbcPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerSetIbcPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventMarkerSetIbcPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerSetIbcPolicy.Merge(m, src)
+}
+func (m *EventMarkerSetIbcPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerSetIbcPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerSetIbcPolicy.DiscardUnknown(m)
 }
 
-func (m *EventMarkerTransfer) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventMarkerSetIbcPolicy proto.InternalMessageInfo
+
+func (m *EventMarkerSetIbcPolicy) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarkerTransfer) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarkerSetIbcPolicy) GetAllowedChannels() []string {
+	if m != nil {
+		return m.AllowedChannels
+	}
+	return nil
 }
 
-func (m *EventMarkerTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FromAddress) > 0 {
-		i -= len(m.FromAddress)
-		copy(dAtA[i:], m.FromAddress)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.FromAddress)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.ToAddress) > 0 {
-		i -= len(m.ToAddress)
-		copy(dAtA[i:], m.ToAddress)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.ToAddress)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x1a
+func (m *EventMarkerSetIbcPolicy) GetAllowedDestinations() []string {
+	if m != nil {
+		return m.AllowedDestinations
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *EventMarkerSetIbcPolicy) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+// EventMarkerSetTransferFee event emitted when the transfer fee of a restricted marker is set or removed
+type EventMarkerSetTransferFee struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Recipient     string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	BasisPoints   uint32 `protobuf:"varint,3,opt,name=basis_points,json=basisPoints,proto3" json:"basis_points,omitempty"`
+	FlatFees      string `protobuf:"bytes,4,opt,name=flat_fees,json=flatFees,proto3" json:"flat_fees,omitempty"`
+	Administrator string `protobuf:"bytes,5,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *EventMarkerSetTransferFee) Reset()         { *m = EventMarkerSetTransferFee{} }
+func (m *EventMarkerSetTransferFee) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerSetTransferFee) ProtoMessage()    {}
+func (*EventMarkerSetTransferFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{29}
+}
+func (m *EventMarkerSetTransferFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerSetTransferFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerSetTransferFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventMarkerSetTransferFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerSetTransferFee.Merge(m, src)
+}
+func (m *EventMarkerSetTransferFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerSetTransferFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerSetTransferFee.DiscardUnknown(m)
 }
 
-func (m *EventMarkerSetDenomMetadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventMarkerSetTransferFee proto.InternalMessageInfo
+
+func (m *EventMarkerSetTransferFee) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarkerSetDenomMetadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarkerSetTransferFee) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
 }
 
-func (m *EventMarkerSetDenomMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MetadataSymbol) > 0 {
-		i -= len(m.MetadataSymbol)
-		copy(dAtA[i:], m.MetadataSymbol)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.MetadataSymbol)))
-		i--
-		dAtA[i] = 0x3a
+func (m *EventMarkerSetTransferFee) GetBasisPoints() uint32 {
+	if m != nil {
+		return m.BasisPoints
 	}
-	if len(m.MetadataName) > 0 {
-		i -= len(m.MetadataName)
-		copy(dAtA[i:], m.MetadataName)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.MetadataName)))
-		i--
-		dAtA[i] = 0x32
+	return 0
+}
+
+func (m *EventMarkerSetTransferFee) GetFlatFees() string {
+	if m != nil {
+		return m.FlatFees
 	}
-	if len(m.Administrator) > 0 {
-		i -= len(m.Administrator)
-		copy(dAtA[i:], m.Administrator)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
-		i--
-		dAtA[i] = 0x2a
+	return ""
+}
+
+func (m *EventMarkerSetTransferFee) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	if len(m.MetadataDenomUnits) > 0 {
-		for iNdEx := len(m.MetadataDenomUnits) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MetadataDenomUnits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMarker(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+	return ""
+}
+
+// EventMarkerTransferFee event emitted when a transfer fee is paid to the recipient of a marker's transfer fee
+type EventMarkerTransferFee struct {
+	Denom       string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	FromAddress string `protobuf:"bytes,2,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	Recipient   string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Fees        string `protobuf:"bytes,4,opt,name=fees,proto3" json:"fees,omitempty"`
+}
+
+func (m *EventMarkerTransferFee) Reset()         { *m = EventMarkerTransferFee{} }
+func (m *EventMarkerTransferFee) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerTransferFee) ProtoMessage()    {}
+func (*EventMarkerTransferFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{30}
+}
+func (m *EventMarkerTransferFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerTransferFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerTransferFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.MetadataDisplay) > 0 {
-		i -= len(m.MetadataDisplay)
-		copy(dAtA[i:], m.MetadataDisplay)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.MetadataDisplay)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *EventMarkerTransferFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerTransferFee.Merge(m, src)
+}
+func (m *EventMarkerTransferFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerTransferFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerTransferFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerTransferFee proto.InternalMessageInfo
+
+func (m *EventMarkerTransferFee) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if len(m.MetadataDescription) > 0 {
-		i -= len(m.MetadataDescription)
-		copy(dAtA[i:], m.MetadataDescription)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.MetadataDescription)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *EventMarkerTransferFee) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
 	}
-	if len(m.MetadataBase) > 0 {
-		i -= len(m.MetadataBase)
-		copy(dAtA[i:], m.MetadataBase)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.MetadataBase)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventMarkerTransferFee) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventDenomUnit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarkerTransferFee) GetFees() string {
+	if m != nil {
+		return m.Fees
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventDenomUnit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarkerReinstate event emitted when a cancelled marker is scheduled to be reinstated
+type EventMarkerReinstate struct {
+	Denom           string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Status          string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Manager         string `protobuf:"bytes,3,opt,name=manager,proto3" json:"manager,omitempty"`
+	ReinstateHeight int64  `protobuf:"varint,4,opt,name=reinstate_height,json=reinstateHeight,proto3" json:"reinstate_height,omitempty"`
+	Administrator   string `protobuf:"bytes,5,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (m *EventDenomUnit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Aliases) > 0 {
-		for iNdEx := len(m.Aliases) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Aliases[iNdEx])
-			copy(dAtA[i:], m.Aliases[iNdEx])
-			i = encodeVarintMarker(dAtA, i, uint64(len(m.Aliases[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+func (m *EventMarkerReinstate) Reset()         { *m = EventMarkerReinstate{} }
+func (m *EventMarkerReinstate) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerReinstate) ProtoMessage()    {}
+func (*EventMarkerReinstate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{31}
+}
+func (m *EventMarkerReinstate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerReinstate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerReinstate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Exponent) > 0 {
-		i -= len(m.Exponent)
-		copy(dAtA[i:], m.Exponent)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Exponent)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *EventMarkerReinstate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerReinstate.Merge(m, src)
+}
+func (m *EventMarkerReinstate) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerReinstate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerReinstate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerReinstate proto.InternalMessageInfo
+
+func (m *EventMarkerReinstate) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventMarkerReinstate) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintMarker(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMarker(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventMarkerReinstate) GetManager() string {
+	if m != nil {
+		return m.Manager
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *EventMarkerReinstate) GetReinstateHeight() int64 {
+	if m != nil {
+		return m.ReinstateHeight
 	}
-	var l int
-	_ = l
-	if m.MaxTotalSupply != 0 {
-		n += 1 + sovMarker(uint64(m.MaxTotalSupply))
-	}
-	if m.EnableGovernance {
-		n += 2
-	}
-	l = len(m.UnrestrictedDenomRegex)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	return n
+	return 0
 }
 
-func (m *MarkerAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BaseAccount != nil {
-		l = m.BaseAccount.Size()
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Manager)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	if len(m.AccessControl) > 0 {
-		for _, e := range m.AccessControl {
-			l = e.Size()
-			n += 1 + l + sovMarker(uint64(l))
-		}
-	}
-	if m.Status != 0 {
-		n += 1 + sovMarker(uint64(m.Status))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = m.Supply.Size()
-	n += 1 + l + sovMarker(uint64(l))
-	if m.MarkerType != 0 {
-		n += 1 + sovMarker(uint64(m.MarkerType))
-	}
-	if m.SupplyFixed {
-		n += 2
-	}
-	if m.AllowGovernanceControl {
-		n += 2
-	}
-	if m.AllowForcedTransfer {
-		n += 2
+func (m *EventMarkerReinstate) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	if len(m.RequiredAttributes) > 0 {
-		for _, s := range m.RequiredAttributes {
-			l = len(s)
-			n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+// EventMarkerReinstated event emitted when a cancelled marker is returned to the proposed or finalized status
+type EventMarkerReinstated struct {
+	Denom   string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Status  string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Manager string `protobuf:"bytes,3,opt,name=manager,proto3" json:"manager,omitempty"`
+}
+
+func (m *EventMarkerReinstated) Reset()         { *m = EventMarkerReinstated{} }
+func (m *EventMarkerReinstated) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerReinstated) ProtoMessage()    {}
+func (*EventMarkerReinstated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{32}
+}
+func (m *EventMarkerReinstated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerReinstated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerReinstated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *EventMarkerReinstated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerReinstated.Merge(m, src)
+}
+func (m *EventMarkerReinstated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerReinstated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerReinstated.DiscardUnknown(m)
 }
 
-func (m *EventMarkerAdd) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Manager)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.MarkerType)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+var xxx_messageInfo_EventMarkerReinstated proto.InternalMessageInfo
+
+func (m *EventMarkerReinstated) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return n
+	return ""
 }
 
-func (m *EventMarkerAddAccess) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Access.Size()
-	n += 1 + l + sovMarker(uint64(l))
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+func (m *EventMarkerReinstated) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	return n
+	return ""
 }
 
-func (m *EventMarkerAccess) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	if len(m.Permissions) > 0 {
-		for _, s := range m.Permissions {
-			l = len(s)
-			n += 1 + l + sovMarker(uint64(l))
-		}
+func (m *EventMarkerReinstated) GetManager() string {
+	if m != nil {
+		return m.Manager
 	}
-	return n
+	return ""
 }
 
-func (m *EventMarkerDeleteAccess) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RemoveAddress)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	return n
+// EventMarkerReclaimEscrow event emitted when the coins held in a cancelled marker's escrow are reclaimed
+type EventMarkerReclaimEscrow struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	ToAddress     string `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	Amount        string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Administrator string `protobuf:"bytes,4,opt,name=administrator,proto3" json:"administrator,omitempty"`
 }
 
-func (m *EventMarkerFinalize) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+func (m *EventMarkerReclaimEscrow) Reset()         { *m = EventMarkerReclaimEscrow{} }
+func (m *EventMarkerReclaimEscrow) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerReclaimEscrow) ProtoMessage()    {}
+func (*EventMarkerReclaimEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{33}
+}
+func (m *EventMarkerReclaimEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerReclaimEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerReclaimEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *EventMarkerReclaimEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerReclaimEscrow.Merge(m, src)
+}
+func (m *EventMarkerReclaimEscrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerReclaimEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerReclaimEscrow.DiscardUnknown(m)
 }
 
-func (m *EventMarkerActivate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+var xxx_messageInfo_EventMarkerReclaimEscrow proto.InternalMessageInfo
+
+func (m *EventMarkerReclaimEscrow) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return n
+	return ""
 }
 
-func (m *EventMarkerCancel) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+func (m *EventMarkerReclaimEscrow) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
 	}
-	return n
+	return ""
 }
 
-func (m *EventMarkerDelete) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+func (m *EventMarkerReclaimEscrow) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	return ""
+}
+
+func (m *EventMarkerReclaimEscrow) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
 	}
-	return n
+	return ""
 }
 
-func (m *EventMarkerMint) Size() (n int) {
-	if m == nil {
-		return 0
+func init() {
+	proto.RegisterEnum("provenance.marker.v1.SupplyChangeType", SupplyChangeType_name, SupplyChangeType_value)
+	proto.RegisterEnum("provenance.marker.v1.MarkerType", MarkerType_name, MarkerType_value)
+	proto.RegisterEnum("provenance.marker.v1.MarkerStatus", MarkerStatus_name, MarkerStatus_value)
+	proto.RegisterType((*Params)(nil), "provenance.marker.v1.Params")
+	proto.RegisterType((*MarkerAccount)(nil), "provenance.marker.v1.MarkerAccount")
+	proto.RegisterType((*TransferLimit)(nil), "provenance.marker.v1.TransferLimit")
+	proto.RegisterType((*IbcPolicy)(nil), "provenance.marker.v1.IbcPolicy")
+	proto.RegisterType((*TransferFee)(nil), "provenance.marker.v1.TransferFee")
+	proto.RegisterType((*PendingReinstatement)(nil), "provenance.marker.v1.PendingReinstatement")
+	proto.RegisterType((*SupplyHistoryEntry)(nil), "provenance.marker.v1.SupplyHistoryEntry")
+	proto.RegisterType((*NetAssetValue)(nil), "provenance.marker.v1.NetAssetValue")
+	proto.RegisterType((*EventMarkerAdd)(nil), "provenance.marker.v1.EventMarkerAdd")
+	proto.RegisterType((*EventMarkerAddAccess)(nil), "provenance.marker.v1.EventMarkerAddAccess")
+	proto.RegisterType((*EventMarkerAccess)(nil), "provenance.marker.v1.EventMarkerAccess")
+	proto.RegisterType((*EventMarkerDeleteAccess)(nil), "provenance.marker.v1.EventMarkerDeleteAccess")
+	proto.RegisterType((*EventMarkerFinalize)(nil), "provenance.marker.v1.EventMarkerFinalize")
+	proto.RegisterType((*EventMarkerActivate)(nil), "provenance.marker.v1.EventMarkerActivate")
+	proto.RegisterType((*EventMarkerCancel)(nil), "provenance.marker.v1.EventMarkerCancel")
+	proto.RegisterType((*EventMarkerDelete)(nil), "provenance.marker.v1.EventMarkerDelete")
+	proto.RegisterType((*EventMarkerMint)(nil), "provenance.marker.v1.EventMarkerMint")
+	proto.RegisterType((*EventMarkerBurn)(nil), "provenance.marker.v1.EventMarkerBurn")
+	proto.RegisterType((*EventMarkerWithdraw)(nil), "provenance.marker.v1.EventMarkerWithdraw")
+	proto.RegisterType((*EventMarkerTransfer)(nil), "provenance.marker.v1.EventMarkerTransfer")
+	proto.RegisterType((*EventMarkerSetDenomMetadata)(nil), "provenance.marker.v1.EventMarkerSetDenomMetadata")
+	proto.RegisterType((*EventDenomUnit)(nil), "provenance.marker.v1.EventDenomUnit")
+	proto.RegisterType((*EventMarkerSetTransferLimit)(nil), "provenance.marker.v1.EventMarkerSetTransferLimit")
+	proto.RegisterType((*EventMarkerAddNetAssetValue)(nil), "provenance.marker.v1.EventMarkerAddNetAssetValue")
+	proto.RegisterType((*EventMarkerChangeType)(nil), "provenance.marker.v1.EventMarkerChangeType")
+	proto.RegisterType((*EventMarkerSetMaxSupply)(nil), "provenance.marker.v1.EventMarkerSetMaxSupply")
+	proto.RegisterType((*EventMarkerBatchTransfer)(nil), "provenance.marker.v1.EventMarkerBatchTransfer")
+	proto.RegisterType((*EventMarkerRecoverAccount)(nil), "provenance.marker.v1.EventMarkerRecoverAccount")
+	proto.RegisterType((*EventMarkerSetIbcPolicy)(nil), "provenance.marker.v1.EventMarkerSetIbcPolicy")
+	proto.RegisterType((*EventMarkerSetTransferFee)(nil), "provenance.marker.v1.EventMarkerSetTransferFee")
+	proto.RegisterType((*EventMarkerTransferFee)(nil), "provenance.marker.v1.EventMarkerTransferFee")
+	proto.RegisterType((*EventMarkerReinstate)(nil), "provenance.marker.v1.EventMarkerReinstate")
+	proto.RegisterType((*EventMarkerReinstated)(nil), "provenance.marker.v1.EventMarkerReinstated")
+	proto.RegisterType((*EventMarkerReclaimEscrow)(nil), "provenance.marker.v1.EventMarkerReclaimEscrow")
+}
+
+func init() { proto.RegisterFile("provenance/marker/v1/marker.proto", fileDescriptor_f7e2c25c71db7f99) }
+
+var fileDescriptor_f7e2c25c71db7f99 = []byte{
+	// 2572 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcf, 0x6f, 0x1b, 0xc7,
+	0xf5, 0xd7, 0x92, 0x14, 0x25, 0x0e, 0x25, 0x99, 0x59, 0x29, 0x12, 0x4d, 0x3b, 0x22, 0xbd, 0xc9,
+	0xd7, 0xd6, 0xd7, 0x6d, 0xa8, 0x58, 0x4d, 0x83, 0xc0, 0x39, 0xb4, 0xfc, 0x25, 0x87, 0xa8, 0x25,
+	0x31, 0x2b, 0xc9, 0x85, 0x83, 0x02, 0xdb, 0xe1, 0xee, 0x88, 0x9a, 0x7a, 0x77, 0x87, 0xd9, 0x1d,
+	0xea, 0x47, 0x51, 0xa0, 0x87, 0x02, 0x41, 0xa0, 0xa2, 0x40, 0x8e, 0xe9, 0x41, 0xa8, 0x81, 0xfe,
+	0x40, 0xd1, 0xa0, 0xe8, 0xa1, 0x2d, 0x7a, 0xeb, 0xa9, 0x87, 0xa0, 0x87, 0x22, 0xbd, 0x15, 0x3d,
+	0x28, 0x45, 0x82, 0xa2, 0x3d, 0xf4, 0xe4, 0xbf, 0xa0, 0x98, 0x1f, 0xbb, 0xdc, 0xa5, 0x96, 0x8e,
+	0x1c, 0x27, 0xe8, 0x49, 0xdc, 0xf7, 0x6b, 0xde, 0xbc, 0xf7, 0xe6, 0x33, 0xef, 0x8d, 0xc0, 0xb5,
+	0xbe, 0x47, 0x0e, 0x90, 0x0b, 0x5d, 0x13, 0xad, 0x3a, 0xd0, 0x7b, 0x80, 0xbc, 0xd5, 0x83, 0x5b,
+	0xf2, 0x57, 0xb5, 0xef, 0x11, 0x4a, 0xd4, 0x85, 0xa1, 0x48, 0x55, 0x32, 0x0e, 0x6e, 0x95, 0x16,
+	0x7a, 0xa4, 0x47, 0xb8, 0xc0, 0x2a, 0xfb, 0x25, 0x64, 0x4b, 0xcb, 0x3d, 0x42, 0x7a, 0x36, 0x5a,
+	0xe5, 0x5f, 0xdd, 0xc1, 0xde, 0xaa, 0x35, 0xf0, 0x20, 0xc5, 0xc4, 0x95, 0xfc, 0xf2, 0x28, 0x9f,
+	0x62, 0x07, 0xf9, 0x14, 0x3a, 0xfd, 0xc0, 0x80, 0x49, 0x7c, 0x87, 0xf8, 0xab, 0x70, 0x40, 0xf7,
+	0x57, 0x0f, 0x6e, 0x75, 0x11, 0x85, 0xb7, 0xf8, 0xc7, 0x08, 0xbf, 0x0b, 0x7d, 0x14, 0xf2, 0x4d,
+	0x82, 0x83, 0x05, 0x2e, 0x0b, 0xbe, 0x21, 0x3c, 0x13, 0x1f, 0x92, 0x75, 0x3d, 0x71, 0xab, 0xd0,
+	0x34, 0x91, 0xef, 0xf7, 0x3c, 0xe8, 0x52, 0x21, 0xa7, 0xfd, 0x22, 0x05, 0xb2, 0x1d, 0xe8, 0x41,
+	0xc7, 0x57, 0x5f, 0x05, 0x05, 0x07, 0x1e, 0x19, 0x94, 0x50, 0x68, 0x1b, 0xfe, 0xa0, 0xdf, 0xb7,
+	0x8f, 0x8b, 0x4a, 0x45, 0x59, 0xc9, 0xd4, 0xe7, 0x3e, 0x38, 0x2b, 0x4f, 0xfc, 0xfd, 0xac, 0x9c,
+	0x1d, 0x60, 0x97, 0xbe, 0xf2, 0xb2, 0x3e, 0xe7, 0xc0, 0xa3, 0x1d, 0x26, 0xb6, 0xcd, 0xa5, 0xd4,
+	0x2f, 0x81, 0x67, 0x90, 0x0b, 0xbb, 0x36, 0x32, 0x7a, 0xe4, 0x00, 0x79, 0x7c, 0xd5, 0x62, 0xaa,
+	0xa2, 0xac, 0x4c, 0xeb, 0x05, 0xc1, 0xb8, 0x13, 0xd2, 0xd5, 0x57, 0x41, 0x71, 0xe0, 0x7a, 0xc8,
+	0xa7, 0x1e, 0x36, 0x29, 0xb2, 0x0c, 0x0b, 0xb9, 0xc4, 0x31, 0x3c, 0xd4, 0x43, 0x47, 0xc5, 0x74,
+	0x45, 0x59, 0xc9, 0xe9, 0x8b, 0x51, 0x7e, 0x93, 0xb1, 0x75, 0xc6, 0x55, 0x5f, 0x03, 0x25, 0xe6,
+	0xa0, 0x70, 0xcd, 0xd8, 0xc7, 0x3e, 0x25, 0xde, 0xb1, 0x81, 0x5c, 0xea, 0x61, 0xe4, 0x17, 0x33,
+	0x15, 0x65, 0x65, 0x56, 0x5f, 0x72, 0xe0, 0x91, 0xf0, 0xea, 0x75, 0xc1, 0x6f, 0x09, 0xb6, 0xfa,
+	0x32, 0x58, 0xf4, 0x10, 0x76, 0x7d, 0x0a, 0x29, 0x32, 0x2c, 0x64, 0xc3, 0x63, 0xa3, 0x6b, 0x13,
+	0xf3, 0x81, 0x5f, 0x9c, 0x64, 0x7b, 0xd4, 0x17, 0x42, 0x6e, 0x93, 0x31, 0xeb, 0x9c, 0x77, 0x7b,
+	0xfa, 0xbd, 0x87, 0xe5, 0x89, 0x7f, 0x3f, 0x2c, 0x4f, 0x68, 0xff, 0xcc, 0x82, 0xd9, 0x0d, 0x1e,
+	0xc8, 0x9a, 0x69, 0x92, 0x81, 0x4b, 0xd5, 0x6f, 0x83, 0x19, 0x96, 0x18, 0x03, 0x8a, 0x6f, 0x1e,
+	0xab, 0xfc, 0x5a, 0xa5, 0x2a, 0xf3, 0xc0, 0xf3, 0x28, 0x93, 0x56, 0xad, 0x43, 0x1f, 0x49, 0xbd,
+	0xfa, 0x95, 0x0f, 0xcf, 0xca, 0xca, 0xa3, 0xb3, 0xf2, 0xfc, 0x31, 0x74, 0xec, 0xdb, 0x5a, 0xd4,
+	0x86, 0xa6, 0xe7, 0xbb, 0x43, 0x49, 0xf5, 0x15, 0x30, 0xe5, 0x40, 0x17, 0xf6, 0x90, 0xc7, 0xa3,
+	0x99, 0xab, 0x5f, 0x7d, 0x74, 0x56, 0x2e, 0x7e, 0xc7, 0x27, 0xee, 0x6d, 0x4d, 0x32, 0xbe, 0x4c,
+	0x1c, 0x4c, 0x91, 0xd3, 0xa7, 0xc7, 0x9a, 0x1e, 0x08, 0xab, 0x9b, 0x60, 0x4e, 0x64, 0xda, 0x30,
+	0x89, 0x4b, 0x3d, 0x62, 0x17, 0xd3, 0x95, 0xf4, 0x4a, 0x7e, 0xed, 0x5a, 0x35, 0xa9, 0xba, 0xab,
+	0x35, 0x2e, 0x7b, 0x87, 0x55, 0x45, 0x3d, 0xc3, 0x52, 0xad, 0xcf, 0x0a, 0xf5, 0x86, 0xd0, 0x56,
+	0x6f, 0x83, 0x2c, 0x8b, 0xcc, 0x40, 0x04, 0x79, 0x6e, 0x4d, 0x4b, 0xb6, 0x23, 0xc2, 0xb3, 0xcd,
+	0x25, 0x75, 0xa9, 0xa1, 0x2e, 0x80, 0x49, 0x9e, 0x61, 0x1e, 0xe6, 0x9c, 0x2e, 0x3e, 0xd4, 0xb7,
+	0x40, 0x56, 0x56, 0x58, 0x96, 0x6f, 0xec, 0xbe, 0xac, 0xb0, 0xeb, 0x3d, 0x4c, 0xf7, 0x07, 0xdd,
+	0xaa, 0x49, 0x1c, 0x59, 0xcf, 0xf2, 0xcf, 0x8b, 0xbe, 0xf5, 0x60, 0x95, 0x1e, 0xf7, 0x91, 0x5f,
+	0x6d, 0xbb, 0xf4, 0xd1, 0x59, 0xf9, 0x86, 0x08, 0x43, 0xb4, 0x5a, 0xb5, 0x8a, 0x88, 0x68, 0x8c,
+	0xa6, 0xcb, 0x85, 0x54, 0x13, 0xe4, 0x85, 0xab, 0x06, 0x33, 0x53, 0x9c, 0xe2, 0x3b, 0xa9, 0x3c,
+	0x6e, 0x27, 0x3b, 0xc7, 0x7d, 0x54, 0xaf, 0x3c, 0x3a, 0x2b, 0x5f, 0x0d, 0x42, 0x1e, 0xaa, 0x47,
+	0xc3, 0x0e, 0x9c, 0x50, 0x5a, 0xbd, 0x06, 0x66, 0x64, 0x79, 0xee, 0xe1, 0x23, 0x64, 0x15, 0xa7,
+	0xf9, 0x21, 0xc8, 0x0b, 0xda, 0x3a, 0x23, 0xb1, 0xfa, 0x87, 0xb6, 0x4d, 0x0e, 0x23, 0x67, 0x25,
+	0x4c, 0x53, 0x8e, 0x8b, 0x2f, 0x72, 0xfe, 0xf0, 0xc8, 0x04, 0x69, 0x58, 0x03, 0xcf, 0x0a, 0xcd,
+	0x3d, 0xe2, 0x99, 0xc8, 0x32, 0xa8, 0x07, 0x5d, 0x7f, 0x0f, 0x79, 0x45, 0xc0, 0xd5, 0xe6, 0x39,
+	0x73, 0x9d, 0xf3, 0x76, 0x24, 0x4b, 0x5d, 0x05, 0xf3, 0x1e, 0x7a, 0x6b, 0x80, 0x3d, 0x64, 0x19,
+	0x90, 0x52, 0x0f, 0x77, 0x07, 0x14, 0xf9, 0xc5, 0x7c, 0x25, 0xbd, 0x92, 0xd3, 0xd5, 0x80, 0x55,
+	0x0b, 0x39, 0x6a, 0x17, 0x80, 0xe1, 0x21, 0x2b, 0xce, 0xf0, 0xec, 0x34, 0x9e, 0x38, 0x3b, 0xcf,
+	0x88, 0x4c, 0x0c, 0x2d, 0x69, 0x7a, 0x2e, 0x3c, 0x99, 0xb7, 0x4b, 0xef, 0x3c, 0x2c, 0x4f, 0xb0,
+	0x93, 0xf5, 0xe7, 0xdf, 0xbd, 0x38, 0x17, 0x3b, 0x54, 0x6d, 0xed, 0x9d, 0x14, 0x98, 0x0d, 0xbc,
+	0xbf, 0x8b, 0x1d, 0x4c, 0x87, 0x15, 0xa4, 0x44, 0x2b, 0xe8, 0x35, 0x90, 0x3d, 0xc4, 0xae, 0x45,
+	0x0e, 0xf9, 0xd1, 0xc8, 0xaf, 0x5d, 0xae, 0x0a, 0xb4, 0xad, 0x06, 0x68, 0x5b, 0x6d, 0x4a, 0x34,
+	0xae, 0x4f, 0x33, 0xf7, 0xdf, 0xfb, 0xa8, 0xac, 0xe8, 0x52, 0x45, 0x7d, 0x03, 0xcc, 0xc8, 0x64,
+	0xda, 0x6c, 0x09, 0x81, 0x3b, 0xf5, 0xea, 0x93, 0x6d, 0x53, 0x97, 0xf5, 0x24, 0xbc, 0x7c, 0x03,
+	0xcc, 0xec, 0x13, 0xdb, 0x0a, 0x4d, 0x66, 0x3e, 0x9b, 0x49, 0x61, 0x83, 0x9b, 0xd4, 0xbe, 0x0f,
+	0x72, 0xed, 0xae, 0xd9, 0x21, 0x36, 0x36, 0x8f, 0xc7, 0x44, 0xe1, 0xff, 0x41, 0x81, 0x67, 0x1d,
+	0x59, 0x86, 0xb9, 0x0f, 0x5d, 0x17, 0xd9, 0x7e, 0x31, 0xc5, 0x73, 0x7b, 0x49, 0xd2, 0x1b, 0x92,
+	0xac, 0xde, 0x02, 0x0b, 0x81, 0xa8, 0x85, 0x7c, 0x8a, 0x5d, 0x1e, 0x1c, 0x9f, 0x43, 0x43, 0x4e,
+	0x16, 0x0f, 0x83, 0xdb, 0x21, 0x4b, 0xfb, 0x8b, 0x02, 0xf2, 0x41, 0x2e, 0xd6, 0x11, 0x1a, 0xe3,
+	0xc3, 0x55, 0x90, 0xf3, 0x90, 0x89, 0xfb, 0x18, 0xb9, 0x54, 0xe0, 0x94, 0x3e, 0x24, 0xb0, 0x13,
+	0xd1, 0x85, 0x3e, 0xf6, 0x8d, 0x3e, 0xc1, 0x2e, 0xf5, 0x79, 0xa8, 0x67, 0x39, 0xcc, 0x61, 0xbf,
+	0xc3, 0x49, 0xea, 0x3e, 0xc8, 0xed, 0xd9, 0x90, 0x1a, 0x7b, 0x88, 0xc3, 0x78, 0x9a, 0x67, 0x53,
+	0xa2, 0x28, 0x83, 0xc3, 0x10, 0x45, 0x1b, 0x04, 0xbb, 0xf5, 0x97, 0x58, 0x48, 0x7f, 0xf5, 0x51,
+	0x79, 0xe5, 0x02, 0x21, 0x65, 0x0a, 0xbe, 0x3e, 0xcd, 0xac, 0xaf, 0x23, 0xe4, 0x6b, 0xbf, 0x56,
+	0xc0, 0x42, 0x07, 0xb9, 0x16, 0x76, 0x7b, 0x7a, 0x00, 0xf7, 0x0e, 0xf3, 0x32, 0x79, 0x67, 0x43,
+	0xdc, 0x4b, 0x3d, 0x31, 0xee, 0x15, 0x87, 0xd8, 0x2d, 0x6e, 0xb5, 0xe0, 0x93, 0xe5, 0x6c, 0x78,
+	0x13, 0xed, 0x23, 0xdc, 0xdb, 0x17, 0xd5, 0x92, 0xd6, 0x2f, 0x85, 0xf4, 0xd7, 0x39, 0x59, 0xfb,
+	0x79, 0x1a, 0xa8, 0xe7, 0x6e, 0xb3, 0x71, 0xb5, 0x50, 0x02, 0xd3, 0x3e, 0x7a, 0x6b, 0x80, 0x82,
+	0xcb, 0x37, 0xa3, 0x87, 0xdf, 0xea, 0x1d, 0x90, 0x67, 0xf5, 0xd1, 0x43, 0x02, 0xfc, 0xd2, 0x7c,
+	0x3b, 0xd7, 0x93, 0xb7, 0x23, 0x16, 0x6c, 0x70, 0x71, 0x06, 0x6a, 0x3a, 0x30, 0xc3, 0xdf, 0xea,
+	0x3a, 0xc8, 0x42, 0x87, 0x5f, 0x77, 0x9f, 0xad, 0xc0, 0xa5, 0x36, 0xb3, 0x23, 0x21, 0x66, 0xf2,
+	0xb3, 0xd9, 0x91, 0xa8, 0x7e, 0x15, 0xe4, 0xd8, 0x45, 0x4b, 0x3c, 0x4c, 0xe5, 0x5d, 0xa2, 0x0f,
+	0x09, 0xbc, 0xf8, 0xd8, 0x45, 0x1e, 0x84, 0x79, 0x8a, 0x87, 0x39, 0xcf, 0x69, 0x22, 0xc4, 0x6a,
+	0x03, 0x00, 0x21, 0xc2, 0x9a, 0x33, 0x8e, 0xd7, 0xf9, 0xb5, 0xd2, 0x39, 0x2c, 0xd9, 0x09, 0x3a,
+	0x37, 0x01, 0x26, 0xef, 0x32, 0x30, 0xc9, 0x71, 0x3d, 0xc6, 0xd1, 0xfe, 0xa4, 0x80, 0xd9, 0x4d,
+	0x44, 0x6b, 0xbe, 0x8f, 0xe8, 0x3d, 0x68, 0x0f, 0x90, 0xfa, 0x55, 0x30, 0xd9, 0xf7, 0xb0, 0x89,
+	0x64, 0x57, 0xf0, 0x98, 0x7a, 0x16, 0x37, 0xae, 0x90, 0x56, 0x17, 0x41, 0xf6, 0x80, 0xd8, 0x03,
+	0x27, 0xc8, 0xa0, 0xfc, 0x62, 0x74, 0x9f, 0x0c, 0x3c, 0x13, 0xc9, 0x62, 0x92, 0x5f, 0xb1, 0x9c,
+	0x67, 0x46, 0x72, 0xfe, 0x12, 0x58, 0x18, 0xf4, 0x2d, 0xc8, 0x7a, 0xac, 0x58, 0x10, 0x26, 0x79,
+	0x10, 0x54, 0xc9, 0xab, 0x0f, 0x63, 0xa1, 0xbd, 0xaf, 0x80, 0xb9, 0xd6, 0x01, 0x72, 0xa9, 0xc4,
+	0x64, 0xcb, 0x1a, 0x53, 0x6a, 0x8b, 0x61, 0x15, 0x88, 0xf3, 0x1e, 0x64, 0x75, 0x31, 0x3c, 0x30,
+	0x81, 0x9b, 0xe7, 0x0e, 0x43, 0x26, 0x7e, 0x18, 0xca, 0xf1, 0x5b, 0x59, 0x34, 0x09, 0xd1, 0x1b,
+	0xb5, 0x08, 0xa6, 0xa0, 0x65, 0x79, 0xc8, 0xf7, 0x65, 0x7a, 0x83, 0x4f, 0xed, 0xc7, 0x0a, 0x58,
+	0x88, 0x7b, 0x2b, 0x1a, 0x19, 0xb5, 0x05, 0xb2, 0xa2, 0x7f, 0x91, 0xc1, 0xbf, 0x91, 0x5c, 0xe7,
+	0x51, 0x5d, 0x2e, 0x2e, 0x53, 0x21, 0x95, 0x87, 0x5b, 0x4f, 0x45, 0xb7, 0xfe, 0x02, 0x98, 0x85,
+	0x96, 0x83, 0x5d, 0xec, 0x53, 0x0f, 0x52, 0x12, 0x9c, 0xee, 0x38, 0x51, 0xdb, 0x02, 0xcf, 0x9c,
+	0x33, 0x1f, 0xdd, 0x8a, 0x12, 0xdb, 0x8a, 0x5a, 0x01, 0xf9, 0x3e, 0xf2, 0x1c, 0xec, 0xfb, 0x1c,
+	0x92, 0x05, 0x82, 0x47, 0x49, 0xda, 0xf7, 0xc0, 0x52, 0xc4, 0x60, 0x13, 0xd9, 0x88, 0x22, 0x69,
+	0xf6, 0xff, 0xc0, 0x9c, 0x87, 0x1c, 0x72, 0x80, 0x8c, 0xb8, 0xf5, 0x59, 0x41, 0xad, 0xc9, 0x35,
+	0x9e, 0x66, 0x3b, 0x6f, 0x80, 0xf9, 0xc8, 0xea, 0xeb, 0xd8, 0x85, 0x36, 0xfe, 0xee, 0xb8, 0xfb,
+	0xe0, 0x9c, 0xc9, 0xd4, 0xa7, 0x9b, 0xac, 0x99, 0x14, 0x1f, 0x40, 0xfa, 0x74, 0x26, 0xe3, 0x41,
+	0x6f, 0xb0, 0x74, 0xdb, 0x9f, 0xa3, 0x41, 0x11, 0xf4, 0xa7, 0x32, 0x88, 0xc0, 0xa5, 0x88, 0xc1,
+	0x0d, 0x2c, 0x8e, 0x8c, 0x3c, 0x4a, 0x4a, 0xec, 0x28, 0x3d, 0x4d, 0xba, 0xe2, 0xcb, 0xd4, 0x07,
+	0x9e, 0xfb, 0x85, 0x2c, 0xf3, 0xb6, 0x12, 0xcb, 0xe1, 0x37, 0x31, 0xdd, 0xb7, 0x3c, 0x78, 0xc8,
+	0x6c, 0xb2, 0x21, 0x35, 0xa8, 0x43, 0xf1, 0xf1, 0x34, 0x2b, 0xa9, 0xcf, 0x01, 0x40, 0x49, 0x58,
+	0xde, 0x02, 0x42, 0x72, 0x94, 0xc8, 0xd2, 0xd6, 0xde, 0x8f, 0x3b, 0x12, 0x36, 0xbf, 0x5f, 0xc0,
+	0xa6, 0x3f, 0xc5, 0x15, 0x76, 0xe3, 0xec, 0x79, 0xc4, 0x09, 0x05, 0x04, 0xa0, 0xe5, 0x19, 0x2d,
+	0xf0, 0xf6, 0x3f, 0x29, 0x70, 0x25, 0xe2, 0xed, 0x36, 0xa2, 0x7c, 0xc6, 0xdd, 0x40, 0x14, 0x5a,
+	0x90, 0x42, 0xf5, 0x79, 0x30, 0xeb, 0xc8, 0xdf, 0x06, 0xbb, 0x2e, 0xa4, 0xf3, 0x33, 0x01, 0x91,
+	0xcd, 0x92, 0xac, 0x9b, 0x0b, 0x85, 0x2c, 0xe4, 0x9b, 0x1e, 0xee, 0xb3, 0x9e, 0x4d, 0xee, 0x68,
+	0x3e, 0xe0, 0x35, 0x87, 0x2c, 0xd6, 0x77, 0x0c, 0x55, 0xb0, 0xdf, 0xb7, 0xe1, 0xb1, 0xdc, 0xe2,
+	0xa5, 0x50, 0x5c, 0x90, 0xd5, 0x7b, 0x31, 0xeb, 0x6c, 0x3e, 0x1f, 0xb8, 0x98, 0x06, 0xcd, 0xd9,
+	0x0b, 0x8f, 0xc1, 0x53, 0xbe, 0x95, 0x5d, 0x17, 0x53, 0x5d, 0x1d, 0xfa, 0x20, 0x49, 0xfe, 0xf9,
+	0x10, 0x4f, 0x26, 0x85, 0x38, 0x1a, 0x00, 0x17, 0x3a, 0xa8, 0x98, 0x8d, 0x07, 0x60, 0x13, 0x3a,
+	0x48, 0xbd, 0x01, 0x42, 0xaf, 0x0d, 0xff, 0xd8, 0xe9, 0x12, 0x9b, 0xdf, 0xee, 0x39, 0x7d, 0x2e,
+	0x20, 0x6f, 0x73, 0xaa, 0xf6, 0x2d, 0x79, 0xa7, 0x85, 0x6e, 0x8c, 0x6f, 0x9f, 0xd0, 0x51, 0x9f,
+	0xb8, 0xc3, 0x2e, 0x36, 0xfc, 0xe6, 0xc8, 0x6d, 0x63, 0xe8, 0xa3, 0xa0, 0x5d, 0x0e, 0x3e, 0xb5,
+	0x3f, 0x28, 0xa3, 0xc9, 0xbc, 0xc8, 0xf0, 0xb2, 0x18, 0x1b, 0x5e, 0x72, 0xe1, 0x5c, 0x72, 0x2d,
+	0x69, 0x2e, 0x89, 0xcf, 0x19, 0xd7, 0x92, 0xe6, 0x8c, 0xd8, 0xdc, 0x70, 0xb1, 0x28, 0x6b, 0x3f,
+	0x89, 0x7b, 0x5e, 0xb3, 0xac, 0x78, 0x07, 0x93, 0xec, 0xf9, 0x42, 0xd0, 0xd7, 0xc8, 0xa3, 0x33,
+	0xda, 0xb6, 0xa4, 0xc7, 0xb4, 0x2d, 0x99, 0x58, 0xdb, 0x72, 0x31, 0x0f, 0x7f, 0xa8, 0x80, 0x67,
+	0xa3, 0x80, 0x3e, 0xec, 0x42, 0x93, 0x7d, 0xbb, 0x0c, 0xa6, 0x89, 0x6d, 0x89, 0x46, 0x42, 0xb8,
+	0x37, 0x45, 0x6c, 0x8b, 0x2b, 0x5c, 0x06, 0xd3, 0x2e, 0x3a, 0x1c, 0x36, 0xbf, 0x39, 0x7d, 0xca,
+	0x45, 0x87, 0x9c, 0x75, 0x1d, 0xcc, 0xc5, 0x96, 0x15, 0x55, 0x9e, 0xd3, 0x47, 0xa8, 0xda, 0x41,
+	0xec, 0x06, 0xde, 0x46, 0x74, 0x23, 0x98, 0x67, 0xc7, 0xb8, 0xf3, 0x5c, 0x6c, 0x92, 0x96, 0x83,
+	0x51, 0x38, 0x04, 0x27, 0xac, 0x9b, 0x4e, 0x5c, 0xf7, 0xa1, 0x02, 0x8a, 0x51, 0x34, 0x87, 0xd4,
+	0xdc, 0x0f, 0x11, 0x2e, 0x79, 0xe5, 0x6b, 0x60, 0x46, 0xbc, 0x81, 0xc4, 0x9a, 0xb4, 0x3c, 0xa7,
+	0xd5, 0x38, 0x89, 0x35, 0x0d, 0xc1, 0xf3, 0x81, 0x21, 0x9e, 0xaf, 0xc4, 0x60, 0x36, 0x1b, 0x50,
+	0x1b, 0x5c, 0xec, 0x5c, 0xa2, 0x32, 0x49, 0x89, 0xfa, 0xab, 0x02, 0x2e, 0x47, 0x5c, 0xd4, 0x91,
+	0x49, 0x0e, 0xc2, 0x91, 0xfe, 0x09, 0x51, 0x78, 0x15, 0xcc, 0x9b, 0xc4, 0xe9, 0x7b, 0xc4, 0xc1,
+	0x3e, 0x7b, 0xb3, 0x90, 0x38, 0x2a, 0x92, 0xa6, 0x46, 0x58, 0x01, 0xe2, 0xf2, 0x17, 0x8e, 0xbe,
+	0x0d, 0x4d, 0x3e, 0xc9, 0x8d, 0x20, 0xb3, 0x1a, 0x61, 0x05, 0x0a, 0x17, 0x2b, 0xbe, 0xdf, 0x2a,
+	0xa3, 0xf9, 0xfe, 0x9f, 0xce, 0xe2, 0x17, 0xcc, 0xc4, 0xef, 0xe3, 0x99, 0x88, 0xc0, 0xd1, 0x17,
+	0x38, 0xbf, 0x5f, 0x89, 0xcf, 0xef, 0x1c, 0x3a, 0x83, 0x91, 0xfb, 0x82, 0xd1, 0xfe, 0x81, 0x02,
+	0x16, 0x13, 0x6e, 0xf0, 0xf1, 0x4e, 0x8f, 0xde, 0xb3, 0xa9, 0x73, 0xf7, 0x6c, 0x7c, 0x5f, 0xe9,
+	0xd1, 0x7d, 0xa9, 0x20, 0x13, 0xf1, 0x97, 0xff, 0xd6, 0x7e, 0x13, 0x9f, 0x28, 0xc2, 0x27, 0x82,
+	0xf1, 0x28, 0x1e, 0x79, 0x1e, 0xc8, 0x7d, 0xae, 0xa3, 0xff, 0x05, 0xe3, 0x66, 0x80, 0x67, 0x93,
+	0x1c, 0xb6, 0x3e, 0x2f, 0x8f, 0xb5, 0x1f, 0xc5, 0xd1, 0x47, 0x47, 0xa6, 0x0d, 0xb1, 0xd3, 0xf2,
+	0x4d, 0x8f, 0x1c, 0x8e, 0xc7, 0xbd, 0x48, 0x87, 0x94, 0x1a, 0xed, 0x90, 0x86, 0x70, 0x90, 0x8e,
+	0xc1, 0xc1, 0x85, 0x0a, 0xfc, 0xe6, 0xbf, 0xd2, 0xa0, 0x30, 0xfa, 0x40, 0xa1, 0x7e, 0x0d, 0x2c,
+	0x6f, 0xef, 0x76, 0x3a, 0x77, 0xef, 0x1b, 0x8d, 0xd7, 0x6b, 0x9b, 0x77, 0x5a, 0xc6, 0xce, 0xfd,
+	0x4e, 0xcb, 0xd8, 0xdd, 0xdc, 0xee, 0xb4, 0x1a, 0xed, 0xf5, 0x76, 0xab, 0x59, 0x98, 0x28, 0x5d,
+	0x39, 0x39, 0xad, 0x2c, 0x45, 0x35, 0x77, 0x5d, 0xbf, 0x8f, 0x4c, 0xbc, 0x87, 0x91, 0xa5, 0xde,
+	0x02, 0x4b, 0x09, 0x06, 0x36, 0xda, 0x9b, 0x3b, 0x05, 0xa5, 0xb4, 0x70, 0x72, 0x5a, 0x89, 0xad,
+	0xc9, 0xfb, 0xf6, 0x64, 0x95, 0xfa, 0xae, 0xbe, 0x59, 0x48, 0x9d, 0x57, 0xe1, 0x3d, 0xf8, 0xd7,
+	0x41, 0x39, 0x41, 0xe5, 0xce, 0xd6, 0x3d, 0xa3, 0xbd, 0xd9, 0xd0, 0x5b, 0xb5, 0xed, 0x56, 0x21,
+	0x7d, 0xde, 0xcf, 0x3b, 0xe4, 0xa0, 0xed, 0x9a, 0x1e, 0x62, 0x5d, 0xdf, 0x78, 0x0b, 0xcd, 0x96,
+	0xb4, 0x90, 0x49, 0xb4, 0xd0, 0x44, 0xd2, 0x42, 0x07, 0xdc, 0x48, 0xb0, 0xd0, 0x68, 0xeb, 0x8d,
+	0xdd, 0xbb, 0xb5, 0x9d, 0xf6, 0xd6, 0xe6, 0xd0, 0x97, 0xc9, 0xd2, 0xf3, 0x27, 0xa7, 0x95, 0x72,
+	0xd4, 0x52, 0x03, 0x7b, 0xe6, 0xc0, 0xe6, 0x88, 0xd4, 0x76, 0x2f, 0x6c, 0x31, 0xf4, 0x2d, 0xfb,
+	0x58, 0x8b, 0x81, 0x8f, 0xa5, 0xcc, 0x3b, 0x3f, 0x5d, 0x9e, 0xb8, 0xf9, 0xb6, 0x02, 0xc0, 0xf0,
+	0x1d, 0x5e, 0x5d, 0x01, 0x4b, 0x1b, 0x35, 0xfd, 0x1b, 0x2d, 0x3d, 0x29, 0xb9, 0xf9, 0x93, 0xd3,
+	0xca, 0xd4, 0xae, 0xfb, 0xc0, 0x25, 0x87, 0xae, 0xba, 0x0c, 0x0a, 0x51, 0xc9, 0xc6, 0x56, 0x7b,
+	0xb3, 0xa0, 0x94, 0xa6, 0x4f, 0x4e, 0x2b, 0x19, 0xf6, 0xe0, 0xa2, 0x56, 0xc1, 0x62, 0x94, 0xaf,
+	0xb7, 0xb6, 0x77, 0xf4, 0x76, 0x63, 0xa7, 0xd5, 0x2c, 0xa4, 0x4a, 0xea, 0xc9, 0x69, 0x65, 0x4e,
+	0x0f, 0xff, 0xf9, 0xc4, 0xe4, 0x6f, 0xfe, 0x31, 0x05, 0x66, 0xa2, 0x4f, 0x7c, 0xea, 0x1a, 0xb8,
+	0x2c, 0x0d, 0x6c, 0xef, 0xd4, 0x76, 0x76, 0xb7, 0x47, 0x9c, 0x99, 0x3f, 0x39, 0xad, 0x5c, 0x12,
+	0xa2, 0xbb, 0xae, 0x85, 0xf6, 0xb0, 0x8b, 0xac, 0xc8, 0xa2, 0x52, 0xa7, 0xa3, 0x6f, 0x75, 0xb6,
+	0xb6, 0x5b, 0xcd, 0x82, 0x22, 0x16, 0x15, 0x0a, 0x1d, 0x8f, 0xf4, 0x89, 0x8f, 0x2c, 0xf5, 0x25,
+	0xb0, 0x14, 0x97, 0x5f, 0x6f, 0x6f, 0xd6, 0xee, 0xb6, 0xdf, 0xe4, 0x5e, 0x46, 0x56, 0x08, 0x66,
+	0x71, 0x4b, 0xbd, 0x09, 0x16, 0xe2, 0x1a, 0xb5, 0xc6, 0x4e, 0xfb, 0x1e, 0x2b, 0xa9, 0xc2, 0xc9,
+	0x69, 0x65, 0x46, 0x88, 0xf3, 0x39, 0x1b, 0x9d, 0xb7, 0xde, 0xa8, 0x6d, 0x36, 0x5a, 0x77, 0xef,
+	0xb6, 0x9a, 0x85, 0x4c, 0xd4, 0xba, 0x98, 0xa1, 0xed, 0x24, 0x7f, 0x9a, 0x2c, 0x6c, 0x5b, 0xf7,
+	0x5b, 0xcd, 0xc2, 0x64, 0x54, 0x83, 0xdd, 0x59, 0x1e, 0x39, 0x46, 0x56, 0x69, 0x9a, 0x65, 0xf1,
+	0x97, 0x3f, 0x5b, 0x9e, 0xa8, 0xf7, 0x3e, 0xf8, 0x78, 0x59, 0xf9, 0xf0, 0xe3, 0x65, 0xe5, 0x1f,
+	0x1f, 0x2f, 0x2b, 0xef, 0x7e, 0xb2, 0x3c, 0xf1, 0xe1, 0x27, 0xcb, 0x13, 0x7f, 0xfb, 0x64, 0x79,
+	0x02, 0x2c, 0x61, 0x92, 0x38, 0x4b, 0x74, 0x94, 0x37, 0xd7, 0x22, 0x0f, 0x81, 0x43, 0x91, 0x17,
+	0x31, 0x89, 0x7c, 0xad, 0x1e, 0x05, 0xff, 0xdb, 0xe4, 0x0f, 0x83, 0xdd, 0x2c, 0x7f, 0xaf, 0xfb,
+	0xca, 0x7f, 0x07, 0x00, 0x70, 0x55, 0x39, 0x68, 0xe8, 0x1d, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	if m.ReinstateDelayBlocks != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.ReinstateDelayBlocks))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	if m.MaxSupplyHistoryEntries != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.MaxSupplyHistoryEntries))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	if len(m.UnrestrictedDenomRegex) > 0 {
+		i -= len(m.UnrestrictedDenomRegex)
+		copy(dAtA[i:], m.UnrestrictedDenomRegex)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.UnrestrictedDenomRegex)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if m.EnableGovernance {
+		i--
+		if m.EnableGovernance {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxTotalSupply != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.MaxTotalSupply))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventMarkerBurn) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MarkerAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MarkerAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarkerAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarker(dAtA, i, uint64(size))
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	i--
+	dAtA[i] = 0x62
+	if len(m.RequiredAttributes) > 0 {
+		for iNdEx := len(m.RequiredAttributes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequiredAttributes[iNdEx])
+			copy(dAtA[i:], m.RequiredAttributes[iNdEx])
+			i = encodeVarintMarker(dAtA, i, uint64(len(m.RequiredAttributes[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
 	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	if m.AllowForcedTransfer {
+		i--
+		if m.AllowForcedTransfer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
 	}
-	return n
+	if m.AllowGovernanceControl {
+		i--
+		if m.AllowGovernanceControl {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.SupplyFixed {
+		i--
+		if m.SupplyFixed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MarkerType != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.MarkerType))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.Supply.Size()
+		i -= size
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Status != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.AccessControl) > 0 {
+		for iNdEx := len(m.AccessControl) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccessControl[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Manager) > 0 {
+		i -= len(m.Manager)
+		copy(dAtA[i:], m.Manager)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Manager)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BaseAccount != nil {
+		{
+			size, err := m.BaseAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMarker(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventMarkerWithdraw) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TransferLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Coins)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	{
+		size := m.HolderLimit.Size()
+		i -= size
+		if _, err := m.HolderLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarker(dAtA, i, uint64(size))
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MarkerLimit.Size()
+		i -= size
+		if _, err := m.MarkerLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarker(dAtA, i, uint64(size))
 	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window):])
+	if err2 != nil {
+		return 0, err2
 	}
-	l = len(m.ToAddress)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	i -= n2
+	i = encodeVarintMarker(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IbcPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IbcPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IbcPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedDestinations) > 0 {
+		for iNdEx := len(m.AllowedDestinations) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDestinations[iNdEx])
+			copy(dAtA[i:], m.AllowedDestinations[iNdEx])
+			i = encodeVarintMarker(dAtA, i, uint64(len(m.AllowedDestinations[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AllowedChannels) > 0 {
+		for iNdEx := len(m.AllowedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedChannels[iNdEx])
+			copy(dAtA[i:], m.AllowedChannels[iNdEx])
+			i = encodeVarintMarker(dAtA, i, uint64(len(m.AllowedChannels[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FlatFees) > 0 {
+		for iNdEx := len(m.FlatFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FlatFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.BasisPoints != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.BasisPoints))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingReinstatement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingReinstatement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingReinstatement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReinstateHeight != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.ReinstateHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Manager) > 0 {
+		i -= len(m.Manager)
+		copy(dAtA[i:], m.Manager)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Manager)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SupplyHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplyHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplyHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintMarker(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x42
+	if m.BlockHeight != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.Supply.Size()
+		i -= size
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ChangeType != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.ChangeType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NetAssetValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NetAssetValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NetAssetValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpdatedBlockHeight != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.UpdatedBlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Volume != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.Volume))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerAdd) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerAdd) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerAdd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.MarkerType) > 0 {
+		i -= len(m.MarkerType)
+		copy(dAtA[i:], m.MarkerType)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.MarkerType)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Manager) > 0 {
+		i -= len(m.Manager)
+		copy(dAtA[i:], m.Manager)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Manager)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerAddAccess) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerAddAccess) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerAddAccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Access.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerAccess) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerAccess) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerAccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Permissions[iNdEx])
+			copy(dAtA[i:], m.Permissions[iNdEx])
+			i = encodeVarintMarker(dAtA, i, uint64(len(m.Permissions[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerDeleteAccess) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerDeleteAccess) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerDeleteAccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RemoveAddress) > 0 {
+		i -= len(m.RemoveAddress)
+		copy(dAtA[i:], m.RemoveAddress)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.RemoveAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerFinalize) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerFinalize) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerFinalize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerActivate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerActivate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerActivate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerCancel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerCancel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerCancel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerDelete) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerDelete) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerDelete) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerMint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerMint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerBurn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerBurn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerBurn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerWithdraw) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerWithdraw) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Coins) > 0 {
+		i -= len(m.Coins)
+		copy(dAtA[i:], m.Coins)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Coins)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerSetDenomMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerSetDenomMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerSetDenomMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MetadataSymbol) > 0 {
+		i -= len(m.MetadataSymbol)
+		copy(dAtA[i:], m.MetadataSymbol)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.MetadataSymbol)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.MetadataName) > 0 {
+		i -= len(m.MetadataName)
+		copy(dAtA[i:], m.MetadataName)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.MetadataName)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MetadataDenomUnits) > 0 {
+		for iNdEx := len(m.MetadataDenomUnits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MetadataDenomUnits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.MetadataDisplay) > 0 {
+		i -= len(m.MetadataDisplay)
+		copy(dAtA[i:], m.MetadataDisplay)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.MetadataDisplay)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MetadataDescription) > 0 {
+		i -= len(m.MetadataDescription)
+		copy(dAtA[i:], m.MetadataDescription)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.MetadataDescription)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MetadataBase) > 0 {
+		i -= len(m.MetadataBase)
+		copy(dAtA[i:], m.MetadataBase)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.MetadataBase)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDenomUnit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDenomUnit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDenomUnit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Aliases) > 0 {
+		for iNdEx := len(m.Aliases) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Aliases[iNdEx])
+			copy(dAtA[i:], m.Aliases[iNdEx])
+			i = encodeVarintMarker(dAtA, i, uint64(len(m.Aliases[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Exponent) > 0 {
+		i -= len(m.Exponent)
+		copy(dAtA[i:], m.Exponent)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Exponent)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerSetTransferLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerSetTransferLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerSetTransferLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.HolderLimit) > 0 {
+		i -= len(m.HolderLimit)
+		copy(dAtA[i:], m.HolderLimit)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.HolderLimit)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MarkerLimit) > 0 {
+		i -= len(m.MarkerLimit)
+		copy(dAtA[i:], m.MarkerLimit)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.MarkerLimit)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Window) > 0 {
+		i -= len(m.Window)
+		copy(dAtA[i:], m.Window)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Window)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerAddNetAssetValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerAddNetAssetValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerAddNetAssetValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Volume != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.Volume))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerChangeType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerChangeType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerChangeType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrators) > 0 {
+		for iNdEx := len(m.Administrators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Administrators[iNdEx])
+			copy(dAtA[i:], m.Administrators[iNdEx])
+			i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrators[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.NewType) > 0 {
+		i -= len(m.NewType)
+		copy(dAtA[i:], m.NewType)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.NewType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OldType) > 0 {
+		i -= len(m.OldType)
+		copy(dAtA[i:], m.OldType)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.OldType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerSetMaxSupply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerSetMaxSupply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerSetMaxSupply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrators) > 0 {
+		for iNdEx := len(m.Administrators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Administrators[iNdEx])
+			copy(dAtA[i:], m.Administrators[iNdEx])
+			i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrators[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.MaxSupply) > 0 {
+		i -= len(m.MaxSupply)
+		copy(dAtA[i:], m.MaxSupply)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.MaxSupply)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerBatchTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerBatchTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerBatchTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TransferCount != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.TransferCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TotalAmount) > 0 {
+		i -= len(m.TotalAmount)
+		copy(dAtA[i:], m.TotalAmount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.TotalAmount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerRecoverAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerRecoverAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerRecoverAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ReplacementAddress) > 0 {
+		i -= len(m.ReplacementAddress)
+		copy(dAtA[i:], m.ReplacementAddress)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.ReplacementAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CompromisedAddress) > 0 {
+		i -= len(m.CompromisedAddress)
+		copy(dAtA[i:], m.CompromisedAddress)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.CompromisedAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerSetIbcPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerSetIbcPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerSetIbcPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AllowedDestinations) > 0 {
+		for iNdEx := len(m.AllowedDestinations) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDestinations[iNdEx])
+			copy(dAtA[i:], m.AllowedDestinations[iNdEx])
+			i = encodeVarintMarker(dAtA, i, uint64(len(m.AllowedDestinations[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AllowedChannels) > 0 {
+		for iNdEx := len(m.AllowedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedChannels[iNdEx])
+			copy(dAtA[i:], m.AllowedChannels[iNdEx])
+			i = encodeVarintMarker(dAtA, i, uint64(len(m.AllowedChannels[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerSetTransferFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerSetTransferFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerSetTransferFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.FlatFees) > 0 {
+		i -= len(m.FlatFees)
+		copy(dAtA[i:], m.FlatFees)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.FlatFees)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BasisPoints != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.BasisPoints))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerTransferFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerTransferFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerTransferFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		i -= len(m.Fees)
+		copy(dAtA[i:], m.Fees)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Fees)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerReinstate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerReinstate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerReinstate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ReinstateHeight != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.ReinstateHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Manager) > 0 {
+		i -= len(m.Manager)
+		copy(dAtA[i:], m.Manager)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Manager)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerReinstated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerReinstated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerReinstated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Manager) > 0 {
+		i -= len(m.Manager)
+		copy(dAtA[i:], m.Manager)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Manager)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarkerReclaimEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerReclaimEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerReclaimEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMarker(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMarker(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxTotalSupply != 0 {
+		n += 1 + sovMarker(uint64(m.MaxTotalSupply))
+	}
+	if m.EnableGovernance {
+		n += 2
+	}
+	l = len(m.UnrestrictedDenomRegex)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if m.MaxSupplyHistoryEntries != 0 {
+		n += 1 + sovMarker(uint64(m.MaxSupplyHistoryEntries))
+	}
+	if m.ReinstateDelayBlocks != 0 {
+		n += 1 + sovMarker(uint64(m.ReinstateDelayBlocks))
+	}
+	return n
+}
+
+func (m *MarkerAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseAccount != nil {
+		l = m.BaseAccount.Size()
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Manager)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if len(m.AccessControl) > 0 {
+		for _, e := range m.AccessControl {
+			l = e.Size()
+			n += 1 + l + sovMarker(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovMarker(uint64(m.Status))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = m.Supply.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	if m.MarkerType != 0 {
+		n += 1 + sovMarker(uint64(m.MarkerType))
+	}
+	if m.SupplyFixed {
+		n += 2
+	}
+	if m.AllowGovernanceControl {
+		n += 2
+	}
+	if m.AllowForcedTransfer {
+		n += 2
+	}
+	if len(m.RequiredAttributes) > 0 {
+		for _, s := range m.RequiredAttributes {
+			l = len(s)
+			n += 1 + l + sovMarker(uint64(l))
+		}
+	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	return n
+}
+
+func (m *TransferLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovMarker(uint64(l))
+	l = m.MarkerLimit.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	l = m.HolderLimit.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	return n
+}
+
+func (m *IbcPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if len(m.AllowedChannels) > 0 {
+		for _, s := range m.AllowedChannels {
+			l = len(s)
+			n += 1 + l + sovMarker(uint64(l))
+		}
+	}
+	if len(m.AllowedDestinations) > 0 {
+		for _, s := range m.AllowedDestinations {
+			l = len(s)
+			n += 1 + l + sovMarker(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TransferFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if m.BasisPoints != 0 {
+		n += 1 + sovMarker(uint64(m.BasisPoints))
+	}
+	if len(m.FlatFees) > 0 {
+		for _, e := range m.FlatFees {
+			l = e.Size()
+			n += 1 + l + sovMarker(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PendingReinstatement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovMarker(uint64(m.Status))
+	}
+	l = len(m.Manager)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if m.ReinstateHeight != 0 {
+		n += 1 + sovMarker(uint64(m.ReinstateHeight))
+	}
+	return n
+}
+
+func (m *SupplyHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovMarker(uint64(m.Sequence))
+	}
+	if m.ChangeType != 0 {
+		n += 1 + sovMarker(uint64(m.ChangeType))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	l = m.Supply.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovMarker(uint64(m.BlockHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovMarker(uint64(l))
+	return n
+}
+
+func (m *NetAssetValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	if m.Volume != 0 {
+		n += 1 + sovMarker(uint64(m.Volume))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovMarker(uint64(m.Sequence))
+	}
+	if m.UpdatedBlockHeight != 0 {
+		n += 1 + sovMarker(uint64(m.UpdatedBlockHeight))
+	}
+	return n
+}
+
+func (m *EventMarkerAdd) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Manager)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.MarkerType)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerAddAccess) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Access.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerAccess) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if len(m.Permissions) > 0 {
+		for _, s := range m.Permissions {
+			l = len(s)
+			n += 1 + l + sovMarker(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventMarkerDeleteAccess) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RemoveAddress)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerFinalize) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerActivate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerCancel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerDelete) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerMint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerBurn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerWithdraw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Coins)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerSetDenomMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MetadataBase)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.MetadataDescription)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.MetadataDisplay)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if len(m.MetadataDenomUnits) > 0 {
+		for _, e := range m.MetadataDenomUnits {
+			l = e.Size()
+			n += 1 + l + sovMarker(uint64(l))
+		}
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.MetadataName)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.MetadataSymbol)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDenomUnit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Exponent)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if len(m.Aliases) > 0 {
+		for _, s := range m.Aliases {
+			l = len(s)
+			n += 1 + l + sovMarker(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventMarkerSetTransferLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Window)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.MarkerLimit)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.HolderLimit)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerAddNetAssetValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if m.Volume != 0 {
+		n += 1 + sovMarker(uint64(m.Volume))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerChangeType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.OldType)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.NewType)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if len(m.Administrators) > 0 {
+		for _, s := range m.Administrators {
+			l = len(s)
+			n += 1 + l + sovMarker(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventMarkerSetMaxSupply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.MaxSupply)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if len(m.Administrators) > 0 {
+		for _, s := range m.Administrators {
+			l = len(s)
+			n += 1 + l + sovMarker(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventMarkerBatchTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.TotalAmount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if m.TransferCount != 0 {
+		n += 1 + sovMarker(uint64(m.TransferCount))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerRecoverAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.CompromisedAddress)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.ReplacementAddress)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerSetIbcPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if len(m.AllowedChannels) > 0 {
+		for _, s := range m.AllowedChannels {
+			l = len(s)
+			n += 1 + l + sovMarker(uint64(l))
+		}
+	}
+	if len(m.AllowedDestinations) > 0 {
+		for _, s := range m.AllowedDestinations {
+			l = len(s)
+			n += 1 + l + sovMarker(uint64(l))
+		}
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerSetTransferFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if m.BasisPoints != 0 {
+		n += 1 + sovMarker(uint64(m.BasisPoints))
+	}
+	l = len(m.FlatFees)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerTransferFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Fees)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerReinstate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Manager)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if m.ReinstateHeight != 0 {
+		n += 1 + sovMarker(uint64(m.ReinstateHeight))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerReinstated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Manager)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerReclaimEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func sovMarker(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMarker(x uint64) (n int) {
+	return sovMarker(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalSupply", wireType)
+			}
+			m.MaxTotalSupply = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTotalSupply |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableGovernance", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableGovernance = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnrestrictedDenomRegex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnrestrictedDenomRegex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupplyHistoryEntries", wireType)
+			}
+			m.MaxSupplyHistoryEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSupplyHistoryEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReinstateDelayBlocks", wireType)
+			}
+			m.ReinstateDelayBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReinstateDelayBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarkerAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarkerAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseAccount == nil {
+				m.BaseAccount = &types.BaseAccount{}
+			}
+			if err := m.BaseAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessControl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessControl = append(m.AccessControl, AccessGrant{})
+			if err := m.AccessControl[len(m.AccessControl)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= MarkerStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerType", wireType)
+			}
+			m.MarkerType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarkerType |= MarkerType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyFixed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SupplyFixed = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowGovernanceControl", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowGovernanceControl = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowForcedTransfer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowForcedTransfer = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredAttributes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequiredAttributes = append(m.RequiredAttributes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarkerLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HolderLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HolderLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IbcPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IbcPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IbcPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedChannels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedChannels = append(m.AllowedChannels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDestinations", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDestinations = append(m.AllowedDestinations, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasisPoints", wireType)
+			}
+			m.BasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BasisPoints |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FlatFees = append(m.FlatFees, types1.Coin{})
+			if err := m.FlatFees[len(m.FlatFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingReinstatement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingReinstatement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingReinstatement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= MarkerStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReinstateHeight", wireType)
+			}
+			m.ReinstateHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReinstateHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SupplyHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplyHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplyHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeType", wireType)
+			}
+			m.ChangeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChangeType |= SupplyChangeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NetAssetValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetAssetValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetAssetValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			m.Volume = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Volume |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBlockHeight", wireType)
+			}
+			m.UpdatedBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedBlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerAdd) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerAdd: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerAdd: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarkerType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerAddAccess) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerAddAccess: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerAddAccess: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Access", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Access.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerAccess) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerAccess: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerAccess: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerDeleteAccess) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerDeleteAccess: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerDeleteAccess: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoveAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerFinalize) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerFinalize: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerFinalize: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerActivate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerActivate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerActivate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerCancel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerCancel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerCancel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerDelete) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerDelete: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerDelete: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *EventMarkerTransfer) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.ToAddress)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *EventMarkerSetDenomMetadata) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MetadataBase)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.MetadataDescription)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.MetadataDisplay)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	if len(m.MetadataDenomUnits) > 0 {
-		for _, e := range m.MetadataDenomUnits {
-			l = e.Size()
-			n += 1 + l + sovMarker(uint64(l))
+func (m *EventMarkerMint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	l = len(m.Administrator)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.MetadataName)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.MetadataSymbol)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	return n
-}
-
-func (m *EventDenomUnit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	l = len(m.Exponent)
-	if l > 0 {
-		n += 1 + l + sovMarker(uint64(l))
-	}
-	if len(m.Aliases) > 0 {
-		for _, s := range m.Aliases {
-			l = len(s)
-			n += 1 + l + sovMarker(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerMint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerMint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovMarker(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMarker(x uint64) (n int) {
-	return sovMarker(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerBurn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2488,17 +8314,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerBurn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerBurn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalSupply", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.MaxTotalSupply = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarker
@@ -2508,16 +8334,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxTotalSupply |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableGovernance", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarker
@@ -2527,15 +8366,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableGovernance = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnrestrictedDenomRegex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2563,7 +8414,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnrestrictedDenomRegex = string(dAtA[iNdEx:postIndex])
+			m.Administrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2586,7 +8437,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerWithdraw) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2609,17 +8460,17 @@ func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MarkerAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerWithdraw: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarkerAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarker
@@ -2629,31 +8480,27 @@ func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMarker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMarker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BaseAccount == nil {
-				m.BaseAccount = &types.BaseAccount{}
-			}
-			if err := m.BaseAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Coins = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2681,13 +8528,13 @@ func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Manager = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessControl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarker
@@ -2697,31 +8544,29 @@ func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMarker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMarker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccessControl = append(m.AccessControl, AccessGrant{})
-			if err := m.AccessControl[len(m.AccessControl)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarker
@@ -2731,14 +8576,77 @@ func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= MarkerStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2766,11 +8674,11 @@ func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2798,15 +8706,13 @@ func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkerType", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
-			m.MarkerType = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarker
@@ -2816,36 +8722,29 @@ func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarkerType |= MarkerType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupplyFixed", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMarker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
 			}
-			m.SupplyFixed = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowGovernanceControl", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarker
@@ -2855,35 +8754,27 @@ func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllowGovernanceControl = bool(v != 0)
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowForcedTransfer", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMarker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
 			}
-			m.AllowForcedTransfer = bool(v != 0)
-		case 11:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiredAttributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2911,7 +8802,7 @@ func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequiredAttributes = append(m.RequiredAttributes, string(dAtA[iNdEx:postIndex]))
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2934,7 +8825,7 @@ func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarkerAdd) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerSetDenomMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2957,15 +8848,15 @@ func (m *EventMarkerAdd) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerAdd: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerSetDenomMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerAdd: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerSetDenomMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataBase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2993,11 +8884,11 @@ func (m *EventMarkerAdd) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.MetadataBase = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataDescription", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3025,11 +8916,11 @@ func (m *EventMarkerAdd) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.MetadataDescription = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataDisplay", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3057,11 +8948,45 @@ func (m *EventMarkerAdd) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.MetadataDisplay = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataDenomUnits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataDenomUnits = append(m.MetadataDenomUnits, &EventDenomUnit{})
+			if err := m.MetadataDenomUnits[len(m.MetadataDenomUnits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3089,11 +9014,11 @@ func (m *EventMarkerAdd) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Manager = string(dAtA[iNdEx:postIndex])
+			m.Administrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkerType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3121,11 +9046,11 @@ func (m *EventMarkerAdd) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarkerType = string(dAtA[iNdEx:postIndex])
+			m.MetadataName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataSymbol", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3153,7 +9078,7 @@ func (m *EventMarkerAdd) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.MetadataSymbol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3176,7 +9101,7 @@ func (m *EventMarkerAdd) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarkerAddAccess) Unmarshal(dAtA []byte) error {
+func (m *EventDenomUnit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3199,17 +9124,17 @@ func (m *EventMarkerAddAccess) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerAddAccess: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventDenomUnit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerAddAccess: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventDenomUnit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Access", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarker
@@ -3219,28 +9144,27 @@ func (m *EventMarkerAddAccess) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMarker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMarker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Access.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3268,11 +9192,11 @@ func (m *EventMarkerAddAccess) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Exponent = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Aliases", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3300,7 +9224,7 @@ func (m *EventMarkerAddAccess) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.Aliases = append(m.Aliases, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3323,7 +9247,7 @@ func (m *EventMarkerAddAccess) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarkerAccess) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerSetTransferLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3346,15 +9270,15 @@ func (m *EventMarkerAccess) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerAccess: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerSetTransferLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerAccess: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerSetTransferLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3382,11 +9306,11 @@ func (m *EventMarkerAccess) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3414,7 +9338,103 @@ func (m *EventMarkerAccess) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
+			m.Window = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarkerLimit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HolderLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HolderLimit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3437,7 +9457,7 @@ func (m *EventMarkerAccess) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarkerDeleteAccess) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerAddNetAssetValue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3460,15 +9480,47 @@ func (m *EventMarkerDeleteAccess) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerDeleteAccess: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerAddNetAssetValue: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerDeleteAccess: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerAddNetAssetValue: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoveAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3496,11 +9548,30 @@ func (m *EventMarkerDeleteAccess) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoveAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			m.Volume = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Volume |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3528,9 +9599,9 @@ func (m *EventMarkerDeleteAccess) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
@@ -3583,7 +9654,7 @@ func (m *EventMarkerDeleteAccess) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarkerFinalize) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerChangeType) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3606,10 +9677,10 @@ func (m *EventMarkerFinalize) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerFinalize: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerChangeType: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerFinalize: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerChangeType: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3646,7 +9717,7 @@ func (m *EventMarkerFinalize) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3674,61 +9745,11 @@ func (m *EventMarkerFinalize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.OldType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMarker(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMarker
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventMarkerActivate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMarker
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerActivate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerActivate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3756,11 +9777,11 @@ func (m *EventMarkerActivate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.NewType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrators", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3788,7 +9809,7 @@ func (m *EventMarkerActivate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.Administrators = append(m.Administrators, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3811,7 +9832,7 @@ func (m *EventMarkerActivate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarkerCancel) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerSetMaxSupply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3834,10 +9855,10 @@ func (m *EventMarkerCancel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerCancel: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerSetMaxSupply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerCancel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerSetMaxSupply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3874,7 +9895,7 @@ func (m *EventMarkerCancel) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3902,7 +9923,39 @@ func (m *EventMarkerCancel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.MaxSupply = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrators = append(m.Administrators, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3925,7 +9978,7 @@ func (m *EventMarkerCancel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarkerDelete) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerBatchTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3948,10 +10001,10 @@ func (m *EventMarkerDelete) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerDelete: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerBatchTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerDelete: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerBatchTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3987,6 +10040,57 @@ func (m *EventMarkerDelete) Unmarshal(dAtA []byte) error {
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferCount", wireType)
+			}
+			m.TransferCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransferCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
@@ -4039,7 +10143,7 @@ func (m *EventMarkerDelete) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarkerMint) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerRecoverAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4062,10 +10166,10 @@ func (m *EventMarkerMint) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerMint: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerRecoverAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerMint: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerRecoverAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4133,6 +10237,70 @@ func (m *EventMarkerMint) Unmarshal(dAtA []byte) error {
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompromisedAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompromisedAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplacementAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReplacementAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
@@ -4185,7 +10353,7 @@ func (m *EventMarkerMint) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarkerBurn) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerSetIbcPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4204,19 +10372,51 @@ func (m *EventMarkerBurn) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerBurn: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerBurn: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerSetIbcPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerSetIbcPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedChannels", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4244,11 +10444,11 @@ func (m *EventMarkerBurn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.AllowedChannels = append(m.AllowedChannels, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDestinations", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4276,9 +10476,9 @@ func (m *EventMarkerBurn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.AllowedDestinations = append(m.AllowedDestinations, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
@@ -4331,7 +10531,7 @@ func (m *EventMarkerBurn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarkerWithdraw) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerSetTransferFee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4354,15 +10554,15 @@ func (m *EventMarkerWithdraw) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerWithdraw: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerSetTransferFee: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerSetTransferFee: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4390,11 +10590,11 @@ func (m *EventMarkerWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4422,11 +10622,30 @@ func (m *EventMarkerWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasisPoints", wireType)
+			}
+			m.BasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BasisPoints |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFees", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4454,11 +10673,11 @@ func (m *EventMarkerWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.FlatFees = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4486,7 +10705,7 @@ func (m *EventMarkerWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			m.Administrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4509,7 +10728,7 @@ func (m *EventMarkerWithdraw) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarkerTransfer) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerTransferFee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4532,15 +10751,15 @@ func (m *EventMarkerTransfer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerTransfer: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerTransferFee: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerTransferFee: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4568,11 +10787,11 @@ func (m *EventMarkerTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4600,11 +10819,11 @@ func (m *EventMarkerTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4632,43 +10851,11 @@ func (m *EventMarkerTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMarker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMarker
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMarker
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ToAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4696,7 +10883,7 @@ func (m *EventMarkerTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			m.Fees = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4719,7 +10906,7 @@ func (m *EventMarkerTransfer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarkerSetDenomMetadata) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerReinstate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4742,15 +10929,15 @@ func (m *EventMarkerSetDenomMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerSetDenomMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerReinstate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerSetDenomMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerReinstate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetadataBase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4778,11 +10965,11 @@ func (m *EventMarkerSetDenomMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetadataBase = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetadataDescription", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4810,11 +10997,11 @@ func (m *EventMarkerSetDenomMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetadataDescription = string(dAtA[iNdEx:postIndex])
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetadataDisplay", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4842,13 +11029,32 @@ func (m *EventMarkerSetDenomMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetadataDisplay = string(dAtA[iNdEx:postIndex])
+			m.Manager = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReinstateHeight", wireType)
+			}
+			m.ReinstateHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReinstateHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetadataDenomUnits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarker
@@ -4858,29 +11064,77 @@ func (m *EventMarkerSetDenomMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMarker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMarker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetadataDenomUnits = append(m.MetadataDenomUnits, &EventDenomUnit{})
-			if err := m.MetadataDenomUnits[len(m.MetadataDenomUnits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 5:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerReinstated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerReinstated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerReinstated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4908,11 +11162,11 @@ func (m *EventMarkerSetDenomMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrator = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetadataName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4940,11 +11194,11 @@ func (m *EventMarkerSetDenomMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetadataName = string(dAtA[iNdEx:postIndex])
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetadataSymbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4972,7 +11226,7 @@ func (m *EventMarkerSetDenomMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetadataSymbol = string(dAtA[iNdEx:postIndex])
+			m.Manager = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4995,7 +11249,7 @@ func (m *EventMarkerSetDenomMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDenomUnit) Unmarshal(dAtA []byte) error {
+func (m *EventMarkerReclaimEscrow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5018,10 +11272,10 @@ func (m *EventDenomUnit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDenomUnit: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarkerReclaimEscrow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDenomUnit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarkerReclaimEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5058,7 +11312,7 @@ func (m *EventDenomUnit) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5086,11 +11340,11 @@ func (m *EventDenomUnit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Exponent = string(dAtA[iNdEx:postIndex])
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Aliases", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5118,7 +11372,39 @@ func (m *EventDenomUnit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Aliases = append(m.Aliases, string(dAtA[iNdEx:postIndex]))
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
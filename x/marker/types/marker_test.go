@@ -200,6 +200,21 @@ func TestNewMarkerValidate(t *testing.T) {
 			NewMarkerAccount(baseAcc, sdk.NewCoin("test", sdk.OneInt()), manager, nil, StatusActive, MarkerType_RestrictedCoin, true, true, false, []string{}),
 			nil,
 		},
+		{
+			"supply above max supply is invalid",
+			withMaxSupply(NewMarkerAccount(baseAcc, sdk.NewCoin("test", sdk.NewInt(11)), manager, nil, StatusProposed, MarkerType_Coin, true, true, false, []string{}), sdk.NewInt(10)),
+			fmt.Errorf("total supply 11 cannot exceed max supply 10"),
+		},
+		{
+			"supply at max supply is ok",
+			withMaxSupply(NewMarkerAccount(baseAcc, sdk.NewCoin("test", sdk.NewInt(10)), manager, nil, StatusProposed, MarkerType_Coin, true, true, false, []string{}), sdk.NewInt(10)),
+			nil,
+		},
+		{
+			"negative max supply is invalid",
+			withMaxSupply(NewMarkerAccount(baseAcc, sdk.NewCoin("test", sdk.NewInt(10)), manager, nil, StatusProposed, MarkerType_Coin, true, true, false, []string{}), sdk.NewInt(-1)),
+			fmt.Errorf("max supply must be greater than or equal to zero"),
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -216,6 +231,11 @@ func TestNewMarkerValidate(t *testing.T) {
 	}
 }
 
+func withMaxSupply(ma *MarkerAccount, maxSupply sdk.Int) *MarkerAccount {
+	ma.MaxSupply = maxSupply
+	return ma
+}
+
 func TestNewMarkerMsgEncoding(t *testing.T) {
 	base := authtypes.NewBaseAccountWithAddress(MustGetMarkerAddress("testcoin"))
 	newMsgMarker := NewMsgAddMarkerRequest("testcoin", sdk.OneInt(), base.GetAddress(), base.GetAddress(), MarkerType_Coin, false, false, false, []string{})
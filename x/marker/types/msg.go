@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 
@@ -38,6 +39,23 @@ var allRequestMsgs = []sdk.Msg{
 	(*MsgUpdateForcedTransferRequest)(nil),
 	(*MsgSetAccountDataRequest)(nil),
 	(*MsgUpdateSendDenyListRequest)(nil),
+	(*MsgSetTransferLimitRequest)(nil),
+	(*MsgSupplyDecreaseProposalRequest)(nil),
+	(*MsgSetAdministratorProposalRequest)(nil),
+	(*MsgRemoveAdministratorProposalRequest)(nil),
+	(*MsgChangeStatusProposalRequest)(nil),
+	(*MsgWithdrawEscrowProposalRequest)(nil),
+	(*MsgSetDenomMetadataProposalRequest)(nil),
+	(*MsgAddNetAssetValuesRequest)(nil),
+	(*MsgChangeMarkerTypeRequest)(nil),
+	(*MsgSetMaxSupplyRequest)(nil),
+	(*MsgBatchTransferRequest)(nil),
+	(*MsgRecoverAccountRequest)(nil),
+	(*MsgSetIbcPolicyRequest)(nil),
+	(*MsgSetTransferFeeRequest)(nil),
+	(*MsgMultiWithdrawRequest)(nil),
+	(*MsgReinstateMarkerRequest)(nil),
+	(*MsgReclaimEscrowRequest)(nil),
 }
 
 // NewMsgAddMarkerRequest creates a new marker in a proposed state with a given total supply a denomination
@@ -577,6 +595,174 @@ func (msg *MsgSupplyIncreaseProposalRequest) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{addr}
 }
 
+// NewMsgSupplyDecreaseProposalRequest creates a MsgSupplyDecreaseProposalRequest
+func NewMsgSupplyDecreaseProposalRequest(amount sdk.Coin, authority string) *MsgSupplyDecreaseProposalRequest {
+	return &MsgSupplyDecreaseProposalRequest{
+		Amount:    amount,
+		Authority: authority,
+	}
+}
+
+func (msg *MsgSupplyDecreaseProposalRequest) ValidateBasic() error {
+	if err := msg.Amount.Validate(); err != nil {
+		return err
+	}
+	if !msg.Amount.IsPositive() {
+		return fmt.Errorf("amount to decrease must be greater than zero")
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	return err
+}
+
+func (msg *MsgSupplyDecreaseProposalRequest) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgSetAdministratorProposalRequest creates a MsgSetAdministratorProposalRequest
+func NewMsgSetAdministratorProposalRequest(denom string, accessGrants []AccessGrant, authority string) *MsgSetAdministratorProposalRequest {
+	return &MsgSetAdministratorProposalRequest{
+		Denom:     denom,
+		Access:    accessGrants,
+		Authority: authority,
+	}
+}
+
+func (msg *MsgSetAdministratorProposalRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if len(msg.Access) == 0 {
+		return fmt.Errorf("at least one access grant is required")
+	}
+	for _, a := range msg.Access {
+		if err := a.Validate(); err != nil {
+			return fmt.Errorf("invalid access grant for administrator: %w", err)
+		}
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	return err
+}
+
+func (msg *MsgSetAdministratorProposalRequest) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgRemoveAdministratorProposalRequest creates a MsgRemoveAdministratorProposalRequest
+func NewMsgRemoveAdministratorProposalRequest(denom string, administrators []string, authority string) *MsgRemoveAdministratorProposalRequest {
+	return &MsgRemoveAdministratorProposalRequest{
+		Denom:          denom,
+		RemovedAddress: administrators,
+		Authority:      authority,
+	}
+}
+
+func (msg *MsgRemoveAdministratorProposalRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if len(msg.RemovedAddress) == 0 {
+		return fmt.Errorf("at least one administrator address is required")
+	}
+	for _, ra := range msg.RemovedAddress {
+		if _, err := sdk.AccAddressFromBech32(ra); err != nil {
+			return fmt.Errorf("administrator account address is invalid: %w", err)
+		}
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	return err
+}
+
+func (msg *MsgRemoveAdministratorProposalRequest) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgChangeStatusProposalRequest creates a MsgChangeStatusProposalRequest
+func NewMsgChangeStatusProposalRequest(denom string, status MarkerStatus, authority string) *MsgChangeStatusProposalRequest {
+	return &MsgChangeStatusProposalRequest{
+		Denom:     denom,
+		NewStatus: status,
+		Authority: authority,
+	}
+}
+
+func (msg *MsgChangeStatusProposalRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if !ValidMarkerStatus(msg.NewStatus) {
+		return fmt.Errorf("invalid marker status: %s", msg.NewStatus)
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	return err
+}
+
+func (msg *MsgChangeStatusProposalRequest) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgWithdrawEscrowProposalRequest creates a MsgWithdrawEscrowProposalRequest
+func NewMsgWithdrawEscrowProposalRequest(denom string, amount sdk.Coins, targetAddress string, authority string) *MsgWithdrawEscrowProposalRequest {
+	return &MsgWithdrawEscrowProposalRequest{
+		Denom:         denom,
+		Amount:        amount,
+		TargetAddress: targetAddress,
+		Authority:     authority,
+	}
+}
+
+func (msg *MsgWithdrawEscrowProposalRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if err := msg.Amount.Validate(); err != nil {
+		return err
+	}
+	if msg.Amount.IsZero() {
+		return fmt.Errorf("amount to withdraw cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.TargetAddress); err != nil {
+		return fmt.Errorf("invalid target address: %w", err)
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	return err
+}
+
+func (msg *MsgWithdrawEscrowProposalRequest) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgSetDenomMetadataProposalRequest creates a MsgSetDenomMetadataProposalRequest
+func NewMsgSetDenomMetadataProposalRequest(metadata banktypes.Metadata, authority string) *MsgSetDenomMetadataProposalRequest {
+	return &MsgSetDenomMetadataProposalRequest{
+		Metadata:  metadata,
+		Authority: authority,
+	}
+}
+
+func (msg *MsgSetDenomMetadataProposalRequest) ValidateBasic() error {
+	if err := msg.Metadata.Validate(); err != nil {
+		return fmt.Errorf("invalid metadata: %w", err)
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	return err
+}
+
+func (msg *MsgSetDenomMetadataProposalRequest) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
 // NewMsgUpdateRequiredAttributesRequest creates a MsgUpdateRequiredAttributesRequest
 func NewMsgUpdateRequiredAttributesRequest(denom string, transferAuthority sdk.AccAddress, removeRequiredAttributes, addRequiredAttributes []string) *MsgUpdateRequiredAttributesRequest {
 	return &MsgUpdateRequiredAttributesRequest{
@@ -693,3 +879,408 @@ func (msg *MsgUpdateSendDenyListRequest) GetSigners() []sdk.AccAddress {
 	addr := sdk.MustAccAddressFromBech32(msg.Authority)
 	return []sdk.AccAddress{addr}
 }
+
+// NewMsgSetTransferLimitRequest creates a new MsgSetTransferLimitRequest
+func NewMsgSetTransferLimitRequest(denom string, window time.Duration, markerLimit, holderLimit sdkmath.Int, authority sdk.AccAddress) *MsgSetTransferLimitRequest {
+	return &MsgSetTransferLimitRequest{
+		Denom:       denom,
+		Window:      window,
+		MarkerLimit: markerLimit,
+		HolderLimit: holderLimit,
+		Authority:   authority.String(),
+	}
+}
+
+func (msg MsgSetTransferLimitRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if err := ValidateTransferLimitValues(msg.Window, msg.MarkerLimit, msg.HolderLimit); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return fmt.Errorf("invalid authority: %w", err)
+	}
+	return nil
+}
+
+func (msg MsgSetTransferLimitRequest) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgAddNetAssetValuesRequest creates a new MsgAddNetAssetValuesRequest
+func NewMsgAddNetAssetValuesRequest(denom string, administrator string, netAssetValues []NetAssetValue) *MsgAddNetAssetValuesRequest {
+	return &MsgAddNetAssetValuesRequest{
+		Denom:          denom,
+		NetAssetValues: netAssetValues,
+		Administrator:  administrator,
+	}
+}
+
+func (msg MsgAddNetAssetValuesRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if len(msg.NetAssetValues) == 0 {
+		return fmt.Errorf("net asset value list cannot be empty")
+	}
+	seen := make(map[string]bool, len(msg.NetAssetValues))
+	for _, nav := range msg.NetAssetValues {
+		if err := nav.Validate(); err != nil {
+			return err
+		}
+		if nav.Price.Denom == msg.Denom {
+			return fmt.Errorf("net asset value price denom cannot match marker denom %q", msg.Denom)
+		}
+		if seen[nav.Price.Denom] {
+			return fmt.Errorf("net asset value list contains duplicate price denom %q", nav.Price.Denom)
+		}
+		seen[nav.Price.Denom] = true
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return fmt.Errorf("invalid administrator address: %w", err)
+	}
+	return nil
+}
+
+func (msg MsgAddNetAssetValuesRequest) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Administrator)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgChangeMarkerTypeRequest creates a new MsgChangeMarkerTypeRequest
+func NewMsgChangeMarkerTypeRequest(denom string, markerType MarkerType, administrators ...string) *MsgChangeMarkerTypeRequest {
+	return &MsgChangeMarkerTypeRequest{
+		Denom:          denom,
+		MarkerType:     markerType,
+		Administrators: administrators,
+	}
+}
+
+func (msg MsgChangeMarkerTypeRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if msg.MarkerType != MarkerType_Coin && msg.MarkerType != MarkerType_RestrictedCoin {
+		return fmt.Errorf("invalid marker type: %s", msg.MarkerType)
+	}
+	return validateAdministrators(msg.Administrators)
+}
+
+func (msg MsgChangeMarkerTypeRequest) GetSigners() []sdk.AccAddress {
+	return administratorSigners(msg.Administrators)
+}
+
+// NewMsgSetMaxSupplyRequest creates a new MsgSetMaxSupplyRequest
+func NewMsgSetMaxSupplyRequest(denom string, maxSupply sdkmath.Int, administrators ...string) *MsgSetMaxSupplyRequest {
+	return &MsgSetMaxSupplyRequest{
+		Denom:          denom,
+		MaxSupply:      maxSupply,
+		Administrators: administrators,
+	}
+}
+
+func (msg MsgSetMaxSupplyRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if msg.MaxSupply.IsNil() || msg.MaxSupply.IsNegative() {
+		return fmt.Errorf("invalid max supply %q: cannot be negative", msg.MaxSupply)
+	}
+	return validateAdministrators(msg.Administrators)
+}
+
+func (msg MsgSetMaxSupplyRequest) GetSigners() []sdk.AccAddress {
+	return administratorSigners(msg.Administrators)
+}
+
+// validateAdministrators checks that there is at least one administrator and that each is a unique, valid address.
+func validateAdministrators(administrators []string) error {
+	if len(administrators) == 0 {
+		return fmt.Errorf("at least one administrator is required")
+	}
+	seen := make(map[string]bool, len(administrators))
+	for _, admin := range administrators {
+		if _, err := sdk.AccAddressFromBech32(admin); err != nil {
+			return fmt.Errorf("invalid administrator %q: %w", admin, err)
+		}
+		if seen[admin] {
+			return fmt.Errorf("duplicate administrator %s", admin)
+		}
+		seen[admin] = true
+	}
+	return nil
+}
+
+// administratorSigners converts the administrators of a multi-signer msg into signer addresses.
+func administratorSigners(administrators []string) []sdk.AccAddress {
+	signers := make([]sdk.AccAddress, len(administrators))
+	for i, admin := range administrators {
+		signers[i] = sdk.MustAccAddressFromBech32(admin)
+	}
+	return signers
+}
+
+// NewMsgBatchTransferRequest creates a new MsgBatchTransferRequest
+func NewMsgBatchTransferRequest(denom string, administrator string, transfers ...BatchTransferEntry) *MsgBatchTransferRequest {
+	return &MsgBatchTransferRequest{
+		Denom:         denom,
+		Transfers:     transfers,
+		Administrator: administrator,
+	}
+}
+
+// NewBatchTransferEntry creates a new BatchTransferEntry
+func NewBatchTransferEntry(from, to string, amount sdkmath.Int) BatchTransferEntry {
+	return BatchTransferEntry{
+		FromAddress: from,
+		ToAddress:   to,
+		Amount:      amount,
+	}
+}
+
+func (msg MsgBatchTransferRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if len(msg.Transfers) == 0 {
+		return fmt.Errorf("transfer list cannot be empty")
+	}
+	for i, transfer := range msg.Transfers {
+		if err := transfer.Validate(); err != nil {
+			return fmt.Errorf("invalid transfer %d: %w", i, err)
+		}
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return fmt.Errorf("invalid administrator address: %w", err)
+	}
+	return nil
+}
+
+func (msg MsgBatchTransferRequest) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Administrator)
+	return []sdk.AccAddress{addr}
+}
+
+// Validate returns an error if the addresses or amount of the transfer are invalid.
+func (e BatchTransferEntry) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(e.FromAddress); err != nil {
+		return fmt.Errorf("invalid from address: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(e.ToAddress); err != nil {
+		return fmt.Errorf("invalid to address: %w", err)
+	}
+	if e.FromAddress == e.ToAddress {
+		return fmt.Errorf("from and to addresses cannot be the same: %s", e.FromAddress)
+	}
+	if e.Amount.IsNil() || !e.Amount.IsPositive() {
+		return fmt.Errorf("amount %q must be positive", e.Amount)
+	}
+	return nil
+}
+
+// NewMsgRecoverAccountRequest creates a new MsgRecoverAccountRequest
+func NewMsgRecoverAccountRequest(denom, compromised, replacement, administrator string) *MsgRecoverAccountRequest {
+	return &MsgRecoverAccountRequest{
+		Denom:              denom,
+		CompromisedAddress: compromised,
+		ReplacementAddress: replacement,
+		Administrator:      administrator,
+	}
+}
+
+func (msg MsgRecoverAccountRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.CompromisedAddress); err != nil {
+		return fmt.Errorf("invalid compromised address: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.ReplacementAddress); err != nil {
+		return fmt.Errorf("invalid replacement address: %w", err)
+	}
+	if msg.CompromisedAddress == msg.ReplacementAddress {
+		return fmt.Errorf("compromised and replacement addresses cannot be the same: %s", msg.CompromisedAddress)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return fmt.Errorf("invalid administrator address: %w", err)
+	}
+	return nil
+}
+
+func (msg MsgRecoverAccountRequest) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Administrator)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgSetIbcPolicyRequest creates a new MsgSetIbcPolicyRequest
+func NewMsgSetIbcPolicyRequest(denom string, allowedChannels, allowedDestinations []string, authority string) *MsgSetIbcPolicyRequest {
+	return &MsgSetIbcPolicyRequest{
+		Denom:               denom,
+		AllowedChannels:     allowedChannels,
+		AllowedDestinations: allowedDestinations,
+		Authority:           authority,
+	}
+}
+
+func (msg MsgSetIbcPolicyRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if err := ValidateIbcPolicyLists(msg.AllowedChannels, msg.AllowedDestinations); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return fmt.Errorf("invalid authority: %w", err)
+	}
+	return nil
+}
+
+func (msg MsgSetIbcPolicyRequest) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgSetTransferFeeRequest creates a new MsgSetTransferFeeRequest
+func NewMsgSetTransferFeeRequest(denom, recipient string, basisPoints uint32, flatFees sdk.Coins, authority string) *MsgSetTransferFeeRequest {
+	return &MsgSetTransferFeeRequest{
+		Denom:       denom,
+		Recipient:   recipient,
+		BasisPoints: basisPoints,
+		FlatFees:    flatFees,
+		Authority:   authority,
+	}
+}
+
+func (msg MsgSetTransferFeeRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if err := ValidateTransferFeeValues(msg.Recipient, msg.BasisPoints, msg.FlatFees); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return fmt.Errorf("invalid authority: %w", err)
+	}
+	return nil
+}
+
+func (msg MsgSetTransferFeeRequest) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgMultiWithdrawRequest creates a new MsgMultiWithdrawRequest
+func NewMsgMultiWithdrawRequest(denom string, administrator string, recipients ...WithdrawRecipient) *MsgMultiWithdrawRequest {
+	return &MsgMultiWithdrawRequest{
+		Denom:         denom,
+		Recipients:    recipients,
+		Administrator: administrator,
+	}
+}
+
+// NewWithdrawRecipient creates a new WithdrawRecipient
+func NewWithdrawRecipient(to string, amount sdk.Coins) WithdrawRecipient {
+	return WithdrawRecipient{
+		ToAddress: to,
+		Amount:    amount,
+	}
+}
+
+func (msg MsgMultiWithdrawRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if len(msg.Recipients) == 0 {
+		return fmt.Errorf("recipient list cannot be empty")
+	}
+	for i, recipient := range msg.Recipients {
+		if err := recipient.Validate(); err != nil {
+			return fmt.Errorf("invalid recipient %d: %w", i, err)
+		}
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return fmt.Errorf("invalid administrator address: %w", err)
+	}
+	return nil
+}
+
+func (msg MsgMultiWithdrawRequest) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Administrator)
+	return []sdk.AccAddress{addr}
+}
+
+// Validate returns an error if the address or amount of the recipient are invalid.
+func (r WithdrawRecipient) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.ToAddress); err != nil {
+		return fmt.Errorf("invalid to address: %w", err)
+	}
+	if err := r.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid amount %q: %w", r.Amount, err)
+	}
+	if r.Amount.IsZero() {
+		return fmt.Errorf("amount cannot be empty")
+	}
+	return nil
+}
+
+// NewMsgReinstateMarkerRequest creates a new MsgReinstateMarkerRequest
+func NewMsgReinstateMarkerRequest(denom string, status MarkerStatus, manager string, authority string) *MsgReinstateMarkerRequest {
+	return &MsgReinstateMarkerRequest{
+		Denom:     denom,
+		Status:    status,
+		Manager:   manager,
+		Authority: authority,
+	}
+}
+
+func (msg MsgReinstateMarkerRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if err := ValidateReinstateStatus(msg.Status); err != nil {
+		return err
+	}
+	if len(msg.Manager) > 0 {
+		if _, err := sdk.AccAddressFromBech32(msg.Manager); err != nil {
+			return fmt.Errorf("invalid manager: %w", err)
+		}
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return fmt.Errorf("invalid authority: %w", err)
+	}
+	return nil
+}
+
+func (msg MsgReinstateMarkerRequest) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgReclaimEscrowRequest creates a new MsgReclaimEscrowRequest
+func NewMsgReclaimEscrowRequest(denom string, toAddress string, authority string) *MsgReclaimEscrowRequest {
+	return &MsgReclaimEscrowRequest{
+		Denom:     denom,
+		ToAddress: toAddress,
+		Authority: authority,
+	}
+}
+
+func (msg MsgReclaimEscrowRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.ToAddress); err != nil {
+		return fmt.Errorf("invalid to address: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return fmt.Errorf("invalid authority: %w", err)
+	}
+	return nil
+}
+
+func (msg MsgReclaimEscrowRequest) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 
 	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
@@ -780,3 +781,790 @@ func TestMsgUpdateSendDenyListRequestGetSigners(t *testing.T) {
 		require.PanicsWithError(t, "decoding bech32 failed: invalid separator index -1", testFunc, "GetSigners")
 	})
 }
+
+func TestMsgSetTransferLimitRequestValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress("authority___________")
+	denom := "somedenom"
+	day := 24 * time.Hour
+
+	tests := []struct {
+		name string
+		msg  *MsgSetTransferLimitRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  NewMsgSetTransferLimitRequest(denom, day, math.NewInt(100), math.NewInt(10), authority),
+			exp:  "",
+		},
+		{
+			name: "only marker limit",
+			msg:  NewMsgSetTransferLimitRequest(denom, day, math.NewInt(100), math.ZeroInt(), authority),
+			exp:  "",
+		},
+		{
+			name: "only holder limit",
+			msg:  NewMsgSetTransferLimitRequest(denom, day, math.ZeroInt(), math.NewInt(10), authority),
+			exp:  "",
+		},
+		{
+			name: "remove limits without window",
+			msg:  NewMsgSetTransferLimitRequest(denom, 0, math.ZeroInt(), math.ZeroInt(), authority),
+			exp:  "",
+		},
+		{
+			name: "invalid denom",
+			msg:  NewMsgSetTransferLimitRequest("1denomcannotstartwithdigit", day, math.NewInt(100), math.NewInt(10), authority),
+			exp:  "invalid denom: 1denomcannotstartwithdigit",
+		},
+		{
+			name: "negative marker limit",
+			msg:  NewMsgSetTransferLimitRequest(denom, day, math.NewInt(-1), math.NewInt(10), authority),
+			exp:  `invalid marker limit "-1": cannot be negative`,
+		},
+		{
+			name: "negative holder limit",
+			msg:  NewMsgSetTransferLimitRequest(denom, day, math.NewInt(100), math.NewInt(-1), authority),
+			exp:  `invalid holder limit "-1": cannot be negative`,
+		},
+		{
+			name: "nil holder limit",
+			msg:  &MsgSetTransferLimitRequest{Denom: denom, Window: day, MarkerLimit: math.NewInt(100), Authority: authority.String()},
+			exp:  `invalid holder limit "<nil>": cannot be negative`,
+		},
+		{
+			name: "zero window",
+			msg:  NewMsgSetTransferLimitRequest(denom, 0, math.NewInt(100), math.NewInt(10), authority),
+			exp:  "invalid window 0s: must be positive",
+		},
+		{
+			name: "negative window",
+			msg:  NewMsgSetTransferLimitRequest(denom, -time.Hour, math.NewInt(100), math.NewInt(10), authority),
+			exp:  "invalid window -1h0m0s: must be positive",
+		},
+		{
+			name: "holder limit greater than marker limit",
+			msg:  NewMsgSetTransferLimitRequest(denom, day, math.NewInt(10), math.NewInt(100), authority),
+			exp:  "holder limit 100 cannot be greater than marker limit 10",
+		},
+		{
+			name: "invalid authority",
+			msg:  &MsgSetTransferLimitRequest{Denom: denom, Window: day, MarkerLimit: math.NewInt(100), HolderLimit: math.NewInt(10), Authority: "x"},
+			exp:  "invalid authority: decoding bech32 failed: invalid bech32 string length 1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				assert.EqualError(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				assert.NoError(t, err, tc.exp, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgSetTransferLimitRequestGetSigners(t *testing.T) {
+	t.Run("good authority", func(t *testing.T) {
+		msg := MsgSetTransferLimitRequest{
+			Authority: sdk.AccAddress("good_address________").String(),
+		}
+		exp := []sdk.AccAddress{sdk.AccAddress("good_address________")}
+
+		var signers []sdk.AccAddress
+		testFunc := func() {
+			signers = msg.GetSigners()
+		}
+		require.NotPanics(t, testFunc, "GetSigners")
+		assert.Equal(t, exp, signers, "GetSigners")
+	})
+
+	t.Run("bad authority", func(t *testing.T) {
+		msg := MsgSetTransferLimitRequest{
+			Authority: "bad_address________",
+		}
+
+		testFunc := func() {
+			_ = msg.GetSigners()
+		}
+		require.PanicsWithError(t, "decoding bech32 failed: invalid separator index -1", testFunc, "GetSigners")
+	})
+}
+
+func TestMsgAddNetAssetValuesRequestValidateBasic(t *testing.T) {
+	admin := sdk.AccAddress("admin_______________").String()
+	denom := "somedenom"
+	usdNav := NewNetAssetValue(sdk.NewInt64Coin("usd", 100), 1, "source")
+	hashNav := NewNetAssetValue(sdk.NewInt64Coin("nhash", 1000), 10, "")
+
+	tests := []struct {
+		name string
+		msg  *MsgAddNetAssetValuesRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  NewMsgAddNetAssetValuesRequest(denom, admin, []NetAssetValue{usdNav, hashNav}),
+			exp:  "",
+		},
+		{
+			name: "invalid denom",
+			msg:  NewMsgAddNetAssetValuesRequest("1denomcannotstartwithdigit", admin, []NetAssetValue{usdNav}),
+			exp:  "invalid denom: 1denomcannotstartwithdigit",
+		},
+		{
+			name: "no net asset values",
+			msg:  NewMsgAddNetAssetValuesRequest(denom, admin, nil),
+			exp:  "net asset value list cannot be empty",
+		},
+		{
+			name: "zero volume",
+			msg:  NewMsgAddNetAssetValuesRequest(denom, admin, []NetAssetValue{NewNetAssetValue(sdk.NewInt64Coin("usd", 100), 0, "")}),
+			exp:  "net asset value volume cannot be zero",
+		},
+		{
+			name: "invalid price",
+			msg:  NewMsgAddNetAssetValuesRequest(denom, admin, []NetAssetValue{{Price: sdk.Coin{Denom: "usd", Amount: math.NewInt(-1)}, Volume: 1}}),
+			exp:  "invalid net asset value price: negative coin amount: -1",
+		},
+		{
+			name: "price in marker denom",
+			msg:  NewMsgAddNetAssetValuesRequest(denom, admin, []NetAssetValue{NewNetAssetValue(sdk.NewInt64Coin(denom, 100), 1, "")}),
+			exp:  `net asset value price denom cannot match marker denom "somedenom"`,
+		},
+		{
+			name: "duplicate price denom",
+			msg:  NewMsgAddNetAssetValuesRequest(denom, admin, []NetAssetValue{usdNav, hashNav, usdNav}),
+			exp:  `net asset value list contains duplicate price denom "usd"`,
+		},
+		{
+			name: "invalid administrator",
+			msg:  NewMsgAddNetAssetValuesRequest(denom, "x", []NetAssetValue{usdNav}),
+			exp:  "invalid administrator address: decoding bech32 failed: invalid bech32 string length 1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				assert.EqualError(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				assert.NoError(t, err, tc.exp, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgChangeMarkerTypeRequestValidateBasic(t *testing.T) {
+	admin1 := sdk.AccAddress("admin1______________").String()
+	admin2 := sdk.AccAddress("admin2______________").String()
+
+	tests := []struct {
+		name string
+		msg  *MsgChangeMarkerTypeRequest
+		exp  string
+	}{
+		{
+			name: "to coin",
+			msg:  NewMsgChangeMarkerTypeRequest("somedenom", MarkerType_Coin, admin1),
+			exp:  "",
+		},
+		{
+			name: "to restricted",
+			msg:  NewMsgChangeMarkerTypeRequest("somedenom", MarkerType_RestrictedCoin, admin1, admin2),
+			exp:  "",
+		},
+		{
+			name: "invalid denom",
+			msg:  NewMsgChangeMarkerTypeRequest("1denomcannotstartwithdigit", MarkerType_Coin, admin1),
+			exp:  "invalid denom: 1denomcannotstartwithdigit",
+		},
+		{
+			name: "unknown type",
+			msg:  NewMsgChangeMarkerTypeRequest("somedenom", MarkerType_Unknown, admin1),
+			exp:  "invalid marker type: MARKER_TYPE_UNSPECIFIED",
+		},
+		{
+			name: "no administrators",
+			msg:  NewMsgChangeMarkerTypeRequest("somedenom", MarkerType_Coin),
+			exp:  "at least one administrator is required",
+		},
+		{
+			name: "invalid administrator",
+			msg:  NewMsgChangeMarkerTypeRequest("somedenom", MarkerType_Coin, admin1, "x"),
+			exp:  `invalid administrator "x": decoding bech32 failed: invalid bech32 string length 1`,
+		},
+		{
+			name: "duplicate administrator",
+			msg:  NewMsgChangeMarkerTypeRequest("somedenom", MarkerType_Coin, admin1, admin2, admin1),
+			exp:  "duplicate administrator " + admin1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				assert.EqualError(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				assert.NoError(t, err, tc.exp, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgSetMaxSupplyRequestValidateBasic(t *testing.T) {
+	admin1 := sdk.AccAddress("admin1______________").String()
+	admin2 := sdk.AccAddress("admin2______________").String()
+
+	tests := []struct {
+		name string
+		msg  *MsgSetMaxSupplyRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  NewMsgSetMaxSupplyRequest("somedenom", math.NewInt(100), admin1, admin2),
+			exp:  "",
+		},
+		{
+			name: "zero max supply",
+			msg:  NewMsgSetMaxSupplyRequest("somedenom", math.ZeroInt(), admin1),
+			exp:  "",
+		},
+		{
+			name: "invalid denom",
+			msg:  NewMsgSetMaxSupplyRequest("1denomcannotstartwithdigit", math.NewInt(100), admin1),
+			exp:  "invalid denom: 1denomcannotstartwithdigit",
+		},
+		{
+			name: "negative max supply",
+			msg:  NewMsgSetMaxSupplyRequest("somedenom", math.NewInt(-1), admin1),
+			exp:  `invalid max supply "-1": cannot be negative`,
+		},
+		{
+			name: "no administrators",
+			msg:  NewMsgSetMaxSupplyRequest("somedenom", math.NewInt(100)),
+			exp:  "at least one administrator is required",
+		},
+		{
+			name: "invalid administrator",
+			msg:  NewMsgSetMaxSupplyRequest("somedenom", math.NewInt(100), admin1, "x"),
+			exp:  `invalid administrator "x": decoding bech32 failed: invalid bech32 string length 1`,
+		},
+		{
+			name: "duplicate administrator",
+			msg:  NewMsgSetMaxSupplyRequest("somedenom", math.NewInt(100), admin1, admin2, admin1),
+			exp:  "duplicate administrator " + admin1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				assert.EqualError(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				assert.NoError(t, err, tc.exp, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestGovProposalMsgsValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress("input111111111111111").String()
+	target := sdk.AccAddress("input22222222222").String()
+	metadata := banktypes.Metadata{
+		Description: "a description",
+		DenomUnits:  []*banktypes.DenomUnit{{Denom: "hotdog", Exponent: 0}},
+		Base:        "hotdog",
+		Display:     "hotdog",
+		Name:        "Hotdog",
+		Symbol:      "HOTDOG",
+	}
+
+	tests := []struct {
+		name string
+		msg  sdk.Msg
+		exp  string
+	}{
+		{
+			name: "supply decrease valid",
+			msg:  NewMsgSupplyDecreaseProposalRequest(sdk.NewInt64Coin("hotdog", 100), authority),
+		},
+		{
+			name: "supply decrease zero amount",
+			msg:  NewMsgSupplyDecreaseProposalRequest(sdk.NewInt64Coin("hotdog", 0), authority),
+			exp:  "amount to decrease must be greater than zero",
+		},
+		{
+			name: "supply decrease invalid authority",
+			msg:  NewMsgSupplyDecreaseProposalRequest(sdk.NewInt64Coin("hotdog", 100), ""),
+			exp:  "empty address string is not allowed",
+		},
+		{
+			name: "set administrator valid",
+			msg:  NewMsgSetAdministratorProposalRequest("hotdog", []AccessGrant{{Address: target, Permissions: AccessList{Access_Mint}}}, authority),
+		},
+		{
+			name: "set administrator no grants",
+			msg:  NewMsgSetAdministratorProposalRequest("hotdog", nil, authority),
+			exp:  "at least one access grant is required",
+		},
+		{
+			name: "set administrator invalid denom",
+			msg:  NewMsgSetAdministratorProposalRequest("", []AccessGrant{{Address: target, Permissions: AccessList{Access_Mint}}}, authority),
+			exp:  "invalid denom: ",
+		},
+		{
+			name: "remove administrator valid",
+			msg:  NewMsgRemoveAdministratorProposalRequest("hotdog", []string{target}, authority),
+		},
+		{
+			name: "remove administrator bad address",
+			msg:  NewMsgRemoveAdministratorProposalRequest("hotdog", []string{"bad"}, authority),
+			exp:  "administrator account address is invalid: decoding bech32 failed: invalid bech32 string length 3",
+		},
+		{
+			name: "remove administrator no addresses",
+			msg:  NewMsgRemoveAdministratorProposalRequest("hotdog", nil, authority),
+			exp:  "at least one administrator address is required",
+		},
+		{
+			name: "change status valid",
+			msg:  NewMsgChangeStatusProposalRequest("hotdog", StatusActive, authority),
+		},
+		{
+			name: "change status undefined",
+			msg:  NewMsgChangeStatusProposalRequest("hotdog", StatusUndefined, authority),
+			exp:  "invalid marker status: undefined",
+		},
+		{
+			name: "withdraw escrow valid",
+			msg:  NewMsgWithdrawEscrowProposalRequest("hotdog", sdk.NewCoins(sdk.NewInt64Coin("hotdog", 10)), target, authority),
+		},
+		{
+			name: "withdraw escrow no amount",
+			msg:  NewMsgWithdrawEscrowProposalRequest("hotdog", sdk.Coins{}, target, authority),
+			exp:  "amount to withdraw cannot be empty",
+		},
+		{
+			name: "withdraw escrow invalid target",
+			msg:  NewMsgWithdrawEscrowProposalRequest("hotdog", sdk.NewCoins(sdk.NewInt64Coin("hotdog", 10)), "", authority),
+			exp:  "invalid target address: empty address string is not allowed",
+		},
+		{
+			name: "set denom metadata valid",
+			msg:  NewMsgSetDenomMetadataProposalRequest(metadata, authority),
+		},
+		{
+			name: "set denom metadata invalid metadata",
+			msg:  NewMsgSetDenomMetadataProposalRequest(banktypes.Metadata{}, authority),
+			exp:  "invalid metadata: name field cannot be blank",
+		},
+		{
+			name: "set denom metadata invalid authority",
+			msg:  NewMsgSetDenomMetadataProposalRequest(metadata, "bad"),
+			exp:  "decoding bech32 failed: invalid bech32 string length 3",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				assert.EqualError(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				assert.NoError(t, err, "ValidateBasic error")
+			}
+			if len(tc.exp) == 0 {
+				assert.Equal(t, []sdk.AccAddress{sdk.MustAccAddressFromBech32(authority)}, tc.msg.GetSigners(), "GetSigners")
+			}
+		})
+	}
+}
+
+func TestMsgBatchTransferRequestValidateBasic(t *testing.T) {
+	admin := sdk.AccAddress("admin_______________").String()
+	addr1 := sdk.AccAddress("addr1_______________").String()
+	addr2 := sdk.AccAddress("addr2_______________").String()
+
+	tests := []struct {
+		name string
+		msg  *MsgBatchTransferRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg: NewMsgBatchTransferRequest("somedenom", admin,
+				NewBatchTransferEntry(addr1, addr2, math.NewInt(10)), NewBatchTransferEntry(addr2, admin, math.NewInt(5))),
+			exp: "",
+		},
+		{
+			name: "invalid denom",
+			msg:  NewMsgBatchTransferRequest("1denomcannotstartwithdigit", admin, NewBatchTransferEntry(addr1, addr2, math.NewInt(10))),
+			exp:  "invalid denom: 1denomcannotstartwithdigit",
+		},
+		{
+			name: "no transfers",
+			msg:  NewMsgBatchTransferRequest("somedenom", admin),
+			exp:  "transfer list cannot be empty",
+		},
+		{
+			name: "invalid from address",
+			msg:  NewMsgBatchTransferRequest("somedenom", admin, NewBatchTransferEntry("x", addr2, math.NewInt(10))),
+			exp:  "invalid transfer 0: invalid from address: decoding bech32 failed: invalid bech32 string length 1",
+		},
+		{
+			name: "invalid to address",
+			msg: NewMsgBatchTransferRequest("somedenom", admin,
+				NewBatchTransferEntry(addr1, addr2, math.NewInt(10)), NewBatchTransferEntry(addr1, "", math.NewInt(10))),
+			exp: "invalid transfer 1: invalid to address: empty address string is not allowed",
+		},
+		{
+			name: "same from and to",
+			msg:  NewMsgBatchTransferRequest("somedenom", admin, NewBatchTransferEntry(addr1, addr1, math.NewInt(10))),
+			exp:  "invalid transfer 0: from and to addresses cannot be the same: " + addr1,
+		},
+		{
+			name: "zero amount",
+			msg:  NewMsgBatchTransferRequest("somedenom", admin, NewBatchTransferEntry(addr1, addr2, math.ZeroInt())),
+			exp:  `invalid transfer 0: amount "0" must be positive`,
+		},
+		{
+			name: "invalid administrator",
+			msg:  NewMsgBatchTransferRequest("somedenom", "", NewBatchTransferEntry(addr1, addr2, math.NewInt(10))),
+			exp:  "invalid administrator address: empty address string is not allowed",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				assert.EqualError(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				assert.NoError(t, err, tc.exp, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgRecoverAccountRequestValidateBasic(t *testing.T) {
+	admin := sdk.AccAddress("admin_______________").String()
+	compromised := sdk.AccAddress("compromised_________").String()
+	replacement := sdk.AccAddress("replacement_________").String()
+
+	tests := []struct {
+		name string
+		msg  *MsgRecoverAccountRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  NewMsgRecoverAccountRequest("somedenom", compromised, replacement, admin),
+			exp:  "",
+		},
+		{
+			name: "invalid denom",
+			msg:  NewMsgRecoverAccountRequest("1denomcannotstartwithdigit", compromised, replacement, admin),
+			exp:  "invalid denom: 1denomcannotstartwithdigit",
+		},
+		{
+			name: "invalid compromised address",
+			msg:  NewMsgRecoverAccountRequest("somedenom", "x", replacement, admin),
+			exp:  "invalid compromised address: decoding bech32 failed: invalid bech32 string length 1",
+		},
+		{
+			name: "invalid replacement address",
+			msg:  NewMsgRecoverAccountRequest("somedenom", compromised, "", admin),
+			exp:  "invalid replacement address: empty address string is not allowed",
+		},
+		{
+			name: "same compromised and replacement",
+			msg:  NewMsgRecoverAccountRequest("somedenom", compromised, compromised, admin),
+			exp:  "compromised and replacement addresses cannot be the same: " + compromised,
+		},
+		{
+			name: "invalid administrator",
+			msg:  NewMsgRecoverAccountRequest("somedenom", compromised, replacement, "x"),
+			exp:  "invalid administrator address: decoding bech32 failed: invalid bech32 string length 1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				assert.EqualError(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				assert.NoError(t, err, tc.exp, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgSetIbcPolicyRequestValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress("authority___________").String()
+
+	tests := []struct {
+		name string
+		msg  *MsgSetIbcPolicyRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  NewMsgSetIbcPolicyRequest("somedenom", []string{"channel-0"}, []string{"cosmos1receiver"}, authority),
+			exp:  "",
+		},
+		{
+			name: "removal",
+			msg:  NewMsgSetIbcPolicyRequest("somedenom", nil, nil, authority),
+			exp:  "",
+		},
+		{
+			name: "invalid denom",
+			msg:  NewMsgSetIbcPolicyRequest("1denomcannotstartwithdigit", []string{"channel-0"}, nil, authority),
+			exp:  "invalid denom: 1denomcannotstartwithdigit",
+		},
+		{
+			name: "duplicate channel",
+			msg:  NewMsgSetIbcPolicyRequest("somedenom", []string{"channel-0", "channel-0"}, nil, authority),
+			exp:  "duplicate allowed channel channel-0",
+		},
+		{
+			name: "invalid authority",
+			msg:  NewMsgSetIbcPolicyRequest("somedenom", []string{"channel-0"}, nil, ""),
+			exp:  "invalid authority: empty address string is not allowed",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				assert.EqualError(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				assert.NoError(t, err, tc.exp, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgSetTransferFeeRequestValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress("authority___________").String()
+	recipient := sdk.AccAddress("recipient___________").String()
+	flatFees := sdk.NewCoins(sdk.NewInt64Coin("nhash", 10))
+
+	tests := []struct {
+		name string
+		msg  *MsgSetTransferFeeRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  NewMsgSetTransferFeeRequest("somedenom", recipient, 25, flatFees, authority),
+			exp:  "",
+		},
+		{
+			name: "removal",
+			msg:  NewMsgSetTransferFeeRequest("somedenom", "", 0, nil, authority),
+			exp:  "",
+		},
+		{
+			name: "invalid denom",
+			msg:  NewMsgSetTransferFeeRequest("1denomcannotstartwithdigit", recipient, 25, nil, authority),
+			exp:  "invalid denom: 1denomcannotstartwithdigit",
+		},
+		{
+			name: "too many basis points",
+			msg:  NewMsgSetTransferFeeRequest("somedenom", recipient, 10_001, nil, authority),
+			exp:  "invalid basis points 10001: cannot be greater than 10000",
+		},
+		{
+			name: "invalid flat fees",
+			msg:  NewMsgSetTransferFeeRequest("somedenom", recipient, 0, sdk.Coins{sdk.Coin{Denom: "nhash", Amount: sdk.ZeroInt()}}, authority),
+			exp:  `invalid flat fees "0nhash": coin 0nhash amount is not positive`,
+		},
+		{
+			name: "invalid recipient",
+			msg:  NewMsgSetTransferFeeRequest("somedenom", "", 25, nil, authority),
+			exp:  "invalid recipient: empty address string is not allowed",
+		},
+		{
+			name: "invalid authority",
+			msg:  NewMsgSetTransferFeeRequest("somedenom", recipient, 25, nil, ""),
+			exp:  "invalid authority: empty address string is not allowed",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				assert.EqualError(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				assert.NoError(t, err, tc.exp, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgMultiWithdrawRequestValidateBasic(t *testing.T) {
+	admin := sdk.AccAddress("admin_______________").String()
+	to1 := sdk.AccAddress("to_1________________").String()
+	to2 := sdk.AccAddress("to_2________________").String()
+	coins := sdk.NewCoins(sdk.NewInt64Coin("somedenom", 10))
+
+	tests := []struct {
+		name string
+		msg  *MsgMultiWithdrawRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  NewMsgMultiWithdrawRequest("somedenom", admin, NewWithdrawRecipient(to1, coins), NewWithdrawRecipient(to2, coins)),
+			exp:  "",
+		},
+		{
+			name: "invalid denom",
+			msg:  NewMsgMultiWithdrawRequest("1denomcannotstartwithdigit", admin, NewWithdrawRecipient(to1, coins)),
+			exp:  "invalid denom: 1denomcannotstartwithdigit",
+		},
+		{
+			name: "no recipients",
+			msg:  NewMsgMultiWithdrawRequest("somedenom", admin),
+			exp:  "recipient list cannot be empty",
+		},
+		{
+			name: "invalid to address",
+			msg:  NewMsgMultiWithdrawRequest("somedenom", admin, NewWithdrawRecipient(to1, coins), NewWithdrawRecipient("", coins)),
+			exp:  "invalid recipient 1: invalid to address: empty address string is not allowed",
+		},
+		{
+			name: "empty amount",
+			msg:  NewMsgMultiWithdrawRequest("somedenom", admin, NewWithdrawRecipient(to1, nil)),
+			exp:  "invalid recipient 0: amount cannot be empty",
+		},
+		{
+			name: "invalid amount",
+			msg:  NewMsgMultiWithdrawRequest("somedenom", admin, NewWithdrawRecipient(to1, sdk.Coins{sdk.Coin{Denom: "somedenom", Amount: sdk.ZeroInt()}})),
+			exp:  `invalid recipient 0: invalid amount "0somedenom": coin 0somedenom amount is not positive`,
+		},
+		{
+			name: "invalid administrator",
+			msg:  NewMsgMultiWithdrawRequest("somedenom", "", NewWithdrawRecipient(to1, coins)),
+			exp:  "invalid administrator address: empty address string is not allowed",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				assert.EqualError(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				assert.NoError(t, err, tc.exp, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgReinstateMarkerRequestValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress("authority___________").String()
+	manager := sdk.AccAddress("manager_____________").String()
+
+	tests := []struct {
+		name string
+		msg  *MsgReinstateMarkerRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  NewMsgReinstateMarkerRequest("somedenom", StatusFinalized, manager, authority),
+			exp:  "",
+		},
+		{
+			name: "no manager",
+			msg:  NewMsgReinstateMarkerRequest("somedenom", StatusProposed, "", authority),
+			exp:  "",
+		},
+		{
+			name: "invalid denom",
+			msg:  NewMsgReinstateMarkerRequest("1denomcannotstartwithdigit", StatusProposed, manager, authority),
+			exp:  "invalid denom: 1denomcannotstartwithdigit",
+		},
+		{
+			name: "invalid status",
+			msg:  NewMsgReinstateMarkerRequest("somedenom", StatusActive, manager, authority),
+			exp:  "invalid reinstate status active: must be proposed or finalized",
+		},
+		{
+			name: "invalid manager",
+			msg:  NewMsgReinstateMarkerRequest("somedenom", StatusProposed, "notanaddress", authority),
+			exp:  "invalid manager: decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name: "invalid authority",
+			msg:  NewMsgReinstateMarkerRequest("somedenom", StatusProposed, manager, ""),
+			exp:  "invalid authority: empty address string is not allowed",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				assert.EqualError(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				assert.NoError(t, err, tc.exp, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgReclaimEscrowRequestValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress("authority___________").String()
+	to := sdk.AccAddress("to__________________").String()
+
+	tests := []struct {
+		name string
+		msg  *MsgReclaimEscrowRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  NewMsgReclaimEscrowRequest("somedenom", to, authority),
+			exp:  "",
+		},
+		{
+			name: "invalid denom",
+			msg:  NewMsgReclaimEscrowRequest("1denomcannotstartwithdigit", to, authority),
+			exp:  "invalid denom: 1denomcannotstartwithdigit",
+		},
+		{
+			name: "invalid to address",
+			msg:  NewMsgReclaimEscrowRequest("somedenom", "", authority),
+			exp:  "invalid to address: empty address string is not allowed",
+		},
+		{
+			name: "invalid authority",
+			msg:  NewMsgReclaimEscrowRequest("somedenom", to, ""),
+			exp:  "invalid authority: empty address string is not allowed",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				assert.EqualError(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				assert.NoError(t, err, tc.exp, "ValidateBasic error")
+			}
+		})
+	}
+}
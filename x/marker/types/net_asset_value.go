@@ -0,0 +1,49 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewNetAssetValue creates a new NetAssetValue. The sequence and block height are assigned when the value is stored.
+func NewNetAssetValue(price sdk.Coin, volume uint64, source string) NetAssetValue {
+	return NetAssetValue{
+		Price:  price,
+		Volume: volume,
+		Source: source,
+	}
+}
+
+// Validate performs basic sanity checks on a NetAssetValue.
+func (n NetAssetValue) Validate() error {
+	if err := n.Price.Validate(); err != nil {
+		return fmt.Errorf("invalid net asset value price: %w", err)
+	}
+	if n.Volume == 0 {
+		return errors.New("net asset value volume cannot be zero")
+	}
+	return nil
+}
+
+// Validate ensures the net asset value history of a marker is valid.
+func (m MarkerNetAssetValues) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return fmt.Errorf("invalid net asset value marker address: %w", err)
+	}
+	seen := make(map[uint64]bool, len(m.NetAssetValues))
+	for _, nav := range m.NetAssetValues {
+		if err := nav.Validate(); err != nil {
+			return err
+		}
+		if nav.Sequence == 0 {
+			return errors.New("net asset value sequence cannot be zero")
+		}
+		if seen[nav.Sequence] {
+			return fmt.Errorf("duplicate net asset value sequence %d for %s", nav.Sequence, m.Address)
+		}
+		seen[nav.Sequence] = true
+	}
+	return nil
+}
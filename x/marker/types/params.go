@@ -16,6 +16,10 @@ const (
 	DefaultMaxTotalSupply = uint64(100000000000)
 	// DefaultUnrestrictedDenomRegex is a regex that denoms created by normal requests must pass.
 	DefaultUnrestrictedDenomRegex = `[a-zA-Z][a-zA-Z0-9\-\.]{2,83}`
+	// DefaultMaxSupplyHistoryEntries is the number of supply history entries retained for each marker.
+	DefaultMaxSupplyHistoryEntries = uint32(1000)
+	// DefaultReinstateDelayBlocks is the number of blocks a cancelled marker waits before it is reinstated.
+	DefaultReinstateDelayBlocks = uint64(100)
 )
 
 var (
@@ -25,6 +29,10 @@ var (
 	ParamStoreKeyMaxTotalSupply = []byte("MaxTotalSupply")
 	// ParamStoreKeyUnrestrictedDenomRegex is the validation regex for validating denoms supplied by users.
 	ParamStoreKeyUnrestrictedDenomRegex = []byte("UnrestrictedDenomRegex")
+	// ParamStoreKeyMaxSupplyHistoryEntries is the number of supply history entries to retain for each marker.
+	ParamStoreKeyMaxSupplyHistoryEntries = []byte("MaxSupplyHistoryEntries")
+	// ParamStoreKeyReinstateDelayBlocks is the number of blocks to wait before a cancelled marker is reinstated.
+	ParamStoreKeyReinstateDelayBlocks = []byte("ReinstateDelayBlocks")
 )
 
 // ParamKeyTable for marker module
@@ -37,11 +45,15 @@ func NewParams(
 	maxTotalSupply uint64,
 	enableGovernance bool,
 	unrestrictedDenomRegex string,
+	maxSupplyHistoryEntries uint32,
+	reinstateDelayBlocks uint64,
 ) Params {
 	return Params{
-		EnableGovernance:       enableGovernance,
-		MaxTotalSupply:         maxTotalSupply,
-		UnrestrictedDenomRegex: unrestrictedDenomRegex,
+		EnableGovernance:        enableGovernance,
+		MaxTotalSupply:          maxTotalSupply,
+		UnrestrictedDenomRegex:  unrestrictedDenomRegex,
+		MaxSupplyHistoryEntries: maxSupplyHistoryEntries,
+		ReinstateDelayBlocks:    reinstateDelayBlocks,
 	}
 }
 
@@ -51,6 +63,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyEnableGovernance, &p.EnableGovernance, validateEnableGovernance),
 		paramtypes.NewParamSetPair(ParamStoreKeyMaxTotalSupply, &p.MaxTotalSupply, validateIntParam),
 		paramtypes.NewParamSetPair(ParamStoreKeyUnrestrictedDenomRegex, &p.UnrestrictedDenomRegex, validateRegexParam),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxSupplyHistoryEntries, &p.MaxSupplyHistoryEntries, validateUint32Param),
+		paramtypes.NewParamSetPair(ParamStoreKeyReinstateDelayBlocks, &p.ReinstateDelayBlocks, validateIntParam),
 	}
 }
 
@@ -60,6 +74,8 @@ func DefaultParams() Params {
 		DefaultMaxTotalSupply,
 		DefaultEnableGovernance,
 		DefaultUnrestrictedDenomRegex,
+		DefaultMaxSupplyHistoryEntries,
+		DefaultReinstateDelayBlocks,
 	)
 }
 
@@ -98,6 +114,12 @@ func (p *Params) Equal(that interface{}) bool {
 	if p.UnrestrictedDenomRegex != that1.UnrestrictedDenomRegex {
 		return false
 	}
+	if p.MaxSupplyHistoryEntries != that1.MaxSupplyHistoryEntries {
+		return false
+	}
+	if p.ReinstateDelayBlocks != that1.ReinstateDelayBlocks {
+		return false
+	}
 	return true
 }
 
@@ -110,6 +132,15 @@ func validateIntParam(i interface{}) error {
 	return nil
 }
 
+func validateUint32Param(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
 func validateEnableGovernance(i interface{}) error {
 	_, ok := i.(bool)
 	if !ok {
@@ -16,11 +16,15 @@ func TestDefaultParams(t *testing.T) {
 	require.Equal(t, DefaultUnrestrictedDenomRegex, p.UnrestrictedDenomRegex)
 	require.Equal(t, DefaultEnableGovernance, p.EnableGovernance)
 	require.Equal(t, uint64(DefaultMaxTotalSupply), p.MaxTotalSupply)
+	require.Equal(t, DefaultMaxSupplyHistoryEntries, p.MaxSupplyHistoryEntries)
+	require.Equal(t, DefaultReinstateDelayBlocks, p.ReinstateDelayBlocks)
 
-	require.True(t, p.Equal(NewParams(DefaultMaxTotalSupply, DefaultEnableGovernance, DefaultUnrestrictedDenomRegex)))
-	require.False(t, p.Equal(NewParams(1000, DefaultEnableGovernance, DefaultUnrestrictedDenomRegex)))
-	require.False(t, p.Equal(NewParams(DefaultMaxTotalSupply, false, DefaultUnrestrictedDenomRegex)))
-	require.False(t, p.Equal(NewParams(DefaultMaxTotalSupply, DefaultEnableGovernance, "a-z")))
+	require.True(t, p.Equal(NewParams(DefaultMaxTotalSupply, DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, DefaultMaxSupplyHistoryEntries, DefaultReinstateDelayBlocks)))
+	require.False(t, p.Equal(NewParams(1000, DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, DefaultMaxSupplyHistoryEntries, DefaultReinstateDelayBlocks)))
+	require.False(t, p.Equal(NewParams(DefaultMaxTotalSupply, false, DefaultUnrestrictedDenomRegex, DefaultMaxSupplyHistoryEntries, DefaultReinstateDelayBlocks)))
+	require.False(t, p.Equal(NewParams(DefaultMaxTotalSupply, DefaultEnableGovernance, "a-z", DefaultMaxSupplyHistoryEntries, DefaultReinstateDelayBlocks)))
+	require.False(t, p.Equal(NewParams(DefaultMaxTotalSupply, DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, 10, DefaultReinstateDelayBlocks)))
+	require.False(t, p.Equal(NewParams(DefaultMaxTotalSupply, DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, DefaultMaxSupplyHistoryEntries, 5)))
 	require.False(t, p.Equal(nil))
 
 	var p2 *Params
@@ -39,13 +43,15 @@ func TestParamString(t *testing.T) {
 	require.Equal(t, `maxtotalsupply: 100000000000
 enablegovernance: true
 unrestricteddenomregex: '[a-zA-Z][a-zA-Z0-9\-\.]{2,83}'
+maxsupplyhistoryentries: 1000
+reinstatedelayblocks: 100
 `, p.String())
 }
 
 func TestParamSetPairs(t *testing.T) {
 	p := DefaultParams()
 	pairs := p.ParamSetPairs()
-	require.Equal(t, 3, len(pairs))
+	require.Equal(t, 5, len(pairs))
 
 	for i := range pairs {
 		switch string(pairs[i].Key) {
@@ -69,6 +75,15 @@ func TestParamSetPairs(t *testing.T) {
 			// If the expression contains the anchors but they are not at the end of the expression that is allowed (however unrealistic)
 			require.NoError(t, pairs[i].ValidatorFn("[a-z].*$."))
 			require.NoError(t, pairs[i].ValidatorFn(".^[a-z].*$."))
+		case string(ParamStoreKeyMaxSupplyHistoryEntries):
+			require.Error(t, pairs[i].ValidatorFn("foo"))
+			require.Error(t, pairs[i].ValidatorFn(uint64(1000)))
+			require.NoError(t, pairs[i].ValidatorFn(uint32(0)))
+			require.NoError(t, pairs[i].ValidatorFn(uint32(1000)))
+		case string(ParamStoreKeyReinstateDelayBlocks):
+			require.Error(t, pairs[i].ValidatorFn("foo"))
+			require.Error(t, pairs[i].ValidatorFn(uint32(10)))
+			require.NoError(t, pairs[i].ValidatorFn(uint64(0)))
 
 		default:
 			require.Fail(t, "unexpected param set pair")
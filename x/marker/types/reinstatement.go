@@ -0,0 +1,42 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewPendingReinstatement creates a new PendingReinstatement for the given denom.
+func NewPendingReinstatement(denom string, status MarkerStatus, manager string, reinstateHeight int64) PendingReinstatement {
+	return PendingReinstatement{
+		Denom:           denom,
+		Status:          status,
+		Manager:         manager,
+		ReinstateHeight: reinstateHeight,
+	}
+}
+
+// Validate performs basic sanity checks on a PendingReinstatement.
+func (r PendingReinstatement) Validate() error {
+	if err := sdk.ValidateDenom(r.Denom); err != nil {
+		return err
+	}
+	if err := ValidateReinstateStatus(r.Status); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Manager); err != nil {
+		return fmt.Errorf("invalid manager: %w", err)
+	}
+	if r.ReinstateHeight < 0 {
+		return fmt.Errorf("invalid reinstate height %d: cannot be negative", r.ReinstateHeight)
+	}
+	return nil
+}
+
+// ValidateReinstateStatus returns an error if a cancelled marker cannot be reinstated to the given status.
+func ValidateReinstateStatus(status MarkerStatus) error {
+	if status != StatusProposed && status != StatusFinalized {
+		return fmt.Errorf("invalid reinstate status %s: must be %s or %s", status, StatusProposed, StatusFinalized)
+	}
+	return nil
+}
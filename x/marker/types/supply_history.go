@@ -0,0 +1,57 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewSupplyHistoryEntry creates a new SupplyHistoryEntry. The sequence is assigned when the entry is stored.
+func NewSupplyHistoryEntry(
+	denom string,
+	changeType SupplyChangeType,
+	amount sdkmath.Int,
+	supply sdkmath.Int,
+	authority string,
+	blockHeight int64,
+	blockTime time.Time,
+) SupplyHistoryEntry {
+	return SupplyHistoryEntry{
+		Denom:       denom,
+		ChangeType:  changeType,
+		Amount:      amount,
+		Supply:      supply,
+		Authority:   authority,
+		BlockHeight: blockHeight,
+		BlockTime:   blockTime,
+	}
+}
+
+// Validate performs basic sanity checks on a SupplyHistoryEntry.
+func (e SupplyHistoryEntry) Validate() error {
+	if err := sdk.ValidateDenom(e.Denom); err != nil {
+		return err
+	}
+	if e.Sequence == 0 {
+		return errors.New("supply history sequence cannot be zero")
+	}
+	if _, ok := SupplyChangeType_name[int32(e.ChangeType)]; !ok || e.ChangeType == SupplyChangeUnspecified {
+		return fmt.Errorf("invalid supply change type: %s", e.ChangeType)
+	}
+	if e.Amount.IsNil() || e.Amount.IsNegative() {
+		return fmt.Errorf("invalid supply history amount: %s", e.Amount)
+	}
+	if e.Supply.IsNil() || e.Supply.IsNegative() {
+		return fmt.Errorf("invalid supply history supply: %s", e.Supply)
+	}
+	if len(e.Authority) > 0 {
+		if _, err := sdk.AccAddressFromBech32(e.Authority); err != nil {
+			return fmt.Errorf("invalid supply history authority: %w", err)
+		}
+	}
+	return nil
+}
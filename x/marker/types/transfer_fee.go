@@ -0,0 +1,69 @@
+package types
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxTransferFeeBasisPoints is the largest portion of a transfer that can be charged as a fee (100%).
+const MaxTransferFeeBasisPoints = 10_000
+
+// NewTransferFee creates a new TransferFee for the given denom.
+func NewTransferFee(denom, recipient string, basisPoints uint32, flatFees sdk.Coins) TransferFee {
+	return TransferFee{
+		Denom:       denom,
+		Recipient:   recipient,
+		BasisPoints: basisPoints,
+		FlatFees:    flatFees,
+	}
+}
+
+// Validate performs basic sanity checks on a TransferFee.
+func (f TransferFee) Validate() error {
+	if err := sdk.ValidateDenom(f.Denom); err != nil {
+		return err
+	}
+	if err := ValidateTransferFeeValues(f.Recipient, f.BasisPoints, f.FlatFees); err != nil {
+		return err
+	}
+	if !f.HasFees() {
+		return fmt.Errorf("transfer fee for %s must have basis points or flat fees", f.Denom)
+	}
+	return nil
+}
+
+// HasFees returns true if either the basis points or flat fees are set.
+func (f TransferFee) HasFees() bool {
+	return f.BasisPoints > 0 || !f.FlatFees.IsZero()
+}
+
+// Calculate returns the fees owed for a transfer of the provided amount of the marker's denom.
+func (f TransferFee) Calculate(amount sdkmath.Int) sdk.Coins {
+	fees := sdk.NewCoins(f.FlatFees...)
+	if f.BasisPoints > 0 && amount.IsPositive() {
+		portion := amount.MulRaw(int64(f.BasisPoints)).QuoRaw(MaxTransferFeeBasisPoints)
+		fees = fees.Add(sdk.NewCoin(f.Denom, portion))
+	}
+	return fees
+}
+
+// ValidateTransferFeeValues checks the recipient, basis points and flat fees of a transfer fee.
+// No basis points and no flat fees is allowed; it indicates that the transfer fee is to be removed.
+func ValidateTransferFeeValues(recipient string, basisPoints uint32, flatFees sdk.Coins) error {
+	if basisPoints > MaxTransferFeeBasisPoints {
+		return fmt.Errorf("invalid basis points %d: cannot be greater than %d", basisPoints, MaxTransferFeeBasisPoints)
+	}
+	if err := flatFees.Validate(); err != nil {
+		return fmt.Errorf("invalid flat fees %q: %w", flatFees, err)
+	}
+	if basisPoints == 0 && flatFees.IsZero() {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(recipient); err != nil {
+		return fmt.Errorf("invalid recipient: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestTransferFeeValidate(t *testing.T) {
+	recipient := sdk.AccAddress("recipient___________").String()
+	flatFees := sdk.NewCoins(sdk.NewInt64Coin("nhash", 10))
+
+	tests := []struct {
+		name string
+		fee  TransferFee
+		exp  string
+	}{
+		{
+			name: "basis points and flat fees",
+			fee:  NewTransferFee("feecoin", recipient, 25, flatFees),
+			exp:  "",
+		},
+		{
+			name: "all of the transfer",
+			fee:  NewTransferFee("feecoin", recipient, MaxTransferFeeBasisPoints, nil),
+			exp:  "",
+		},
+		{
+			name: "invalid denom",
+			fee:  NewTransferFee("x", recipient, 25, nil),
+			exp:  "invalid denom: x",
+		},
+		{
+			name: "no fees",
+			fee:  NewTransferFee("feecoin", recipient, 0, nil),
+			exp:  "transfer fee for feecoin must have basis points or flat fees",
+		},
+		{
+			name: "no recipient",
+			fee:  NewTransferFee("feecoin", "", 0, flatFees),
+			exp:  "invalid recipient: empty address string is not allowed",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.fee.Validate()
+			if len(tc.exp) > 0 {
+				assert.EqualError(t, err, tc.exp, "Validate")
+			} else {
+				assert.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
+
+func TestTransferFeeCalculate(t *testing.T) {
+	flatFees := sdk.NewCoins(sdk.NewInt64Coin("nhash", 10), sdk.NewInt64Coin("feecoin", 1))
+
+	tests := []struct {
+		name   string
+		fee    TransferFee
+		amount int64
+		exp    string
+	}{
+		{name: "basis points only", fee: NewTransferFee("feecoin", "", 25, nil), amount: 10_000, exp: "25feecoin"},
+		{name: "basis points round down", fee: NewTransferFee("feecoin", "", 25, nil), amount: 399, exp: ""},
+		{name: "flat fees only", fee: NewTransferFee("feecoin", "", 0, flatFees), amount: 10_000, exp: "1feecoin,10nhash"},
+		{name: "both", fee: NewTransferFee("feecoin", "", 100, flatFees), amount: 500, exp: "6feecoin,10nhash"},
+		{name: "zero amount", fee: NewTransferFee("feecoin", "", 100, flatFees), amount: 0, exp: "1feecoin,10nhash"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fees := tc.fee.Calculate(math.NewInt(tc.amount))
+			assert.Equal(t, tc.exp, fees.String(), "Calculate")
+		})
+	}
+}
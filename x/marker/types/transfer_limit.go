@@ -0,0 +1,70 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewTransferLimit creates a new TransferLimit for the given denom.
+func NewTransferLimit(denom string, window time.Duration, markerLimit, holderLimit sdkmath.Int) TransferLimit {
+	return TransferLimit{
+		Denom:       denom,
+		Window:      window,
+		MarkerLimit: markerLimit,
+		HolderLimit: holderLimit,
+	}
+}
+
+// Validate performs basic sanity checks on a TransferLimit.
+func (l TransferLimit) Validate() error {
+	if err := sdk.ValidateDenom(l.Denom); err != nil {
+		return err
+	}
+	if err := ValidateTransferLimitValues(l.Window, l.MarkerLimit, l.HolderLimit); err != nil {
+		return err
+	}
+	if !l.HasLimits() {
+		return fmt.Errorf("transfer limit for %s must have a marker limit or holder limit", l.Denom)
+	}
+	return nil
+}
+
+// HasLimits returns true if either the marker-wide or per-holder limit is set.
+func (l TransferLimit) HasLimits() bool {
+	return l.HasMarkerLimit() || l.HasHolderLimit()
+}
+
+// HasMarkerLimit returns true if the marker-wide limit is set.
+func (l TransferLimit) HasMarkerLimit() bool {
+	return !l.MarkerLimit.IsNil() && l.MarkerLimit.IsPositive()
+}
+
+// HasHolderLimit returns true if the per-holder limit is set.
+func (l TransferLimit) HasHolderLimit() bool {
+	return !l.HolderLimit.IsNil() && l.HolderLimit.IsPositive()
+}
+
+// ValidateTransferLimitValues checks the window and limit amounts of a transfer limit.
+// Both limits being zero is allowed; it indicates that the transfer limits are to be removed.
+func ValidateTransferLimitValues(window time.Duration, markerLimit, holderLimit sdkmath.Int) error {
+	if markerLimit.IsNil() || markerLimit.IsNegative() {
+		return fmt.Errorf("invalid marker limit %q: cannot be negative", markerLimit)
+	}
+	if holderLimit.IsNil() || holderLimit.IsNegative() {
+		return fmt.Errorf("invalid holder limit %q: cannot be negative", holderLimit)
+	}
+	if markerLimit.IsZero() && holderLimit.IsZero() {
+		return nil
+	}
+	if window <= 0 {
+		return fmt.Errorf("invalid window %s: must be positive", window)
+	}
+	if markerLimit.IsPositive() && holderLimit.GT(markerLimit) {
+		return fmt.Errorf("holder limit %s cannot be greater than marker limit %s", holderLimit, markerLimit)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"cosmossdk.io/math"
+)
+
+func TestTransferLimitValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		limit TransferLimit
+		exp   string
+	}{
+		{
+			name:  "both limits",
+			limit: NewTransferLimit("limitcoin", time.Hour, math.NewInt(100), math.NewInt(10)),
+			exp:   "",
+		},
+		{
+			name:  "equal limits",
+			limit: NewTransferLimit("limitcoin", time.Hour, math.NewInt(100), math.NewInt(100)),
+			exp:   "",
+		},
+		{
+			name:  "invalid denom",
+			limit: NewTransferLimit("x", time.Hour, math.NewInt(100), math.NewInt(10)),
+			exp:   "invalid denom: x",
+		},
+		{
+			name:  "no limits",
+			limit: NewTransferLimit("limitcoin", time.Hour, math.ZeroInt(), math.ZeroInt()),
+			exp:   "transfer limit for limitcoin must have a marker limit or holder limit",
+		},
+		{
+			name:  "no window",
+			limit: NewTransferLimit("limitcoin", 0, math.ZeroInt(), math.NewInt(10)),
+			exp:   "invalid window 0s: must be positive",
+		},
+		{
+			name:  "nil marker limit",
+			limit: TransferLimit{Denom: "limitcoin", Window: time.Hour, HolderLimit: math.NewInt(10)},
+			exp:   `invalid marker limit "<nil>": cannot be negative`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.limit.Validate()
+			if len(tc.exp) > 0 {
+				assert.EqualError(t, err, tc.exp, "Validate")
+			} else {
+				assert.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
+
+func TestGenesisStateValidateTransferLimits(t *testing.T) {
+	genState := DefaultGenesisState()
+	genState.TransferLimits = []TransferLimit{
+		NewTransferLimit("limitcoin", time.Hour, math.NewInt(100), math.NewInt(10)),
+		NewTransferLimit("othercoin", time.Hour, math.NewInt(100), math.ZeroInt()),
+	}
+	assert.NoError(t, genState.Validate(), "Validate unique transfer limits")
+
+	genState.TransferLimits = append(genState.TransferLimits, NewTransferLimit("limitcoin", time.Minute, math.NewInt(5), math.ZeroInt()))
+	assert.EqualError(t, genState.Validate(), "duplicate transfer limit for limitcoin", "Validate duplicate transfer limits")
+}
@@ -5,8 +5,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
 
 	"github.com/provenance-io/provenance/x/marker/types"
 )
@@ -36,6 +41,22 @@ type MarkerMsgParams struct {
 	Withdraw *WithdrawParams `json:"withdraw_coins,omitempty"`
 	// Params for encoding a MsgTransferRequest
 	Transfer *TransferParams `json:"transfer_marker_coins,omitempty"`
+	// Params for encoding a MsgAddFinalizeActivateMarkerRequest
+	AddFinalizeActivate *AddFinalizeActivateMarkerParams `json:"add_finalize_activate_marker,omitempty"`
+	// Params for encoding a MsgUpdateRequiredAttributesRequest
+	UpdateRequiredAttributes *UpdateRequiredAttributesParams `json:"update_required_attributes,omitempty"`
+	// Params for encoding a MsgUpdateForcedTransferRequest
+	UpdateForcedTransfer *UpdateForcedTransferParams `json:"update_forced_transfer,omitempty"`
+	// Params for encoding a MsgUpdateSendDenyListRequest
+	UpdateSendDenyList *UpdateSendDenyListParams `json:"update_send_deny_list,omitempty"`
+	// Params for encoding a MsgSetDenomMetadataRequest
+	SetDenomMetadata *SetDenomMetadataParams `json:"set_denom_metadata,omitempty"`
+	// Params for encoding a MsgIbcTransferRequest
+	IbcTransfer *IbcTransferParams `json:"ibc_transfer,omitempty"`
+	// Params for encoding a MsgSetAccountDataRequest
+	SetAccountData *SetAccountDataParams `json:"set_account_data,omitempty"`
+	// Params for encoding a MsgSetTransferLimitRequest
+	SetTransferLimit *SetTransferLimitParams `json:"set_transfer_limit,omitempty"`
 }
 
 // CreateMarkerParams are params for encoding a MsgAddMarkerRequest.
@@ -124,6 +145,106 @@ type TransferParams struct {
 	From string `json:"from"`
 }
 
+// AddFinalizeActivateMarkerParams are params for encoding a MsgAddFinalizeActivateMarkerRequest.
+type AddFinalizeActivateMarkerParams struct {
+	// The marker denomination and amount
+	Coin sdk.Coin `json:"coin"`
+	// The marker type
+	Type string `json:"marker_type,omitempty"`
+	// The access to grant on the marker
+	AccessGrants []AccessGrantParams `json:"access_grants"`
+	// Indicates the supply of the marker is fixed
+	SupplyFixed bool `json:"supply_fixed,omitempty"`
+	// Allow the marker to be controlled by governance proposals
+	AllowGovernanceControl bool `json:"allow_governance_control,omitempty"`
+	// Allow forced transfers
+	AllowForcedTransfer bool `json:"allow_forced_transfer,omitempty"`
+	// list of restricted attributes
+	RestrictedAttributes []string `json:"restricted_attributes,omitempty"`
+}
+
+// AccessGrantParams are the permissions to grant an address on a marker being created.
+type AccessGrantParams struct {
+	// The grant address
+	Address string `json:"address"`
+	// The grant permissions
+	Permissions []string `json:"permissions"`
+}
+
+// UpdateRequiredAttributesParams are params for encoding a MsgUpdateRequiredAttributesRequest.
+type UpdateRequiredAttributesParams struct {
+	// The marker denomination
+	Denom string `json:"denom"`
+	// The required attributes to remove
+	RemoveRequiredAttributes []string `json:"remove_required_attributes,omitempty"`
+	// The required attributes to add
+	AddRequiredAttributes []string `json:"add_required_attributes,omitempty"`
+}
+
+// UpdateForcedTransferParams are params for encoding a MsgUpdateForcedTransferRequest.
+type UpdateForcedTransferParams struct {
+	// The marker denomination
+	Denom string `json:"denom"`
+	// Whether forced transfers are allowed
+	AllowForcedTransfer bool `json:"allow_forced_transfer"`
+}
+
+// UpdateSendDenyListParams are params for encoding a MsgUpdateSendDenyListRequest.
+type UpdateSendDenyListParams struct {
+	// The marker denomination
+	Denom string `json:"denom"`
+	// The addresses to remove from the deny list
+	RemoveDeniedAddresses []string `json:"remove_denied_addresses,omitempty"`
+	// The addresses to add to the deny list
+	AddDeniedAddresses []string `json:"add_denied_addresses,omitempty"`
+}
+
+// SetDenomMetadataParams are params for encoding a MsgSetDenomMetadataRequest.
+type SetDenomMetadataParams struct {
+	// The denom metadata to set
+	Metadata banktypes.Metadata `json:"metadata"`
+}
+
+// IbcTransferParams are params for encoding a MsgIbcTransferRequest.
+type IbcTransferParams struct {
+	// The port on which the packet will be sent
+	SourcePort string `json:"source_port"`
+	// The channel by which the packet will be sent
+	SourceChannel string `json:"source_channel"`
+	// The denomination and amount to transfer
+	Coin sdk.Coin `json:"coin"`
+	// The sender of the transfer
+	Sender string `json:"sender"`
+	// The recipient of the transfer on the destination chain
+	Receiver string `json:"receiver"`
+	// The block height on the destination chain after which the transfer times out
+	TimeoutHeight clienttypes.Height `json:"timeout_height"`
+	// The timestamp (in nanoseconds) on the destination chain after which the transfer times out
+	TimeoutTimestamp uint64 `json:"timeout_timestamp,omitempty"`
+	// An optional memo
+	Memo string `json:"memo,omitempty"`
+}
+
+// SetAccountDataParams are params for encoding a MsgSetAccountDataRequest.
+type SetAccountDataParams struct {
+	// The marker denomination
+	Denom string `json:"denom"`
+	// The account data value
+	Value string `json:"value"`
+}
+
+// SetTransferLimitParams are params for encoding a MsgSetTransferLimitRequest.
+type SetTransferLimitParams struct {
+	// The marker denomination
+	Denom string `json:"denom"`
+	// The length of the rolling window in seconds
+	WindowSeconds uint64 `json:"window_seconds"`
+	// The maximum amount all holders can transfer during the window
+	MarkerLimit string `json:"marker_limit,omitempty"`
+	// The maximum amount a single holder can transfer during the window
+	HolderLimit string `json:"holder_limit,omitempty"`
+}
+
 // Encoder returns a smart contract message encoder for the name module.
 func Encoder(contract sdk.AccAddress, msg json.RawMessage, _ string) ([]sdk.Msg, error) {
 	wrapper := struct {
@@ -159,6 +280,22 @@ func Encoder(contract sdk.AccAddress, msg json.RawMessage, _ string) ([]sdk.Msg,
 		return params.Withdraw.Encode(contract)
 	case params.Transfer != nil:
 		return params.Transfer.Encode(contract)
+	case params.AddFinalizeActivate != nil:
+		return params.AddFinalizeActivate.Encode(contract)
+	case params.UpdateRequiredAttributes != nil:
+		return params.UpdateRequiredAttributes.Encode(contract)
+	case params.UpdateForcedTransfer != nil:
+		return params.UpdateForcedTransfer.Encode(contract)
+	case params.UpdateSendDenyList != nil:
+		return params.UpdateSendDenyList.Encode(contract)
+	case params.SetDenomMetadata != nil:
+		return params.SetDenomMetadata.Encode(contract)
+	case params.IbcTransfer != nil:
+		return params.IbcTransfer.Encode(contract)
+	case params.SetAccountData != nil:
+		return params.SetAccountData.Encode(contract)
+	case params.SetTransferLimit != nil:
+		return params.SetTransferLimit.Encode(contract)
 	default:
 		return nil, fmt.Errorf("wasm: invalid marker encode request: %s", string(msg))
 	}
@@ -318,3 +455,144 @@ func (params *TransferParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error)
 	msg := types.NewMsgTransferRequest(contract, from, to, params.Coin)
 	return []sdk.Msg{msg}, nil
 }
+
+// Encode creates a MsgAddFinalizeActivateMarkerRequest.
+// The contract must be the signer (from address) and manager of the marker.
+func (params *AddFinalizeActivateMarkerParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	if !params.Coin.IsValid() {
+		return nil, fmt.Errorf("wasm: invalid marker supply in AddFinalizeActivateMarkerParams: coin is invalid")
+	}
+	if strings.TrimSpace(params.Type) == "" {
+		return nil, fmt.Errorf("wasm: missing marker type in AddFinalizeActivateMarkerParams")
+	}
+	markerType, err := types.MarkerTypeFromString(params.Type)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid marker type in AddFinalizeActivateMarkerParams: %w", err)
+	}
+	if len(params.AccessGrants) == 0 {
+		return nil, fmt.Errorf("wasm: missing access grants in AddFinalizeActivateMarkerParams")
+	}
+	grants := make([]types.AccessGrant, len(params.AccessGrants))
+	for i, grant := range params.AccessGrants {
+		address, err := sdk.AccAddressFromBech32(grant.Address)
+		if err != nil {
+			return nil, fmt.Errorf("wasm: invalid access grant address in AddFinalizeActivateMarkerParams: %w", err)
+		}
+		access := make([]types.Access, len(grant.Permissions))
+		for j, perm := range grant.Permissions {
+			access[j] = types.AccessByName(perm)
+		}
+		grants[i] = *types.NewAccessGrant(address, access)
+	}
+	msg := types.NewMsgAddFinalizeActivateMarkerRequest(
+		params.Coin.Denom, params.Coin.Amount, contract, contract, markerType, params.SupplyFixed,
+		params.AllowGovernanceControl, params.AllowForcedTransfer, params.RestrictedAttributes, grants,
+	)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("wasm: invalid AddFinalizeActivateMarkerParams: %w", err)
+	}
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgUpdateRequiredAttributesRequest.
+// The contract must have transfer access on the marker.
+func (params *UpdateRequiredAttributesParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	msg := types.NewMsgUpdateRequiredAttributesRequest(
+		params.Denom, contract, params.RemoveRequiredAttributes, params.AddRequiredAttributes)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("wasm: invalid UpdateRequiredAttributesParams: %w", err)
+	}
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgUpdateForcedTransferRequest.
+// The contract must be the governance module account (the module authority) for the request to be accepted.
+func (params *UpdateForcedTransferParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	msg := types.NewMsgUpdateForcedTransferRequest(params.Denom, params.AllowForcedTransfer, contract)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("wasm: invalid UpdateForcedTransferParams: %w", err)
+	}
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgUpdateSendDenyListRequest.
+// The contract must have transfer access on the marker.
+func (params *UpdateSendDenyListParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	msg := types.NewMsgUpdateSendDenyListRequest(
+		params.Denom, contract, params.RemoveDeniedAddresses, params.AddDeniedAddresses)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("wasm: invalid UpdateSendDenyListParams: %w", err)
+	}
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgSetDenomMetadataRequest.
+// The contract must have admin access on the marker.
+func (params *SetDenomMetadataParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	msg := types.NewSetDenomMetadataRequest(params.Metadata, contract)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("wasm: invalid SetDenomMetadataParams: %w", err)
+	}
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgIbcTransferRequest.
+// The contract must have transfer access on the marker.
+func (params *IbcTransferParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	if !params.Coin.IsValid() {
+		return nil, fmt.Errorf("wasm: invalid IbcTransferParams: coin is invalid")
+	}
+	msg := types.NewIbcMsgTransferRequest(
+		contract.String(), params.SourcePort, params.SourceChannel, params.Coin, params.Sender,
+		params.Receiver, params.TimeoutHeight, params.TimeoutTimestamp, params.Memo,
+	)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("wasm: invalid IbcTransferParams: %w", err)
+	}
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgSetAccountDataRequest.
+// The contract must have deposit access on the marker.
+func (params *SetAccountDataParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	msg := &types.MsgSetAccountDataRequest{
+		Denom:  params.Denom,
+		Value:  params.Value,
+		Signer: contract.String(),
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("wasm: invalid SetAccountDataParams: %w", err)
+	}
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgSetTransferLimitRequest.
+// The contract must have transfer access on the marker.
+func (params *SetTransferLimitParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	markerLimit, err := parseLimit(params.MarkerLimit)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid marker limit in SetTransferLimitParams: %w", err)
+	}
+	holderLimit, err := parseLimit(params.HolderLimit)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid holder limit in SetTransferLimitParams: %w", err)
+	}
+	window := time.Duration(params.WindowSeconds) * time.Second
+	msg := types.NewMsgSetTransferLimitRequest(params.Denom, window, markerLimit, holderLimit, contract)
+	if err = msg.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("wasm: invalid SetTransferLimitParams: %w", err)
+	}
+	return []sdk.Msg{msg}, nil
+}
+
+// parseLimit converts an optional transfer limit amount to an Int, treating an empty value as zero (no limit).
+func parseLimit(value string) (sdkmath.Int, error) {
+	if strings.TrimSpace(value) == "" {
+		return sdkmath.ZeroInt(), nil
+	}
+	limit, ok := sdkmath.NewIntFromString(value)
+	if !ok {
+		return sdkmath.Int{}, fmt.Errorf("%q is not an integer", value)
+	}
+	return limit, nil
+}
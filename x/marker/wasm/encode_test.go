@@ -0,0 +1,334 @@
+package wasm_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+	"github.com/provenance-io/provenance/x/marker/wasm"
+)
+
+func TestEncoder(t *testing.T) {
+	contract := sdk.AccAddress("contract____________")
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+
+	tests := []struct {
+		name    string
+		msg     string
+		expErr  string
+		expMsgs []sdk.Msg
+	}{
+		{
+			name:   "invalid json",
+			msg:    `{"marker":`,
+			expErr: "wasm: failed to unmarshal marker encode params",
+		},
+		{
+			name:   "no marker params",
+			msg:    `{}`,
+			expErr: "wasm: nil marker encode params",
+		},
+		{
+			name:   "unknown message",
+			msg:    `{"marker":{"unknown":{}}}`,
+			expErr: "wasm: invalid marker encode request",
+		},
+		{
+			name: "create marker",
+			msg:  `{"marker":{"create_marker":{"coin":{"denom":"testcoin","amount":"100"},"marker_type":"restricted","allow_forced_transfer":true}}}`,
+			expMsgs: []sdk.Msg{types.NewMsgAddMarkerRequest("testcoin", sdk.NewInt(100), contract, contract,
+				types.MarkerType_RestrictedCoin, false, false, true, nil)},
+		},
+		{
+			name:   "create marker without a type",
+			msg:    `{"marker":{"create_marker":{"coin":{"denom":"testcoin","amount":"100"}}}}`,
+			expErr: "wasm: missing marker type in CreateMarkerParams",
+		},
+		{
+			name:   "create marker with an invalid type",
+			msg:    `{"marker":{"create_marker":{"coin":{"denom":"testcoin","amount":"100"},"marker_type":"bogus"}}}`,
+			expErr: "wasm: invalid marker type in CreateMarkerParams",
+		},
+		{
+			name:   "create coin marker allowing forced transfers",
+			msg:    `{"marker":{"create_marker":{"coin":{"denom":"testcoin","amount":"100"},"marker_type":"coin","allow_forced_transfer":true}}}`,
+			expErr: "wasm: allow_forced_transfer can only be set if marker type is restricted",
+		},
+		{
+			name: "grant access",
+			msg:  fmt.Sprintf(`{"marker":{"grant_marker_access":{"denom":"testcoin","address":"%s","permissions":["mint","burn"]}}}`, addr1),
+			expMsgs: []sdk.Msg{types.NewMsgAddAccessRequest("testcoin", contract,
+				*types.NewAccessGrant(addr1, []types.Access{types.Access_Mint, types.Access_Burn}))},
+		},
+		{
+			name:   "grant access with an invalid address",
+			msg:    `{"marker":{"grant_marker_access":{"denom":"testcoin","address":"bad","permissions":["mint"]}}}`,
+			expErr: "wasm: invalid address in GrantAccessParams",
+		},
+		{
+			name:   "grant access without a denom",
+			msg:    fmt.Sprintf(`{"marker":{"grant_marker_access":{"address":"%s","permissions":["mint"]}}}`, addr1),
+			expErr: "wasm: empty denomination in GrantAccessParams",
+		},
+		{
+			name:    "revoke access",
+			msg:     fmt.Sprintf(`{"marker":{"revoke_marker_access":{"denom":"testcoin","address":"%s"}}}`, addr1),
+			expMsgs: []sdk.Msg{types.NewDeleteAccessRequest("testcoin", contract, addr1)},
+		},
+		{
+			name:   "revoke access with an invalid address",
+			msg:    `{"marker":{"revoke_marker_access":{"denom":"testcoin","address":"bad"}}}`,
+			expErr: "wasm: invalid address in RevokeAccessParams",
+		},
+		{
+			name:    "finalize marker",
+			msg:     `{"marker":{"finalize_marker":{"denom":"testcoin"}}}`,
+			expMsgs: []sdk.Msg{types.NewMsgFinalizeRequest("testcoin", contract)},
+		},
+		{
+			name:   "finalize marker with an invalid denom",
+			msg:    `{"marker":{"finalize_marker":{"denom":"1"}}}`,
+			expErr: "wasm: invalid denomination in FinalizeMarkerParams",
+		},
+		{
+			name:    "activate marker",
+			msg:     `{"marker":{"activate_marker":{"denom":"testcoin"}}}`,
+			expMsgs: []sdk.Msg{types.NewMsgActivateRequest("testcoin", contract)},
+		},
+		{
+			name:   "activate marker with an invalid denom",
+			msg:    `{"marker":{"activate_marker":{"denom":""}}}`,
+			expErr: "wasm: invalid denomination in ActivateMarkerParams",
+		},
+		{
+			name:    "cancel marker",
+			msg:     `{"marker":{"cancel_marker":{"denom":"testcoin"}}}`,
+			expMsgs: []sdk.Msg{types.NewMsgCancelRequest("testcoin", contract)},
+		},
+		{
+			name:   "cancel marker with an invalid denom",
+			msg:    `{"marker":{"cancel_marker":{"denom":""}}}`,
+			expErr: "wasm: invalid denomination in CancelMarkerParams",
+		},
+		{
+			name:    "destroy marker",
+			msg:     `{"marker":{"destroy_marker":{"denom":"testcoin"}}}`,
+			expMsgs: []sdk.Msg{types.NewMsgDeleteRequest("testcoin", contract)},
+		},
+		{
+			name:   "destroy marker with an invalid denom",
+			msg:    `{"marker":{"destroy_marker":{"denom":""}}}`,
+			expErr: "wasm: invalid denomination in DestroyMarkerParams",
+		},
+		{
+			name:    "mint supply",
+			msg:     `{"marker":{"mint_marker_supply":{"coin":{"denom":"testcoin","amount":"10"}}}}`,
+			expMsgs: []sdk.Msg{types.NewMsgMintRequest(contract, sdk.NewInt64Coin("testcoin", 10))},
+		},
+		{
+			name:   "mint supply with an invalid coin",
+			msg:    `{"marker":{"mint_marker_supply":{"coin":{"denom":"testcoin","amount":"-10"}}}}`,
+			expErr: "wasm: invalid MintSupplyParams: coin is invalid",
+		},
+		{
+			name:    "burn supply",
+			msg:     `{"marker":{"burn_marker_supply":{"coin":{"denom":"testcoin","amount":"10"}}}}`,
+			expMsgs: []sdk.Msg{types.NewMsgBurnRequest(contract, sdk.NewInt64Coin("testcoin", 10))},
+		},
+		{
+			name:   "burn supply with an invalid coin",
+			msg:    `{"marker":{"burn_marker_supply":{"coin":{"denom":"","amount":"10"}}}}`,
+			expErr: "wasm: invalid BurnSupplyParams: coin is invalid",
+		},
+		{
+			name: "withdraw coins",
+			msg:  fmt.Sprintf(`{"marker":{"withdraw_coins":{"marker_denom":"testcoin","coin":{"denom":"testcoin","amount":"10"},"recipient":"%s"}}}`, addr1),
+			expMsgs: []sdk.Msg{types.NewMsgWithdrawRequest(contract, addr1, "testcoin",
+				sdk.NewCoins(sdk.NewInt64Coin("testcoin", 10)))},
+		},
+		{
+			name:   "withdraw coins with an invalid marker denom",
+			msg:    fmt.Sprintf(`{"marker":{"withdraw_coins":{"marker_denom":"","coin":{"denom":"testcoin","amount":"10"},"recipient":"%s"}}}`, addr1),
+			expErr: "wasm: invalid marker denom in WithdrawParams",
+		},
+		{
+			name:   "withdraw coins with an invalid coin",
+			msg:    fmt.Sprintf(`{"marker":{"withdraw_coins":{"marker_denom":"testcoin","coin":{"denom":"","amount":"10"},"recipient":"%s"}}}`, addr1),
+			expErr: "wasm: invalid WithdrawParams: coin is invalid",
+		},
+		{
+			name:   "withdraw coins with an invalid recipient",
+			msg:    `{"marker":{"withdraw_coins":{"marker_denom":"testcoin","coin":{"denom":"testcoin","amount":"10"},"recipient":"bad"}}}`,
+			expErr: "wasm: invalid recipient address",
+		},
+		{
+			name:    "transfer coins",
+			msg:     fmt.Sprintf(`{"marker":{"transfer_marker_coins":{"coin":{"denom":"testcoin","amount":"10"},"to":"%s","from":"%s"}}}`, addr2, addr1),
+			expMsgs: []sdk.Msg{types.NewMsgTransferRequest(contract, addr1, addr2, sdk.NewInt64Coin("testcoin", 10))},
+		},
+		{
+			name:   "transfer coins with an invalid coin",
+			msg:    fmt.Sprintf(`{"marker":{"transfer_marker_coins":{"coin":{"denom":"","amount":"10"},"to":"%s","from":"%s"}}}`, addr2, addr1),
+			expErr: "wasm: invalid TransferParams: coin is invalid",
+		},
+		{
+			name:   "transfer coins with an invalid to address",
+			msg:    fmt.Sprintf(`{"marker":{"transfer_marker_coins":{"coin":{"denom":"testcoin","amount":"10"},"to":"bad","from":"%s"}}}`, addr1),
+			expErr: "wasm: invalid 'to' address in TransferParams",
+		},
+		{
+			name:   "transfer coins with an invalid from address",
+			msg:    fmt.Sprintf(`{"marker":{"transfer_marker_coins":{"coin":{"denom":"testcoin","amount":"10"},"to":"%s","from":"bad"}}}`, addr2),
+			expErr: "wasm: invalid 'from' address in TransferParams",
+		},
+		{
+			name: "add finalize activate marker",
+			msg: fmt.Sprintf(`{"marker":{"add_finalize_activate_marker":{"coin":{"denom":"testcoin","amount":"100"},"marker_type":"coin",`+
+				`"access_grants":[{"address":"%s","permissions":["admin","mint"]}],"supply_fixed":true}}}`, addr1),
+			expMsgs: []sdk.Msg{types.NewMsgAddFinalizeActivateMarkerRequest("testcoin", sdk.NewInt(100), contract, contract,
+				types.MarkerType_Coin, true, false, false, nil,
+				[]types.AccessGrant{*types.NewAccessGrant(addr1, []types.Access{types.Access_Admin, types.Access_Mint})})},
+		},
+		{
+			name:   "add finalize activate marker without a type",
+			msg:    fmt.Sprintf(`{"marker":{"add_finalize_activate_marker":{"coin":{"denom":"testcoin","amount":"100"},"access_grants":[{"address":"%s","permissions":["admin"]}]}}}`, addr1),
+			expErr: "wasm: missing marker type in AddFinalizeActivateMarkerParams",
+		},
+		{
+			name:   "add finalize activate marker without access grants",
+			msg:    `{"marker":{"add_finalize_activate_marker":{"coin":{"denom":"testcoin","amount":"100"},"marker_type":"coin","access_grants":[]}}}`,
+			expErr: "wasm: missing access grants in AddFinalizeActivateMarkerParams",
+		},
+		{
+			name:   "add finalize activate marker with an invalid grant address",
+			msg:    `{"marker":{"add_finalize_activate_marker":{"coin":{"denom":"testcoin","amount":"100"},"marker_type":"coin","access_grants":[{"address":"bad","permissions":["admin"]}]}}}`,
+			expErr: "wasm: invalid access grant address in AddFinalizeActivateMarkerParams",
+		},
+		{
+			name:    "update required attributes",
+			msg:     `{"marker":{"update_required_attributes":{"denom":"testcoin","remove_required_attributes":["old.attr"],"add_required_attributes":["new.attr"]}}}`,
+			expMsgs: []sdk.Msg{types.NewMsgUpdateRequiredAttributesRequest("testcoin", contract, []string{"old.attr"}, []string{"new.attr"})},
+		},
+		{
+			name:   "update required attributes without changes",
+			msg:    `{"marker":{"update_required_attributes":{"denom":"testcoin"}}}`,
+			expErr: "wasm: invalid UpdateRequiredAttributesParams",
+		},
+		{
+			name:    "update forced transfer",
+			msg:     `{"marker":{"update_forced_transfer":{"denom":"testcoin","allow_forced_transfer":true}}}`,
+			expMsgs: []sdk.Msg{types.NewMsgUpdateForcedTransferRequest("testcoin", true, contract)},
+		},
+		{
+			name:   "update forced transfer with an invalid denom",
+			msg:    `{"marker":{"update_forced_transfer":{"denom":"","allow_forced_transfer":true}}}`,
+			expErr: "wasm: invalid UpdateForcedTransferParams",
+		},
+		{
+			name:    "update send deny list",
+			msg:     fmt.Sprintf(`{"marker":{"update_send_deny_list":{"denom":"testcoin","remove_denied_addresses":["%s"],"add_denied_addresses":["%s"]}}}`, addr1, addr2),
+			expMsgs: []sdk.Msg{types.NewMsgUpdateSendDenyListRequest("testcoin", contract, []string{addr1.String()}, []string{addr2.String()})},
+		},
+		{
+			name:   "update send deny list without changes",
+			msg:    `{"marker":{"update_send_deny_list":{"denom":"testcoin"}}}`,
+			expErr: "wasm: invalid UpdateSendDenyListParams",
+		},
+		{
+			name: "set denom metadata",
+			msg: `{"marker":{"set_denom_metadata":{"metadata":{"description":"a test coin",` +
+				`"denom_units":[{"denom":"ntestcoin","exponent":0},{"denom":"testcoin","exponent":9}],"base":"ntestcoin","display":"testcoin","name":"testcoin","symbol":"TC"}}}}`,
+			expMsgs: []sdk.Msg{types.NewSetDenomMetadataRequest(banktypes.Metadata{
+				Description: "a test coin",
+				DenomUnits:  []*banktypes.DenomUnit{{Denom: "ntestcoin", Exponent: 0}, {Denom: "testcoin", Exponent: 9}},
+				Base:        "ntestcoin",
+				Display:     "testcoin",
+				Name:        "testcoin",
+				Symbol:      "TC",
+			}, contract)},
+		},
+		{
+			name:   "set denom metadata with invalid metadata",
+			msg:    `{"marker":{"set_denom_metadata":{"metadata":{"base":""}}}}`,
+			expErr: "wasm: invalid SetDenomMetadataParams",
+		},
+		{
+			name: "ibc transfer",
+			msg: fmt.Sprintf(`{"marker":{"ibc_transfer":{"source_port":"transfer","source_channel":"channel-0","coin":{"denom":"testcoin","amount":"10"},`+
+				`"sender":"%s","receiver":"receiver","timeout_height":{"revision_number":1,"revision_height":100}}}}`, addr1),
+			expMsgs: []sdk.Msg{types.NewIbcMsgTransferRequest(contract.String(), "transfer", "channel-0", sdk.NewInt64Coin("testcoin", 10),
+				addr1.String(), "receiver", clienttypes.NewHeight(1, 100), 0, "")},
+		},
+		{
+			name:   "ibc transfer with an invalid coin",
+			msg:    fmt.Sprintf(`{"marker":{"ibc_transfer":{"source_port":"transfer","source_channel":"channel-0","coin":{"denom":"","amount":"10"},"sender":"%s","receiver":"receiver"}}}`, addr1),
+			expErr: "wasm: invalid IbcTransferParams: coin is invalid",
+		},
+		{
+			name:   "ibc transfer with an invalid port",
+			msg:    fmt.Sprintf(`{"marker":{"ibc_transfer":{"source_port":"","source_channel":"channel-0","coin":{"denom":"testcoin","amount":"10"},"sender":"%s","receiver":"receiver"}}}`, addr1),
+			expErr: "wasm: invalid IbcTransferParams",
+		},
+		{
+			name:    "set account data",
+			msg:     `{"marker":{"set_account_data":{"denom":"testcoin","value":"some data"}}}`,
+			expMsgs: []sdk.Msg{&types.MsgSetAccountDataRequest{Denom: "testcoin", Value: "some data", Signer: contract.String()}},
+		},
+		{
+			name:   "set account data with an invalid denom",
+			msg:    `{"marker":{"set_account_data":{"denom":"","value":"some data"}}}`,
+			expErr: "wasm: invalid SetAccountDataParams",
+		},
+		{
+			name: "set transfer limit",
+			msg:  `{"marker":{"set_transfer_limit":{"denom":"testcoin","window_seconds":3600,"marker_limit":"100","holder_limit":"10"}}}`,
+			expMsgs: []sdk.Msg{types.NewMsgSetTransferLimitRequest("testcoin", time.Hour,
+				sdkmath.NewInt(100), sdkmath.NewInt(10), contract)},
+		},
+		{
+			name: "set transfer limit without a holder limit",
+			msg:  `{"marker":{"set_transfer_limit":{"denom":"testcoin","window_seconds":60,"marker_limit":"100"}}}`,
+			expMsgs: []sdk.Msg{types.NewMsgSetTransferLimitRequest("testcoin", time.Minute,
+				sdkmath.NewInt(100), sdkmath.ZeroInt(), contract)},
+		},
+		{
+			name:   "set transfer limit with an invalid marker limit",
+			msg:    `{"marker":{"set_transfer_limit":{"denom":"testcoin","window_seconds":60,"marker_limit":"lots"}}}`,
+			expErr: `wasm: invalid marker limit in SetTransferLimitParams: "lots" is not an integer`,
+		},
+		{
+			name:   "set transfer limit with an invalid holder limit",
+			msg:    `{"marker":{"set_transfer_limit":{"denom":"testcoin","window_seconds":60,"holder_limit":"1.5"}}}`,
+			expErr: `wasm: invalid holder limit in SetTransferLimitParams: "1.5" is not an integer`,
+		},
+		{
+			name:   "set transfer limit without a window",
+			msg:    `{"marker":{"set_transfer_limit":{"denom":"testcoin","marker_limit":"100"}}}`,
+			expErr: "wasm: invalid SetTransferLimitParams",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msgs, err := wasm.Encoder(contract, []byte(tc.msg), "")
+			if len(tc.expErr) > 0 {
+				require.ErrorContains(t, err, tc.expErr, "Encoder error")
+				assert.Nil(t, msgs, "Encoder msgs")
+				return
+			}
+			require.NoError(t, err, "Encoder error")
+			assert.Equal(t, tc.expMsgs, msgs, "Encoder msgs")
+		})
+	}
+}
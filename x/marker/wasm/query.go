@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"strings"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/provenance-io/provenance/internal/provwasm"
 	"github.com/provenance-io/provenance/x/marker/keeper"
@@ -19,6 +21,34 @@ type MarkerQueryParams struct {
 	*GetMarkerByAddress `json:"get_marker_by_address,omitempty"`
 	// Get a marker by denomination.
 	*GetMarkerByDenom `json:"get_marker_by_denom,omitempty"`
+	// Get the accounts holding a marker's coin.
+	*GetMarkerHolders `json:"get_marker_holders,omitempty"`
+	// Get the total supply of a marker.
+	*GetMarkerSupply `json:"get_marker_supply,omitempty"`
+	// Get the coins held in escrow by a marker.
+	*GetMarkerEscrow `json:"get_marker_escrow,omitempty"`
+	// Get the access granted on a marker.
+	*GetMarkerAccess `json:"get_marker_access,omitempty"`
+	// Get the denom metadata of a marker.
+	*GetDenomMetadata `json:"get_denom_metadata,omitempty"`
+	// Get the account data of a marker.
+	*GetMarkerAccountData `json:"get_marker_account_data,omitempty"`
+	// Get the transfer limit of a marker and how much of it is left.
+	*GetTransferAllowance `json:"get_transfer_allowance,omitempty"`
+	// Get the supply history of a marker.
+	*GetSupplyHistory `json:"get_supply_history,omitempty"`
+	// Get the markers managed by an account.
+	*GetMarkersByManager `json:"get_markers_by_manager,omitempty"`
+	// Get the markers an account has been granted access on.
+	*GetMarkersByAccessHolder `json:"get_markers_by_access_holder,omitempty"`
+	// Get the markers of a marker type.
+	*GetMarkersByType `json:"get_markers_by_type,omitempty"`
+	// Get the markers that allow (or disallow) forced transfers.
+	*GetMarkersByForcedTransfer `json:"get_markers_by_forced_transfer,omitempty"`
+	// Get the markers requiring an attribute.
+	*GetMarkersByRequiredAttribute `json:"get_markers_by_required_attribute,omitempty"`
+	// Get the net asset values of a marker.
+	*GetNetAssetValues `json:"get_net_asset_values,omitempty"`
 }
 
 // GetMarkerByAddress represent a query request to get a marker by address.
@@ -33,6 +63,110 @@ type GetMarkerByDenom struct {
 	Denom string `json:"denom,omitempty"`
 }
 
+// GetMarkerHolders represent a query request to get the accounts holding a marker's coin.
+type GetMarkerHolders struct {
+	// The marker denomination or address
+	ID string `json:"id,omitempty"`
+	// Optional pagination parameters
+	Pagination *query.PageRequest `json:"pagination,omitempty"`
+}
+
+// GetMarkerSupply represent a query request to get the total supply of a marker.
+type GetMarkerSupply struct {
+	// The marker denomination or address
+	ID string `json:"id,omitempty"`
+}
+
+// GetMarkerEscrow represent a query request to get the coins held in escrow by a marker.
+type GetMarkerEscrow struct {
+	// The marker denomination or address
+	ID string `json:"id,omitempty"`
+}
+
+// GetMarkerAccess represent a query request to get the access granted on a marker.
+type GetMarkerAccess struct {
+	// The marker denomination or address
+	ID string `json:"id,omitempty"`
+}
+
+// GetDenomMetadata represent a query request to get the denom metadata of a marker.
+type GetDenomMetadata struct {
+	// The marker denomination
+	Denom string `json:"denom,omitempty"`
+}
+
+// GetMarkerAccountData represent a query request to get the account data of a marker.
+type GetMarkerAccountData struct {
+	// The marker denomination
+	Denom string `json:"denom,omitempty"`
+}
+
+// GetTransferAllowance represent a query request to get the transfer limit of a marker and how much of it is left.
+type GetTransferAllowance struct {
+	// The marker denomination or address
+	ID string `json:"id,omitempty"`
+	// Optional holder address to include the holder allowance for
+	Address string `json:"address,omitempty"`
+}
+
+// GetSupplyHistory represent a query request to get the supply history of a marker.
+type GetSupplyHistory struct {
+	// The marker denomination or address
+	ID string `json:"id,omitempty"`
+	// Optional pagination parameters
+	Pagination *query.PageRequest `json:"pagination,omitempty"`
+}
+
+// GetMarkersByManager represent a query request to get the markers managed by an account.
+type GetMarkersByManager struct {
+	// The manager address
+	Manager string `json:"manager,omitempty"`
+	// Optional pagination parameters
+	Pagination *query.PageRequest `json:"pagination,omitempty"`
+}
+
+// GetMarkersByAccessHolder represent a query request to get the markers an account has been granted access on.
+type GetMarkersByAccessHolder struct {
+	// The access holder address
+	Address string `json:"address,omitempty"`
+	// Optional permission the access holder must have
+	Permission string `json:"permission,omitempty"`
+	// Optional pagination parameters
+	Pagination *query.PageRequest `json:"pagination,omitempty"`
+}
+
+// GetMarkersByType represent a query request to get the markers of a marker type.
+type GetMarkersByType struct {
+	// The marker type
+	Type string `json:"marker_type,omitempty"`
+	// Optional pagination parameters
+	Pagination *query.PageRequest `json:"pagination,omitempty"`
+}
+
+// GetMarkersByForcedTransfer represent a query request to get the markers that allow (or disallow) forced transfers.
+type GetMarkersByForcedTransfer struct {
+	// Whether the markers allow forced transfers
+	AllowForcedTransfer bool `json:"allow_forced_transfer"`
+	// Optional pagination parameters
+	Pagination *query.PageRequest `json:"pagination,omitempty"`
+}
+
+// GetMarkersByRequiredAttribute represent a query request to get the markers requiring an attribute.
+type GetMarkersByRequiredAttribute struct {
+	// The required attribute name
+	Attribute string `json:"attribute,omitempty"`
+	// Optional pagination parameters
+	Pagination *query.PageRequest `json:"pagination,omitempty"`
+}
+
+// GetNetAssetValues represent a query request to get the net asset values of a marker.
+type GetNetAssetValues struct {
+	// The marker denomination or address
+	ID string `json:"id,omitempty"`
+	// Optional pagination parameters
+	Pagination *query.PageRequest `json:"pagination,omitempty"`
+}
+
 // Querier returns a smart contract querier for the name module.
 func Querier(keeper keeper.Keeper) provwasm.Querier {
 	return func(ctx sdk.Context, query json.RawMessage, version string) ([]byte, error) {
@@ -51,6 +185,34 @@ func Querier(keeper keeper.Keeper) provwasm.Querier {
 			return params.GetMarkerByAddress.Run(ctx, keeper)
 		case params.GetMarkerByDenom != nil:
 			return params.GetMarkerByDenom.Run(ctx, keeper)
+		case params.GetMarkerHolders != nil:
+			return params.GetMarkerHolders.Run(ctx, keeper)
+		case params.GetMarkerSupply != nil:
+			return params.GetMarkerSupply.Run(ctx, keeper)
+		case params.GetMarkerEscrow != nil:
+			return params.GetMarkerEscrow.Run(ctx, keeper)
+		case params.GetMarkerAccess != nil:
+			return params.GetMarkerAccess.Run(ctx, keeper)
+		case params.GetDenomMetadata != nil:
+			return params.GetDenomMetadata.Run(ctx, keeper)
+		case params.GetMarkerAccountData != nil:
+			return params.GetMarkerAccountData.Run(ctx, keeper)
+		case params.GetTransferAllowance != nil:
+			return params.GetTransferAllowance.Run(ctx, keeper)
+		case params.GetSupplyHistory != nil:
+			return params.GetSupplyHistory.Run(ctx, keeper)
+		case params.GetMarkersByManager != nil:
+			return params.GetMarkersByManager.Run(ctx, keeper)
+		case params.GetMarkersByAccessHolder != nil:
+			return params.GetMarkersByAccessHolder.Run(ctx, keeper)
+		case params.GetMarkersByType != nil:
+			return params.GetMarkersByType.Run(ctx, keeper)
+		case params.GetMarkersByForcedTransfer != nil:
+			return params.GetMarkersByForcedTransfer.Run(ctx, keeper)
+		case params.GetMarkersByRequiredAttribute != nil:
+			return params.GetMarkersByRequiredAttribute.Run(ctx, keeper)
+		case params.GetNetAssetValues != nil:
+			return params.GetNetAssetValues.Run(ctx, keeper)
 		default:
 			return nil, fmt.Errorf("wasm: invalid marker query: %s", string(query))
 		}
@@ -102,3 +264,223 @@ func (params *GetMarkerByDenom) Run(ctx sdk.Context, keeper keeper.Keeper) ([]by
 	}
 	return bz, nil
 }
+
+// Run gets the accounts holding a marker's coin.
+func (params *GetMarkerHolders) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	if strings.TrimSpace(params.ID) == "" {
+		return nil, fmt.Errorf("wasm: marker id cannot be empty")
+	}
+	res, err := keeper.Holding(sdk.WrapSDKContext(ctx), &types.QueryHoldingRequest{Id: params.ID, Pagination: params.Pagination})
+	if err != nil {
+		return nil, fmt.Errorf("wasm: marker holding query failed: %w", err)
+	}
+	holders := &MarkerHolders{Pagination: res.Pagination}
+	for _, balance := range res.Balances {
+		holders.Holders = append(holders.Holders, &MarkerHolder{Address: balance.Address, Coins: balance.Coins})
+	}
+	return marshalResponse(holders)
+}
+
+// Run gets the total supply of a marker.
+func (params *GetMarkerSupply) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	if strings.TrimSpace(params.ID) == "" {
+		return nil, fmt.Errorf("wasm: marker id cannot be empty")
+	}
+	res, err := keeper.Supply(sdk.WrapSDKContext(ctx), &types.QuerySupplyRequest{Id: params.ID})
+	if err != nil {
+		return nil, fmt.Errorf("wasm: marker supply query failed: %w", err)
+	}
+	return marshalResponse(&MarkerSupply{Amount: res.Amount})
+}
+
+// Run gets the coins held in escrow by a marker.
+func (params *GetMarkerEscrow) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	if strings.TrimSpace(params.ID) == "" {
+		return nil, fmt.Errorf("wasm: marker id cannot be empty")
+	}
+	res, err := keeper.Escrow(sdk.WrapSDKContext(ctx), &types.QueryEscrowRequest{Id: params.ID})
+	if err != nil {
+		return nil, fmt.Errorf("wasm: marker escrow query failed: %w", err)
+	}
+	return marshalResponse(&MarkerEscrow{Coins: res.Escrow})
+}
+
+// Run gets the access granted on a marker.
+func (params *GetMarkerAccess) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	if strings.TrimSpace(params.ID) == "" {
+		return nil, fmt.Errorf("wasm: marker id cannot be empty")
+	}
+	res, err := keeper.Access(sdk.WrapSDKContext(ctx), &types.QueryAccessRequest{Id: params.ID})
+	if err != nil {
+		return nil, fmt.Errorf("wasm: marker access query failed: %w", err)
+	}
+	access := &MarkerAccess{}
+	for _, grant := range res.Accounts {
+		access.Permissions = append(access.Permissions, accessGrantFor(grant))
+	}
+	return marshalResponse(access)
+}
+
+// Run gets the denom metadata of a marker.
+func (params *GetDenomMetadata) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	if strings.TrimSpace(params.Denom) == "" {
+		return nil, fmt.Errorf("wasm: marker denomination cannot be empty")
+	}
+	res, err := keeper.DenomMetadata(sdk.WrapSDKContext(ctx), &types.QueryDenomMetadataRequest{Denom: params.Denom})
+	if err != nil {
+		return nil, fmt.Errorf("wasm: denom metadata query failed: %w", err)
+	}
+	return marshalResponse(&DenomMetadata{Metadata: res.Metadata})
+}
+
+// Run gets the account data of a marker.
+func (params *GetMarkerAccountData) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	if strings.TrimSpace(params.Denom) == "" {
+		return nil, fmt.Errorf("wasm: marker denomination cannot be empty")
+	}
+	res, err := keeper.AccountData(sdk.WrapSDKContext(ctx), &types.QueryAccountDataRequest{Denom: params.Denom})
+	if err != nil {
+		return nil, fmt.Errorf("wasm: marker account data query failed: %w", err)
+	}
+	return marshalResponse(&MarkerAccountData{Value: res.Value})
+}
+
+// Run gets the transfer limit of a marker and how much of it is left.
+func (params *GetTransferAllowance) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	if strings.TrimSpace(params.ID) == "" {
+		return nil, fmt.Errorf("wasm: marker id cannot be empty")
+	}
+	req := &types.QueryTransferAllowanceRequest{Id: params.ID, Address: params.Address}
+	res, err := keeper.TransferAllowance(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: marker transfer allowance query failed: %w", err)
+	}
+	return marshalResponse(transferAllowanceFor(res))
+}
+
+// Run gets the supply history of a marker.
+func (params *GetSupplyHistory) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	if strings.TrimSpace(params.ID) == "" {
+		return nil, fmt.Errorf("wasm: marker id cannot be empty")
+	}
+	req := &types.QuerySupplyHistoryRequest{Id: params.ID, Pagination: params.Pagination}
+	res, err := keeper.SupplyHistory(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: marker supply history query failed: %w", err)
+	}
+	history := &SupplyHistory{Pagination: res.Pagination}
+	for _, entry := range res.Entries {
+		history.Entries = append(history.Entries, supplyChangeFor(entry))
+	}
+	return marshalResponse(history)
+}
+
+// Run gets the markers managed by an account.
+func (params *GetMarkersByManager) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	if strings.TrimSpace(params.Manager) == "" {
+		return nil, fmt.Errorf("wasm: manager address cannot be empty")
+	}
+	req := &types.QueryMarkersByManagerRequest{Manager: params.Manager, Pagination: params.Pagination}
+	res, err := keeper.MarkersByManager(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: markers by manager query failed: %w", err)
+	}
+	return marshalMarkers(ctx, keeper, res.Markers, res.Pagination)
+}
+
+// Run gets the markers an account has been granted access on.
+func (params *GetMarkersByAccessHolder) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	if strings.TrimSpace(params.Address) == "" {
+		return nil, fmt.Errorf("wasm: access holder address cannot be empty")
+	}
+	access := types.AccessByName(params.Permission)
+	if strings.TrimSpace(params.Permission) != "" && access == types.Access_Unknown {
+		return nil, fmt.Errorf("wasm: invalid marker permission: %s", params.Permission)
+	}
+	req := &types.QueryMarkersByAccessHolderRequest{Address: params.Address, Access: access, Pagination: params.Pagination}
+	res, err := keeper.MarkersByAccessHolder(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: markers by access holder query failed: %w", err)
+	}
+	return marshalMarkers(ctx, keeper, res.Markers, res.Pagination)
+}
+
+// Run gets the markers of a marker type.
+func (params *GetMarkersByType) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	if strings.TrimSpace(params.Type) == "" {
+		return nil, fmt.Errorf("wasm: marker type cannot be empty")
+	}
+	markerType, err := types.MarkerTypeFromString(params.Type)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid marker type: %w", err)
+	}
+	req := &types.QueryMarkersByTypeRequest{MarkerType: markerType, Pagination: params.Pagination}
+	res, err := keeper.MarkersByType(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: markers by type query failed: %w", err)
+	}
+	return marshalMarkers(ctx, keeper, res.Markers, res.Pagination)
+}
+
+// Run gets the markers that allow (or disallow) forced transfers.
+func (params *GetMarkersByForcedTransfer) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	req := &types.QueryMarkersByForcedTransferRequest{AllowForcedTransfer: params.AllowForcedTransfer, Pagination: params.Pagination}
+	res, err := keeper.MarkersByForcedTransfer(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: markers by forced transfer query failed: %w", err)
+	}
+	return marshalMarkers(ctx, keeper, res.Markers, res.Pagination)
+}
+
+// Run gets the markers requiring an attribute.
+func (params *GetMarkersByRequiredAttribute) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	if strings.TrimSpace(params.Attribute) == "" {
+		return nil, fmt.Errorf("wasm: required attribute cannot be empty")
+	}
+	req := &types.QueryMarkersByRequiredAttributeRequest{Attribute: params.Attribute, Pagination: params.Pagination}
+	res, err := keeper.MarkersByRequiredAttribute(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: markers by required attribute query failed: %w", err)
+	}
+	return marshalMarkers(ctx, keeper, res.Markers, res.Pagination)
+}
+
+// Run gets the net asset values of a marker.
+func (params *GetNetAssetValues) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	if strings.TrimSpace(params.ID) == "" {
+		return nil, fmt.Errorf("wasm: marker id cannot be empty")
+	}
+	req := &types.QueryNetAssetValuesRequest{Id: params.ID, Pagination: params.Pagination}
+	res, err := keeper.NetAssetValues(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: marker net asset values query failed: %w", err)
+	}
+	navs := &NetAssetValues{Pagination: res.Pagination}
+	for _, nav := range res.NetAssetValues {
+		navs.NetAssetValues = append(navs.NetAssetValues, netAssetValueFor(nav))
+	}
+	return marshalResponse(navs)
+}
+
+// marshalMarkers converts the markers returned by a marker search to json.
+func marshalMarkers(ctx sdk.Context, keeper keeper.Keeper, anys []*codectypes.Any, pageRes *query.PageResponse) ([]byte, error) {
+	markers := &Markers{Pagination: pageRes}
+	for _, anyMarker := range anys {
+		markerAccount, ok := anyMarker.GetCachedValue().(*types.MarkerAccount)
+		if !ok {
+			return nil, fmt.Errorf("wasm: unable to type-cast marker account")
+		}
+		balance := keeper.GetEscrow(ctx, markerAccount)
+		markers.Markers = append(markers.Markers, createResponseType(markerAccount, balance))
+	}
+	return marshalResponse(markers)
+}
+
+// marshalResponse converts a marker query response to json.
+func marshalResponse(response interface{}) ([]byte, error) {
+	bz, err := json.Marshal(response)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: marshal marker query response failed: %w", err)
+	}
+	return bz, nil
+}
@@ -0,0 +1,391 @@
+package wasm_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/marker/types"
+	"github.com/provenance-io/provenance/x/marker/wasm"
+)
+
+func TestQuerier(t *testing.T) {
+	app := simapp.Setup(t)
+	blockTime := time.Now().UTC().Truncate(time.Second)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 5, Time: blockTime})
+	app.MarkerKeeper.SetParams(ctx, types.DefaultParams())
+
+	manager := sdk.AccAddress("manager_____________")
+	holder := sdk.AccAddress("holder______________")
+	denom := "wasmcoin"
+
+	mac := types.NewEmptyMarkerAccount(denom, manager.String(), []types.AccessGrant{*types.NewAccessGrant(manager,
+		[]types.Access{types.Access_Admin, types.Access_Mint, types.Access_Withdraw, types.Access_Transfer})})
+	require.NoError(t, mac.SetSupply(sdk.NewInt64Coin(denom, 1000)), "SetSupply")
+	mac.MarkerType = types.MarkerType_RestrictedCoin
+	mac.AllowForcedTransfer = true
+	mac.RequiredAttributes = []string{"kyc.provenance.io"}
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac), "AddMarkerAccount")
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, manager, denom), "FinalizeMarker")
+	require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, manager, denom), "ActivateMarker")
+	require.NoError(t, app.MarkerKeeper.MintCoin(ctx, manager, sdk.NewInt64Coin(denom, 100)), "MintCoin")
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, manager, holder, denom, sdk.NewCoins(sdk.NewInt64Coin(denom, 40))), "WithdrawCoins")
+	app.MarkerKeeper.SetTransferLimit(ctx, types.NewTransferLimit(denom, time.Hour, sdk.NewInt(500), sdk.NewInt(50)))
+	app.MarkerKeeper.AddNetAssetValue(ctx, mac.GetAddress(), types.NewNetAssetValue(sdk.NewInt64Coin("usd", 100), 10, "source"))
+	require.NoError(t, app.AttributeKeeper.SetAccountData(ctx, mac.GetAddress().String(), "some data"), "SetAccountData")
+	metadata := banktypes.Metadata{
+		Description: "a wasm coin",
+		DenomUnits:  []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+		Base:        denom,
+		Display:     denom,
+	}
+	app.BankKeeper.SetDenomMetaData(ctx, metadata)
+
+	otherMac := types.NewEmptyMarkerAccount("othercoin", holder.String(), nil)
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, otherMac), "AddMarkerAccount othercoin")
+
+	account, err := app.MarkerKeeper.GetMarkerByDenom(ctx, denom)
+	require.NoError(t, err, "GetMarkerByDenom")
+	escrow := sdk.NewCoins(sdk.NewInt64Coin(denom, 1060))
+	expMarker := &wasm.Marker{
+		AccountNumber:       account.GetAccountNumber(),
+		Address:             mac.GetAddress().String(),
+		Coins:               escrow,
+		Denom:               denom,
+		MarkerType:          wasm.MarkerTypeRestricted,
+		Permissions:         []*wasm.AccessGrant{{Address: manager.String(), Permissions: []wasm.MarkerPermission{"admin", "mint", "withdraw", "transfer"}}},
+		Sequence:            account.GetSequence(),
+		Status:              wasm.MarkerStatusActive,
+		TotalSupply:         "1100",
+		SupplyFixed:         true,
+		AllowForcedTransfer: true,
+		MaxSupply:           "0",
+	}
+	expOtherMarker := &wasm.Marker{
+		AccountNumber: otherMac.GetAccountNumber(),
+		Address:       otherMac.GetAddress().String(),
+		Coins:         sdk.Coins{},
+		Denom:         "othercoin",
+		Manager:       holder.String(),
+		MarkerType:    wasm.MarkerTypeCoin,
+		Status:        wasm.MarkerStatusProposed,
+		TotalSupply:   "0",
+		SupplyFixed:   true,
+		MaxSupply:     "0",
+	}
+
+	tests := []struct {
+		name    string
+		query   string
+		expErr  string
+		resp    interface{}
+		expResp interface{}
+	}{
+		{
+			name:   "invalid json",
+			query:  `{"marker":`,
+			expErr: "wasm: invalid query",
+		},
+		{
+			name:   "no marker params",
+			query:  `{}`,
+			expErr: "wasm: nil marker query params",
+		},
+		{
+			name:   "unknown query",
+			query:  `{"marker":{"unknown":{}}}`,
+			expErr: "wasm: invalid marker query",
+		},
+		{
+			name:    "marker by address",
+			query:   fmt.Sprintf(`{"marker":{"get_marker_by_address":{"address":"%s"}}}`, mac.GetAddress()),
+			resp:    &wasm.Marker{},
+			expResp: expMarker,
+		},
+		{
+			name:   "marker by address without an address",
+			query:  `{"marker":{"get_marker_by_address":{}}}`,
+			expErr: "wasm: marker address cannot be empty",
+		},
+		{
+			name:   "marker by address with an invalid address",
+			query:  `{"marker":{"get_marker_by_address":{"address":"bad"}}}`,
+			expErr: "wasm: address is invalid",
+		},
+		{
+			name:   "marker by address that is not a marker",
+			query:  fmt.Sprintf(`{"marker":{"get_marker_by_address":{"address":"%s"}}}`, holder),
+			expErr: "wasm: no marker found for address",
+		},
+		{
+			name:    "marker by denom",
+			query:   fmt.Sprintf(`{"marker":{"get_marker_by_denom":{"denom":"%s"}}}`, denom),
+			resp:    &wasm.Marker{},
+			expResp: expMarker,
+		},
+		{
+			name:   "marker by denom without a denom",
+			query:  `{"marker":{"get_marker_by_denom":{}}}`,
+			expErr: "wasm: marker denomination cannot be empty",
+		},
+		{
+			name:   "marker by unknown denom",
+			query:  `{"marker":{"get_marker_by_denom":{"denom":"nocoin"}}}`,
+			expErr: "wasm: no marker found for denomination 'nocoin'",
+		},
+		{
+			name:  "marker holders",
+			query: fmt.Sprintf(`{"marker":{"get_marker_holders":{"id":"%s"}}}`, denom),
+			resp:  &wasm.MarkerHolders{},
+			expResp: &wasm.MarkerHolders{
+				Holders: []*wasm.MarkerHolder{
+					{Address: mac.GetAddress().String(), Coins: escrow},
+					{Address: holder.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(denom, 40))},
+				},
+				Pagination: &query.PageResponse{Total: 2},
+			},
+		},
+		{
+			name:   "marker holders without an id",
+			query:  `{"marker":{"get_marker_holders":{}}}`,
+			expErr: "wasm: marker id cannot be empty",
+		},
+		{
+			name:    "marker supply",
+			query:   fmt.Sprintf(`{"marker":{"get_marker_supply":{"id":"%s"}}}`, denom),
+			resp:    &wasm.MarkerSupply{},
+			expResp: &wasm.MarkerSupply{Amount: sdk.NewInt64Coin(denom, 1100)},
+		},
+		{
+			name:   "marker supply of an unknown marker",
+			query:  `{"marker":{"get_marker_supply":{"id":"nocoin"}}}`,
+			expErr: "wasm: marker supply query failed",
+		},
+		{
+			name:    "marker escrow",
+			query:   fmt.Sprintf(`{"marker":{"get_marker_escrow":{"id":"%s"}}}`, denom),
+			resp:    &wasm.MarkerEscrow{},
+			expResp: &wasm.MarkerEscrow{Coins: escrow},
+		},
+		{
+			name:   "marker escrow without an id",
+			query:  `{"marker":{"get_marker_escrow":{}}}`,
+			expErr: "wasm: marker id cannot be empty",
+		},
+		{
+			name:    "marker access",
+			query:   fmt.Sprintf(`{"marker":{"get_marker_access":{"id":"%s"}}}`, denom),
+			resp:    &wasm.MarkerAccess{},
+			expResp: &wasm.MarkerAccess{Permissions: expMarker.Permissions},
+		},
+		{
+			name:   "marker access of an unknown marker",
+			query:  `{"marker":{"get_marker_access":{"id":"nocoin"}}}`,
+			expErr: "wasm: marker access query failed",
+		},
+		{
+			name:    "denom metadata",
+			query:   fmt.Sprintf(`{"marker":{"get_denom_metadata":{"denom":"%s"}}}`, denom),
+			resp:    &wasm.DenomMetadata{},
+			expResp: &wasm.DenomMetadata{Metadata: metadata},
+		},
+		{
+			name:   "denom metadata without a denom",
+			query:  `{"marker":{"get_denom_metadata":{}}}`,
+			expErr: "wasm: marker denomination cannot be empty",
+		},
+		{
+			name:    "marker account data",
+			query:   fmt.Sprintf(`{"marker":{"get_marker_account_data":{"denom":"%s"}}}`, denom),
+			resp:    &wasm.MarkerAccountData{},
+			expResp: &wasm.MarkerAccountData{Value: "some data"},
+		},
+		{
+			name:   "marker account data with an invalid denom",
+			query:  `{"marker":{"get_marker_account_data":{"denom":"1"}}}`,
+			expErr: "wasm: marker account data query failed",
+		},
+		{
+			name:  "transfer allowance",
+			query: fmt.Sprintf(`{"marker":{"get_transfer_allowance":{"id":"%s"}}}`, denom),
+			resp:  &wasm.TransferAllowance{},
+			expResp: &wasm.TransferAllowance{
+				Denom:           denom,
+				WindowSeconds:   3600,
+				MarkerLimit:     "500",
+				HolderLimit:     "50",
+				MarkerUsed:      "0",
+				MarkerRemaining: "500",
+			},
+		},
+		{
+			name:  "transfer allowance of a holder",
+			query: fmt.Sprintf(`{"marker":{"get_transfer_allowance":{"id":"%s","address":"%s"}}}`, denom, holder),
+			resp:  &wasm.TransferAllowance{},
+			expResp: &wasm.TransferAllowance{
+				Denom:           denom,
+				WindowSeconds:   3600,
+				MarkerLimit:     "500",
+				HolderLimit:     "50",
+				MarkerUsed:      "0",
+				MarkerRemaining: "500",
+				HolderUsed:      "0",
+				HolderRemaining: "50",
+			},
+		},
+		{
+			name:   "transfer allowance without an id",
+			query:  `{"marker":{"get_transfer_allowance":{}}}`,
+			expErr: "wasm: marker id cannot be empty",
+		},
+		{
+			name:   "transfer allowance of a marker without a limit",
+			query:  `{"marker":{"get_transfer_allowance":{"id":"othercoin"}}}`,
+			expErr: "wasm: marker transfer allowance query failed",
+		},
+		{
+			name:  "supply history",
+			query: fmt.Sprintf(`{"marker":{"get_supply_history":{"id":"%s"}}}`, denom),
+			resp:  &wasm.SupplyHistory{},
+			expResp: &wasm.SupplyHistory{
+				Entries: []*wasm.SupplyChange{{
+					Sequence:    1,
+					ChangeType:  "mint",
+					Amount:      "100",
+					Supply:      "1100",
+					Authority:   manager.String(),
+					BlockHeight: 5,
+					BlockTime:   blockTime,
+				}},
+				Pagination: &query.PageResponse{Total: 1},
+			},
+		},
+		{
+			name:   "supply history without an id",
+			query:  `{"marker":{"get_supply_history":{}}}`,
+			expErr: "wasm: marker id cannot be empty",
+		},
+		{
+			name:    "markers by manager",
+			query:   fmt.Sprintf(`{"marker":{"get_markers_by_manager":{"manager":"%s"}}}`, holder),
+			resp:    &wasm.Markers{},
+			expResp: &wasm.Markers{Markers: []*wasm.Marker{expOtherMarker}, Pagination: &query.PageResponse{Total: 1}},
+		},
+		{
+			name:   "markers by manager without a manager",
+			query:  `{"marker":{"get_markers_by_manager":{}}}`,
+			expErr: "wasm: manager address cannot be empty",
+		},
+		{
+			name:   "markers by manager with an invalid manager",
+			query:  `{"marker":{"get_markers_by_manager":{"manager":"bad"}}}`,
+			expErr: "wasm: markers by manager query failed",
+		},
+		{
+			name:    "markers by access holder",
+			query:   fmt.Sprintf(`{"marker":{"get_markers_by_access_holder":{"address":"%s","permission":"mint"}}}`, manager),
+			resp:    &wasm.Markers{},
+			expResp: &wasm.Markers{Markers: []*wasm.Marker{expMarker}, Pagination: &query.PageResponse{Total: 1}},
+		},
+		{
+			name:    "markers by access holder without the permission",
+			query:   fmt.Sprintf(`{"marker":{"get_markers_by_access_holder":{"address":"%s","permission":"burn"}}}`, manager),
+			resp:    &wasm.Markers{},
+			expResp: &wasm.Markers{Pagination: &query.PageResponse{}},
+		},
+		{
+			name:   "markers by access holder without an address",
+			query:  `{"marker":{"get_markers_by_access_holder":{}}}`,
+			expErr: "wasm: access holder address cannot be empty",
+		},
+		{
+			name:   "markers by access holder with an invalid permission",
+			query:  fmt.Sprintf(`{"marker":{"get_markers_by_access_holder":{"address":"%s","permission":"bogus"}}}`, manager),
+			expErr: "wasm: invalid marker permission: bogus",
+		},
+		{
+			name:    "markers by type",
+			query:   `{"marker":{"get_markers_by_type":{"marker_type":"restricted"}}}`,
+			resp:    &wasm.Markers{},
+			expResp: &wasm.Markers{Markers: []*wasm.Marker{expMarker}, Pagination: &query.PageResponse{Total: 1}},
+		},
+		{
+			name:   "markers by type without a type",
+			query:  `{"marker":{"get_markers_by_type":{}}}`,
+			expErr: "wasm: marker type cannot be empty",
+		},
+		{
+			name:   "markers by type with an invalid type",
+			query:  `{"marker":{"get_markers_by_type":{"marker_type":"bogus"}}}`,
+			expErr: "wasm: invalid marker type",
+		},
+		{
+			name:    "markers by forced transfer",
+			query:   `{"marker":{"get_markers_by_forced_transfer":{"allow_forced_transfer":true}}}`,
+			resp:    &wasm.Markers{},
+			expResp: &wasm.Markers{Markers: []*wasm.Marker{expMarker}, Pagination: &query.PageResponse{Total: 1}},
+		},
+		{
+			name:    "markers by required attribute",
+			query:   `{"marker":{"get_markers_by_required_attribute":{"attribute":"kyc.provenance.io"}}}`,
+			resp:    &wasm.Markers{},
+			expResp: &wasm.Markers{Markers: []*wasm.Marker{expMarker}, Pagination: &query.PageResponse{Total: 1}},
+		},
+		{
+			name:   "markers by required attribute without an attribute",
+			query:  `{"marker":{"get_markers_by_required_attribute":{}}}`,
+			expErr: "wasm: required attribute cannot be empty",
+		},
+		{
+			name:  "net asset values",
+			query: fmt.Sprintf(`{"marker":{"get_net_asset_values":{"id":"%s"}}}`, denom),
+			resp:  &wasm.NetAssetValues{},
+			expResp: &wasm.NetAssetValues{
+				NetAssetValues: []*wasm.NetAssetValue{{
+					Price:              sdk.NewInt64Coin("usd", 100),
+					Volume:             10,
+					Source:             "source",
+					Sequence:           1,
+					UpdatedBlockHeight: 5,
+				}},
+				Pagination: &query.PageResponse{Total: 1},
+			},
+		},
+		{
+			name:   "net asset values without an id",
+			query:  `{"marker":{"get_net_asset_values":{}}}`,
+			expErr: "wasm: marker id cannot be empty",
+		},
+		{
+			name:   "net asset values with an invalid id",
+			query:  `{"marker":{"get_net_asset_values":{"id":"1"}}}`,
+			expErr: "wasm: marker net asset values query failed",
+		},
+	}
+
+	querier := wasm.Querier(app.MarkerKeeper)
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			bz, err := querier(ctx, []byte(tc.query), "")
+			if len(tc.expErr) > 0 {
+				require.ErrorContains(t, err, tc.expErr, "querier error")
+				assert.Nil(t, bz, "querier response")
+				return
+			}
+			require.NoError(t, err, "querier error")
+			require.NoError(t, json.Unmarshal(bz, tc.resp), "unmarshal querier response")
+			assert.Equal(t, tc.expResp, tc.resp, "querier response")
+		})
+	}
+}
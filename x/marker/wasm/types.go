@@ -2,7 +2,12 @@
 package wasm
 
 import (
+	"strings"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/provenance-io/provenance/x/marker/types"
 )
@@ -25,6 +30,7 @@ type Marker struct {
 	TotalSupply         string         `json:"total_supply"`
 	SupplyFixed         bool           `json:"supply_fixed"`
 	AllowForcedTransfer bool           `json:"allow_forced_transfer"`
+	MaxSupply           string         `json:"max_supply"`
 }
 
 // AccessGrant are marker permissions granted to an account.
@@ -33,6 +39,93 @@ type AccessGrant struct {
 	Permissions []MarkerPermission `json:"permissions,omitempty"`
 }
 
+// MarkerHolders are the accounts holding a marker's coin.
+type MarkerHolders struct {
+	Holders    []*MarkerHolder     `json:"holders,omitempty"`
+	Pagination *query.PageResponse `json:"pagination,omitempty"`
+}
+
+// MarkerHolder is an account holding a marker's coin.
+type MarkerHolder struct {
+	Address string    `json:"address"`
+	Coins   sdk.Coins `json:"coins"`
+}
+
+// MarkerSupply is the total supply of a marker.
+type MarkerSupply struct {
+	Amount sdk.Coin `json:"amount"`
+}
+
+// MarkerEscrow are the coins held in escrow by a marker.
+type MarkerEscrow struct {
+	Coins sdk.Coins `json:"coins"`
+}
+
+// MarkerAccess are the permissions granted on a marker.
+type MarkerAccess struct {
+	Permissions []*AccessGrant `json:"permissions,omitempty"`
+}
+
+// DenomMetadata is the denom metadata of a marker.
+type DenomMetadata struct {
+	Metadata banktypes.Metadata `json:"metadata"`
+}
+
+// MarkerAccountData is the account data of a marker.
+type MarkerAccountData struct {
+	Value string `json:"value"`
+}
+
+// TransferAllowance is the transfer limit of a marker and how much of it is left in the current window.
+type TransferAllowance struct {
+	Denom           string `json:"denom"`
+	WindowSeconds   uint64 `json:"window_seconds"`
+	MarkerLimit     string `json:"marker_limit"`
+	HolderLimit     string `json:"holder_limit"`
+	MarkerUsed      string `json:"marker_used"`
+	MarkerRemaining string `json:"marker_remaining,omitempty"`
+	HolderUsed      string `json:"holder_used,omitempty"`
+	HolderRemaining string `json:"holder_remaining,omitempty"`
+}
+
+// SupplyHistory are the recorded supply changes of a marker.
+type SupplyHistory struct {
+	Entries    []*SupplyChange     `json:"entries,omitempty"`
+	Pagination *query.PageResponse `json:"pagination,omitempty"`
+}
+
+// SupplyChange is a single recorded supply change of a marker.
+type SupplyChange struct {
+	Sequence    uint64    `json:"sequence"`
+	ChangeType  string    `json:"change_type"`
+	Amount      string    `json:"amount"`
+	Supply      string    `json:"supply"`
+	Authority   string    `json:"authority"`
+	BlockHeight int64     `json:"block_height"`
+	BlockTime   time.Time `json:"block_time"`
+}
+
+// Markers are the markers matching a marker search.
+type Markers struct {
+	Markers    []*Marker           `json:"markers,omitempty"`
+	Pagination *query.PageResponse `json:"pagination,omitempty"`
+}
+
+// NetAssetValues are the net asset values reported for a marker.
+type NetAssetValues struct {
+	NetAssetValues []*NetAssetValue    `json:"net_asset_values,omitempty"`
+	Pagination     *query.PageResponse `json:"pagination,omitempty"`
+}
+
+// NetAssetValue is a single net asset value reported for a marker.
+type NetAssetValue struct {
+	Price              sdk.Coin `json:"price"`
+	Volume             uint64   `json:"volume"`
+	Source             string   `json:"source"`
+	Sequence           uint64   `json:"sequence"`
+	UpdatedBlockHeight int64    `json:"updated_block_height"`
+}
+
 // MarkerType defines types of markers.
 type MarkerType string
 
@@ -99,6 +192,7 @@ func createResponseType(input *types.MarkerAccount, balance sdk.Coins) *Marker {
 		TotalSupply:         input.GetSupply().Amount.String(),
 		SupplyFixed:         input.SupplyFixed,
 		AllowForcedTransfer: input.AllowForcedTransfer,
+		MaxSupply:           input.GetMaxSupply().String(),
 	}
 	for _, ag := range input.GetAccessList() {
 		marker.Permissions = append(marker.Permissions, accessGrantFor(ag))
@@ -168,3 +262,48 @@ func permissionFor(input types.Access) MarkerPermission {
 		return MarkerPermissionUnspecified
 	}
 }
+
+// Adapt the core transfer allowance query response to provwasm format.
+func transferAllowanceFor(input *types.QueryTransferAllowanceResponse) *TransferAllowance {
+	allowance := &TransferAllowance{
+		Denom:         input.Limit.Denom,
+		WindowSeconds: uint64(input.Limit.Window / time.Second),
+		MarkerLimit:   input.Limit.MarkerLimit.String(),
+		HolderLimit:   input.Limit.HolderLimit.String(),
+		MarkerUsed:    input.MarkerUsed.String(),
+	}
+	if input.MarkerRemaining != nil {
+		allowance.MarkerRemaining = input.MarkerRemaining.String()
+	}
+	if input.HolderUsed != nil {
+		allowance.HolderUsed = input.HolderUsed.String()
+	}
+	if input.HolderRemaining != nil {
+		allowance.HolderRemaining = input.HolderRemaining.String()
+	}
+	return allowance
+}
+
+// Adapt the core supply history entry to provwasm format.
+func supplyChangeFor(input types.SupplyHistoryEntry) *SupplyChange {
+	return &SupplyChange{
+		Sequence:    input.Sequence,
+		ChangeType:  strings.ToLower(strings.TrimPrefix(input.ChangeType.String(), "SUPPLY_CHANGE_TYPE_")),
+		Amount:      input.Amount.String(),
+		Supply:      input.Supply.String(),
+		Authority:   input.Authority,
+		BlockHeight: input.BlockHeight,
+		BlockTime:   input.BlockTime,
+	}
+}
+
+// Adapt the core net asset value to provwasm format.
+func netAssetValueFor(input types.NetAssetValue) *NetAssetValue {
+	return &NetAssetValue{
+		Price:              input.Price,
+		Volume:             input.Volume,
+		Source:             input.Source,
+		Sequence:           input.Sequence,
+		UpdatedBlockHeight: input.UpdatedBlockHeight,
+	}
+}
@@ -0,0 +1,15 @@
+package metadata
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/keeper"
+)
+
+// MaxExpiredScopeListingCount is the maximum number of expired scope listings removed in a block.
+const MaxExpiredScopeListingCount = 10_000
+
+// BeginBlocker is called at the beginning of every block
+func BeginBlocker(ctx sdk.Context, keeper keeper.Keeper) {
+	keeper.RemoveExpiredScopeListings(ctx, MaxExpiredScopeListingCount)
+}
@@ -348,7 +348,7 @@ specification_id: %s`,
 		s.contractSpecID,
 	)
 
-	s.recordAsJson = fmt.Sprintf("{\"name\":\"recordname\",\"session_id\":\"%s\",\"process\":{\"hash\":\"notarealprocesshash\",\"name\":\"record process\",\"method\":\"myMethod\"},\"inputs\":[{\"name\":\"inputname\",\"hash\":\"notarealrecordinputhash\",\"type_name\":\"inputtypename\",\"status\":\"RECORD_INPUT_STATUS_RECORD\"}],\"outputs\":[{\"hash\":\"notarealrecordoutputhash\",\"status\":\"RESULT_STATUS_PASS\"}],\"specification_id\":\"%s\"}",
+	s.recordAsJson = fmt.Sprintf("{\"name\":\"recordname\",\"session_id\":\"%s\",\"process\":{\"hash\":\"notarealprocesshash\",\"name\":\"record process\",\"method\":\"myMethod\"},\"inputs\":[{\"name\":\"inputname\",\"hash\":\"notarealrecordinputhash\",\"type_name\":\"inputtypename\",\"status\":\"RECORD_INPUT_STATUS_RECORD\"}],\"outputs\":[{\"hash\":\"notarealrecordoutputhash\",\"status\":\"RESULT_STATUS_PASS\"}],\"specification_id\":\"%s\",\"value\":null}",
 		s.sessionID,
 		s.recordSpecID,
 	)
@@ -389,7 +389,7 @@ specification_id: %s`,
 		s.scopeSpecID,
 	)
 
-	s.contractSpecAsJson = fmt.Sprintf("{\"specification_id\":\"%s\",\"description\":null,\"owner_addresses\":[\"%s\"],\"parties_involved\":[\"PARTY_TYPE_OWNER\"],\"hash\":\"notreallyasourcehash\",\"class_name\":\"contractclassname\"}",
+	s.contractSpecAsJson = fmt.Sprintf("{\"specification_id\":\"%s\",\"description\":null,\"owner_addresses\":[\"%s\"],\"parties_involved\":[\"PARTY_TYPE_OWNER\"],\"hash\":\"notreallyasourcehash\",\"class_name\":\"contractclassname\",\"value_schemas\":[]}",
 		s.contractSpecID,
 		s.user1AddrStr,
 	)
@@ -660,12 +660,12 @@ func (s *IntegrationCLITestSuite) TestGetMetadataParamsCmd() {
 		{
 			name:   "get params as json output",
 			args:   []string{s.asJson},
-			expOut: []string{"\"params\":{}"},
+			expOut: []string{"\"params\":{\"max_history_versions\":10,\"max_scope_owners\":100,\"max_data_access\":100,\"max_records_per_scope\":10000,\"max_record_inputs\":100,\"max_record_outputs\":100,\"max_session_context_size\":10240}"},
 		},
 		{
 			name:   "get params as text output",
 			args:   []string{s.asText},
-			expOut: []string{"params: {}"},
+			expOut: []string{"params:", "max_history_versions: 10", "max_scope_owners: 100", "max_session_context_size: 10240"},
 		},
 		{
 			name:   "get params - invalid args",
@@ -675,7 +675,7 @@ func (s *IntegrationCLITestSuite) TestGetMetadataParamsCmd() {
 		{
 			name:   "get params as json output including request",
 			args:   []string{s.asJson, s.includeRequest},
-			expOut: []string{"\"params\":{}", "\"request\":{\"include_request\":true}"},
+			expOut: []string{"\"params\":{\"max_history_versions\":10,\"max_scope_owners\":100,\"max_data_access\":100,\"max_records_per_scope\":10000,\"max_record_inputs\":100,\"max_record_outputs\":100,\"max_session_context_size\":10240}", "\"request\":{\"include_request\":true}"},
 		},
 		{
 			name:   "get locator params as json",
@@ -34,6 +34,16 @@ var (
 
 const all = "all"
 
+// Flags for the history and lineage queries.
+const (
+	// FlagHeight is the flag for the block height to look up a version at.
+	FlagHeight = "at-height"
+	// FlagDirection is the flag for which way to walk a record's lineage.
+	FlagDirection = "direction"
+	// FlagMaxDepth is the flag for how far to walk a record's lineage.
+	FlagMaxDepth = "max-depth"
+)
+
 // GetQueryCmd returns the top-level command for marker CLI queries.
 func GetQueryCmd() *cobra.Command {
 	queryCmd := &cobra.Command{
@@ -58,6 +68,12 @@ func GetQueryCmd() *cobra.Command {
 		GetValueOwnershipCmd(),
 		GetOSLocatorCmd(),
 		GetAccountDataCmd(),
+		GetScopeListingCmd(),
+		GetRecordHistoryCmd(),
+		GetScopeHistoryCmd(),
+		GetRecordsByHashCmd(),
+		GetRecordLineageCmd(),
+		GetLockedScopesCmd(),
 	)
 	return queryCmd
 }
@@ -600,6 +616,245 @@ func GetAccountDataCmd() *cobra.Command {
 	return cmd
 }
 
+// GetScopeListingCmd is the CLI command for querying the offer to sell the value ownership of a scope.
+func GetScopeListingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "scope-listing <scope id>",
+		Short:   "Get the offer to sell the value ownership of a scope",
+		Aliases: []string{"listing", "sl"},
+		Example: fmt.Sprintf(`$ %s scope-listing scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel`, cmdStart),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.ScopeListing(cmd.Context(), &types.ScopeListingRequest{ScopeId: args[0]})
+			if err != nil {
+				return fmt.Errorf("failed to query scope listing for %q: %w", args[0], err)
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetRecordHistoryCmd is the CLI command for querying the stored versions of a record.
+func GetRecordHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "record-history <record id>",
+		Short:   "Get the stored versions of a record",
+		Aliases: []string{"rh"},
+		Example: fmt.Sprintf(`$ %[1]s record-history record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3
+$ %[1]s record-history record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3 --%[2]s 1000`, cmdStart, FlagHeight),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			height, err := cmd.Flags().GetInt64(FlagHeight)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.RecordHistory(cmd.Context(), &types.RecordHistoryRequest{RecordAddr: args[0], Height: height, Pagination: pageReq})
+			if err != nil {
+				return fmt.Errorf("failed to query record history for %q: %w", args[0], err)
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	cmd.Flags().Int64(FlagHeight, 0, "Only get the version in effect at this block height")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "versions")
+	return cmd
+}
+
+// GetScopeHistoryCmd is the CLI command for querying the stored versions of a scope's owners and value owner.
+func GetScopeHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "scope-history <scope id>",
+		Short:   "Get the stored versions of a scope's owners and value owner",
+		Aliases: []string{"sh"},
+		Example: fmt.Sprintf(`$ %[1]s scope-history scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel
+$ %[1]s scope-history 91978ba2-5f35-459a-86a7-feca1b0512e0 --%[2]s 1000`, cmdStart, FlagHeight),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			height, err := cmd.Flags().GetInt64(FlagHeight)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.ScopeHistory(cmd.Context(), &types.ScopeHistoryRequest{ScopeId: args[0], Height: height, Pagination: pageReq})
+			if err != nil {
+				return fmt.Errorf("failed to query scope history for %q: %w", args[0], err)
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	cmd.Flags().Int64(FlagHeight, 0, "Only get the version in effect at this block height")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "versions")
+	return cmd
+}
+
+// GetRecordsByHashCmd is the CLI command for querying the records that have an output or hash input with a hash.
+func GetRecordsByHashCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "records-by-hash <hash>",
+		Short:   "Get the records that have an output or hash input with the provided hash",
+		Aliases: []string{"rbh"},
+		Example: fmt.Sprintf(`$ %s records-by-hash 2F8oNV9xrxfTBrkWWrQl3wljtV9WZ5BlN4ZKS7Dc3Pw=`, cmdStart),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.RecordsByHash(cmd.Context(), &types.RecordsByHashRequest{
+				Hash:           args[0],
+				ExcludeIdInfo:  excludeIDInfo,
+				IncludeRequest: includeRequest,
+				Pagination:     pageReq,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to query records by hash %q: %w", args[0], err)
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	addExcludeIDInfoFlag(cmd)
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "records")
+	return cmd
+}
+
+// GetRecordLineageCmd is the CLI command for querying the graph of records connected to a record through record inputs.
+func GetRecordLineageCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "record-lineage <record id>",
+		Short:   "Get the graph of records connected to a record through record inputs",
+		Aliases: []string{"lineage", "rl"},
+		Example: fmt.Sprintf(`$ %[1]s record-lineage record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3
+$ %[1]s record-lineage record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3 --%[2]s upstream --%[3]s 10`,
+			cmdStart, FlagDirection, FlagMaxDepth),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			directionStr, err := cmd.Flags().GetString(FlagDirection)
+			if err != nil {
+				return err
+			}
+			direction, err := parseLineageDirection(directionStr)
+			if err != nil {
+				return err
+			}
+			maxDepth, err := cmd.Flags().GetUint32(FlagMaxDepth)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.RecordLineage(cmd.Context(), &types.RecordLineageRequest{
+				RecordAddr: args[0],
+				Direction:  direction,
+				MaxDepth:   maxDepth,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to query record lineage for %q: %w", args[0], err)
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	cmd.Flags().String(FlagDirection, "both", "Which way to walk from the record: upstream, downstream or both")
+	cmd.Flags().Uint32(FlagMaxDepth, 0, fmt.Sprintf("The number of record input references to follow (default %d)", types.DefaultLineageDepth))
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetLockedScopesCmd is the CLI command for querying all of the legal holds on scopes.
+func GetLockedScopesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "locked-scopes",
+		Short:   "Get all of the legal holds on scopes",
+		Example: fmt.Sprintf(`$ %s locked-scopes`, cmdStart),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.LockedScopes(cmd.Context(), &types.LockedScopesRequest{Pagination: pageReq})
+			if err != nil {
+				return fmt.Errorf("failed to query locked scopes: %w", err)
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "locked scopes")
+	return cmd
+}
+
+// parseLineageDirection converts the provided string into a LineageDirection.
+func parseLineageDirection(direction string) (types.LineageDirection, error) {
+	switch strings.ToLower(strings.TrimSpace(direction)) {
+	case "up", "upstream":
+		return types.LineageDirection_LINEAGE_DIRECTION_UPSTREAM, nil
+	case "down", "downstream":
+		return types.LineageDirection_LINEAGE_DIRECTION_DOWNSTREAM, nil
+	case "", "both":
+		return types.LineageDirection_LINEAGE_DIRECTION_BOTH, nil
+	}
+	return types.LineageDirection_LINEAGE_DIRECTION_UNSPECIFIED, fmt.Errorf("unknown direction %q: expected upstream, downstream or both", direction)
+}
+
 // ------------ private funcs for actually querying and outputting ------------
 
 // outputParams calls the Params query and outputs the response.
@@ -3,7 +3,10 @@ package cli
 import (
 	"encoding/base64"
 	"fmt"
+	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/spf13/cobra"
@@ -13,6 +16,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	attrcli "github.com/provenance-io/provenance/x/attribute/client/cli"
 	"github.com/provenance-io/provenance/x/metadata/types"
@@ -21,6 +28,14 @@ import (
 const (
 	FlagSigners            = "signers"
 	FlagRequirePartyRollup = "require-party-rollup"
+	FlagValue              = "value"
+	FlagValueType          = "value-type"
+	FlagValueSchema        = "value-schema"
+	FlagScopeIDs           = "scope-ids"
+	FlagScopeSpecIDs       = "scope-spec-ids"
+	FlagRecordNames        = "record-names"
+	FlagUses               = "uses"
+	FlagExpiration         = "expiration"
 	AddSwitch              = "add"
 	RemoveSwitch           = "remove"
 )
@@ -43,6 +58,11 @@ func NewTxCmd() *cobra.Command {
 		AddRemoveScopeOwnersCmd(),
 		UpdateValueOwnersCmd(),
 		MigrateValueOwnerCmd(),
+		ListScopeForSaleCmd(),
+		CancelScopeListingCmd(),
+		BuyScopeCmd(),
+		LockScopeCmd(),
+		UnlockScopeCmd(),
 
 		BindOsLocatorCmd(),
 		RemoveOsLocatorCmd(),
@@ -50,6 +70,7 @@ func NewTxCmd() *cobra.Command {
 
 		WriteScopeSpecificationCmd(),
 		RemoveScopeSpecificationCmd(),
+		MigrateScopesToSpecCmd(),
 
 		WriteContractSpecificationCmd(),
 		RemoveContractSpecificationCmd(),
@@ -64,8 +85,13 @@ func NewTxCmd() *cobra.Command {
 
 		WriteRecordCmd(),
 		RemoveRecordCmd(),
+		WriteScopeBundleCmd(),
 
 		SetAccountDataCmd(),
+
+		UpdateParamsCmd(),
+
+		GrantMetadataAuthorizationCmd(),
 	)
 
 	return txCmd
@@ -405,6 +431,230 @@ func MigrateValueOwnerCmd() *cobra.Command {
 	return cmd
 }
 
+// ListScopeForSaleCmd creates a command for offering the value ownership of a scope for a price.
+func ListScopeForSaleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list-scope-for-sale <scope id> <price> <expiration>",
+		Aliases: []string{"list-scope", "lsfs"},
+		Short:   "Offer the value ownership of a scope for a price until an expiration time (RFC 3339).",
+		Example: fmt.Sprintf(`$ %[1]s tx metadata list-scope-for-sale scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn 1000nhash 2030-01-01T00:00:00Z`,
+			version.AppName),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			scopeID, err := types.MetadataAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid scope id %q: %w", args[0], err)
+			}
+
+			price, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid price %q: %w", args[1], err)
+			}
+
+			expiration, err := time.Parse(time.RFC3339, args[2])
+			if err != nil {
+				return fmt.Errorf("invalid expiration %q: %w", args[2], err)
+			}
+
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgListScopeForSaleRequest(scopeID, price, expiration, signers)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addSignersFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CancelScopeListingCmd creates a command for removing an offer to sell the value ownership of a scope.
+func CancelScopeListingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel-scope-listing <scope id>",
+		Aliases: []string{"csl"},
+		Short:   "Remove the offer to sell the value ownership of a scope.",
+		Example: fmt.Sprintf(`$ %[1]s tx metadata cancel-scope-listing scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			scopeID, err := types.MetadataAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid scope id %q: %w", args[0], err)
+			}
+
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelScopeListingRequest(scopeID, signers)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addSignersFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// BuyScopeCmd creates a command for buying the value ownership of a listed scope.
+func BuyScopeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "buy-scope <scope id> <price>",
+		Aliases: []string{"bs"},
+		Short:   "Pay the listing price of a scope and become its value owner.",
+		Long: `Pay the listing price of a scope and become its value owner.
+The buyer is the --from account. The price must equal the price of the scope's listing.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata buy-scope scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn 1000nhash`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			scopeID, err := types.MetadataAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid scope id %q: %w", args[0], err)
+			}
+
+			price, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid price %q: %w", args[1], err)
+			}
+
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBuyScopeRequest(scopeID, clientCtx.GetFromAddress(), price, signers)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addSignersFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// LockScopeCmd creates a command for placing a legal hold on a scope.
+func LockScopeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "lock-scope <scope id> <reason>",
+		Aliases: []string{"ls"},
+		Short:   "Place a legal hold on a scope so that it cannot be changed.",
+		Long: `Place a legal hold on a scope so that it cannot be changed.
+While locked, the scope's owners, value owner, sessions and records cannot be changed, and it cannot be deleted.
+A scope can be locked by governance, or by a scope owner with the CUSTODIAN or CONTROLLER role.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata lock-scope scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn "case 2023-cv-0042"`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			scopeID, err := types.MetadataAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid scope id %q: %w", args[0], err)
+			}
+
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgLockScopeRequest(scopeID, args[1], signers)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addSignersFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// UnlockScopeCmd creates a command for removing the legal hold on a scope.
+func UnlockScopeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "unlock-scope <scope id>",
+		Aliases: []string{"us"},
+		Short:   "Remove the legal hold on a scope.",
+		Long: `Remove the legal hold on a scope.
+A scope can only be unlocked by the address that locked it, or by governance.
+A scope locked by governance can only be unlocked by governance.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata unlock-scope scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			scopeID, err := types.MetadataAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid scope id %q: %w", args[0], err)
+			}
+
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnlockScopeRequest(scopeID, signers)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addSignersFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // BindOsLocatorCmd creates a command for binding an owner to uri in the object store.
 func BindOsLocatorCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -580,7 +830,8 @@ classname          - name of contract specification
 description-name   - description name identifier (optional)
 description        - description text (optional, can only be provided with a description-name)
 website-url        - address of website (optional, can only be provided with a description)
-icon-url           - address to a image to be used as an icon (optional, can only be provided with an website-url)`,
+icon-url           - address to a image to be used as an icon (optional, can only be provided with an website-url)
+Schemas for inline record values can be provided using the --value-schema flag (repeatable), formatted as <type-name>=<json-schema>.`,
 		Example: fmt.Sprintf(`$ %[1]s tx metadata write-contract-specification contractspec1q0w6ys5g6jm509v2830374aprsrq260w62 pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "owner" "hashvalue" "myclassname" --from=mykey`, version.AppName),
 		Args:    cobra.RangeArgs(5, 9),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -617,6 +868,18 @@ icon-url           - address to a image to be used as an icon (optional, can onl
 				}
 			}
 
+			valueSchemas, err := cmd.Flags().GetStringArray(FlagValueSchema)
+			if err != nil {
+				return err
+			}
+			for _, valueSchema := range valueSchemas {
+				parts := strings.SplitN(valueSchema, "=", 2)
+				if len(parts) != 2 {
+					return fmt.Errorf("invalid value schema %q: expected format <type-name>=<json-schema>", valueSchema)
+				}
+				contractSpecification.ValueSchemas = append(contractSpecification.ValueSchemas, *types.NewValueSchema(parts[0], parts[1]))
+			}
+
 			signers, err := parseSigners(cmd, &clientCtx)
 			if err != nil {
 				return err
@@ -631,6 +894,7 @@ icon-url           - address to a image to be used as an icon (optional, can onl
 		},
 	}
 	addSignersFlagToCmd(cmd)
+	cmd.Flags().StringArray(FlagValueSchema, nil, "A schema for inline record values, formatted as <type-name>=<json-schema> (repeatable)")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -827,7 +1091,9 @@ contract-spec-id  - a bech32 address string for a contract specification - If pr
 session-id        - a bech32 address string for the session this record belongs to
   Either a contract-spec-id or a session-id must be provided (but not both).
   If a contract-spec-id is provided, a new session will be created using it as the specification for the session, and the record will be part of that session.
-  If a session-id is provided, the record will be part of that session (a new session is NOT created).`,
+  If a session-id is provided, the record will be part of that session (a new session is NOT created).
+An inline value can be stored with the record using the --value and --value-type flags.
+  The value must be json, and the value type must equal the type name of the record specification.`,
 		Example: fmt.Sprintf(`$ %[1]s tx metadata write-record scope1qp... \
 recspec1qh... \
 recordname \
@@ -896,6 +1162,18 @@ contractspec-name
 				Outputs:         outputs,
 			}
 
+			value, err := cmd.Flags().GetString(FlagValue)
+			if err != nil {
+				return err
+			}
+			valueType, err := cmd.Flags().GetString(FlagValueType)
+			if err != nil {
+				return err
+			}
+			if len(value) > 0 || len(valueType) > 0 {
+				record.Value = types.NewRecordValue(valueType, value)
+			}
+
 			contractOrSessionID, err := types.MetadataAddressFromBech32(args[7])
 			if err != nil {
 				return err
@@ -935,6 +1213,8 @@ contractspec-name
 	}
 
 	addSignersFlagToCmd(cmd)
+	cmd.Flags().String(FlagValue, "", "The json of an inline value to store with the record")
+	cmd.Flags().String(FlagValueType, "", "The type name of the inline value, must equal the record specification type name")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -1051,6 +1331,60 @@ func RemoveScopeSpecificationCmd() *cobra.Command {
 	return cmd
 }
 
+// MigrateScopesToSpecCmd creates a command to move scopes from one scope specification to another.
+func MigrateScopesToSpecCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-scopes-to-spec <from-specification-id> <to-specification-id> [<scope-id> ...]",
+		Short: "Move scopes from one scope specification to another",
+		Long: `Move scopes from one scope specification to another.
+If no scope ids are provided, all scopes using the from specification are moved.
+Scopes that do not satisfy the to specification are left alone and listed as failures in the response.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata migrate-scopes-to-spec scopespec1qs30c9axgrw5669ft0kffe6h9gysfe58v3 scopespec1qjpreurq8n7ylc3plfp7mxdp5t5qcm9wnw --from=mykey
+$ %[1]s tx metadata migrate-scopes-to-spec scopespec1qs30c9axgrw5669ft0kffe6h9gysfe58v3 scopespec1qjpreurq8n7ylc3plfp7mxdp5t5qcm9wnw scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel --from=mykey`, version.AppName),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromSpecID, err := types.MetadataAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			toSpecID, err := types.MetadataAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			scopeIDs := make([]types.MetadataAddress, len(args)-2)
+			for i, arg := range args[2:] {
+				scopeIDs[i], err = types.MetadataAddressFromBech32(arg)
+				if err != nil {
+					return err
+				}
+			}
+
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMigrateScopesToSpecRequest(fromSpecID, toSpecID, scopeIDs, signers)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addSignersFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // RemoveContractSpecificationCmd creates a command to remove a contract specification
 func RemoveContractSpecificationCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -1166,6 +1500,50 @@ func RemoveRecordCmd() *cobra.Command {
 	return cmd
 }
 
+// WriteScopeBundleCmd creates a command to add/update a scope along with sessions and records in it.
+func WriteScopeBundleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "write-scope-bundle <bundle json file>",
+		Short: "Add/Update a scope along with sessions and records in it",
+		Long: strings.TrimSpace(`Add/Update a scope along with sessions and records in it.
+The bundle is provided as a json file with the scope, sessions and records fields of a MsgWriteScopeBundleRequest.
+The signers are taken from the --signers flag (or --from if not provided), not from the file.
+`),
+		Example: fmt.Sprintf("$ %s tx metadata write-scope-bundle bundle.json --from=mykey", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var msg types.MsgWriteScopeBundleRequest
+			if err = clientCtx.Codec.UnmarshalJSON(contents, &msg); err != nil {
+				return fmt.Errorf("invalid scope bundle in %s: %w", args[0], err)
+			}
+
+			msg.Signers, err = parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	addSignersFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // RemoveRecordSpecificationCmd creates  a command to remove a record specification
 func RemoveRecordSpecificationCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -1250,6 +1628,92 @@ $ %[1]s tx metadata account-data %[2]s --%[5]s
 	return cmd
 }
 
+// GrantMetadataAuthorizationCmd creates a command for granting a MetadataAuthorization to an address.
+func GrantMetadataAuthorizationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "grant-authz <grantee> <msg type url>",
+		Aliases: []string{"ga"},
+		Short:   "Grant an address permission to sign a metadata message for you",
+		Long: `Grant an address permission to sign a metadata message for you.
+The permission can be restricted to specific scopes, scope specifications and record names,
+and can be limited to a number of uses. It can be revoked using the authz module's revoke command.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata grant-authz pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 /provenance.metadata.v1.MsgWriteRecordRequest \
+  --scope-ids scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn --record-names loan,note --uses 10`, version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid grantee %q: %w", args[0], err)
+			}
+
+			scopeIDs, err := parseMetadataAddressesFlag(cmd, FlagScopeIDs)
+			if err != nil {
+				return err
+			}
+			scopeSpecIDs, err := parseMetadataAddressesFlag(cmd, FlagScopeSpecIDs)
+			if err != nil {
+				return err
+			}
+			recordNames, err := cmd.Flags().GetStringSlice(FlagRecordNames)
+			if err != nil {
+				return err
+			}
+			uses, err := cmd.Flags().GetUint32(FlagUses)
+			if err != nil {
+				return err
+			}
+			expSec, err := cmd.Flags().GetInt64(FlagExpiration)
+			if err != nil {
+				return err
+			}
+
+			authorization := types.NewMetadataAuthorization(args[1], scopeIDs, scopeSpecIDs, recordNames, uses)
+			if err = authorization.ValidateBasic(); err != nil {
+				return err
+			}
+
+			exp := time.Unix(expSec, 0)
+			msg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, &exp)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagScopeIDs, nil, "The scopes that the grantee can sign for (default any)")
+	cmd.Flags().StringSlice(FlagScopeSpecIDs, nil, "The scope specifications that the grantee can sign for (default any)")
+	cmd.Flags().StringSlice(FlagRecordNames, nil, "The record names that the grantee can sign for (default any)")
+	cmd.Flags().Uint32(FlagUses, 0, "The number of times the grant can be used (default unlimited)")
+	cmd.Flags().Int64(FlagExpiration, time.Now().AddDate(1, 0, 0).Unix(), "The Unix timestamp. Default is one year.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseMetadataAddressesFlag gets the metadata addresses provided to a string slice flag.
+func parseMetadataAddressesFlag(cmd *cobra.Command, flagName string) ([]types.MetadataAddress, error) {
+	vals, err := cmd.Flags().GetStringSlice(flagName)
+	if err != nil {
+		return nil, err
+	}
+	var rv []types.MetadataAddress
+	for _, val := range vals {
+		addr, err := types.MetadataAddressFromBech32(val)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --%s value %q: %w", flagName, val, err)
+		}
+		rv = append(rv, addr)
+	}
+	return rv, nil
+}
+
 // addSignersFlagToCmd adds the standard --signers flag to a command.
 // See also: parseSigners.
 func addSignersFlagToCmd(cmd *cobra.Command) {
@@ -1286,3 +1750,43 @@ func validateAccAddress(addr, argName string) (string, error) {
 	}
 	return addr, nil
 }
+
+// UpdateParamsCmd creates a command to submit a governance proposal to update the metadata module's params.
+func UpdateParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-params <max-history-versions> <max-scope-owners> <max-data-access> <max-records-per-scope> <max-record-inputs> <max-record-outputs> <max-session-context-size>",
+		Short: "Submit a governance proposal to update the metadata module's params",
+		Long: strings.TrimSpace(`Submit a governance proposal to update the metadata module's params.
+All params must be provided. Other than max-history-versions, a value of zero means there is no limit.
+`),
+		Example: fmt.Sprintf("$ %s tx metadata update-params 10 100 100 10000 100 100 10240 --deposit 50000nhash", version.AppName),
+		Args:    cobra.ExactArgs(7),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			vals := make([]uint32, len(args))
+			for i, arg := range args {
+				val, err := strconv.ParseUint(arg, 10, 32)
+				if err != nil {
+					return fmt.Errorf("invalid param value %q: %w", arg, err)
+				}
+				vals[i] = uint32(val)
+			}
+			params := types.NewParams(vals[0], vals[1], vals[2], vals[3], vals[4], vals[5], vals[6])
+
+			msg := types.NewMsgUpdateParamsRequest(authtypes.NewModuleAddress(govtypes.ModuleName).String(), params)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return govcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	govcli.AddGovPropFlagsToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -25,3 +25,8 @@ type AttrKeeper interface {
 	GetAccountData(ctx sdk.Context, addr string) (string, error)
 	SetAccountData(ctx sdk.Context, addr string, value string) error
 }
+
+// BankKeeper defines the bank functionality needed by the metadata module.
+type BankKeeper interface {
+	SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+}
@@ -61,6 +61,22 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 			}
 		}
 	}
+	for _, listing := range data.ScopeListings {
+		k.SetScopeListing(ctx, listing)
+	}
+	for _, lock := range data.ScopeLocks {
+		k.SetScopeLock(ctx, lock)
+	}
+
+	// Setting the scopes and records above wrote versions for them at the genesis height.
+	// Those are replaced with the history from the genesis state so that it's imported exactly.
+	k.clearVersionHistory(ctx)
+	for _, version := range data.RecordVersions {
+		k.SetRecordVersion(ctx, version)
+	}
+	for _, version := range data.ScopeVersions {
+		k.SetScopeVersion(ctx, version)
+	}
 }
 
 // ExportGenesis exports the current keeper state of the metadata module.ExportGenesis
@@ -74,6 +90,10 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 	contractSpecs := make([]types.ContractSpecification, 0)
 	recordSpecs := make([]types.RecordSpecification, 0)
 	objectStoreLocators := make([]types.ObjectStoreLocator, 0)
+	scopeListings := make([]types.ScopeListing, 0)
+	recordVersions := make([]types.RecordVersion, 0)
+	scopeVersions := make([]types.ScopeVersion, 0)
+	scopeLocks := make([]types.ScopeLock, 0)
 
 	appendToScopes := func(scope types.Scope) bool {
 		scopes = append(scopes, scope)
@@ -134,5 +154,38 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		panic(err)
 	}
 
-	return types.NewGenesisState(params, oslocatorparams, scopes, sessions, records, scopeSpecs, contractSpecs, recordSpecs, objectStoreLocators)
+	err := k.IterateScopeListings(ctx, func(listing types.ScopeListing) bool {
+		scopeListings = append(scopeListings, listing)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.IterateRecordVersions(ctx, func(version types.RecordVersion) bool {
+		recordVersions = append(recordVersions, version)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.IterateScopeVersions(ctx, func(version types.ScopeVersion) bool {
+		scopeVersions = append(scopeVersions, version)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.IterateScopeLocks(ctx, func(lock types.ScopeLock) bool {
+		scopeLocks = append(scopeLocks, lock)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.NewGenesisState(params, oslocatorparams, scopes, sessions, records, scopeSpecs, contractSpecs, recordSpecs,
+		objectStoreLocators, scopeListings, recordVersions, scopeVersions, scopeLocks)
 }
@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// addRecordVersion stores the provided record as the version of the record written in the current block,
+// then prunes the record's oldest versions so that at most MaxHistoryVersions are kept (zero means no limit).
+func (k Keeper) addRecordVersion(ctx sdk.Context, recordID types.MetadataAddress, record types.Record, deleted bool) {
+	k.SetRecordVersion(ctx, types.NewRecordVersion(record, ctx.BlockHeight(), ctx.BlockTime(), deleted))
+	if max := k.GetMaxHistoryVersions(ctx); max > 0 {
+		k.pruneVersions(ctx, types.GetRecordVersionIteratorPrefix(recordID), max)
+	}
+}
+
+// addScopeVersion stores the owners and value owner of the provided scope as the version of the scope written
+// in the current block, then prunes the scope's oldest versions so that at most MaxHistoryVersions are kept (zero means no limit).
+func (k Keeper) addScopeVersion(ctx sdk.Context, scope types.Scope, deleted bool) {
+	k.SetScopeVersion(ctx, types.NewScopeVersion(scope, ctx.BlockHeight(), ctx.BlockTime(), deleted))
+	if max := k.GetMaxHistoryVersions(ctx); max > 0 {
+		k.pruneVersions(ctx, types.GetScopeVersionIteratorPrefix(scope.ScopeId), max)
+	}
+}
+
+// pruneVersions deletes all but the newest max entries under the given prefix.
+func (k Keeper) pruneVersions(ctx sdk.Context, pre []byte, max uint32) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), pre)
+	it := store.ReverseIterator(nil, nil)
+	var toDelete [][]byte
+	kept := uint32(0)
+	for ; it.Valid(); it.Next() {
+		if kept < max {
+			kept++
+			continue
+		}
+		toDelete = append(toDelete, it.Key())
+	}
+	it.Close()
+	for _, key := range toDelete {
+		store.Delete(key)
+	}
+}
+
+// SetRecordVersion stores a version of a record, replacing any other version written at the same height.
+func (k Keeper) SetRecordVersion(ctx sdk.Context, version types.RecordVersion) {
+	recordID := version.Record.SessionId.MustGetAsRecordAddress(version.Record.Name)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetRecordVersionKey(recordID, version.Height), k.cdc.MustMarshal(&version))
+}
+
+// SetScopeVersion stores a version of a scope, replacing any other version written at the same height.
+func (k Keeper) SetScopeVersion(ctx sdk.Context, version types.ScopeVersion) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetScopeVersionKey(version.ScopeId, version.Height), k.cdc.MustMarshal(&version))
+}
+
+// GetRecordVersionAt returns the version of a record that was in effect at the given height.
+func (k Keeper) GetRecordVersionAt(ctx sdk.Context, recordID types.MetadataAddress, height int64) (version types.RecordVersion, found bool) {
+	b := k.getVersionAt(ctx, types.GetRecordVersionIteratorPrefix(recordID), height)
+	if b == nil {
+		return types.RecordVersion{}, false
+	}
+	if err := k.cdc.Unmarshal(b, &version); err != nil {
+		k.Logger(ctx).Error("could not unmarshal record version", "err", err, "recordId", recordID.String(), "height", height)
+		return types.RecordVersion{}, false
+	}
+	return version, true
+}
+
+// GetScopeVersionAt returns the version of a scope that was in effect at the given height.
+func (k Keeper) GetScopeVersionAt(ctx sdk.Context, scopeID types.MetadataAddress, height int64) (version types.ScopeVersion, found bool) {
+	b := k.getVersionAt(ctx, types.GetScopeVersionIteratorPrefix(scopeID), height)
+	if b == nil {
+		return types.ScopeVersion{}, false
+	}
+	if err := k.cdc.Unmarshal(b, &version); err != nil {
+		k.Logger(ctx).Error("could not unmarshal scope version", "err", err, "scopeId", scopeID.String(), "height", height)
+		return types.ScopeVersion{}, false
+	}
+	return version, true
+}
+
+// getVersionAt returns the value of the newest entry under the given prefix that was written at or before the given height.
+func (k Keeper) getVersionAt(ctx sdk.Context, pre []byte, height int64) []byte {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), pre)
+	it := store.ReverseIterator(nil, sdk.Uint64ToBigEndian(uint64(height)+1))
+	defer it.Close()
+	if !it.Valid() {
+		return nil
+	}
+	return it.Value()
+}
+
+// IterateRecordVersions processes all stored record versions with the given handler.
+func (k Keeper) IterateRecordVersions(ctx sdk.Context, handler func(version types.RecordVersion) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, types.RecordVersionKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var version types.RecordVersion
+		if err := k.cdc.Unmarshal(it.Value(), &version); err != nil {
+			return err
+		}
+		if handler(version) {
+			break
+		}
+	}
+	return nil
+}
+
+// IterateScopeVersions processes all stored scope versions with the given handler.
+func (k Keeper) IterateScopeVersions(ctx sdk.Context, handler func(version types.ScopeVersion) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, types.ScopeVersionKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var version types.ScopeVersion
+		if err := k.cdc.Unmarshal(it.Value(), &version); err != nil {
+			return err
+		}
+		if handler(version) {
+			break
+		}
+	}
+	return nil
+}
+
+// clearVersionHistory deletes all stored record and scope versions.
+func (k Keeper) clearVersionHistory(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	for _, pre := range [][]byte{types.RecordVersionKeyPrefix, types.ScopeVersionKeyPrefix} {
+		deleteAll(store, pre)
+	}
+}
+
+// deleteAll deletes all entries in the store with the given prefix.
+func deleteAll(store storetypes.KVStore, pre []byte) {
+	it := sdk.KVStorePrefixIterator(store, pre)
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
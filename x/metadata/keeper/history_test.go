@@ -0,0 +1,262 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// versionHistory is a scope and record written by setupVersionHistory.
+type versionHistory struct {
+	start      time.Time
+	atHeight   func(height int64) sdk.Context
+	scope      types.Scope
+	sessionID  types.MetadataAddress
+	recordID   types.MetadataAddress
+	valueOwner string
+	buyer      string
+}
+
+// setupVersionHistory keeps at most three versions, then writes a scope and a record at height 10,
+// changes the scope's value owner at height 12, and writes the record again at heights 11, 12 and 13
+// with the output hashes "second", "third" and "fourth". Block time advances a minute per height.
+func (s *ScopeKeeperTestSuite) setupVersionHistory() versionHistory {
+	rv := versionHistory{
+		start:      time.Now().UTC().Truncate(time.Second),
+		valueOwner: sdk.AccAddress("valueOwner__________").String(),
+		buyer:      sdk.AccAddress("buyer_______________").String(),
+	}
+	ctx := s.FreshCtx()
+	rv.atHeight = func(height int64) sdk.Context {
+		return ctx.WithBlockHeight(height).WithBlockTime(rv.start.Add(time.Duration(height-10) * time.Minute))
+	}
+
+	params := s.app.MetadataKeeper.GetParams(ctx)
+	params.MaxHistoryVersions = 3
+	s.app.MetadataKeeper.SetParams(ctx, params)
+
+	scopeUUID := uuid.New()
+	scope := types.NewScope(types.ScopeMetadataAddress(scopeUUID), nil, ownerPartyList(s.user1), nil, rv.valueOwner, false)
+	rv.sessionID = types.SessionMetadataAddress(scopeUUID, uuid.New())
+	session := types.NewSession("name", rv.sessionID, types.ContractSpecMetadataAddress(uuid.New()), ownerPartyList(s.user1), nil)
+	record := types.NewRecord("record", rv.sessionID, *types.NewProcess("process", &types.Process_Hash{Hash: "HASH"}, "process"),
+		[]types.RecordInput{}, []types.RecordOutput{{Hash: "first", Status: types.ResultStatus_RESULT_STATUS_PASS}}, nil)
+	rv.recordID = rv.sessionID.MustGetAsRecordAddress(record.Name)
+
+	s.app.MetadataKeeper.SetScope(rv.atHeight(10), *scope)
+	s.app.MetadataKeeper.SetSession(rv.atHeight(10), *session)
+	s.app.MetadataKeeper.SetRecord(rv.atHeight(10), *record)
+
+	// Updating a scope without changing its owners doesn't add a version.
+	scope.RequirePartyRollup = true
+	s.app.MetadataKeeper.SetScope(rv.atHeight(11), *scope)
+	s.app.MetadataKeeper.SetScopeValueOwners(rv.atHeight(12), []*types.Scope{scope}, rv.buyer)
+	rv.scope = *scope
+
+	for i, hash := range []string{"second", "third", "fourth"} {
+		record.Outputs[0].Hash = hash
+		s.app.MetadataKeeper.SetRecord(rv.atHeight(int64(11+i)), *record)
+	}
+	return rv
+}
+
+func (s *ScopeKeeperTestSuite) TestRecordHistory() {
+	vh := s.setupVersionHistory()
+
+	tests := []struct {
+		name       string
+		req        *types.RecordHistoryRequest
+		expHeights []int64
+		expHashes  []string
+		expNextKey bool
+		expErr     string
+	}{
+		{
+			name:       "all kept versions",
+			req:        &types.RecordHistoryRequest{RecordAddr: vh.recordID.String()},
+			expHeights: []int64{11, 12, 13},
+			expHashes:  []string{"second", "third", "fourth"},
+		},
+		{
+			name:       "newest version paginated",
+			req:        &types.RecordHistoryRequest{RecordAddr: vh.recordID.String(), Pagination: &query.PageRequest{Limit: 1, Reverse: true}},
+			expHeights: []int64{13},
+			expHashes:  []string{"fourth"},
+			expNextKey: true,
+		},
+		{
+			name:       "version at a height after the last write",
+			req:        &types.RecordHistoryRequest{RecordAddr: vh.recordID.String(), Height: 19},
+			expHeights: []int64{13},
+			expHashes:  []string{"fourth"},
+		},
+		{
+			name:   "height before the oldest kept version",
+			req:    &types.RecordHistoryRequest{RecordAddr: vh.recordID.String(), Height: 10},
+			expErr: "no version of record " + vh.recordID.String() + " found at height 10: not found",
+		},
+		{
+			name:   "scope id",
+			req:    &types.RecordHistoryRequest{RecordAddr: vh.scope.ScopeId.String()},
+			expErr: "address [" + vh.scope.ScopeId.String() + "] is not a record address: invalid request",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			resp, err := s.app.MetadataKeeper.RecordHistory(sdk.WrapSDKContext(vh.atHeight(20)), tc.req)
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, tc.expErr, "RecordHistory")
+				return
+			}
+			s.Require().NoError(err, "RecordHistory")
+
+			var heights []int64
+			var hashes []string
+			for _, version := range resp.Versions {
+				heights = append(heights, version.Height)
+				hashes = append(hashes, version.Record.Outputs[0].Hash)
+				s.Assert().Equal(vh.start.Add(time.Duration(version.Height-10)*time.Minute), version.Time, "version %d time", version.Height)
+				s.Assert().Equal(vh.sessionID, version.Record.SessionId, "version %d session", version.Height)
+			}
+			s.Assert().Equal(tc.expHeights, heights, "version heights")
+			s.Assert().Equal(tc.expHashes, hashes, "version output hashes")
+			s.Assert().Equal(tc.expNextKey, len(resp.Pagination.GetNextKey()) > 0, "has next key")
+		})
+	}
+}
+
+func (s *ScopeKeeperTestSuite) TestScopeHistory() {
+	vh := s.setupVersionHistory()
+	scopeUUID, err := vh.scope.ScopeId.ScopeUUID()
+	s.Require().NoError(err, "ScopeUUID")
+
+	tests := []struct {
+		name           string
+		req            *types.ScopeHistoryRequest
+		expHeights     []int64
+		expValueOwners []string
+	}{
+		{
+			name:           "all versions by scope uuid",
+			req:            &types.ScopeHistoryRequest{ScopeId: scopeUUID.String()},
+			expHeights:     []int64{10, 12},
+			expValueOwners: []string{vh.valueOwner, vh.buyer},
+		},
+		{
+			name:           "version at a height before the value owner change",
+			req:            &types.ScopeHistoryRequest{ScopeId: vh.scope.ScopeId.String(), Height: 11},
+			expHeights:     []int64{10},
+			expValueOwners: []string{vh.valueOwner},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			resp, err := s.app.MetadataKeeper.ScopeHistory(sdk.WrapSDKContext(vh.atHeight(20)), tc.req)
+			s.Require().NoError(err, "ScopeHistory")
+
+			var heights []int64
+			var valueOwners []string
+			for _, version := range resp.Versions {
+				heights = append(heights, version.Height)
+				valueOwners = append(valueOwners, version.ValueOwnerAddress)
+				s.Assert().Equal(vh.scope.Owners, version.Owners, "version %d owners", version.Height)
+			}
+			s.Assert().Equal(tc.expHeights, heights, "version heights")
+			s.Assert().Equal(tc.expValueOwners, valueOwners, "version value owners")
+		})
+	}
+}
+
+func (s *ScopeKeeperTestSuite) TestVersionHistoryRemoveScopeAndGenesis() {
+	vh := s.setupVersionHistory()
+	genesis := s.app.MetadataKeeper.ExportGenesis(vh.atHeight(20))
+
+	// These are run in order against the same state.
+	tests := []struct {
+		name  string
+		check func()
+	}{
+		{
+			name: "exported history",
+			check: func() {
+				s.Assert().Len(genesis.RecordVersions, 3, "exported record versions")
+				s.Assert().Len(genesis.ScopeVersions, 2, "exported scope versions")
+				s.Assert().NoError(genesis.Validate(), "exported genesis Validate")
+			},
+		},
+		{
+			name: "scope removed adds tombstones for it and its records",
+			check: func() {
+				s.app.MetadataKeeper.RemoveScope(vh.atHeight(21), vh.scope.ScopeId)
+				version, found := s.app.MetadataKeeper.GetRecordVersionAt(vh.atHeight(22), vh.recordID, 22)
+				if s.Assert().True(found, "GetRecordVersionAt found") {
+					s.Assert().True(version.Deleted, "record version deleted")
+				}
+				scopeVersion, found := s.app.MetadataKeeper.GetScopeVersionAt(vh.atHeight(22), vh.scope.ScopeId, 22)
+				if s.Assert().True(found, "GetScopeVersionAt found") {
+					s.Assert().True(scopeVersion.Deleted, "scope version deleted")
+					s.Assert().Equal(vh.buyer, scopeVersion.ValueOwnerAddress, "scope version value owner")
+				}
+			},
+		},
+		{
+			name: "history imported",
+			check: func() {
+				ctx := vh.atHeight(23)
+				s.app.MetadataKeeper.InitGenesis(ctx, genesis)
+				exported := s.app.MetadataKeeper.ExportGenesis(ctx)
+				s.Assert().Equal(genesis.RecordVersions, exported.RecordVersions, "record versions")
+				s.Assert().Equal(genesis.ScopeVersions, exported.ScopeVersions, "scope versions")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, tc.check)
+	}
+}
+
+func (s *ScopeKeeperTestSuite) TestVersionHistoryMaxVersions() {
+	tests := []struct {
+		name          string
+		maxVersions   uint32
+		expOldestKept int64
+	}{
+		{name: "no limit", maxVersions: 0, expOldestKept: 1},
+		{name: "one version", maxVersions: 1, expOldestKept: 4},
+		{name: "two versions", maxVersions: 2, expOldestKept: 3},
+		{name: "more versions than writes", maxVersions: 10, expOldestKept: 1},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			ctx := s.FreshCtx().WithBlockTime(time.Now().UTC())
+			params := s.app.MetadataKeeper.GetParams(ctx)
+			params.MaxHistoryVersions = tc.maxVersions
+			s.app.MetadataKeeper.SetParams(ctx, params)
+
+			scope := types.NewScope(types.ScopeMetadataAddress(uuid.New()), nil, ownerPartyList(s.user1), nil, "", false)
+			for h := int64(1); h <= 4; h++ {
+				scope.ValueOwnerAddress = sdk.AccAddress(fmt.Sprintf("value_owner_%08d", h)).String()
+				s.app.MetadataKeeper.SetScope(ctx.WithBlockHeight(h), *scope)
+			}
+
+			for h := int64(1); h <= 4; h++ {
+				version, found := s.app.MetadataKeeper.GetScopeVersionAt(ctx, scope.ScopeId, h)
+				if h < tc.expOldestKept {
+					s.Assert().False(found, "GetScopeVersionAt(%d) found", h)
+				} else if s.Assert().True(found, "GetScopeVersionAt(%d) found", h) {
+					s.Assert().Equal(h, version.Height, "GetScopeVersionAt(%d) height", h)
+				}
+			}
+		})
+	}
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/provenance-io/provenance/x/metadata/types"
@@ -120,16 +122,22 @@ type Keeper struct {
 
 	// For getting/setting account data.
 	attrKeeper AttrKeeper
+
+	// For paying for scopes bought from a listing.
+	bankKeeper BankKeeper
+
+	// the signing authority for the gov proposals
+	authority string
 }
 
 // NewKeeper creates new instances of the metadata Keeper.
 func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramtypes.Subspace,
-	authKeeper AuthKeeper, authzKeeper AuthzKeeper, attrKeeper AttrKeeper,
+	authKeeper AuthKeeper, authzKeeper AuthzKeeper, attrKeeper AttrKeeper, bankKeeper BankKeeper,
 ) Keeper {
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
-		paramSpace = paramSpace.WithKeyTable(types.OSParamKeyTable())
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
 	}
 	return Keeper{
 		storeKey:    key,
@@ -138,9 +146,16 @@ func NewKeeper(
 		authKeeper:  authKeeper,
 		authzKeeper: authzKeeper,
 		attrKeeper:  attrKeeper,
+		bankKeeper:  bankKeeper,
+		authority:   authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	}
 }
 
+// GetAuthority returns the signing authority for the metadata module's governance-gated msgs.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// lineageGraph is used to build the result of a lineage query.
+type lineageGraph struct {
+	k       Keeper
+	ctx     sdk.Context
+	nodes   []types.LineageNode
+	edges   []types.LineageEdge
+	records map[string]*types.Record
+	inNodes map[string]bool
+	inEdges map[string]bool
+}
+
+// GetRecordLineage walks the record input references from the record with the given id, up to maxDepth references away.
+// Upstream, a record's inputs are followed to the records they reference.
+// Downstream, the records that reference a record in their inputs are followed.
+// The first node is always the requested record.
+func (k Keeper) GetRecordLineage(
+	ctx sdk.Context,
+	recordID types.MetadataAddress,
+	direction types.LineageDirection,
+	maxDepth uint32,
+) ([]types.LineageNode, []types.LineageEdge) {
+	g := &lineageGraph{
+		k:       k,
+		ctx:     ctx,
+		records: make(map[string]*types.Record),
+		inNodes: make(map[string]bool),
+		inEdges: make(map[string]bool),
+	}
+	g.addNode(recordID, 0)
+	if direction != types.LineageDirection_LINEAGE_DIRECTION_DOWNSTREAM {
+		g.walk(recordID, maxDepth, g.upstreamOf)
+	}
+	if direction != types.LineageDirection_LINEAGE_DIRECTION_UPSTREAM {
+		g.walk(recordID, maxDepth, g.downstreamOf)
+	}
+	return g.nodes, g.edges
+}
+
+// walk does a breadth-first traversal from the provided record using the next func to find (and add edges to) neighbors.
+func (g *lineageGraph) walk(recordID types.MetadataAddress, maxDepth uint32, next func(types.MetadataAddress) []types.MetadataAddress) {
+	visited := map[string]bool{string(recordID): true}
+	queue := []types.MetadataAddress{recordID}
+	for depth := uint32(1); depth <= maxDepth && len(queue) > 0; depth++ {
+		var nextQueue []types.MetadataAddress
+		for _, id := range queue {
+			for _, neighbor := range next(id) {
+				if visited[string(neighbor)] {
+					continue
+				}
+				visited[string(neighbor)] = true
+				if g.addNode(neighbor, depth) {
+					nextQueue = append(nextQueue, neighbor)
+				}
+			}
+		}
+		queue = nextQueue
+	}
+}
+
+// upstreamOf adds edges for, and returns, the records used as inputs to the provided record.
+func (g *lineageGraph) upstreamOf(recordID types.MetadataAddress) []types.MetadataAddress {
+	record := g.getRecord(recordID)
+	if record == nil {
+		return nil
+	}
+	for _, input := range record.Inputs {
+		if source, ok := input.Source.(*types.RecordInput_RecordId); ok {
+			g.addEdge(source.RecordId, recordID, input)
+		}
+	}
+	return record.GetInputRecordIDs()
+}
+
+// downstreamOf adds edges for, and returns, the records that use the provided record as an input.
+func (g *lineageGraph) downstreamOf(recordID types.MetadataAddress) []types.MetadataAddress {
+	var rv []types.MetadataAddress
+	pre := types.GetRecordInputCacheIteratorPrefix(recordID)
+	store := g.ctx.KVStore(g.k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, pre)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		downstreamID := types.MetadataAddress(it.Key()[len(pre):])
+		record := g.getRecord(downstreamID)
+		if record == nil {
+			continue
+		}
+		for _, input := range record.Inputs {
+			if source, ok := input.Source.(*types.RecordInput_RecordId); ok && source.RecordId.Equals(recordID) {
+				g.addEdge(recordID, downstreamID, input)
+			}
+		}
+		rv = append(rv, downstreamID)
+	}
+	return rv
+}
+
+// getRecord gets a record from state, caching it for later lookups. Returns nil if the record doesn't exist.
+func (g *lineageGraph) getRecord(recordID types.MetadataAddress) *types.Record {
+	if record, known := g.records[string(recordID)]; known {
+		return record
+	}
+	var rv *types.Record
+	if record, found := g.k.GetRecord(g.ctx, recordID); found {
+		rv = &record
+	}
+	g.records[string(recordID)] = rv
+	return rv
+}
+
+// addNode adds a node for the provided record if it's not already in the graph.
+// Returns true if the record exists, i.e. it can be walked further.
+func (g *lineageGraph) addNode(recordID types.MetadataAddress, depth uint32) bool {
+	record := g.getRecord(recordID)
+	if g.inNodes[string(recordID)] {
+		return record != nil
+	}
+	g.inNodes[string(recordID)] = true
+
+	node := types.LineageNode{
+		RecordAddr: recordID.String(),
+		Depth:      depth,
+		Found:      record != nil,
+	}
+	if scopeID, err := recordID.AsScopeAddress(); err == nil {
+		node.ScopeAddr = scopeID.String()
+	}
+	if record != nil {
+		node.Name = record.Name
+		if !record.SpecificationId.Empty() {
+			node.SpecificationAddr = record.SpecificationId.String()
+		}
+		for _, output := range record.Outputs {
+			node.OutputStatuses = append(node.OutputStatuses, output.Status)
+		}
+	}
+	g.nodes = append(g.nodes, node)
+	return record != nil
+}
+
+// addEdge adds an edge for the provided input if it's not already in the graph.
+func (g *lineageGraph) addEdge(inputRecordID, recordID types.MetadataAddress, input types.RecordInput) {
+	edge := types.LineageEdge{
+		InputRecordAddr: inputRecordID.String(),
+		RecordAddr:      recordID.String(),
+		InputName:       input.Name,
+		InputStatus:     input.Status,
+	}
+	key := edge.InputRecordAddr + " " + edge.RecordAddr + " " + edge.InputName
+	if g.inEdges[key] {
+		return
+	}
+	g.inEdges[key] = true
+	g.edges = append(g.edges, edge)
+}
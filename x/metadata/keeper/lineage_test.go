@@ -0,0 +1,197 @@
+package keeper_test
+
+import (
+	"github.com/google/uuid"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/keeper"
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// recordLineage is the records written by setupRecordLineage.
+type recordLineage struct {
+	a, b, c, d   types.MetadataAddress
+	missing      types.MetadataAddress
+	otherScopeID types.MetadataAddress
+	recordD      types.Record
+}
+
+// setupRecordLineage writes records in the suite's session with these inputs:
+// missing <- b <- c (in another scope), a <- b, and a <- d.
+func (s *RecordKeeperTestSuite) setupRecordLineage(ctx sdk.Context) recordLineage {
+	otherScopeUUID := uuid.New()
+	otherSessionID := types.SessionMetadataAddress(otherScopeUUID, uuid.New())
+	rv := recordLineage{
+		a:            s.sessionID.MustGetAsRecordAddress("a"),
+		b:            s.sessionID.MustGetAsRecordAddress("b"),
+		c:            otherSessionID.MustGetAsRecordAddress("c"),
+		d:            s.sessionID.MustGetAsRecordAddress("d"),
+		missing:      types.RecordMetadataAddress(s.scopeUUID, "missing"),
+		otherScopeID: types.ScopeMetadataAddress(otherScopeUUID),
+	}
+
+	process := *types.NewProcess("process", &types.Process_Hash{Hash: "HASH"}, "process")
+	pass := []types.RecordOutput{*types.NewRecordOutput("out", types.ResultStatus_RESULT_STATUS_PASS)}
+	fail := []types.RecordOutput{*types.NewRecordOutput("out", types.ResultStatus_RESULT_STATUS_FAIL)}
+	recordInput := func(name string, id types.MetadataAddress) types.RecordInput {
+		return *types.NewRecordInput(name, &types.RecordInput_RecordId{RecordId: id}, "record", types.RecordInputStatus_Record)
+	}
+	rv.recordD = *types.NewRecord("d", s.sessionID, process, []types.RecordInput{recordInput("from_a", rv.a)}, pass, nil)
+	records := []types.Record{
+		*types.NewRecord("a", s.sessionID, process, nil, pass, nil),
+		*types.NewRecord("b", s.sessionID, process, []types.RecordInput{recordInput("from_a", rv.a), recordInput("from_missing", rv.missing)}, fail, nil),
+		*types.NewRecord("c", otherSessionID, process, []types.RecordInput{recordInput("from_b", rv.b)}, pass, nil),
+		rv.recordD,
+	}
+	for _, record := range records {
+		s.app.MetadataKeeper.SetRecord(ctx, record)
+	}
+	return rv
+}
+
+// lineageEdge returns the edge of a record input that references another record.
+func lineageEdge(input, record types.MetadataAddress, name string) types.LineageEdge {
+	return types.LineageEdge{InputRecordAddr: input.String(), RecordAddr: record.String(), InputName: name, InputStatus: types.RecordInputStatus_Record}
+}
+
+// lineageNodeAddrs returns the record addresses of the nodes in a lineage response.
+func lineageNodeAddrs(resp *types.RecordLineageResponse) []string {
+	var rv []string
+	for _, node := range resp.Nodes {
+		rv = append(rv, node.RecordAddr)
+	}
+	return rv
+}
+
+func (s *RecordKeeperTestSuite) TestRecordLineage() {
+	ctx := s.FreshCtx()
+	rl := s.setupRecordLineage(ctx)
+	upstream := types.LineageDirection_LINEAGE_DIRECTION_UPSTREAM
+	downstream := types.LineageDirection_LINEAGE_DIRECTION_DOWNSTREAM
+
+	tests := []struct {
+		name     string
+		req      *types.RecordLineageRequest
+		expNodes []string
+		expEdges []types.LineageEdge
+		expErr   string
+	}{
+		{
+			name:     "upstream through another scope to a missing record",
+			req:      &types.RecordLineageRequest{RecordAddr: rl.c.String(), Direction: upstream},
+			expNodes: []string{rl.c.String(), rl.b.String(), rl.a.String(), rl.missing.String()},
+			expEdges: []types.LineageEdge{lineageEdge(rl.b, rl.c, "from_b"), lineageEdge(rl.a, rl.b, "from_a"), lineageEdge(rl.missing, rl.b, "from_missing")},
+		},
+		{
+			name:     "downstream limited to a depth of one",
+			req:      &types.RecordLineageRequest{RecordAddr: rl.a.String(), Direction: downstream, MaxDepth: 1},
+			expNodes: []string{rl.a.String(), rl.b.String(), rl.d.String()},
+			expEdges: []types.LineageEdge{lineageEdge(rl.a, rl.b, "from_a"), lineageEdge(rl.a, rl.d, "from_a")},
+		},
+		{
+			name:     "downstream",
+			req:      &types.RecordLineageRequest{RecordAddr: rl.a.String(), Direction: downstream},
+			expNodes: []string{rl.a.String(), rl.b.String(), rl.d.String(), rl.c.String()},
+			expEdges: []types.LineageEdge{lineageEdge(rl.a, rl.b, "from_a"), lineageEdge(rl.a, rl.d, "from_a"), lineageEdge(rl.b, rl.c, "from_b")},
+		},
+		{
+			name:     "both directions",
+			req:      &types.RecordLineageRequest{RecordAddr: rl.b.String(), MaxDepth: 1},
+			expNodes: []string{rl.b.String(), rl.a.String(), rl.missing.String(), rl.c.String()},
+			expEdges: []types.LineageEdge{lineageEdge(rl.a, rl.b, "from_a"), lineageEdge(rl.missing, rl.b, "from_missing"), lineageEdge(rl.b, rl.c, "from_b")},
+		},
+		{
+			name:   "max depth too large",
+			req:    &types.RecordLineageRequest{RecordAddr: rl.b.String(), MaxDepth: 21},
+			expErr: "max depth 21 cannot be more than 20: invalid request",
+		},
+		{
+			name:   "unknown direction",
+			req:    &types.RecordLineageRequest{RecordAddr: rl.b.String(), Direction: 9},
+			expErr: "unknown lineage direction 9: invalid request",
+		},
+		{
+			name:   "missing record",
+			req:    &types.RecordLineageRequest{RecordAddr: rl.missing.String()},
+			expErr: "record not found for id " + rl.missing.String() + ": not found",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			resp, err := s.app.MetadataKeeper.RecordLineage(sdk.WrapSDKContext(ctx), tc.req)
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, tc.expErr, "RecordLineage")
+				return
+			}
+			s.Require().NoError(err, "RecordLineage")
+			s.Assert().ElementsMatch(tc.expNodes, lineageNodeAddrs(resp), "nodes")
+			s.Assert().ElementsMatch(tc.expEdges, resp.Edges, "edges")
+		})
+	}
+
+	s.Run("node details", func() {
+		resp, err := s.app.MetadataKeeper.RecordLineage(sdk.WrapSDKContext(ctx), &types.RecordLineageRequest{RecordAddr: rl.c.String(), Direction: upstream})
+		s.Require().NoError(err, "RecordLineage")
+		s.Require().Equal([]string{rl.c.String(), rl.b.String(), rl.a.String(), rl.missing.String()}, lineageNodeAddrs(resp), "nodes in order")
+		s.Assert().Equal(types.LineageNode{
+			RecordAddr:     rl.b.String(),
+			ScopeAddr:      s.scopeID.String(),
+			Name:           "b",
+			OutputStatuses: []types.ResultStatus{types.ResultStatus_RESULT_STATUS_FAIL},
+			Depth:          1,
+			Found:          true,
+		}, resp.Nodes[1], "node b")
+		s.Assert().Equal(rl.otherScopeID.String(), resp.Nodes[0].ScopeAddr, "node c scope")
+		s.Assert().False(resp.Nodes[3].Found, "missing node found")
+	})
+}
+
+func (s *RecordKeeperTestSuite) TestRecordInputIndex() {
+	ctx := s.FreshCtx()
+	rl := s.setupRecordLineage(ctx)
+
+	// These are run in order against the same state.
+	tests := []struct {
+		name     string
+		change   func()
+		expNodes []string
+	}{
+		{
+			name: "index removed",
+			change: func() {
+				store := ctx.KVStore(s.app.GetKey(types.StoreKey))
+				store.Delete(types.GetRecordInputCacheKey(rl.a, rl.b))
+				store.Delete(types.GetRecordInputCacheKey(rl.a, rl.d))
+			},
+			expNodes: []string{rl.a.String()},
+		},
+		{
+			name: "index rebuilt by the migration",
+			change: func() {
+				s.Require().NoError(keeper.NewMigrator(s.app.MetadataKeeper).Migrate3to4(ctx), "Migrate3to4")
+			},
+			expNodes: []string{rl.a.String(), rl.b.String(), rl.d.String(), rl.c.String()},
+		},
+		{
+			name: "record inputs changed and record removed",
+			change: func() {
+				rl.recordD.Inputs = nil
+				s.app.MetadataKeeper.SetRecord(ctx, rl.recordD)
+				s.app.MetadataKeeper.RemoveRecord(ctx, rl.b)
+			},
+			expNodes: []string{rl.a.String()},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			tc.change()
+			resp, err := s.app.MetadataKeeper.RecordLineage(sdk.WrapSDKContext(ctx),
+				&types.RecordLineageRequest{RecordAddr: rl.a.String(), Direction: types.LineageDirection_LINEAGE_DIRECTION_DOWNSTREAM})
+			s.Require().NoError(err, "RecordLineage downstream")
+			s.Assert().ElementsMatch(tc.expNodes, lineageNodeAddrs(resp), "downstream nodes")
+		})
+	}
+}
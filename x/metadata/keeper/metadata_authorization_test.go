@@ -0,0 +1,314 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// metadataAuthzScopes are the scopes and records of a new granter used to test metadata authorizations.
+type metadataAuthzScopes struct {
+	granter   sdk.AccAddress
+	grantee   sdk.AccAddress
+	specID1   types.MetadataAddress
+	specID2   types.MetadataAddress
+	scopeID1  types.MetadataAddress
+	scopeID2  types.MetadataAddress
+	sessionID types.MetadataAddress
+}
+
+// newMetadataAuthzScopes stores two scopes owned by a new granter, each on its own new scope specification,
+// and two records ("allowed" and "other") in the first scope.
+func (s *AuthzTestSuite) newMetadataAuthzScopes(ctx sdk.Context) metadataAuthzScopes {
+	rv := metadataAuthzScopes{
+		granter:  randomUser().Addr,
+		grantee:  randomUser().Addr,
+		specID1:  types.ScopeSpecMetadataAddress(uuid.New()),
+		specID2:  types.ScopeSpecMetadataAddress(uuid.New()),
+		scopeID2: types.ScopeMetadataAddress(uuid.New()),
+	}
+	scopeUUID1 := uuid.New()
+	rv.scopeID1 = types.ScopeMetadataAddress(scopeUUID1)
+	rv.sessionID = types.SessionMetadataAddress(scopeUUID1, uuid.New())
+	ownerRole := []types.PartyType{types.PartyType_PARTY_TYPE_OWNER}
+	for _, specID := range []types.MetadataAddress{rv.specID1, rv.specID2} {
+		s.app.MetadataKeeper.SetScopeSpecification(ctx, *types.NewScopeSpecification(specID, nil,
+			[]string{rv.granter.String()}, ownerRole, nil))
+	}
+	s.app.MetadataKeeper.SetScope(ctx, *types.NewScope(rv.scopeID1, rv.specID1, ownerPartyList(rv.granter.String()), nil, "", false))
+	s.app.MetadataKeeper.SetScope(ctx, *types.NewScope(rv.scopeID2, rv.specID2, ownerPartyList(rv.granter.String()), nil, "", false))
+
+	process := *types.NewProcess("process", &types.Process_Hash{Hash: "HASH"}, "process")
+	outputs := []types.RecordOutput{*types.NewRecordOutput("out", types.ResultStatus_RESULT_STATUS_PASS)}
+	s.app.MetadataKeeper.SetRecord(ctx, *types.NewRecord("allowed", rv.sessionID, process, nil, outputs, nil))
+	s.app.MetadataKeeper.SetRecord(ctx, *types.NewRecord("other", rv.sessionID, process, nil, outputs, nil))
+	return rv
+}
+
+func (s *AuthzTestSuite) TestMetadataAuthorizationValidateSigners() {
+	tests := []struct {
+		name    string
+		auth    func(ids metadataAuthzScopes) authz.Authorization
+		expired bool
+		msg     func(ids metadataAuthzScopes) types.MetadataMsg
+		expErr  bool
+	}{
+		{
+			name: "scope on another specification",
+			auth: func(ids metadataAuthzScopes) authz.Authorization {
+				return types.NewMetadataAuthorization(types.TypeURLMsgDeleteScopeRequest, nil, []types.MetadataAddress{ids.specID1}, nil, 0)
+			},
+			msg: func(ids metadataAuthzScopes) types.MetadataMsg {
+				return types.NewMsgDeleteScopeRequest(ids.scopeID2, []string{ids.grantee.String()})
+			},
+			expErr: true,
+		},
+		{
+			name: "scope on an allowed specification",
+			auth: func(ids metadataAuthzScopes) authz.Authorization {
+				return types.NewMetadataAuthorization(types.TypeURLMsgDeleteScopeRequest, nil, []types.MetadataAddress{ids.specID1}, nil, 0)
+			},
+			msg: func(ids metadataAuthzScopes) types.MetadataMsg {
+				return types.NewMsgDeleteScopeRequest(ids.scopeID1, []string{ids.grantee.String()})
+			},
+		},
+		{
+			name: "record deletion of another record",
+			auth: func(ids metadataAuthzScopes) authz.Authorization {
+				return types.NewMetadataAuthorization(types.TypeURLMsgDeleteRecordRequest, nil, nil, []string{"allowed"}, 0)
+			},
+			msg: func(ids metadataAuthzScopes) types.MetadataMsg {
+				return types.NewMsgDeleteRecordRequest(ids.sessionID.MustGetAsRecordAddress("other"), []string{ids.grantee.String()})
+			},
+			expErr: true,
+		},
+		{
+			name: "record deletion of an allowed record",
+			auth: func(ids metadataAuthzScopes) authz.Authorization {
+				return types.NewMetadataAuthorization(types.TypeURLMsgDeleteRecordRequest, nil, nil, []string{"allowed"}, 0)
+			},
+			msg: func(ids metadataAuthzScopes) types.MetadataMsg {
+				return types.NewMsgDeleteRecordRequest(ids.sessionID.MustGetAsRecordAddress("allowed"), []string{ids.grantee.String()})
+			},
+		},
+		{
+			name: "scope write authorization used for an owner of another scope",
+			auth: func(ids metadataAuthzScopes) authz.Authorization {
+				return types.NewMetadataAuthorization(types.TypeURLMsgWriteScopeRequest, []types.MetadataAddress{ids.scopeID1}, nil, nil, 0)
+			},
+			msg: func(ids metadataAuthzScopes) types.MetadataMsg {
+				return types.NewMsgAddScopeOwnerRequest(ids.scopeID2, ownerPartyList(ids.grantee.String()), []string{ids.grantee.String()})
+			},
+			expErr: true,
+		},
+		{
+			name: "scope write authorization used for an owner of an allowed scope",
+			auth: func(ids metadataAuthzScopes) authz.Authorization {
+				return types.NewMetadataAuthorization(types.TypeURLMsgWriteScopeRequest, []types.MetadataAddress{ids.scopeID1}, nil, nil, 0)
+			},
+			msg: func(ids metadataAuthzScopes) types.MetadataMsg {
+				return types.NewMsgAddScopeOwnerRequest(ids.scopeID1, ownerPartyList(ids.grantee.String()), []string{ids.grantee.String()})
+			},
+		},
+		{
+			name: "expired authorization",
+			auth: func(ids metadataAuthzScopes) authz.Authorization {
+				return types.NewMetadataAuthorization(types.TypeURLMsgUpdateValueOwnersRequest, nil, nil, nil, 0)
+			},
+			expired: true,
+			msg: func(ids metadataAuthzScopes) types.MetadataMsg {
+				return types.NewMsgUpdateValueOwnersRequest([]types.MetadataAddress{ids.scopeID2}, ids.grantee, []string{ids.grantee.String()})
+			},
+			expErr: true,
+		},
+		{
+			name: "generic authorization",
+			auth: func(ids metadataAuthzScopes) authz.Authorization {
+				return authz.NewGenericAuthorization(types.TypeURLMsgUpdateValueOwnersRequest)
+			},
+			msg: func(ids metadataAuthzScopes) types.MetadataMsg {
+				return types.NewMsgUpdateValueOwnersRequest([]types.MetadataAddress{ids.scopeID2}, ids.grantee, []string{ids.grantee.String()})
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			ctx := s.FreshCtx()
+			ids := s.newMetadataAuthzScopes(ctx)
+			exp := ctx.BlockTime().Add(time.Hour)
+			if tc.expired {
+				exp = ctx.BlockTime().Add(time.Minute)
+			}
+			auth := tc.auth(ids)
+			s.Require().NoError(auth.ValidateBasic(), "ValidateBasic")
+			s.Require().NoError(s.app.AuthzKeeper.SaveGrant(ctx, ids.grantee, ids.granter, auth, &exp), "SaveGrant")
+			if tc.expired {
+				ctx = ctx.WithBlockTime(exp.Add(time.Minute))
+			}
+
+			err := s.app.MetadataKeeper.ValidateSignersWithoutParties(ctx, []string{ids.granter.String()}, tc.msg(ids))
+			if tc.expErr {
+				s.Assert().EqualError(err, "missing signature: "+ids.granter.String(), "ValidateSignersWithoutParties")
+			} else {
+				s.Assert().NoError(err, "ValidateSignersWithoutParties")
+			}
+		})
+	}
+}
+
+func (s *AuthzTestSuite) TestMetadataAuthorizationUsesRemaining() {
+	ctx := s.FreshCtx()
+	ids := s.newMetadataAuthzScopes(ctx)
+	exp := ctx.BlockTime().Add(time.Hour)
+	auth := types.NewMetadataAuthorization(types.TypeURLMsgDeleteScopeRequest, nil, []types.MetadataAddress{ids.specID1}, nil, 2)
+	s.Require().NoError(s.app.AuthzKeeper.SaveGrant(ctx, ids.grantee, ids.granter, auth, &exp), "SaveGrant")
+	msg := types.NewMsgDeleteScopeRequest(ids.scopeID1, []string{ids.grantee.String()})
+
+	tests := []struct {
+		name    string
+		expErr  bool
+		expUses uint32
+		expAuth bool
+	}{
+		{name: "first use", expUses: 1, expAuth: true},
+		{name: "last use", expAuth: false},
+		{name: "after the last use", expErr: true, expAuth: false},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			err := s.app.MetadataKeeper.ValidateSignersWithoutParties(s.FreshCtx(), []string{ids.granter.String()}, msg)
+			if tc.expErr {
+				s.Assert().EqualError(err, "missing signature: "+ids.granter.String(), "ValidateSignersWithoutParties")
+			} else {
+				s.Assert().NoError(err, "ValidateSignersWithoutParties")
+			}
+			stored, _ := s.app.AuthzKeeper.GetAuthorization(ctx, ids.grantee, ids.granter, types.TypeURLMsgDeleteScopeRequest)
+			if !tc.expAuth {
+				s.Assert().Nil(stored, "authorization")
+				return
+			}
+			if s.Assert().IsType(&types.MetadataAuthorization{}, stored, "authorization") {
+				s.Assert().Equal(tc.expUses, stored.(*types.MetadataAuthorization).UsesRemaining, "uses remaining")
+			}
+		})
+	}
+}
+
+func (s *AuthzTestSuite) TestMetadataAuthorizationMsgExec() {
+	tests := []struct {
+		name   string
+		auth   func(ids metadataAuthzScopes) *types.MetadataAuthorization
+		msg    func(ids metadataAuthzScopes) sdk.Msg
+		expErr func(ids metadataAuthzScopes) string
+		check  func(ctx sdk.Context, ids metadataAuthzScopes)
+	}{
+		{
+			name: "write to an existing scope on another specification",
+			auth: func(ids metadataAuthzScopes) *types.MetadataAuthorization {
+				return types.NewMetadataAuthorization(types.TypeURLMsgWriteScopeRequest, nil, []types.MetadataAddress{ids.specID1}, nil, 0)
+			},
+			msg: func(ids metadataAuthzScopes) sdk.Msg {
+				scope := types.NewScope(ids.scopeID2, ids.specID1, ownerPartyList(ids.grantee.String()), nil, "", false)
+				return types.NewMsgWriteScopeRequest(*scope, []string{ids.granter.String()})
+			},
+			expErr: func(ids metadataAuthzScopes) string {
+				return "authorization is restricted to specific scope specifications, which cannot be checked for a scope that might already exist: unauthorized"
+			},
+			check: func(ctx sdk.Context, ids metadataAuthzScopes) {
+				scope, found := s.app.MetadataKeeper.GetScope(ctx, ids.scopeID2)
+				s.Require().True(found, "GetScope found")
+				s.Assert().Equal(ids.specID2, scope.SpecificationId, "scope specification")
+				s.Assert().Equal(ownerPartyList(ids.granter.String()), scope.Owners, "scope owners")
+			},
+		},
+		{
+			name: "scope deletion with a scope specification restriction",
+			auth: func(ids metadataAuthzScopes) *types.MetadataAuthorization {
+				return types.NewMetadataAuthorization(types.TypeURLMsgDeleteScopeRequest, nil, []types.MetadataAddress{ids.specID1}, nil, 0)
+			},
+			msg: func(ids metadataAuthzScopes) sdk.Msg {
+				return types.NewMsgDeleteScopeRequest(ids.scopeID1, []string{ids.granter.String()})
+			},
+			expErr: func(ids metadataAuthzScopes) string {
+				return "authorization is restricted to specific scope specifications but no scope specification is involved: unauthorized"
+			},
+		},
+		{
+			name: "record deletion with a record name restriction",
+			auth: func(ids metadataAuthzScopes) *types.MetadataAuthorization {
+				return types.NewMetadataAuthorization(types.TypeURLMsgDeleteRecordRequest, nil, nil, []string{"allowed"}, 0)
+			},
+			msg: func(ids metadataAuthzScopes) sdk.Msg {
+				return types.NewMsgDeleteRecordRequest(ids.sessionID.MustGetAsRecordAddress("other"), []string{ids.granter.String()})
+			},
+			expErr: func(ids metadataAuthzScopes) string {
+				return "authorization is restricted to specific records, which cannot be checked for a record identified only by its id: unauthorized"
+			},
+		},
+		{
+			name: "value owner migration with a scope restriction",
+			auth: func(ids metadataAuthzScopes) *types.MetadataAuthorization {
+				return types.NewMetadataAuthorization(types.TypeURLMsgMigrateValueOwnerRequest, []types.MetadataAddress{ids.scopeID1}, nil, nil, 0)
+			},
+			msg: func(ids metadataAuthzScopes) sdk.Msg {
+				return types.NewMsgMigrateValueOwnerRequest(ids.granter, ids.grantee, []string{ids.granter.String()})
+			},
+			expErr: func(ids metadataAuthzScopes) string {
+				return "authorization is restricted to specific scopes or scope specifications, which cannot be checked for the scopes of a value owner: unauthorized"
+			},
+		},
+		{
+			name: "owner added to another scope",
+			auth: func(ids metadataAuthzScopes) *types.MetadataAuthorization {
+				return types.NewMetadataAuthorization(types.TypeURLMsgAddScopeOwnerRequest, []types.MetadataAddress{ids.scopeID1}, nil, nil, 0)
+			},
+			msg: func(ids metadataAuthzScopes) sdk.Msg {
+				return types.NewMsgAddScopeOwnerRequest(ids.scopeID2, ownerPartyList(ids.grantee.String()), []string{ids.granter.String()})
+			},
+			expErr: func(ids metadataAuthzScopes) string {
+				return fmt.Sprintf("scope %s is not allowed: unauthorized", ids.scopeID2)
+			},
+		},
+		{
+			name: "owner added to an allowed scope",
+			auth: func(ids metadataAuthzScopes) *types.MetadataAuthorization {
+				return types.NewMetadataAuthorization(types.TypeURLMsgAddScopeOwnerRequest, []types.MetadataAddress{ids.scopeID1}, nil, nil, 0)
+			},
+			msg: func(ids metadataAuthzScopes) sdk.Msg {
+				return types.NewMsgAddScopeOwnerRequest(ids.scopeID1, ownerPartyList(ids.grantee.String()), []string{ids.granter.String()})
+			},
+			check: func(ctx sdk.Context, ids metadataAuthzScopes) {
+				scope, found := s.app.MetadataKeeper.GetScope(ctx, ids.scopeID1)
+				s.Require().True(found, "GetScope found")
+				s.Assert().Equal(ownerPartyList(ids.granter.String(), ids.grantee.String()), scope.Owners, "scope owners")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			ctx := s.FreshCtx()
+			ids := s.newMetadataAuthzScopes(ctx)
+			exp := ctx.BlockTime().Add(time.Hour)
+			s.Require().NoError(s.app.AuthzKeeper.SaveGrant(ctx, ids.grantee, ids.granter, tc.auth(ids), &exp), "SaveGrant")
+
+			_, err := s.app.AuthzKeeper.DispatchActions(ctx, ids.grantee, []sdk.Msg{tc.msg(ids)})
+			if tc.expErr != nil {
+				s.Assert().EqualError(err, tc.expErr(ids), "DispatchActions")
+			} else {
+				s.Assert().NoError(err, "DispatchActions")
+			}
+			if tc.check != nil {
+				tc.check(ctx, ids)
+			}
+		})
+	}
+}
@@ -1,5 +1,11 @@
 package keeper
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
 	keeper Keeper
@@ -9,3 +15,21 @@ type Migrator struct {
 func NewMigrator(keeper Keeper) Migrator {
 	return Migrator{keeper: keeper}
 }
+
+// Migrate3to4 builds the record hash and input record indexes from all existing records.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	store := ctx.KVStore(m.keeper.storeKey)
+	return m.keeper.IterateRecords(ctx, types.MetadataAddress{}, func(record types.Record) bool {
+		m.keeper.indexRecord(store, record.GetRecordAddress(), &record, nil)
+		return false
+	})
+}
+
+// Migrate4to5 builds the number of records in each scope from all existing records.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	store := ctx.KVStore(m.keeper.storeKey)
+	return m.keeper.IterateRecords(ctx, types.MetadataAddress{}, func(record types.Record) bool {
+		m.keeper.addToRecordCount(store, record.GetRecordAddress(), 1)
+		return false
+	})
+}
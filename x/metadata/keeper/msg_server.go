@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/provenance-io/provenance/x/metadata/types"
 )
@@ -245,6 +246,142 @@ func (k msgServer) MigrateValueOwner(
 	return &types.MsgMigrateValueOwnerResponse{}, nil
 }
 
+// ListScopeForSale offers the value ownership of a scope for a price.
+func (k msgServer) ListScopeForSale(
+	goCtx context.Context,
+	msg *types.MsgListScopeForSaleRequest,
+) (*types.MsgListScopeForSaleResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "ListScopeForSale")
+	ctx := UnwrapMetadataContext(goCtx)
+
+	scope, found := k.GetScope(ctx, msg.ScopeId)
+	if !found {
+		return nil, sdkerrors.ErrNotFound.Wrapf("scope not found with id %s", msg.ScopeId)
+	}
+
+	if err := k.ValidateListScopeForSale(ctx, scope, msg); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	listing := types.NewScopeListing(scope.ScopeId, scope.ValueOwnerAddress, msg.Price, msg.Expiration)
+	k.SetScopeListing(ctx, listing)
+	k.EmitEvent(ctx, types.NewEventScopeListed(listing))
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_ListScopeForSale, msg.GetSignerStrs()))
+	return &types.MsgListScopeForSaleResponse{}, nil
+}
+
+// CancelScopeListing removes an offer to sell the value ownership of a scope.
+func (k msgServer) CancelScopeListing(
+	goCtx context.Context,
+	msg *types.MsgCancelScopeListingRequest,
+) (*types.MsgCancelScopeListingResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "CancelScopeListing")
+	ctx := UnwrapMetadataContext(goCtx)
+
+	listing, found := k.GetScopeListing(ctx, msg.ScopeId)
+	if !found {
+		return nil, sdkerrors.ErrNotFound.Wrapf("scope listing not found for id %s", msg.ScopeId)
+	}
+	scope, found := k.GetScope(ctx, msg.ScopeId)
+	if !found {
+		return nil, sdkerrors.ErrNotFound.Wrapf("scope not found with id %s", msg.ScopeId)
+	}
+
+	if err := k.ValidateCancelScopeListing(ctx, scope, msg); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	k.RemoveScopeListing(ctx, msg.ScopeId)
+	k.EmitEvent(ctx, types.NewEventScopeListingCancelled(listing))
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_CancelScopeListing, msg.GetSignerStrs()))
+	return &types.MsgCancelScopeListingResponse{}, nil
+}
+
+// BuyScope pays the listing price of a scope to its seller and makes the buyer the scope's value owner.
+func (k msgServer) BuyScope(
+	goCtx context.Context,
+	msg *types.MsgBuyScopeRequest,
+) (*types.MsgBuyScopeResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "BuyScope")
+	ctx := UnwrapMetadataContext(goCtx)
+
+	listing, found := k.GetScopeListing(ctx, msg.ScopeId)
+	if !found {
+		return nil, sdkerrors.ErrNotFound.Wrapf("scope listing not found for id %s", msg.ScopeId)
+	}
+	scope, found := k.GetScope(ctx, msg.ScopeId)
+	if !found {
+		return nil, sdkerrors.ErrNotFound.Wrapf("scope not found with id %s", msg.ScopeId)
+	}
+
+	if err := k.ValidateBuyScope(ctx, scope, listing, msg); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if err := k.Keeper.BuyScope(ctx, scope, listing, msg.Buyer); err != nil {
+		return nil, err
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_BuyScope, msg.GetSignerStrs()))
+	return &types.MsgBuyScopeResponse{}, nil
+}
+
+// LockScope places a legal hold on a scope, preventing changes to it until it is unlocked.
+func (k msgServer) LockScope(
+	goCtx context.Context,
+	msg *types.MsgLockScopeRequest,
+) (*types.MsgLockScopeResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "LockScope")
+	ctx := UnwrapMetadataContext(goCtx)
+
+	scope, found := k.GetScope(ctx, msg.ScopeId)
+	if !found {
+		return nil, sdkerrors.ErrNotFound.Wrapf("scope not found with id %s", msg.ScopeId)
+	}
+
+	lockedBy, err := k.ValidateLockScope(ctx, scope, msg)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	lock := types.NewScopeLock(scope.ScopeId, lockedBy, msg.Reason, ctx.BlockTime())
+	k.SetScopeLock(ctx, lock)
+	k.EmitEvent(ctx, types.NewEventScopeLocked(lock))
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_LockScope, msg.GetSignerStrs()))
+	return &types.MsgLockScopeResponse{}, nil
+}
+
+// UnlockScope removes the legal hold on a scope.
+func (k msgServer) UnlockScope(
+	goCtx context.Context,
+	msg *types.MsgUnlockScopeRequest,
+) (*types.MsgUnlockScopeResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "UnlockScope")
+	ctx := UnwrapMetadataContext(goCtx)
+
+	if _, found := k.GetScopeLock(ctx, msg.ScopeId); !found {
+		return nil, sdkerrors.ErrNotFound.Wrapf("scope lock not found for id %s", msg.ScopeId)
+	}
+	scope, found := k.GetScope(ctx, msg.ScopeId)
+	if !found {
+		return nil, sdkerrors.ErrNotFound.Wrapf("scope not found with id %s", msg.ScopeId)
+	}
+
+	unlockedBy, err := k.ValidateUnlockScope(ctx, scope, msg)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	k.RemoveScopeLock(ctx, scope.ScopeId)
+	k.EmitEvent(ctx, types.NewEventScopeUnlocked(scope.ScopeId, unlockedBy))
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_UnlockScope, msg.GetSignerStrs()))
+	return &types.MsgUnlockScopeResponse{}, nil
+}
+
 // WriteSession adds or updates a session context.
 func (k msgServer) WriteSession(
 	goCtx context.Context,
@@ -330,6 +467,70 @@ func (k msgServer) DeleteRecord(
 	return types.NewMsgDeleteRecordResponse(), nil
 }
 
+// WriteScopeBundle adds or updates a scope along with sessions and records in it.
+// Each entry is validated against the state left by the ones before it, and then all of them
+// are validated together with one signer check, so any authz authorization is only used once.
+func (k msgServer) WriteScopeBundle(
+	goCtx context.Context,
+	msg *types.MsgWriteScopeBundleRequest,
+) (*types.MsgWriteScopeBundleResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "WriteScopeBundle")
+	ctx := UnwrapMetadataContext(goCtx)
+
+	reqs := newSignerRequirements()
+	var existingScope *types.Scope
+	if e, found := k.GetScope(ctx, msg.Scope.ScopeId); found {
+		existingScope = &e
+	}
+	if err := k.validateWriteScope(ctx, existingScope, msg.Scope, msg, reqs); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("scope %s: %v", msg.Scope.ScopeId, err)
+	}
+	k.SetScope(ctx, msg.Scope)
+
+	sessionIDs := make([]types.MetadataAddress, len(msg.Sessions))
+	for i, session := range msg.Sessions {
+		var existing *types.Session
+		var existingAudit *types.AuditFields
+		if e, found := k.GetSession(ctx, session.SessionId); found {
+			existing = &e
+			existingAudit = existing.Audit
+		}
+		if err := k.validateWriteSession(ctx, existing, session, msg, reqs); err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("session %s: %v", session.SessionId, err)
+		}
+		session.Audit = existingAudit.UpdateAudit(ctx.BlockTime(), strings.Join(msg.Signers, ", "), "")
+		k.SetSession(ctx, session)
+		sessionIDs[i] = session.SessionId
+	}
+
+	recordIDs := make([]types.MetadataAddress, len(msg.Records))
+	for i, record := range msg.Records {
+		recordID, err := record.SessionId.AsRecordAddress(record.Name)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("record %q: %v", record.Name, err)
+		}
+		var existing *types.Record
+		if e, found := k.GetRecord(ctx, recordID); found {
+			existing = &e
+		}
+		if err = k.validateWriteRecord(ctx, existing, &record, msg, reqs); err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("record %q: %v", record.Name, err)
+		}
+		k.SetRecord(ctx, record)
+		if existing != nil && !existing.SessionId.Equals(record.SessionId) {
+			k.RemoveSession(ctx, existing.SessionId)
+		}
+		recordIDs[i] = recordID
+	}
+
+	if err := k.validateSignerRequirements(ctx, reqs, msg); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_WriteScopeBundle, msg.GetSignerStrs()))
+	return types.NewMsgWriteScopeBundleResponse(msg.Scope.ScopeId, sessionIDs, recordIDs), nil
+}
+
 // WriteScopeSpecification adds or updates a scope specification.
 func (k msgServer) WriteScopeSpecification(
 	goCtx context.Context,
@@ -382,6 +583,45 @@ func (k msgServer) DeleteScopeSpecification(
 	return types.NewMsgDeleteScopeSpecificationResponse(), nil
 }
 
+// MigrateScopesToSpec moves scopes from one scope specification to another.
+func (k msgServer) MigrateScopesToSpec(
+	goCtx context.Context,
+	msg *types.MsgMigrateScopesToSpecRequest,
+) (*types.MsgMigrateScopesToSpecResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "MigrateScopesToSpec")
+	ctx := UnwrapMetadataContext(goCtx)
+
+	fromSpec, found := k.GetScopeSpecification(ctx, msg.FromSpecificationId)
+	if !found {
+		return nil, sdkerrors.ErrNotFound.Wrapf("scope specification not found with id %s", msg.FromSpecificationId)
+	}
+	toSpec, found := k.GetScopeSpecification(ctx, msg.ToSpecificationId)
+	if !found {
+		return nil, sdkerrors.ErrNotFound.Wrapf("scope specification not found with id %s", msg.ToSpecificationId)
+	}
+	if err := k.ValidateMigrateScopesToSpec(ctx, fromSpec, toSpec, msg); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	scopeIDs, err := k.GetScopeIDsForMigration(ctx, msg)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	var migrated []types.MetadataAddress
+	var failures []types.ScopeMigrationFailure
+	for _, scopeID := range scopeIDs {
+		if err = k.MigrateScopeToSpec(ctx, scopeID, fromSpec, toSpec); err != nil {
+			failures = append(failures, types.ScopeMigrationFailure{ScopeId: scopeID, Reason: err.Error()})
+			continue
+		}
+		migrated = append(migrated, scopeID)
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_MigrateScopesToSpec, msg.GetSignerStrs()))
+	return types.NewMsgMigrateScopesToSpecResponse(migrated, failures), nil
+}
+
 // WriteContractSpecification adds or updates a contract specification.
 func (k msgServer) WriteContractSpecification(
 	goCtx context.Context,
@@ -727,3 +967,21 @@ func (k msgServer) SetAccountData(
 
 	return &types.MsgSetAccountDataResponse{}, nil
 }
+
+// UpdateParams is a governance proposal endpoint for updating the metadata module's params.
+func (k msgServer) UpdateParams(
+	goCtx context.Context,
+	msg *types.MsgUpdateParamsRequest,
+) (*types.MsgUpdateParamsResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "UpdateParams")
+	ctx := UnwrapMetadataContext(goCtx)
+
+	if msg.Authority != k.GetAuthority() {
+		return nil, govtypes.ErrInvalidSigner.Wrapf("expected %s got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	k.SetParams(ctx, msg.Params)
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_UpdateParams, msg.GetSignerStrs()))
+	return &types.MsgUpdateParamsResponse{}, nil
+}
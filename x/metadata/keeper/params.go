@@ -7,9 +7,63 @@ import (
 )
 
 // GetParams returns the total set of metadata parameters.
-func (k Keeper) GetParams(_ sdk.Context) (params types.Params) {
-	return types.Params{} // there are currently no params so no further action required here.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	return types.Params{
+		MaxHistoryVersions:    k.GetMaxHistoryVersions(ctx),
+		MaxScopeOwners:        k.GetMaxScopeOwners(ctx),
+		MaxDataAccess:         k.GetMaxDataAccess(ctx),
+		MaxRecordsPerScope:    k.GetMaxRecordsPerScope(ctx),
+		MaxRecordInputs:       k.GetMaxRecordInputs(ctx),
+		MaxRecordOutputs:      k.GetMaxRecordOutputs(ctx),
+		MaxSessionContextSize: k.GetMaxSessionContextSize(ctx),
+	}
 }
 
 // SetParams sets the metadata parameters to the param space.
-func (k Keeper) SetParams(_ sdk.Context, _ types.Params) {} // currently no params are supported
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetMaxHistoryVersions gets the configured number of versions to keep for each record and scope (or the default if unset)
+func (k Keeper) GetMaxHistoryVersions(ctx sdk.Context) uint32 {
+	return k.getUint32Param(ctx, types.ParamStoreKeyMaxHistoryVersions, types.DefaultMaxHistoryVersions)
+}
+
+// GetMaxScopeOwners gets the configured maximum number of owners a scope can have (or the default if unset)
+func (k Keeper) GetMaxScopeOwners(ctx sdk.Context) uint32 {
+	return k.getUint32Param(ctx, types.ParamStoreKeyMaxScopeOwners, types.DefaultMaxScopeOwners)
+}
+
+// GetMaxDataAccess gets the configured maximum number of data access addresses a scope can have (or the default if unset)
+func (k Keeper) GetMaxDataAccess(ctx sdk.Context) uint32 {
+	return k.getUint32Param(ctx, types.ParamStoreKeyMaxDataAccess, types.DefaultMaxDataAccess)
+}
+
+// GetMaxRecordsPerScope gets the configured maximum number of records a scope can have (or the default if unset)
+func (k Keeper) GetMaxRecordsPerScope(ctx sdk.Context) uint32 {
+	return k.getUint32Param(ctx, types.ParamStoreKeyMaxRecordsPerScope, types.DefaultMaxRecordsPerScope)
+}
+
+// GetMaxRecordInputs gets the configured maximum number of inputs a record can have (or the default if unset)
+func (k Keeper) GetMaxRecordInputs(ctx sdk.Context) uint32 {
+	return k.getUint32Param(ctx, types.ParamStoreKeyMaxRecordInputs, types.DefaultMaxRecordInputs)
+}
+
+// GetMaxRecordOutputs gets the configured maximum number of outputs a record can have (or the default if unset)
+func (k Keeper) GetMaxRecordOutputs(ctx sdk.Context) uint32 {
+	return k.getUint32Param(ctx, types.ParamStoreKeyMaxRecordOutputs, types.DefaultMaxRecordOutputs)
+}
+
+// GetMaxSessionContextSize gets the configured maximum number of bytes in a session's context (or the default if unset)
+func (k Keeper) GetMaxSessionContextSize(ctx sdk.Context) uint32 {
+	return k.getUint32Param(ctx, types.ParamStoreKeyMaxSessionContextSize, types.DefaultMaxSessionContextSize)
+}
+
+// getUint32Param gets the uint32 param with the provided key (or the provided default if unset)
+func (k Keeper) getUint32Param(ctx sdk.Context, key []byte, def uint32) (val uint32) {
+	val = def
+	if k.paramSpace.Has(ctx, key) {
+		k.paramSpace.Get(ctx, key, &val)
+	}
+	return
+}
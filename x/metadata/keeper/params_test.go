@@ -0,0 +1,267 @@
+package keeper_test
+
+import (
+	"github.com/google/uuid"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/keeper"
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// paramLimitsScope is a scope stored by newParamLimitsScopeFunc.
+type paramLimitsScope struct {
+	scope     types.Scope
+	sessionID types.MetadataAddress
+}
+
+// newParamLimitsScopeFunc stores new specifications that have user1 as an owner and the record specifications
+// "first", "second" and "third". It returns a function that stores a new scope using those specifications,
+// with a session and the named records.
+func (s *ScopeKeeperTestSuite) newParamLimitsScopeFunc(ctx sdk.Context) func(owners, dataAccess, records []string) paramLimitsScope {
+	ownerRole := []types.PartyType{types.PartyType_PARTY_TYPE_OWNER}
+	contractSpecUUID := uuid.New()
+	contractSpecID := types.ContractSpecMetadataAddress(contractSpecUUID)
+	s.app.MetadataKeeper.SetContractSpecification(ctx, *types.NewContractSpecification(contractSpecID, nil,
+		[]string{s.user1}, ownerRole, types.NewContractSpecificationSourceHash("HASH"), "contract"))
+	scopeSpecID := types.ScopeSpecMetadataAddress(uuid.New())
+	s.app.MetadataKeeper.SetScopeSpecification(ctx, *types.NewScopeSpecification(scopeSpecID, nil,
+		[]string{s.user1}, ownerRole, []types.MetadataAddress{contractSpecID}))
+	for _, name := range []string{"first", "second", "third"} {
+		s.app.MetadataKeeper.SetRecordSpecification(ctx, *types.NewRecordSpecification(types.RecordSpecMetadataAddress(contractSpecUUID, name),
+			name, nil, "string", types.DefinitionType_DEFINITION_TYPE_RECORD_LIST, ownerRole))
+	}
+
+	return func(owners, dataAccess, records []string) paramLimitsScope {
+		scopeUUID := uuid.New()
+		rv := paramLimitsScope{
+			scope:     *types.NewScope(types.ScopeMetadataAddress(scopeUUID), scopeSpecID, ownerPartyList(owners...), dataAccess, "", false),
+			sessionID: types.SessionMetadataAddress(scopeUUID, uuid.New()),
+		}
+		s.app.MetadataKeeper.SetScope(ctx, rv.scope)
+		s.app.MetadataKeeper.SetSession(ctx, *types.NewSession("session", rv.sessionID, contractSpecID, ownerPartyList(s.user1), nil))
+		for _, name := range records {
+			s.app.MetadataKeeper.SetRecord(ctx, *newParamLimitsRecord(rv.sessionID, name, nil, 1))
+		}
+		return rv
+	}
+}
+
+// newParamLimitsRecord returns a record in the session with the given inputs and number of outputs.
+func newParamLimitsRecord(sessionID types.MetadataAddress, name string, inputs []types.RecordInput, outputs int) *types.Record {
+	process := *types.NewProcess("process", &types.Process_Hash{Hash: "HASH"}, "process")
+	recordOutputs := make([]types.RecordOutput, outputs)
+	for i := range recordOutputs {
+		recordOutputs[i] = *types.NewRecordOutput("out", types.ResultStatus_RESULT_STATUS_PASS)
+	}
+	return types.NewRecord(name, sessionID, process, inputs, recordOutputs, nil)
+}
+
+func (s *ScopeKeeperTestSuite) TestUpdateParams() {
+	params := types.NewParams(types.DefaultMaxHistoryVersions, 2, 1, 2, 1, 1, 4)
+
+	tests := []struct {
+		name      string
+		authority string
+		expErr    string
+	}{
+		{
+			name:      "not governance",
+			authority: s.user1,
+			expErr:    "expected " + s.app.MetadataKeeper.GetAuthority() + " got " + s.user1,
+		},
+		{
+			name:      "governance",
+			authority: s.app.MetadataKeeper.GetAuthority(),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			ctx := s.FreshCtx()
+			server := keeper.NewMsgServerImpl(s.app.MetadataKeeper)
+			_, err := server.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParamsRequest(tc.authority, params))
+			if len(tc.expErr) > 0 {
+				s.Assert().ErrorContains(err, tc.expErr, "UpdateParams")
+				s.Assert().Equal(types.DefaultParams(), s.app.MetadataKeeper.GetParams(ctx), "params after failed update")
+				return
+			}
+			s.Require().NoError(err, "UpdateParams")
+			s.Assert().Equal(params, s.app.MetadataKeeper.GetParams(ctx), "params after update")
+		})
+	}
+}
+
+func (s *ScopeKeeperTestSuite) TestParamLimits() {
+	limits := types.NewParams(types.DefaultMaxHistoryVersions, 2, 1, 2, 1, 1, 4)
+	noLimits := types.NewParams(types.DefaultMaxHistoryVersions, 0, 0, 0, 0, 0, 0)
+	inputs := []types.RecordInput{
+		*types.NewRecordInput("in1", &types.RecordInput_Hash{Hash: "HASH1"}, "string", types.RecordInputStatus_Proposed),
+		*types.NewRecordInput("in2", &types.RecordInput_Hash{Hash: "HASH2"}, "string", types.RecordInputStatus_Proposed),
+	}
+	signers := []string{s.user1}
+
+	tests := []struct {
+		name string
+		// owners, dataAccess and records are those of the existing scope. The owners default to user1.
+		owners     []string
+		dataAccess []string
+		records    []string
+		params     types.Params
+		run        func(server types.MsgServer, ctx sdk.Context, pls paramLimitsScope) error
+		expErr     string
+	}{
+		{
+			name:   "scope written with too many owners",
+			params: limits,
+			run: func(server types.MsgServer, ctx sdk.Context, pls paramLimitsScope) error {
+				pls.scope.Owners = ownerPartyList(s.user1, s.user2, s.user3)
+				_, err := server.WriteScope(sdk.WrapSDKContext(ctx), types.NewMsgWriteScopeRequest(pls.scope, signers))
+				return err
+			},
+			expErr: "scope cannot have more than 2 owners, got 3",
+		},
+		{
+			name:   "scope written within the limits",
+			params: limits,
+			run: func(server types.MsgServer, ctx sdk.Context, pls paramLimitsScope) error {
+				pls.scope.Owners = ownerPartyList(s.user1, s.user2)
+				pls.scope.DataAccess = []string{s.user3}
+				_, err := server.WriteScope(sdk.WrapSDKContext(ctx), types.NewMsgWriteScopeRequest(pls.scope, signers))
+				return err
+			},
+		},
+		{
+			name:       "data access added over the limit",
+			dataAccess: []string{s.user2},
+			params:     limits,
+			run: func(server types.MsgServer, ctx sdk.Context, pls paramLimitsScope) error {
+				_, err := server.AddScopeDataAccess(sdk.WrapSDKContext(ctx), types.NewMsgAddScopeDataAccessRequest(pls.scope.ScopeId, []string{s.user3}, signers))
+				return err
+			},
+			expErr: "scope cannot have more than 1 data access addresses, got 2",
+		},
+		{
+			name:   "owners added over the limit",
+			params: limits,
+			run: func(server types.MsgServer, ctx sdk.Context, pls paramLimitsScope) error {
+				_, err := server.AddScopeOwner(sdk.WrapSDKContext(ctx), types.NewMsgAddScopeOwnerRequest(pls.scope.ScopeId, ownerPartyList(s.user2, s.user3), signers))
+				return err
+			},
+			expErr: "scope cannot have more than 2 owners, got 3",
+		},
+		{
+			name:   "session written with a context that is too large",
+			params: limits,
+			run: func(server types.MsgServer, ctx sdk.Context, pls paramLimitsScope) error {
+				session, _ := s.app.MetadataKeeper.GetSession(ctx, pls.sessionID)
+				session.Context = []byte("12345")
+				_, err := server.WriteSession(sdk.WrapSDKContext(ctx), types.NewMsgWriteSessionRequest(session, signers))
+				return err
+			},
+			expErr: "session context cannot be more than 4 bytes, got 5",
+		},
+		{
+			name:   "session written with a context at the limit",
+			params: limits,
+			run: func(server types.MsgServer, ctx sdk.Context, pls paramLimitsScope) error {
+				session, _ := s.app.MetadataKeeper.GetSession(ctx, pls.sessionID)
+				session.Context = []byte("1234")
+				_, err := server.WriteSession(sdk.WrapSDKContext(ctx), types.NewMsgWriteSessionRequest(session, signers))
+				return err
+			},
+		},
+		{
+			name:   "record written with too many outputs",
+			params: limits,
+			run: func(server types.MsgServer, ctx sdk.Context, pls paramLimitsScope) error {
+				record := newParamLimitsRecord(pls.sessionID, "first", nil, 2)
+				_, err := server.WriteRecord(sdk.WrapSDKContext(ctx), types.NewMsgWriteRecordRequest(*record, nil, "", signers, nil))
+				return err
+			},
+			expErr: "record cannot have more than 1 outputs, got 2",
+		},
+		{
+			name:   "record written with too many inputs",
+			params: limits,
+			run: func(server types.MsgServer, ctx sdk.Context, pls paramLimitsScope) error {
+				record := newParamLimitsRecord(pls.sessionID, "first", inputs, 1)
+				_, err := server.WriteRecord(sdk.WrapSDKContext(ctx), types.NewMsgWriteRecordRequest(*record, nil, "", signers, nil))
+				return err
+			},
+			expErr: "record cannot have more than 1 inputs, got 2",
+		},
+		{
+			name:    "record added to a scope at the record limit",
+			records: []string{"first", "second"},
+			params:  limits,
+			run: func(server types.MsgServer, ctx sdk.Context, pls paramLimitsScope) error {
+				record := newParamLimitsRecord(pls.sessionID, "third", nil, 1)
+				_, err := server.WriteRecord(sdk.WrapSDKContext(ctx), types.NewMsgWriteRecordRequest(*record, nil, "", signers, nil))
+				return err
+			},
+			expErr: "cannot have more than 2 records",
+		},
+		{
+			name:    "record updated in a scope at the record limit",
+			records: []string{"first", "second"},
+			params:  limits,
+			run: func(server types.MsgServer, ctx sdk.Context, pls paramLimitsScope) error {
+				record := newParamLimitsRecord(pls.sessionID, "first", nil, 1)
+				_, err := server.WriteRecord(sdk.WrapSDKContext(ctx), types.NewMsgWriteRecordRequest(*record, nil, "", signers, nil))
+				return err
+			},
+		},
+		{
+			name:    "record added without limits",
+			records: []string{"first", "second"},
+			params:  noLimits,
+			run: func(server types.MsgServer, ctx sdk.Context, pls paramLimitsScope) error {
+				record := newParamLimitsRecord(pls.sessionID, "third", nil, 1)
+				_, err := server.WriteRecord(sdk.WrapSDKContext(ctx), types.NewMsgWriteRecordRequest(*record, nil, "", signers, nil))
+				return err
+			},
+		},
+		{
+			name:   "owners removed from a scope over a lowered limit",
+			owners: []string{s.user1, s.user2, s.user3},
+			params: types.NewParams(types.DefaultMaxHistoryVersions, 1, 0, 0, 0, 0, 0),
+			run: func(server types.MsgServer, ctx sdk.Context, pls paramLimitsScope) error {
+				_, err := server.DeleteScopeOwner(sdk.WrapSDKContext(ctx), types.NewMsgDeleteScopeOwnerRequest(pls.scope.ScopeId,
+					[]string{s.user3}, []string{s.user1, s.user2, s.user3}))
+				return err
+			},
+		},
+		{
+			name:   "owners added to a scope over a lowered limit",
+			owners: []string{s.user1, s.user2},
+			params: types.NewParams(types.DefaultMaxHistoryVersions, 1, 0, 0, 0, 0, 0),
+			run: func(server types.MsgServer, ctx sdk.Context, pls paramLimitsScope) error {
+				_, err := server.AddScopeOwner(sdk.WrapSDKContext(ctx), types.NewMsgAddScopeOwnerRequest(pls.scope.ScopeId,
+					ownerPartyList(s.user3), []string{s.user1, s.user2}))
+				return err
+			},
+			expErr: "scope cannot have more than 1 owners, got 3",
+		},
+	}
+
+	ctx := s.FreshCtx()
+	newScope := s.newParamLimitsScopeFunc(ctx)
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			owners := tc.owners
+			if len(owners) == 0 {
+				owners = []string{s.user1}
+			}
+			pls := newScope(owners, tc.dataAccess, tc.records)
+			s.app.MetadataKeeper.SetParams(ctx, tc.params)
+
+			err := tc.run(keeper.NewMsgServerImpl(s.app.MetadataKeeper), ctx, pls)
+			if len(tc.expErr) > 0 {
+				s.Assert().ErrorContains(err, tc.expErr, "msg error")
+			} else {
+				s.Assert().NoError(err, "msg error")
+			}
+		})
+	}
+}
@@ -26,10 +26,7 @@ var _ types.QueryServer = Keeper{}
 func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "Params")
 	ctx := sdk.UnwrapSDKContext(c)
-	var params types.Params
-	k.paramSpace.GetParamSet(ctx, &params)
-
-	resp := &types.QueryParamsResponse{Params: params}
+	resp := &types.QueryParamsResponse{Params: k.GetParams(ctx)}
 	if req != nil && req.IncludeRequest {
 		resp.Request = req
 	}
@@ -1199,6 +1196,171 @@ func (k Keeper) AccountData(c context.Context, req *types.AccountDataRequest) (*
 	return &types.AccountDataResponse{Value: value}, nil
 }
 
+// ScopeListing returns the open offer to sell the value ownership of a scope.
+func (k Keeper) ScopeListing(c context.Context, req *types.ScopeListingRequest) (*types.ScopeListingResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "ScopeListing")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	scopeAddr, err := ParseScopeID(req.ScopeId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	listing, found := k.GetScopeListing(ctx, scopeAddr)
+	if !found {
+		return nil, sdkerrors.ErrNotFound.Wrapf("scope listing not found for id %s", scopeAddr)
+	}
+
+	return &types.ScopeListingResponse{Listing: listing}, nil
+}
+
+// RecordHistory returns the stored versions of a record.
+func (k Keeper) RecordHistory(c context.Context, req *types.RecordHistoryRequest) (*types.RecordHistoryResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "RecordHistory")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	recordAddr, err := ParseRecordAddr(req.RecordAddr)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	retval := types.RecordHistoryResponse{}
+	if req.Height > 0 {
+		version, found := k.GetRecordVersionAt(ctx, recordAddr, req.Height)
+		if !found {
+			return nil, sdkerrors.ErrNotFound.Wrapf("no version of record %s found at height %d", recordAddr, req.Height)
+		}
+		retval.Versions = append(retval.Versions, version)
+		return &retval, nil
+	}
+
+	versionStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetRecordVersionIteratorPrefix(recordAddr))
+	pageRes, err := query.Paginate(versionStore, req.Pagination, func(_, value []byte) error {
+		var version types.RecordVersion
+		if vErr := k.cdc.Unmarshal(value, &version); vErr != nil {
+			return vErr
+		}
+		retval.Versions = append(retval.Versions, version)
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("paginate: %v", err)
+	}
+	retval.Pagination = pageRes
+	return &retval, nil
+}
+
+// ScopeHistory returns the stored versions of a scope's owners and value owner.
+func (k Keeper) ScopeHistory(c context.Context, req *types.ScopeHistoryRequest) (*types.ScopeHistoryResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "ScopeHistory")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	scopeAddr, err := ParseScopeID(req.ScopeId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	retval := types.ScopeHistoryResponse{}
+	if req.Height > 0 {
+		version, found := k.GetScopeVersionAt(ctx, scopeAddr, req.Height)
+		if !found {
+			return nil, sdkerrors.ErrNotFound.Wrapf("no version of scope %s found at height %d", scopeAddr, req.Height)
+		}
+		retval.Versions = append(retval.Versions, version)
+		return &retval, nil
+	}
+
+	versionStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetScopeVersionIteratorPrefix(scopeAddr))
+	pageRes, err := query.Paginate(versionStore, req.Pagination, func(_, value []byte) error {
+		var version types.ScopeVersion
+		if vErr := k.cdc.Unmarshal(value, &version); vErr != nil {
+			return vErr
+		}
+		retval.Versions = append(retval.Versions, version)
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("paginate: %v", err)
+	}
+	retval.Pagination = pageRes
+	return &retval, nil
+}
+
+// RecordsByHash returns the records that have an output or hash input with the provided hash.
+func (k Keeper) RecordsByHash(c context.Context, req *types.RecordsByHashRequest) (*types.RecordsByHashResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "RecordsByHash")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	retval := types.RecordsByHashResponse{}
+	if req.IncludeRequest {
+		retval.Request = req
+	}
+
+	if len(req.Hash) == 0 {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap("hash cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	hashStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetRecordHashCacheIteratorPrefix(req.Hash))
+	pageRes, err := query.Paginate(hashStore, req.Pagination, func(key, _ []byte) error {
+		recordAddr := types.MetadataAddress(key)
+		record, found := k.GetRecord(ctx, recordAddr)
+		if !found {
+			retval.Records = append(retval.Records, types.WrapRecordNotFound(recordAddr))
+			return nil
+		}
+		retval.Records = append(retval.Records, types.WrapRecord(&record, !req.ExcludeIdInfo))
+		return nil
+	})
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrapf("paginate: %v", err)
+	}
+	retval.Pagination = pageRes
+	return &retval, nil
+}
+
+// RecordLineage returns the graph of records connected to a record through record inputs.
+func (k Keeper) RecordLineage(c context.Context, req *types.RecordLineageRequest) (*types.RecordLineageResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "RecordLineage")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	recordAddr, err := ParseRecordAddr(req.RecordAddr)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	if _, known := types.LineageDirection_name[int32(req.Direction)]; !known {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("unknown lineage direction %d", req.Direction)
+	}
+	maxDepth := req.MaxDepth
+	if maxDepth == 0 {
+		maxDepth = types.DefaultLineageDepth
+	}
+	if maxDepth > types.MaxLineageDepth {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("max depth %d cannot be more than %d", maxDepth, types.MaxLineageDepth)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, found := k.GetRecord(ctx, recordAddr); !found {
+		return nil, sdkerrors.ErrNotFound.Wrapf("record not found for id %s", recordAddr)
+	}
+
+	nodes, edges := k.GetRecordLineage(ctx, recordAddr, req.Direction, maxDepth)
+	return &types.RecordLineageResponse{Nodes: nodes, Edges: edges}, nil
+}
+
 func IsBase64(s string) bool {
 	_, err := b64.StdEncoding.DecodeString(s)
 	return err == nil
@@ -1371,3 +1533,28 @@ func getPageRequest(req hasPageRequest) *query.PageRequest {
 	}
 	return pageRequest
 }
+
+// LockedScopes returns all of the legal holds that are currently on scopes.
+func (k Keeper) LockedScopes(c context.Context, req *types.LockedScopesRequest) (*types.LockedScopesResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "LockedScopes")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	retval := types.LockedScopesResponse{}
+	ctx := sdk.UnwrapSDKContext(c)
+	lockStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScopeLockKeyPrefix)
+	pageRes, err := query.Paginate(lockStore, req.Pagination, func(_, value []byte) error {
+		var lock types.ScopeLock
+		if err := k.cdc.Unmarshal(value, &lock); err != nil {
+			return err
+		}
+		retval.Locks = append(retval.Locks, lock)
+		return nil
+	})
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrapf("paginate: %v", err)
+	}
+	retval.Pagination = pageRes
+	return &retval, nil
+}
@@ -61,14 +61,26 @@ func (k Keeper) SetRecord(ctx sdk.Context, record types.Record) {
 
 	recordID := record.SessionId.MustGetAsRecordAddress(record.Name)
 
+	var oldRecord *types.Record
 	var event proto.Message = types.NewEventRecordCreated(recordID, record.SessionId)
 	action := types.TLAction_Created
-	if store.Has(recordID) {
+	oldRecordBytes := store.Get(recordID)
+	if oldRecordBytes != nil {
 		event = types.NewEventRecordUpdated(recordID, record.SessionId)
 		action = types.TLAction_Updated
+		oldRecord = &types.Record{}
+		if err := k.cdc.Unmarshal(oldRecordBytes, oldRecord); err != nil {
+			k.Logger(ctx).Error("could not unmarshal old record", "err", err, "recordId", recordID.String(), "oldRecordBytes", oldRecordBytes)
+			oldRecord = nil
+		}
 	}
 
 	store.Set(recordID, b)
+	k.indexRecord(store, recordID, &record, oldRecord)
+	if oldRecordBytes == nil {
+		k.addToRecordCount(store, recordID, 1)
+	}
+	k.addRecordVersion(ctx, recordID, record, false)
 	k.EmitEvent(ctx, event)
 	defer types.GetIncObjFunc(types.TLType_Record, action)
 }
@@ -84,6 +96,9 @@ func (k Keeper) RemoveRecord(ctx sdk.Context, id types.MetadataAddress) {
 	}
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(id)
+	k.indexRecord(store, id, nil, &record)
+	k.addToRecordCount(store, id, -1)
+	k.addRecordVersion(ctx, id, record, true)
 	k.EmitEvent(ctx, types.NewEventRecordDeleted(id))
 	defer types.GetIncObjFunc(types.TLType_Record, types.TLAction_Deleted)
 
@@ -114,6 +129,37 @@ func (k Keeper) IterateRecords(ctx sdk.Context, scopeID types.MetadataAddress, h
 	return nil
 }
 
+// GetRecordCount returns the number of records in the provided scope.
+func (k Keeper) GetRecordCount(ctx sdk.Context, scopeID types.MetadataAddress) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetRecordCountKey(scopeID))
+	if len(bz) == 0 {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// addToRecordCount adds the provided amount to the number of records in the scope of the provided record.
+// The entry is deleted once a scope has no more records.
+func (k Keeper) addToRecordCount(store sdk.KVStore, recordID types.MetadataAddress, amount int64) {
+	scopeID, err := recordID.AsScopeAddress()
+	if err != nil {
+		return
+	}
+	key := types.GetRecordCountKey(scopeID)
+	var count uint64
+	if bz := store.Get(key); len(bz) > 0 {
+		count = sdk.BigEndianToUint64(bz)
+	}
+	switch {
+	case amount < 0 && count <= uint64(-amount):
+		store.Delete(key)
+	case amount < 0:
+		store.Set(key, sdk.Uint64ToBigEndian(count-uint64(-amount)))
+	default:
+		store.Set(key, sdk.Uint64ToBigEndian(count+uint64(amount)))
+	}
+}
+
 // ValidateWriteRecord checks the current record and the proposed record to determine if the proposed changes are valid
 // based on the existing state
 // Note: The proposed parameter is a reference here so that the SpecificationId can be set in cases when it's not provided.
@@ -122,10 +168,29 @@ func (k Keeper) ValidateWriteRecord(
 	existing *types.Record,
 	msg *types.MsgWriteRecordRequest,
 ) error {
-	proposed := &msg.Record
+	return k.validateWriteRecord(ctx, existing, &msg.Record, msg, nil)
+}
+
+// validateWriteRecord checks the existing and proposed record like ValidateWriteRecord does.
+// The signers of the provided msg are the ones checked against the record's requirements.
+// If the proposed record does not have a specification id, it is set.
+// If reqs is not nil, the signer requirements are added to it instead of being checked.
+func (k Keeper) validateWriteRecord(
+	ctx sdk.Context,
+	existing *types.Record,
+	proposed *types.Record,
+	msg types.MetadataMsg,
+	reqs *signerRequirements,
+) error {
 	if err := proposed.ValidateBasic(); err != nil {
 		return err
 	}
+	if limit := k.GetMaxRecordInputs(ctx); types.ExceedsLimit(limit, len(proposed.Inputs)) {
+		return fmt.Errorf("record cannot have more than %d inputs, got %d", limit, len(proposed.Inputs))
+	}
+	if limit := k.GetMaxRecordOutputs(ctx); types.ExceedsLimit(limit, len(proposed.Outputs)) {
+		return fmt.Errorf("record cannot have more than %d outputs, got %d", limit, len(proposed.Outputs))
+	}
 
 	var oldSession *types.Session
 	if existing != nil {
@@ -158,6 +223,14 @@ func (k Keeper) ValidateWriteRecord(
 	if !found {
 		return fmt.Errorf("scope not found with id %s", scopeID)
 	}
+	if err := k.validateScopeNotLocked(ctx, scopeID); err != nil {
+		return err
+	}
+	if existing == nil {
+		if limit := k.GetMaxRecordsPerScope(ctx); limit > 0 && k.GetRecordCount(ctx, scopeID) >= uint64(limit) {
+			return fmt.Errorf("scope %s cannot have more than %d records", scopeID, limit)
+		}
+	}
 	session, found := k.GetSession(ctx, proposed.SessionId)
 	if !found {
 		return fmt.Errorf("session not found for session id %s", proposed.SessionId)
@@ -192,7 +265,9 @@ func (k Keeper) ValidateWriteRecord(
 		if oldSession != nil {
 			reqSigs = append(reqSigs, oldSession.GetAllPartyAddresses()...)
 		}
-		if err = k.ValidateSignersWithoutParties(ctx, reqSigs, msg); err != nil {
+		if reqs != nil {
+			reqs.addSigners(reqSigs)
+		} else if err = k.ValidateSignersWithoutParties(ctx, reqSigs, msg); err != nil {
 			return err
 		}
 	} else {
@@ -206,7 +281,9 @@ func (k Keeper) ValidateWriteRecord(
 		if oldSession != nil {
 			reqParties = append(reqParties, oldSession.Parties...)
 		}
-		if err = k.ValidateSignersWithParties(ctx, reqParties, session.Parties, recSpec.ResponsibleParties, msg); err != nil {
+		if reqs != nil {
+			reqs.addParties(fmt.Sprintf("record %q", proposed.Name), reqParties, session.Parties, recSpec.ResponsibleParties, true)
+		} else if err = k.ValidateSignersWithParties(ctx, reqParties, session.Parties, recSpec.ResponsibleParties, msg); err != nil {
 			return err
 		}
 	}
@@ -303,6 +380,38 @@ func (k Keeper) ValidateWriteRecord(
 	// case types.DefinitionType_DEFINITION_TYPE_PROPOSED: ignored
 	// case types.DefinitionType_DEFINITION_TYPE_UNSPECIFIED: ignored
 
+	// Make sure the inline value conforms to its spec.
+	if proposed.Value != nil {
+		if err = k.validateRecordValue(ctx, session.SpecificationId, recSpec, *proposed.Value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateRecordValue makes sure an inline record value has the record specification's type name,
+// and satisfies the contract specification's value schema for that type name (if there is one).
+func (k Keeper) validateRecordValue(
+	ctx sdk.Context,
+	contractSpecID types.MetadataAddress,
+	recSpec types.RecordSpecification,
+	value types.RecordValue,
+) error {
+	if value.TypeName != recSpec.TypeName {
+		return fmt.Errorf("value has TypeName %s but spec calls for %s", value.TypeName, recSpec.TypeName)
+	}
+	contractSpec, found := k.GetContractSpecification(ctx, contractSpecID)
+	if !found {
+		return fmt.Errorf("contract specification not found with id %s", contractSpecID)
+	}
+	schema, found := contractSpec.GetValueSchema(value.TypeName)
+	if !found {
+		return nil
+	}
+	if err := schema.ValidateValue(value.Json); err != nil {
+		return fmt.Errorf("value does not satisfy the %s schema: %w", value.TypeName, err)
+	}
 	return nil
 }
 
@@ -317,6 +426,9 @@ func (k Keeper) ValidateDeleteRecord(ctx sdk.Context, proposedID types.MetadataA
 	// GetRecord found a record, so we know proposedID is good and will have a scope id.
 	// That's why we can ignore the error from AsScopeAddress().
 	scopeID, _ := proposedID.AsScopeAddress()
+	if err := k.validateScopeNotLocked(ctx, scopeID); err != nil {
+		return err
+	}
 	var scope *types.Scope
 	if s, found := k.GetScope(ctx, scopeID); found {
 		scope = &s
@@ -351,3 +463,52 @@ func (k Keeper) ValidateDeleteRecord(ctx sdk.Context, proposedID types.MetadataA
 
 	return nil
 }
+
+// getRecordIndexKeys gets the index entry keys for a record.
+func getRecordIndexKeys(recordID types.MetadataAddress, record *types.Record) [][]byte {
+	if record == nil {
+		return nil
+	}
+	var rv [][]byte
+	for _, hash := range record.GetHashes() {
+		rv = append(rv, types.GetRecordHashCacheKey(hash, recordID))
+	}
+	for _, inputRecordID := range record.GetInputRecordIDs() {
+		rv = append(rv, types.GetRecordInputCacheKey(inputRecordID, recordID))
+	}
+	return rv
+}
+
+// indexRecord updates the hash and input record index entries for a record.
+//
+// When adding a new record:  indexRecord(store, recordID, record, nil)
+//
+// When deleting a record:  indexRecord(store, recordID, nil, record)
+//
+// When updating a record:  indexRecord(store, recordID, newRecord, oldRecord)
+func (k Keeper) indexRecord(store sdk.KVStore, recordID types.MetadataAddress, newRecord, oldRecord *types.Record) {
+	newKeys := getRecordIndexKeys(recordID, newRecord)
+	oldKeys := getRecordIndexKeys(recordID, oldRecord)
+
+	have := func(keys [][]byte) map[string]bool {
+		rv := make(map[string]bool, len(keys))
+		for _, key := range keys {
+			rv[string(key)] = true
+		}
+		return rv
+	}
+	inNew := have(newKeys)
+	inOld := have(oldKeys)
+
+	// Iterating over the key lists (instead of the maps) keeps the store writes deterministic.
+	for _, key := range oldKeys {
+		if !inNew[string(key)] {
+			store.Delete(key)
+		}
+	}
+	for _, key := range newKeys {
+		if !inOld[string(key)] {
+			store.Set(key, []byte{0x01})
+		}
+	}
+}
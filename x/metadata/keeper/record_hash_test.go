@@ -0,0 +1,136 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/keeper"
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// newHashedRecord returns a record in the suite's session with an output with the given hash and the given inputs.
+func (s *RecordKeeperTestSuite) newHashedRecord(name string, outputHash string, inputs ...types.RecordInput) types.Record {
+	process := *types.NewProcess("process", &types.Process_Hash{Hash: "HASH"}, "process")
+	return *types.NewRecord(name, s.sessionID, process, inputs,
+		[]types.RecordOutput{*types.NewRecordOutput(outputHash, types.ResultStatus_RESULT_STATUS_PASS)}, nil)
+}
+
+// recordIDsByHash returns the ids of the records found by the RecordsByHash query for the given hash.
+func (s *RecordKeeperTestSuite) recordIDsByHash(ctx sdk.Context, hash string) []types.MetadataAddress {
+	resp, err := s.app.MetadataKeeper.RecordsByHash(sdk.WrapSDKContext(ctx), &types.RecordsByHashRequest{Hash: hash})
+	s.Require().NoError(err, "RecordsByHash(%q)", hash)
+	var rv []types.MetadataAddress
+	for _, r := range resp.Records {
+		rv = append(rv, r.RecordIdInfo.RecordId)
+	}
+	return rv
+}
+
+func (s *RecordKeeperTestSuite) TestRecordHashIndex() {
+	ctx := s.FreshCtx()
+	docInput := *types.NewRecordInput("doc", &types.RecordInput_Hash{Hash: "input_doc"}, "pdf", types.RecordInputStatus_Proposed)
+	record1 := s.newHashedRecord("record1", "shared_doc", docInput)
+	record1ID := s.sessionID.MustGetAsRecordAddress(record1.Name)
+	record2ID := s.sessionID.MustGetAsRecordAddress("record2")
+
+	// These are run in order against the same state.
+	tests := []struct {
+		name      string
+		change    func()
+		expByHash map[string][]types.MetadataAddress
+	}{
+		{
+			name: "records written",
+			change: func() {
+				s.app.MetadataKeeper.SetRecord(ctx, record1)
+				s.app.MetadataKeeper.SetRecord(ctx, s.newHashedRecord("record2", "shared_doc"))
+			},
+			expByHash: map[string][]types.MetadataAddress{
+				"input_doc":   {record1ID},
+				"shared_doc":  {record1ID, record2ID},
+				"unknown_doc": nil,
+			},
+		},
+		{
+			name: "record updated only indexes its new hashes",
+			change: func() {
+				s.app.MetadataKeeper.SetRecord(ctx, s.newHashedRecord("record1", "new_doc", docInput))
+			},
+			expByHash: map[string][]types.MetadataAddress{
+				"input_doc":  {record1ID},
+				"shared_doc": {record2ID},
+				"new_doc":    {record1ID},
+			},
+		},
+		{
+			name: "index removed then rebuilt by the migration",
+			change: func() {
+				store := ctx.KVStore(s.app.GetKey(types.StoreKey))
+				for _, hash := range []string{"new_doc", "input_doc", "shared_doc"} {
+					store.Delete(types.GetRecordHashCacheKey(hash, record1ID))
+					store.Delete(types.GetRecordHashCacheKey(hash, record2ID))
+				}
+				s.Require().Empty(s.recordIDsByHash(ctx, "new_doc"), "records with the new hash after clearing the index")
+				s.Require().NoError(keeper.NewMigrator(s.app.MetadataKeeper).Migrate3to4(ctx), "Migrate3to4")
+			},
+			expByHash: map[string][]types.MetadataAddress{
+				"input_doc":  {record1ID},
+				"shared_doc": {record2ID},
+				"new_doc":    {record1ID},
+			},
+		},
+		{
+			name:   "record removed",
+			change: func() { s.app.MetadataKeeper.RemoveRecord(ctx, record1ID) },
+			expByHash: map[string][]types.MetadataAddress{
+				"input_doc":  nil,
+				"shared_doc": {record2ID},
+				"new_doc":    nil,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			tc.change()
+			for hash, exp := range tc.expByHash {
+				s.Assert().ElementsMatch(exp, s.recordIDsByHash(ctx, hash), "records with the hash %q", hash)
+			}
+		})
+	}
+}
+
+func (s *RecordKeeperTestSuite) TestRecordsByHash() {
+	ctx := s.FreshCtx()
+	docInput := *types.NewRecordInput("doc", &types.RecordInput_Hash{Hash: "input_doc"}, "pdf", types.RecordInputStatus_Proposed)
+	s.app.MetadataKeeper.SetRecord(ctx, s.newHashedRecord("record1", "output_doc", docInput))
+
+	tests := []struct {
+		name     string
+		hash     string
+		expNames []string
+		expErr   string
+	}{
+		{name: "input hash", hash: "input_doc", expNames: []string{"record1"}},
+		{name: "output hash", hash: "output_doc", expNames: []string{"record1"}},
+		{name: "unknown hash", hash: "unknown_doc"},
+		{name: "no hash", expErr: "hash cannot be empty: invalid request"},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			resp, err := s.app.MetadataKeeper.RecordsByHash(sdk.WrapSDKContext(ctx), &types.RecordsByHashRequest{Hash: tc.hash})
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, tc.expErr, "RecordsByHash")
+				return
+			}
+			s.Require().NoError(err, "RecordsByHash")
+
+			var names []string
+			for _, r := range resp.Records {
+				names = append(names, r.Record.Name)
+				s.Assert().Equal(s.scopeID.String(), r.RecordIdInfo.ScopeIdInfo.ScopeAddr, "record %q scope", r.Record.Name)
+			}
+			s.Assert().Equal(tc.expNames, names, "record names")
+		})
+	}
+}
@@ -141,6 +141,77 @@ func (s *RecordKeeperTestSuite) TestMetadataRecordIterator() {
 
 }
 
+func (s *RecordKeeperTestSuite) TestGetRecordCount() {
+	ctx := s.FreshCtx()
+	process := *types.NewProcess("processname", &types.Process_Hash{Hash: "HASH"}, "process_method")
+	newRecord := func(name string) types.Record {
+		return *types.NewRecord(name, s.sessionID, process, []types.RecordInput{}, []types.RecordOutput{}, s.recordSpecID)
+	}
+	recordID := func(name string) types.MetadataAddress {
+		return s.sessionID.MustGetAsRecordAddress(name)
+	}
+	otherScopeID := types.ScopeMetadataAddress(uuid.New())
+
+	// These are run in order against the same state.
+	tests := []struct {
+		name   string
+		change func()
+		exp    uint64
+	}{
+		{
+			name:   "no records",
+			change: func() {},
+			exp:    0,
+		},
+		{
+			name:   "new record",
+			change: func() { s.app.MetadataKeeper.SetRecord(ctx, newRecord("first")) },
+			exp:    1,
+		},
+		{
+			name:   "another new record",
+			change: func() { s.app.MetadataKeeper.SetRecord(ctx, newRecord("second")) },
+			exp:    2,
+		},
+		{
+			name:   "existing record updated",
+			change: func() { s.app.MetadataKeeper.SetRecord(ctx, newRecord("second")) },
+			exp:    2,
+		},
+		{
+			name:   "record removed",
+			change: func() { s.app.MetadataKeeper.RemoveRecord(ctx, recordID("first")) },
+			exp:    1,
+		},
+		{
+			name:   "unknown record removed",
+			change: func() { s.app.MetadataKeeper.RemoveRecord(ctx, recordID("unknown")) },
+			exp:    1,
+		},
+		{
+			name: "count removed then rebuilt by the migration",
+			change: func() {
+				ctx.KVStore(s.app.GetKey(types.StoreKey)).Delete(types.GetRecordCountKey(s.scopeID))
+				s.Require().NoError(keeper.NewMigrator(s.app.MetadataKeeper).Migrate4to5(ctx), "Migrate4to5")
+			},
+			exp: 1,
+		},
+		{
+			name:   "last record removed",
+			change: func() { s.app.MetadataKeeper.RemoveRecord(ctx, recordID("second")) },
+			exp:    0,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			tc.change()
+			s.Assert().Equal(tc.exp, s.app.MetadataKeeper.GetRecordCount(ctx, s.scopeID), "GetRecordCount")
+			s.Assert().Zero(s.app.MetadataKeeper.GetRecordCount(ctx, otherScopeID), "GetRecordCount of another scope")
+		})
+	}
+}
+
 func (s *RecordKeeperTestSuite) TestValidateDeleteRecord() {
 	pt := func(addr string, role types.PartyType, opt bool) types.Party {
 		return types.Party{
@@ -471,15 +542,18 @@ func (s *RecordKeeperTestSuite) TestValidateWriteRecord() {
 	)
 	s.app.MetadataKeeper.SetSession(ctx, *session)
 
+	recordTypeName := "TestRecordTypeName"
 	contractSpec := types.ContractSpecification{
 		SpecificationId: s.contractSpecID,
 		OwnerAddresses:  []string{s.user1},
 		PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
 		ClassName:       "classname",
+		ValueSchemas: []types.ValueSchema{
+			*types.NewValueSchema(recordTypeName, `{"type":"object","required":["amount"],"properties":{"amount":{"type":"integer","minimum":0}}}`),
+		},
 	}
 	s.app.MetadataKeeper.SetContractSpecification(ctx, contractSpec)
 	recordSpecID := types.RecordSpecMetadataAddress(s.contractSpecUUID, s.recordName)
-	recordTypeName := "TestRecordTypeName"
 	recordSpec := types.NewRecordSpecification(
 		recordSpecID,
 		s.recordName,
@@ -546,6 +620,11 @@ func (s *RecordKeeperTestSuite) TestValidateWriteRecord() {
 	)
 	goodInputs := []types.RecordInput{*goodInput}
 	goodOutputs := []types.RecordOutput{{Hash: "justsomeoutput", Status: types.ResultStatus_RESULT_STATUS_PASS}}
+	withValue := func(typeName, json string) *types.Record {
+		rv := types.NewRecord(s.recordName, sessionID, *process, goodInputs, goodOutputs, s.recordSpecID)
+		rv.Value = types.NewRecordValue(typeName, json)
+		return rv
+	}
 
 	randomScopeUUID := uuid.New()
 	randomScopeID := types.ScopeMetadataAddress(randomScopeUUID)
@@ -848,6 +927,30 @@ func (s *RecordKeeperTestSuite) TestValidateWriteRecord() {
 			partiesInvolved: ownerPartyList(s.user1),
 			errorMsg:        "invalid output count (expected > 0, got: 0)",
 		},
+		"value - valid": {
+			proposed:        withValue(recordTypeName, `{"amount":5}`),
+			signers:         []string{s.user1},
+			partiesInvolved: ownerPartyList(s.user1),
+			errorMsg:        "",
+		},
+		"value - type name wrong": {
+			proposed:        withValue("OtherTypeName", `{"amount":5}`),
+			signers:         []string{s.user1},
+			partiesInvolved: ownerPartyList(s.user1),
+			errorMsg:        "value has TypeName OtherTypeName but spec calls for " + recordTypeName,
+		},
+		"value - does not satisfy schema": {
+			proposed:        withValue(recordTypeName, `{"amount":-5}`),
+			signers:         []string{s.user1},
+			partiesInvolved: ownerPartyList(s.user1),
+			errorMsg:        "value does not satisfy the " + recordTypeName + " schema: $.amount: -5 is less than the minimum 0",
+		},
+		"value - invalid json": {
+			proposed:        withValue(recordTypeName, `{"amount":`),
+			signers:         []string{s.user1},
+			partiesInvolved: ownerPartyList(s.user1),
+			errorMsg:        "invalid record value: json is not valid",
+		},
 		"valid - empty specification id": {
 			existing: nil,
 			proposed: types.NewRecord(
@@ -123,6 +123,12 @@ func (k Keeper) SetScope(ctx sdk.Context, scope types.Scope) {
 
 	store.Set(scope.ScopeId, b)
 	k.indexScope(store, &scope, oldScope)
+	if oldScope != nil && oldScope.ValueOwnerAddress != scope.ValueOwnerAddress {
+		k.cancelScopeListing(ctx, scope.ScopeId)
+	}
+	if oldScope == nil || oldScope.ValueOwnerAddress != scope.ValueOwnerAddress || !types.EqualParties(oldScope.Owners, scope.Owners) {
+		k.addScopeVersion(ctx, scope, false)
+	}
 	k.EmitEvent(ctx, event)
 	defer types.GetIncObjFunc(types.TLType_Scope, action)
 }
@@ -154,7 +160,9 @@ func (k Keeper) RemoveScope(ctx sdk.Context, id types.MetadataAddress) {
 	// Sessions will be removed as the last record in each is deleted.
 
 	k.indexScope(store, nil, &scope)
+	k.cancelScopeListing(ctx, id)
 	store.Delete(id)
+	k.addScopeVersion(ctx, scope, true)
 	k.EmitEvent(ctx, types.NewEventScopeDeleted(scope.ScopeId))
 	defer types.GetIncObjFunc(types.TLType_Scope, types.TLAction_Deleted)
 }
@@ -175,6 +183,10 @@ func (k Keeper) SetScopeValueOwners(ctx sdk.Context, scopes []*types.Scope, newV
 		b := k.cdc.MustMarshal(&newScope)
 		store.Set(newScope.ScopeId, b)
 		k.indexScope(store, &newScope, oldScope)
+		if oldScope.ValueOwnerAddress != newValueOwner {
+			k.cancelScopeListing(ctx, oldScope.ScopeId)
+			k.addScopeVersion(ctx, newScope, false)
+		}
 		k.EmitEvent(ctx, types.NewEventScopeUpdated(oldScope.ScopeId))
 	}
 	types.GetIncObjFuncN(types.TLType_Scope, types.TLAction_Updated, len(scopes))()
@@ -298,16 +310,34 @@ func (k Keeper) ValidateWriteScope(
 	existing *types.Scope,
 	msg *types.MsgWriteScopeRequest,
 ) error {
-	proposed := msg.Scope
+	return k.validateWriteScope(ctx, existing, msg.Scope, msg, nil)
+}
+
+// validateWriteScope checks the existing and proposed scope like ValidateWriteScope does.
+// The signers of the provided msg are the ones checked against the scope's requirements.
+// If reqs is not nil, the signer requirements are added to it instead of being checked.
+func (k Keeper) validateWriteScope(
+	ctx sdk.Context,
+	existing *types.Scope,
+	proposed types.Scope,
+	msg types.MetadataMsg,
+	reqs *signerRequirements,
+) error {
 	if err := proposed.ValidateBasic(); err != nil {
 		return err
 	}
+	if err := k.validateScopeLimits(ctx, existing, proposed); err != nil {
+		return err
+	}
 
 	// IDs must match
 	if existing != nil {
 		if !proposed.ScopeId.Equals(existing.ScopeId) {
 			return fmt.Errorf("cannot update scope identifier. expected %s, got %s", existing.ScopeId, proposed.ScopeId)
 		}
+		if err := k.validateScopeNotLocked(ctx, existing.ScopeId); err != nil {
+			return err
+		}
 	}
 
 	if err := proposed.SpecificationId.Validate(); err != nil {
@@ -354,7 +384,9 @@ func (k Keeper) ValidateWriteScope(
 			//   - If not new, all existing owners must sign.
 			//   - Value owner signer restrictions are applied.
 			if existing != nil && !existing.Equals(proposed) {
-				if validatedParties, err = k.validateAllRequiredSigned(ctx, existing.GetAllOwnerAddresses(), msg); err != nil {
+				if reqs != nil {
+					reqs.addSigners(existing.GetAllOwnerAddresses())
+				} else if validatedParties, err = k.validateAllRequiredSigned(ctx, existing.GetAllOwnerAddresses(), msg); err != nil {
 					return err
 				}
 			}
@@ -367,7 +399,9 @@ func (k Keeper) ValidateWriteScope(
 			//   - Value owner signer restrictions are applied.
 			// Note: This means that a scope can be initially written without consideration for signers and roles.
 			if existing != nil {
-				if validatedParties, err = k.validateAllRequiredPartiesSigned(ctx, existing.Owners, existing.Owners, scopeSpec.PartiesInvolved, msg); err != nil {
+				if reqs != nil {
+					reqs.addParties("scope "+proposed.ScopeId.String(), existing.Owners, existing.Owners, scopeSpec.PartiesInvolved, false)
+				} else if validatedParties, err = k.validateAllRequiredPartiesSigned(ctx, existing.Owners, existing.Owners, scopeSpec.PartiesInvolved, msg); err != nil {
 					return err
 				}
 			}
@@ -379,10 +413,30 @@ func (k Keeper) ValidateWriteScope(
 		return err
 	}
 
+	if reqs != nil {
+		reqs.useSigners(usedSigners)
+		return nil
+	}
 	usedSigners.AlsoUse(GetUsedSigners(validatedParties))
 	return k.validateSmartContractSigners(ctx, usedSigners, msg)
 }
 
+// validateScopeLimits makes sure the proposed scope's owners and data access are within the limits set in the params.
+// A scope that is already over a limit (e.g. because the limit was lowered) can still be updated as long as it doesn't grow.
+func (k Keeper) validateScopeLimits(ctx sdk.Context, existing *types.Scope, proposed types.Scope) error {
+	var existingOwners, existingDataAccess int
+	if existing != nil {
+		existingOwners, existingDataAccess = len(existing.Owners), len(existing.DataAccess)
+	}
+	if limit := k.GetMaxScopeOwners(ctx); len(proposed.Owners) > existingOwners && types.ExceedsLimit(limit, len(proposed.Owners)) {
+		return fmt.Errorf("scope cannot have more than %d owners, got %d", limit, len(proposed.Owners))
+	}
+	if limit := k.GetMaxDataAccess(ctx); len(proposed.DataAccess) > existingDataAccess && types.ExceedsLimit(limit, len(proposed.DataAccess)) {
+		return fmt.Errorf("scope cannot have more than %d data access addresses, got %d", limit, len(proposed.DataAccess))
+	}
+	return nil
+}
+
 // ValidateDeleteScope checks the current scope and the proposed removal scope to determine if the proposed remove is valid
 // based on the existing state
 func (k Keeper) ValidateDeleteScope(ctx sdk.Context, msg *types.MsgDeleteScopeRequest) error {
@@ -390,6 +444,9 @@ func (k Keeper) ValidateDeleteScope(ctx sdk.Context, msg *types.MsgDeleteScopeRe
 	if !found {
 		return fmt.Errorf("scope not found with id %s", msg.ScopeId)
 	}
+	if err := k.validateScopeNotLocked(ctx, scope.ScopeId); err != nil {
+		return err
+	}
 
 	var err error
 	var validatedParties []*PartyDetails
@@ -490,6 +547,9 @@ func (k Keeper) ValidateAddScopeDataAccess(
 	if len(msg.DataAccess) < 1 {
 		return fmt.Errorf("data access list cannot be empty")
 	}
+	if limit := k.GetMaxDataAccess(ctx); types.ExceedsLimit(limit, len(existing.DataAccess)+len(msg.DataAccess)) {
+		return fmt.Errorf("scope cannot have more than %d data access addresses, got %d", limit, len(existing.DataAccess)+len(msg.DataAccess))
+	}
 
 	for _, da := range msg.DataAccess {
 		_, err := sdk.AccAddressFromBech32(da)
@@ -597,6 +657,12 @@ func (k Keeper) ValidateUpdateScopeOwners(
 	if err := proposed.ValidateOwnersBasic(); err != nil {
 		return err
 	}
+	if err := k.validateScopeNotLocked(ctx, existing.ScopeId); err != nil {
+		return err
+	}
+	if err := k.validateScopeLimits(ctx, &existing, proposed); err != nil {
+		return err
+	}
 
 	scopeSpec, found := k.GetScopeSpecification(ctx, proposed.SpecificationId)
 	if !found {
@@ -653,6 +719,9 @@ func (k Keeper) ValidateUpdateValueOwners(
 		if len(scope.ValueOwnerAddress) == 0 {
 			return fmt.Errorf("scope %s does not yet have a value owner", scope.ScopeId)
 		}
+		if err := k.validateScopeNotLocked(ctx, scope.ScopeId); err != nil {
+			return err
+		}
 		if !knownValueOwners[scope.ValueOwnerAddress] {
 			existingValueOwners = append(existingValueOwners, scope.ValueOwnerAddress)
 			knownValueOwners[scope.ValueOwnerAddress] = true
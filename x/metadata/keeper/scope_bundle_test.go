@@ -0,0 +1,229 @@
+package keeper_test
+
+import (
+	"github.com/google/uuid"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/provenance-io/provenance/x/metadata/keeper"
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// newScopeBundleFunc stores new specifications that have user1 as an owner and the record specifications
+// "first", "second" and "third". It returns a function that creates bundles for a new scope using those specifications.
+func (s *ScopeKeeperTestSuite) newScopeBundleFunc(ctx sdk.Context) func(names []string, signers ...string) *types.MsgWriteScopeBundleRequest {
+	ownerRole := []types.PartyType{types.PartyType_PARTY_TYPE_OWNER}
+	contractSpecUUID := uuid.New()
+	contractSpecID := types.ContractSpecMetadataAddress(contractSpecUUID)
+	s.app.MetadataKeeper.SetContractSpecification(ctx, *types.NewContractSpecification(contractSpecID, nil,
+		[]string{s.user1}, ownerRole, types.NewContractSpecificationSourceHash("HASH"), "contract"))
+	scopeSpecID := types.ScopeSpecMetadataAddress(uuid.New())
+	s.app.MetadataKeeper.SetScopeSpecification(ctx, *types.NewScopeSpecification(scopeSpecID, nil,
+		[]string{s.user1}, ownerRole, []types.MetadataAddress{contractSpecID}))
+	for _, name := range []string{"first", "second", "third"} {
+		s.app.MetadataKeeper.SetRecordSpecification(ctx, *types.NewRecordSpecification(types.RecordSpecMetadataAddress(contractSpecUUID, name),
+			name, nil, "string", types.DefinitionType_DEFINITION_TYPE_RECORD, ownerRole))
+	}
+
+	process := *types.NewProcess("process", &types.Process_Hash{Hash: "HASH"}, "process")
+	outputs := []types.RecordOutput{*types.NewRecordOutput("out", types.ResultStatus_RESULT_STATUS_PASS)}
+	return func(names []string, signers ...string) *types.MsgWriteScopeBundleRequest {
+		scopeUUID := uuid.New()
+		scope := *types.NewScope(types.ScopeMetadataAddress(scopeUUID), scopeSpecID, ownerPartyList(s.user1), nil, "", false)
+		session := *types.NewSession("session", types.SessionMetadataAddress(scopeUUID, uuid.New()), contractSpecID, ownerPartyList(s.user1), nil)
+		records := make([]types.Record, len(names))
+		for i, name := range names {
+			records[i] = *types.NewRecord(name, session.SessionId, process, nil, outputs, nil)
+		}
+		return types.NewMsgWriteScopeBundleRequest(scope, []types.Session{session}, records, signers)
+	}
+}
+
+func (s *ScopeKeeperTestSuite) TestWriteScopeBundle() {
+	tests := []struct {
+		name          string
+		existingScope bool
+		records       []string
+		signers       []string
+		expErr        string
+	}{
+		{
+			name:    "scope with a session and records",
+			records: []string{"first", "second"},
+			signers: []string{s.user1},
+		},
+		{
+			name:    "scope with a session and no records",
+			signers: []string{s.user1},
+		},
+		{
+			name:    "record without a record specification",
+			records: []string{"first", "fourth"},
+			signers: []string{s.user1},
+			expErr:  `record "fourth": record specification not found`,
+		},
+		{
+			name:          "existing scope owner did not sign",
+			existingScope: true,
+			records:       []string{"first"},
+			signers:       []string{s.user2},
+			expErr:        "missing signature: " + s.user1,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			ctx := s.FreshCtx().WithEventManager(sdk.NewEventManager())
+			msg := s.newScopeBundleFunc(ctx)(tc.records, tc.signers...)
+			sessionID := msg.Sessions[0].SessionId
+			if tc.existingScope {
+				s.app.MetadataKeeper.SetScope(ctx, msg.Scope)
+			}
+
+			server := keeper.NewMsgServerImpl(s.app.MetadataKeeper)
+			resp, err := server.WriteScopeBundle(sdk.WrapSDKContext(ctx), msg)
+			if len(tc.expErr) > 0 {
+				s.Assert().ErrorContains(err, tc.expErr, "WriteScopeBundle")
+				return
+			}
+			s.Require().NoError(err, "WriteScopeBundle")
+
+			recordIDs := make([]types.MetadataAddress, len(tc.records))
+			for i, name := range tc.records {
+				recordIDs[i] = sessionID.MustGetAsRecordAddress(name)
+				record, found := s.app.MetadataKeeper.GetRecord(ctx, recordIDs[i])
+				if s.Assert().True(found, "record %q found", name) {
+					s.Assert().False(record.SpecificationId.Empty(), "record %q specification id", name)
+				}
+			}
+			s.Assert().Equal(types.NewMsgWriteScopeBundleResponse(msg.Scope.ScopeId, []types.MetadataAddress{sessionID}, recordIDs),
+				resp, "WriteScopeBundle response")
+			_, found := s.app.MetadataKeeper.GetScope(ctx, msg.Scope.ScopeId)
+			s.Assert().True(found, "scope found")
+			session, found := s.app.MetadataKeeper.GetSession(ctx, sessionID)
+			if s.Assert().True(found, "session found") && s.Assert().NotNil(session.Audit, "session audit") {
+				s.Assert().Equal(s.user1, session.Audit.CreatedBy, "session created by")
+			}
+			expEvent, err := sdk.TypedEventToEvent(types.NewEventTxCompleted(types.TxEndpoint_WriteScopeBundle, msg.Signers))
+			s.Require().NoError(err, "TypedEventToEvent")
+			s.Assert().Contains(ctx.EventManager().Events(), expEvent, "emitted events")
+		})
+	}
+}
+
+func (s *ScopeKeeperTestSuite) TestWriteScopeBundleSignerCheck() {
+	// The scope owner must sign for the session, and both session parties must sign for the record.
+	// Those are combined into one signer check, so each address is only checked once for the whole bundle.
+	tests := []struct {
+		name    string
+		rollup  bool
+		grant   func(ctx sdk.Context)
+		signers []string
+		expErr  string
+	}{
+		{
+			name:    "signed by both",
+			signers: []string{s.user1, s.user3},
+		},
+		{
+			name:    "signed by the scope owner only",
+			signers: []string{s.user1},
+			expErr:  "missing signature: " + s.user3 + ": invalid request",
+		},
+		{
+			name:    "signed by neither",
+			signers: []string{s.user2},
+			expErr:  "missing signatures: " + s.user1 + ", " + s.user3 + ": invalid request",
+		},
+		{
+			name: "scope owner authorized the other party",
+			grant: func(ctx sdk.Context) {
+				s.Require().NoError(s.app.AuthzKeeper.SaveGrant(ctx, s.user3Addr, s.user1Addr,
+					authz.NewGenericAuthorization(types.TypeURLMsgWriteScopeBundleRequest), nil), "SaveGrant")
+			},
+			signers: []string{s.user3},
+		},
+		{
+			name:    "party rollup signed by both",
+			rollup:  true,
+			signers: []string{s.user1, s.user3},
+		},
+		{
+			name:    "party rollup signed by the scope owner only",
+			rollup:  true,
+			signers: []string{s.user1},
+			expErr:  "missing signature: " + s.user3 + ": invalid request",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			ctx := s.FreshCtx()
+			if tc.grant != nil {
+				tc.grant(ctx)
+			}
+			msg := s.newScopeBundleFunc(ctx)([]string{"first"}, tc.signers...)
+			msg.Sessions[0].Parties = append(msg.Sessions[0].Parties, types.Party{Address: s.user3, Role: types.PartyType_PARTY_TYPE_AFFILIATE})
+			if tc.rollup {
+				msg.Scope.Owners = msg.Sessions[0].Parties
+				msg.Scope.RequirePartyRollup = true
+			}
+
+			server := keeper.NewMsgServerImpl(s.app.MetadataKeeper)
+			_, err := server.WriteScopeBundle(sdk.WrapSDKContext(ctx), msg)
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, tc.expErr, "WriteScopeBundle")
+			} else {
+				s.Assert().NoError(err, "WriteScopeBundle")
+			}
+		})
+	}
+}
+
+func (s *ScopeKeeperTestSuite) TestWriteScopeBundleAuthorization() {
+	ctx := s.FreshCtx()
+	newBundle := s.newScopeBundleFunc(ctx)
+	auth := types.NewMetadataAuthorization(types.TypeURLMsgWriteScopeBundleRequest, nil, nil, []string{"first", "second"}, 1)
+	s.Require().NoError(s.app.AuthzKeeper.SaveGrant(ctx, s.user2Addr, s.user1Addr, auth, nil), "SaveGrant")
+
+	// These are run in order against the same grant.
+	tests := []struct {
+		name    string
+		records []string
+		expErr  string
+		expAuth bool
+	}{
+		{
+			name:    "record not allowed by the authorization",
+			records: []string{"first", "third"},
+			expErr:  "missing signature: " + s.user1,
+			expAuth: true,
+		},
+		{
+			name:    "several records used as one use of the authorization",
+			records: []string{"first", "second"},
+			expAuth: false,
+		},
+		{
+			name:    "after the last use of the authorization",
+			records: []string{"first"},
+			expErr:  "missing signature: " + s.user1,
+			expAuth: false,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			server := keeper.NewMsgServerImpl(s.app.MetadataKeeper)
+			_, err := server.WriteScopeBundle(sdk.WrapSDKContext(s.FreshCtx()), newBundle(tc.records, s.user2))
+			if len(tc.expErr) > 0 {
+				s.Assert().ErrorContains(err, tc.expErr, "WriteScopeBundle")
+			} else {
+				s.Assert().NoError(err, "WriteScopeBundle")
+			}
+			granted, _ := s.app.AuthzKeeper.GetAuthorization(ctx, s.user2Addr, s.user1Addr, types.TypeURLMsgWriteScopeBundleRequest)
+			s.Assert().Equal(tc.expAuth, granted != nil, "authorization exists")
+		})
+	}
+}
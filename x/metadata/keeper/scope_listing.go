@@ -0,0 +1,184 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// GetScopeListing returns the offer to sell the value ownership of the scope with the given id.
+func (k Keeper) GetScopeListing(ctx sdk.Context, scopeID types.MetadataAddress) (listing types.ScopeListing, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetScopeListingKey(scopeID))
+	if b == nil {
+		return types.ScopeListing{}, false
+	}
+	if err := k.cdc.Unmarshal(b, &listing); err != nil {
+		k.Logger(ctx).Error("could not unmarshal scope listing", "err", err, "scopeId", scopeID.String())
+		return types.ScopeListing{}, false
+	}
+	return listing, true
+}
+
+// SetScopeListing stores an offer to sell the value ownership of a scope, replacing any existing one.
+func (k Keeper) SetScopeListing(ctx sdk.Context, listing types.ScopeListing) {
+	store := ctx.KVStore(k.storeKey)
+	if existing, found := k.GetScopeListing(ctx, listing.ScopeId); found {
+		store.Delete(types.GetScopeListingExpirationKey(existing.Expiration, existing.ScopeId))
+	}
+	store.Set(types.GetScopeListingKey(listing.ScopeId), k.cdc.MustMarshal(&listing))
+	store.Set(types.GetScopeListingExpirationKey(listing.Expiration, listing.ScopeId), []byte{0x01})
+}
+
+// RemoveScopeListing deletes the offer to sell the value ownership of the scope with the given id.
+func (k Keeper) RemoveScopeListing(ctx sdk.Context, scopeID types.MetadataAddress) {
+	store := ctx.KVStore(k.storeKey)
+	if existing, found := k.GetScopeListing(ctx, scopeID); found {
+		store.Delete(types.GetScopeListingExpirationKey(existing.Expiration, scopeID))
+	}
+	store.Delete(types.GetScopeListingKey(scopeID))
+}
+
+// RemoveExpiredScopeListings deletes up to limit (zero for no limit) of the scope listings that have expired
+// by the current block time, emitting a cancelled event for each. It returns the number of listings deleted.
+func (k Keeper) RemoveExpiredScopeListings(ctx sdk.Context, limit int) int {
+	store := ctx.KVStore(k.storeKey)
+	blockTimePrefix := types.GetScopeListingExpirationIteratorPrefix(ctx.BlockTime())
+
+	var expiredKeys [][]byte
+	it := store.Iterator(types.ScopeListingExpirationKeyPrefix, sdk.PrefixEndBytes(blockTimePrefix))
+	for ; it.Valid(); it.Next() {
+		expiredKeys = append(expiredKeys, it.Key())
+		if limit != 0 && len(expiredKeys) >= limit {
+			break
+		}
+	}
+	it.Close()
+
+	for _, key := range expiredKeys {
+		store.Delete(key)
+		k.cancelScopeListing(ctx, types.MetadataAddress(key[len(blockTimePrefix):]))
+	}
+	return len(expiredKeys)
+}
+
+// IterateScopeListings processes all stored scope listings with the given handler.
+func (k Keeper) IterateScopeListings(ctx sdk.Context, handler func(listing types.ScopeListing) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, types.ScopeListingKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var listing types.ScopeListing
+		if err := k.cdc.Unmarshal(it.Value(), &listing); err != nil {
+			return err
+		}
+		if handler(listing) {
+			break
+		}
+	}
+	return nil
+}
+
+// cancelScopeListing deletes a scope's listing (if it has one) and emits an event about it.
+// It's used when a scope is deleted or its value owner changes, since the listing no longer applies,
+// and when the listing expires.
+func (k Keeper) cancelScopeListing(ctx sdk.Context, scopeID types.MetadataAddress) {
+	listing, found := k.GetScopeListing(ctx, scopeID)
+	if !found {
+		return
+	}
+	k.RemoveScopeListing(ctx, scopeID)
+	k.EmitEvent(ctx, types.NewEventScopeListingCancelled(listing))
+}
+
+// ValidateListScopeForSale makes sure that the scope's value owner has signed the msg,
+// and that the provided scope can be listed for sale until the given expiration.
+func (k Keeper) ValidateListScopeForSale(ctx sdk.Context, scope types.Scope, msg *types.MsgListScopeForSaleRequest) error {
+	if len(scope.ValueOwnerAddress) == 0 {
+		return fmt.Errorf("scope %s does not yet have a value owner", scope.ScopeId)
+	}
+	if err := k.validateScopeNotLocked(ctx, scope.ScopeId); err != nil {
+		return err
+	}
+	if !msg.Expiration.After(ctx.BlockTime()) {
+		return fmt.Errorf("expiration %s must be after the current block time %s",
+			msg.Expiration.UTC().Format(time.RFC3339), ctx.BlockTime().UTC().Format(time.RFC3339))
+	}
+	return k.validateScopeValueOwnerSigned(ctx, scope, msg)
+}
+
+// ValidateCancelScopeListing makes sure that the scope's value owner has signed the msg.
+func (k Keeper) ValidateCancelScopeListing(ctx sdk.Context, scope types.Scope, msg *types.MsgCancelScopeListingRequest) error {
+	return k.validateScopeValueOwnerSigned(ctx, scope, msg)
+}
+
+// validateScopeValueOwnerSigned makes sure that the msg is signed by those allowed to change the value owner of the scope.
+func (k Keeper) validateScopeValueOwnerSigned(ctx sdk.Context, scope types.Scope, msg types.MetadataMsg) error {
+	usedSigners, err := k.ValidateScopeValueOwnerUpdate(ctx, scope.ValueOwnerAddress, "", msg)
+	if err != nil {
+		return err
+	}
+	return k.validateSmartContractSigners(ctx, usedSigners, msg)
+}
+
+// ValidateBuyScope makes sure that the listing can still be used and that the buyer
+// is allowed to become the value owner of the scope for the price in the msg.
+func (k Keeper) ValidateBuyScope(
+	ctx sdk.Context,
+	scope types.Scope,
+	listing types.ScopeListing,
+	msg *types.MsgBuyScopeRequest,
+) error {
+	if listing.IsExpired(ctx.BlockTime()) {
+		return fmt.Errorf("listing for scope %s expired at %s", scope.ScopeId, listing.Expiration.UTC().Format(time.RFC3339))
+	}
+	if err := k.validateScopeNotLocked(ctx, scope.ScopeId); err != nil {
+		return err
+	}
+	if scope.ValueOwnerAddress != listing.Seller {
+		return fmt.Errorf("listing seller %s is no longer the value owner of scope %s", listing.Seller, scope.ScopeId)
+	}
+	if msg.Buyer == listing.Seller {
+		return fmt.Errorf("buyer %s is already the value owner of scope %s", msg.Buyer, scope.ScopeId)
+	}
+	// Not using Coins.IsEqual here because it panics when the denoms differ.
+	if msg.Price.String() != listing.Price.String() {
+		return fmt.Errorf("price %s does not equal the listing price %s", msg.Price, listing.Price)
+	}
+
+	signers := NewSignersWrapper(msg.GetSignerStrs())
+	usedSigners, err := k.validateScopeValueOwnerChangeToProposed(ctx, msg.Buyer, signers)
+	if err != nil {
+		return err
+	}
+	return k.validateSmartContractSigners(ctx, usedSigners.Use(msg.Buyer), msg)
+}
+
+// BuyScope pays the listing price from the buyer to the seller and makes the buyer the
+// value owner of the scope. The payment is a regular bank send, so all restrictions on
+// the price's denoms (e.g. those of restricted markers) are applied to it.
+//
+// Contract: The scope must not have been modified from its value as read from state.
+func (k Keeper) BuyScope(ctx sdk.Context, scope types.Scope, listing types.ScopeListing, buyer string) error {
+	buyerAddr, err := sdk.AccAddressFromBech32(buyer)
+	if err != nil {
+		return fmt.Errorf("invalid buyer address: %w", err)
+	}
+	sellerAddr, err := sdk.AccAddressFromBech32(listing.Seller)
+	if err != nil {
+		return fmt.Errorf("invalid seller address: %w", err)
+	}
+
+	if err = k.bankKeeper.SendCoins(ctx, buyerAddr, sellerAddr, listing.Price); err != nil {
+		return fmt.Errorf("could not pay %s to %s for scope %s: %w", listing.Price, listing.Seller, scope.ScopeId, err)
+	}
+
+	// Remove the listing first so that changing the value owner doesn't also report it as cancelled.
+	k.RemoveScopeListing(ctx, scope.ScopeId)
+	k.SetScopeValueOwners(ctx, []*types.Scope{&scope}, buyer)
+	k.EmitEvent(ctx, types.NewEventScopeSold(listing, buyer))
+	return nil
+}
@@ -0,0 +1,347 @@
+package keeper_test
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	"github.com/provenance-io/provenance/x/metadata/keeper"
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// newListingCtx returns a fresh context with a block time and a new event manager.
+func (s *ScopeKeeperTestSuite) newListingCtx() sdk.Context {
+	return s.FreshCtx().WithBlockTime(time.Now().UTC().Truncate(time.Second)).WithEventManager(sdk.NewEventManager())
+}
+
+// newListableScope stores a new scope owned by user1 with user1 as its value owner.
+// If price is not empty, the scope is also listed for that price until an hour after the block time.
+func (s *ScopeKeeperTestSuite) newListableScope(ctx sdk.Context, price sdk.Coins) types.Scope {
+	scope := types.NewScope(types.ScopeMetadataAddress(uuid.New()), nil, ownerPartyList(s.user1), nil, s.user1, false)
+	s.app.MetadataKeeper.SetScope(ctx, *scope)
+	if !price.Empty() {
+		s.app.MetadataKeeper.SetScopeListing(ctx, types.NewScopeListing(scope.ScopeId, s.user1, price, ctx.BlockTime().Add(time.Hour)))
+	}
+	return *scope
+}
+
+// expandListingErr replaces the {scope_id}, {block_time} and {expiration} placeholders in an expected error.
+func expandListingErr(expErr string, scopeID types.MetadataAddress, blockTime, expiration time.Time) string {
+	return strings.NewReplacer(
+		"{scope_id}", scopeID.String(),
+		"{block_time}", blockTime.Format(time.RFC3339),
+		"{expiration}", expiration.Format(time.RFC3339),
+	).Replace(expErr)
+}
+
+func (s *ScopeKeeperTestSuite) TestListScopeForSale() {
+	price := sdk.NewCoins(sdk.NewInt64Coin("scopecoin", 100))
+
+	tests := []struct {
+		name       string
+		listed     bool
+		unknown    bool
+		expiration time.Duration
+		signers    []string
+		expErr     string
+	}{
+		{
+			name:       "unknown scope",
+			unknown:    true,
+			expiration: time.Hour,
+			signers:    []string{s.user1},
+			expErr:     "scope not found with id {scope_id}: not found",
+		},
+		{
+			name:       "value owner did not sign",
+			expiration: time.Hour,
+			signers:    []string{s.user2},
+			expErr:     "missing signature from existing value owner " + s.user1 + ": invalid request",
+		},
+		{
+			name:    "expiration at the block time",
+			signers: []string{s.user1},
+			expErr:  "expiration {block_time} must be after the current block time {block_time}: invalid request",
+		},
+		{
+			name:       "new listing",
+			expiration: time.Hour,
+			signers:    []string{s.user1},
+		},
+		{
+			name:       "replacing a listing",
+			listed:     true,
+			expiration: 2 * time.Hour,
+			signers:    []string{s.user1},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			ctx := s.newListingCtx()
+			var listedPrice sdk.Coins
+			if tc.listed {
+				listedPrice = sdk.NewCoins(sdk.NewInt64Coin("scopecoin", 1))
+			}
+			scopeID := s.newListableScope(ctx, listedPrice).ScopeId
+			if tc.unknown {
+				scopeID = types.ScopeMetadataAddress(uuid.New())
+			}
+			expiration := ctx.BlockTime().Add(tc.expiration)
+
+			server := keeper.NewMsgServerImpl(s.app.MetadataKeeper)
+			_, err := server.ListScopeForSale(sdk.WrapSDKContext(ctx), types.NewMsgListScopeForSaleRequest(scopeID, price, expiration, tc.signers))
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, expandListingErr(tc.expErr, scopeID, ctx.BlockTime(), time.Time{}), "ListScopeForSale")
+				return
+			}
+			s.Require().NoError(err, "ListScopeForSale")
+
+			listing, found := s.app.MetadataKeeper.GetScopeListing(ctx, scopeID)
+			s.Require().True(found, "GetScopeListing found")
+			s.Assert().Equal(types.NewScopeListing(scopeID, s.user1, price, expiration), listing, "scope listing")
+			s.Assert().Contains(s.app.MetadataKeeper.ExportGenesis(ctx).ScopeListings, listing, "exported scope listings")
+		})
+	}
+}
+
+func (s *ScopeKeeperTestSuite) TestCancelScopeListing() {
+	price := sdk.NewCoins(sdk.NewInt64Coin("scopecoin", 100))
+
+	tests := []struct {
+		name    string
+		listed  bool
+		signers []string
+		expErr  string
+	}{
+		{
+			name:    "not listed",
+			signers: []string{s.user1},
+			expErr:  "scope listing not found for id {scope_id}: not found",
+		},
+		{
+			name:    "value owner did not sign",
+			listed:  true,
+			signers: []string{s.user2},
+			expErr:  "missing signature from existing value owner " + s.user1 + ": invalid request",
+		},
+		{
+			name:    "value owner",
+			listed:  true,
+			signers: []string{s.user1},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			ctx := s.newListingCtx()
+			var listedPrice sdk.Coins
+			if tc.listed {
+				listedPrice = price
+			}
+			scopeID := s.newListableScope(ctx, listedPrice).ScopeId
+
+			server := keeper.NewMsgServerImpl(s.app.MetadataKeeper)
+			_, err := server.CancelScopeListing(sdk.WrapSDKContext(ctx), types.NewMsgCancelScopeListingRequest(scopeID, tc.signers))
+			_, found := s.app.MetadataKeeper.GetScopeListing(ctx, scopeID)
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, expandListingErr(tc.expErr, scopeID, ctx.BlockTime(), time.Time{}), "CancelScopeListing")
+				s.Assert().Equal(tc.listed, found, "GetScopeListing found after failed cancel")
+				return
+			}
+			s.Require().NoError(err, "CancelScopeListing")
+			s.Assert().False(found, "GetScopeListing found after cancel")
+
+			_, err = server.BuyScope(sdk.WrapSDKContext(ctx), types.NewMsgBuyScopeRequest(scopeID, s.user2Addr, price, []string{s.user2}))
+			s.Assert().EqualError(err, "scope listing not found for id "+scopeID.String()+": not found", "BuyScope after cancel")
+		})
+	}
+}
+
+func (s *ScopeKeeperTestSuite) TestBuyScope() {
+	ctx := s.newListingCtx()
+	price := sdk.NewCoins(sdk.NewInt64Coin("scopecoin", 100))
+	other := sdk.AccAddress("other_______________")
+
+	// Restricted coins are only paid if the marker allows the transfer.
+	restrictedDenom := "restrictedscopecoin"
+	restricted := markertypes.NewMarkerAccount(
+		authtypes.NewBaseAccountWithAddress(markertypes.MustGetMarkerAddress(restrictedDenom)),
+		sdk.NewInt64Coin(restrictedDenom, 1000), other,
+		[]markertypes.AccessGrant{*markertypes.NewAccessGrant(other, []markertypes.Access{markertypes.Access_Mint, markertypes.Access_Withdraw})},
+		markertypes.StatusProposed, markertypes.MarkerType_RestrictedCoin, true, false, false, []string{},
+	)
+	s.Require().NoError(s.app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, restricted), "AddFinalizeAndActivateMarker")
+	restrictedPrice := sdk.NewCoins(sdk.NewInt64Coin(restrictedDenom, 10))
+
+	tests := []struct {
+		name      string
+		listPrice sdk.Coins
+		price     sdk.Coins
+		blockTime time.Duration
+		expErr    string
+	}{
+		{
+			name:   "not listed",
+			price:  price,
+			expErr: "scope listing not found for id {scope_id}: not found",
+		},
+		{
+			name:      "wrong price",
+			listPrice: price,
+			price:     sdk.NewCoins(sdk.NewInt64Coin("othercoin", 100)),
+			expErr:    "price 100othercoin does not equal the listing price 100scopecoin: invalid request",
+		},
+		{
+			name:      "expired listing",
+			listPrice: price,
+			price:     price,
+			blockTime: time.Hour,
+			expErr:    "listing for scope {scope_id} expired at {expiration}: invalid request",
+		},
+		{
+			name:      "restricted price without transfer permission",
+			listPrice: restrictedPrice,
+			price:     restrictedPrice,
+			expErr:    "could not pay 10restrictedscopecoin to " + s.user1 + " for scope {scope_id}: " + s.user2 + " does not have transfer permissions",
+		},
+		{
+			name:      "listing price paid",
+			listPrice: price,
+			price:     price,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			ctx := s.newListingCtx()
+			buyerFunds := sdk.NewCoins(sdk.NewInt64Coin("scopecoin", 500))
+			s.Require().NoError(testutil.FundAccount(s.app.BankKeeper, ctx, s.user2Addr, buyerFunds), "FundAccount buyer")
+			s.Require().NoError(testutil.FundAccount(s.app.BankKeeper, markertypes.WithBypass(ctx), s.user2Addr, restrictedPrice), "FundAccount buyer restricted")
+			scope := s.newListableScope(ctx, tc.listPrice)
+			listing, _ := s.app.MetadataKeeper.GetScopeListing(ctx, scope.ScopeId)
+			sellerBalance := s.app.BankKeeper.GetAllBalances(ctx, s.user1Addr)
+			buyCtx := ctx.WithBlockTime(ctx.BlockTime().Add(tc.blockTime))
+
+			server := keeper.NewMsgServerImpl(s.app.MetadataKeeper)
+			_, err := server.BuyScope(sdk.WrapSDKContext(buyCtx), types.NewMsgBuyScopeRequest(scope.ScopeId, s.user2Addr, tc.price, []string{s.user2}))
+			stored, found := s.app.MetadataKeeper.GetScope(ctx, scope.ScopeId)
+			s.Require().True(found, "GetScope found")
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, expandListingErr(tc.expErr, scope.ScopeId, ctx.BlockTime(), listing.Expiration), "BuyScope")
+				s.Assert().Equal(s.user1, stored.ValueOwnerAddress, "value owner after failed purchase")
+				return
+			}
+			s.Require().NoError(err, "BuyScope")
+
+			s.Assert().Equal(s.user2, stored.ValueOwnerAddress, "value owner after purchase")
+			s.Assert().Equal(sellerBalance.Add(price...).String(), s.app.BankKeeper.GetAllBalances(ctx, s.user1Addr).String(), "seller balance after purchase")
+			_, found = s.app.MetadataKeeper.GetScopeListing(ctx, scope.ScopeId)
+			s.Assert().False(found, "GetScopeListing found after purchase")
+			expEvent, err := sdk.TypedEventToEvent(types.NewEventScopeSold(listing, s.user2))
+			s.Require().NoError(err, "TypedEventToEvent")
+			s.Assert().Contains(ctx.EventManager().Events(), expEvent, "emitted events")
+		})
+	}
+}
+
+func (s *ScopeKeeperTestSuite) TestScopeListingDroppedOnChange() {
+	price := sdk.NewCoins(sdk.NewInt64Coin("scopecoin", 100))
+
+	tests := []struct {
+		name   string
+		change func(ctx sdk.Context, scope types.Scope)
+	}{
+		{
+			name: "value owner changed",
+			change: func(ctx sdk.Context, scope types.Scope) {
+				s.app.MetadataKeeper.SetScopeValueOwners(ctx, []*types.Scope{&scope}, s.user2)
+			},
+		},
+		{
+			name: "scope removed",
+			change: func(ctx sdk.Context, scope types.Scope) {
+				s.app.MetadataKeeper.RemoveScope(ctx, scope.ScopeId)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			ctx := s.newListingCtx()
+			scope := s.newListableScope(ctx, price)
+			tc.change(ctx, scope)
+			_, found := s.app.MetadataKeeper.GetScopeListing(ctx, scope.ScopeId)
+			s.Assert().False(found, "GetScopeListing found")
+		})
+	}
+}
+
+func (s *ScopeKeeperTestSuite) TestRemoveExpiredScopeListings() {
+	ctx := s.newListingCtx()
+	price := sdk.NewCoins(sdk.NewInt64Coin("scopecoin", 100))
+	// Each scope is listed until an hour after the block time. The second is then extended by an hour.
+	first := s.newListableScope(ctx, price)
+	second := s.newListableScope(ctx, price)
+	third := s.newListableScope(ctx, price)
+	extended := types.NewScopeListing(second.ScopeId, s.user1, price, ctx.BlockTime().Add(2*time.Hour))
+	s.app.MetadataKeeper.SetScopeListing(ctx, extended)
+
+	// These are run in order against the same state.
+	tests := []struct {
+		name       string
+		blockTime  time.Duration
+		limit      int
+		expRemoved int
+		expListed  []types.MetadataAddress
+		expGone    []types.MetadataAddress
+	}{
+		{
+			name:      "before any expiration",
+			blockTime: 59 * time.Minute,
+			expListed: []types.MetadataAddress{first.ScopeId, second.ScopeId, third.ScopeId},
+		},
+		{
+			name:       "at the expiration with a limit",
+			blockTime:  time.Hour,
+			limit:      1,
+			expRemoved: 1,
+		},
+		{
+			name:       "remaining listing at the old expiration",
+			blockTime:  time.Hour,
+			expRemoved: 1,
+			expListed:  []types.MetadataAddress{second.ScopeId},
+			expGone:    []types.MetadataAddress{first.ScopeId, third.ScopeId},
+		},
+		{
+			name:       "extended listing at its expiration",
+			blockTime:  2 * time.Hour,
+			expRemoved: 1,
+			expGone:    []types.MetadataAddress{first.ScopeId, second.ScopeId, third.ScopeId},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			blockCtx := ctx.WithBlockTime(ctx.BlockTime().Add(tc.blockTime)).WithEventManager(sdk.NewEventManager())
+			removed := s.app.MetadataKeeper.RemoveExpiredScopeListings(blockCtx, tc.limit)
+			s.Assert().Equal(tc.expRemoved, removed, "RemoveExpiredScopeListings")
+			s.Assert().Len(blockCtx.EventManager().Events(), tc.expRemoved, "emitted events")
+			for _, scopeID := range tc.expListed {
+				_, found := s.app.MetadataKeeper.GetScopeListing(blockCtx, scopeID)
+				s.Assert().True(found, "GetScopeListing(%s) found", scopeID)
+			}
+			for _, scopeID := range tc.expGone {
+				_, found := s.app.MetadataKeeper.GetScopeListing(blockCtx, scopeID)
+				s.Assert().False(found, "GetScopeListing(%s) found", scopeID)
+			}
+		})
+	}
+}
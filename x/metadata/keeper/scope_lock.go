@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// scopeLockRoles are the scope owner roles that are allowed to lock a scope.
+var scopeLockRoles = []types.PartyType{types.PartyType_PARTY_TYPE_CUSTODIAN, types.PartyType_PARTY_TYPE_CONTROLLER}
+
+// GetScopeLock returns the legal hold on the scope with the given id.
+func (k Keeper) GetScopeLock(ctx sdk.Context, scopeID types.MetadataAddress) (lock types.ScopeLock, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetScopeLockKey(scopeID))
+	if b == nil {
+		return types.ScopeLock{}, false
+	}
+	if err := k.cdc.Unmarshal(b, &lock); err != nil {
+		k.Logger(ctx).Error("could not unmarshal scope lock", "err", err, "scopeId", scopeID.String())
+		return types.ScopeLock{}, false
+	}
+	return lock, true
+}
+
+// SetScopeLock stores a legal hold on a scope, replacing any existing one.
+func (k Keeper) SetScopeLock(ctx sdk.Context, lock types.ScopeLock) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetScopeLockKey(lock.ScopeId), k.cdc.MustMarshal(&lock))
+}
+
+// RemoveScopeLock deletes the legal hold on the scope with the given id.
+func (k Keeper) RemoveScopeLock(ctx sdk.Context, scopeID types.MetadataAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetScopeLockKey(scopeID))
+}
+
+// IterateScopeLocks processes all stored scope locks with the given handler.
+func (k Keeper) IterateScopeLocks(ctx sdk.Context, handler func(lock types.ScopeLock) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, types.ScopeLockKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var lock types.ScopeLock
+		if err := k.cdc.Unmarshal(it.Value(), &lock); err != nil {
+			return err
+		}
+		if handler(lock) {
+			break
+		}
+	}
+	return nil
+}
+
+// validateScopeNotLocked returns an error if the scope with the given id is locked.
+func (k Keeper) validateScopeNotLocked(ctx sdk.Context, scopeID types.MetadataAddress) error {
+	if lock, found := k.GetScopeLock(ctx, scopeID); found {
+		return fmt.Errorf("scope %s is locked: %s", scopeID, lock.Reason)
+	}
+	return nil
+}
+
+// ValidateLockScope makes sure that the scope isn't already locked, and returns the signer that is locking it.
+func (k Keeper) ValidateLockScope(ctx sdk.Context, scope types.Scope, msg *types.MsgLockScopeRequest) (string, error) {
+	if lock, found := k.GetScopeLock(ctx, scope.ScopeId); found {
+		return "", fmt.Errorf("scope %s is already locked by %s", scope.ScopeId, lock.LockedBy)
+	}
+	return k.getScopeLocker(scope, msg)
+}
+
+// ValidateUnlockScope makes sure that the scope is locked, and returns the signer that is unlocking it.
+// Only governance or the address that locked the scope can unlock it, so one owner cannot lift a hold
+// placed by another.
+func (k Keeper) ValidateUnlockScope(ctx sdk.Context, scope types.Scope, msg *types.MsgUnlockScopeRequest) (string, error) {
+	lock, found := k.GetScopeLock(ctx, scope.ScopeId)
+	if !found {
+		return "", fmt.Errorf("scope %s is not locked", scope.ScopeId)
+	}
+	signers := msg.GetSignerStrs()
+	for _, signer := range signers {
+		if signer == k.GetAuthority() {
+			return signer, nil
+		}
+	}
+	if lock.LockedBy == k.GetAuthority() {
+		return "", fmt.Errorf("scope %s was locked by governance and can only be unlocked by governance", scope.ScopeId)
+	}
+	for _, signer := range signers {
+		if signer == lock.LockedBy {
+			return signer, nil
+		}
+	}
+	return "", fmt.Errorf("scope %s was locked by %s and can only be unlocked by that address or governance", scope.ScopeId, lock.LockedBy)
+}
+
+// getScopeLocker returns the signer that is allowed to lock the scope: either the governance module account,
+// or a scope owner with one of the scopeLockRoles.
+func (k Keeper) getScopeLocker(scope types.Scope, msg types.MetadataMsg) (string, error) {
+	signers := msg.GetSignerStrs()
+	for _, signer := range signers {
+		if signer == k.GetAuthority() {
+			return signer, nil
+		}
+	}
+	for _, signer := range signers {
+		for _, owner := range scope.Owners {
+			if owner.Address != signer {
+				continue
+			}
+			for _, role := range scopeLockRoles {
+				if owner.Role == role {
+					return signer, nil
+				}
+			}
+		}
+	}
+	return "", fmt.Errorf("scope %s can only be locked by governance or an owner with role %s or %s",
+		scope.ScopeId, scopeLockRoles[0].SimpleString(), scopeLockRoles[1].SimpleString())
+}
@@ -0,0 +1,275 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/keeper"
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// newLockableScope stores a new scope owned by user1 with user2 as a custodian and user3 as a controller.
+// If lockedBy is not empty, the scope is also locked by that address.
+func (s *ScopeKeeperTestSuite) newLockableScope(ctx sdk.Context, lockedBy string) types.Scope {
+	parties := append(ownerPartyList(s.user1),
+		types.Party{Address: s.user2, Role: types.PartyType_PARTY_TYPE_CUSTODIAN},
+		types.Party{Address: s.user3, Role: types.PartyType_PARTY_TYPE_CONTROLLER},
+	)
+	scope := types.NewScope(types.ScopeMetadataAddress(uuid.New()), s.scopeSpecID, parties, nil, s.user1, false)
+	s.app.MetadataKeeper.SetScope(ctx, *scope)
+	if len(lockedBy) > 0 {
+		s.app.MetadataKeeper.SetScopeLock(ctx, types.NewScopeLock(scope.ScopeId, lockedBy, "litigation", ctx.BlockTime()))
+	}
+	return *scope
+}
+
+func (s *ScopeKeeperTestSuite) TestLockScope() {
+	gov := s.app.MetadataKeeper.GetAuthority()
+	other := sdk.AccAddress("other_______________").String()
+
+	tests := []struct {
+		name     string
+		lockedBy string
+		unknown  bool
+		signers  []string
+		expErr   string
+		expBy    string
+	}{
+		{
+			name:    "owner without a lock role",
+			signers: []string{s.user1, other},
+			expErr:  "scope %s can only be locked by governance or an owner with role CUSTODIAN or CONTROLLER: invalid request",
+		},
+		{
+			name:    "unknown scope",
+			unknown: true,
+			signers: []string{s.user2},
+			expErr:  "scope not found with id %s: not found",
+		},
+		{
+			name:     "already locked",
+			lockedBy: s.user2,
+			signers:  []string{s.user3},
+			expErr:   "scope %s is already locked by " + s.user2 + ": invalid request",
+		},
+		{
+			name:    "custodian",
+			signers: []string{s.user1, s.user2},
+			expBy:   s.user2,
+		},
+		{
+			name:    "controller",
+			signers: []string{s.user3},
+			expBy:   s.user3,
+		},
+		{
+			name:    "governance",
+			signers: []string{s.user2, gov},
+			expBy:   gov,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			ctx := s.FreshCtx().WithBlockTime(time.Now().UTC()).WithEventManager(sdk.NewEventManager())
+			scopeID := s.newLockableScope(ctx, tc.lockedBy).ScopeId
+			if tc.unknown {
+				scopeID = types.ScopeMetadataAddress(uuid.New())
+			}
+
+			server := keeper.NewMsgServerImpl(s.app.MetadataKeeper)
+			_, err := server.LockScope(sdk.WrapSDKContext(ctx), types.NewMsgLockScopeRequest(scopeID, "litigation", tc.signers))
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, fmt.Sprintf(tc.expErr, scopeID), "LockScope")
+				return
+			}
+			s.Require().NoError(err, "LockScope")
+
+			lock, found := s.app.MetadataKeeper.GetScopeLock(ctx, scopeID)
+			s.Require().True(found, "GetScopeLock found")
+			s.Assert().Equal(types.NewScopeLock(scopeID, tc.expBy, "litigation", ctx.BlockTime()), lock, "scope lock")
+			expEvent, err := sdk.TypedEventToEvent(types.NewEventScopeLocked(lock))
+			s.Require().NoError(err, "TypedEventToEvent")
+			s.Assert().Contains(ctx.EventManager().Events(), expEvent, "emitted events")
+		})
+	}
+}
+
+func (s *ScopeKeeperTestSuite) TestScopeLockBlocksChanges() {
+	ctx := s.FreshCtx().WithBlockTime(time.Now().UTC())
+	goCtx := sdk.WrapSDKContext(ctx)
+	server := keeper.NewMsgServerImpl(s.app.MetadataKeeper)
+	other := sdk.AccAddress("other_______________")
+
+	scope := s.newLockableScope(ctx, s.user2)
+	scopeUUID, err := scope.ScopeId.ScopeUUID()
+	s.Require().NoError(err, "ScopeUUID")
+	sessionID := types.SessionMetadataAddress(scopeUUID, uuid.New())
+	session := types.NewSession("session", sessionID, types.ContractSpecMetadataAddress(uuid.New()), ownerPartyList(s.user1), nil)
+	record := types.NewRecord("record", sessionID, *types.NewProcess("process", &types.Process_Hash{Hash: "HASH"}, "process"), nil,
+		[]types.RecordOutput{*types.NewRecordOutput("out", types.ResultStatus_RESULT_STATUS_PASS)}, nil)
+	s.app.MetadataKeeper.SetRecord(ctx, *record)
+	signers := []string{s.user1, s.user2, s.user3}
+
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{
+			name: "write scope",
+			call: func() error {
+				_, err := server.WriteScope(goCtx, types.NewMsgWriteScopeRequest(scope, signers))
+				return err
+			},
+		},
+		{
+			name: "delete scope",
+			call: func() error {
+				_, err := server.DeleteScope(goCtx, types.NewMsgDeleteScopeRequest(scope.ScopeId, signers))
+				return err
+			},
+		},
+		{
+			name: "update value owners",
+			call: func() error {
+				_, err := server.UpdateValueOwners(goCtx, types.NewMsgUpdateValueOwnersRequest([]types.MetadataAddress{scope.ScopeId}, other, []string{s.user1}))
+				return err
+			},
+		},
+		{
+			name: "add scope owner",
+			call: func() error {
+				_, err := server.AddScopeOwner(goCtx, types.NewMsgAddScopeOwnerRequest(scope.ScopeId, ownerPartyList(other.String()), signers))
+				return err
+			},
+		},
+		{
+			name: "list scope for sale",
+			call: func() error {
+				_, err := server.ListScopeForSale(goCtx, types.NewMsgListScopeForSaleRequest(scope.ScopeId,
+					sdk.NewCoins(sdk.NewInt64Coin("scopecoin", 1)), ctx.BlockTime().Add(time.Hour), []string{s.user1}))
+				return err
+			},
+		},
+		{
+			name: "write session",
+			call: func() error {
+				_, err := server.WriteSession(goCtx, types.NewMsgWriteSessionRequest(*session, signers))
+				return err
+			},
+		},
+		{
+			name: "write record",
+			call: func() error {
+				_, err := server.WriteRecord(goCtx, types.NewMsgWriteRecordRequest(*record, nil, "", signers, nil))
+				return err
+			},
+		},
+		{
+			name: "delete record",
+			call: func() error {
+				_, err := server.DeleteRecord(goCtx, types.NewMsgDeleteRecordRequest(sessionID.MustGetAsRecordAddress(record.Name), signers))
+				return err
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.Assert().EqualError(tc.call(), "scope "+scope.ScopeId.String()+" is locked: litigation: invalid request")
+		})
+	}
+}
+
+func (s *ScopeKeeperTestSuite) TestUnlockScope() {
+	gov := s.app.MetadataKeeper.GetAuthority()
+
+	tests := []struct {
+		name     string
+		lockedBy string
+		signers  []string
+		expErr   string
+	}{
+		{
+			name:    "not locked",
+			signers: []string{s.user2},
+			expErr:  "scope lock not found for id %s: not found",
+		},
+		{
+			name:     "owner without a lock role",
+			lockedBy: s.user2,
+			signers:  []string{s.user1},
+			expErr:   "scope %s was locked by " + s.user2 + " and can only be unlocked by that address or governance: invalid request",
+		},
+		{
+			name:     "another owner with a lock role",
+			lockedBy: s.user2,
+			signers:  []string{s.user1, s.user3},
+			expErr:   "scope %s was locked by " + s.user2 + " and can only be unlocked by that address or governance: invalid request",
+		},
+		{
+			name:     "custodian unlocking a governance lock",
+			lockedBy: gov,
+			signers:  []string{s.user2},
+			expErr:   "scope %s was locked by governance and can only be unlocked by governance: invalid request",
+		},
+		{
+			name:     "locker",
+			lockedBy: s.user2,
+			signers:  []string{s.user1, s.user2},
+		},
+		{
+			name:     "governance lifting an owner's lock",
+			lockedBy: s.user3,
+			signers:  []string{gov},
+		},
+		{
+			name:     "governance lifting its own lock",
+			lockedBy: gov,
+			signers:  []string{gov},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			ctx := s.FreshCtx().WithBlockTime(time.Now().UTC()).WithEventManager(sdk.NewEventManager())
+			scopeID := s.newLockableScope(ctx, tc.lockedBy).ScopeId
+
+			server := keeper.NewMsgServerImpl(s.app.MetadataKeeper)
+			_, err := server.UnlockScope(sdk.WrapSDKContext(ctx), types.NewMsgUnlockScopeRequest(scopeID, tc.signers))
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, fmt.Sprintf(tc.expErr, scopeID), "UnlockScope")
+				_, found := s.app.MetadataKeeper.GetScopeLock(ctx, scopeID)
+				s.Assert().Equal(len(tc.lockedBy) > 0, found, "GetScopeLock found after failed unlock")
+				return
+			}
+			s.Require().NoError(err, "UnlockScope")
+
+			_, found := s.app.MetadataKeeper.GetScopeLock(ctx, scopeID)
+			s.Assert().False(found, "GetScopeLock found after unlock")
+			expEvent, err := sdk.TypedEventToEvent(types.NewEventScopeUnlocked(scopeID, tc.signers[len(tc.signers)-1]))
+			s.Require().NoError(err, "TypedEventToEvent")
+			s.Assert().Contains(ctx.EventManager().Events(), expEvent, "emitted events")
+
+			_, err = server.UpdateValueOwners(sdk.WrapSDKContext(ctx), types.NewMsgUpdateValueOwnersRequest(
+				[]types.MetadataAddress{scopeID}, sdk.AccAddress("other_______________"), []string{s.user1}))
+			s.Assert().NoError(err, "UpdateValueOwners after unlock")
+		})
+	}
+}
+
+func (s *ScopeKeeperTestSuite) TestLockedScopesQueryAndExport() {
+	ctx := s.FreshCtx().WithBlockTime(time.Now().UTC())
+	scope := s.newLockableScope(ctx, s.user2)
+	lock, found := s.app.MetadataKeeper.GetScopeLock(ctx, scope.ScopeId)
+	s.Require().True(found, "GetScopeLock found")
+
+	resp, err := s.app.MetadataKeeper.LockedScopes(sdk.WrapSDKContext(ctx), &types.LockedScopesRequest{})
+	s.Require().NoError(err, "LockedScopes")
+	s.Assert().Equal([]types.ScopeLock{lock}, resp.Locks, "LockedScopes locks")
+	s.Assert().Equal([]types.ScopeLock{lock}, s.app.MetadataKeeper.ExportGenesis(ctx).ScopeLocks, "exported scope locks")
+}
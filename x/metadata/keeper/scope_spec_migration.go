@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// ValidateMigrateScopesToSpec makes sure that the owners of both scope specifications have signed the msg,
+// unless it's signed by the governance module account.
+func (k Keeper) ValidateMigrateScopesToSpec(
+	ctx sdk.Context,
+	fromSpec, toSpec types.ScopeSpecification,
+	msg *types.MsgMigrateScopesToSpecRequest,
+) error {
+	for _, signer := range msg.Signers {
+		if signer == k.GetAuthority() {
+			return nil
+		}
+	}
+
+	var required []string
+	have := make(map[string]bool)
+	for _, owner := range append(fromSpec.OwnerAddresses, toSpec.OwnerAddresses...) {
+		if !have[owner] {
+			required = append(required, owner)
+			have[owner] = true
+		}
+	}
+	return k.ValidateSignersWithoutParties(ctx, required, msg)
+}
+
+// GetScopeIDsForMigration returns the ids of the scopes requested to be moved off of the from specification.
+// If none were requested, the ids of all scopes using the from specification are returned.
+func (k Keeper) GetScopeIDsForMigration(ctx sdk.Context, msg *types.MsgMigrateScopesToSpecRequest) ([]types.MetadataAddress, error) {
+	if len(msg.ScopeIds) > 0 {
+		return msg.ScopeIds, nil
+	}
+	var rv []types.MetadataAddress
+	err := k.IterateScopesForScopeSpec(ctx, msg.FromSpecificationId, func(scopeID types.MetadataAddress) bool {
+		rv = append(rv, scopeID)
+		return false
+	})
+	return rv, err
+}
+
+// MigrateScopeToSpec moves a scope from one scope specification to another.
+// An error is returned (and nothing is changed) if the scope isn't using the from specification,
+// or if the scope's owners, sessions or records don't satisfy the to specification.
+func (k Keeper) MigrateScopeToSpec(ctx sdk.Context, scopeID types.MetadataAddress, fromSpec, toSpec types.ScopeSpecification) error {
+	scope, found := k.GetScope(ctx, scopeID)
+	if !found {
+		return fmt.Errorf("scope not found")
+	}
+	if !scope.SpecificationId.Equals(fromSpec.SpecificationId) {
+		return fmt.Errorf("scope uses specification %s", scope.SpecificationId)
+	}
+	if err := k.validateScopeNotLocked(ctx, scopeID); err != nil {
+		return err
+	}
+	if err := k.validateScopeSatisfiesSpec(ctx, scope, toSpec); err != nil {
+		return err
+	}
+
+	scope.SpecificationId = toSpec.SpecificationId
+	k.SetScope(ctx, scope)
+	k.EmitEvent(ctx, types.NewEventScopeMigratedToSpec(scopeID, fromSpec.SpecificationId, toSpec.SpecificationId))
+	return nil
+}
+
+// validateScopeSatisfiesSpec makes sure that the scope's owners have all the roles required by the scope specification,
+// and that each of the scope's sessions and records use one of the scope specification's contract specifications.
+func (k Keeper) validateScopeSatisfiesSpec(ctx sdk.Context, scope types.Scope, spec types.ScopeSpecification) error {
+	if err := validateRolesPresent(scope.Owners, spec.PartiesInvolved); err != nil {
+		return err
+	}
+
+	allowed := make(map[string]bool, len(spec.ContractSpecIds))
+	for _, contractSpecID := range spec.ContractSpecIds {
+		allowed[string(contractSpecID)] = true
+	}
+
+	var err error
+	iterErr := k.IterateSessions(ctx, scope.ScopeId, func(session types.Session) bool {
+		if !allowed[string(session.SpecificationId)] {
+			err = fmt.Errorf("session %s uses contract specification %s which is not in scope specification %s",
+				session.SessionId, session.SpecificationId, spec.SpecificationId)
+			return true
+		}
+		return false
+	})
+	if iterErr != nil {
+		return iterErr
+	}
+	if err != nil {
+		return err
+	}
+
+	iterErr = k.IterateRecords(ctx, scope.ScopeId, func(record types.Record) bool {
+		if record.SpecificationId.Empty() {
+			return false
+		}
+		contractSpecID, csErr := record.SpecificationId.AsContractSpecAddress()
+		if csErr != nil || !allowed[string(contractSpecID)] {
+			err = fmt.Errorf("record %s uses record specification %s which is not in scope specification %s",
+				record.GetRecordAddress(), record.SpecificationId, spec.SpecificationId)
+			return true
+		}
+		return false
+	})
+	if iterErr != nil {
+		return iterErr
+	}
+	return err
+}
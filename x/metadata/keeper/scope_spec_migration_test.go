@@ -0,0 +1,161 @@
+package keeper_test
+
+import (
+	"github.com/google/uuid"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/keeper"
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// scopeSpecMigration is the specifications and scopes stored by setupScopeSpecMigration.
+type scopeSpecMigration struct {
+	fromSpecID    types.MetadataAddress
+	toSpecID      types.MetadataAddress
+	good          types.MetadataAddress
+	empty         types.MetadataAddress
+	otherContract types.MetadataAddress
+	missingRole   types.MetadataAddress
+	otherSpec     types.MetadataAddress
+}
+
+// setupScopeSpecMigration stores a "from" scope specification owned by user1 and a "to" scope specification owned
+// by user2, and scopes owned by user3. The "to" specification only allows one of the two contract specifications of
+// the "from" specification. Only the good and empty scopes can be moved from the "from" to the "to" specification.
+func (s *ScopeKeeperTestSuite) setupScopeSpecMigration(ctx sdk.Context) scopeSpecMigration {
+	contractSpecID := types.ContractSpecMetadataAddress(uuid.New())
+	otherContractSpecID := types.ContractSpecMetadataAddress(uuid.New())
+	rv := scopeSpecMigration{
+		fromSpecID: types.ScopeSpecMetadataAddress(uuid.New()),
+		toSpecID:   types.ScopeSpecMetadataAddress(uuid.New()),
+	}
+	owners := []types.PartyType{types.PartyType_PARTY_TYPE_OWNER}
+	s.app.MetadataKeeper.SetScopeSpecification(ctx, *types.NewScopeSpecification(rv.fromSpecID, nil, []string{s.user1}, owners,
+		[]types.MetadataAddress{contractSpecID, otherContractSpecID}))
+	s.app.MetadataKeeper.SetScopeSpecification(ctx, *types.NewScopeSpecification(rv.toSpecID, nil, []string{s.user2}, owners,
+		[]types.MetadataAddress{contractSpecID}))
+
+	process := *types.NewProcess("process", &types.Process_Hash{Hash: "HASH"}, "process")
+	pass := []types.RecordOutput{*types.NewRecordOutput("out", types.ResultStatus_RESULT_STATUS_PASS)}
+	newScope := func(specID types.MetadataAddress, parties []types.Party, sessionSpecID types.MetadataAddress) types.MetadataAddress {
+		scopeUUID := uuid.New()
+		scopeID := types.ScopeMetadataAddress(scopeUUID)
+		s.app.MetadataKeeper.SetScope(ctx, *types.NewScope(scopeID, specID, parties, nil, s.user3, false))
+		if sessionSpecID != nil {
+			sessionID := types.SessionMetadataAddress(scopeUUID, uuid.New())
+			s.app.MetadataKeeper.SetSession(ctx, *types.NewSession("session", sessionID, sessionSpecID, parties, nil))
+			recSpecID := sessionSpecID.MustGetAsRecordSpecAddress("record")
+			s.app.MetadataKeeper.SetRecord(ctx, *types.NewRecord("record", sessionID, process, nil, pass, recSpecID))
+		}
+		return scopeID
+	}
+
+	rv.good = newScope(rv.fromSpecID, ownerPartyList(s.user3), contractSpecID)
+	rv.empty = newScope(rv.fromSpecID, ownerPartyList(s.user3), nil)
+	rv.otherContract = newScope(rv.fromSpecID, ownerPartyList(s.user3), otherContractSpecID)
+	rv.missingRole = newScope(rv.fromSpecID, []types.Party{{Address: s.user3, Role: types.PartyType_PARTY_TYPE_SERVICER}}, nil)
+	rv.otherSpec = newScope(rv.toSpecID, ownerPartyList(s.user3), nil)
+	return rv
+}
+
+func (s *ScopeKeeperTestSuite) TestMigrateScopesToSpec() {
+	tests := []struct {
+		name   string
+		msg    func(ssm scopeSpecMigration) *types.MsgMigrateScopesToSpecRequest
+		expErr string
+		// expMigrated are the scopes migrated, and expFailures are the reasons expected for the scopes that are not.
+		expMigrated func(ssm scopeSpecMigration) []types.MetadataAddress
+		expFailures func(ssm scopeSpecMigration) map[string]string
+	}{
+		{
+			name: "to specification owner did not sign",
+			msg: func(ssm scopeSpecMigration) *types.MsgMigrateScopesToSpecRequest {
+				return types.NewMsgMigrateScopesToSpecRequest(ssm.fromSpecID, ssm.toSpecID, nil, []string{s.user1})
+			},
+			expErr: "missing signature: " + s.user2 + ": invalid request",
+		},
+		{
+			name: "unknown to specification",
+			msg: func(ssm scopeSpecMigration) *types.MsgMigrateScopesToSpecRequest {
+				return types.NewMsgMigrateScopesToSpecRequest(ssm.fromSpecID, types.ScopeSpecMetadataAddress(uuid.New()), nil, []string{s.user1})
+			},
+			expErr: "scope specification not found with id",
+		},
+		{
+			name: "listed scopes signed by the specification owners",
+			msg: func(ssm scopeSpecMigration) *types.MsgMigrateScopesToSpecRequest {
+				scopeIDs := []types.MetadataAddress{ssm.good, ssm.otherContract, ssm.missingRole, ssm.otherSpec}
+				return types.NewMsgMigrateScopesToSpecRequest(ssm.fromSpecID, ssm.toSpecID, scopeIDs, []string{s.user1, s.user2})
+			},
+			expMigrated: func(ssm scopeSpecMigration) []types.MetadataAddress {
+				return []types.MetadataAddress{ssm.good}
+			},
+			expFailures: func(ssm scopeSpecMigration) map[string]string {
+				return map[string]string{
+					ssm.otherContract.String(): "which is not in scope specification " + ssm.toSpecID.String(),
+					ssm.missingRole.String():   "missing roles required by spec: OWNER need 1 have 0",
+					ssm.otherSpec.String():     "scope uses specification " + ssm.toSpecID.String(),
+				}
+			},
+		},
+		{
+			name: "all scopes on the specification by governance",
+			msg: func(ssm scopeSpecMigration) *types.MsgMigrateScopesToSpecRequest {
+				return types.NewMsgMigrateScopesToSpecRequest(ssm.fromSpecID, ssm.toSpecID, nil, []string{s.app.MetadataKeeper.GetAuthority()})
+			},
+			expMigrated: func(ssm scopeSpecMigration) []types.MetadataAddress {
+				return []types.MetadataAddress{ssm.good, ssm.empty}
+			},
+			expFailures: func(ssm scopeSpecMigration) map[string]string {
+				return map[string]string{
+					ssm.otherContract.String(): "which is not in scope specification " + ssm.toSpecID.String(),
+					ssm.missingRole.String():   "missing roles required by spec: OWNER need 1 have 0",
+				}
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			ctx := s.FreshCtx()
+			ssm := s.setupScopeSpecMigration(ctx)
+
+			server := keeper.NewMsgServerImpl(s.app.MetadataKeeper)
+			resp, err := server.MigrateScopesToSpec(sdk.WrapSDKContext(ctx), tc.msg(ssm))
+			if len(tc.expErr) > 0 {
+				s.Assert().ErrorContains(err, tc.expErr, "MigrateScopesToSpec")
+				return
+			}
+			s.Require().NoError(err, "MigrateScopesToSpec")
+
+			expMigrated := tc.expMigrated(ssm)
+			s.Assert().ElementsMatch(expMigrated, resp.MigratedScopeIds, "migrated scope ids")
+			expFailures := tc.expFailures(ssm)
+			failures := make(map[string]string, len(resp.Failures))
+			for _, failure := range resp.Failures {
+				failures[failure.ScopeId.String()] = failure.Reason
+			}
+			s.Require().Len(failures, len(expFailures), "failures")
+			for scopeID, expReason := range expFailures {
+				s.Assert().Contains(failures[scopeID], expReason, "failure reason for scope %s", scopeID)
+			}
+
+			onToSpec := []types.MetadataAddress{ssm.otherSpec}
+			for _, scopeID := range []types.MetadataAddress{ssm.good, ssm.empty, ssm.otherContract, ssm.missingRole} {
+				scope, found := s.app.MetadataKeeper.GetScope(ctx, scopeID)
+				s.Require().True(found, "GetScope(%s) found", scopeID)
+				if scope.SpecificationId.Equals(ssm.toSpecID) {
+					onToSpec = append(onToSpec, scopeID)
+				}
+			}
+			s.Assert().ElementsMatch(append(expMigrated, ssm.otherSpec), onToSpec, "scopes using the to specification")
+			var indexed []types.MetadataAddress
+			s.Require().NoError(s.app.MetadataKeeper.IterateScopesForScopeSpec(ctx, ssm.toSpecID, func(scopeID types.MetadataAddress) bool {
+				indexed = append(indexed, scopeID)
+				return false
+			}), "IterateScopesForScopeSpec")
+			s.Assert().ElementsMatch(onToSpec, indexed, "scopes indexed by the to specification")
+		})
+	}
+}
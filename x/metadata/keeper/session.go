@@ -106,10 +106,25 @@ func (k Keeper) IterateSessions(ctx sdk.Context, scopeID types.MetadataAddress,
 // ValidateWriteSession checks the current session and the proposed session to determine if the proposed changes are valid
 // based on the existing state
 func (k Keeper) ValidateWriteSession(ctx sdk.Context, existing *types.Session, msg *types.MsgWriteSessionRequest) error {
-	proposed := msg.Session
+	return k.validateWriteSession(ctx, existing, msg.Session, msg, nil)
+}
+
+// validateWriteSession checks the existing and proposed session like ValidateWriteSession does.
+// The signers of the provided msg are the ones checked against the session's requirements.
+// If reqs is not nil, the signer requirements are added to it instead of being checked.
+func (k Keeper) validateWriteSession(
+	ctx sdk.Context,
+	existing *types.Session,
+	proposed types.Session,
+	msg types.MetadataMsg,
+	reqs *signerRequirements,
+) error {
 	if err := proposed.ValidateBasic(); err != nil {
 		return err
 	}
+	if limit := k.GetMaxSessionContextSize(ctx); types.ExceedsLimit(limit, len(proposed.Context)) {
+		return fmt.Errorf("session context cannot be more than %d bytes, got %d", limit, len(proposed.Context))
+	}
 
 	if existing != nil {
 		if !proposed.SessionId.Equals(existing.SessionId) {
@@ -134,6 +149,9 @@ func (k Keeper) ValidateWriteSession(ctx sdk.Context, existing *types.Session, m
 	if !found {
 		return fmt.Errorf("scope not found for scope id %s", scopeID)
 	}
+	if err = k.validateScopeNotLocked(ctx, scopeID); err != nil {
+		return err
+	}
 	if err = types.ValidateOptionalParties(scope.RequirePartyRollup, proposed.Parties); err != nil {
 		return err
 	}
@@ -169,7 +187,9 @@ func (k Keeper) ValidateWriteSession(ctx sdk.Context, existing *types.Session, m
 		if err = k.validateProvenanceRole(ctx, BuildPartyDetails(nil, proposed.Parties)); err != nil {
 			return err
 		}
-		if err = k.ValidateSignersWithoutParties(ctx, scope.GetAllOwnerAddresses(), msg); err != nil {
+		if reqs != nil {
+			reqs.addSigners(scope.GetAllOwnerAddresses())
+		} else if err = k.ValidateSignersWithoutParties(ctx, scope.GetAllOwnerAddresses(), msg); err != nil {
 			return err
 		}
 	} else {
@@ -202,7 +222,9 @@ func (k Keeper) ValidateWriteSession(ctx sdk.Context, existing *types.Session, m
 			// provided to ValidateSignersWithParties, which does those.
 		}
 		reqParties = append(reqParties, scope.Owners...)
-		if err = k.ValidateSignersWithParties(ctx, reqParties, availableParties, contractSpec.PartiesInvolved, msg); err != nil {
+		if reqs != nil {
+			reqs.addParties("session "+proposed.SessionId.String(), reqParties, availableParties, contractSpec.PartiesInvolved, true)
+		} else if err = k.ValidateSignersWithParties(ctx, reqParties, availableParties, contractSpec.PartiesInvolved, msg); err != nil {
 			return err
 		}
 	}
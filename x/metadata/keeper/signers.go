@@ -7,6 +7,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 
 	markertypes "github.com/provenance-io/provenance/x/marker/types"
 	"github.com/provenance-io/provenance/x/metadata/types"
@@ -236,7 +237,13 @@ func (k Keeper) findAuthzGrantee(
 			if authorization != nil {
 				// If Accept returns an error, we just ignore this authorization
 				// and look for another that'll work.
-				resp, err := authorization.Accept(ctx, msg)
+				var resp authz.AcceptResponse
+				var err error
+				if metadataAuth, isMetadataAuth := authorization.(*types.MetadataAuthorization); isMetadataAuth {
+					resp, err = metadataAuth.AcceptTargets(k.getMetadataAuthorizationTargets(ctx, msg))
+				} else {
+					resp, err = authorization.Accept(ctx, msg)
+				}
 				if err == nil && resp.Accept {
 					switch {
 					case resp.Delete:
@@ -258,6 +265,31 @@ func (k Keeper) findAuthzGrantee(
 	return nil, nil
 }
 
+// getMetadataAuthorizationTargets gets the scopes, scope specifications and records involved in the provided msg.
+// Unlike types.GetMetadataAuthorizationTargets, the state is used to also include the specifications of the
+// scopes involved, the scopes of a value owner being migrated, and the name of a record being deleted.
+func (k Keeper) getMetadataAuthorizationTargets(ctx sdk.Context, msg types.MetadataMsg) types.MetadataAuthorizationTargets {
+	rv := types.GetMetadataAuthorizationTargets(msg)
+	switch m := msg.(type) {
+	case *types.MsgMigrateValueOwnerRequest:
+		// Errors are ignored here; at worst, some scopes are left out, making it harder for the msg to be accepted.
+		_ = k.IterateScopesForValueOwner(ctx, m.Existing, func(scopeID types.MetadataAddress) bool {
+			rv.AddScopeID(scopeID)
+			return false
+		})
+	case *types.MsgDeleteRecordRequest:
+		if record, found := k.GetRecord(ctx, m.RecordId); found {
+			rv.AddRecordName(record.Name)
+		}
+	}
+	for _, scopeID := range rv.ScopeIDs {
+		if scope, found := k.GetScope(ctx, scopeID); found {
+			rv.AddScopeSpecID(scope.SpecificationId)
+		}
+	}
+	return rv
+}
+
 // associateAuthorizations checks authz for authorizations from each party (the granters) to
 // each signer (the grantees). If found, updates the party details to indicate there's a signer.
 // The onAssociation function is called when a grantee is found; it should return whether
@@ -589,6 +621,118 @@ func (k Keeper) validateAllRequiredSigned(ctx sdk.Context, required []string, ms
 	return details, nil
 }
 
+// signerRequirements collects what the signers of a msg must satisfy for several entries (e.g. the scope, sessions
+// and records of a bundle) so that they can all be validated with one signer check using validateSignerRequirements.
+type signerRequirements struct {
+	// required are the addresses that must sign (or have granted an authorization to a signer).
+	required []string
+	// roles are the roles required by each entry, along with the parties available to fulfill them.
+	roles []*roleRequirement
+	// usedSigners are the signers already used by checks that were not deferred (e.g. value owner changes).
+	usedSigners UsedSignersMap
+}
+
+// roleRequirement is the roles required by an entry and the parties available to fulfill them.
+type roleRequirement struct {
+	entry            string
+	reqParties       []types.Party
+	availableParties []types.Party
+	reqRoles         []types.PartyType
+	checkProvenance  bool
+}
+
+// newSignerRequirements creates a new, empty, signerRequirements.
+func newSignerRequirements() *signerRequirements {
+	return &signerRequirements{usedSigners: NewUsedSignersMap()}
+}
+
+// addSigners notes that the provided addresses must sign. It is the deferred version of ValidateSignersWithoutParties.
+func (r *signerRequirements) addSigners(required []string) {
+	r.required = append(r.required, required...)
+}
+
+// addParties notes that the optional=false reqParties must sign, and that the reqRoles of the provided entry must be
+// fulfilled by signers from availableParties. It is the deferred version of validateAllRequiredPartiesSigned, and
+// of ValidateSignersWithParties if checkProvenance is true.
+func (r *signerRequirements) addParties(
+	entry string,
+	reqParties, availableParties []types.Party,
+	reqRoles []types.PartyType,
+	checkProvenance bool,
+) {
+	for _, party := range reqParties {
+		if !party.Optional {
+			r.required = append(r.required, party.Address)
+		}
+	}
+	r.roles = append(r.roles, &roleRequirement{
+		entry:            entry,
+		reqParties:       reqParties,
+		availableParties: availableParties,
+		reqRoles:         reqRoles,
+		checkProvenance:  checkProvenance,
+	})
+}
+
+// useSigners notes that the provided signers have already been used by a check.
+func (r *signerRequirements) useSigners(usedSigners UsedSignersMap) {
+	r.usedSigners.AlsoUse(usedSigners)
+}
+
+// validateSignerRequirements makes sure the signers of the msg satisfy all the provided requirements at once:
+//   - All required addresses, across all entries, are either signers or have granted an authorization to a signer.
+//   - The required roles of each entry are fulfilled by signers of its available parties.
+//   - Any signers that are smart contracts are allowed to sign.
+//
+// A required address is only looked up once, even if several entries need it, so authz authorizations are too.
+func (k Keeper) validateSignerRequirements(ctx sdk.Context, reqs *signerRequirements, msg types.MetadataMsg) error {
+	required := make([]string, 0, len(reqs.required))
+	isRequired := make(map[string]bool, len(reqs.required))
+	for _, addr := range reqs.required {
+		if !isRequired[addr] {
+			isRequired[addr] = true
+			required = append(required, addr)
+		}
+	}
+	signed, err := k.validateAllRequiredSigned(ctx, required, msg)
+	if err != nil {
+		return err
+	}
+	signerOf := make(map[string]string, len(signed))
+	for _, party := range signed {
+		signerOf[party.GetAddress()] = party.GetSigner()
+	}
+
+	usedSigners := NewUsedSignersMap().AlsoUse(reqs.usedSigners).AlsoUse(GetUsedSigners(signed))
+	signers := NewSignersWrapper(msg.GetSignerStrs())
+	for _, req := range reqs.roles {
+		parties := BuildPartyDetails(req.reqParties, req.availableParties)
+		for _, party := range parties {
+			if signer, found := signerOf[party.GetAddress()]; found {
+				party.SetSigner(signer)
+			}
+		}
+		associateSigners(parties, signers)
+
+		missingRoles := associateRequiredRoles(parties, req.reqRoles)
+		rolesAreMissing, err := k.associateAuthorizationsForRoles(ctx, missingRoles, parties, signers, msg)
+		if err != nil {
+			return fmt.Errorf("%s: %w", req.entry, err)
+		}
+		if rolesAreMissing {
+			return fmt.Errorf("%s: missing signers for roles required by spec: %s", req.entry, missingRolesString(parties, req.reqRoles))
+		}
+		if req.checkProvenance {
+			if err = k.validateProvenanceRole(ctx, parties); err != nil {
+				return fmt.Errorf("%s: %w", req.entry, err)
+			}
+		}
+		usedSigners.AlsoUse(GetUsedSigners(parties))
+	}
+
+	return k.validateSmartContractSigners(ctx, usedSigners, msg)
+}
+
 // validateRolesPresent returns an error if one or more required roles are not present in the parties.
 //
 // This is similar to associateRequiredRoles, except this one doesn't require the party to have a signer.
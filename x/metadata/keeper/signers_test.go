@@ -2479,14 +2479,21 @@ func TestGetAuthzMessageTypeURLs(t *testing.T) {
 			url:      types.TypeURLMsgMigrateValueOwnerRequest,
 			expected: []string{types.TypeURLMsgMigrateValueOwnerRequest, types.TypeURLMsgWriteScopeRequest},
 		},
+		boringCase(types.TypeURLMsgListScopeForSaleRequest),
+		boringCase(types.TypeURLMsgCancelScopeListingRequest),
+		boringCase(types.TypeURLMsgBuyScopeRequest),
+		boringCase(types.TypeURLMsgLockScopeRequest),
+		boringCase(types.TypeURLMsgUnlockScopeRequest),
 		boringCase(types.TypeURLMsgWriteSessionRequest),
 		{
 			url:      types.TypeURLMsgWriteRecordRequest,
 			expected: []string{types.TypeURLMsgWriteRecordRequest, types.TypeURLMsgWriteSessionRequest},
 		},
 		boringCase(types.TypeURLMsgDeleteRecordRequest),
+		boringCase(types.TypeURLMsgWriteScopeBundleRequest),
 		boringCase(types.TypeURLMsgWriteScopeSpecificationRequest),
 		boringCase(types.TypeURLMsgDeleteScopeSpecificationRequest),
+		boringCase(types.TypeURLMsgMigrateScopesToSpecRequest),
 		boringCase(types.TypeURLMsgWriteContractSpecificationRequest),
 		boringCase(types.TypeURLMsgDeleteContractSpecificationRequest),
 		{
@@ -2509,6 +2516,7 @@ func TestGetAuthzMessageTypeURLs(t *testing.T) {
 		boringCase(types.TypeURLMsgDeleteOSLocatorRequest),
 		boringCase(types.TypeURLMsgModifyOSLocatorRequest),
 		boringCase(types.TypeURLMsgSetAccountDataRequest),
+		boringCase(types.TypeURLMsgUpdateParamsRequest),
 	}
 
 	for _, tc := range tests {
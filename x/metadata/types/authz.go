@@ -0,0 +1,290 @@
+package types
+
+import (
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var (
+	_ authz.Authorization = &MetadataAuthorization{}
+)
+
+// NewMetadataAuthorization creates a new MetadataAuthorization object.
+func NewMetadataAuthorization(
+	msgTypeURL string,
+	scopeIDs []MetadataAddress,
+	scopeSpecIDs []MetadataAddress,
+	recordNames []string,
+	usesRemaining uint32,
+) *MetadataAuthorization {
+	return &MetadataAuthorization{
+		MsgTypeUrl:            msgTypeURL,
+		ScopeIds:              scopeIDs,
+		ScopeSpecificationIds: scopeSpecIDs,
+		RecordNames:           recordNames,
+		UsesRemaining:         usesRemaining,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a MetadataAuthorization) MsgTypeURL() string {
+	return a.MsgTypeUrl
+}
+
+// Accept implements Authorization.Accept. It is used for msgs run through authz's MsgExec.
+// Only the scopes, scope specifications and records identified in the msg itself are considered.
+// If a restriction can't be checked using just the msg, the msg is not accepted. That includes:
+//   - A scope specification restriction on a scope write, since the scope might already exist with another specification.
+//   - A scope or scope specification restriction on a value owner migration, since the scopes aren't in the msg.
+//   - A record name restriction on a record deletion, since the record name isn't in the msg.
+func (a MetadataAuthorization) Accept(_ sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	if _, ok := msg.(MetadataMsg); !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+	if err := a.validateResolvableFromMsg(msg); err != nil {
+		return authz.AcceptResponse{}, err
+	}
+	return a.AcceptTargets(GetMetadataAuthorizationTargets(msg))
+}
+
+// validateResolvableFromMsg returns an error if this authorization has a restriction that
+// can't be fully checked using just the provided msg.
+func (a MetadataAuthorization) validateResolvableFromMsg(msg sdk.Msg) error {
+	switch msg.(type) {
+	case *MsgWriteScopeRequest, *MsgWriteScopeBundleRequest:
+		if len(a.ScopeSpecificationIds) > 0 {
+			return sdkerrors.ErrUnauthorized.Wrap("authorization is restricted to specific scope specifications, " +
+				"which cannot be checked for a scope that might already exist")
+		}
+	case *MsgMigrateValueOwnerRequest:
+		if len(a.ScopeIds) > 0 || len(a.ScopeSpecificationIds) > 0 {
+			return sdkerrors.ErrUnauthorized.Wrap("authorization is restricted to specific scopes or scope specifications, " +
+				"which cannot be checked for the scopes of a value owner")
+		}
+	case *MsgDeleteRecordRequest:
+		if len(a.RecordNames) > 0 {
+			return sdkerrors.ErrUnauthorized.Wrap("authorization is restricted to specific records, " +
+				"which cannot be checked for a record identified only by its id")
+		}
+	}
+	return nil
+}
+
+// AcceptTargets checks that the provided targets are allowed by this authorization, and
+// returns the response that Accept should provide for a msg involving those targets.
+func (a MetadataAuthorization) AcceptTargets(targets MetadataAuthorizationTargets) (authz.AcceptResponse, error) {
+	if len(a.ScopeIds) > 0 {
+		if len(targets.ScopeIDs) == 0 {
+			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("authorization is restricted to specific scopes but no scope is involved")
+		}
+		for _, scopeID := range targets.ScopeIDs {
+			if !containsMetadataAddress(a.ScopeIds, scopeID) {
+				return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("scope %s is not allowed", scopeID)
+			}
+		}
+	}
+	if len(a.ScopeSpecificationIds) > 0 {
+		if len(targets.ScopeSpecIDs) == 0 {
+			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("authorization is restricted to specific scope specifications but no scope specification is involved")
+		}
+		for _, specID := range targets.ScopeSpecIDs {
+			if !containsMetadataAddress(a.ScopeSpecificationIds, specID) {
+				return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("scope specification %s is not allowed", specID)
+			}
+		}
+	}
+	if len(a.RecordNames) > 0 {
+		if len(targets.RecordNames) == 0 {
+			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("authorization is restricted to specific records but no record is involved")
+		}
+		for _, name := range targets.RecordNames {
+			if !containsString(a.RecordNames, name) {
+				return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("record %q is not allowed", name)
+			}
+		}
+	}
+
+	switch a.UsesRemaining {
+	case 0:
+		return authz.AcceptResponse{Accept: true}, nil
+	case 1:
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	default:
+		updated := a
+		updated.UsesRemaining--
+		return authz.AcceptResponse{Accept: true, Updated: &updated}, nil
+	}
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a MetadataAuthorization) ValidateBasic() error {
+	if !isMetadataMsgTypeURL(a.MsgTypeUrl) {
+		return sdkerrors.ErrInvalidType.Wrapf("%q is not a metadata msg type url", a.MsgTypeUrl)
+	}
+	for i, scopeID := range a.ScopeIds {
+		if !scopeID.IsScopeAddress() {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid scope id: %s", scopeID)
+		}
+		if containsMetadataAddress(a.ScopeIds[:i], scopeID) {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate scope id: %s", scopeID)
+		}
+	}
+	for i, specID := range a.ScopeSpecificationIds {
+		if !specID.IsScopeSpecificationAddress() {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid scope specification id: %s", specID)
+		}
+		if containsMetadataAddress(a.ScopeSpecificationIds[:i], specID) {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate scope specification id: %s", specID)
+		}
+	}
+	for i, name := range a.RecordNames {
+		if len(name) == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrap("record names cannot be empty")
+		}
+		if containsString(a.RecordNames[:i], name) {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate record name: %q", name)
+		}
+	}
+	return nil
+}
+
+// String implements stringer interface
+func (a MetadataAuthorization) String() string {
+	out, _ := yaml.Marshal(a)
+	return string(out)
+}
+
+// MetadataAuthorizationTargets are the scopes, scope specifications and records involved in a msg.
+type MetadataAuthorizationTargets struct {
+	ScopeIDs     []MetadataAddress
+	ScopeSpecIDs []MetadataAddress
+	RecordNames  []string
+}
+
+// AddScopeID adds the provided scope id to these targets if it's not already there.
+func (t *MetadataAuthorizationTargets) AddScopeID(scopeID MetadataAddress) {
+	if !scopeID.Empty() && !containsMetadataAddress(t.ScopeIDs, scopeID) {
+		t.ScopeIDs = append(t.ScopeIDs, scopeID)
+	}
+}
+
+// AddScopeSpecID adds the provided scope specification id to these targets if it's not already there.
+func (t *MetadataAuthorizationTargets) AddScopeSpecID(specID MetadataAddress) {
+	if !specID.Empty() && !containsMetadataAddress(t.ScopeSpecIDs, specID) {
+		t.ScopeSpecIDs = append(t.ScopeSpecIDs, specID)
+	}
+}
+
+// AddRecordName adds the provided record name to these targets if it's not already there.
+func (t *MetadataAuthorizationTargets) AddRecordName(name string) {
+	if len(name) > 0 && !containsString(t.RecordNames, name) {
+		t.RecordNames = append(t.RecordNames, name)
+	}
+}
+
+// addScopeOf adds the scope of the provided metadata address to these targets if it has one.
+func (t *MetadataAuthorizationTargets) addScopeOf(addr MetadataAddress) {
+	if scopeID, err := addr.AsScopeAddress(); err == nil {
+		t.AddScopeID(scopeID)
+	}
+}
+
+// GetMetadataAuthorizationTargets gets the scopes, scope specifications and records identified in the provided msg.
+// Only the msg is considered, so e.g. the scope specification of an existing scope is not included.
+func GetMetadataAuthorizationTargets(msg sdk.Msg) MetadataAuthorizationTargets {
+	rv := MetadataAuthorizationTargets{}
+	switch m := msg.(type) {
+	case *MsgWriteScopeRequest:
+		rv.AddScopeID(m.Scope.ScopeId)
+		rv.AddScopeSpecID(m.Scope.SpecificationId)
+	case *MsgDeleteScopeRequest:
+		rv.AddScopeID(m.ScopeId)
+	case *MsgAddScopeDataAccessRequest:
+		rv.AddScopeID(m.ScopeId)
+	case *MsgDeleteScopeDataAccessRequest:
+		rv.AddScopeID(m.ScopeId)
+	case *MsgAddScopeOwnerRequest:
+		rv.AddScopeID(m.ScopeId)
+	case *MsgDeleteScopeOwnerRequest:
+		rv.AddScopeID(m.ScopeId)
+	case *MsgUpdateValueOwnersRequest:
+		for _, scopeID := range m.ScopeIds {
+			rv.AddScopeID(scopeID)
+		}
+	case *MsgListScopeForSaleRequest:
+		rv.AddScopeID(m.ScopeId)
+	case *MsgCancelScopeListingRequest:
+		rv.AddScopeID(m.ScopeId)
+	case *MsgBuyScopeRequest:
+		rv.AddScopeID(m.ScopeId)
+	case *MsgLockScopeRequest:
+		rv.AddScopeID(m.ScopeId)
+	case *MsgUnlockScopeRequest:
+		rv.AddScopeID(m.ScopeId)
+	case *MsgWriteSessionRequest:
+		rv.addScopeOf(m.Session.SessionId)
+	case *MsgWriteRecordRequest:
+		rv.addScopeOf(m.Record.SessionId)
+		rv.AddRecordName(m.Record.Name)
+	case *MsgDeleteRecordRequest:
+		rv.addScopeOf(m.RecordId)
+	case *MsgWriteScopeBundleRequest:
+		rv.AddScopeID(m.Scope.ScopeId)
+		rv.AddScopeSpecID(m.Scope.SpecificationId)
+		for _, record := range m.Records {
+			rv.AddRecordName(record.Name)
+		}
+	case *MsgWriteScopeSpecificationRequest:
+		rv.AddScopeSpecID(m.Specification.SpecificationId)
+	case *MsgDeleteScopeSpecificationRequest:
+		rv.AddScopeSpecID(m.SpecificationId)
+	case *MsgMigrateScopesToSpecRequest:
+		for _, scopeID := range m.ScopeIds {
+			rv.AddScopeID(scopeID)
+		}
+		rv.AddScopeSpecID(m.FromSpecificationId)
+		rv.AddScopeSpecID(m.ToSpecificationId)
+	case *MsgAddContractSpecToScopeSpecRequest:
+		rv.AddScopeSpecID(m.ScopeSpecificationId)
+	case *MsgDeleteContractSpecFromScopeSpecRequest:
+		rv.AddScopeSpecID(m.ScopeSpecificationId)
+	case *MsgSetAccountDataRequest:
+		if m.MetadataAddr.IsScopeAddress() {
+			rv.AddScopeID(m.MetadataAddr)
+		}
+	}
+	return rv
+}
+
+// isMetadataMsgTypeURL returns true if the provided type url is for one of the metadata msgs.
+func isMetadataMsgTypeURL(typeURL string) bool {
+	for _, msg := range allRequestMsgs {
+		if sdk.MsgTypeURL(msg) == typeURL {
+			return true
+		}
+	}
+	return false
+}
+
+// containsMetadataAddress returns true if the provided address is in the provided list.
+func containsMetadataAddress(addrs []MetadataAddress, addr MetadataAddress) bool {
+	for _, a := range addrs {
+		if a.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// containsString returns true if the provided string is in the provided list.
+func containsString(vals []string, val string) bool {
+	for _, v := range vals {
+		if v == val {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,549 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/metadata/v1/authz.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MetadataAuthorization gives the grantee permission to sign a metadata message on behalf of the granter,
+// optionally restricted to specific scopes, scope specifications and records.
+// The expiration of the authorization is the expiration of its grant.
+type MetadataAuthorization struct {
+	// msg_type_url is the type url of the metadata message that this authorization is for,
+	// e.g. /provenance.metadata.v1.MsgWriteRecordRequest.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty" yaml:"msg_type_url"`
+	// scope_ids, if not empty, are the only scopes that the grantee can sign for.
+	// Messages that do not involve a scope cannot be signed using this authorization.
+	ScopeIds []MetadataAddress `protobuf:"bytes,2,rep,name=scope_ids,json=scopeIds,proto3,customtype=MetadataAddress" json:"scope_ids" yaml:"scope_ids"`
+	// scope_specification_ids, if not empty, are the only scope specifications that the grantee can sign for.
+	// These are compared to the specifications of the scopes involved and to any scope specification in the message.
+	// Messages that do not involve a scope specification cannot be signed using this authorization.
+	ScopeSpecificationIds []MetadataAddress `protobuf:"bytes,3,rep,name=scope_specification_ids,json=scopeSpecificationIds,proto3,customtype=MetadataAddress" json:"scope_specification_ids" yaml:"scope_specification_ids"`
+	// record_names, if not empty, are the only record names that the grantee can sign for.
+	// Messages that do not involve a record cannot be signed using this authorization.
+	RecordNames []string `protobuf:"bytes,4,rep,name=record_names,json=recordNames,proto3" json:"record_names,omitempty" yaml:"record_names"`
+	// uses_remaining is the number of times that this authorization can still be used.
+	// Zero means it can be used an unlimited number of times. When the last use is made, the grant is deleted.
+	UsesRemaining uint32 `protobuf:"varint,5,opt,name=uses_remaining,json=usesRemaining,proto3" json:"uses_remaining,omitempty" yaml:"uses_remaining"`
+}
+
+func (m *MetadataAuthorization) Reset()      { *m = MetadataAuthorization{} }
+func (*MetadataAuthorization) ProtoMessage() {}
+func (*MetadataAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f32299731a0af1ed, []int{0}
+}
+func (m *MetadataAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MetadataAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MetadataAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MetadataAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetadataAuthorization.Merge(m, src)
+}
+func (m *MetadataAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *MetadataAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetadataAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MetadataAuthorization proto.InternalMessageInfo
+
+func (m *MetadataAuthorization) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MetadataAuthorization) GetRecordNames() []string {
+	if m != nil {
+		return m.RecordNames
+	}
+	return nil
+}
+
+func (m *MetadataAuthorization) GetUsesRemaining() uint32 {
+	if m != nil {
+		return m.UsesRemaining
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*MetadataAuthorization)(nil), "provenance.metadata.v1.MetadataAuthorization")
+}
+
+func init() {
+	proto.RegisterFile("provenance/metadata/v1/authz.proto", fileDescriptor_f32299731a0af1ed)
+}
+
+var fileDescriptor_f32299731a0af1ed = []byte{
+	// 408 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xb1, 0xce, 0xd3, 0x30,
+	0x14, 0x85, 0x13, 0x02, 0x88, 0xdf, 0xb4, 0x80, 0x02, 0xe1, 0x4f, 0x3b, 0x24, 0x91, 0xa7, 0x4a,
+	0xa8, 0x89, 0x2a, 0x58, 0xda, 0x09, 0x32, 0xc1, 0x00, 0x42, 0x01, 0x16, 0x96, 0xc8, 0x4d, 0x4c,
+	0x6a, 0x51, 0xc7, 0xc1, 0x76, 0x2a, 0xda, 0xa7, 0x60, 0x64, 0x64, 0xe0, 0x11, 0x78, 0x88, 0x8a,
+	0xa9, 0x23, 0x62, 0x88, 0x50, 0xfb, 0x06, 0x7d, 0x02, 0x94, 0x98, 0xb6, 0xa9, 0x80, 0xcd, 0xe7,
+	0x7e, 0xe7, 0x9e, 0x9b, 0xe8, 0x5e, 0x00, 0x0b, 0xce, 0x16, 0x38, 0x47, 0x79, 0x82, 0x03, 0x8a,
+	0x25, 0x4a, 0x91, 0x44, 0xc1, 0x62, 0x14, 0xa0, 0x52, 0xce, 0x56, 0x7e, 0xc1, 0x99, 0x64, 0xe6,
+	0xfd, 0x93, 0xc7, 0x3f, 0x78, 0xfc, 0xc5, 0xa8, 0x7f, 0x2f, 0x63, 0x19, 0x6b, 0x2c, 0x41, 0xfd,
+	0x52, 0xee, 0x7e, 0x2f, 0x61, 0x82, 0x32, 0x11, 0x2b, 0xa0, 0x84, 0x42, 0xf0, 0xab, 0x01, 0xac,
+	0xe7, 0x7f, 0x02, 0x9e, 0x94, 0x72, 0xc6, 0x38, 0x59, 0x21, 0x49, 0x58, 0x6e, 0x8e, 0x41, 0x87,
+	0x8a, 0x2c, 0x96, 0xcb, 0x02, 0xc7, 0x25, 0x9f, 0xdb, 0xba, 0xa7, 0x0f, 0x2e, 0xc2, 0xcb, 0x7d,
+	0xe5, 0xde, 0x5d, 0x22, 0x3a, 0x9f, 0xc0, 0x36, 0x85, 0x11, 0xa0, 0x22, 0x7b, 0xbd, 0x2c, 0xf0,
+	0x1b, 0x3e, 0x37, 0x9f, 0x82, 0x0b, 0x91, 0xb0, 0x02, 0xc7, 0x24, 0x15, 0xf6, 0x15, 0xcf, 0x18,
+	0x74, 0xc2, 0x07, 0xeb, 0xca, 0xd5, 0x7e, 0x56, 0xee, 0xed, 0xe3, 0xb0, 0x34, 0xe5, 0x58, 0x88,
+	0x7d, 0xe5, 0xde, 0x51, 0x71, 0xc7, 0x0e, 0x18, 0xdd, 0x68, 0xde, 0xcf, 0x52, 0x61, 0x7e, 0x00,
+	0x97, 0xaa, 0x2e, 0x0a, 0x9c, 0x90, 0x77, 0x24, 0x69, 0xbe, 0xad, 0xc9, 0x35, 0x9a, 0xdc, 0xf1,
+	0xff, 0x73, 0x9d, 0x76, 0xee, 0x5f, 0xfd, 0x30, 0xb2, 0x1a, 0xf2, 0xaa, 0x0d, 0xea, 0x91, 0x13,
+	0xd0, 0xe1, 0x38, 0x61, 0x3c, 0x8d, 0x73, 0x44, 0xb1, 0xb0, 0xaf, 0x7a, 0xc6, 0xf9, 0x7f, 0xb7,
+	0x29, 0x8c, 0x6e, 0x2a, 0xf9, 0xa2, 0x56, 0xe6, 0x63, 0x70, 0xab, 0x14, 0x58, 0xc4, 0x1c, 0x53,
+	0x44, 0x72, 0x92, 0x67, 0xf6, 0x35, 0x4f, 0x1f, 0x74, 0xc3, 0xde, 0xbe, 0x72, 0x2d, 0xd5, 0x7d,
+	0xce, 0x61, 0xd4, 0xad, 0x0b, 0xd1, 0x41, 0x4f, 0xac, 0xcf, 0x5f, 0x5c, 0xed, 0xfb, 0xb7, 0x61,
+	0xf7, 0x6c, 0x19, 0xe1, 0xfb, 0xf5, 0xd6, 0xd1, 0x37, 0x5b, 0x47, 0xff, 0xb5, 0x75, 0xf4, 0x4f,
+	0x3b, 0x47, 0xdb, 0xec, 0x1c, 0xed, 0xc7, 0xce, 0xd1, 0x40, 0x8f, 0x30, 0xff, 0xdf, 0xc7, 0xf0,
+	0x52, 0x7f, 0xfb, 0x28, 0x23, 0x72, 0x56, 0x4e, 0xfd, 0x84, 0xd1, 0xe0, 0x64, 0x1a, 0x12, 0xd6,
+	0x52, 0xc1, 0xc7, 0xd3, 0x95, 0xd5, 0xeb, 0x14, 0xd3, 0xeb, 0xcd, 0x69, 0x3c, 0xfc, 0x3d, 0x00,
+	0x20, 0x14, 0x86, 0x63, 0x89, 0x02, 0x00, 0x00,
+}
+
+func (m *MetadataAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MetadataAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MetadataAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UsesRemaining != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.UsesRemaining))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.RecordNames) > 0 {
+		for iNdEx := len(m.RecordNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RecordNames[iNdEx])
+			copy(dAtA[i:], m.RecordNames[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.RecordNames[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ScopeSpecificationIds) > 0 {
+		for iNdEx := len(m.ScopeSpecificationIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.ScopeSpecificationIds[iNdEx].Size()
+				i -= size
+				if _, err := m.ScopeSpecificationIds[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ScopeIds) > 0 {
+		for iNdEx := len(m.ScopeIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.ScopeIds[iNdEx].Size()
+				i -= size
+				if _, err := m.ScopeIds[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MetadataAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.ScopeIds) > 0 {
+		for _, e := range m.ScopeIds {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.ScopeSpecificationIds) > 0 {
+		for _, e := range m.ScopeSpecificationIds {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.RecordNames) > 0 {
+		for _, s := range m.RecordNames {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.UsesRemaining != 0 {
+		n += 1 + sovAuthz(uint64(m.UsesRemaining))
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MetadataAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetadataAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetadataAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIds", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v MetadataAddress
+			m.ScopeIds = append(m.ScopeIds, v)
+			if err := m.ScopeIds[len(m.ScopeIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecificationIds", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v MetadataAddress
+			m.ScopeSpecificationIds = append(m.ScopeSpecificationIds, v)
+			if err := m.ScopeSpecificationIds[len(m.ScopeSpecificationIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordNames", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordNames = append(m.RecordNames, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsesRemaining", wireType)
+			}
+			m.UsesRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsesRemaining |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
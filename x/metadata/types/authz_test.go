@@ -0,0 +1,224 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestMetadataAuthorizationAccept(t *testing.T) {
+	scopeUUID := uuid.New()
+	scopeID := ScopeMetadataAddress(scopeUUID)
+	otherScopeID := ScopeMetadataAddress(uuid.New())
+	specID := ScopeSpecMetadataAddress(uuid.New())
+	sessionID := SessionMetadataAddress(scopeUUID, uuid.New())
+	record := Record{Name: "allowed", SessionId: sessionID}
+	ctx := sdk.Context{}
+
+	tests := []struct {
+		name string
+		auth *MetadataAuthorization
+		msg  sdk.Msg
+		exp  string
+	}{
+		{
+			name: "no restrictions",
+			auth: NewMetadataAuthorization(TypeURLMsgDeleteScopeRequest, nil, nil, nil, 0),
+			msg:  NewMsgDeleteScopeRequest(scopeID, nil),
+		},
+		{
+			name: "allowed scope",
+			auth: NewMetadataAuthorization(TypeURLMsgUpdateValueOwnersRequest, []MetadataAddress{scopeID, otherScopeID}, nil, nil, 0),
+			msg:  NewMsgUpdateValueOwnersRequest([]MetadataAddress{scopeID, otherScopeID}, nil, nil),
+		},
+		{
+			name: "one scope not allowed",
+			auth: NewMetadataAuthorization(TypeURLMsgUpdateValueOwnersRequest, []MetadataAddress{scopeID}, nil, nil, 0),
+			msg:  NewMsgUpdateValueOwnersRequest([]MetadataAddress{scopeID, otherScopeID}, nil, nil),
+			exp:  "scope " + otherScopeID.String() + " is not allowed: unauthorized",
+		},
+		{
+			name: "scope of a record",
+			auth: NewMetadataAuthorization(TypeURLMsgWriteRecordRequest, []MetadataAddress{scopeID}, nil, []string{"allowed"}, 0),
+			msg:  NewMsgWriteRecordRequest(record, nil, "", nil, nil),
+		},
+		{
+			name: "record name not allowed",
+			auth: NewMetadataAuthorization(TypeURLMsgWriteRecordRequest, nil, nil, []string{"other"}, 0),
+			msg:  NewMsgWriteRecordRequest(record, nil, "", nil, nil),
+			exp:  `record "allowed" is not allowed: unauthorized`,
+		},
+		{
+			name: "record names of a bundle",
+			auth: NewMetadataAuthorization(TypeURLMsgWriteScopeBundleRequest, []MetadataAddress{scopeID}, nil, []string{"allowed"}, 0),
+			msg:  NewMsgWriteScopeBundleRequest(Scope{ScopeId: scopeID}, nil, []Record{record, {Name: "other", SessionId: sessionID}}, nil),
+			exp:  `record "other" is not allowed: unauthorized`,
+		},
+		{
+			name: "record name unknown from msg",
+			auth: NewMetadataAuthorization(TypeURLMsgDeleteRecordRequest, nil, nil, []string{"allowed"}, 0),
+			msg:  NewMsgDeleteRecordRequest(sessionID.MustGetAsRecordAddress("allowed"), nil),
+			exp:  "authorization is restricted to specific records, which cannot be checked for a record identified only by its id: unauthorized",
+		},
+		{
+			name: "record deletion without a record name restriction",
+			auth: NewMetadataAuthorization(TypeURLMsgDeleteRecordRequest, []MetadataAddress{scopeID}, nil, nil, 0),
+			msg:  NewMsgDeleteRecordRequest(sessionID.MustGetAsRecordAddress("allowed"), nil),
+		},
+		{
+			name: "scope write with a scope specification restriction",
+			auth: NewMetadataAuthorization(TypeURLMsgWriteScopeRequest, nil, []MetadataAddress{specID}, nil, 0),
+			msg:  NewMsgWriteScopeRequest(Scope{ScopeId: scopeID, SpecificationId: specID}, nil),
+			exp:  "authorization is restricted to specific scope specifications, which cannot be checked for a scope that might already exist: unauthorized",
+		},
+		{
+			name: "bundle write with a scope specification restriction",
+			auth: NewMetadataAuthorization(TypeURLMsgWriteScopeBundleRequest, nil, []MetadataAddress{specID}, nil, 0),
+			msg:  NewMsgWriteScopeBundleRequest(Scope{ScopeId: scopeID, SpecificationId: specID}, nil, nil, nil),
+			exp:  "authorization is restricted to specific scope specifications, which cannot be checked for a scope that might already exist: unauthorized",
+		},
+		{
+			name: "scope write with a scope restriction",
+			auth: NewMetadataAuthorization(TypeURLMsgWriteScopeRequest, []MetadataAddress{scopeID}, nil, nil, 0),
+			msg:  NewMsgWriteScopeRequest(Scope{ScopeId: scopeID, SpecificationId: specID}, nil),
+		},
+		{
+			name: "value owner migration with a scope restriction",
+			auth: NewMetadataAuthorization(TypeURLMsgMigrateValueOwnerRequest, []MetadataAddress{scopeID}, nil, nil, 0),
+			msg:  NewMsgMigrateValueOwnerRequest(sdk.AccAddress("existing"), sdk.AccAddress("proposed"), nil),
+			exp:  "authorization is restricted to specific scopes or scope specifications, which cannot be checked for the scopes of a value owner: unauthorized",
+		},
+		{
+			name: "value owner migration with a scope specification restriction",
+			auth: NewMetadataAuthorization(TypeURLMsgMigrateValueOwnerRequest, nil, []MetadataAddress{specID}, nil, 0),
+			msg:  NewMsgMigrateValueOwnerRequest(sdk.AccAddress("existing"), sdk.AccAddress("proposed"), nil),
+			exp:  "authorization is restricted to specific scopes or scope specifications, which cannot be checked for the scopes of a value owner: unauthorized",
+		},
+		{
+			name: "value owner migration without restrictions",
+			auth: NewMetadataAuthorization(TypeURLMsgMigrateValueOwnerRequest, nil, nil, nil, 0),
+			msg:  NewMsgMigrateValueOwnerRequest(sdk.AccAddress("existing"), sdk.AccAddress("proposed"), nil),
+		},
+		{
+			name: "scope specification unknown from msg",
+			auth: NewMetadataAuthorization(TypeURLMsgDeleteScopeRequest, nil, []MetadataAddress{specID}, nil, 0),
+			msg:  NewMsgDeleteScopeRequest(scopeID, nil),
+			exp:  "authorization is restricted to specific scope specifications but no scope specification is involved: unauthorized",
+		},
+		{
+			name: "allowed scope specification",
+			auth: NewMetadataAuthorization(TypeURLMsgDeleteScopeSpecificationRequest, nil, []MetadataAddress{specID}, nil, 0),
+			msg:  NewMsgDeleteScopeSpecificationRequest(specID, nil),
+		},
+		{
+			name: "no scope involved",
+			auth: NewMetadataAuthorization(TypeURLMsgDeleteScopeSpecificationRequest, []MetadataAddress{scopeID}, nil, nil, 0),
+			msg:  NewMsgDeleteScopeSpecificationRequest(specID, nil),
+			exp:  "authorization is restricted to specific scopes but no scope is involved: unauthorized",
+		},
+		{
+			name: "not a metadata msg",
+			auth: NewMetadataAuthorization(TypeURLMsgDeleteScopeRequest, nil, nil, nil, 0),
+			msg:  &banktypes.MsgSend{},
+			exp:  "type mismatch: invalid type",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := tc.auth.Accept(ctx, tc.msg)
+			if len(tc.exp) > 0 {
+				assert.EqualError(t, err, tc.exp, "Accept")
+				assert.False(t, resp.Accept, "Accept response Accept")
+			} else {
+				assert.NoError(t, err, "Accept")
+				assert.True(t, resp.Accept, "Accept response Accept")
+			}
+		})
+	}
+}
+
+func TestMetadataAuthorizationUsesRemaining(t *testing.T) {
+	msg := NewMsgDeleteScopeRequest(ScopeMetadataAddress(uuid.New()), nil)
+
+	resp, err := NewMetadataAuthorization(TypeURLMsgDeleteScopeRequest, nil, nil, nil, 0).Accept(sdk.Context{}, msg)
+	require.NoError(t, err, "Accept unlimited uses")
+	assert.Equal(t, false, resp.Delete, "unlimited uses Delete")
+	assert.Nil(t, resp.Updated, "unlimited uses Updated")
+
+	resp, err = NewMetadataAuthorization(TypeURLMsgDeleteScopeRequest, nil, nil, nil, 3).Accept(sdk.Context{}, msg)
+	require.NoError(t, err, "Accept 3 uses")
+	assert.Equal(t, false, resp.Delete, "3 uses Delete")
+	assert.Equal(t, NewMetadataAuthorization(TypeURLMsgDeleteScopeRequest, nil, nil, nil, 2), resp.Updated, "3 uses Updated")
+
+	resp, err = NewMetadataAuthorization(TypeURLMsgDeleteScopeRequest, nil, nil, nil, 1).Accept(sdk.Context{}, msg)
+	require.NoError(t, err, "Accept last use")
+	assert.Equal(t, true, resp.Delete, "last use Delete")
+}
+
+func TestMetadataAuthorizationValidateBasic(t *testing.T) {
+	scopeID := ScopeMetadataAddress(uuid.New())
+	specID := ScopeSpecMetadataAddress(uuid.New())
+
+	tests := []struct {
+		name string
+		auth *MetadataAuthorization
+		exp  string
+	}{
+		{
+			name: "control",
+			auth: NewMetadataAuthorization(TypeURLMsgWriteRecordRequest, []MetadataAddress{scopeID}, []MetadataAddress{specID}, []string{"name"}, 5),
+		},
+		{
+			name: "not a metadata msg",
+			auth: NewMetadataAuthorization("/cosmos.bank.v1beta1.MsgSend", nil, nil, nil, 0),
+			exp:  `"/cosmos.bank.v1beta1.MsgSend" is not a metadata msg type url: invalid type`,
+		},
+		{
+			name: "not a scope id",
+			auth: NewMetadataAuthorization(TypeURLMsgWriteRecordRequest, []MetadataAddress{specID}, nil, nil, 0),
+			exp:  "invalid scope id: " + specID.String() + ": invalid address",
+		},
+		{
+			name: "duplicate scope id",
+			auth: NewMetadataAuthorization(TypeURLMsgWriteRecordRequest, []MetadataAddress{scopeID, scopeID}, nil, nil, 0),
+			exp:  "duplicate scope id: " + scopeID.String() + ": invalid request",
+		},
+		{
+			name: "not a scope specification id",
+			auth: NewMetadataAuthorization(TypeURLMsgWriteRecordRequest, nil, []MetadataAddress{scopeID}, nil, 0),
+			exp:  "invalid scope specification id: " + scopeID.String() + ": invalid address",
+		},
+		{
+			name: "duplicate scope specification id",
+			auth: NewMetadataAuthorization(TypeURLMsgWriteRecordRequest, nil, []MetadataAddress{specID, specID}, nil, 0),
+			exp:  "duplicate scope specification id: " + specID.String() + ": invalid request",
+		},
+		{
+			name: "empty record name",
+			auth: NewMetadataAuthorization(TypeURLMsgWriteRecordRequest, nil, nil, []string{""}, 0),
+			exp:  "record names cannot be empty: invalid request",
+		},
+		{
+			name: "duplicate record name",
+			auth: NewMetadataAuthorization(TypeURLMsgWriteRecordRequest, nil, nil, []string{"name", "name"}, 0),
+			exp:  `duplicate record name: "name": invalid request`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.auth.ValidateBasic()
+			if len(tc.exp) > 0 {
+				assert.EqualError(t, err, tc.exp, "ValidateBasic")
+			} else {
+				assert.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
 // RegisterInterfaces registers implementations for the tx messages
@@ -21,6 +22,12 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		(*MsgWriteP8EContractSpecRequest)(nil),
 		(*MsgP8EMemorializeContractRequest)(nil),
 	)
+
+	registry.RegisterImplementations(
+		(*authz.Authorization)(nil),
+		&MetadataAuthorization{},
+	)
+
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
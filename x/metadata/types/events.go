@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	"github.com/armon/go-metrics"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -107,14 +109,22 @@ const (
 	TxEndpoint_DeleteScopeOwner      TxEndpoint = "DeleteScopeOwner"
 	TxEndpoint_UpdateValueOwners     TxEndpoint = "UpdateValueOwners"
 	TxEndpoint_MigrateValueOwner     TxEndpoint = "MigrateValueOwner"
+	TxEndpoint_ListScopeForSale      TxEndpoint = "ListScopeForSale"
+	TxEndpoint_CancelScopeListing    TxEndpoint = "CancelScopeListing"
+	TxEndpoint_BuyScope              TxEndpoint = "BuyScope"
+	TxEndpoint_LockScope             TxEndpoint = "LockScope"
+	TxEndpoint_UnlockScope           TxEndpoint = "UnlockScope"
 
 	TxEndpoint_WriteSession TxEndpoint = "WriteSession"
 
 	TxEndpoint_WriteRecord  TxEndpoint = "WriteRecord"
 	TxEndpoint_DeleteRecord TxEndpoint = "DeleteRecord"
 
+	TxEndpoint_WriteScopeBundle TxEndpoint = "WriteScopeBundle"
+
 	TxEndpoint_WriteScopeSpecification  TxEndpoint = "WriteScopeSpecification"
 	TxEndpoint_DeleteScopeSpecification TxEndpoint = "DeleteScopeSpecification"
+	TxEndpoint_MigrateScopesToSpec      TxEndpoint = "MigrateScopesToSpec"
 
 	TxEndpoint_WriteContractSpecification  TxEndpoint = "WriteContractSpecification"
 	TxEndpoint_DeleteContractSpecification TxEndpoint = "DeleteContractSpecification"
@@ -128,6 +138,8 @@ const (
 	TxEndpoint_BindOSLocator   TxEndpoint = "BindOSLocator"
 	TxEndpoint_DeleteOSLocator TxEndpoint = "DeleteOSLocator"
 	TxEndpoint_ModifyOSLocator TxEndpoint = "ModifyOSLocator"
+
+	TxEndpoint_UpdateParams TxEndpoint = "UpdateParams"
 )
 
 func NewEventTxCompleted(endpoint TxEndpoint, signers []string) *EventTxCompleted {
@@ -156,6 +168,46 @@ func NewEventScopeDeleted(scopeID MetadataAddress) *EventScopeDeleted {
 	}
 }
 
+func NewEventScopeListed(listing ScopeListing) *EventScopeListed {
+	return &EventScopeListed{
+		ScopeAddr:  listing.ScopeId.String(),
+		Seller:     listing.Seller,
+		Price:      listing.Price.String(),
+		Expiration: listing.Expiration.UTC().Format(time.RFC3339Nano),
+	}
+}
+
+func NewEventScopeListingCancelled(listing ScopeListing) *EventScopeListingCancelled {
+	return &EventScopeListingCancelled{
+		ScopeAddr: listing.ScopeId.String(),
+		Seller:    listing.Seller,
+	}
+}
+
+func NewEventScopeSold(listing ScopeListing, buyer string) *EventScopeSold {
+	return &EventScopeSold{
+		ScopeAddr: listing.ScopeId.String(),
+		Seller:    listing.Seller,
+		Buyer:     buyer,
+		Price:     listing.Price.String(),
+	}
+}
+
+func NewEventScopeLocked(lock ScopeLock) *EventScopeLocked {
+	return &EventScopeLocked{
+		ScopeAddr: lock.ScopeId.String(),
+		LockedBy:  lock.LockedBy,
+		Reason:    lock.Reason,
+	}
+}
+
+func NewEventScopeUnlocked(scopeID MetadataAddress, unlockedBy string) *EventScopeUnlocked {
+	return &EventScopeUnlocked{
+		ScopeAddr:  scopeID.String(),
+		UnlockedBy: unlockedBy,
+	}
+}
+
 func NewEventSessionCreated(sessionID MetadataAddress) *EventSessionCreated {
 	return &EventSessionCreated{
 		SessionAddr: sessionID.String(),
@@ -218,6 +270,14 @@ func NewEventScopeSpecificationDeleted(scopeSpecificationID MetadataAddress) *Ev
 	}
 }
 
+func NewEventScopeMigratedToSpec(scopeID, fromSpecID, toSpecID MetadataAddress) *EventScopeMigratedToSpec {
+	return &EventScopeMigratedToSpec{
+		ScopeAddr:             scopeID.String(),
+		FromSpecificationAddr: fromSpecID.String(),
+		ToSpecificationAddr:   toSpecID.String(),
+	}
+}
+
 func NewEventContractSpecificationCreated(contractSpecificationID MetadataAddress) *EventContractSpecificationCreated {
 	return &EventContractSpecificationCreated{
 		ContractSpecificationAddr: contractSpecificationID.String(),
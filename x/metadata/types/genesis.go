@@ -1,7 +1,41 @@
 package types
 
+import "fmt"
+
 // Validate ensures the genesis state is valid.
 func (state GenesisState) Validate() error {
+	seen := make(map[string]bool)
+	for i, listing := range state.ScopeListings {
+		if err := listing.Validate(); err != nil {
+			return fmt.Errorf("invalid scope listing[%d]: %w", i, err)
+		}
+		key := listing.ScopeId.String()
+		if seen[key] {
+			return fmt.Errorf("duplicate scope listing for %s", key)
+		}
+		seen[key] = true
+	}
+	for i, version := range state.RecordVersions {
+		if err := version.Validate(); err != nil {
+			return fmt.Errorf("invalid record version[%d]: %w", i, err)
+		}
+	}
+	for i, version := range state.ScopeVersions {
+		if err := version.Validate(); err != nil {
+			return fmt.Errorf("invalid scope version[%d]: %w", i, err)
+		}
+	}
+	seen = make(map[string]bool)
+	for i, lock := range state.ScopeLocks {
+		if err := lock.Validate(); err != nil {
+			return fmt.Errorf("invalid scope lock[%d]: %w", i, err)
+		}
+		key := lock.ScopeId.String()
+		if seen[key] {
+			return fmt.Errorf("duplicate scope lock for %s", key)
+		}
+		seen[key] = true
+	}
 	return nil
 }
 
@@ -16,6 +50,10 @@ func NewGenesisState(
 	contracSpecs []ContractSpecification,
 	recordSpecs []RecordSpecification,
 	objectStoreLocators []ObjectStoreLocator,
+	scopeListings []ScopeListing,
+	recordVersions []RecordVersion,
+	scopeVersions []ScopeVersion,
+	scopeLocks []ScopeLock,
 ) *GenesisState {
 	return &GenesisState{
 		Params:                 params,
@@ -27,6 +65,10 @@ func NewGenesisState(
 		ContractSpecifications: contracSpecs,
 		RecordSpecifications:   recordSpecs,
 		ObjectStoreLocators:    objectStoreLocators,
+		ScopeListings:          scopeListings,
+		RecordVersions:         recordVersions,
+		ScopeVersions:          scopeVersions,
+		ScopeLocks:             scopeLocks,
 	}
 }
 
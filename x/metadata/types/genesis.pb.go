@@ -36,6 +36,14 @@ type GenesisState struct {
 	RecordSpecifications   []RecordSpecification   `protobuf:"bytes,7,rep,name=record_specifications,json=recordSpecifications,proto3" json:"record_specifications"`
 	OSLocatorParams        OSLocatorParams         `protobuf:"bytes,8,opt,name=o_s_locator_params,json=oSLocatorParams,proto3" json:"o_s_locator_params"`
 	ObjectStoreLocators    []ObjectStoreLocator    `protobuf:"bytes,9,rep,name=object_store_locators,json=objectStoreLocators,proto3" json:"object_store_locators"`
+	// scope_listings are the open offers to sell the value ownership of scopes.
+	ScopeListings []ScopeListing `protobuf:"bytes,10,rep,name=scope_listings,json=scopeListings,proto3" json:"scope_listings"`
+	// record_versions are the stored past versions of records.
+	RecordVersions []RecordVersion `protobuf:"bytes,11,rep,name=record_versions,json=recordVersions,proto3" json:"record_versions"`
+	// scope_versions are the stored past versions of scope owners and value owners.
+	ScopeVersions []ScopeVersion `protobuf:"bytes,12,rep,name=scope_versions,json=scopeVersions,proto3" json:"scope_versions"`
+	// scope_locks are the legal holds on scopes.
+	ScopeLocks []ScopeLock `protobuf:"bytes,13,rep,name=scope_locks,json=scopeLocks,proto3" json:"scope_locks"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -80,37 +88,42 @@ func init() {
 }
 
 var fileDescriptor_a835c20198efc302 = []byte{
-	// 473 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0xcf, 0x6e, 0x13, 0x31,
-	0x10, 0xc6, 0x77, 0x69, 0xd9, 0x06, 0x17, 0x09, 0xc9, 0xa4, 0x65, 0xa9, 0xc4, 0xa6, 0xaa, 0x40,
-	0x44, 0x45, 0xdd, 0x55, 0x0b, 0x27, 0x40, 0x48, 0x94, 0x03, 0x17, 0xa4, 0x56, 0xdd, 0x5b, 0x2f,
-	0x2b, 0xc7, 0x71, 0x83, 0xa1, 0xd9, 0x59, 0x79, 0x4c, 0x04, 0x6f, 0xc0, 0x11, 0x89, 0x17, 0xe8,
-	0xe3, 0xf4, 0xd8, 0x23, 0x27, 0x84, 0x92, 0x0b, 0x8f, 0x51, 0xc5, 0xf6, 0x36, 0xcd, 0x1f, 0xe7,
-	0x96, 0xec, 0xfc, 0xbe, 0xef, 0x9b, 0xb1, 0xc7, 0xe4, 0x69, 0xa5, 0x60, 0x20, 0x4a, 0x56, 0x72,
-	0x91, 0xf5, 0x85, 0x66, 0x5d, 0xa6, 0x59, 0x36, 0xd8, 0xcf, 0x7a, 0xa2, 0x14, 0x28, 0x31, 0xad,
-	0x14, 0x68, 0xa0, 0x9b, 0x13, 0x2a, 0xad, 0xa9, 0x74, 0xb0, 0xbf, 0xd5, 0xec, 0x41, 0x0f, 0x0c,
-	0x92, 0x8d, 0x7f, 0x59, 0x7a, 0xeb, 0x99, 0xc7, 0xf3, 0x46, 0x69, 0xb1, 0x1d, 0x0f, 0x86, 0x1c,
-	0x2a, 0xe1, 0x98, 0x5d, 0x1f, 0x53, 0x09, 0x2e, 0xcf, 0x24, 0x67, 0x5a, 0x42, 0xe9, 0xd8, 0xb6,
-	0x87, 0x85, 0xce, 0x17, 0xc1, 0x35, 0x6a, 0x50, 0xce, 0x75, 0xe7, 0x77, 0x44, 0xee, 0x7f, 0xb4,
-	0x03, 0xe6, 0x9a, 0x69, 0x41, 0xdf, 0x92, 0xa8, 0x62, 0x8a, 0xf5, 0x31, 0x0e, 0xb7, 0xc3, 0xf6,
-	0xfa, 0x41, 0x92, 0x2e, 0x1e, 0x38, 0x3d, 0x36, 0xd4, 0xe1, 0xea, 0xe5, 0xdf, 0x56, 0x70, 0xe2,
-	0x34, 0xf4, 0x0d, 0x89, 0x4c, 0xcf, 0x18, 0xdf, 0xd9, 0x5e, 0x69, 0xaf, 0x1f, 0x3c, 0xf1, 0xa9,
-	0xf3, 0x31, 0x55, 0x8b, 0xad, 0x84, 0xbe, 0x27, 0x0d, 0x14, 0x88, 0x12, 0x4a, 0x8c, 0x57, 0x8c,
-	0xbc, 0xe5, 0x95, 0x5b, 0xce, 0x19, 0xdc, 0xc8, 0xe8, 0x3b, 0xb2, 0xa6, 0x04, 0x07, 0xd5, 0xc5,
-	0x78, 0xd5, 0x38, 0x78, 0xdb, 0x3f, 0x31, 0x98, 0x33, 0xa8, 0x45, 0x94, 0x93, 0xa6, 0x69, 0xa6,
-	0x98, 0x3a, 0x55, 0x8c, 0xef, 0x1a, 0xb3, 0xdd, 0xa5, 0xd3, 0xe4, 0xb7, 0x25, 0xce, 0xf8, 0x21,
-	0xce, 0x55, 0x90, 0x9e, 0x93, 0x47, 0x1c, 0x4a, 0xad, 0x18, 0xd7, 0xb3, 0x39, 0x91, 0xc9, 0xd9,
-	0xf3, 0xe5, 0x7c, 0x70, 0xb2, 0x45, 0x51, 0x9b, 0x7c, 0x51, 0x11, 0xe9, 0x19, 0xd9, 0xb0, 0xd3,
-	0xcd, 0x66, 0xad, 0x99, 0xac, 0x17, 0xcb, 0x0f, 0x68, 0x51, 0x52, 0x53, 0xcd, 0x97, 0x90, 0x9e,
-	0x12, 0x0a, 0x05, 0x16, 0xe7, 0xc0, 0x99, 0x06, 0x55, 0xb8, 0x25, 0x6a, 0x98, 0x25, 0x7a, 0xee,
-	0x0b, 0x39, 0xca, 0x3f, 0x59, 0x7e, 0x6a, 0x9b, 0x1e, 0xc0, 0xf4, 0x67, 0xda, 0x25, 0x1b, 0x76,
-	0x75, 0x0b, 0xb3, 0xbb, 0x75, 0x08, 0xc6, 0xf7, 0x96, 0xdf, 0xcb, 0x91, 0x11, 0xe5, 0x63, 0x8d,
-	0x33, 0xac, 0xef, 0x05, 0xe6, 0x2a, 0xf8, 0xba, 0xf1, 0xf3, 0xa2, 0x15, 0xfc, 0xbf, 0x68, 0x05,
-	0x87, 0x5f, 0x2f, 0x87, 0x49, 0x78, 0x35, 0x4c, 0xc2, 0x7f, 0xc3, 0x24, 0xfc, 0x35, 0x4a, 0x82,
-	0xab, 0x51, 0x12, 0xfc, 0x19, 0x25, 0x01, 0x79, 0x2c, 0xc1, 0x13, 0x76, 0x1c, 0x9e, 0xbe, 0xea,
-	0x49, 0xfd, 0xf9, 0x5b, 0x27, 0xe5, 0xd0, 0xcf, 0x26, 0xd0, 0x9e, 0x84, 0x5b, 0xff, 0xb2, 0xef,
-	0x93, 0x17, 0xa9, 0x7f, 0x54, 0x02, 0x3b, 0x91, 0x79, 0x89, 0x2f, 0xaf, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0x3a, 0xcc, 0x7d, 0x59, 0x80, 0x04, 0x00, 0x00,
+	// 560 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xc1, 0x6e, 0xd3, 0x4e,
+	0x10, 0xc6, 0xed, 0x7f, 0xfa, 0x4f, 0xc3, 0xa6, 0x69, 0xa5, 0x25, 0x2d, 0xa6, 0x12, 0x4e, 0xa8,
+	0x5a, 0x11, 0x15, 0xd5, 0x56, 0x0b, 0x27, 0x40, 0x48, 0x94, 0x03, 0x1c, 0x2a, 0xb5, 0x34, 0x88,
+	0x43, 0x2f, 0x91, 0xb3, 0xd9, 0x06, 0x93, 0xc4, 0x63, 0xed, 0x2c, 0x11, 0xbc, 0x01, 0x47, 0x1e,
+	0xa1, 0x8f, 0xd3, 0x63, 0x8f, 0x70, 0x41, 0x28, 0xb9, 0xf0, 0x18, 0x28, 0xbb, 0xeb, 0xc4, 0x69,
+	0x62, 0x8b, 0x5b, 0x32, 0xfb, 0xfb, 0xbe, 0x6f, 0x66, 0x77, 0x64, 0xb2, 0x1b, 0x0b, 0x18, 0xf2,
+	0x28, 0x88, 0x18, 0xf7, 0x07, 0x5c, 0x06, 0x9d, 0x40, 0x06, 0xfe, 0xf0, 0xd0, 0xef, 0xf2, 0x88,
+	0x63, 0x88, 0x5e, 0x2c, 0x40, 0x02, 0xdd, 0x9a, 0x51, 0x5e, 0x42, 0x79, 0xc3, 0xc3, 0xed, 0x6a,
+	0x17, 0xba, 0xa0, 0x10, 0x7f, 0xf2, 0x4b, 0xd3, 0xdb, 0x7b, 0x19, 0x9e, 0x53, 0xa5, 0xc6, 0x76,
+	0x32, 0x30, 0x64, 0x10, 0x73, 0xc3, 0xec, 0x67, 0x31, 0x31, 0x67, 0xe1, 0x65, 0xc8, 0x02, 0x19,
+	0x42, 0x64, 0xd8, 0x46, 0x06, 0x0b, 0xed, 0x4f, 0x9c, 0x49, 0x94, 0x20, 0x8c, 0xeb, 0xce, 0xcf,
+	0x12, 0x59, 0x7b, 0xa3, 0x07, 0x6c, 0xca, 0x40, 0x72, 0xfa, 0x82, 0x14, 0xe3, 0x40, 0x04, 0x03,
+	0x74, 0xec, 0xba, 0xdd, 0x28, 0x1f, 0xb9, 0xde, 0xf2, 0x81, 0xbd, 0x33, 0x45, 0x1d, 0xaf, 0x5c,
+	0xff, 0xaa, 0x59, 0xe7, 0x46, 0x43, 0x9f, 0x93, 0xa2, 0xea, 0x19, 0x9d, 0xff, 0xea, 0x85, 0x46,
+	0xf9, 0xe8, 0x41, 0x96, 0xba, 0x39, 0xa1, 0x12, 0xb1, 0x96, 0xd0, 0x57, 0xa4, 0x84, 0x1c, 0x31,
+	0x84, 0x08, 0x9d, 0x82, 0x92, 0xd7, 0x32, 0xe5, 0x9a, 0x33, 0x06, 0x53, 0x19, 0x7d, 0x49, 0x56,
+	0x05, 0x67, 0x20, 0x3a, 0xe8, 0xac, 0xd4, 0x0b, 0x79, 0xed, 0x9f, 0x2b, 0xcc, 0x18, 0x24, 0x22,
+	0xca, 0x48, 0x55, 0x35, 0xd3, 0x9a, 0xbb, 0x55, 0x74, 0xfe, 0x57, 0x66, 0xfb, 0xb9, 0xd3, 0x34,
+	0xd3, 0x12, 0x63, 0x7c, 0x17, 0x17, 0x4e, 0x90, 0xf6, 0xc9, 0x3d, 0x06, 0x91, 0x14, 0x01, 0x93,
+	0xb7, 0x73, 0x8a, 0x2a, 0xe7, 0x20, 0x2b, 0xe7, 0xb5, 0x91, 0x2d, 0x8b, 0xda, 0x62, 0xcb, 0x0e,
+	0x91, 0x5e, 0x92, 0x4d, 0x3d, 0xdd, 0xed, 0xac, 0x55, 0x95, 0xf5, 0x38, 0xff, 0x82, 0x96, 0x25,
+	0x55, 0xc5, 0xe2, 0x11, 0xd2, 0x0b, 0x42, 0xa1, 0x85, 0xad, 0x3e, 0xb0, 0x40, 0x82, 0x68, 0x99,
+	0x25, 0x2a, 0xa9, 0x25, 0x7a, 0x94, 0x15, 0x72, 0xda, 0x3c, 0xd1, 0xfc, 0xdc, 0x36, 0x6d, 0xc0,
+	0x7c, 0x99, 0x76, 0xc8, 0xa6, 0x5e, 0xdd, 0x96, 0xda, 0xdd, 0x24, 0x04, 0x9d, 0x3b, 0xf9, 0xef,
+	0x72, 0xaa, 0x44, 0xcd, 0x89, 0xc6, 0x18, 0x26, 0xef, 0x02, 0x0b, 0x27, 0x48, 0xdf, 0x91, 0x75,
+	0xfd, 0xf8, 0xfd, 0x10, 0x65, 0x18, 0x75, 0xd1, 0x21, 0xca, 0x7e, 0x37, 0xf7, 0xd9, 0x4f, 0x34,
+	0x6c, 0x8c, 0x2b, 0x98, 0xaa, 0x21, 0x7d, 0x4f, 0x36, 0xcc, 0xe5, 0x0f, 0xb9, 0xd0, 0x9b, 0x5d,
+	0x56, 0x9e, 0x7b, 0xf9, 0xd7, 0xfe, 0x41, 0xd3, 0xc6, 0x74, 0x5d, 0xa4, 0x8b, 0xa9, 0x46, 0xa7,
+	0xa6, 0x6b, 0xff, 0xd0, 0xe8, 0xbc, 0x67, 0x05, 0x53, 0x35, 0xa4, 0x6f, 0x49, 0xd9, 0xcc, 0x0e,
+	0xac, 0x87, 0x4e, 0x45, 0xf9, 0x3d, 0xcc, 0x1f, 0x1c, 0x58, 0xcf, 0x98, 0x11, 0x4c, 0x0a, 0xf8,
+	0xac, 0xf4, 0xed, 0xaa, 0x66, 0xfd, 0xb9, 0xaa, 0x59, 0xc7, 0xbd, 0xeb, 0x91, 0x6b, 0xdf, 0x8c,
+	0x5c, 0xfb, 0xf7, 0xc8, 0xb5, 0xbf, 0x8f, 0x5d, 0xeb, 0x66, 0xec, 0x5a, 0x3f, 0xc6, 0xae, 0x45,
+	0xee, 0x87, 0x90, 0x61, 0x7d, 0x66, 0x5f, 0x3c, 0xed, 0x86, 0xf2, 0xe3, 0xe7, 0xb6, 0xc7, 0x60,
+	0xe0, 0xcf, 0xa0, 0x83, 0x10, 0x52, 0xff, 0xfc, 0x2f, 0xb3, 0xef, 0x9a, 0xfc, 0x1a, 0x73, 0x6c,
+	0x17, 0xd5, 0xf7, 0xec, 0xc9, 0xdf, 0x01, 0x00, 0xe6, 0x38, 0xc4, 0x73, 0xc6, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -133,6 +146,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ScopeLocks) > 0 {
+		for iNdEx := len(m.ScopeLocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScopeLocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.ScopeVersions) > 0 {
+		for iNdEx := len(m.ScopeVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScopeVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.RecordVersions) > 0 {
+		for iNdEx := len(m.RecordVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ScopeListings) > 0 {
+		for iNdEx := len(m.ScopeListings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScopeListings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.ObjectStoreLocators) > 0 {
 		for iNdEx := len(m.ObjectStoreLocators) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -317,6 +386,30 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ScopeListings) > 0 {
+		for _, e := range m.ScopeListings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RecordVersions) > 0 {
+		for _, e := range m.RecordVersions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ScopeVersions) > 0 {
+		for _, e := range m.ScopeVersions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ScopeLocks) > 0 {
+		for _, e := range m.ScopeLocks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -659,6 +752,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeListings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeListings = append(m.ScopeListings, ScopeListing{})
+			if err := m.ScopeListings[len(m.ScopeListings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordVersions = append(m.RecordVersions, RecordVersion{})
+			if err := m.RecordVersions[len(m.RecordVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeVersions = append(m.ScopeVersions, ScopeVersion{})
+			if err := m.ScopeVersions[len(m.ScopeVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeLocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeLocks = append(m.ScopeLocks, ScopeLock{})
+			if err := m.ScopeLocks[len(m.ScopeLocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"crypto/sha256"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 )
@@ -42,6 +45,14 @@ const (
 //
 // - 0x21<owner_address>: ObjectStoreLocator
 //
+// - 0x12<scope_id>: ScopeListing
+//
+// - 0x10<scope_id>: ScopeLock
+//
+// - 0x06<record_id><height>: RecordVersion
+//
+// - 0x07<scope_id><height>: ScopeVersion
+//
 // These keys are used for indexing and more specific iteration.
 // These keys are handled using the stuff in this file.
 // The "..._address" parts are all bytes of an Account Address.
@@ -58,6 +69,12 @@ const (
 // - 0x14<contract_spec_id><scope_spec_id>: 0x01
 //
 // - 0x20<owner_address><contract_spec_id>: 0x01
+//
+// - 0x08<hash_sha256><record_id>: 0x01
+//
+// - 0x09<input_record_id><record_id>: 0x01
+//
+// - 0x15<expiration><scope_id>: 0x01
 var (
 	// ScopeKeyPrefix is the key for scope records in metadata store
 	ScopeKeyPrefix = []byte{0x00}
@@ -85,9 +102,28 @@ var (
 	ContractSpecScopeSpecCacheKeyPrefix = []byte{0x14}
 	// AddressContractSpecCacheKeyPrefix for contract spec lookup by address
 	AddressContractSpecCacheKeyPrefix = []byte{0x20}
+	// RecordHashCacheKeyPrefix for record lookup by output or input hash
+	RecordHashCacheKeyPrefix = []byte{0x08}
+	// RecordInputCacheKeyPrefix for record lookup by a record used as an input
+	RecordInputCacheKeyPrefix = []byte{0x09}
+	// RecordCountKeyPrefix for the number of records in each scope
+	RecordCountKeyPrefix = []byte{0x13}
 
 	// OSLocatorAddressKeyPrefix is the key for OSLocator Record by address
 	OSLocatorAddressKeyPrefix = []byte{0x21}
+
+	// ScopeListingKeyPrefix is the key for offers to sell the value ownership of scopes
+	ScopeListingKeyPrefix = []byte{0x12}
+	// ScopeListingExpirationKeyPrefix for scope listing lookup by expiration
+	ScopeListingExpirationKeyPrefix = []byte{0x15}
+
+	// ScopeLockKeyPrefix is the key for legal holds on scopes
+	ScopeLockKeyPrefix = []byte{0x10}
+
+	// RecordVersionKeyPrefix is the key for the version history of records
+	RecordVersionKeyPrefix = []byte{0x06}
+	// ScopeVersionKeyPrefix is the key for the version history of scope owners and value owners
+	ScopeVersionKeyPrefix = []byte{0x07}
 )
 
 // GetAddressScopeCacheIteratorPrefix returns an iterator prefix for all scope cache entries assigned to a given address
@@ -150,7 +186,74 @@ func GetAddressContractSpecCacheKey(addr sdk.AccAddress, contractSpecID Metadata
 	return append(GetAddressContractSpecCacheIteratorPrefix(addr), contractSpecID.Bytes()...)
 }
 
+// GetRecordHashCacheIteratorPrefix returns an iterator prefix for all record cache entries assigned to a given hash.
+// Hashes can be any length, so the sha256 checksum of the hash is used in the key.
+func GetRecordHashCacheIteratorPrefix(hash string) []byte {
+	checksum := sha256.Sum256([]byte(hash))
+	return append(RecordHashCacheKeyPrefix, checksum[:]...)
+}
+
+// GetRecordHashCacheKey returns the store key for a hash + record id cache entry
+func GetRecordHashCacheKey(hash string, recordID MetadataAddress) []byte {
+	return append(GetRecordHashCacheIteratorPrefix(hash), recordID.Bytes()...)
+}
+
+// GetRecordInputCacheIteratorPrefix returns an iterator prefix for all record cache entries that use a given record as an input.
+func GetRecordInputCacheIteratorPrefix(inputRecordID MetadataAddress) []byte {
+	return append(RecordInputCacheKeyPrefix, inputRecordID.Bytes()...)
+}
+
+// GetRecordInputCacheKey returns the store key for an input record id + record id cache entry
+func GetRecordInputCacheKey(inputRecordID MetadataAddress, recordID MetadataAddress) []byte {
+	return append(GetRecordInputCacheIteratorPrefix(inputRecordID), recordID.Bytes()...)
+}
+
+// GetRecordCountKey returns the store key for the number of records in a scope
+func GetRecordCountKey(scopeID MetadataAddress) []byte {
+	return append(RecordCountKeyPrefix, scopeID.Bytes()...)
+}
+
 // GetOSLocatorKey returns a store key for an object store locator entry
 func GetOSLocatorKey(addr sdk.AccAddress) []byte {
 	return append(OSLocatorAddressKeyPrefix, address.MustLengthPrefix(addr.Bytes())...)
 }
+
+// GetScopeListingKey returns the store key for a scope listing entry
+func GetScopeListingKey(scopeID MetadataAddress) []byte {
+	return append(ScopeListingKeyPrefix, scopeID.Bytes()...)
+}
+
+// GetScopeListingExpirationIteratorPrefix returns an iterator prefix for all scope listings that expire at the given time
+func GetScopeListingExpirationIteratorPrefix(expiration time.Time) []byte {
+	return append(ScopeListingExpirationKeyPrefix, sdk.FormatTimeBytes(expiration)...)
+}
+
+// GetScopeListingExpirationKey returns the store key for a scope listing expiration cache entry
+func GetScopeListingExpirationKey(expiration time.Time, scopeID MetadataAddress) []byte {
+	return append(GetScopeListingExpirationIteratorPrefix(expiration), scopeID.Bytes()...)
+}
+
+// GetScopeLockKey returns the store key for a scope lock entry
+func GetScopeLockKey(scopeID MetadataAddress) []byte {
+	return append(ScopeLockKeyPrefix, scopeID.Bytes()...)
+}
+
+// GetRecordVersionIteratorPrefix returns an iterator prefix for all stored versions of a record
+func GetRecordVersionIteratorPrefix(recordID MetadataAddress) []byte {
+	return append(RecordVersionKeyPrefix, recordID.Bytes()...)
+}
+
+// GetRecordVersionKey returns the store key for the version of a record written at the given height
+func GetRecordVersionKey(recordID MetadataAddress, height int64) []byte {
+	return append(GetRecordVersionIteratorPrefix(recordID), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetScopeVersionIteratorPrefix returns an iterator prefix for all stored versions of a scope
+func GetScopeVersionIteratorPrefix(scopeID MetadataAddress) []byte {
+	return append(ScopeVersionKeyPrefix, scopeID.Bytes()...)
+}
+
+// GetScopeVersionKey returns the store key for the version of a scope written at the given height
+func GetScopeVersionKey(scopeID MetadataAddress, height int64) []byte {
+	return append(GetScopeVersionIteratorPrefix(scopeID), sdk.Uint64ToBigEndian(uint64(height))...)
+}
@@ -25,6 +25,21 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the set of params for the metadata module.
 type Params struct {
+	// max_history_versions is the number of past versions kept for each record and scope.
+	// Older versions are pruned as new ones are added. Zero means no limit, i.e. all versions are kept.
+	MaxHistoryVersions uint32 `protobuf:"varint,1,opt,name=max_history_versions,json=maxHistoryVersions,proto3" json:"max_history_versions,omitempty" yaml:"max_history_versions"`
+	// max_scope_owners is the maximum number of owners a scope can have. Zero means no limit.
+	MaxScopeOwners uint32 `protobuf:"varint,2,opt,name=max_scope_owners,json=maxScopeOwners,proto3" json:"max_scope_owners,omitempty" yaml:"max_scope_owners"`
+	// max_data_access is the maximum number of data access addresses a scope can have. Zero means no limit.
+	MaxDataAccess uint32 `protobuf:"varint,3,opt,name=max_data_access,json=maxDataAccess,proto3" json:"max_data_access,omitempty" yaml:"max_data_access"`
+	// max_records_per_scope is the maximum number of records a scope can have. Zero means no limit.
+	MaxRecordsPerScope uint32 `protobuf:"varint,4,opt,name=max_records_per_scope,json=maxRecordsPerScope,proto3" json:"max_records_per_scope,omitempty" yaml:"max_records_per_scope"`
+	// max_record_inputs is the maximum number of inputs a record can have. Zero means no limit.
+	MaxRecordInputs uint32 `protobuf:"varint,5,opt,name=max_record_inputs,json=maxRecordInputs,proto3" json:"max_record_inputs,omitempty" yaml:"max_record_inputs"`
+	// max_record_outputs is the maximum number of outputs a record can have. Zero means no limit.
+	MaxRecordOutputs uint32 `protobuf:"varint,6,opt,name=max_record_outputs,json=maxRecordOutputs,proto3" json:"max_record_outputs,omitempty" yaml:"max_record_outputs"`
+	// max_session_context_size is the maximum number of bytes in a session's context. Zero means no limit.
+	MaxSessionContextSize uint32 `protobuf:"varint,7,opt,name=max_session_context_size,json=maxSessionContextSize,proto3" json:"max_session_context_size,omitempty" yaml:"max_session_context_size"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -59,6 +74,55 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetMaxHistoryVersions() uint32 {
+	if m != nil {
+		return m.MaxHistoryVersions
+	}
+	return 0
+}
+
+func (m *Params) GetMaxScopeOwners() uint32 {
+	if m != nil {
+		return m.MaxScopeOwners
+	}
+	return 0
+}
+
+func (m *Params) GetMaxDataAccess() uint32 {
+	if m != nil {
+		return m.MaxDataAccess
+	}
+	return 0
+}
+
+func (m *Params) GetMaxRecordsPerScope() uint32 {
+	if m != nil {
+		return m.MaxRecordsPerScope
+	}
+	return 0
+}
+
+func (m *Params) GetMaxRecordInputs() uint32 {
+	if m != nil {
+		return m.MaxRecordInputs
+	}
+	return 0
+}
+
+func (m *Params) GetMaxRecordOutputs() uint32 {
+	if m != nil {
+		return m.MaxRecordOutputs
+	}
+	return 0
+}
+
+func (m *Params) GetMaxSessionContextSize() uint32 {
+	if m != nil {
+		return m.MaxSessionContextSize
+	}
+	return 0
+}
+
 // ScopeIdInfo contains various info regarding a scope id.
 type ScopeIdInfo struct {
 	// scope_id is the raw bytes of the scope address.
@@ -560,69 +624,82 @@ func init() {
 }
 
 var fileDescriptor_786fb0ab3f663d79 = []byte{
-	// 994 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x6f, 0xdc, 0x44,
-	0x14, 0x5e, 0x37, 0x21, 0x6d, 0x5e, 0x7e, 0xec, 0xc6, 0xdd, 0x4d, 0xb6, 0x69, 0xea, 0x49, 0xa7,
-	0x54, 0x5a, 0x85, 0xb2, 0x4b, 0x4b, 0x25, 0xa4, 0xdc, 0x08, 0xaa, 0x94, 0xaa, 0x2a, 0x8a, 0xbc,
-	0x02, 0x09, 0x84, 0x14, 0xb9, 0xb6, 0x93, 0x58, 0xb0, 0xeb, 0x95, 0xbd, 0x1b, 0xb5, 0xe2, 0xc0,
-	0xbf, 0xc0, 0x91, 0x63, 0xef, 0x9c, 0xf8, 0x2f, 0x7a, 0xac, 0xc4, 0x05, 0x71, 0x18, 0x41, 0xc2,
-	0x81, 0xf3, 0xfc, 0x05, 0xc8, 0x33, 0x63, 0xcf, 0x1b, 0xff, 0xb8, 0x71, 0xf3, 0x8c, 0xbf, 0xf7,
-	0x7d, 0xf6, 0xfb, 0x3e, 0xbf, 0xd9, 0x85, 0x87, 0xb3, 0x24, 0xbe, 0x0c, 0xa7, 0xde, 0xd4, 0x0f,
-	0x47, 0x93, 0x70, 0xee, 0x05, 0xde, 0xdc, 0x1b, 0x5d, 0x3e, 0x2e, 0xae, 0x87, 0xb3, 0x24, 0x9e,
-	0xc7, 0xf6, 0xb6, 0x86, 0x0d, 0x8b, 0x5b, 0x97, 0x8f, 0x77, 0xbb, 0xe7, 0xf1, 0x79, 0x2c, 0x20,
-	0xa3, 0xec, 0x4a, 0xa2, 0xa9, 0x0d, 0x2b, 0x27, 0x5e, 0xe2, 0x4d, 0xd2, 0xc3, 0x5b, 0xbf, 0xbc,
-	0x25, 0xad, 0x7f, 0xdf, 0x12, 0x8b, 0xfe, 0x7e, 0x03, 0xd6, 0xc6, 0x7e, 0x3c, 0x0b, 0x9f, 0x07,
-	0xcf, 0xa7, 0x67, 0xb1, 0xfd, 0x0c, 0x6e, 0xa5, 0xd9, 0xf2, 0x34, 0x0a, 0xfa, 0xd6, 0xbe, 0x35,
-	0x58, 0x3f, 0x3a, 0x78, 0xc7, 0x48, 0xeb, 0x4f, 0x46, 0xda, 0x2f, 0x95, 0xc0, 0xe7, 0x41, 0x90,
-	0x84, 0x69, 0xca, 0x19, 0x69, 0xbf, 0xf1, 0x26, 0x3f, 0x1c, 0xd2, 0xbc, 0x80, 0xba, 0x37, 0x53,
-	0x49, 0x65, 0x1f, 0x41, 0x3b, 0xdf, 0x3d, 0x9d, 0x25, 0xe1, 0x59, 0xf4, 0xba, 0x7f, 0x43, 0xb0,
-	0xed, 0x72, 0x46, 0xb6, 0xcd, 0x32, 0x05, 0xa0, 0xee, 0x86, 0xaa, 0x3e, 0x11, 0x6b, 0xfb, 0x25,
-	0xdc, 0x2e, 0x20, 0xf2, 0x62, 0xb1, 0x88, 0x82, 0xfe, 0x92, 0xe0, 0x71, 0x38, 0x23, 0xbb, 0x25,
-	0x1e, 0x0d, 0xa2, 0x6e, 0x47, 0x71, 0x89, 0x77, 0xfb, 0x6a, 0x11, 0x05, 0xf6, 0x53, 0x00, 0x09,
-	0xf0, 0x82, 0x20, 0xe9, 0x2f, 0xef, 0x5b, 0x83, 0xd5, 0xa3, 0x1e, 0x67, 0x64, 0x0b, 0xb3, 0x64,
-	0xf7, 0xa8, 0xbb, 0x2a, 0x16, 0xd9, 0x7b, 0xea, 0x2a, 0xa1, 0xfd, 0x41, 0x7d, 0x95, 0x94, 0x94,
-	0x55, 0x99, 0x16, 0xfd, 0x6d, 0x19, 0x36, 0xc6, 0x61, 0x9a, 0x46, 0xf1, 0x54, 0xf5, 0xf5, 0x05,
-	0x40, 0x2a, 0x37, 0x74, 0x67, 0x1f, 0x35, 0x77, 0x36, 0xa7, 0x2f, 0x4a, 0x32, 0xfa, 0x9c, 0xd0,
-	0x3e, 0x86, 0x2d, 0x7d, 0xc7, 0xec, 0xef, 0x1e, 0x67, 0xa4, 0x5f, 0x2e, 0x2e, 0x3a, 0xdc, 0x2e,
-	0x38, 0x54, 0x8f, 0xc7, 0xd0, 0x43, 0xb0, 0x4a, 0x97, 0xf7, 0x39, 0x23, 0x7b, 0x15, 0x36, 0xfc,
-	0xd2, 0x76, 0xc1, 0xa8, 0x3b, 0xfd, 0x0d, 0xec, 0x60, 0xb4, 0xba, 0x14, 0xb4, 0xcb, 0x82, 0x96,
-	0x72, 0x46, 0x9c, 0x2a, 0x2d, 0x02, 0x52, 0xb7, 0xab, 0x89, 0xe5, 0x85, 0xa0, 0x3e, 0x84, 0xf5,
-	0x1c, 0x26, 0x6c, 0x94, 0x86, 0xec, 0x70, 0x46, 0x6e, 0x9b, 0x7c, 0xd2, 0xc8, 0x35, 0xb5, 0x14,
-	0x56, 0xa2, 0x5a, 0xf1, 0x2c, 0x2b, 0x4d, 0xb5, 0xf2, 0x01, 0xf2, 0x5a, 0xa1, 0xeb, 0xc1, 0x46,
-	0x11, 0xb3, 0x68, 0x7a, 0x16, 0xf7, 0x6f, 0xee, 0x5b, 0x83, 0xb5, 0x27, 0x0f, 0x86, 0xf5, 0x1f,
-	0xe0, 0x10, 0x7d, 0x52, 0x47, 0x7d, 0xce, 0x48, 0xb7, 0x14, 0xd5, 0x8c, 0x23, 0x93, 0xd0, 0x30,
-	0x7a, 0xb5, 0x04, 0xeb, 0x6e, 0xe8, 0xc7, 0x49, 0xa0, 0x22, 0x73, 0x0c, 0xab, 0x89, 0x58, 0xeb,
-	0xc4, 0x7c, 0xd4, 0x9c, 0x98, 0x8e, 0x54, 0x28, 0x2a, 0xa8, 0x7b, 0x2b, 0x51, 0x6c, 0xf6, 0x33,
-	0xe8, 0x14, 0xfb, 0x66, 0x5c, 0xee, 0x72, 0x46, 0x76, 0x4a, 0x95, 0x45, 0x5a, 0x36, 0x73, 0x02,
-	0x15, 0x96, 0x13, 0xe8, 0x6a, 0x50, 0x25, 0x2b, 0x84, 0x33, 0x72, 0xb7, 0x4c, 0x85, 0xa3, 0xb2,
-	0x95, 0xd3, 0xe9, 0xa4, 0x8c, 0xa1, 0xa7, 0xb1, 0x17, 0x5e, 0x7a, 0x11, 0x06, 0xa7, 0x53, 0x6f,
-	0x12, 0xaa, 0x9c, 0xa0, 0xf8, 0xd5, 0xc2, 0xa8, 0x6b, 0xe7, 0x9c, 0xc7, 0x62, 0xf7, 0x4b, 0x6f,
-	0x12, 0xda, 0x9f, 0xc1, 0x9a, 0x42, 0xa3, 0x88, 0x6c, 0x73, 0x46, 0x6c, 0x83, 0x4a, 0x26, 0x04,
-	0xe4, 0x4a, 0x04, 0xa4, 0x62, 0xf2, 0xca, 0xff, 0x6e, 0xf2, 0xaf, 0x4b, 0xd0, 0x16, 0x65, 0xe3,
-	0x59, 0xe8, 0x2b, 0x9f, 0xc7, 0xb9, 0x6c, 0x3a, 0x0b, 0x7d, 0xed, 0xf5, 0xa8, 0xd9, 0x6b, 0x43,
-	0x48, 0x55, 0xe5, 0x42, 0x92, 0x38, 0xf3, 0xca, 0xb8, 0x6d, 0xda, 0x8e, 0xbc, 0xaa, 0x43, 0x51,
-	0x77, 0x0b, 0x71, 0x29, 0xf7, 0x23, 0xb8, 0x67, 0x62, 0xd1, 0x0a, 0xc5, 0x60, 0xc0, 0x19, 0xf9,
-	0xb0, 0x8e, 0xba, 0x04, 0xa7, 0x6e, 0x1f, 0x69, 0x14, 0x3d, 0x11, 0xb1, 0x28, 0x4e, 0x0f, 0x81,
-	0x46, 0xf3, 0xba, 0x72, 0x7a, 0x14, 0x80, 0xfc, 0xf4, 0xc8, 0x38, 0x84, 0x99, 0x26, 0x07, 0x9a,
-	0xde, 0xf5, 0x1c, 0xf2, 0x91, 0x34, 0x87, 0x18, 0xe3, 0xff, 0x2c, 0x81, 0xfd, 0x45, 0x3c, 0x9d,
-	0x27, 0x9e, 0x3f, 0x47, 0x86, 0x7d, 0x07, 0x1d, 0x5f, 0xed, 0x96, 0x3c, 0x7b, 0xd2, 0xec, 0x99,
-	0xfa, 0xca, 0xca, 0x85, 0xd4, 0xdd, 0xf4, 0x0d, 0x85, 0x6c, 0x7a, 0x96, 0x41, 0xa6, 0x79, 0x68,
-	0x7a, 0x36, 0x00, 0xa9, 0xdb, 0x35, 0x49, 0x95, 0x85, 0x3f, 0xc2, 0x83, 0x4a, 0x85, 0xb9, 0x81,
-	0x8c, 0x1c, 0x72, 0x46, 0x0e, 0x1a, 0x64, 0xaa, 0x45, 0xd4, 0x75, 0x4c, 0x49, 0xdc, 0x37, 0x61,
-	0xea, 0x0b, 0xb0, 0xcd, 0x32, 0xe4, 0xeb, 0x3d, 0xce, 0xc8, 0x9d, 0x3a, 0x2d, 0x69, 0x6d, 0x07,
-	0x53, 0x0b, 0x77, 0x2b, 0x64, 0xc8, 0xe0, 0x46, 0x32, 0xf5, 0xcb, 0xc0, 0x2f, 0x3d, 0x19, 0xfd,
-	0x7b, 0x19, 0x3a, 0x72, 0xf2, 0x22, 0x93, 0xbf, 0x06, 0x35, 0xfe, 0x4a, 0x16, 0x7f, 0xd2, 0x6c,
-	0x71, 0xcf, 0x98, 0x2f, 0x85, 0xc1, 0xeb, 0x09, 0xe2, 0x46, 0x23, 0xaf, 0xd6, 0xdc, 0xea, 0xc8,
-	0x2b, 0x5b, 0x6b, 0x63, 0x3a, 0x65, 0xec, 0x02, 0xee, 0x97, 0xd0, 0x8d, 0xb6, 0x3e, 0xe2, 0x8c,
-	0x0c, 0x6a, 0x05, 0xea, 0x9a, 0xb5, 0x87, 0xc5, 0x2a, 0x96, 0x7a, 0xb0, 0x5b, 0xe2, 0xa8, 0xce,
-	0xf0, 0x87, 0x9c, 0x91, 0xfb, 0xb5, 0x7a, 0xc6, 0x20, 0xdf, 0xc6, 0x42, 0x68, 0x98, 0xeb, 0xa3,
-	0x4b, 0x67, 0x46, 0xda, 0x5c, 0x3d, 0xba, 0x50, 0x62, 0x36, 0x35, 0x9d, 0xc8, 0xcb, 0x4f, 0xd0,
-	0xab, 0x84, 0x18, 0x8d, 0xf8, 0x83, 0xa6, 0x11, 0x5f, 0xfd, 0xfa, 0xb1, 0x43, 0xb5, 0x94, 0xd4,
-	0xb5, 0xfd, 0x6a, 0xd5, 0xf7, 0xef, 0xae, 0x1c, 0xeb, 0xfd, 0x95, 0x63, 0xfd, 0x75, 0xe5, 0x58,
-	0x3f, 0x5f, 0x3b, 0xad, 0xf7, 0xd7, 0x4e, 0xeb, 0x8f, 0x6b, 0xa7, 0x05, 0x77, 0xa2, 0xb8, 0x41,
-	0xfd, 0xc4, 0xfa, 0xf6, 0xe9, 0x79, 0x34, 0xbf, 0x58, 0xbc, 0x1a, 0xfa, 0xf1, 0x64, 0xa4, 0x41,
-	0x1f, 0x47, 0x31, 0x5a, 0x8d, 0x5e, 0xeb, 0xbf, 0x08, 0xf3, 0x37, 0xb3, 0x30, 0x7d, 0xb5, 0x22,
-	0x7e, 0xef, 0x7f, 0xfa, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x18, 0x3b, 0xb8, 0x76, 0x46, 0x0c,
+	// 1202 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0xbf, 0x6f, 0xdb, 0x46,
+	0x14, 0xc7, 0xad, 0x58, 0x71, 0xe2, 0xb3, 0x64, 0xc9, 0x8c, 0xe4, 0x30, 0x4e, 0xa2, 0x4b, 0xce,
+	0x0d, 0x60, 0xb8, 0xa9, 0xd4, 0xa4, 0x01, 0x0a, 0x78, 0x8b, 0xda, 0x00, 0x36, 0x8c, 0x34, 0xee,
+	0x09, 0x0d, 0xd0, 0x22, 0x80, 0x70, 0x21, 0xcf, 0x36, 0xd1, 0x4a, 0x14, 0x78, 0x94, 0x2b, 0xa7,
+	0x43, 0xff, 0x85, 0x8e, 0x1d, 0xb3, 0x77, 0xea, 0xda, 0xbf, 0x20, 0x63, 0x80, 0x2e, 0x45, 0x07,
+	0xa2, 0xb5, 0x3b, 0x74, 0xe6, 0x5f, 0x50, 0xf0, 0xee, 0x48, 0x3e, 0xfe, 0xda, 0xba, 0xf1, 0x8e,
+	0xdf, 0xf7, 0x79, 0xe4, 0xfb, 0x3e, 0xbe, 0x93, 0xd0, 0x83, 0x99, 0xe7, 0x9e, 0xf1, 0x29, 0x9b,
+	0x5a, 0x7c, 0x30, 0xe1, 0x3e, 0xb3, 0x99, 0xcf, 0x06, 0x67, 0x8f, 0x92, 0xeb, 0xfe, 0xcc, 0x73,
+	0x7d, 0xd7, 0xd8, 0x4c, 0x65, 0xfd, 0xe4, 0xd6, 0xd9, 0xa3, 0xad, 0xce, 0x89, 0x7b, 0xe2, 0x4a,
+	0xc9, 0x20, 0xba, 0x52, 0x6a, 0xf2, 0x5b, 0x1d, 0xad, 0x1c, 0x31, 0x8f, 0x4d, 0x84, 0xf1, 0x25,
+	0xea, 0x4c, 0xd8, 0x62, 0x7c, 0xea, 0x08, 0xdf, 0xf5, 0xce, 0xc7, 0x67, 0xdc, 0x13, 0x8e, 0x3b,
+	0x15, 0x66, 0xed, 0x5e, 0x6d, 0xa7, 0x39, 0xc4, 0x61, 0x80, 0x6f, 0x9f, 0xb3, 0xc9, 0x77, 0x7b,
+	0xa4, 0x4c, 0x45, 0xa8, 0x31, 0x61, 0x8b, 0x7d, 0xb5, 0xfb, 0x52, 0x6f, 0x1a, 0xcf, 0x50, 0x3b,
+	0x12, 0x0b, 0xcb, 0x9d, 0xf1, 0xb1, 0xfb, 0xfd, 0x94, 0x7b, 0xc2, 0xbc, 0x22, 0x71, 0xb7, 0xc3,
+	0x00, 0xdf, 0x4c, 0x71, 0x50, 0x41, 0xe8, 0xfa, 0x84, 0x2d, 0x46, 0xd1, 0xce, 0x0b, 0xb9, 0x61,
+	0x0c, 0x51, 0x2b, 0x12, 0x45, 0x6f, 0x32, 0x66, 0x96, 0xc5, 0x85, 0x30, 0x97, 0x25, 0x65, 0x2b,
+	0x0c, 0xf0, 0x66, 0x4a, 0x01, 0x02, 0x42, 0x9b, 0x13, 0xb6, 0xf8, 0x9c, 0xf9, 0xec, 0xa9, 0x5c,
+	0x1b, 0x23, 0xd4, 0x8d, 0x24, 0x1e, 0xb7, 0x5c, 0xcf, 0x16, 0xe3, 0x19, 0xf7, 0x54, 0x52, 0xb3,
+	0x2e, 0x49, 0xf7, 0xc2, 0x00, 0xdf, 0x49, 0x49, 0x05, 0x99, 0x7a, 0x3f, 0xaa, 0xb6, 0x8f, 0xb8,
+	0x27, 0x1f, 0xcf, 0xd8, 0x47, 0x1b, 0xa9, 0x7a, 0xec, 0x4c, 0x67, 0x73, 0x5f, 0x98, 0x57, 0x25,
+	0xf0, 0x4e, 0x18, 0x60, 0x33, 0x0f, 0xd4, 0x12, 0x42, 0x5b, 0x09, 0xec, 0x40, 0xee, 0x18, 0x87,
+	0xc8, 0x00, 0x32, 0x77, 0xee, 0x4b, 0xd4, 0x8a, 0x44, 0xdd, 0x0d, 0x03, 0x7c, 0xab, 0x80, 0xd2,
+	0x1a, 0x42, 0xdb, 0x09, 0xeb, 0x85, 0xda, 0x32, 0x5e, 0x21, 0x53, 0x16, 0x95, 0x8b, 0xc8, 0x86,
+	0xb1, 0xe5, 0x4e, 0x7d, 0xbe, 0xf0, 0xc7, 0xc2, 0x79, 0xc3, 0xcd, 0x6b, 0x12, 0xb9, 0x1d, 0x06,
+	0x18, 0x83, 0xf2, 0x97, 0x28, 0x09, 0x8d, 0x0a, 0x36, 0x52, 0x77, 0x3e, 0x53, 0x37, 0x46, 0xce,
+	0x1b, 0xbe, 0x77, 0xfd, 0xe7, 0xb7, 0x78, 0xe9, 0xdf, 0xb7, 0xb8, 0x46, 0x7e, 0xbf, 0x82, 0xd6,
+	0x64, 0x21, 0x0e, 0xec, 0x83, 0xe9, 0xb1, 0x6b, 0x3c, 0x43, 0xd7, 0x95, 0x91, 0x8e, 0x2d, 0xbb,
+	0xa6, 0x31, 0xdc, 0x7d, 0x17, 0xe0, 0xa5, 0x3f, 0x03, 0xdc, 0x7a, 0xae, 0x3b, 0xf1, 0xa9, 0x6d,
+	0x7b, 0x5c, 0x88, 0x30, 0xc0, 0x2d, 0x95, 0x3e, 0x0e, 0x20, 0xf4, 0x9a, 0x50, 0xa8, 0xc8, 0xee,
+	0x78, 0x77, 0x3c, 0xf3, 0xf8, 0xb1, 0xb3, 0x90, 0x4d, 0xd3, 0x80, 0x76, 0xe7, 0x04, 0x84, 0x36,
+	0x75, 0xf4, 0x91, 0x5c, 0x1b, 0xcf, 0xd1, 0x8d, 0x44, 0xa2, 0x2e, 0xe6, 0x73, 0xc7, 0x96, 0x6d,
+	0xd3, 0x18, 0xf6, 0xc2, 0x00, 0x6f, 0xe5, 0x38, 0xa9, 0x88, 0xd0, 0xb6, 0x66, 0xc9, 0x77, 0xfb,
+	0x6a, 0xee, 0xd8, 0xc6, 0x13, 0x84, 0x94, 0x80, 0xd9, 0xb6, 0x27, 0x5b, 0x66, 0x75, 0xd8, 0x0d,
+	0x03, 0xbc, 0x01, 0x29, 0xd1, 0x3d, 0x42, 0x57, 0xe5, 0x22, 0x7a, 0xcf, 0x34, 0x4a, 0xe6, 0xbe,
+	0x5a, 0x1e, 0xa5, 0x52, 0xae, 0x8a, 0x38, 0x17, 0xf9, 0xb5, 0x8e, 0x9a, 0xba, 0xec, 0xba, 0xae,
+	0x87, 0x08, 0xc5, 0x0e, 0x25, 0x95, 0x7d, 0x58, 0x5d, 0xd9, 0x18, 0x9f, 0x84, 0x44, 0xf8, 0x18,
+	0x18, 0xf5, 0x6c, 0x7a, 0x27, 0x5b, 0x5f, 0xd0, 0xb3, 0x05, 0x09, 0xa1, 0xad, 0x84, 0xa1, 0x6b,
+	0x3c, 0x42, 0x5d, 0x20, 0x2b, 0x54, 0x19, 0x7c, 0x52, 0xa5, 0x32, 0x42, 0x8d, 0x84, 0x98, 0x56,
+	0xfa, 0x6b, 0x74, 0x13, 0xaa, 0xf5, 0xa5, 0xc4, 0xd6, 0x25, 0x96, 0x84, 0x01, 0xee, 0x15, 0xb1,
+	0x40, 0x48, 0x68, 0x27, 0x05, 0xab, 0x0b, 0x89, 0xde, 0x43, 0x8d, 0x58, 0x26, 0x6d, 0x54, 0x86,
+	0xdc, 0x0c, 0x03, 0x7c, 0x23, 0xcb, 0x53, 0x46, 0xae, 0xe9, 0xa5, 0xb4, 0x12, 0xc4, 0xca, 0x67,
+	0x59, 0xa9, 0x8a, 0x55, 0x0f, 0xb0, 0x26, 0x40, 0x5e, 0x86, 0x9a, 0x49, 0x9b, 0x39, 0xd3, 0x63,
+	0x57, 0x7e, 0x83, 0x6b, 0x8f, 0xb7, 0xfb, 0xe5, 0x93, 0xba, 0x0f, 0x3e, 0xa9, 0xa1, 0x19, 0x06,
+	0xb8, 0x93, 0x6b, 0xd5, 0x88, 0x11, 0xa5, 0x48, 0x65, 0xe4, 0x62, 0x19, 0x35, 0xf4, 0x3c, 0x51,
+	0x2d, 0xb3, 0x8f, 0x56, 0xe3, 0x91, 0x13, 0x77, 0xcc, 0x87, 0xd5, 0x1d, 0xd3, 0x56, 0x19, 0x92,
+	0x08, 0x42, 0xaf, 0x7b, 0x9a, 0x16, 0xcd, 0xf0, 0x64, 0x3f, 0xdb, 0x2e, 0x60, 0x86, 0xe7, 0x15,
+	0x84, 0xae, 0xc7, 0x00, 0xdd, 0x2c, 0x47, 0xa8, 0x93, 0x8a, 0x0a, 0xbd, 0x02, 0x4e, 0x97, 0x32,
+	0x15, 0xa1, 0x1b, 0x31, 0x2e, 0xed, 0x94, 0x11, 0xea, 0xa6, 0xda, 0x53, 0x26, 0x4e, 0xb9, 0x3d,
+	0x9e, 0xb2, 0x09, 0x37, 0xeb, 0xf9, 0xf6, 0x2b, 0x95, 0x11, 0x6a, 0xc4, 0xcc, 0x7d, 0xb9, 0xfb,
+	0x05, 0x9b, 0x70, 0xe3, 0x53, 0xb4, 0xa6, 0xd5, 0xa0, 0x45, 0x36, 0xc3, 0x00, 0x1b, 0x19, 0x94,
+	0xea, 0x10, 0xa4, 0x56, 0xb2, 0x41, 0x0a, 0x26, 0xaf, 0xfc, 0xef, 0x26, 0xff, 0xb2, 0x8c, 0x5a,
+	0x32, 0x6c, 0x34, 0xe3, 0x96, 0xf6, 0x79, 0x14, 0xa7, 0x15, 0x33, 0x6e, 0xa5, 0x5e, 0x0f, 0xaa,
+	0xbd, 0xce, 0x24, 0xd2, 0x51, 0x71, 0x22, 0x05, 0x8e, 0xbc, 0xca, 0xdc, 0xce, 0xda, 0x0e, 0xbc,
+	0x2a, 0x53, 0x11, 0xba, 0x01, 0x58, 0xda, 0x7d, 0x07, 0xdd, 0xcd, 0x6a, 0xc1, 0x0a, 0xb4, 0xc1,
+	0x4e, 0x18, 0xe0, 0x0f, 0xca, 0xd0, 0x39, 0x39, 0xa1, 0x26, 0xc8, 0x91, 0xd4, 0x44, 0xb6, 0x45,
+	0x72, 0x7a, 0x48, 0x35, 0x98, 0xd7, 0x85, 0xd3, 0x23, 0x11, 0xc4, 0xa7, 0x47, 0xc4, 0x90, 0x66,
+	0x66, 0x19, 0x60, 0x7a, 0x97, 0x33, 0xd4, 0x23, 0x35, 0x05, 0x7c, 0x0e, 0xf2, 0xcf, 0x32, 0x32,
+	0xa2, 0x63, 0xd3, 0x63, 0x96, 0x0f, 0x0c, 0x7b, 0x85, 0xda, 0x96, 0xde, 0xcd, 0x79, 0xf6, 0xb8,
+	0xda, 0x33, 0xfd, 0x95, 0xe5, 0x03, 0x09, 0x5d, 0xb7, 0x32, 0x19, 0xa2, 0xe9, 0x99, 0x17, 0x65,
+	0xcd, 0x03, 0xd3, 0xb3, 0x42, 0x48, 0x68, 0x27, 0x0b, 0xd5, 0x16, 0xfe, 0x80, 0xb6, 0x0b, 0x11,
+	0xd9, 0x0d, 0x60, 0x64, 0x3f, 0x0c, 0xf0, 0x6e, 0x45, 0x9a, 0x62, 0x10, 0xa1, 0xbd, 0x6c, 0x4a,
+	0x58, 0x37, 0x69, 0xea, 0x21, 0x32, 0xb2, 0x61, 0xc0, 0x57, 0xf0, 0xf3, 0xa8, 0xa8, 0x21, 0xb4,
+	0x0d, 0xd1, 0xd2, 0xdd, 0x02, 0x0c, 0x18, 0x5c, 0x09, 0xd3, 0xbf, 0x0c, 0xac, 0xdc, 0x93, 0x91,
+	0xbf, 0xeb, 0xa8, 0xad, 0x26, 0x2f, 0x30, 0xf9, 0x25, 0xd2, 0xe3, 0x2f, 0x67, 0xf1, 0xc7, 0xd5,
+	0x16, 0x77, 0x33, 0xf3, 0x25, 0x31, 0xb8, 0xe1, 0x01, 0x36, 0x18, 0x79, 0xa5, 0xe6, 0x16, 0x47,
+	0x5e, 0xde, 0x5a, 0x03, 0xe2, 0xb4, 0xb1, 0x73, 0x74, 0x3f, 0xa7, 0xae, 0xb4, 0xf5, 0x61, 0x18,
+	0xe0, 0x9d, 0xd2, 0x04, 0x65, 0xc5, 0xba, 0x03, 0x93, 0x15, 0x2c, 0x65, 0x68, 0x2b, 0xc7, 0x28,
+	0xce, 0xf0, 0x07, 0x61, 0x80, 0xef, 0x97, 0xe6, 0xcb, 0x0c, 0xf2, 0x4d, 0x98, 0x08, 0x0c, 0xf3,
+	0xf4, 0xe8, 0x4a, 0x7b, 0x46, 0xd9, 0x5c, 0x3c, 0xba, 0x40, 0xc7, 0xac, 0xa7, 0x38, 0xd9, 0x2f,
+	0x3f, 0xa2, 0x6e, 0xa1, 0x89, 0xc1, 0x88, 0xdf, 0xad, 0x1a, 0xf1, 0xc5, 0xaf, 0x1f, 0x3a, 0x54,
+	0x8a, 0x24, 0xd4, 0xb0, 0x8a, 0x51, 0xdf, 0xbe, 0xbb, 0xe8, 0xd5, 0xde, 0x5f, 0xf4, 0x6a, 0x7f,
+	0x5d, 0xf4, 0x6a, 0x3f, 0x5d, 0xf6, 0x96, 0xde, 0x5f, 0xf6, 0x96, 0xfe, 0xb8, 0xec, 0x2d, 0xa1,
+	0x5b, 0x8e, 0x5b, 0x91, 0xfd, 0xa8, 0xf6, 0xcd, 0x93, 0x13, 0xc7, 0x3f, 0x9d, 0xbf, 0xee, 0x5b,
+	0xee, 0x64, 0x90, 0x8a, 0x3e, 0x72, 0x5c, 0xb0, 0x1a, 0x2c, 0xd2, 0xff, 0x92, 0xfe, 0xf9, 0x8c,
+	0x8b, 0xd7, 0x2b, 0xf2, 0x8f, 0xe1, 0x27, 0xff, 0x0d, 0x00, 0x52, 0x98, 0x13, 0x0a, 0x6f, 0x0e,
 	0x00, 0x00,
 }
 
@@ -645,6 +722,27 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.MaxHistoryVersions != that1.MaxHistoryVersions {
+		return false
+	}
+	if this.MaxScopeOwners != that1.MaxScopeOwners {
+		return false
+	}
+	if this.MaxDataAccess != that1.MaxDataAccess {
+		return false
+	}
+	if this.MaxRecordsPerScope != that1.MaxRecordsPerScope {
+		return false
+	}
+	if this.MaxRecordInputs != that1.MaxRecordInputs {
+		return false
+	}
+	if this.MaxRecordOutputs != that1.MaxRecordOutputs {
+		return false
+	}
+	if this.MaxSessionContextSize != that1.MaxSessionContextSize {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -667,6 +765,41 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxSessionContextSize != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.MaxSessionContextSize))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxRecordOutputs != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.MaxRecordOutputs))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxRecordInputs != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.MaxRecordInputs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxRecordsPerScope != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.MaxRecordsPerScope))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxDataAccess != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.MaxDataAccess))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxScopeOwners != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.MaxScopeOwners))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxHistoryVersions != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.MaxHistoryVersions))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -1096,6 +1229,27 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.MaxHistoryVersions != 0 {
+		n += 1 + sovMetadata(uint64(m.MaxHistoryVersions))
+	}
+	if m.MaxScopeOwners != 0 {
+		n += 1 + sovMetadata(uint64(m.MaxScopeOwners))
+	}
+	if m.MaxDataAccess != 0 {
+		n += 1 + sovMetadata(uint64(m.MaxDataAccess))
+	}
+	if m.MaxRecordsPerScope != 0 {
+		n += 1 + sovMetadata(uint64(m.MaxRecordsPerScope))
+	}
+	if m.MaxRecordInputs != 0 {
+		n += 1 + sovMetadata(uint64(m.MaxRecordInputs))
+	}
+	if m.MaxRecordOutputs != 0 {
+		n += 1 + sovMetadata(uint64(m.MaxRecordOutputs))
+	}
+	if m.MaxSessionContextSize != 0 {
+		n += 1 + sovMetadata(uint64(m.MaxSessionContextSize))
+	}
 	return n
 }
 
@@ -1312,6 +1466,139 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHistoryVersions", wireType)
+			}
+			m.MaxHistoryVersions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHistoryVersions |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxScopeOwners", wireType)
+			}
+			m.MaxScopeOwners = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxScopeOwners |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDataAccess", wireType)
+			}
+			m.MaxDataAccess = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDataAccess |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRecordsPerScope", wireType)
+			}
+			m.MaxRecordsPerScope = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRecordsPerScope |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRecordInputs", wireType)
+			}
+			m.MaxRecordInputs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRecordInputs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRecordOutputs", wireType)
+			}
+			m.MaxRecordOutputs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRecordOutputs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSessionContextSize", wireType)
+			}
+			m.MaxSessionContextSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSessionContextSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(dAtA[iNdEx:])
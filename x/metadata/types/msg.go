@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -21,9 +22,16 @@ const (
 	TypeURLMsgDeleteScopeOwnerRequest                = "/provenance.metadata.v1.MsgDeleteScopeOwnerRequest"
 	TypeURLMsgUpdateValueOwnersRequest               = "/provenance.metadata.v1.MsgUpdateValueOwnersRequest"
 	TypeURLMsgMigrateValueOwnerRequest               = "/provenance.metadata.v1.MsgMigrateValueOwnerRequest"
+	TypeURLMsgListScopeForSaleRequest                = "/provenance.metadata.v1.MsgListScopeForSaleRequest"
+	TypeURLMsgCancelScopeListingRequest              = "/provenance.metadata.v1.MsgCancelScopeListingRequest"
+	TypeURLMsgBuyScopeRequest                        = "/provenance.metadata.v1.MsgBuyScopeRequest"
+	TypeURLMsgLockScopeRequest                       = "/provenance.metadata.v1.MsgLockScopeRequest"
+	TypeURLMsgUnlockScopeRequest                     = "/provenance.metadata.v1.MsgUnlockScopeRequest"
+	TypeURLMsgMigrateScopesToSpecRequest             = "/provenance.metadata.v1.MsgMigrateScopesToSpecRequest"
 	TypeURLMsgWriteSessionRequest                    = "/provenance.metadata.v1.MsgWriteSessionRequest"
 	TypeURLMsgWriteRecordRequest                     = "/provenance.metadata.v1.MsgWriteRecordRequest"
 	TypeURLMsgDeleteRecordRequest                    = "/provenance.metadata.v1.MsgDeleteRecordRequest"
+	TypeURLMsgWriteScopeBundleRequest                = "/provenance.metadata.v1.MsgWriteScopeBundleRequest"
 	TypeURLMsgWriteScopeSpecificationRequest         = "/provenance.metadata.v1.MsgWriteScopeSpecificationRequest"
 	TypeURLMsgDeleteScopeSpecificationRequest        = "/provenance.metadata.v1.MsgDeleteScopeSpecificationRequest"
 	TypeURLMsgWriteContractSpecificationRequest      = "/provenance.metadata.v1.MsgWriteContractSpecificationRequest"
@@ -36,6 +44,7 @@ const (
 	TypeURLMsgDeleteOSLocatorRequest                 = "/provenance.metadata.v1.MsgDeleteOSLocatorRequest"
 	TypeURLMsgModifyOSLocatorRequest                 = "/provenance.metadata.v1.MsgModifyOSLocatorRequest"
 	TypeURLMsgSetAccountDataRequest                  = "/provenance.metadata.v1.MsgSetAccountDataRequest"
+	TypeURLMsgUpdateParamsRequest                    = "/provenance.metadata.v1.MsgUpdateParamsRequest"
 )
 
 // MetadataMsg extends the sdk.Msg interface with functions common to x/metadata messages.
@@ -56,9 +65,16 @@ var allRequestMsgs = []MetadataMsg{
 	(*MsgDeleteScopeOwnerRequest)(nil),
 	(*MsgUpdateValueOwnersRequest)(nil),
 	(*MsgMigrateValueOwnerRequest)(nil),
+	(*MsgListScopeForSaleRequest)(nil),
+	(*MsgCancelScopeListingRequest)(nil),
+	(*MsgBuyScopeRequest)(nil),
+	(*MsgLockScopeRequest)(nil),
+	(*MsgUnlockScopeRequest)(nil),
+	(*MsgMigrateScopesToSpecRequest)(nil),
 	(*MsgWriteSessionRequest)(nil),
 	(*MsgWriteRecordRequest)(nil),
 	(*MsgDeleteRecordRequest)(nil),
+	(*MsgWriteScopeBundleRequest)(nil),
 
 	(*MsgWriteScopeSpecificationRequest)(nil),
 	(*MsgDeleteScopeSpecificationRequest)(nil),
@@ -77,6 +93,8 @@ var allRequestMsgs = []MetadataMsg{
 	(*MsgModifyOSLocatorRequest)(nil),
 
 	(*MsgSetAccountDataRequest)(nil),
+
+	(*MsgUpdateParamsRequest)(nil),
 }
 
 // We still need these deprecated messages to be sdk.Msg for the codec.
@@ -433,6 +451,197 @@ func (msg MsgMigrateValueOwnerRequest) ValidateBasic() error {
 	return nil
 }
 
+// ------------------  MsgListScopeForSaleRequest  ------------------
+
+// NewMsgListScopeForSaleRequest creates a new msg instance
+func NewMsgListScopeForSaleRequest(scopeID MetadataAddress, price sdk.Coins, expiration time.Time, signers []string) *MsgListScopeForSaleRequest {
+	return &MsgListScopeForSaleRequest{
+		ScopeId:    scopeID,
+		Price:      price,
+		Expiration: expiration,
+		Signers:    signers,
+	}
+}
+
+// GetSigners returns the address(es) that signed. Implements sdk.Msg interface.
+func (msg MsgListScopeForSaleRequest) GetSigners() []sdk.AccAddress {
+	return stringsToAccAddresses(msg.Signers)
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgListScopeForSaleRequest) GetSignerStrs() []string {
+	return msg.Signers
+}
+
+// ValidateBasic performs as much validation as possible without outside info. Implements sdk.Msg interface.
+func (msg MsgListScopeForSaleRequest) ValidateBasic() error {
+	if !msg.ScopeId.IsScopeAddress() {
+		return fmt.Errorf("address is not a scope id: %v", msg.ScopeId.String())
+	}
+	if err := validatePrice(msg.Price); err != nil {
+		return err
+	}
+	if msg.Expiration.IsZero() {
+		return fmt.Errorf("an expiration is required")
+	}
+	if len(msg.Signers) == 0 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	return nil
+}
+
+// ------------------  MsgCancelScopeListingRequest  ------------------
+
+// NewMsgCancelScopeListingRequest creates a new msg instance
+func NewMsgCancelScopeListingRequest(scopeID MetadataAddress, signers []string) *MsgCancelScopeListingRequest {
+	return &MsgCancelScopeListingRequest{
+		ScopeId: scopeID,
+		Signers: signers,
+	}
+}
+
+// GetSigners returns the address(es) that signed. Implements sdk.Msg interface.
+func (msg MsgCancelScopeListingRequest) GetSigners() []sdk.AccAddress {
+	return stringsToAccAddresses(msg.Signers)
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgCancelScopeListingRequest) GetSignerStrs() []string {
+	return msg.Signers
+}
+
+// ValidateBasic performs as much validation as possible without outside info. Implements sdk.Msg interface.
+func (msg MsgCancelScopeListingRequest) ValidateBasic() error {
+	if !msg.ScopeId.IsScopeAddress() {
+		return fmt.Errorf("address is not a scope id: %v", msg.ScopeId.String())
+	}
+	if len(msg.Signers) == 0 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	return nil
+}
+
+// ------------------  MsgBuyScopeRequest  ------------------
+
+// NewMsgBuyScopeRequest creates a new msg instance
+func NewMsgBuyScopeRequest(scopeID MetadataAddress, buyer sdk.AccAddress, price sdk.Coins, signers []string) *MsgBuyScopeRequest {
+	return &MsgBuyScopeRequest{
+		ScopeId: scopeID,
+		Buyer:   buyer.String(),
+		Price:   price,
+		Signers: signers,
+	}
+}
+
+// GetSigners returns the address(es) that signed. Implements sdk.Msg interface.
+func (msg MsgBuyScopeRequest) GetSigners() []sdk.AccAddress {
+	return stringsToAccAddresses(msg.Signers)
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgBuyScopeRequest) GetSignerStrs() []string {
+	return msg.Signers
+}
+
+// ValidateBasic performs as much validation as possible without outside info. Implements sdk.Msg interface.
+func (msg MsgBuyScopeRequest) ValidateBasic() error {
+	if !msg.ScopeId.IsScopeAddress() {
+		return fmt.Errorf("address is not a scope id: %v", msg.ScopeId.String())
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Buyer); err != nil {
+		return fmt.Errorf("invalid buyer address: %w", err)
+	}
+	if err := validatePrice(msg.Price); err != nil {
+		return err
+	}
+	if len(msg.Signers) == 0 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	for _, signer := range msg.Signers {
+		if signer == msg.Buyer {
+			return nil
+		}
+	}
+	return fmt.Errorf("buyer %s must be a signer", msg.Buyer)
+}
+
+// validatePrice makes sure the provided price of a scope listing is valid and not zero.
+func validatePrice(price sdk.Coins) error {
+	if err := price.Validate(); err != nil {
+		return fmt.Errorf("invalid price %q: %w", price, err)
+	}
+	if price.IsZero() {
+		return fmt.Errorf("price cannot be zero")
+	}
+	return nil
+}
+
+// ------------------  MsgLockScopeRequest  ------------------
+
+// NewMsgLockScopeRequest creates a new msg instance
+func NewMsgLockScopeRequest(scopeID MetadataAddress, reason string, signers []string) *MsgLockScopeRequest {
+	return &MsgLockScopeRequest{
+		ScopeId: scopeID,
+		Reason:  reason,
+		Signers: signers,
+	}
+}
+
+// GetSigners returns the address(es) that signed. Implements sdk.Msg interface.
+func (msg MsgLockScopeRequest) GetSigners() []sdk.AccAddress {
+	return stringsToAccAddresses(msg.Signers)
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgLockScopeRequest) GetSignerStrs() []string {
+	return msg.Signers
+}
+
+// ValidateBasic performs as much validation as possible without outside info. Implements sdk.Msg interface.
+func (msg MsgLockScopeRequest) ValidateBasic() error {
+	if !msg.ScopeId.IsScopeAddress() {
+		return fmt.Errorf("address is not a scope id: %v", msg.ScopeId.String())
+	}
+	if err := validateScopeLockReason(msg.Reason); err != nil {
+		return err
+	}
+	if len(msg.Signers) == 0 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	return nil
+}
+
+// ------------------  MsgUnlockScopeRequest  ------------------
+
+// NewMsgUnlockScopeRequest creates a new msg instance
+func NewMsgUnlockScopeRequest(scopeID MetadataAddress, signers []string) *MsgUnlockScopeRequest {
+	return &MsgUnlockScopeRequest{
+		ScopeId: scopeID,
+		Signers: signers,
+	}
+}
+
+// GetSigners returns the address(es) that signed. Implements sdk.Msg interface.
+func (msg MsgUnlockScopeRequest) GetSigners() []sdk.AccAddress {
+	return stringsToAccAddresses(msg.Signers)
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgUnlockScopeRequest) GetSignerStrs() []string {
+	return msg.Signers
+}
+
+// ValidateBasic performs as much validation as possible without outside info. Implements sdk.Msg interface.
+func (msg MsgUnlockScopeRequest) ValidateBasic() error {
+	if !msg.ScopeId.IsScopeAddress() {
+		return fmt.Errorf("address is not a scope id: %v", msg.ScopeId.String())
+	}
+	if len(msg.Signers) == 0 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	return nil
+}
+
 // ------------------  MsgWriteSessionRequest  ------------------
 
 // NewMsgWriteSessionRequest creates a new msg instance
@@ -585,6 +794,72 @@ func (msg MsgDeleteRecordRequest) ValidateBasic() error {
 	return nil
 }
 
+// ------------------  MsgWriteScopeBundleRequest  ------------------
+
+// NewMsgWriteScopeBundleRequest creates a new msg instance
+func NewMsgWriteScopeBundleRequest(scope Scope, sessions []Session, records []Record, signers []string) *MsgWriteScopeBundleRequest {
+	return &MsgWriteScopeBundleRequest{Scope: scope, Sessions: sessions, Records: records, Signers: signers}
+}
+
+// GetSigners returns the address(es) that signed. Implements sdk.Msg interface.
+func (msg MsgWriteScopeBundleRequest) GetSigners() []sdk.AccAddress {
+	return stringsToAccAddresses(msg.Signers)
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgWriteScopeBundleRequest) GetSignerStrs() []string {
+	return msg.Signers
+}
+
+// ValidateBasic performs as much validation as possible without outside info. Implements sdk.Msg interface.
+func (msg MsgWriteScopeBundleRequest) ValidateBasic() error {
+	if len(msg.Signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	if err := msg.Scope.ValidateBasic(); err != nil {
+		return err
+	}
+	sessionIDs := make([]MetadataAddress, 0, len(msg.Sessions))
+	for _, session := range msg.Sessions {
+		if err := session.ValidateBasic(); err != nil {
+			return err
+		}
+		if err := msg.validateInScope(session.SessionId); err != nil {
+			return fmt.Errorf("session %s: %w", session.SessionId, err)
+		}
+		if containsMetadataAddress(sessionIDs, session.SessionId) {
+			return fmt.Errorf("duplicate session id %s", session.SessionId)
+		}
+		sessionIDs = append(sessionIDs, session.SessionId)
+	}
+	recordNames := make([]string, 0, len(msg.Records))
+	for _, record := range msg.Records {
+		if err := record.ValidateBasic(); err != nil {
+			return err
+		}
+		if err := msg.validateInScope(record.SessionId); err != nil {
+			return fmt.Errorf("record %q: %w", record.Name, err)
+		}
+		if containsString(recordNames, record.Name) {
+			return fmt.Errorf("duplicate record name %q", record.Name)
+		}
+		recordNames = append(recordNames, record.Name)
+	}
+	return nil
+}
+
+// validateInScope returns an error if the provided session id is not part of this msg's scope.
+func (msg MsgWriteScopeBundleRequest) validateInScope(sessionID MetadataAddress) error {
+	scopeID, err := sessionID.AsScopeAddress()
+	if err != nil {
+		return err
+	}
+	if !scopeID.Equals(msg.Scope.ScopeId) {
+		return fmt.Errorf("not in scope %s", msg.Scope.ScopeId)
+	}
+	return nil
+}
+
 // ------------------  MsgWriteScopeSpecificationRequest  ------------------
 
 // NewMsgWriteScopeSpecificationRequest creates a new msg instance
@@ -658,6 +933,55 @@ func (msg MsgDeleteScopeSpecificationRequest) ValidateBasic() error {
 	return nil
 }
 
+// ------------------  MsgMigrateScopesToSpecRequest  ------------------
+
+// NewMsgMigrateScopesToSpecRequest creates a new msg instance
+func NewMsgMigrateScopesToSpecRequest(fromSpecID, toSpecID MetadataAddress, scopeIDs []MetadataAddress, signers []string) *MsgMigrateScopesToSpecRequest {
+	return &MsgMigrateScopesToSpecRequest{
+		FromSpecificationId: fromSpecID,
+		ToSpecificationId:   toSpecID,
+		ScopeIds:            scopeIDs,
+		Signers:             signers,
+	}
+}
+
+// GetSigners returns the address(es) that signed. Implements sdk.Msg interface.
+func (msg MsgMigrateScopesToSpecRequest) GetSigners() []sdk.AccAddress {
+	return stringsToAccAddresses(msg.Signers)
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgMigrateScopesToSpecRequest) GetSignerStrs() []string {
+	return msg.Signers
+}
+
+// ValidateBasic performs as much validation as possible without outside info. Implements sdk.Msg interface.
+func (msg MsgMigrateScopesToSpecRequest) ValidateBasic() error {
+	if len(msg.Signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	if !msg.FromSpecificationId.IsScopeSpecificationAddress() {
+		return fmt.Errorf("invalid from specification id: %s", msg.FromSpecificationId)
+	}
+	if !msg.ToSpecificationId.IsScopeSpecificationAddress() {
+		return fmt.Errorf("invalid to specification id: %s", msg.ToSpecificationId)
+	}
+	if msg.FromSpecificationId.Equals(msg.ToSpecificationId) {
+		return fmt.Errorf("from and to specification ids cannot be the same: %s", msg.ToSpecificationId)
+	}
+	seen := make(map[string]bool)
+	for _, scopeID := range msg.ScopeIds {
+		if !scopeID.IsScopeAddress() {
+			return fmt.Errorf("address is not a scope id: %s", scopeID)
+		}
+		if seen[string(scopeID)] {
+			return fmt.Errorf("duplicate scope id: %s", scopeID)
+		}
+		seen[string(scopeID)] = true
+	}
+	return nil
+}
+
 // ------------------  MsgWriteContractSpecificationRequest  ------------------
 
 // NewMsgWriteContractSpecificationRequest creates a new msg instance
@@ -1030,6 +1354,31 @@ func (msg MsgSetAccountDataRequest) GetSignerStrs() []string {
 	return msg.Signers
 }
 
+// ------------------  MsgUpdateParamsRequest  ------------------
+
+// NewMsgUpdateParamsRequest creates a new msg instance
+func NewMsgUpdateParamsRequest(authority string, params Params) *MsgUpdateParamsRequest {
+	return &MsgUpdateParamsRequest{Authority: authority, Params: params}
+}
+
+// ValidateBasic performs as much validation as possible without outside info. Implements sdk.Msg interface.
+func (msg MsgUpdateParamsRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return fmt.Errorf("invalid authority: %w", err)
+	}
+	return nil
+}
+
+// GetSigners returns the address(es) that signed. Implements sdk.Msg interface.
+func (msg MsgUpdateParamsRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Authority)}
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgUpdateParamsRequest) GetSignerStrs() []string {
+	return []string{msg.Authority}
+}
+
 // ------------------  SessionIdComponents  ------------------
 
 func (msg *SessionIdComponents) GetSessionAddr() (MetadataAddress, error) {
@@ -1115,6 +1464,21 @@ func NewMsgDeleteRecordResponse() *MsgDeleteRecordResponse {
 	return &MsgDeleteRecordResponse{}
 }
 
+func NewMsgWriteScopeBundleResponse(scopeID MetadataAddress, sessionIDs, recordIDs []MetadataAddress) *MsgWriteScopeBundleResponse {
+	rv := &MsgWriteScopeBundleResponse{
+		ScopeIdInfo:    GetScopeIDInfo(scopeID),
+		SessionIdInfos: make([]*SessionIdInfo, len(sessionIDs)),
+		RecordIdInfos:  make([]*RecordIdInfo, len(recordIDs)),
+	}
+	for i, sessionID := range sessionIDs {
+		rv.SessionIdInfos[i] = GetSessionIDInfo(sessionID)
+	}
+	for i, recordID := range recordIDs {
+		rv.RecordIdInfos[i] = GetRecordIDInfo(recordID)
+	}
+	return rv
+}
+
 func NewMsgWriteScopeSpecificationResponse(scopeSpecID MetadataAddress) *MsgWriteScopeSpecificationResponse {
 	return &MsgWriteScopeSpecificationResponse{
 		ScopeSpecIdInfo: GetScopeSpecIDInfo(scopeSpecID),
@@ -1125,6 +1489,13 @@ func NewMsgDeleteScopeSpecificationResponse() *MsgDeleteScopeSpecificationRespon
 	return &MsgDeleteScopeSpecificationResponse{}
 }
 
+func NewMsgMigrateScopesToSpecResponse(migrated []MetadataAddress, failures []ScopeMigrationFailure) *MsgMigrateScopesToSpecResponse {
+	return &MsgMigrateScopesToSpecResponse{
+		MigratedScopeIds: migrated,
+		Failures:         failures,
+	}
+}
+
 func NewMsgWriteContractSpecificationResponse(contractSpecID MetadataAddress) *MsgWriteContractSpecificationResponse {
 	return &MsgWriteContractSpecificationResponse{
 		ContractSpecIdInfo: GetContractSpecIDInfo(contractSpecID),
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -52,11 +53,18 @@ func TestAllMsgsGetSigners(t *testing.T) {
 		func(signers []string) MetadataMsg { return &MsgDeleteScopeOwnerRequest{Signers: signers} },
 		func(signers []string) MetadataMsg { return &MsgUpdateValueOwnersRequest{Signers: signers} },
 		func(signers []string) MetadataMsg { return &MsgMigrateValueOwnerRequest{Signers: signers} },
+		func(signers []string) MetadataMsg { return &MsgListScopeForSaleRequest{Signers: signers} },
+		func(signers []string) MetadataMsg { return &MsgCancelScopeListingRequest{Signers: signers} },
+		func(signers []string) MetadataMsg { return &MsgBuyScopeRequest{Signers: signers} },
+		func(signers []string) MetadataMsg { return &MsgLockScopeRequest{Signers: signers} },
+		func(signers []string) MetadataMsg { return &MsgUnlockScopeRequest{Signers: signers} },
 		func(signers []string) MetadataMsg { return &MsgWriteSessionRequest{Signers: signers} },
 		func(signers []string) MetadataMsg { return &MsgWriteRecordRequest{Signers: signers} },
 		func(signers []string) MetadataMsg { return &MsgDeleteRecordRequest{Signers: signers} },
+		func(signers []string) MetadataMsg { return &MsgWriteScopeBundleRequest{Signers: signers} },
 		func(signers []string) MetadataMsg { return &MsgWriteScopeSpecificationRequest{Signers: signers} },
 		func(signers []string) MetadataMsg { return &MsgDeleteScopeSpecificationRequest{Signers: signers} },
+		func(signers []string) MetadataMsg { return &MsgMigrateScopesToSpecRequest{Signers: signers} },
 		func(signers []string) MetadataMsg { return &MsgWriteContractSpecificationRequest{Signers: signers} },
 		func(signers []string) MetadataMsg { return &MsgDeleteContractSpecificationRequest{Signers: signers} },
 		func(signers []string) MetadataMsg { return &MsgAddContractSpecToScopeSpecRequest{Signers: signers} },
@@ -78,6 +86,7 @@ func TestAllMsgsGetSigners(t *testing.T) {
 		func(signer string) MetadataMsg {
 			return &MsgModifyOSLocatorRequest{Locator: ObjectStoreLocator{Owner: signer}}
 		},
+		func(signer string) MetadataMsg { return &MsgUpdateParamsRequest{Authority: signer} },
 	}
 
 	multiSignerCases := []struct {
@@ -774,6 +783,382 @@ func TestMsgMigrateValueOwnerRequest_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgListScopeForSaleRequest_ValidateBasic(t *testing.T) {
+	scopeID := ScopeMetadataAddress(uuid.New())
+	price := sdk.NewCoins(sdk.NewInt64Coin("scopecoin", 100))
+	expiration := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		msg  MsgListScopeForSaleRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  *NewMsgListScopeForSaleRequest(scopeID, price, expiration, []string{"signer1"}),
+			exp:  "",
+		},
+		{
+			name: "not a scope id",
+			msg:  *NewMsgListScopeForSaleRequest(ScopeSpecMetadataAddress(uuid.New()), price, expiration, []string{"signer1"}),
+			exp:  "address is not a scope id",
+		},
+		{
+			name: "no price",
+			msg:  *NewMsgListScopeForSaleRequest(scopeID, nil, expiration, []string{"signer1"}),
+			exp:  "price cannot be zero",
+		},
+		{
+			name: "invalid price",
+			msg:  *NewMsgListScopeForSaleRequest(scopeID, sdk.Coins{sdk.Coin{Denom: "x", Amount: sdk.NewInt(1)}}, expiration, []string{"signer1"}),
+			exp:  "invalid price \"1x\": invalid denom: x",
+		},
+		{
+			name: "no expiration",
+			msg:  *NewMsgListScopeForSaleRequest(scopeID, price, time.Time{}, []string{"signer1"}),
+			exp:  "an expiration is required",
+		},
+		{
+			name: "no signers",
+			msg:  *NewMsgListScopeForSaleRequest(scopeID, price, expiration, nil),
+			exp:  "at least one signer is required",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				assert.ErrorContains(t, err, tc.exp, "ValidateBasic")
+			} else {
+				assert.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestMsgCancelScopeListingRequest_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgCancelScopeListingRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  *NewMsgCancelScopeListingRequest(ScopeMetadataAddress(uuid.New()), []string{"signer1"}),
+			exp:  "",
+		},
+		{
+			name: "not a scope id",
+			msg:  *NewMsgCancelScopeListingRequest(ScopeSpecMetadataAddress(uuid.New()), []string{"signer1"}),
+			exp:  "address is not a scope id",
+		},
+		{
+			name: "no signers",
+			msg:  *NewMsgCancelScopeListingRequest(ScopeMetadataAddress(uuid.New()), []string{}),
+			exp:  "at least one signer is required",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				assert.ErrorContains(t, err, tc.exp, "ValidateBasic")
+			} else {
+				assert.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestMsgBuyScopeRequest_ValidateBasic(t *testing.T) {
+	scopeID := ScopeMetadataAddress(uuid.New())
+	buyer := sdk.AccAddress("buyer_______________")
+	price := sdk.NewCoins(sdk.NewInt64Coin("scopecoin", 100))
+
+	tests := []struct {
+		name string
+		msg  MsgBuyScopeRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  *NewMsgBuyScopeRequest(scopeID, buyer, price, []string{"signer1", buyer.String()}),
+			exp:  "",
+		},
+		{
+			name: "not a scope id",
+			msg:  *NewMsgBuyScopeRequest(ScopeSpecMetadataAddress(uuid.New()), buyer, price, []string{buyer.String()}),
+			exp:  "address is not a scope id",
+		},
+		{
+			name: "no buyer",
+			msg:  *NewMsgBuyScopeRequest(scopeID, nil, price, []string{buyer.String()}),
+			exp:  "invalid buyer address: empty address string is not allowed",
+		},
+		{
+			name: "no price",
+			msg:  *NewMsgBuyScopeRequest(scopeID, buyer, sdk.Coins{}, []string{buyer.String()}),
+			exp:  "price cannot be zero",
+		},
+		{
+			name: "no signers",
+			msg:  *NewMsgBuyScopeRequest(scopeID, buyer, price, nil),
+			exp:  "at least one signer is required",
+		},
+		{
+			name: "buyer not a signer",
+			msg:  *NewMsgBuyScopeRequest(scopeID, buyer, price, []string{"signer1"}),
+			exp:  "buyer " + buyer.String() + " must be a signer",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				assert.ErrorContains(t, err, tc.exp, "ValidateBasic")
+			} else {
+				assert.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestMsgLockScopeRequest_ValidateBasic(t *testing.T) {
+	scopeID := ScopeMetadataAddress(uuid.New())
+	signers := []string{"signer1"}
+
+	tests := []struct {
+		name string
+		msg  MsgLockScopeRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  *NewMsgLockScopeRequest(scopeID, "litigation", signers),
+			exp:  "",
+		},
+		{
+			name: "not a scope id",
+			msg:  *NewMsgLockScopeRequest(ScopeSpecMetadataAddress(uuid.New()), "litigation", signers),
+			exp:  "address is not a scope id",
+		},
+		{
+			name: "no reason",
+			msg:  *NewMsgLockScopeRequest(scopeID, "", signers),
+			exp:  "a reason is required",
+		},
+		{
+			name: "reason too long",
+			msg:  *NewMsgLockScopeRequest(scopeID, strings.Repeat("r", MaxScopeLockReasonLength+1), signers),
+			exp:  "reason length 257 exceeds maximum length of 256",
+		},
+		{
+			name: "no signers",
+			msg:  *NewMsgLockScopeRequest(scopeID, "litigation", nil),
+			exp:  "at least one signer is required",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				assert.ErrorContains(t, err, tc.exp, "ValidateBasic")
+			} else {
+				assert.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestMsgUnlockScopeRequest_ValidateBasic(t *testing.T) {
+	scopeID := ScopeMetadataAddress(uuid.New())
+
+	tests := []struct {
+		name string
+		msg  MsgUnlockScopeRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  *NewMsgUnlockScopeRequest(scopeID, []string{"signer1"}),
+			exp:  "",
+		},
+		{
+			name: "not a scope id",
+			msg:  *NewMsgUnlockScopeRequest(ScopeSpecMetadataAddress(uuid.New()), []string{"signer1"}),
+			exp:  "address is not a scope id",
+		},
+		{
+			name: "no signers",
+			msg:  *NewMsgUnlockScopeRequest(scopeID, nil),
+			exp:  "at least one signer is required",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				assert.ErrorContains(t, err, tc.exp, "ValidateBasic")
+			} else {
+				assert.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestMsgWriteScopeBundleRequest_ValidateBasic(t *testing.T) {
+	scopeUUID := uuid.New()
+	scopeID := ScopeMetadataAddress(scopeUUID)
+	owner := sdk.AccAddress("owner_______________").String()
+	scope := *NewScope(scopeID, ScopeSpecMetadataAddress(uuid.New()), ownerPartyList(owner), nil, "", false)
+	sessionID := SessionMetadataAddress(scopeUUID, uuid.New())
+	otherSessionID := SessionMetadataAddress(uuid.New(), uuid.New())
+	contractSpecID := ContractSpecMetadataAddress(uuid.New())
+	parties := ownerPartyList(owner)
+	session := *NewSession("session", sessionID, contractSpecID, parties, nil)
+	process := *NewProcess("process", &Process_Hash{Hash: "HASH"}, "process")
+	record := *NewRecord("record", sessionID, process, nil, nil, nil)
+	signers := []string{"signer1"}
+
+	tests := []struct {
+		name string
+		msg  MsgWriteScopeBundleRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  *NewMsgWriteScopeBundleRequest(scope, []Session{session}, []Record{record}, signers),
+			exp:  "",
+		},
+		{
+			name: "scope only",
+			msg:  *NewMsgWriteScopeBundleRequest(scope, nil, nil, signers),
+			exp:  "",
+		},
+		{
+			name: "no signers",
+			msg:  *NewMsgWriteScopeBundleRequest(scope, nil, nil, nil),
+			exp:  "at least one signer is required",
+		},
+		{
+			name: "invalid scope",
+			msg:  *NewMsgWriteScopeBundleRequest(Scope{}, nil, nil, signers),
+			exp:  "address is empty",
+		},
+		{
+			name: "invalid session",
+			msg:  *NewMsgWriteScopeBundleRequest(scope, []Session{*NewSession("session", sessionID, nil, parties, nil)}, nil, signers),
+			exp:  "address is empty",
+		},
+		{
+			name: "session in other scope",
+			msg:  *NewMsgWriteScopeBundleRequest(scope, []Session{*NewSession("session", otherSessionID, contractSpecID, parties, nil)}, nil, signers),
+			exp:  "session " + otherSessionID.String() + ": not in scope " + scopeID.String(),
+		},
+		{
+			name: "duplicate session",
+			msg:  *NewMsgWriteScopeBundleRequest(scope, []Session{session, session}, nil, signers),
+			exp:  "duplicate session id " + sessionID.String(),
+		},
+		{
+			name: "invalid record",
+			msg:  *NewMsgWriteScopeBundleRequest(scope, nil, []Record{*NewRecord("", sessionID, process, nil, nil, nil)}, signers),
+			exp:  "invalid/missing name for record",
+		},
+		{
+			name: "record in other scope",
+			msg:  *NewMsgWriteScopeBundleRequest(scope, nil, []Record{*NewRecord("record", otherSessionID, process, nil, nil, nil)}, signers),
+			exp:  `record "record": not in scope ` + scopeID.String(),
+		},
+		{
+			name: "duplicate record name",
+			msg:  *NewMsgWriteScopeBundleRequest(scope, nil, []Record{record, record}, signers),
+			exp:  `duplicate record name "record"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				assert.ErrorContains(t, err, tc.exp, "ValidateBasic")
+			} else {
+				assert.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestMsgMigrateScopesToSpecRequest_ValidateBasic(t *testing.T) {
+	fromSpecID := ScopeSpecMetadataAddress(uuid.New())
+	toSpecID := ScopeSpecMetadataAddress(uuid.New())
+	scopeID := ScopeMetadataAddress(uuid.New())
+	signers := []string{"signer1"}
+
+	tests := []struct {
+		name string
+		msg  MsgMigrateScopesToSpecRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  *NewMsgMigrateScopesToSpecRequest(fromSpecID, toSpecID, []MetadataAddress{scopeID}, signers),
+			exp:  "",
+		},
+		{
+			name: "no scope ids",
+			msg:  *NewMsgMigrateScopesToSpecRequest(fromSpecID, toSpecID, nil, signers),
+			exp:  "",
+		},
+		{
+			name: "no signers",
+			msg:  *NewMsgMigrateScopesToSpecRequest(fromSpecID, toSpecID, nil, nil),
+			exp:  "at least one signer is required",
+		},
+		{
+			name: "from not a scope spec id",
+			msg:  *NewMsgMigrateScopesToSpecRequest(scopeID, toSpecID, nil, signers),
+			exp:  "invalid from specification id: " + scopeID.String(),
+		},
+		{
+			name: "to not a scope spec id",
+			msg:  *NewMsgMigrateScopesToSpecRequest(fromSpecID, nil, nil, signers),
+			exp:  "invalid to specification id: ",
+		},
+		{
+			name: "same specs",
+			msg:  *NewMsgMigrateScopesToSpecRequest(fromSpecID, fromSpecID, nil, signers),
+			exp:  "from and to specification ids cannot be the same: " + fromSpecID.String(),
+		},
+		{
+			name: "not a scope id",
+			msg:  *NewMsgMigrateScopesToSpecRequest(fromSpecID, toSpecID, []MetadataAddress{toSpecID}, signers),
+			exp:  "address is not a scope id: " + toSpecID.String(),
+		},
+		{
+			name: "duplicate scope id",
+			msg:  *NewMsgMigrateScopesToSpecRequest(fromSpecID, toSpecID, []MetadataAddress{scopeID, scopeID}, signers),
+			exp:  "duplicate scope id: " + scopeID.String(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				assert.ErrorContains(t, err, tc.exp, "ValidateBasic")
+			} else {
+				assert.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
 func TestMsgAddContractSpecToScopeSpecRequestValidateBasic(t *testing.T) {
 	contractSpecID := ContractSpecMetadataAddress(uuid.New())
 	scopeSpecID := ScopeSpecMetadataAddress(uuid.New())
@@ -1001,6 +1386,48 @@ func TestMsgSetAccountDataRequest_ValidateBasic(t *testing.T) {
 
 // TestPrintMessageTypeStrings just prints out all the MsgTypeURLs.
 // The output can be copy/pasted into the const area in msg.go
+func TestMsgUpdateParamsRequest_ValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress("authority___________").String()
+
+	tests := []struct {
+		name string
+		msg  MsgUpdateParamsRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  *NewMsgUpdateParamsRequest(authority, DefaultParams()),
+			exp:  "",
+		},
+		{
+			name: "no limits",
+			msg:  *NewMsgUpdateParamsRequest(authority, NewParams(0, 0, 0, 0, 0, 0, 0)),
+			exp:  "",
+		},
+		{
+			name: "no authority",
+			msg:  *NewMsgUpdateParamsRequest("", DefaultParams()),
+			exp:  "invalid authority: empty address string is not allowed",
+		},
+		{
+			name: "bad authority",
+			msg:  *NewMsgUpdateParamsRequest("badaddr", DefaultParams()),
+			exp:  "invalid authority: decoding bech32 failed",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				assert.ErrorContains(t, err, tc.exp, "ValidateBasic")
+			} else {
+				assert.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
 func TestPrintMessageTypeStrings(t *testing.T) {
 	var sb strings.Builder
 	sb.WriteString("const (\n")
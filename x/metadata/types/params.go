@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	yaml "gopkg.in/yaml.v2"
 
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -8,25 +10,89 @@ import (
 
 var _ paramtypes.ParamSet = &Params{}
 
-// ParamKeyTable for metadata module
+// Default parameter values
+const (
+	DefaultMaxHistoryVersions    = 10
+	DefaultMaxScopeOwners        = 100
+	DefaultMaxDataAccess         = 100
+	DefaultMaxRecordsPerScope    = 10_000
+	DefaultMaxRecordInputs       = 100
+	DefaultMaxRecordOutputs      = 100
+	DefaultMaxSessionContextSize = 10_240
+)
+
+// Parameter store keys
+var (
+	ParamStoreKeyMaxHistoryVersions    = []byte("MaxHistoryVersions")
+	ParamStoreKeyMaxScopeOwners        = []byte("MaxScopeOwners")
+	ParamStoreKeyMaxDataAccess         = []byte("MaxDataAccess")
+	ParamStoreKeyMaxRecordsPerScope    = []byte("MaxRecordsPerScope")
+	ParamStoreKeyMaxRecordInputs       = []byte("MaxRecordInputs")
+	ParamStoreKeyMaxRecordOutputs      = []byte("MaxRecordOutputs")
+	ParamStoreKeyMaxSessionContextSize = []byte("MaxSessionContextSize")
+)
+
+// ParamKeyTable for metadata module, including the object store locator params that share its subspace.
 func ParamKeyTable() paramtypes.KeyTable {
-	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{}).RegisterParamSet(&OSLocatorParams{})
 }
 
 // NewParams creates a new parameter object
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	maxHistoryVersions uint32,
+	maxScopeOwners uint32,
+	maxDataAccess uint32,
+	maxRecordsPerScope uint32,
+	maxRecordInputs uint32,
+	maxRecordOutputs uint32,
+	maxSessionContextSize uint32,
+) Params {
+	return Params{
+		MaxHistoryVersions:    maxHistoryVersions,
+		MaxScopeOwners:        maxScopeOwners,
+		MaxDataAccess:         maxDataAccess,
+		MaxRecordsPerScope:    maxRecordsPerScope,
+		MaxRecordInputs:       maxRecordInputs,
+		MaxRecordOutputs:      maxRecordOutputs,
+		MaxSessionContextSize: maxSessionContextSize,
+	}
 }
 
 // ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
 // pairs of auth module's parameters.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxHistoryVersions, &p.MaxHistoryVersions, validateUint32Param),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxScopeOwners, &p.MaxScopeOwners, validateUint32Param),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxDataAccess, &p.MaxDataAccess, validateUint32Param),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxRecordsPerScope, &p.MaxRecordsPerScope, validateUint32Param),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxRecordInputs, &p.MaxRecordInputs, validateUint32Param),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxRecordOutputs, &p.MaxRecordOutputs, validateUint32Param),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxSessionContextSize, &p.MaxSessionContextSize, validateUint32Param),
+	}
 }
 
 // DefaultParams defines the parameters for this module
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(
+		DefaultMaxHistoryVersions,
+		DefaultMaxScopeOwners,
+		DefaultMaxDataAccess,
+		DefaultMaxRecordsPerScope,
+		DefaultMaxRecordInputs,
+		DefaultMaxRecordOutputs,
+		DefaultMaxSessionContextSize,
+	)
+}
+
+// validateUint32Param makes sure the provided param value is a uint32. All uint32 values are allowed.
+func validateUint32Param(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
 }
 
 // String implements stringer interface
@@ -34,3 +100,9 @@ func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+// ExceedsLimit returns true if the provided count is more than the provided limit.
+// A limit of zero means there is no limit.
+func ExceedsLimit(limit uint32, count int) bool {
+	return limit > 0 && count > int(limit)
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultParams(t *testing.T) {
+	params := DefaultParams()
+	require.Equal(t, DefaultMaxHistoryVersions, int(params.MaxHistoryVersions))
+	require.Equal(t, DefaultMaxScopeOwners, int(params.MaxScopeOwners))
+	require.Equal(t, DefaultMaxDataAccess, int(params.MaxDataAccess))
+	require.Equal(t, DefaultMaxRecordsPerScope, int(params.MaxRecordsPerScope))
+	require.Equal(t, DefaultMaxRecordInputs, int(params.MaxRecordInputs))
+	require.Equal(t, DefaultMaxRecordOutputs, int(params.MaxRecordOutputs))
+	require.Equal(t, DefaultMaxSessionContextSize, int(params.MaxSessionContextSize))
+}
+
+func TestParamKeyTable(t *testing.T) {
+	params := DefaultParams()
+	require.Len(t, params.ParamSetPairs(), 7)
+	require.NoError(t, validateUint32Param(uint32(5)))
+	require.Error(t, validateUint32Param(5))
+	require.NotPanics(t, func() { ParamKeyTable() }, "ParamKeyTable with both param sets")
+}
+
+func TestExceedsLimit(t *testing.T) {
+	require.False(t, ExceedsLimit(0, 1000), "no limit")
+	require.False(t, ExceedsLimit(3, 3), "at limit")
+	require.True(t, ExceedsLimit(3, 4), "over limit")
+}
@@ -136,3 +136,10 @@ func WrapRecordSpecNotFound(ma MetadataAddress) *RecordSpecificationWrapper {
 		RecordSpecIdInfo: GetRecordSpecIDInfo(ma),
 	}
 }
+
+const (
+	// DefaultLineageDepth is the number of record input references followed by a lineage query when none is requested.
+	DefaultLineageDepth = 5
+	// MaxLineageDepth is the most record input references that a lineage query can follow.
+	MaxLineageDepth = 20
+)
@@ -306,6 +306,11 @@ func (r Record) ValidateBasic() error {
 	if err = r.Process.ValidateBasic(); err != nil {
 		return fmt.Errorf("invalid record process: %w", err)
 	}
+	if r.Value != nil {
+		if err = r.Value.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid record value: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -320,6 +325,39 @@ func (r Record) String() string {
 	return out
 }
 
+// GetHashes returns the hashes of this record's outputs and hash inputs. Each hash only appears once in the return value.
+func (r Record) GetHashes() []string {
+	var rv []string
+	have := make(map[string]bool)
+	add := func(hash string) {
+		if len(hash) > 0 && !have[hash] {
+			rv = append(rv, hash)
+			have[hash] = true
+		}
+	}
+	for _, o := range r.Outputs {
+		add(o.Hash)
+	}
+	for _, i := range r.Inputs {
+		add(i.GetHash())
+	}
+	return rv
+}
+
+// GetInputRecordIDs returns the ids of the records used as inputs to this record. Each id only appears once in the return value.
+func (r Record) GetInputRecordIDs() []MetadataAddress {
+	var rv []MetadataAddress
+	have := make(map[string]bool)
+	for _, i := range r.Inputs {
+		source, ok := i.Source.(*RecordInput_RecordId)
+		if ok && len(source.RecordId) > 0 && !have[string(source.RecordId)] {
+			rv = append(rv, source.RecordId)
+			have[string(source.RecordId)] = true
+		}
+	}
+	return rv
+}
+
 // GetRecordAddress returns the address for this record, or an empty MetadataAddress if it cannot be constructed.
 func (r Record) GetRecordAddress() MetadataAddress {
 	addr, err := r.SessionId.AsRecordAddress(r.Name)
@@ -610,3 +648,116 @@ s2Loop:
 	}
 	return true
 }
+
+// NewScopeListing creates a new instance.
+func NewScopeListing(scopeID MetadataAddress, seller string, price sdk.Coins, expiration time.Time) ScopeListing {
+	return ScopeListing{
+		ScopeId:    scopeID,
+		Seller:     seller,
+		Price:      price,
+		Expiration: expiration,
+	}
+}
+
+// Validate performs basic format checking of the data within a scope listing.
+func (l ScopeListing) Validate() error {
+	if !l.ScopeId.IsScopeAddress() {
+		return fmt.Errorf("address is not a scope id: %v", l.ScopeId.String())
+	}
+	if _, err := sdk.AccAddressFromBech32(l.Seller); err != nil {
+		return fmt.Errorf("invalid seller address: %w", err)
+	}
+	if err := validatePrice(l.Price); err != nil {
+		return err
+	}
+	if l.Expiration.IsZero() {
+		return errors.New("an expiration is required")
+	}
+	return nil
+}
+
+// IsExpired returns true if the listing can no longer be used at the provided block time.
+func (l ScopeListing) IsExpired(blockTime time.Time) bool {
+	return !blockTime.Before(l.Expiration)
+}
+
+// MaxScopeLockReasonLength is the maximum length of the reason a scope is locked.
+const MaxScopeLockReasonLength = 256
+
+// NewScopeLock creates a new instance.
+func NewScopeLock(scopeID MetadataAddress, lockedBy string, reason string, lockedAt time.Time) ScopeLock {
+	return ScopeLock{
+		ScopeId:  scopeID,
+		LockedBy: lockedBy,
+		Reason:   reason,
+		LockedAt: lockedAt,
+	}
+}
+
+// Validate performs basic format checking of the data within a scope lock.
+func (l ScopeLock) Validate() error {
+	if !l.ScopeId.IsScopeAddress() {
+		return fmt.Errorf("address is not a scope id: %v", l.ScopeId.String())
+	}
+	if _, err := sdk.AccAddressFromBech32(l.LockedBy); err != nil {
+		return fmt.Errorf("invalid locked by address: %w", err)
+	}
+	return validateScopeLockReason(l.Reason)
+}
+
+// validateScopeLockReason makes sure the reason a scope is locked is provided and not too long.
+func validateScopeLockReason(reason string) error {
+	if len(reason) == 0 {
+		return errors.New("a reason is required")
+	}
+	if len(reason) > MaxScopeLockReasonLength {
+		return fmt.Errorf("reason length %d exceeds maximum length of %d", len(reason), MaxScopeLockReasonLength)
+	}
+	return nil
+}
+
+// NewRecordVersion creates a new instance.
+func NewRecordVersion(record Record, height int64, blockTime time.Time, deleted bool) RecordVersion {
+	return RecordVersion{
+		Record:  record,
+		Height:  height,
+		Time:    blockTime,
+		Deleted: deleted,
+	}
+}
+
+// Validate performs basic format checking of the data within a record version.
+func (v RecordVersion) Validate() error {
+	if v.Height < 0 {
+		return fmt.Errorf("invalid height %d", v.Height)
+	}
+	return v.Record.ValidateBasic()
+}
+
+// NewScopeVersion creates a new instance containing the owners and value owner of the provided scope.
+func NewScopeVersion(scope Scope, height int64, blockTime time.Time, deleted bool) ScopeVersion {
+	return ScopeVersion{
+		ScopeId:           scope.ScopeId,
+		Owners:            scope.Owners,
+		ValueOwnerAddress: scope.ValueOwnerAddress,
+		Height:            height,
+		Time:              blockTime,
+		Deleted:           deleted,
+	}
+}
+
+// Validate performs basic format checking of the data within a scope version.
+func (v ScopeVersion) Validate() error {
+	if !v.ScopeId.IsScopeAddress() {
+		return fmt.Errorf("address is not a scope id: %v", v.ScopeId.String())
+	}
+	if v.Height < 0 {
+		return fmt.Errorf("invalid height %d", v.Height)
+	}
+	if len(v.ValueOwnerAddress) > 0 {
+		if _, err := sdk.AccAddressFromBech32(v.ValueOwnerAddress); err != nil {
+			return fmt.Errorf("invalid value owner address: %w", err)
+		}
+	}
+	return ValidatePartiesBasic(v.Owners)
+}
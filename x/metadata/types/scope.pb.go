@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
@@ -267,6 +269,8 @@ type Record struct {
 	Outputs []RecordOutput `protobuf:"bytes,5,rep,name=outputs,proto3" json:"outputs"`
 	// specification_id is the id of the record specification that was used to create this record.
 	SpecificationId MetadataAddress `protobuf:"bytes,6,opt,name=specification_id,json=specificationId,proto3,customtype=MetadataAddress" json:"specification_id" yaml:"specification_id"`
+	// value is an optional small value stored on chain with this record.
+	Value *RecordValue `protobuf:"bytes,7,opt,name=value,proto3" json:"value,omitempty" yaml:"value,omitempty"`
 }
 
 func (m *Record) Reset()      { *m = Record{} }
@@ -329,6 +333,69 @@ func (m *Record) GetOutputs() []RecordOutput {
 	return nil
 }
 
+func (m *Record) GetValue() *RecordValue {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+// RecordValue is a small value stored on chain with a record.
+type RecordValue struct {
+	// type_name is the type of this value. It must equal the type_name of the record's specification.
+	TypeName string `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty" yaml:"type_name"`
+	// json is the value encoded as json.
+	// If the record's contract specification has a value schema for the type_name, the value must satisfy it.
+	Json string `protobuf:"bytes,2,opt,name=json,proto3" json:"json,omitempty"`
+}
+
+func (m *RecordValue) Reset()         { *m = RecordValue{} }
+func (m *RecordValue) String() string { return proto.CompactTextString(m) }
+func (*RecordValue) ProtoMessage()    {}
+func (*RecordValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edeea634bfb18aba, []int{3}
+}
+func (m *RecordValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecordValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordValue.Merge(m, src)
+}
+func (m *RecordValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordValue proto.InternalMessageInfo
+
+func (m *RecordValue) GetTypeName() string {
+	if m != nil {
+		return m.TypeName
+	}
+	return ""
+}
+
+func (m *RecordValue) GetJson() string {
+	if m != nil {
+		return m.Json
+	}
+	return ""
+}
+
 // Process contains information used to uniquely identify what was used to generate this record
 type Process struct {
 	// unique identifier for this process
@@ -346,7 +413,7 @@ type Process struct {
 func (m *Process) Reset()      { *m = Process{} }
 func (*Process) ProtoMessage() {}
 func (*Process) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edeea634bfb18aba, []int{3}
+	return fileDescriptor_edeea634bfb18aba, []int{4}
 }
 func (m *Process) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -453,7 +520,7 @@ type RecordInput struct {
 func (m *RecordInput) Reset()      { *m = RecordInput{} }
 func (*RecordInput) ProtoMessage() {}
 func (*RecordInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edeea634bfb18aba, []int{4}
+	return fileDescriptor_edeea634bfb18aba, []int{5}
 }
 func (m *RecordInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -552,7 +619,7 @@ type RecordOutput struct {
 func (m *RecordOutput) Reset()      { *m = RecordOutput{} }
 func (*RecordOutput) ProtoMessage() {}
 func (*RecordOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edeea634bfb18aba, []int{5}
+	return fileDescriptor_edeea634bfb18aba, []int{6}
 }
 func (m *RecordOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -608,7 +675,7 @@ type Party struct {
 func (m *Party) Reset()      { *m = Party{} }
 func (*Party) ProtoMessage() {}
 func (*Party) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edeea634bfb18aba, []int{6}
+	return fileDescriptor_edeea634bfb18aba, []int{7}
 }
 func (m *Party) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -678,7 +745,7 @@ func (m *AuditFields) Reset()         { *m = AuditFields{} }
 func (m *AuditFields) String() string { return proto.CompactTextString(m) }
 func (*AuditFields) ProtoMessage()    {}
 func (*AuditFields) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edeea634bfb18aba, []int{7}
+	return fileDescriptor_edeea634bfb18aba, []int{8}
 }
 func (m *AuditFields) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -749,17 +816,313 @@ func (m *AuditFields) GetMessage() string {
 	return ""
 }
 
+// ScopeListing is an offer to sell the value ownership of a scope for a price.
+type ScopeListing struct {
+	// scope_id is the id of the scope being sold.
+	ScopeId MetadataAddress `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3,customtype=MetadataAddress" json:"scope_id" yaml:"scope_id"`
+	// seller is the value owner of the scope at the time it was listed, and the recipient of the payment.
+	Seller string `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	// price is the amount the buyer has to pay for the scope's value ownership.
+	Price github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=price,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"price"`
+	// expiration is the time after which the scope can no longer be bought using this listing.
+	Expiration time.Time `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration"`
+}
+
+func (m *ScopeListing) Reset()         { *m = ScopeListing{} }
+func (m *ScopeListing) String() string { return proto.CompactTextString(m) }
+func (*ScopeListing) ProtoMessage()    {}
+func (*ScopeListing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edeea634bfb18aba, []int{9}
+}
+func (m *ScopeListing) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeListing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeListing.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScopeListing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeListing.Merge(m, src)
+}
+func (m *ScopeListing) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeListing) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeListing.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScopeListing proto.InternalMessageInfo
+
+func (m *ScopeListing) GetSeller() string {
+	if m != nil {
+		return m.Seller
+	}
+	return ""
+}
+
+func (m *ScopeListing) GetPrice() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Price
+	}
+	return nil
+}
+
+func (m *ScopeListing) GetExpiration() time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return time.Time{}
+}
+
+// ScopeLock is a legal hold on a scope. While a scope is locked, its owners, value owner, sessions and records cannot be
+// changed, and it cannot be deleted.
+type ScopeLock struct {
+	// scope_id is the id of the scope that is locked.
+	ScopeId MetadataAddress `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3,customtype=MetadataAddress" json:"scope_id" yaml:"scope_id"`
+	// locked_by is the address that locked the scope. Only this address or governance can unlock the scope.
+	// If it's the governance module account, only governance can unlock the scope.
+	LockedBy string `protobuf:"bytes,2,opt,name=locked_by,json=lockedBy,proto3" json:"locked_by,omitempty" yaml:"locked_by"`
+	// reason is a short explanation of why the scope is locked.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// locked_at is the block time at which the scope was locked.
+	LockedAt time.Time `protobuf:"bytes,4,opt,name=locked_at,json=lockedAt,proto3,stdtime" json:"locked_at" yaml:"locked_at"`
+}
+
+func (m *ScopeLock) Reset()         { *m = ScopeLock{} }
+func (m *ScopeLock) String() string { return proto.CompactTextString(m) }
+func (*ScopeLock) ProtoMessage()    {}
+func (*ScopeLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edeea634bfb18aba, []int{10}
+}
+func (m *ScopeLock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeLock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScopeLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeLock.Merge(m, src)
+}
+func (m *ScopeLock) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeLock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScopeLock proto.InternalMessageInfo
+
+func (m *ScopeLock) GetLockedBy() string {
+	if m != nil {
+		return m.LockedBy
+	}
+	return ""
+}
+
+func (m *ScopeLock) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *ScopeLock) GetLockedAt() time.Time {
+	if m != nil {
+		return m.LockedAt
+	}
+	return time.Time{}
+}
+
+// RecordVersion is a past (or current) state of a record, as it was written at a given block.
+type RecordVersion struct {
+	// record is the record as it was at this version. Its session_id is the session that wrote this version.
+	Record Record `protobuf:"bytes,1,opt,name=record,proto3" json:"record"`
+	// height is the block height at which this version was written.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time at which this version was written.
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	// deleted is true if the record was deleted at this height.
+	Deleted bool `protobuf:"varint,4,opt,name=deleted,proto3" json:"deleted,omitempty"`
+}
+
+func (m *RecordVersion) Reset()         { *m = RecordVersion{} }
+func (m *RecordVersion) String() string { return proto.CompactTextString(m) }
+func (*RecordVersion) ProtoMessage()    {}
+func (*RecordVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edeea634bfb18aba, []int{11}
+}
+func (m *RecordVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecordVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordVersion.Merge(m, src)
+}
+func (m *RecordVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordVersion proto.InternalMessageInfo
+
+func (m *RecordVersion) GetRecord() Record {
+	if m != nil {
+		return m.Record
+	}
+	return Record{}
+}
+
+func (m *RecordVersion) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *RecordVersion) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *RecordVersion) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
+// ScopeVersion is a past (or current) state of a scope's owners and value owner, as it was written at a given block.
+type ScopeVersion struct {
+	// scope_id is the id of the scope this version is for.
+	ScopeId MetadataAddress `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3,customtype=MetadataAddress" json:"scope_id" yaml:"scope_id"`
+	// owners are the owners of the scope at this version.
+	Owners []Party `protobuf:"bytes,2,rep,name=owners,proto3" json:"owners"`
+	// value_owner_address is the value owner of the scope at this version.
+	ValueOwnerAddress string `protobuf:"bytes,3,opt,name=value_owner_address,json=valueOwnerAddress,proto3" json:"value_owner_address,omitempty" yaml:"value_owner_address"`
+	// height is the block height at which this version was written.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time at which this version was written.
+	Time time.Time `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
+	// deleted is true if the scope was deleted at this height.
+	Deleted bool `protobuf:"varint,6,opt,name=deleted,proto3" json:"deleted,omitempty"`
+}
+
+func (m *ScopeVersion) Reset()         { *m = ScopeVersion{} }
+func (m *ScopeVersion) String() string { return proto.CompactTextString(m) }
+func (*ScopeVersion) ProtoMessage()    {}
+func (*ScopeVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edeea634bfb18aba, []int{12}
+}
+func (m *ScopeVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScopeVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeVersion.Merge(m, src)
+}
+func (m *ScopeVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScopeVersion proto.InternalMessageInfo
+
+func (m *ScopeVersion) GetOwners() []Party {
+	if m != nil {
+		return m.Owners
+	}
+	return nil
+}
+
+func (m *ScopeVersion) GetValueOwnerAddress() string {
+	if m != nil {
+		return m.ValueOwnerAddress
+	}
+	return ""
+}
+
+func (m *ScopeVersion) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ScopeVersion) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *ScopeVersion) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterEnum("provenance.metadata.v1.RecordInputStatus", RecordInputStatus_name, RecordInputStatus_value)
 	proto.RegisterEnum("provenance.metadata.v1.ResultStatus", ResultStatus_name, ResultStatus_value)
 	proto.RegisterType((*Scope)(nil), "provenance.metadata.v1.Scope")
 	proto.RegisterType((*Session)(nil), "provenance.metadata.v1.Session")
 	proto.RegisterType((*Record)(nil), "provenance.metadata.v1.Record")
+	proto.RegisterType((*RecordValue)(nil), "provenance.metadata.v1.RecordValue")
 	proto.RegisterType((*Process)(nil), "provenance.metadata.v1.Process")
 	proto.RegisterType((*RecordInput)(nil), "provenance.metadata.v1.RecordInput")
 	proto.RegisterType((*RecordOutput)(nil), "provenance.metadata.v1.RecordOutput")
 	proto.RegisterType((*Party)(nil), "provenance.metadata.v1.Party")
 	proto.RegisterType((*AuditFields)(nil), "provenance.metadata.v1.AuditFields")
+	proto.RegisterType((*ScopeListing)(nil), "provenance.metadata.v1.ScopeListing")
+	proto.RegisterType((*ScopeLock)(nil), "provenance.metadata.v1.ScopeLock")
+	proto.RegisterType((*RecordVersion)(nil), "provenance.metadata.v1.RecordVersion")
+	proto.RegisterType((*ScopeVersion)(nil), "provenance.metadata.v1.ScopeVersion")
 }
 
 func init() {
@@ -767,84 +1130,105 @@ func init() {
 }
 
 var fileDescriptor_edeea634bfb18aba = []byte{
-	// 1230 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4b, 0x6f, 0xdb, 0xc6,
-	0x13, 0x17, 0x25, 0x59, 0x8f, 0x91, 0xff, 0xff, 0x28, 0x1b, 0xc3, 0x51, 0xd4, 0x44, 0x54, 0xd9,
-	0x02, 0x71, 0xdd, 0x54, 0x6a, 0xdc, 0x17, 0x90, 0xbe, 0x20, 0xc6, 0x36, 0x22, 0x24, 0xb5, 0xd5,
-	0x95, 0x7d, 0x29, 0x50, 0x08, 0x34, 0xb9, 0x91, 0x89, 0x48, 0x5a, 0x96, 0x0f, 0x27, 0x6a, 0x6f,
-	0x05, 0x8a, 0x02, 0x39, 0xe5, 0x98, 0x4b, 0x80, 0xf6, 0x03, 0xf4, 0x7b, 0xe4, 0xd0, 0x43, 0x8e,
-	0x45, 0x0f, 0x6c, 0x91, 0xdc, 0x72, 0xd4, 0x27, 0x28, 0xf6, 0x41, 0x91, 0x4a, 0x28, 0x23, 0x45,
-	0xdb, 0x1b, 0x67, 0xe6, 0x37, 0xb3, 0x33, 0xf3, 0x9b, 0x9d, 0x25, 0x68, 0x8e, 0x4b, 0x4f, 0xc8,
-	0xc4, 0x98, 0x98, 0xa4, 0x3d, 0x26, 0xbe, 0x61, 0x19, 0xbe, 0xd1, 0x3e, 0xb9, 0xda, 0xf6, 0x4c,
-	0xea, 0x90, 0x96, 0xe3, 0x52, 0x9f, 0xa2, 0xf5, 0x18, 0xd3, 0x8a, 0x30, 0xad, 0x93, 0xab, 0xf5,
-	0xb5, 0x21, 0x1d, 0x52, 0x0e, 0x69, 0xb3, 0x2f, 0x81, 0xae, 0xab, 0x43, 0x4a, 0x87, 0x23, 0xd2,
-	0xe6, 0xd2, 0x51, 0x70, 0xbb, 0xed, 0xdb, 0x63, 0xe2, 0xf9, 0xc6, 0xd8, 0x91, 0x80, 0xe6, 0x8b,
-	0x00, 0x8b, 0x78, 0xa6, 0x6b, 0x3b, 0x3e, 0x75, 0x25, 0x62, 0x73, 0x59, 0x52, 0x0e, 0x31, 0xed,
-	0xdb, 0xb6, 0x69, 0xf8, 0x36, 0x9d, 0x08, 0xac, 0xf6, 0x6b, 0x0e, 0x56, 0xfa, 0x2c, 0x59, 0xb4,
-	0x03, 0x25, 0x9e, 0xf5, 0xc0, 0xb6, 0x6a, 0x4a, 0x53, 0xd9, 0x58, 0xd5, 0x37, 0x1f, 0x87, 0x6a,
-	0xe6, 0xf7, 0x50, 0x3d, 0xf3, 0x85, 0x0c, 0xd2, 0xb1, 0x2c, 0x97, 0x78, 0xde, 0x2c, 0x54, 0xcf,
-	0x4c, 0x8d, 0xf1, 0xe8, 0x9a, 0x16, 0x39, 0x68, 0xb8, 0xc8, 0x3f, 0xbb, 0x16, 0xfa, 0x1a, 0xaa,
-	0x0b, 0xe7, 0xb0, 0x70, 0x59, 0x1e, 0x6e, 0x6b, 0x79, 0xb8, 0xf3, 0x32, 0xdc, 0x0b, 0x8e, 0x1a,
-	0x3e, 0xb3, 0xa0, 0xea, 0x5a, 0xe8, 0x63, 0x28, 0xd0, 0xbb, 0x13, 0xe2, 0x7a, 0xb5, 0x5c, 0x33,
-	0xb7, 0x51, 0xd9, 0xba, 0xd4, 0x4a, 0xef, 0x6e, 0xab, 0x67, 0xb8, 0xfe, 0x54, 0xcf, 0xb3, 0x33,
-	0xb1, 0x74, 0x41, 0x1f, 0x41, 0x85, 0x99, 0x07, 0x86, 0x69, 0x12, 0xcf, 0xab, 0xe5, 0x9b, 0xb9,
-	0x8d, 0xb2, 0xbe, 0x3e, 0x0b, 0x55, 0x24, 0xce, 0x4f, 0x18, 0x35, 0x0c, 0x3c, 0x45, 0x2e, 0xa0,
-	0x3d, 0x38, 0x77, 0x62, 0x8c, 0x02, 0x32, 0xe0, 0x81, 0x06, 0x86, 0x48, 0xbc, 0xb6, 0xd2, 0x54,
-	0x36, 0xca, 0x7a, 0x63, 0x16, 0xaa, 0x75, 0x11, 0x20, 0x05, 0xa4, 0xe1, 0xb3, 0x5c, 0xbb, 0xcf,
-	0x94, 0xb2, 0x62, 0xf4, 0x25, 0xac, 0xb9, 0xe4, 0x9b, 0xc0, 0x76, 0xc9, 0xc0, 0x61, 0x79, 0x0e,
-	0x5c, 0x3a, 0x1a, 0x05, 0x4e, 0xad, 0xd0, 0x54, 0x36, 0x4a, 0xba, 0x3a, 0x0b, 0xd5, 0xd7, 0x44,
-	0xc0, 0x34, 0x94, 0x86, 0x91, 0x54, 0xf3, 0x1a, 0x31, 0x57, 0x5e, 0xcb, 0x3f, 0xfc, 0x49, 0xcd,
-	0x68, 0x0f, 0x73, 0x50, 0xec, 0x13, 0xcf, 0xb3, 0xe9, 0x04, 0xdd, 0x04, 0xf0, 0xc4, 0x67, 0x4c,
-	0xe9, 0x95, 0xe5, 0x1c, 0x9c, 0x95, 0x1c, 0xcc, 0x5d, 0x34, 0x5c, 0x96, 0xc2, 0x7f, 0x4f, 0xeb,
-	0xa7, 0x50, 0x64, 0x25, 0xda, 0xe4, 0x6f, 0xf1, 0x1a, 0xf9, 0xa0, 0xb7, 0x21, 0x3f, 0x31, 0xc6,
-	0xa4, 0x96, 0xe7, 0x84, 0x9c, 0x7f, 0x1e, 0xaa, 0x79, 0x7f, 0xea, 0x90, 0x59, 0xa8, 0x56, 0x44,
-	0x0a, 0x4c, 0xd2, 0x30, 0x07, 0xa1, 0x1a, 0x14, 0x4d, 0x3a, 0xf1, 0xc9, 0x3d, 0x9f, 0x13, 0xb8,
-	0x8a, 0x23, 0x11, 0x1d, 0xc2, 0x8a, 0x11, 0x58, 0xb6, 0x5f, 0x33, 0x9b, 0xca, 0x46, 0x65, 0xeb,
-	0x8d, 0x65, 0x39, 0x74, 0x18, 0x68, 0xd7, 0x26, 0x23, 0xcb, 0xd3, 0xeb, 0xb3, 0x50, 0x5d, 0x17,
-	0x87, 0x70, 0xdf, 0x2b, 0x74, 0x6c, 0xfb, 0x64, 0xec, 0xf8, 0x53, 0x0d, 0x8b, 0x68, 0x92, 0x9a,
-	0x5f, 0x72, 0x50, 0xc0, 0xc4, 0xa4, 0xae, 0x85, 0x2e, 0xcb, 0x74, 0x15, 0x9e, 0xee, 0xb9, 0xe7,
-	0xa1, 0x9a, 0xb5, 0xad, 0x59, 0xa8, 0x96, 0x45, 0x1c, 0xd6, 0x21, 0x91, 0xea, 0x22, 0x85, 0xd9,
-	0x7f, 0x46, 0xe1, 0xe7, 0x50, 0x74, 0x5c, 0xca, 0x27, 0x3f, 0xc7, 0xeb, 0x53, 0x97, 0xf6, 0x58,
-	0xc0, 0xe6, 0x5d, 0x16, 0x22, 0xea, 0x40, 0xc1, 0x9e, 0x38, 0x81, 0x2f, 0x6e, 0xce, 0x29, 0xfd,
-	0x11, 0x65, 0x76, 0x19, 0x36, 0xba, 0x81, 0xc2, 0x11, 0x6d, 0x43, 0x91, 0x06, 0x3e, 0x8f, 0xb1,
-	0xc2, 0x63, 0xbc, 0x79, 0x7a, 0x8c, 0x7d, 0x0e, 0x8e, 0x12, 0x91, 0xae, 0xa9, 0xc3, 0x58, 0xf8,
-	0xd7, 0x86, 0x51, 0xf2, 0xf5, 0x1d, 0x14, 0x65, 0x1f, 0x50, 0x1d, 0x8a, 0xd1, 0x95, 0xe7, 0x94,
-	0xdd, 0xc8, 0xe0, 0x48, 0x81, 0xd6, 0x20, 0x7f, 0x6c, 0x78, 0xc7, 0x9c, 0x1c, 0x66, 0xe0, 0x12,
-	0x42, 0x92, 0x61, 0xd6, 0xe8, 0xb2, 0x24, 0x73, 0x1d, 0x0a, 0x63, 0xe2, 0x1f, 0x53, 0x4b, 0x8c,
-	0x29, 0x96, 0x92, 0x38, 0x4e, 0x5f, 0x05, 0x90, 0x7d, 0x66, 0x49, 0xfd, 0x90, 0x85, 0x4a, 0xa2,
-	0x8b, 0xf3, 0x78, 0x4a, 0x22, 0xde, 0x2e, 0x94, 0x5d, 0x0e, 0x89, 0x67, 0xe3, 0x72, 0x7a, 0xe9,
-	0xd5, 0x68, 0x99, 0x48, 0xb4, 0x76, 0x23, 0x83, 0x4b, 0x42, 0xea, 0x5a, 0xf3, 0x0a, 0x72, 0x0b,
-	0x15, 0x5c, 0x85, 0x32, 0xbb, 0x34, 0x83, 0xc4, 0xbd, 0x5a, 0x8b, 0x43, 0xcd, 0x4d, 0x1a, 0x2e,
-	0xb1, 0xef, 0x3d, 0x96, 0x50, 0x07, 0x0a, 0x9e, 0x6f, 0xf8, 0x81, 0x58, 0x8c, 0xff, 0xdf, 0x7a,
-	0xeb, 0x15, 0xe6, 0xa3, 0xcf, 0x1d, 0xb0, 0x74, 0x94, 0xbd, 0x28, 0x41, 0xc1, 0xa3, 0x81, 0x6b,
-	0x12, 0xed, 0x36, 0xac, 0x26, 0x07, 0x81, 0xf5, 0x81, 0xe7, 0x2a, 0xfb, 0xc0, 0x33, 0xfd, 0x64,
-	0x7e, 0x6c, 0x96, 0x1f, 0x7b, 0xca, 0x48, 0x79, 0xc1, 0x28, 0xf5, 0x44, 0xed, 0x5b, 0x58, 0xe1,
-	0x8b, 0x85, 0x2d, 0x87, 0x05, 0xaa, 0x63, 0xa2, 0x3f, 0x80, 0xbc, 0x4b, 0x47, 0x44, 0x1e, 0xf2,
-	0xfa, 0xa9, 0xfb, 0xe9, 0x60, 0xea, 0x10, 0xcc, 0xe1, 0xa8, 0x0e, 0x25, 0xea, 0xb0, 0xc1, 0x32,
-	0x46, 0xbc, 0xc3, 0x25, 0x3c, 0x97, 0xe5, 0xd9, 0x3f, 0xe6, 0xa1, 0x92, 0xd8, 0x28, 0xe8, 0x7b,
-	0x05, 0x56, 0x4d, 0x97, 0x18, 0x3e, 0xb1, 0x06, 0x96, 0xe1, 0x0b, 0xd2, 0x2b, 0x5b, 0xf5, 0x96,
-	0x78, 0xf8, 0x5b, 0xd1, 0xc3, 0xdf, 0x3a, 0x88, 0xfe, 0x0c, 0xf4, 0xeb, 0x6c, 0xec, 0x9f, 0x87,
-	0xea, 0x7a, 0xd2, 0x2f, 0xde, 0x44, 0xb3, 0x50, 0xbd, 0x24, 0x78, 0x4b, 0xb7, 0x6b, 0x0f, 0xfe,
-	0x50, 0x15, 0x5c, 0x91, 0xc6, 0x6d, 0xc3, 0x27, 0xe8, 0x33, 0x80, 0x08, 0x7b, 0x34, 0x15, 0xc3,
-	0x9d, 0x7c, 0x97, 0x62, 0x5b, 0x72, 0xdf, 0x95, 0xa5, 0x5a, 0x9f, 0xf2, 0x22, 0x02, 0xc7, 0x8a,
-	0x8b, 0xc8, 0xbd, 0x7a, 0x11, 0x49, 0xbf, 0xb4, 0x22, 0xd2, 0xed, 0xb2, 0x08, 0x69, 0x8c, 0x8a,
-	0x88, 0xb0, 0x47, 0x53, 0x39, 0xc4, 0x89, 0x22, 0x62, 0xdb, 0x42, 0x11, 0x52, 0xad, 0x4f, 0xd1,
-	0x87, 0x50, 0x3c, 0x21, 0x2e, 0x5b, 0x9f, 0x7c, 0xa2, 0xff, 0xa7, 0x5f, 0x9c, 0x85, 0x6a, 0x4d,
-	0x3e, 0xf5, 0xc2, 0x90, 0xf4, 0x8c, 0xc0, 0xcc, 0x6f, 0x4c, 0x3c, 0xcf, 0x18, 0x12, 0xbe, 0x96,
-	0xca, 0x49, 0x3f, 0x69, 0x58, 0xf0, 0x93, 0xba, 0xcd, 0x9f, 0x15, 0x38, 0xfb, 0xd2, 0xdd, 0x40,
-	0xef, 0x82, 0x8a, 0x77, 0xae, 0xef, 0xe3, 0xed, 0x41, 0x77, 0xaf, 0x77, 0x78, 0x30, 0xe8, 0x1f,
-	0x74, 0x0e, 0x0e, 0xfb, 0x83, 0xc3, 0xbd, 0x7e, 0x6f, 0xe7, 0x7a, 0x77, 0xb7, 0xbb, 0xb3, 0x5d,
-	0xcd, 0xd4, 0x2b, 0xf7, 0x1f, 0x35, 0x8b, 0x87, 0x93, 0x3b, 0x13, 0x7a, 0x77, 0x82, 0x5a, 0x70,
-	0x31, 0xcd, 0xa3, 0x87, 0xf7, 0x7b, 0xfb, 0xfd, 0x9d, 0xed, 0xaa, 0x52, 0x5f, 0xbd, 0xff, 0xa8,
-	0x59, 0xea, 0xb9, 0xd4, 0xa1, 0x1e, 0xb1, 0xd0, 0x26, 0xd4, 0xd3, 0xf0, 0x42, 0x57, 0xcd, 0xd6,
-	0xe1, 0xfe, 0xa3, 0xa6, 0x7c, 0xbb, 0x36, 0x03, 0x76, 0x23, 0xe3, 0x7b, 0x84, 0x2e, 0xc1, 0x05,
-	0xbc, 0xd3, 0x3f, 0xbc, 0x95, 0x9e, 0x17, 0x5a, 0x07, 0xb4, 0x68, 0xee, 0x75, 0xfa, 0xfd, 0xaa,
-	0xf2, 0xb2, 0xbe, 0x7f, 0xb3, 0xdb, 0xab, 0x66, 0x5f, 0xd6, 0xef, 0x76, 0xba, 0xb7, 0xaa, 0x39,
-	0xfd, 0xce, 0xe3, 0xa7, 0x0d, 0xe5, 0xc9, 0xd3, 0x86, 0xf2, 0xe7, 0xd3, 0x86, 0xf2, 0xe0, 0x59,
-	0x23, 0xf3, 0xe4, 0x59, 0x23, 0xf3, 0xdb, 0xb3, 0x46, 0x06, 0x2e, 0xd8, 0x74, 0xc9, 0x5d, 0xec,
-	0x29, 0x5f, 0xbd, 0x3f, 0xb4, 0xfd, 0xe3, 0xe0, 0xa8, 0x65, 0xd2, 0x71, 0x3b, 0x06, 0xbd, 0x63,
-	0xd3, 0x84, 0xd4, 0xbe, 0x17, 0xff, 0x25, 0xb3, 0x5d, 0xe6, 0x1d, 0x15, 0xf8, 0x74, 0xbe, 0xf7,
-	0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7d, 0x59, 0x3f, 0x99, 0xde, 0x0b, 0x00, 0x00,
+	// 1557 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6f, 0xdb, 0x46,
+	0x16, 0x17, 0x25, 0xea, 0xeb, 0x49, 0xd9, 0x28, 0x13, 0xc3, 0x51, 0xb4, 0x89, 0xa8, 0xe5, 0x2e,
+	0x10, 0xaf, 0x37, 0x91, 0x62, 0xef, 0x27, 0xb2, 0x69, 0x0b, 0xc9, 0x1f, 0x88, 0x90, 0xd4, 0x56,
+	0x29, 0xab, 0x87, 0x02, 0x85, 0x40, 0x93, 0x13, 0x99, 0xb5, 0xa4, 0x61, 0xc9, 0x91, 0x13, 0xb5,
+	0xb7, 0xa2, 0x45, 0x81, 0x9c, 0x72, 0x2a, 0x72, 0x09, 0xd0, 0x5e, 0xfb, 0x1f, 0xf4, 0x3f, 0xc8,
+	0xa1, 0x28, 0x72, 0x2c, 0x7a, 0x50, 0x8a, 0xe4, 0x96, 0xa3, 0xd0, 0x3f, 0xa0, 0x98, 0x0f, 0x4a,
+	0x54, 0x2c, 0xbb, 0x4e, 0xe3, 0x9e, 0x38, 0xef, 0xcd, 0xfb, 0x7e, 0xbf, 0x79, 0x33, 0x04, 0xdd,
+	0xf5, 0xc8, 0x01, 0xee, 0x9b, 0x7d, 0x0b, 0x57, 0x7a, 0x98, 0x9a, 0xb6, 0x49, 0xcd, 0xca, 0xc1,
+	0x4a, 0xc5, 0xb7, 0x88, 0x8b, 0xcb, 0xae, 0x47, 0x28, 0x41, 0x8b, 0x53, 0x99, 0x72, 0x20, 0x53,
+	0x3e, 0x58, 0x29, 0x14, 0x2d, 0xe2, 0xf7, 0x88, 0x5f, 0xd9, 0x35, 0x7d, 0x5c, 0x39, 0x58, 0xd9,
+	0xc5, 0xd4, 0x5c, 0xa9, 0x58, 0xc4, 0xe9, 0x0b, 0xbd, 0xc2, 0x42, 0x87, 0x74, 0x08, 0x5f, 0x56,
+	0xd8, 0x4a, 0x72, 0xb5, 0x0e, 0x21, 0x9d, 0x2e, 0xae, 0x70, 0x6a, 0x77, 0x70, 0xb7, 0x42, 0x9d,
+	0x1e, 0xf6, 0xa9, 0xd9, 0x73, 0xa5, 0x40, 0xe9, 0x55, 0x01, 0x1b, 0xfb, 0x96, 0xe7, 0xb8, 0x94,
+	0x78, 0x52, 0x62, 0xf9, 0xa8, 0xa0, 0x5d, 0x6c, 0x39, 0x77, 0x1d, 0xcb, 0xa4, 0x0e, 0x91, 0x41,
+	0xe8, 0xdf, 0xc7, 0x20, 0xde, 0x64, 0xc9, 0xa0, 0x0d, 0x48, 0xf1, 0xac, 0xda, 0x8e, 0x9d, 0x57,
+	0x4a, 0xca, 0x52, 0xb6, 0xb6, 0xfc, 0x64, 0xa4, 0x45, 0x7e, 0x1a, 0x69, 0x67, 0xdf, 0x95, 0x46,
+	0xaa, 0xb6, 0xed, 0x61, 0xdf, 0x1f, 0x8f, 0xb4, 0xb3, 0x43, 0xb3, 0xd7, 0xbd, 0xa1, 0x07, 0x0a,
+	0xba, 0x91, 0xe4, 0xcb, 0xba, 0x8d, 0x3e, 0x84, 0xdc, 0x8c, 0x1f, 0x66, 0x2e, 0xca, 0xcd, 0xad,
+	0x1e, 0x6d, 0xee, 0x82, 0x34, 0xf7, 0x8a, 0xa2, 0x6e, 0x9c, 0x9d, 0x61, 0xd5, 0x6d, 0xf4, 0x7f,
+	0x48, 0x90, 0x7b, 0x7d, 0xec, 0xf9, 0xf9, 0x58, 0x29, 0xb6, 0x94, 0x59, 0xbd, 0x5c, 0x9e, 0x5f,
+	0xfd, 0x72, 0xc3, 0xf4, 0xe8, 0xb0, 0xa6, 0x32, 0x9f, 0x86, 0x54, 0x41, 0xff, 0x85, 0x0c, 0xdb,
+	0x6e, 0x9b, 0x96, 0x85, 0x7d, 0x3f, 0xaf, 0x96, 0x62, 0x4b, 0xe9, 0xda, 0xe2, 0x78, 0xa4, 0x21,
+	0xe1, 0x3f, 0xb4, 0xa9, 0x1b, 0xc0, 0x43, 0xe4, 0x04, 0xda, 0x82, 0xf3, 0x07, 0x66, 0x77, 0x80,
+	0xdb, 0xdc, 0x50, 0xdb, 0x14, 0x81, 0xe7, 0xe3, 0x25, 0x65, 0x29, 0x5d, 0x2b, 0x8e, 0x47, 0x5a,
+	0x41, 0x18, 0x98, 0x23, 0xa4, 0x1b, 0xe7, 0x38, 0x77, 0x9b, 0x31, 0x65, 0xc6, 0xe8, 0x3d, 0x58,
+	0xf0, 0xf0, 0xc7, 0x03, 0xc7, 0xc3, 0x6d, 0x97, 0xc5, 0xd9, 0xf6, 0x48, 0xb7, 0x3b, 0x70, 0xf3,
+	0x89, 0x92, 0xb2, 0x94, 0xaa, 0x69, 0xe3, 0x91, 0xf6, 0x67, 0x61, 0x70, 0x9e, 0x94, 0x6e, 0x20,
+	0xc9, 0xe6, 0x39, 0x1a, 0x9c, 0x79, 0x43, 0x7d, 0xf4, 0xb5, 0x16, 0xd1, 0x1f, 0xc5, 0x20, 0xd9,
+	0xc4, 0xbe, 0xef, 0x90, 0x3e, 0xba, 0x0d, 0xe0, 0x8b, 0xe5, 0xb4, 0xa5, 0x57, 0x8f, 0xee, 0xc1,
+	0x39, 0xd9, 0x83, 0x89, 0x8a, 0x6e, 0xa4, 0x25, 0xf1, 0xc7, 0xb7, 0xf5, 0x2d, 0x48, 0xb2, 0x14,
+	0x1d, 0xfc, 0x5a, 0x7d, 0x0d, 0x74, 0xd0, 0x3f, 0x40, 0xed, 0x9b, 0x3d, 0x9c, 0x57, 0x79, 0x43,
+	0x2e, 0xbc, 0x1c, 0x69, 0x2a, 0x1d, 0xba, 0x78, 0x3c, 0xd2, 0x32, 0x22, 0x04, 0x46, 0xe9, 0x06,
+	0x17, 0x42, 0x79, 0x48, 0x5a, 0xa4, 0x4f, 0xf1, 0x7d, 0xca, 0x1b, 0x98, 0x35, 0x02, 0x12, 0xb5,
+	0x20, 0x6e, 0x0e, 0x6c, 0x87, 0xe6, 0xad, 0x92, 0xb2, 0x94, 0x59, 0xfd, 0xeb, 0x51, 0x31, 0x54,
+	0x99, 0xd0, 0xa6, 0x83, 0xbb, 0xb6, 0x5f, 0x2b, 0x8c, 0x47, 0xda, 0xa2, 0x70, 0xc2, 0x75, 0xaf,
+	0x92, 0x9e, 0x43, 0x71, 0xcf, 0xa5, 0x43, 0xdd, 0x10, 0xd6, 0x64, 0x6b, 0x3e, 0x57, 0x21, 0x61,
+	0x60, 0x8b, 0x78, 0x36, 0xba, 0x22, 0xc3, 0x55, 0x78, 0xb8, 0xe7, 0x5f, 0x8e, 0xb4, 0xa8, 0x63,
+	0x8f, 0x47, 0x5a, 0x5a, 0xd8, 0x61, 0x15, 0x12, 0xa1, 0xce, 0xb6, 0x30, 0xfa, 0x66, 0x2d, 0x7c,
+	0x07, 0x92, 0xae, 0x47, 0x38, 0xf2, 0x63, 0x3c, 0x3f, 0xed, 0xc8, 0x1a, 0x0b, 0xb1, 0x49, 0x95,
+	0x05, 0x89, 0xaa, 0x90, 0x70, 0xfa, 0xee, 0x80, 0x8a, 0x93, 0x73, 0x4c, 0x7d, 0x44, 0x9a, 0x75,
+	0x26, 0x1b, 0x9c, 0x40, 0xa1, 0x88, 0xd6, 0x21, 0x49, 0x06, 0x94, 0xdb, 0x88, 0x73, 0x1b, 0x7f,
+	0x3b, 0xde, 0xc6, 0xf6, 0x80, 0x4e, 0x8d, 0x04, 0xaa, 0x73, 0xc1, 0x98, 0x38, 0x3d, 0x30, 0xb6,
+	0x20, 0xce, 0x8f, 0x6c, 0x3e, 0x79, 0x3c, 0x0c, 0x44, 0x88, 0xef, 0x33, 0xd1, 0x30, 0x0c, 0xb8,
+	0xee, 0x0c, 0x0c, 0x38, 0x47, 0xc2, 0x60, 0x07, 0x32, 0x21, 0x3d, 0xb4, 0x02, 0x69, 0x86, 0xcd,
+	0x76, 0x08, 0x0f, 0x0b, 0xe3, 0x91, 0x96, 0x9b, 0xc2, 0x96, 0x6f, 0xe9, 0x46, 0x8a, 0xad, 0xb7,
+	0x18, 0x28, 0x10, 0xa8, 0x1f, 0xf9, 0xa4, 0xcf, 0xe1, 0x90, 0x36, 0xf8, 0x5a, 0xff, 0x14, 0x92,
+	0xb2, 0x69, 0xa8, 0x00, 0xc9, 0x60, 0x3e, 0x71, 0x7b, 0xb7, 0x22, 0x46, 0xc0, 0x40, 0x0b, 0xa0,
+	0xee, 0x99, 0xfe, 0x9e, 0x50, 0xbd, 0x15, 0x31, 0x38, 0xc5, 0x0c, 0x72, 0xf7, 0x31, 0x61, 0x90,
+	0xad, 0xd1, 0x22, 0x24, 0x7a, 0x98, 0xee, 0x11, 0x5b, 0x9c, 0x29, 0x43, 0x52, 0x22, 0x89, 0x5a,
+	0x16, 0x40, 0x82, 0x82, 0x55, 0xf0, 0x8b, 0x28, 0x64, 0x42, 0x2d, 0x9f, 0xd8, 0x53, 0x42, 0xf6,
+	0x36, 0x21, 0xed, 0x71, 0x91, 0x29, 0x90, 0xaf, 0xcc, 0xef, 0x53, 0x2e, 0x98, 0x7c, 0x52, 0x5a,
+	0xbf, 0x15, 0x31, 0x52, 0x82, 0xaa, 0xdb, 0x93, 0x0c, 0x62, 0x33, 0x19, 0xcc, 0x54, 0x51, 0x3d,
+	0x51, 0x15, 0xab, 0x90, 0xf0, 0xa9, 0x49, 0x07, 0x62, 0x8a, 0xff, 0x69, 0xf5, 0xef, 0x27, 0x00,
+	0x73, 0x93, 0x2b, 0x18, 0x52, 0x51, 0xd6, 0x22, 0x05, 0x09, 0x9f, 0x0c, 0x3c, 0x0b, 0xeb, 0x77,
+	0x21, 0x1b, 0x46, 0x2d, 0xab, 0x03, 0x8f, 0x55, 0xd6, 0x81, 0x47, 0x7a, 0x73, 0xe2, 0x36, 0xca,
+	0xdd, 0x1e, 0x83, 0x7f, 0x7f, 0xd0, 0x9d, 0xeb, 0x51, 0xff, 0x04, 0xe2, 0x7c, 0x0a, 0xb2, 0x49,
+	0x36, 0xd3, 0xea, 0x69, 0xa3, 0xff, 0x0d, 0xaa, 0x47, 0xba, 0x58, 0x3a, 0xf9, 0xcb, 0xb1, 0xc3,
+	0x74, 0x67, 0xe8, 0x62, 0x83, 0x8b, 0xa3, 0x02, 0xa4, 0x88, 0xcb, 0x4e, 0x81, 0xd9, 0xe5, 0x15,
+	0x4e, 0x19, 0x13, 0x5a, 0xfa, 0xfe, 0x52, 0x85, 0x4c, 0x68, 0xfc, 0xa1, 0xcf, 0x14, 0xc8, 0x5a,
+	0x1e, 0x36, 0x29, 0xb6, 0xdb, 0xb6, 0x49, 0x45, 0xd3, 0x33, 0xab, 0x85, 0xb2, 0x78, 0xa5, 0x94,
+	0x83, 0x57, 0x4a, 0x79, 0x27, 0x78, 0xc6, 0xd4, 0xd6, 0xd8, 0x19, 0x7d, 0x39, 0xd2, 0x16, 0xc3,
+	0x7a, 0xd3, 0xf3, 0x32, 0x1e, 0x69, 0x97, 0x45, 0xdf, 0xe6, 0xef, 0xeb, 0x0f, 0x9f, 0x69, 0x8a,
+	0x91, 0x91, 0x9b, 0xeb, 0x26, 0xc5, 0xe8, 0x6d, 0x80, 0x40, 0x76, 0x77, 0x28, 0xc0, 0x1d, 0xbe,
+	0x44, 0xa7, 0x7b, 0xe1, 0x53, 0x99, 0x96, 0xec, 0xda, 0x90, 0x27, 0x31, 0x70, 0xed, 0x69, 0x12,
+	0xb1, 0x93, 0x27, 0x11, 0xd6, 0x9b, 0x97, 0xc4, 0xfc, 0x7d, 0x99, 0x84, 0xdc, 0x0c, 0x92, 0x08,
+	0x64, 0x77, 0x87, 0x79, 0xf5, 0xd5, 0x24, 0xa6, 0x7b, 0x33, 0x49, 0x48, 0x76, 0x6d, 0x88, 0xfe,
+	0x03, 0xc9, 0x03, 0xec, 0xb1, 0x59, 0xcf, 0x11, 0x7d, 0xa6, 0x76, 0x69, 0x3c, 0xd2, 0xf2, 0x72,
+	0x24, 0x89, 0x8d, 0xb0, 0x66, 0x20, 0xcc, 0xf4, 0x7a, 0xd8, 0xf7, 0xcd, 0x0e, 0xe6, 0x33, 0x34,
+	0x1d, 0xd6, 0x93, 0x1b, 0x33, 0x7a, 0x92, 0xa7, 0x7f, 0x15, 0x85, 0x2c, 0x7f, 0x39, 0xde, 0x71,
+	0x7c, 0xea, 0xf4, 0x3b, 0xa7, 0xf5, 0x80, 0x5c, 0x84, 0x84, 0x8f, 0xbb, 0x5d, 0xec, 0xc9, 0x01,
+	0x27, 0x29, 0x64, 0x42, 0xdc, 0xf5, 0x1c, 0x0b, 0xcb, 0x07, 0xc2, 0xc5, 0xb2, 0x78, 0x5e, 0x97,
+	0xd9, 0xf3, 0xba, 0x2c, 0x9f, 0xd7, 0xe5, 0x35, 0xe2, 0xf4, 0x6b, 0xd7, 0x99, 0xdb, 0x6f, 0x9f,
+	0x69, 0x4b, 0x1d, 0x87, 0xee, 0x0d, 0x76, 0xcb, 0x16, 0xe9, 0x55, 0xe4, 0x5b, 0x5c, 0x7c, 0xae,
+	0xf9, 0xf6, 0x7e, 0x85, 0x4d, 0x02, 0x9f, 0x2b, 0xf8, 0x86, 0xb0, 0x8c, 0xd6, 0x01, 0xf0, 0x7d,
+	0xd7, 0xf1, 0xf8, 0x45, 0x90, 0x57, 0x7f, 0x13, 0x04, 0x29, 0xe6, 0x88, 0x77, 0x32, 0xa4, 0xa7,
+	0xff, 0xa2, 0x40, 0x5a, 0x14, 0x86, 0x58, 0xfb, 0xa7, 0x55, 0x95, 0x15, 0x48, 0x77, 0x89, 0xb5,
+	0x1f, 0x46, 0x78, 0x68, 0xc2, 0x4d, 0xb6, 0x74, 0x23, 0x25, 0xd6, 0xb5, 0x21, 0x2b, 0xa4, 0x87,
+	0x4d, 0x76, 0x53, 0x88, 0xc1, 0x2e, 0x29, 0xd4, 0x9a, 0x98, 0x32, 0xe9, 0x09, 0x92, 0xbc, 0xc4,
+	0xc2, 0x3d, 0xe4, 0xca, 0xa4, 0x02, 0xc2, 0xd2, 0x5d, 0x95, 0xea, 0xdf, 0x29, 0x70, 0x46, 0xde,
+	0x6c, 0x12, 0x59, 0x37, 0x59, 0x00, 0x8c, 0x21, 0x87, 0x42, 0xf1, 0xf8, 0x11, 0x1b, 0x3c, 0x15,
+	0x84, 0x0e, 0x0b, 0x7f, 0x0f, 0x3b, 0x9d, 0x3d, 0xca, 0xd3, 0x8d, 0x19, 0x92, 0x42, 0xff, 0x03,
+	0x95, 0xfd, 0x12, 0xe5, 0x63, 0xaf, 0xd1, 0x1e, 0xae, 0xc1, 0xc6, 0xa5, 0x8d, 0xbb, 0x98, 0x62,
+	0x71, 0xa9, 0xa5, 0x8c, 0x80, 0xd4, 0x7f, 0x08, 0xb0, 0x1c, 0x84, 0x7e, 0x4a, 0x5d, 0x9b, 0xfe,
+	0xad, 0x44, 0x5f, 0xff, 0x6f, 0xe5, 0x88, 0x9f, 0x8e, 0xd8, 0xef, 0xfd, 0xe9, 0x98, 0x16, 0x54,
+	0x9d, 0x5b, 0xd0, 0xf8, 0x9b, 0x14, 0x34, 0x31, 0x53, 0xd0, 0xe5, 0x6f, 0x14, 0x38, 0x77, 0xe8,
+	0xe2, 0x44, 0xd7, 0x41, 0x33, 0x36, 0xd6, 0xb6, 0x8d, 0xf5, 0x76, 0x7d, 0xab, 0xd1, 0xda, 0x69,
+	0x37, 0x77, 0xaa, 0x3b, 0xad, 0x66, 0xbb, 0xb5, 0xd5, 0x6c, 0x6c, 0xac, 0xd5, 0x37, 0xeb, 0x1b,
+	0xeb, 0xb9, 0x48, 0x21, 0xf3, 0xe0, 0x71, 0x29, 0xd9, 0xea, 0xef, 0xf7, 0xc9, 0xbd, 0x3e, 0x2a,
+	0xc3, 0xa5, 0x79, 0x1a, 0x0d, 0x63, 0xbb, 0xb1, 0xdd, 0xdc, 0x58, 0xcf, 0x29, 0x85, 0xec, 0x83,
+	0xc7, 0xa5, 0x54, 0xc3, 0x23, 0x2e, 0xf1, 0xb1, 0x8d, 0x96, 0xa1, 0x30, 0x4f, 0x5e, 0xf0, 0x72,
+	0xd1, 0x02, 0x3c, 0x78, 0x5c, 0x92, 0xaf, 0xf0, 0xe5, 0x01, 0x64, 0xc3, 0x97, 0x2c, 0xba, 0x0c,
+	0x17, 0x8d, 0x8d, 0x66, 0xeb, 0xce, 0xfc, 0xb8, 0xd0, 0x22, 0xa0, 0xd9, 0xed, 0x46, 0xb5, 0xd9,
+	0xcc, 0x29, 0x87, 0xf9, 0xcd, 0xdb, 0xf5, 0x46, 0x2e, 0x7a, 0x98, 0xbf, 0x59, 0xad, 0xdf, 0xc9,
+	0xc5, 0x6a, 0xfb, 0x4f, 0x9e, 0x17, 0x95, 0xa7, 0xcf, 0x8b, 0xca, 0xcf, 0xcf, 0x8b, 0xca, 0xc3,
+	0x17, 0xc5, 0xc8, 0xd3, 0x17, 0xc5, 0xc8, 0x8f, 0x2f, 0x8a, 0x11, 0xb8, 0xe8, 0x90, 0x23, 0xf0,
+	0xd1, 0x50, 0x3e, 0xf8, 0x57, 0x68, 0x98, 0x4d, 0x85, 0xae, 0x39, 0x24, 0x44, 0x55, 0xee, 0x4f,
+	0xff, 0xf7, 0xf9, 0x78, 0xdb, 0x4d, 0xf0, 0x2e, 0xfe, 0xf3, 0xd7, 0x01, 0x00, 0xdd, 0xcb, 0x94,
+	0x08, 0xc8, 0x10, 0x00, 0x00,
 }
 
 func (m *Scope) Marshal() (dAtA []byte, err error) {
@@ -1035,6 +1419,18 @@ func (m *Record) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintScope(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	{
 		size := m.SpecificationId.Size()
 		i -= size
@@ -1103,6 +1499,43 @@ func (m *Record) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RecordValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Json) > 0 {
+		i -= len(m.Json)
+		copy(dAtA[i:], m.Json)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.Json)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TypeName) > 0 {
+		i -= len(m.TypeName)
+		copy(dAtA[i:], m.TypeName)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.TypeName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Process) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1380,12 +1813,12 @@ func (m *AuditFields) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedDate):])
-	if err3 != nil {
-		return 0, err3
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedDate):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n3
-	i = encodeVarintScope(dAtA, i, uint64(n3))
+	i -= n4
+	i = encodeVarintScope(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x1a
 	if len(m.CreatedBy) > 0 {
@@ -1395,22 +1828,272 @@ func (m *AuditFields) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedDate):])
-	if err4 != nil {
-		return 0, err4
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedDate):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n4
-	i = encodeVarintScope(dAtA, i, uint64(n4))
+	i -= n5
+	i = encodeVarintScope(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintScope(dAtA []byte, offset int, v uint64) int {
-	offset -= sovScope(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
+func (m *ScopeListing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeListing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeListing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintScope(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Price) > 0 {
+		for iNdEx := len(m.Price) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Price[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintScope(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.ScopeId.Size()
+		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintScope(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopeLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LockedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LockedAt):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintScope(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.LockedBy) > 0 {
+		i -= len(m.LockedBy)
+		copy(dAtA[i:], m.LockedBy)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.LockedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.ScopeId.Size()
+		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintScope(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deleted {
+		i--
+		if m.Deleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintScope(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintScope(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopeVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deleted {
+		i--
+		if m.Deleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintScope(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ValueOwnerAddress) > 0 {
+		i -= len(m.ValueOwnerAddress)
+		copy(dAtA[i:], m.ValueOwnerAddress)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.ValueOwnerAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owners) > 0 {
+		for iNdEx := len(m.Owners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Owners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintScope(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.ScopeId.Size()
+		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintScope(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintScope(dAtA []byte, offset int, v uint64) int {
+	offset -= sovScope(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
 		v >>= 7
 		offset++
 	}
@@ -1508,6 +2191,27 @@ func (m *Record) Size() (n int) {
 	}
 	l = m.SpecificationId.Size()
 	n += 1 + l + sovScope(uint64(l))
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovScope(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeName)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	l = len(m.Json)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
 	return n
 }
 
@@ -1626,44 +2330,1199 @@ func (m *Party) Size() (n int) {
 	if m.Optional {
 		n += 2
 	}
-	return n
-}
+	return n
+}
+
+func (m *AuditFields) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedDate)
+	n += 1 + l + sovScope(uint64(l))
+	l = len(m.CreatedBy)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedDate)
+	n += 1 + l + sovScope(uint64(l))
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovScope(uint64(m.Version))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopeListing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovScope(uint64(l))
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	if len(m.Price) > 0 {
+		for _, e := range m.Price {
+			l = e.Size()
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration)
+	n += 1 + l + sovScope(uint64(l))
+	return n
+}
+
+func (m *ScopeLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovScope(uint64(l))
+	l = len(m.LockedBy)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LockedAt)
+	n += 1 + l + sovScope(uint64(l))
+	return n
+}
+
+func (m *RecordVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Record.Size()
+	n += 1 + l + sovScope(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovScope(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovScope(uint64(l))
+	if m.Deleted {
+		n += 2
+	}
+	return n
+}
+
+func (m *ScopeVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovScope(uint64(l))
+	if len(m.Owners) > 0 {
+		for _, e := range m.Owners {
+			l = e.Size()
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	l = len(m.ValueOwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovScope(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovScope(uint64(l))
+	if m.Deleted {
+		n += 2
+	}
+	return n
+}
+
+func sovScope(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozScope(x uint64) (n int) {
+	return sovScope(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Scope) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Scope: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Scope: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owners = append(m.Owners, Party{})
+			if err := m.Owners[len(m.Owners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataAccess", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataAccess = append(m.DataAccess, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueOwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueOwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequirePartyRollup", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequirePartyRollup = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Session) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Session: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Session: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SessionId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parties = append(m.Parties, Party{})
+			if err := m.Parties[len(m.Parties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Context = append(m.Context[:0], dAtA[iNdEx:postIndex]...)
+			if m.Context == nil {
+				m.Context = []byte{}
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Audit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Audit == nil {
+				m.Audit = &AuditFields{}
+			}
+			if err := m.Audit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Record) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Record: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Record: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SessionId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Process", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Process.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Inputs = append(m.Inputs, RecordInput{})
+			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outputs = append(m.Outputs, RecordOutput{})
+			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Value == nil {
+				m.Value = &RecordValue{}
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Json", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Json = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Process) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Process: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Process: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessId = &Process_Address{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessId = &Process_Hash{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *AuditFields) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedDate)
-	n += 1 + l + sovScope(uint64(l))
-	l = len(m.CreatedBy)
-	if l > 0 {
-		n += 1 + l + sovScope(uint64(l))
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedDate)
-	n += 1 + l + sovScope(uint64(l))
-	l = len(m.UpdatedBy)
-	if l > 0 {
-		n += 1 + l + sovScope(uint64(l))
-	}
-	if m.Version != 0 {
-		n += 1 + sovScope(uint64(m.Version))
-	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovScope(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovScope(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozScope(x uint64) (n int) {
-	return sovScope(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Scope) Unmarshal(dAtA []byte) error {
+func (m *RecordInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1686,17 +3545,17 @@ func (m *Scope) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Scope: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Scope: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -1706,28 +3565,27 @@ func (m *Scope) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthScope
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthScope
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1754,47 +3612,16 @@ func (m *Scope) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var vv MetadataAddress
+			v := &vv
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Source = &RecordInput_RecordId{*v}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowScope
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthScope
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthScope
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Owners = append(m.Owners, Party{})
-			if err := m.Owners[len(m.Owners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataAccess", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1822,11 +3649,11 @@ func (m *Scope) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataAccess = append(m.DataAccess, string(dAtA[iNdEx:postIndex]))
+			m.Source = &RecordInput_Hash{string(dAtA[iNdEx:postIndex])}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueOwnerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1854,13 +3681,13 @@ func (m *Scope) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValueOwnerAddress = string(dAtA[iNdEx:postIndex])
+			m.TypeName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequirePartyRollup", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var v int
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -1870,12 +3697,11 @@ func (m *Scope) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Status |= RecordInputStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RequirePartyRollup = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipScope(dAtA[iNdEx:])
@@ -1897,7 +3723,7 @@ func (m *Scope) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Session) Unmarshal(dAtA []byte) error {
+func (m *RecordOutput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1920,17 +3746,17 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Session: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordOutput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Session: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordOutput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -1940,30 +3766,29 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthScope
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthScope
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SessionId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var byteLen int
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -1973,62 +3798,64 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Status |= ResultStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthScope
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthScope
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parties", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowScope
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthScope
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthScope
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Party) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parties = append(m.Parties, Party{})
-			if err := m.Parties[len(m.Parties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Party: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Party: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2056,13 +3883,13 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-			var byteLen int
+			m.Role = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -2072,31 +3899,16 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Role |= PartyType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthScope
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthScope
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Context = append(m.Context[:0], dAtA[iNdEx:postIndex]...)
-			if m.Context == nil {
-				m.Context = []byte{}
-			}
-			iNdEx = postIndex
-		case 99:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Audit", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Optional", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -2106,28 +3918,12 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthScope
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthScope
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Audit == nil {
-				m.Audit = &AuditFields{}
-			}
-			if err := m.Audit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Optional = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipScope(dAtA[iNdEx:])
@@ -2149,7 +3945,7 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Record) Unmarshal(dAtA []byte) error {
+func (m *AuditFields) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2172,17 +3968,17 @@ func (m *Record) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Record: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuditFields: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Record: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuditFields: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedDate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -2192,29 +3988,30 @@ func (m *Record) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthScope
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthScope
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBy", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -2224,28 +4021,27 @@ func (m *Record) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthScope
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthScope
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SessionId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CreatedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Process", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedDate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2272,15 +4068,15 @@ func (m *Record) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Process.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdatedDate, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -2290,31 +4086,29 @@ func (m *Record) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthScope
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthScope
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Inputs = append(m.Inputs, RecordInput{})
-			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -2324,31 +4118,16 @@ func (m *Record) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Version |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthScope
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthScope
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Outputs = append(m.Outputs, RecordOutput{})
-			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -2358,24 +4137,23 @@ func (m *Record) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthScope
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthScope
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2398,7 +4176,7 @@ func (m *Record) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Process) Unmarshal(dAtA []byte) error {
+func (m *ScopeListing) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2421,17 +4199,17 @@ func (m *Process) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Process: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeListing: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Process: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeListing: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -2441,27 +4219,28 @@ func (m *Process) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthScope
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthScope
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProcessId = &Process_Address{string(dAtA[iNdEx:postIndex])}
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2489,13 +4268,13 @@ func (m *Process) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProcessId = &Process_Hash{string(dAtA[iNdEx:postIndex])}
+			m.Seller = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -2505,29 +4284,31 @@ func (m *Process) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthScope
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthScope
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Price = append(m.Price, types.Coin{})
+			if err := m.Price[len(m.Price)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -2537,23 +4318,24 @@ func (m *Process) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthScope
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthScope
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Method = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2576,7 +4358,7 @@ func (m *Process) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordInput) Unmarshal(dAtA []byte) error {
+func (m *ScopeLock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2590,56 +4372,24 @@ func (m *RecordInput) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RecordInput: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordInput: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowScope
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthScope
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthScope
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopeLock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopeLock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2666,16 +4416,13 @@ func (m *RecordInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var vv MetadataAddress
-			v := &vv
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Source = &RecordInput_RecordId{*v}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2703,11 +4450,11 @@ func (m *RecordInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = &RecordInput_Hash{string(dAtA[iNdEx:postIndex])}
+			m.LockedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TypeName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2735,13 +4482,13 @@ func (m *RecordInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TypeName = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedAt", wireType)
 			}
-			m.Status = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -2751,11 +4498,25 @@ func (m *RecordInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= RecordInputStatus(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LockedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipScope(dAtA[iNdEx:])
@@ -2777,7 +4538,7 @@ func (m *RecordInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordOutput) Unmarshal(dAtA []byte) error {
+func (m *RecordVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2800,17 +4561,17 @@ func (m *RecordOutput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordOutput: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordVersion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -2820,29 +4581,30 @@ func (m *RecordOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthScope
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthScope
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.Status = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -2852,66 +4614,16 @@ func (m *RecordOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= ResultStatus(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipScope(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthScope
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Party) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowScope
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Party: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Party: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -2921,46 +4633,28 @@ func (m *Party) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthScope
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthScope
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
-			}
-			m.Role = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowScope
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Role |= PartyType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 3:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Optional", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -2977,7 +4671,7 @@ func (m *Party) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Optional = bool(v != 0)
+			m.Deleted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipScope(dAtA[iNdEx:])
@@ -2999,7 +4693,7 @@ func (m *Party) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AuditFields) Unmarshal(dAtA []byte) error {
+func (m *ScopeVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3022,17 +4716,17 @@ func (m *AuditFields) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AuditFields: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeVersion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuditFields: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -3042,60 +4736,28 @@ func (m *AuditFields) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthScope
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthScope
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedDate, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBy", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowScope
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthScope
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthScope
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CreatedBy = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3122,13 +4784,14 @@ func (m *AuditFields) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdatedDate, dAtA[iNdEx:postIndex]); err != nil {
+			m.Owners = append(m.Owners, Party{})
+			if err := m.Owners[len(m.Owners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueOwnerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3156,13 +4819,13 @@ func (m *AuditFields) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			m.ValueOwnerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.Version = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -3172,16 +4835,16 @@ func (m *AuditFields) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint32(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowScope
@@ -3191,24 +4854,45 @@ func (m *AuditFields) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthScope
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthScope
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deleted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipScope(dAtA[iNdEx:])
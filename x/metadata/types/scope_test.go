@@ -672,6 +672,39 @@ func (s *ScopeTestSuite) TestRecordValidateBasic() {
 	}
 }
 
+func (s *ScopeTestSuite) TestRecordGetHashes() {
+	sessionID := SessionMetadataAddress(uuid.New(), uuid.New())
+	ps := NewProcess("process_name", &Process_Hash{"address"}, "method")
+	hashInput := NewRecordInput("hash_input", &RecordInput_Hash{"input_hash"}, "ri_type", RecordInputStatus_Proposed)
+	dupInput := NewRecordInput("dup_input", &RecordInput_Hash{"output_hash"}, "ri_type", RecordInputStatus_Proposed)
+	recordInput := NewRecordInput("record_input", &RecordInput_RecordId{RecordMetadataAddress(uuid.New(), "other")}, "ri_type", RecordInputStatus_Record)
+	tests := []struct {
+		name   string
+		record *Record
+		exp    []string
+	}{
+		{
+			name:   "no inputs or outputs",
+			record: NewRecord("name", sessionID, *ps, nil, nil, nil),
+			exp:    nil,
+		},
+		{
+			name: "outputs and inputs",
+			record: NewRecord("name", sessionID, *ps,
+				[]RecordInput{*hashInput, *recordInput, *dupInput},
+				[]RecordOutput{*NewRecordOutput("output_hash", ResultStatus_RESULT_STATUS_PASS), *NewRecordOutput("", ResultStatus_RESULT_STATUS_SKIP)},
+				nil),
+			exp: []string{"output_hash", "input_hash"},
+		},
+	}
+
+	for _, tc := range tests {
+		s.T().Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.exp, tc.record.GetHashes(), "GetHashes")
+		})
+	}
+}
+
 func (s *ScopeTestSuite) TestSessionValidateBasic() {
 	scopeUUID := uuid.New()
 	sessionUUID := uuid.New()
@@ -180,9 +180,29 @@ func (s ContractSpecification) ValidateBasic() error {
 		return fmt.Errorf("class name exceeds maximum length (expected <= %d got: %d)",
 			maxContractSpecificationClassNameLength, len(s.ClassName))
 	}
+	seenTypeNames := make(map[string]bool)
+	for i, schema := range s.ValueSchemas {
+		if err = schema.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid value schema at index %d: %w", i, err)
+		}
+		if seenTypeNames[schema.TypeName] {
+			return fmt.Errorf("duplicate value schema type name %q", schema.TypeName)
+		}
+		seenTypeNames[schema.TypeName] = true
+	}
 	return nil
 }
 
+// GetValueSchema returns the value schema for the provided type name.
+func (s ContractSpecification) GetValueSchema(typeName string) (ValueSchema, bool) {
+	for _, schema := range s.ValueSchemas {
+		if schema.TypeName == typeName {
+			return schema, true
+		}
+	}
+	return ValueSchema{}, false
+}
+
 // String implements stringer interface
 func (s ContractSpecification) String() string {
 	out, _ := yaml.Marshal(s)
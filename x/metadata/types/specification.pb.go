@@ -211,6 +211,8 @@ type ContractSpecification struct {
 	Source isContractSpecification_Source `protobuf_oneof:"source"`
 	// name of the class/type of this contract executable
 	ClassName string `protobuf:"bytes,7,opt,name=class_name,json=className,proto3" json:"class_name,omitempty" yaml:"class_name"`
+	// value_schemas are the schemas that inline record values must satisfy, one per type_name.
+	ValueSchemas []ValueSchema `protobuf:"bytes,8,rep,name=value_schemas,json=valueSchemas,proto3" json:"value_schemas" yaml:"value_schemas,omitempty"`
 }
 
 func (m *ContractSpecification) Reset()      { *m = ContractSpecification{} }
@@ -310,6 +312,13 @@ func (m *ContractSpecification) GetClassName() string {
 	return ""
 }
 
+func (m *ContractSpecification) GetValueSchemas() []ValueSchema {
+	if m != nil {
+		return m.ValueSchemas
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ContractSpecification) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -318,6 +327,61 @@ func (*ContractSpecification) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+// ValueSchema is a schema for the inline values of records with a given type_name.
+type ValueSchema struct {
+	// type_name is the record specification type_name this schema applies to.
+	TypeName string `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty" yaml:"type_name"`
+	// json_schema is a json schema that the values must satisfy.
+	// Only a subset of the json schema keywords are supported, see the metadata module spec for details.
+	JsonSchema string `protobuf:"bytes,2,opt,name=json_schema,json=jsonSchema,proto3" json:"json_schema,omitempty" yaml:"json_schema"`
+}
+
+func (m *ValueSchema) Reset()      { *m = ValueSchema{} }
+func (*ValueSchema) ProtoMessage() {}
+func (*ValueSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e2d1042057ea889, []int{2}
+}
+func (m *ValueSchema) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValueSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValueSchema.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValueSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValueSchema.Merge(m, src)
+}
+func (m *ValueSchema) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValueSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValueSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValueSchema proto.InternalMessageInfo
+
+func (m *ValueSchema) GetTypeName() string {
+	if m != nil {
+		return m.TypeName
+	}
+	return ""
+}
+
+func (m *ValueSchema) GetJsonSchema() string {
+	if m != nil {
+		return m.JsonSchema
+	}
+	return ""
+}
+
 // RecordSpecification defines the specification for a Record including allowed/required inputs/outputs
 type RecordSpecification struct {
 	// unique identifier for this specification on chain
@@ -337,7 +401,7 @@ type RecordSpecification struct {
 func (m *RecordSpecification) Reset()      { *m = RecordSpecification{} }
 func (*RecordSpecification) ProtoMessage() {}
 func (*RecordSpecification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e2d1042057ea889, []int{2}
+	return fileDescriptor_1e2d1042057ea889, []int{3}
 }
 func (m *RecordSpecification) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -419,7 +483,7 @@ type InputSpecification struct {
 func (m *InputSpecification) Reset()      { *m = InputSpecification{} }
 func (*InputSpecification) ProtoMessage() {}
 func (*InputSpecification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e2d1042057ea889, []int{3}
+	return fileDescriptor_1e2d1042057ea889, []int{4}
 }
 func (m *InputSpecification) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -515,7 +579,7 @@ type Description struct {
 func (m *Description) Reset()      { *m = Description{} }
 func (*Description) ProtoMessage() {}
 func (*Description) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e2d1042057ea889, []int{4}
+	return fileDescriptor_1e2d1042057ea889, []int{5}
 }
 func (m *Description) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -577,6 +641,7 @@ func init() {
 	proto.RegisterEnum("provenance.metadata.v1.PartyType", PartyType_name, PartyType_value)
 	proto.RegisterType((*ScopeSpecification)(nil), "provenance.metadata.v1.ScopeSpecification")
 	proto.RegisterType((*ContractSpecification)(nil), "provenance.metadata.v1.ContractSpecification")
+	proto.RegisterType((*ValueSchema)(nil), "provenance.metadata.v1.ValueSchema")
 	proto.RegisterType((*RecordSpecification)(nil), "provenance.metadata.v1.RecordSpecification")
 	proto.RegisterType((*InputSpecification)(nil), "provenance.metadata.v1.InputSpecification")
 	proto.RegisterType((*Description)(nil), "provenance.metadata.v1.Description")
@@ -587,70 +652,75 @@ func init() {
 }
 
 var fileDescriptor_1e2d1042057ea889 = []byte{
-	// 1008 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x16, 0x4d, 0x59, 0xb6, 0x56, 0x81, 0xc5, 0xac, 0x7f, 0xc2, 0x38, 0x85, 0xa8, 0xb2, 0x40,
-	0x2b, 0x18, 0xb0, 0x04, 0x3b, 0x01, 0x0a, 0xe4, 0x46, 0x49, 0x74, 0xbc, 0x80, 0x42, 0x0a, 0x2b,
-	0xc9, 0x45, 0x0a, 0x14, 0x04, 0x4d, 0x6e, 0x6c, 0x36, 0x12, 0x49, 0x90, 0x94, 0x52, 0xbf, 0x43,
-	0x0f, 0x3d, 0xf6, 0xd8, 0x53, 0x1f, 0xa0, 0x40, 0xdf, 0x21, 0xbd, 0xa5, 0xb7, 0x22, 0x07, 0xa2,
-	0xb0, 0xdf, 0x40, 0xc7, 0x9e, 0x0a, 0x2e, 0x29, 0x89, 0xa2, 0x25, 0x20, 0x97, 0xf6, 0x94, 0x93,
-	0x76, 0xe7, 0xfb, 0x66, 0x76, 0xf6, 0x9b, 0x4f, 0x0b, 0x82, 0x23, 0xd7, 0x73, 0x26, 0xc4, 0xd6,
-	0x6d, 0x83, 0x34, 0x46, 0x24, 0xd0, 0x4d, 0x3d, 0xd0, 0x1b, 0x93, 0x93, 0x86, 0xef, 0x12, 0xc3,
-	0x7a, 0x6d, 0x19, 0x7a, 0x60, 0x39, 0x76, 0xdd, 0xf5, 0x9c, 0xc0, 0x81, 0x07, 0x0b, 0x6e, 0x7d,
-	0xc6, 0xad, 0x4f, 0x4e, 0x0e, 0xf7, 0xae, 0x9c, 0x2b, 0x87, 0x52, 0x1a, 0xd1, 0x2a, 0x66, 0x8b,
-	0x7f, 0xb2, 0x00, 0xf6, 0x0c, 0xc7, 0x25, 0xbd, 0x74, 0x29, 0xf8, 0x1d, 0xe0, 0x96, 0x6a, 0x6b,
-	0x96, 0xc9, 0x33, 0x55, 0xa6, 0xf6, 0xa0, 0x79, 0xfa, 0x2e, 0x14, 0x72, 0x1f, 0x42, 0xa1, 0xfc,
-	0x32, 0xa9, 0x2d, 0x99, 0xa6, 0x47, 0x7c, 0x7f, 0x1a, 0x0a, 0x8f, 0x6e, 0xf4, 0xd1, 0xf0, 0xb9,
-	0x98, 0x4d, 0x14, 0x71, 0x79, 0x29, 0x84, 0x4c, 0x28, 0x83, 0x92, 0x49, 0x7c, 0xc3, 0xb3, 0xdc,
-	0x28, 0xc0, 0x6f, 0x54, 0x99, 0x5a, 0xe9, 0xf4, 0x8b, 0xfa, 0xea, 0xce, 0xeb, 0xed, 0x05, 0x15,
-	0xa7, 0xf3, 0x60, 0x0b, 0x94, 0x9d, 0xb7, 0x36, 0xf1, 0x34, 0x3d, 0xee, 0x81, 0xf8, 0x3c, 0x5b,
-	0x65, 0x6b, 0xc5, 0xe6, 0xe1, 0x34, 0x14, 0x0e, 0xe2, 0x6e, 0x32, 0x04, 0x11, 0xef, 0xd0, 0x88,
-	0x34, 0x0b, 0x40, 0x0b, 0x70, 0xae, 0xee, 0x05, 0x16, 0xf1, 0x35, 0xcb, 0x9e, 0x38, 0xc3, 0x09,
-	0x31, 0xf9, 0x7c, 0x95, 0xad, 0xed, 0x9c, 0x7e, 0xbe, 0xae, 0xa1, 0xae, 0xee, 0x05, 0x37, 0xfd,
-	0x1b, 0x97, 0x34, 0x9f, 0x2c, 0xae, 0x9d, 0x2d, 0x22, 0xe2, 0x72, 0x12, 0x42, 0x49, 0x04, 0x6a,
-	0xe0, 0xa1, 0xe1, 0xd8, 0x81, 0xa7, 0x1b, 0x81, 0x16, 0x49, 0xa2, 0x59, 0xa6, 0xcf, 0x6f, 0x56,
-	0xd9, 0xda, 0x83, 0xe6, 0xd3, 0xf5, 0xb2, 0xf2, 0x71, 0xfd, 0x7b, 0x99, 0x22, 0x2e, 0xcf, 0x62,
-	0xd1, 0xf0, 0x90, 0xe9, 0x3f, 0xcf, 0xff, 0xfc, 0x8b, 0x90, 0x13, 0x7f, 0xcf, 0x83, 0xfd, 0x56,
-	0x0a, 0xf9, 0x34, 0xd6, 0xff, 0x76, 0xac, 0xdf, 0x83, 0x92, 0x47, 0x7c, 0x67, 0xec, 0x19, 0x24,
-	0x12, 0x74, 0x93, 0x0a, 0xfa, 0x62, 0x1a, 0x0a, 0x30, 0x2e, 0x91, 0x02, 0xc5, 0x7f, 0x42, 0xe1,
-	0xf8, 0xca, 0x0a, 0xae, 0xc7, 0x97, 0x75, 0xc3, 0x19, 0x35, 0x0c, 0xc7, 0x1f, 0x39, 0x7e, 0xf2,
-	0x73, 0xec, 0x9b, 0x6f, 0x1a, 0xc1, 0x8d, 0x4b, 0xfc, 0xba, 0x64, 0x18, 0xc9, 0x55, 0xce, 0x73,
-	0x18, 0xcc, 0x0a, 0x20, 0x13, 0xee, 0x81, 0xfc, 0xb5, 0xee, 0x5f, 0xf3, 0x85, 0x2a, 0x53, 0x2b,
-	0x9e, 0xe7, 0x30, 0xdd, 0xc1, 0x67, 0x00, 0x18, 0x43, 0xdd, 0xf7, 0x35, 0x5b, 0x1f, 0x11, 0x7e,
-	0x2b, 0xc2, 0x9a, 0xfb, 0xd3, 0x50, 0x78, 0x98, 0x58, 0x67, 0x8e, 0x89, 0xb8, 0x48, 0x37, 0x8a,
-	0x3e, 0x22, 0xb1, 0x5b, 0x9a, 0xdb, 0xa0, 0x10, 0x57, 0x17, 0x3f, 0xb0, 0x60, 0x17, 0x13, 0xc3,
-	0xf1, 0xcc, 0xff, 0xd5, 0x35, 0x10, 0xe4, 0x69, 0xdb, 0x91, 0x5d, 0x8a, 0x98, 0xae, 0x61, 0x13,
-	0x14, 0x2c, 0xdb, 0x1d, 0x07, 0xf1, 0xe4, 0x4b, 0xa7, 0x47, 0xeb, 0x66, 0x86, 0x22, 0xd6, 0x52,
-	0xbb, 0x38, 0xc9, 0x84, 0x27, 0xa0, 0x18, 0x89, 0x19, 0x6b, 0x92, 0xa7, 0x9a, 0xec, 0x4d, 0x43,
-	0x81, 0x8b, 0x1b, 0x9b, 0x43, 0x22, 0xde, 0x8e, 0xd6, 0x91, 0x22, 0x50, 0xa3, 0x93, 0x1c, 0x0f,
-	0x03, 0x2d, 0x0a, 0xd1, 0x49, 0xee, 0x9c, 0x7e, 0xb9, 0xde, 0xc0, 0xaf, 0x2d, 0xdb, 0x8a, 0xce,
-	0xa4, 0xa6, 0x39, 0x58, 0x9a, 0xf8, 0xac, 0x88, 0x48, 0xc7, 0x37, 0x1e, 0x06, 0x11, 0x07, 0x7a,
-	0x60, 0xd7, 0x23, 0xbe, 0xeb, 0xd8, 0xbe, 0x75, 0x39, 0x24, 0x5a, 0xe2, 0x24, 0xbe, 0xf0, 0xb1,
-	0xc6, 0xac, 0x4c, 0x43, 0xe1, 0x70, 0x7e, 0x46, 0xb6, 0x8e, 0x88, 0x61, 0x2a, 0xda, 0x8d, 0x83,
-	0xc9, 0xa3, 0xf0, 0x07, 0x03, 0xe0, 0x7d, 0xb1, 0xe6, 0xe2, 0x33, 0x29, 0xf1, 0x97, 0x84, 0xdb,
-	0xf8, 0x28, 0xe1, 0xce, 0x40, 0xd1, 0xa3, 0xce, 0x89, 0xbc, 0xc1, 0x52, 0x6f, 0x7c, 0xb5, 0xda,
-	0x17, 0xdc, 0xac, 0xfb, 0x84, 0x2d, 0x9e, 0xe7, 0xf0, 0x76, 0xbc, 0x4b, 0xd9, 0x3b, 0x9f, 0xb6,
-	0xf7, 0x3d, 0xa3, 0xfe, 0xc6, 0x80, 0x52, 0xea, 0xf5, 0x58, 0x79, 0x89, 0xea, 0xf2, 0x5b, 0xc4,
-	0x52, 0x68, 0xe9, 0x99, 0xf9, 0x1a, 0x94, 0xde, 0x92, 0x4b, 0xdf, 0x0a, 0x88, 0x36, 0xf6, 0x86,
-	0x89, 0x43, 0x52, 0x43, 0x4c, 0x81, 0x22, 0x06, 0xc9, 0x6e, 0xe0, 0x0d, 0x61, 0x1d, 0x6c, 0x5b,
-	0x86, 0x63, 0xd3, 0xac, 0x4d, 0x9a, 0xb5, 0x3b, 0x0d, 0x85, 0x72, 0x9c, 0x35, 0x43, 0x44, 0xbc,
-	0x15, 0x2d, 0x07, 0xde, 0x30, 0x6e, 0xff, 0xe8, 0x47, 0x06, 0xec, 0x2c, 0x3b, 0x06, 0x0a, 0xe0,
-	0x49, 0x5b, 0x3e, 0x43, 0x0a, 0xea, 0x23, 0x55, 0xd1, 0xfa, 0xaf, 0xba, 0xb2, 0x36, 0x50, 0x7a,
-	0x5d, 0xb9, 0x85, 0xce, 0x90, 0xdc, 0xe6, 0x72, 0xf0, 0x33, 0xc0, 0x67, 0x09, 0x5d, 0xac, 0x76,
-	0xd5, 0x9e, 0xdc, 0xe6, 0x18, 0x78, 0x08, 0x0e, 0xb2, 0x28, 0x96, 0x5b, 0x2a, 0x6e, 0x73, 0x1b,
-	0xab, 0x4a, 0xc7, 0x98, 0xd6, 0x41, 0xbd, 0x3e, 0xc7, 0x1e, 0xfd, 0xba, 0x01, 0x8a, 0x73, 0x5f,
-	0x45, 0xa5, 0xba, 0x12, 0xee, 0xbf, 0x5a, 0xd5, 0xc4, 0x63, 0xb0, 0x9f, 0xc2, 0x54, 0x8c, 0x5e,
-	0x20, 0x45, 0xea, 0xab, 0x98, 0x63, 0xe0, 0x23, 0xb0, 0x9b, 0x82, 0x7a, 0x32, 0xbe, 0x40, 0x2d,
-	0x19, 0x73, 0x1b, 0x19, 0x00, 0x29, 0x17, 0x72, 0x2f, 0xca, 0x60, 0x21, 0x0f, 0xf6, 0x52, 0x40,
-	0x6b, 0xd0, 0xeb, 0xab, 0x6d, 0x24, 0x29, 0x5c, 0x1e, 0xee, 0x01, 0x2e, 0x7d, 0xcc, 0x37, 0x8a,
-	0x8c, 0xb9, 0xcd, 0x0c, 0x5f, 0x3a, 0x3b, 0x43, 0x1d, 0x24, 0xf5, 0x65, 0xae, 0x00, 0x0f, 0x00,
-	0x4c, 0xf3, 0x5f, 0x2a, 0xa8, 0x39, 0xe8, 0x71, 0x5b, 0x99, 0x76, 0xbb, 0x58, 0xbd, 0x90, 0x15,
-	0x49, 0x69, 0xc9, 0xdc, 0x76, 0x06, 0x6a, 0xa9, 0x4a, 0x1f, 0xab, 0x9d, 0x8e, 0x8c, 0x39, 0x90,
-	0x39, 0xe7, 0x42, 0xea, 0xa0, 0x36, 0xbd, 0x63, 0xa9, 0xf9, 0xe6, 0xdd, 0x6d, 0x85, 0x79, 0x7f,
-	0x5b, 0x61, 0xfe, 0xbe, 0xad, 0x30, 0x3f, 0xdd, 0x55, 0x72, 0xef, 0xef, 0x2a, 0xb9, 0xbf, 0xee,
-	0x2a, 0x39, 0xf0, 0xd8, 0x72, 0xd6, 0xfc, 0x63, 0xbb, 0xcc, 0xb7, 0xcf, 0x52, 0xaf, 0xfc, 0x82,
-	0x74, 0x6c, 0x39, 0xa9, 0x5d, 0xe3, 0x87, 0xc5, 0xd7, 0x1c, 0x7d, 0xf7, 0x2f, 0x0b, 0xf4, 0xab,
-	0xec, 0xe9, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x1e, 0x3a, 0xc3, 0x1d, 0xf1, 0x09, 0x00, 0x00,
+	// 1088 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x3f, 0x6f, 0xdb, 0xc6,
+	0x1b, 0x16, 0x4d, 0x59, 0x91, 0x4e, 0xf9, 0x59, 0xcc, 0xd9, 0x56, 0x18, 0xe7, 0x07, 0x51, 0x65,
+	0x81, 0x54, 0x30, 0x6a, 0x09, 0x76, 0x02, 0x14, 0xc8, 0x26, 0x4a, 0x74, 0x4c, 0xc0, 0xa1, 0x84,
+	0x93, 0xec, 0x22, 0x05, 0x0a, 0x82, 0x26, 0x2f, 0x36, 0x13, 0x89, 0x24, 0x78, 0x94, 0x52, 0x4f,
+	0xfd, 0x02, 0x1d, 0x3a, 0x76, 0xec, 0xd4, 0xee, 0xfd, 0x14, 0xe9, 0x96, 0x6e, 0x45, 0x06, 0xa2,
+	0xb0, 0xbf, 0x81, 0xc6, 0x4e, 0x05, 0x8f, 0x94, 0x75, 0xa2, 0xed, 0xc2, 0x4b, 0x3b, 0x75, 0xd2,
+	0xdd, 0xfb, 0x3c, 0xef, 0xdf, 0x7b, 0x5e, 0x49, 0x60, 0xdb, 0x0f, 0xbc, 0x29, 0x76, 0x4d, 0xd7,
+	0xc2, 0xad, 0x31, 0x0e, 0x4d, 0xdb, 0x0c, 0xcd, 0xd6, 0x74, 0xb7, 0x45, 0x7c, 0x6c, 0x39, 0xaf,
+	0x1d, 0xcb, 0x0c, 0x1d, 0xcf, 0x6d, 0xfa, 0x81, 0x17, 0x7a, 0xb0, 0xba, 0xe0, 0x36, 0xe7, 0xdc,
+	0xe6, 0x74, 0x77, 0x6b, 0xe3, 0xd4, 0x3b, 0xf5, 0x28, 0xa5, 0x15, 0x9f, 0x12, 0xb6, 0xfc, 0x1b,
+	0x0f, 0xe0, 0xc0, 0xf2, 0x7c, 0x3c, 0x60, 0x43, 0xc1, 0xaf, 0x81, 0xb0, 0x14, 0xdb, 0x70, 0x6c,
+	0x91, 0xab, 0x73, 0x8d, 0xfb, 0xca, 0xde, 0xfb, 0x48, 0xca, 0x7d, 0x8c, 0xa4, 0xca, 0xcb, 0x34,
+	0x76, 0xdb, 0xb6, 0x03, 0x4c, 0xc8, 0x2c, 0x92, 0x1e, 0x9e, 0x9b, 0xe3, 0xd1, 0x73, 0x39, 0xeb,
+	0x28, 0xa3, 0xca, 0x92, 0x49, 0xb3, 0xa1, 0x0a, 0xca, 0x36, 0x26, 0x56, 0xe0, 0xf8, 0xb1, 0x41,
+	0x5c, 0xa9, 0x73, 0x8d, 0xf2, 0xde, 0xa7, 0xcd, 0x9b, 0x2b, 0x6f, 0x76, 0x17, 0x54, 0xc4, 0xfa,
+	0xc1, 0x0e, 0xa8, 0x78, 0xef, 0x5c, 0x1c, 0x18, 0x66, 0x52, 0x03, 0x26, 0x22, 0x5f, 0xe7, 0x1b,
+	0x25, 0x65, 0x6b, 0x16, 0x49, 0xd5, 0xa4, 0x9a, 0x0c, 0x41, 0x46, 0x6b, 0xd4, 0xd2, 0x9e, 0x1b,
+	0xa0, 0x03, 0x04, 0xdf, 0x0c, 0x42, 0x07, 0x13, 0xc3, 0x71, 0xa7, 0xde, 0x68, 0x8a, 0x6d, 0x31,
+	0x5f, 0xe7, 0x1b, 0x6b, 0x7b, 0x9f, 0xdc, 0x56, 0x50, 0xdf, 0x0c, 0xc2, 0xf3, 0xe1, 0xb9, 0x8f,
+	0x95, 0xc7, 0x8b, 0xb6, 0xb3, 0x41, 0x64, 0x54, 0x49, 0x4d, 0x5a, 0x6a, 0x81, 0x06, 0x78, 0x60,
+	0x79, 0x6e, 0x18, 0x98, 0x56, 0x68, 0xc4, 0x23, 0x31, 0x1c, 0x9b, 0x88, 0xab, 0x75, 0xbe, 0x71,
+	0x5f, 0x79, 0x7a, 0xfb, 0x58, 0xc5, 0x24, 0xfe, 0x35, 0x4f, 0x19, 0x55, 0xe6, 0xb6, 0xf8, 0xf1,
+	0x34, 0x9b, 0x3c, 0xcf, 0xff, 0xf0, 0xa3, 0x94, 0x93, 0x7f, 0x5e, 0x05, 0x9b, 0x1d, 0x06, 0xf9,
+	0xef, 0x59, 0xff, 0xd9, 0x67, 0x7d, 0x03, 0xca, 0x01, 0x26, 0xde, 0x24, 0xb0, 0x70, 0x3c, 0xd0,
+	0x55, 0x3a, 0xd0, 0x17, 0xb3, 0x48, 0x82, 0x49, 0x08, 0x06, 0x94, 0xff, 0x8c, 0xa4, 0x9d, 0x53,
+	0x27, 0x3c, 0x9b, 0x9c, 0x34, 0x2d, 0x6f, 0xdc, 0xb2, 0x3c, 0x32, 0xf6, 0x48, 0xfa, 0xb1, 0x43,
+	0xec, 0xb7, 0xad, 0xf0, 0xdc, 0xc7, 0xa4, 0xd9, 0xb6, 0xac, 0xb4, 0x95, 0x83, 0x1c, 0x02, 0xf3,
+	0x00, 0x9a, 0x0d, 0x37, 0x40, 0xfe, 0xcc, 0x24, 0x67, 0x62, 0xa1, 0xce, 0x35, 0x4a, 0x07, 0x39,
+	0x44, 0x6f, 0xf0, 0x19, 0x00, 0xd6, 0xc8, 0x24, 0xc4, 0x70, 0xcd, 0x31, 0x16, 0xef, 0xc5, 0x98,
+	0xb2, 0x39, 0x8b, 0xa4, 0x07, 0xa9, 0x74, 0xae, 0x30, 0x19, 0x95, 0xe8, 0x45, 0x37, 0xc7, 0x18,
+	0xfa, 0xe0, 0x7f, 0x53, 0x73, 0x34, 0xc1, 0x06, 0xb1, 0xce, 0xf0, 0xd8, 0x24, 0x62, 0xb1, 0xce,
+	0xff, 0xdd, 0x83, 0x1d, 0xc7, 0xe4, 0x01, 0xe5, 0x2a, 0x4f, 0x62, 0xbd, 0xcc, 0x22, 0xa9, 0x96,
+	0x64, 0x58, 0x8a, 0xf3, 0xb9, 0x37, 0x76, 0x42, 0x3c, 0xf6, 0xc3, 0x73, 0x19, 0xdd, 0x9f, 0x2e,
+	0x9c, 0x52, 0x7d, 0x2a, 0x45, 0x50, 0x48, 0xfa, 0x91, 0xbf, 0x05, 0x65, 0x26, 0x28, 0xdc, 0x05,
+	0xa5, 0xb8, 0xfd, 0xa4, 0x0b, 0x8e, 0x76, 0xb1, 0x31, 0x8b, 0x24, 0x21, 0xc9, 0x71, 0x05, 0xc9,
+	0xa8, 0x18, 0x9f, 0x69, 0x0f, 0x5f, 0x80, 0xf2, 0x1b, 0xe2, 0xb9, 0x69, 0x6a, 0x2a, 0xb9, 0x92,
+	0x52, 0x5d, 0xcc, 0x9e, 0x01, 0x65, 0x04, 0xe2, 0x5b, 0x92, 0x2b, 0x5d, 0x95, 0x8f, 0x3c, 0x58,
+	0x47, 0xd8, 0xf2, 0x02, 0xfb, 0x5f, 0x5d, 0x14, 0x08, 0xf2, 0xb4, 0x47, 0x5a, 0x2e, 0xa2, 0x67,
+	0xa8, 0x80, 0x82, 0xe3, 0xfa, 0x93, 0x30, 0x11, 0x7b, 0x79, 0x6f, 0xfb, 0xb6, 0x67, 0xd0, 0x62,
+	0xd6, 0x52, 0xb9, 0x28, 0xf5, 0x5c, 0x1e, 0x60, 0xfe, 0x4e, 0x03, 0x34, 0xa8, 0x78, 0x27, 0xa3,
+	0xd0, 0x88, 0x4d, 0x54, 0xbc, 0x6b, 0x7b, 0x4f, 0x6e, 0xdf, 0xd9, 0xd7, 0x8e, 0xeb, 0xc4, 0x39,
+	0xe9, 0x9e, 0x54, 0x97, 0x44, 0x3e, 0x0f, 0x22, 0x53, 0xc5, 0x4e, 0x46, 0x61, 0xcc, 0x81, 0x01,
+	0x58, 0x0f, 0x30, 0xf1, 0x3d, 0x97, 0x38, 0x27, 0x23, 0x6c, 0xa4, 0xcb, 0x23, 0x16, 0xee, 0xba,
+	0x8b, 0xb5, 0x59, 0x24, 0x6d, 0x5d, 0xe5, 0xc8, 0xc6, 0x91, 0x11, 0x64, 0xac, 0xfd, 0xc4, 0x98,
+	0x3e, 0xee, 0xaf, 0x1c, 0x80, 0xd7, 0x87, 0x75, 0x35, 0x7c, 0x8e, 0x19, 0xfe, 0xd2, 0xe0, 0x56,
+	0xee, 0x34, 0xb8, 0x7d, 0x50, 0x0a, 0xa8, 0x72, 0x62, 0x6d, 0xf0, 0x54, 0x1b, 0x9f, 0xdd, 0xac,
+	0x0b, 0x61, 0x5e, 0x7d, 0xca, 0x96, 0x0f, 0x72, 0xa8, 0x98, 0xdc, 0x98, 0x8d, 0xce, 0xb3, 0x1b,
+	0x7d, 0x6d, 0x53, 0x7e, 0xe1, 0x40, 0x99, 0xf9, 0xc2, 0xbc, 0xb1, 0x89, 0xfa, 0xf2, 0xd7, 0x2f,
+	0x4f, 0x21, 0xd6, 0x14, 0x6f, 0xcb, 0x3b, 0x7c, 0x42, 0x9c, 0x10, 0x1b, 0x93, 0x60, 0x94, 0x2a,
+	0x84, 0x79, 0x44, 0x06, 0x94, 0x11, 0x48, 0x6f, 0x47, 0xc1, 0x08, 0x36, 0x41, 0xd1, 0xb1, 0x3c,
+	0x97, 0x7a, 0xad, 0x52, 0xaf, 0xf5, 0x59, 0x24, 0x55, 0x12, 0xaf, 0x39, 0x22, 0xa3, 0x7b, 0xf1,
+	0xf1, 0x28, 0x18, 0x25, 0xe5, 0x6f, 0x7f, 0xc7, 0x81, 0xb5, 0x65, 0xc5, 0x40, 0x09, 0x3c, 0xee,
+	0xaa, 0xfb, 0x9a, 0xae, 0x0d, 0xb5, 0x9e, 0x6e, 0x0c, 0x5f, 0xf5, 0x55, 0xe3, 0x48, 0x1f, 0xf4,
+	0xd5, 0x8e, 0xb6, 0xaf, 0xa9, 0x5d, 0x21, 0x07, 0xff, 0x0f, 0xc4, 0x2c, 0xa1, 0x8f, 0x7a, 0xfd,
+	0xde, 0x40, 0xed, 0x0a, 0x1c, 0xdc, 0x02, 0xd5, 0x2c, 0x8a, 0xd4, 0x4e, 0x0f, 0x75, 0x85, 0x95,
+	0x9b, 0x42, 0x27, 0x98, 0x71, 0xa8, 0x0d, 0x86, 0x02, 0xbf, 0xfd, 0xd3, 0x0a, 0x28, 0x5d, 0xe9,
+	0x2a, 0x0e, 0xd5, 0x6f, 0xa3, 0xe1, 0xab, 0x9b, 0x8a, 0x78, 0x04, 0x36, 0x19, 0xac, 0x87, 0xb4,
+	0x17, 0x9a, 0xde, 0x1e, 0xf6, 0x90, 0xc0, 0xc1, 0x87, 0x60, 0x9d, 0x81, 0x06, 0x2a, 0x3a, 0xd6,
+	0x3a, 0x2a, 0x12, 0x56, 0x32, 0x80, 0xa6, 0x1f, 0xab, 0x83, 0xd8, 0x83, 0x87, 0x22, 0xd8, 0x60,
+	0x80, 0xce, 0xd1, 0x60, 0xd8, 0xeb, 0x6a, 0x6d, 0x5d, 0xc8, 0xc3, 0x0d, 0x20, 0xb0, 0x69, 0xbe,
+	0xd4, 0x55, 0x24, 0xac, 0x66, 0xf8, 0xed, 0xfd, 0x7d, 0xed, 0x50, 0x6b, 0x0f, 0x55, 0xa1, 0x00,
+	0xab, 0x00, 0xb2, 0xfc, 0x97, 0xba, 0xa6, 0x1c, 0x0d, 0x84, 0x7b, 0x99, 0x72, 0xfb, 0xa8, 0x77,
+	0xac, 0xea, 0x6d, 0xbd, 0xa3, 0x0a, 0xc5, 0x0c, 0xd4, 0xe9, 0xe9, 0x43, 0xd4, 0x3b, 0x3c, 0x54,
+	0x91, 0x00, 0x32, 0x79, 0x8e, 0xdb, 0x87, 0x5a, 0x97, 0xf6, 0x58, 0x56, 0xde, 0xbe, 0xbf, 0xa8,
+	0x71, 0x1f, 0x2e, 0x6a, 0xdc, 0x1f, 0x17, 0x35, 0xee, 0xfb, 0xcb, 0x5a, 0xee, 0xc3, 0x65, 0x2d,
+	0xf7, 0xfb, 0x65, 0x2d, 0x07, 0x1e, 0x39, 0xde, 0x2d, 0x1b, 0xdb, 0xe7, 0xbe, 0x7a, 0xc6, 0xfc,
+	0xb0, 0x2d, 0x48, 0x3b, 0x8e, 0xc7, 0xdc, 0x5a, 0xdf, 0x2c, 0xfe, 0xc0, 0xd2, 0x9f, 0xba, 0x93,
+	0x02, 0xfd, 0x23, 0xfa, 0xf4, 0xaf, 0x01, 0x00, 0x11, 0xd9, 0x07, 0x97, 0xe4, 0x0a, 0x00, 0x00,
 }
 
 func (m *ScopeSpecification) Marshal() (dAtA []byte, err error) {
@@ -759,6 +829,20 @@ func (m *ContractSpecification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ValueSchemas) > 0 {
+		for iNdEx := len(m.ValueSchemas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValueSchemas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSpecification(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.ClassName) > 0 {
 		i -= len(m.ClassName)
 		copy(dAtA[i:], m.ClassName)
@@ -857,6 +941,43 @@ func (m *ContractSpecification_Hash) MarshalToSizedBuffer(dAtA []byte) (int, err
 	dAtA[i] = 0x32
 	return len(dAtA) - i, nil
 }
+func (m *ValueSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValueSchema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValueSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JsonSchema) > 0 {
+		i -= len(m.JsonSchema)
+		copy(dAtA[i:], m.JsonSchema)
+		i = encodeVarintSpecification(dAtA, i, uint64(len(m.JsonSchema)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TypeName) > 0 {
+		i -= len(m.TypeName)
+		copy(dAtA[i:], m.TypeName)
+		i = encodeVarintSpecification(dAtA, i, uint64(len(m.TypeName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RecordSpecification) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1148,6 +1269,12 @@ func (m *ContractSpecification) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSpecification(uint64(l))
 	}
+	if len(m.ValueSchemas) > 0 {
+		for _, e := range m.ValueSchemas {
+			l = e.Size()
+			n += 1 + l + sovSpecification(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1173,6 +1300,23 @@ func (m *ContractSpecification_Hash) Size() (n int) {
 	n += 1 + l + sovSpecification(uint64(l))
 	return n
 }
+func (m *ValueSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeName)
+	if l > 0 {
+		n += 1 + l + sovSpecification(uint64(l))
+	}
+	l = len(m.JsonSchema)
+	if l > 0 {
+		n += 1 + l + sovSpecification(uint64(l))
+	}
+	return n
+}
+
 func (m *RecordSpecification) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1830,6 +1974,154 @@ func (m *ContractSpecification) Unmarshal(dAtA []byte) error {
 			}
 			m.ClassName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueSchemas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueSchemas = append(m.ValueSchemas, ValueSchema{})
+			if err := m.ValueSchemas[len(m.ValueSchemas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSpecification(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValueSchema) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSpecification
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValueSchema: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValueSchema: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JsonSchema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JsonSchema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSpecification(dAtA[iNdEx:])
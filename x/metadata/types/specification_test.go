@@ -423,6 +423,32 @@ func (s *SpecificationTestSuite) TestContractSpecValidateBasic() {
 			"",
 		},
 
+		// ValueSchemas tests
+		{
+			"ValueSchemas - invalid schema",
+			&ContractSpecification{
+				SpecificationId: ContractSpecMetadataAddress(uuid.New()),
+				OwnerAddresses:  []string{specTestBech32},
+				PartiesInvolved: []PartyType{PartyType_PARTY_TYPE_OWNER},
+				Source:          NewContractSpecificationSourceHash("somehash"),
+				ClassName:       "someclass",
+				ValueSchemas:    []ValueSchema{*NewValueSchema("type1", `{}`), *NewValueSchema("type2", `{"type":"date"}`)},
+			},
+			`invalid value schema at index 1: invalid json schema: $.type: unknown type "date"`,
+		},
+		{
+			"ValueSchemas - duplicate type name",
+			&ContractSpecification{
+				SpecificationId: ContractSpecMetadataAddress(uuid.New()),
+				OwnerAddresses:  []string{specTestBech32},
+				PartiesInvolved: []PartyType{PartyType_PARTY_TYPE_OWNER},
+				Source:          NewContractSpecificationSourceHash("somehash"),
+				ClassName:       "someclass",
+				ValueSchemas:    []ValueSchema{*NewValueSchema("type1", `{}`), *NewValueSchema("type1", `{"type":"string"}`)},
+			},
+			`duplicate value schema type name "type1"`,
+		},
+
 		// A simple valid ContractSpecification
 		{
 			"simple valid test case",
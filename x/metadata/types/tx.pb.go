@@ -6,22 +6,28 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	p8e "github.com/provenance-io/provenance/x/metadata/types/p8e"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -684,6 +690,409 @@ func (m *MsgMigrateValueOwnerResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgMigrateValueOwnerResponse proto.InternalMessageInfo
 
+// MsgListScopeForSaleRequest is the request to offer the value ownership of a scope for a price.
+type MsgListScopeForSaleRequest struct {
+	// scope_id is the id of the scope to sell.
+	ScopeId MetadataAddress `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3,customtype=MetadataAddress" json:"scope_id" yaml:"scope_id"`
+	// price is the amount the buyer will have to pay to the scope's current value owner.
+	Price github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=price,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"price"`
+	// expiration is the time after which the scope can no longer be bought using this listing.
+	Expiration time.Time `protobuf:"bytes,3,opt,name=expiration,proto3,stdtime" json:"expiration"`
+	// signers is the list of addresses of those signing this request.
+	Signers []string `protobuf:"bytes,4,rep,name=signers,proto3" json:"signers,omitempty"`
+}
+
+func (m *MsgListScopeForSaleRequest) Reset()         { *m = MsgListScopeForSaleRequest{} }
+func (m *MsgListScopeForSaleRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgListScopeForSaleRequest) ProtoMessage()    {}
+func (*MsgListScopeForSaleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{16}
+}
+func (m *MsgListScopeForSaleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgListScopeForSaleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgListScopeForSaleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgListScopeForSaleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgListScopeForSaleRequest.Merge(m, src)
+}
+func (m *MsgListScopeForSaleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgListScopeForSaleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgListScopeForSaleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgListScopeForSaleRequest proto.InternalMessageInfo
+
+// MsgListScopeForSaleResponse is the response from listing a scope for sale.
+type MsgListScopeForSaleResponse struct {
+}
+
+func (m *MsgListScopeForSaleResponse) Reset()         { *m = MsgListScopeForSaleResponse{} }
+func (m *MsgListScopeForSaleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgListScopeForSaleResponse) ProtoMessage()    {}
+func (*MsgListScopeForSaleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{17}
+}
+func (m *MsgListScopeForSaleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgListScopeForSaleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgListScopeForSaleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgListScopeForSaleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgListScopeForSaleResponse.Merge(m, src)
+}
+func (m *MsgListScopeForSaleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgListScopeForSaleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgListScopeForSaleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgListScopeForSaleResponse proto.InternalMessageInfo
+
+// MsgCancelScopeListingRequest is the request to remove an offer to sell the value ownership of a scope.
+type MsgCancelScopeListingRequest struct {
+	// scope_id is the id of the scope with the listing to cancel.
+	ScopeId MetadataAddress `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3,customtype=MetadataAddress" json:"scope_id" yaml:"scope_id"`
+	// signers is the list of addresses of those signing this request.
+	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+}
+
+func (m *MsgCancelScopeListingRequest) Reset()         { *m = MsgCancelScopeListingRequest{} }
+func (m *MsgCancelScopeListingRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScopeListingRequest) ProtoMessage()    {}
+func (*MsgCancelScopeListingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{18}
+}
+func (m *MsgCancelScopeListingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScopeListingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScopeListingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelScopeListingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScopeListingRequest.Merge(m, src)
+}
+func (m *MsgCancelScopeListingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScopeListingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScopeListingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelScopeListingRequest proto.InternalMessageInfo
+
+// MsgCancelScopeListingResponse is the response from cancelling a scope listing.
+type MsgCancelScopeListingResponse struct {
+}
+
+func (m *MsgCancelScopeListingResponse) Reset()         { *m = MsgCancelScopeListingResponse{} }
+func (m *MsgCancelScopeListingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScopeListingResponse) ProtoMessage()    {}
+func (*MsgCancelScopeListingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{19}
+}
+func (m *MsgCancelScopeListingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScopeListingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScopeListingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelScopeListingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScopeListingResponse.Merge(m, src)
+}
+func (m *MsgCancelScopeListingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScopeListingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScopeListingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelScopeListingResponse proto.InternalMessageInfo
+
+// MsgBuyScopeRequest is the request to buy the value ownership of a listed scope.
+type MsgBuyScopeRequest struct {
+	// scope_id is the id of the scope to buy.
+	ScopeId MetadataAddress `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3,customtype=MetadataAddress" json:"scope_id" yaml:"scope_id"`
+	// buyer is the account that pays for the scope and becomes its value owner.
+	Buyer string `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// price is the amount the buyer agrees to pay. It must equal the listing price.
+	Price github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=price,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"price"`
+	// signers is the list of addresses of those signing this request.
+	Signers []string `protobuf:"bytes,4,rep,name=signers,proto3" json:"signers,omitempty"`
+}
+
+func (m *MsgBuyScopeRequest) Reset()         { *m = MsgBuyScopeRequest{} }
+func (m *MsgBuyScopeRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgBuyScopeRequest) ProtoMessage()    {}
+func (*MsgBuyScopeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{20}
+}
+func (m *MsgBuyScopeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBuyScopeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBuyScopeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBuyScopeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBuyScopeRequest.Merge(m, src)
+}
+func (m *MsgBuyScopeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBuyScopeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBuyScopeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBuyScopeRequest proto.InternalMessageInfo
+
+// MsgBuyScopeResponse is the response from buying a scope.
+type MsgBuyScopeResponse struct {
+}
+
+func (m *MsgBuyScopeResponse) Reset()         { *m = MsgBuyScopeResponse{} }
+func (m *MsgBuyScopeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBuyScopeResponse) ProtoMessage()    {}
+func (*MsgBuyScopeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{21}
+}
+func (m *MsgBuyScopeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBuyScopeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBuyScopeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBuyScopeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBuyScopeResponse.Merge(m, src)
+}
+func (m *MsgBuyScopeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBuyScopeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBuyScopeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBuyScopeResponse proto.InternalMessageInfo
+
+// MsgLockScopeRequest is the request to place a legal hold on a scope.
+// It must be signed by a scope owner with the custodian or controller role, or by the governance module account.
+type MsgLockScopeRequest struct {
+	// scope_id is the id of the scope to lock.
+	ScopeId MetadataAddress `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3,customtype=MetadataAddress" json:"scope_id" yaml:"scope_id"`
+	// reason is a short explanation of why the scope is being locked.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// signers is the list of addresses of those signing this request.
+	Signers []string `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
+}
+
+func (m *MsgLockScopeRequest) Reset()         { *m = MsgLockScopeRequest{} }
+func (m *MsgLockScopeRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgLockScopeRequest) ProtoMessage()    {}
+func (*MsgLockScopeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{22}
+}
+func (m *MsgLockScopeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLockScopeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLockScopeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLockScopeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLockScopeRequest.Merge(m, src)
+}
+func (m *MsgLockScopeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLockScopeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLockScopeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLockScopeRequest proto.InternalMessageInfo
+
+// MsgLockScopeResponse is the response from locking a scope.
+type MsgLockScopeResponse struct {
+}
+
+func (m *MsgLockScopeResponse) Reset()         { *m = MsgLockScopeResponse{} }
+func (m *MsgLockScopeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgLockScopeResponse) ProtoMessage()    {}
+func (*MsgLockScopeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{23}
+}
+func (m *MsgLockScopeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLockScopeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLockScopeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLockScopeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLockScopeResponse.Merge(m, src)
+}
+func (m *MsgLockScopeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLockScopeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLockScopeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLockScopeResponse proto.InternalMessageInfo
+
+// MsgUnlockScopeRequest is the request to remove the legal hold from a scope.
+// It must be signed by the address that locked the scope, or by the governance module account.
+// A scope locked by governance can only be unlocked by governance.
+type MsgUnlockScopeRequest struct {
+	// scope_id is the id of the scope to unlock.
+	ScopeId MetadataAddress `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3,customtype=MetadataAddress" json:"scope_id" yaml:"scope_id"`
+	// signers is the list of addresses of those signing this request.
+	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+}
+
+func (m *MsgUnlockScopeRequest) Reset()         { *m = MsgUnlockScopeRequest{} }
+func (m *MsgUnlockScopeRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgUnlockScopeRequest) ProtoMessage()    {}
+func (*MsgUnlockScopeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{24}
+}
+func (m *MsgUnlockScopeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnlockScopeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnlockScopeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnlockScopeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnlockScopeRequest.Merge(m, src)
+}
+func (m *MsgUnlockScopeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnlockScopeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnlockScopeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnlockScopeRequest proto.InternalMessageInfo
+
+// MsgUnlockScopeResponse is the response from unlocking a scope.
+type MsgUnlockScopeResponse struct {
+}
+
+func (m *MsgUnlockScopeResponse) Reset()         { *m = MsgUnlockScopeResponse{} }
+func (m *MsgUnlockScopeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnlockScopeResponse) ProtoMessage()    {}
+func (*MsgUnlockScopeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{25}
+}
+func (m *MsgUnlockScopeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnlockScopeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnlockScopeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnlockScopeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnlockScopeResponse.Merge(m, src)
+}
+func (m *MsgUnlockScopeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnlockScopeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnlockScopeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnlockScopeResponse proto.InternalMessageInfo
+
 // MsgWriteSessionRequest is the request type for the Msg/WriteSession RPC method.
 type MsgWriteSessionRequest struct {
 	// session is the Session you want added or updated.
@@ -709,7 +1118,7 @@ func (m *MsgWriteSessionRequest) Reset()         { *m = MsgWriteSessionRequest{}
 func (m *MsgWriteSessionRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgWriteSessionRequest) ProtoMessage()    {}
 func (*MsgWriteSessionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{16}
+	return fileDescriptor_3a3a0892f91e3036, []int{26}
 }
 func (m *MsgWriteSessionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -754,7 +1163,7 @@ func (m *SessionIdComponents) Reset()         { *m = SessionIdComponents{} }
 func (m *SessionIdComponents) String() string { return proto.CompactTextString(m) }
 func (*SessionIdComponents) ProtoMessage()    {}
 func (*SessionIdComponents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{17}
+	return fileDescriptor_3a3a0892f91e3036, []int{27}
 }
 func (m *SessionIdComponents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -845,7 +1254,7 @@ func (m *MsgWriteSessionResponse) Reset()         { *m = MsgWriteSessionResponse
 func (m *MsgWriteSessionResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWriteSessionResponse) ProtoMessage()    {}
 func (*MsgWriteSessionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{18}
+	return fileDescriptor_3a3a0892f91e3036, []int{28}
 }
 func (m *MsgWriteSessionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -910,7 +1319,7 @@ func (m *MsgWriteRecordRequest) Reset()         { *m = MsgWriteRecordRequest{} }
 func (m *MsgWriteRecordRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgWriteRecordRequest) ProtoMessage()    {}
 func (*MsgWriteRecordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{19}
+	return fileDescriptor_3a3a0892f91e3036, []int{29}
 }
 func (m *MsgWriteRecordRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -949,7 +1358,7 @@ func (m *MsgWriteRecordResponse) Reset()         { *m = MsgWriteRecordResponse{}
 func (m *MsgWriteRecordResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWriteRecordResponse) ProtoMessage()    {}
 func (*MsgWriteRecordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{20}
+	return fileDescriptor_3a3a0892f91e3036, []int{30}
 }
 func (m *MsgWriteRecordResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -995,7 +1404,7 @@ func (m *MsgDeleteRecordRequest) Reset()         { *m = MsgDeleteRecordRequest{}
 func (m *MsgDeleteRecordRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteRecordRequest) ProtoMessage()    {}
 func (*MsgDeleteRecordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{21}
+	return fileDescriptor_3a3a0892f91e3036, []int{31}
 }
 func (m *MsgDeleteRecordRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1032,7 +1441,7 @@ func (m *MsgDeleteRecordResponse) Reset()         { *m = MsgDeleteRecordResponse
 func (m *MsgDeleteRecordResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteRecordResponse) ProtoMessage()    {}
 func (*MsgDeleteRecordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{22}
+	return fileDescriptor_3a3a0892f91e3036, []int{32}
 }
 func (m *MsgDeleteRecordResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1061,14 +1470,126 @@ func (m *MsgDeleteRecordResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeleteRecordResponse proto.InternalMessageInfo
 
-// MsgWriteScopeSpecificationRequest is the request type for the Msg/WriteScopeSpecification RPC method.
-type MsgWriteScopeSpecificationRequest struct {
-	// specification is the ScopeSpecification you want added or updated.
-	Specification ScopeSpecification `protobuf:"bytes,1,opt,name=specification,proto3" json:"specification"`
+// MsgWriteScopeBundleRequest is the request type for the Msg/WriteScopeBundle RPC method.
+// The scope is written first, then the sessions, then the records, each validated the same way as in the
+// individual WriteScope, WriteSession and WriteRecord endpoints. If any of them is invalid, none are written.
+type MsgWriteScopeBundleRequest struct {
+	// scope is the Scope you want added or updated.
+	Scope Scope `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope"`
+	// sessions are the Sessions you want added or updated. They must all be in the scope.
+	Sessions []Session `protobuf:"bytes,2,rep,name=sessions,proto3" json:"sessions"`
+	// records are the Records you want added or updated. They must all be in the scope, and their sessions must either
+	// be in this request or already exist.
+	Records []Record `protobuf:"bytes,3,rep,name=records,proto3" json:"records"`
 	// signers is the list of address of those signing this request.
-	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
-	// spec_uuid is an optional scope specification uuid string, e.g. "dc83ea70-eacd-40fe-9adf-1cf6148bf8a2"
-	// If provided, it will be used to generate the MetadataAddress for the scope specification which will override the
+	Signers []string `protobuf:"bytes,4,rep,name=signers,proto3" json:"signers,omitempty"`
+}
+
+func (m *MsgWriteScopeBundleRequest) Reset()         { *m = MsgWriteScopeBundleRequest{} }
+func (m *MsgWriteScopeBundleRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgWriteScopeBundleRequest) ProtoMessage()    {}
+func (*MsgWriteScopeBundleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{33}
+}
+func (m *MsgWriteScopeBundleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWriteScopeBundleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWriteScopeBundleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWriteScopeBundleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteScopeBundleRequest.Merge(m, src)
+}
+func (m *MsgWriteScopeBundleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWriteScopeBundleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteScopeBundleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWriteScopeBundleRequest proto.InternalMessageInfo
+
+// MsgWriteScopeBundleResponse is the response type for the Msg/WriteScopeBundle RPC method.
+type MsgWriteScopeBundleResponse struct {
+	// scope_id_info contains information about the id/address of the scope that was added or updated.
+	ScopeIdInfo *ScopeIdInfo `protobuf:"bytes,1,opt,name=scope_id_info,json=scopeIdInfo,proto3" json:"scope_id_info,omitempty" yaml:"scope_id_info"`
+	// session_id_infos contains information about the ids/addresses of the sessions that were added or updated.
+	SessionIdInfos []*SessionIdInfo `protobuf:"bytes,2,rep,name=session_id_infos,json=sessionIdInfos,proto3" json:"session_id_infos,omitempty" yaml:"session_id_infos"`
+	// record_id_infos contains information about the ids/addresses of the records that were added or updated.
+	RecordIdInfos []*RecordIdInfo `protobuf:"bytes,3,rep,name=record_id_infos,json=recordIdInfos,proto3" json:"record_id_infos,omitempty" yaml:"record_id_infos"`
+}
+
+func (m *MsgWriteScopeBundleResponse) Reset()         { *m = MsgWriteScopeBundleResponse{} }
+func (m *MsgWriteScopeBundleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWriteScopeBundleResponse) ProtoMessage()    {}
+func (*MsgWriteScopeBundleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{34}
+}
+func (m *MsgWriteScopeBundleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWriteScopeBundleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWriteScopeBundleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWriteScopeBundleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteScopeBundleResponse.Merge(m, src)
+}
+func (m *MsgWriteScopeBundleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWriteScopeBundleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteScopeBundleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWriteScopeBundleResponse proto.InternalMessageInfo
+
+func (m *MsgWriteScopeBundleResponse) GetScopeIdInfo() *ScopeIdInfo {
+	if m != nil {
+		return m.ScopeIdInfo
+	}
+	return nil
+}
+
+func (m *MsgWriteScopeBundleResponse) GetSessionIdInfos() []*SessionIdInfo {
+	if m != nil {
+		return m.SessionIdInfos
+	}
+	return nil
+}
+
+func (m *MsgWriteScopeBundleResponse) GetRecordIdInfos() []*RecordIdInfo {
+	if m != nil {
+		return m.RecordIdInfos
+	}
+	return nil
+}
+
+// MsgWriteScopeSpecificationRequest is the request type for the Msg/WriteScopeSpecification RPC method.
+type MsgWriteScopeSpecificationRequest struct {
+	// specification is the ScopeSpecification you want added or updated.
+	Specification ScopeSpecification `protobuf:"bytes,1,opt,name=specification,proto3" json:"specification"`
+	// signers is the list of address of those signing this request.
+	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+	// spec_uuid is an optional scope specification uuid string, e.g. "dc83ea70-eacd-40fe-9adf-1cf6148bf8a2"
+	// If provided, it will be used to generate the MetadataAddress for the scope specification which will override the
 	// specification_id in the provided specification. If not provided (or it is an empty string), nothing special
 	// happens.
 	// If there is a value in specification.specification_id that is different from the one created from this uuid, an
@@ -1080,7 +1601,7 @@ func (m *MsgWriteScopeSpecificationRequest) Reset()         { *m = MsgWriteScope
 func (m *MsgWriteScopeSpecificationRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgWriteScopeSpecificationRequest) ProtoMessage()    {}
 func (*MsgWriteScopeSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{23}
+	return fileDescriptor_3a3a0892f91e3036, []int{35}
 }
 func (m *MsgWriteScopeSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1119,7 +1640,7 @@ func (m *MsgWriteScopeSpecificationResponse) Reset()         { *m = MsgWriteScop
 func (m *MsgWriteScopeSpecificationResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWriteScopeSpecificationResponse) ProtoMessage()    {}
 func (*MsgWriteScopeSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{24}
+	return fileDescriptor_3a3a0892f91e3036, []int{36}
 }
 func (m *MsgWriteScopeSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1166,7 +1687,7 @@ func (m *MsgDeleteScopeSpecificationRequest) Reset()         { *m = MsgDeleteSco
 func (m *MsgDeleteScopeSpecificationRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteScopeSpecificationRequest) ProtoMessage()    {}
 func (*MsgDeleteScopeSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{25}
+	return fileDescriptor_3a3a0892f91e3036, []int{37}
 }
 func (m *MsgDeleteScopeSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1203,7 +1724,7 @@ func (m *MsgDeleteScopeSpecificationResponse) Reset()         { *m = MsgDeleteSc
 func (m *MsgDeleteScopeSpecificationResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteScopeSpecificationResponse) ProtoMessage()    {}
 func (*MsgDeleteScopeSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{26}
+	return fileDescriptor_3a3a0892f91e3036, []int{38}
 }
 func (m *MsgDeleteScopeSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1232,6 +1753,148 @@ func (m *MsgDeleteScopeSpecificationResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeleteScopeSpecificationResponse proto.InternalMessageInfo
 
+// MsgMigrateScopesToSpecRequest is the request type for the Msg/MigrateScopesToSpec RPC method.
+// It must be signed by the owners of both scope specifications, or by the governance module account.
+type MsgMigrateScopesToSpecRequest struct {
+	// from_specification_id is the id of the scope specification that the scopes are currently using.
+	FromSpecificationId MetadataAddress `protobuf:"bytes,1,opt,name=from_specification_id,json=fromSpecificationId,proto3,customtype=MetadataAddress" json:"from_specification_id" yaml:"from_specification_id"`
+	// to_specification_id is the id of the scope specification to move the scopes to.
+	ToSpecificationId MetadataAddress `protobuf:"bytes,2,opt,name=to_specification_id,json=toSpecificationId,proto3,customtype=MetadataAddress" json:"to_specification_id" yaml:"to_specification_id"`
+	// scope_ids are the ids of the scopes to move. If empty, all scopes using the from specification are moved.
+	ScopeIds []MetadataAddress `protobuf:"bytes,3,rep,name=scope_ids,json=scopeIds,proto3,customtype=MetadataAddress" json:"scope_ids" yaml:"scope_ids"`
+	// signers is the list of addresses of those signing this request.
+	Signers []string `protobuf:"bytes,4,rep,name=signers,proto3" json:"signers,omitempty"`
+}
+
+func (m *MsgMigrateScopesToSpecRequest) Reset()         { *m = MsgMigrateScopesToSpecRequest{} }
+func (m *MsgMigrateScopesToSpecRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateScopesToSpecRequest) ProtoMessage()    {}
+func (*MsgMigrateScopesToSpecRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{39}
+}
+func (m *MsgMigrateScopesToSpecRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateScopesToSpecRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateScopesToSpecRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateScopesToSpecRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateScopesToSpecRequest.Merge(m, src)
+}
+func (m *MsgMigrateScopesToSpecRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateScopesToSpecRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateScopesToSpecRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateScopesToSpecRequest proto.InternalMessageInfo
+
+// MsgMigrateScopesToSpecResponse is the response type for the Msg/MigrateScopesToSpec RPC method.
+type MsgMigrateScopesToSpecResponse struct {
+	// migrated_scope_ids are the ids of the scopes that were moved to the new specification.
+	MigratedScopeIds []MetadataAddress `protobuf:"bytes,1,rep,name=migrated_scope_ids,json=migratedScopeIds,proto3,customtype=MetadataAddress" json:"migrated_scope_ids" yaml:"migrated_scope_ids"`
+	// failures are the scopes that could not be moved and the reasons why.
+	Failures []ScopeMigrationFailure `protobuf:"bytes,2,rep,name=failures,proto3" json:"failures"`
+}
+
+func (m *MsgMigrateScopesToSpecResponse) Reset()         { *m = MsgMigrateScopesToSpecResponse{} }
+func (m *MsgMigrateScopesToSpecResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateScopesToSpecResponse) ProtoMessage()    {}
+func (*MsgMigrateScopesToSpecResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{40}
+}
+func (m *MsgMigrateScopesToSpecResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateScopesToSpecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateScopesToSpecResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateScopesToSpecResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateScopesToSpecResponse.Merge(m, src)
+}
+func (m *MsgMigrateScopesToSpecResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateScopesToSpecResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateScopesToSpecResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateScopesToSpecResponse proto.InternalMessageInfo
+
+func (m *MsgMigrateScopesToSpecResponse) GetFailures() []ScopeMigrationFailure {
+	if m != nil {
+		return m.Failures
+	}
+	return nil
+}
+
+// ScopeMigrationFailure is a scope that could not be moved to a new scope specification.
+type ScopeMigrationFailure struct {
+	// scope_id is the id of the scope that was not moved.
+	ScopeId MetadataAddress `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3,customtype=MetadataAddress" json:"scope_id" yaml:"scope_id"`
+	// reason is why the scope could not be moved.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *ScopeMigrationFailure) Reset()         { *m = ScopeMigrationFailure{} }
+func (m *ScopeMigrationFailure) String() string { return proto.CompactTextString(m) }
+func (*ScopeMigrationFailure) ProtoMessage()    {}
+func (*ScopeMigrationFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{41}
+}
+func (m *ScopeMigrationFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeMigrationFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeMigrationFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScopeMigrationFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeMigrationFailure.Merge(m, src)
+}
+func (m *ScopeMigrationFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeMigrationFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeMigrationFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScopeMigrationFailure proto.InternalMessageInfo
+
+func (m *ScopeMigrationFailure) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 // MsgWriteContractSpecificationRequest is the request type for the Msg/WriteContractSpecification RPC method.
 type MsgWriteContractSpecificationRequest struct {
 	// specification is the ContractSpecification you want added or updated.
@@ -1251,7 +1914,7 @@ func (m *MsgWriteContractSpecificationRequest) Reset()         { *m = MsgWriteCo
 func (m *MsgWriteContractSpecificationRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgWriteContractSpecificationRequest) ProtoMessage()    {}
 func (*MsgWriteContractSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{27}
+	return fileDescriptor_3a3a0892f91e3036, []int{42}
 }
 func (m *MsgWriteContractSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1291,7 +1954,7 @@ func (m *MsgWriteContractSpecificationResponse) Reset()         { *m = MsgWriteC
 func (m *MsgWriteContractSpecificationResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWriteContractSpecificationResponse) ProtoMessage()    {}
 func (*MsgWriteContractSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{28}
+	return fileDescriptor_3a3a0892f91e3036, []int{43}
 }
 func (m *MsgWriteContractSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1340,7 +2003,7 @@ func (m *MsgAddContractSpecToScopeSpecRequest) Reset()         { *m = MsgAddCont
 func (m *MsgAddContractSpecToScopeSpecRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgAddContractSpecToScopeSpecRequest) ProtoMessage()    {}
 func (*MsgAddContractSpecToScopeSpecRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{29}
+	return fileDescriptor_3a3a0892f91e3036, []int{44}
 }
 func (m *MsgAddContractSpecToScopeSpecRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1377,7 +2040,7 @@ func (m *MsgAddContractSpecToScopeSpecResponse) Reset()         { *m = MsgAddCon
 func (m *MsgAddContractSpecToScopeSpecResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAddContractSpecToScopeSpecResponse) ProtoMessage()    {}
 func (*MsgAddContractSpecToScopeSpecResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{30}
+	return fileDescriptor_3a3a0892f91e3036, []int{45}
 }
 func (m *MsgAddContractSpecToScopeSpecResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1423,7 +2086,7 @@ func (m *MsgDeleteContractSpecFromScopeSpecRequest) String() string {
 }
 func (*MsgDeleteContractSpecFromScopeSpecRequest) ProtoMessage() {}
 func (*MsgDeleteContractSpecFromScopeSpecRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{31}
+	return fileDescriptor_3a3a0892f91e3036, []int{46}
 }
 func (m *MsgDeleteContractSpecFromScopeSpecRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1465,7 +2128,7 @@ func (m *MsgDeleteContractSpecFromScopeSpecResponse) String() string {
 }
 func (*MsgDeleteContractSpecFromScopeSpecResponse) ProtoMessage() {}
 func (*MsgDeleteContractSpecFromScopeSpecResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{32}
+	return fileDescriptor_3a3a0892f91e3036, []int{47}
 }
 func (m *MsgDeleteContractSpecFromScopeSpecResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1505,7 +2168,7 @@ func (m *MsgDeleteContractSpecificationRequest) Reset()         { *m = MsgDelete
 func (m *MsgDeleteContractSpecificationRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteContractSpecificationRequest) ProtoMessage()    {}
 func (*MsgDeleteContractSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{33}
+	return fileDescriptor_3a3a0892f91e3036, []int{48}
 }
 func (m *MsgDeleteContractSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1544,7 +2207,7 @@ func (m *MsgDeleteContractSpecificationResponse) Reset() {
 func (m *MsgDeleteContractSpecificationResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteContractSpecificationResponse) ProtoMessage()    {}
 func (*MsgDeleteContractSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{34}
+	return fileDescriptor_3a3a0892f91e3036, []int{49}
 }
 func (m *MsgDeleteContractSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1592,7 +2255,7 @@ func (m *MsgWriteRecordSpecificationRequest) Reset()         { *m = MsgWriteReco
 func (m *MsgWriteRecordSpecificationRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgWriteRecordSpecificationRequest) ProtoMessage()    {}
 func (*MsgWriteRecordSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{35}
+	return fileDescriptor_3a3a0892f91e3036, []int{50}
 }
 func (m *MsgWriteRecordSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1632,7 +2295,7 @@ func (m *MsgWriteRecordSpecificationResponse) Reset()         { *m = MsgWriteRec
 func (m *MsgWriteRecordSpecificationResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWriteRecordSpecificationResponse) ProtoMessage()    {}
 func (*MsgWriteRecordSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{36}
+	return fileDescriptor_3a3a0892f91e3036, []int{51}
 }
 func (m *MsgWriteRecordSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1679,7 +2342,7 @@ func (m *MsgDeleteRecordSpecificationRequest) Reset()         { *m = MsgDeleteRe
 func (m *MsgDeleteRecordSpecificationRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteRecordSpecificationRequest) ProtoMessage()    {}
 func (*MsgDeleteRecordSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{37}
+	return fileDescriptor_3a3a0892f91e3036, []int{52}
 }
 func (m *MsgDeleteRecordSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1716,7 +2379,7 @@ func (m *MsgDeleteRecordSpecificationResponse) Reset()         { *m = MsgDeleteR
 func (m *MsgDeleteRecordSpecificationResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteRecordSpecificationResponse) ProtoMessage()    {}
 func (*MsgDeleteRecordSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{38}
+	return fileDescriptor_3a3a0892f91e3036, []int{53}
 }
 func (m *MsgDeleteRecordSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1755,7 +2418,7 @@ func (m *MsgBindOSLocatorRequest) Reset()         { *m = MsgBindOSLocatorRequest
 func (m *MsgBindOSLocatorRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgBindOSLocatorRequest) ProtoMessage()    {}
 func (*MsgBindOSLocatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{39}
+	return fileDescriptor_3a3a0892f91e3036, []int{54}
 }
 func (m *MsgBindOSLocatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1793,7 +2456,7 @@ func (m *MsgBindOSLocatorResponse) Reset()         { *m = MsgBindOSLocatorRespon
 func (m *MsgBindOSLocatorResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgBindOSLocatorResponse) ProtoMessage()    {}
 func (*MsgBindOSLocatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{40}
+	return fileDescriptor_3a3a0892f91e3036, []int{55}
 }
 func (m *MsgBindOSLocatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1839,7 +2502,7 @@ func (m *MsgDeleteOSLocatorRequest) Reset()         { *m = MsgDeleteOSLocatorReq
 func (m *MsgDeleteOSLocatorRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteOSLocatorRequest) ProtoMessage()    {}
 func (*MsgDeleteOSLocatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{41}
+	return fileDescriptor_3a3a0892f91e3036, []int{56}
 }
 func (m *MsgDeleteOSLocatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1877,7 +2540,7 @@ func (m *MsgDeleteOSLocatorResponse) Reset()         { *m = MsgDeleteOSLocatorRe
 func (m *MsgDeleteOSLocatorResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteOSLocatorResponse) ProtoMessage()    {}
 func (*MsgDeleteOSLocatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{42}
+	return fileDescriptor_3a3a0892f91e3036, []int{57}
 }
 func (m *MsgDeleteOSLocatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1923,7 +2586,7 @@ func (m *MsgModifyOSLocatorRequest) Reset()         { *m = MsgModifyOSLocatorReq
 func (m *MsgModifyOSLocatorRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgModifyOSLocatorRequest) ProtoMessage()    {}
 func (*MsgModifyOSLocatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{43}
+	return fileDescriptor_3a3a0892f91e3036, []int{58}
 }
 func (m *MsgModifyOSLocatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1961,7 +2624,7 @@ func (m *MsgModifyOSLocatorResponse) Reset()         { *m = MsgModifyOSLocatorRe
 func (m *MsgModifyOSLocatorResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgModifyOSLocatorResponse) ProtoMessage()    {}
 func (*MsgModifyOSLocatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{44}
+	return fileDescriptor_3a3a0892f91e3036, []int{59}
 }
 func (m *MsgModifyOSLocatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2012,7 +2675,7 @@ func (m *MsgSetAccountDataRequest) Reset()         { *m = MsgSetAccountDataReque
 func (m *MsgSetAccountDataRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgSetAccountDataRequest) ProtoMessage()    {}
 func (*MsgSetAccountDataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{45}
+	return fileDescriptor_3a3a0892f91e3036, []int{60}
 }
 func (m *MsgSetAccountDataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2049,7 +2712,7 @@ func (m *MsgSetAccountDataResponse) Reset()         { *m = MsgSetAccountDataResp
 func (m *MsgSetAccountDataResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSetAccountDataResponse) ProtoMessage()    {}
 func (*MsgSetAccountDataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{46}
+	return fileDescriptor_3a3a0892f91e3036, []int{61}
 }
 func (m *MsgSetAccountDataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2078,6 +2741,84 @@ func (m *MsgSetAccountDataResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetAccountDataResponse proto.InternalMessageInfo
 
+// MsgUpdateParamsRequest is a request message for the UpdateParams endpoint.
+type MsgUpdateParamsRequest struct {
+	// authority should be the governance module account address.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params are the new param values to set.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParamsRequest) Reset()         { *m = MsgUpdateParamsRequest{} }
+func (m *MsgUpdateParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsRequest) ProtoMessage()    {}
+func (*MsgUpdateParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{62}
+}
+func (m *MsgUpdateParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsRequest.Merge(m, src)
+}
+func (m *MsgUpdateParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsRequest proto.InternalMessageInfo
+
+// MsgUpdateParamsResponse is a response message for the UpdateParams endpoint.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{63}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 // MsgWriteP8eContractSpecRequest has been deprecated and is no longer usable.
 // Deprecated: This message is no longer part of any endpoint and cannot be used for anything.
 //
@@ -2091,7 +2832,7 @@ func (m *MsgWriteP8EContractSpecRequest) Reset()         { *m = MsgWriteP8EContr
 func (m *MsgWriteP8EContractSpecRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgWriteP8EContractSpecRequest) ProtoMessage()    {}
 func (*MsgWriteP8EContractSpecRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{47}
+	return fileDescriptor_3a3a0892f91e3036, []int{64}
 }
 func (m *MsgWriteP8EContractSpecRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2133,7 +2874,7 @@ func (m *MsgWriteP8EContractSpecResponse) Reset()         { *m = MsgWriteP8ECont
 func (m *MsgWriteP8EContractSpecResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWriteP8EContractSpecResponse) ProtoMessage()    {}
 func (*MsgWriteP8EContractSpecResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{48}
+	return fileDescriptor_3a3a0892f91e3036, []int{65}
 }
 func (m *MsgWriteP8EContractSpecResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2180,7 +2921,7 @@ func (m *MsgP8EMemorializeContractRequest) Reset()         { *m = MsgP8EMemorial
 func (m *MsgP8EMemorializeContractRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgP8EMemorializeContractRequest) ProtoMessage()    {}
 func (*MsgP8EMemorializeContractRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{49}
+	return fileDescriptor_3a3a0892f91e3036, []int{66}
 }
 func (m *MsgP8EMemorializeContractRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2223,7 +2964,7 @@ func (m *MsgP8EMemorializeContractResponse) Reset()         { *m = MsgP8EMemoria
 func (m *MsgP8EMemorializeContractResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgP8EMemorializeContractResponse) ProtoMessage()    {}
 func (*MsgP8EMemorializeContractResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{50}
+	return fileDescriptor_3a3a0892f91e3036, []int{67}
 }
 func (m *MsgP8EMemorializeContractResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2269,6 +3010,16 @@ func init() {
 	proto.RegisterType((*MsgUpdateValueOwnersResponse)(nil), "provenance.metadata.v1.MsgUpdateValueOwnersResponse")
 	proto.RegisterType((*MsgMigrateValueOwnerRequest)(nil), "provenance.metadata.v1.MsgMigrateValueOwnerRequest")
 	proto.RegisterType((*MsgMigrateValueOwnerResponse)(nil), "provenance.metadata.v1.MsgMigrateValueOwnerResponse")
+	proto.RegisterType((*MsgListScopeForSaleRequest)(nil), "provenance.metadata.v1.MsgListScopeForSaleRequest")
+	proto.RegisterType((*MsgListScopeForSaleResponse)(nil), "provenance.metadata.v1.MsgListScopeForSaleResponse")
+	proto.RegisterType((*MsgCancelScopeListingRequest)(nil), "provenance.metadata.v1.MsgCancelScopeListingRequest")
+	proto.RegisterType((*MsgCancelScopeListingResponse)(nil), "provenance.metadata.v1.MsgCancelScopeListingResponse")
+	proto.RegisterType((*MsgBuyScopeRequest)(nil), "provenance.metadata.v1.MsgBuyScopeRequest")
+	proto.RegisterType((*MsgBuyScopeResponse)(nil), "provenance.metadata.v1.MsgBuyScopeResponse")
+	proto.RegisterType((*MsgLockScopeRequest)(nil), "provenance.metadata.v1.MsgLockScopeRequest")
+	proto.RegisterType((*MsgLockScopeResponse)(nil), "provenance.metadata.v1.MsgLockScopeResponse")
+	proto.RegisterType((*MsgUnlockScopeRequest)(nil), "provenance.metadata.v1.MsgUnlockScopeRequest")
+	proto.RegisterType((*MsgUnlockScopeResponse)(nil), "provenance.metadata.v1.MsgUnlockScopeResponse")
 	proto.RegisterType((*MsgWriteSessionRequest)(nil), "provenance.metadata.v1.MsgWriteSessionRequest")
 	proto.RegisterType((*SessionIdComponents)(nil), "provenance.metadata.v1.SessionIdComponents")
 	proto.RegisterType((*MsgWriteSessionResponse)(nil), "provenance.metadata.v1.MsgWriteSessionResponse")
@@ -2276,10 +3027,15 @@ func init() {
 	proto.RegisterType((*MsgWriteRecordResponse)(nil), "provenance.metadata.v1.MsgWriteRecordResponse")
 	proto.RegisterType((*MsgDeleteRecordRequest)(nil), "provenance.metadata.v1.MsgDeleteRecordRequest")
 	proto.RegisterType((*MsgDeleteRecordResponse)(nil), "provenance.metadata.v1.MsgDeleteRecordResponse")
+	proto.RegisterType((*MsgWriteScopeBundleRequest)(nil), "provenance.metadata.v1.MsgWriteScopeBundleRequest")
+	proto.RegisterType((*MsgWriteScopeBundleResponse)(nil), "provenance.metadata.v1.MsgWriteScopeBundleResponse")
 	proto.RegisterType((*MsgWriteScopeSpecificationRequest)(nil), "provenance.metadata.v1.MsgWriteScopeSpecificationRequest")
 	proto.RegisterType((*MsgWriteScopeSpecificationResponse)(nil), "provenance.metadata.v1.MsgWriteScopeSpecificationResponse")
 	proto.RegisterType((*MsgDeleteScopeSpecificationRequest)(nil), "provenance.metadata.v1.MsgDeleteScopeSpecificationRequest")
 	proto.RegisterType((*MsgDeleteScopeSpecificationResponse)(nil), "provenance.metadata.v1.MsgDeleteScopeSpecificationResponse")
+	proto.RegisterType((*MsgMigrateScopesToSpecRequest)(nil), "provenance.metadata.v1.MsgMigrateScopesToSpecRequest")
+	proto.RegisterType((*MsgMigrateScopesToSpecResponse)(nil), "provenance.metadata.v1.MsgMigrateScopesToSpecResponse")
+	proto.RegisterType((*ScopeMigrationFailure)(nil), "provenance.metadata.v1.ScopeMigrationFailure")
 	proto.RegisterType((*MsgWriteContractSpecificationRequest)(nil), "provenance.metadata.v1.MsgWriteContractSpecificationRequest")
 	proto.RegisterType((*MsgWriteContractSpecificationResponse)(nil), "provenance.metadata.v1.MsgWriteContractSpecificationResponse")
 	proto.RegisterType((*MsgAddContractSpecToScopeSpecRequest)(nil), "provenance.metadata.v1.MsgAddContractSpecToScopeSpecRequest")
@@ -2300,6 +3056,8 @@ func init() {
 	proto.RegisterType((*MsgModifyOSLocatorResponse)(nil), "provenance.metadata.v1.MsgModifyOSLocatorResponse")
 	proto.RegisterType((*MsgSetAccountDataRequest)(nil), "provenance.metadata.v1.MsgSetAccountDataRequest")
 	proto.RegisterType((*MsgSetAccountDataResponse)(nil), "provenance.metadata.v1.MsgSetAccountDataResponse")
+	proto.RegisterType((*MsgUpdateParamsRequest)(nil), "provenance.metadata.v1.MsgUpdateParamsRequest")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "provenance.metadata.v1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgWriteP8EContractSpecRequest)(nil), "provenance.metadata.v1.MsgWriteP8eContractSpecRequest")
 	proto.RegisterType((*MsgWriteP8EContractSpecResponse)(nil), "provenance.metadata.v1.MsgWriteP8eContractSpecResponse")
 	proto.RegisterType((*MsgP8EMemorializeContractRequest)(nil), "provenance.metadata.v1.MsgP8eMemorializeContractRequest")
@@ -2309,147 +3067,188 @@ func init() {
 func init() { proto.RegisterFile("provenance/metadata/v1/tx.proto", fileDescriptor_3a3a0892f91e3036) }
 
 var fileDescriptor_3a3a0892f91e3036 = []byte{
-	// 2233 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xdd, 0x6f, 0x1c, 0x57,
-	0x15, 0xf7, 0x5d, 0x37, 0xb6, 0xf7, 0xd8, 0xae, 0xed, 0xeb, 0xaf, 0xf5, 0xa4, 0xd9, 0x31, 0xd3,
-	0xa4, 0x75, 0x9d, 0xc6, 0x8b, 0x5d, 0x43, 0x5d, 0x37, 0x01, 0xbc, 0x0d, 0x28, 0x86, 0x9a, 0x44,
-	0xe3, 0x7e, 0x08, 0x24, 0x64, 0x4d, 0x76, 0xae, 0x37, 0x43, 0xed, 0x9d, 0xed, 0xcc, 0xac, 0xf3,
-	0x81, 0xa0, 0xaa, 0x84, 0x10, 0xaa, 0x84, 0x54, 0x09, 0xa9, 0xa2, 0x12, 0x42, 0x79, 0x42, 0x91,
-	0x80, 0x3e, 0x22, 0xfe, 0x02, 0x54, 0xde, 0xf2, 0x02, 0x42, 0x08, 0x2d, 0x28, 0x79, 0x81, 0xd7,
-	0xfd, 0x03, 0x10, 0x9a, 0x7b, 0xef, 0xcc, 0xdc, 0xbb, 0x3b, 0x5f, 0xeb, 0x24, 0x56, 0x90, 0x78,
-	0x88, 0x94, 0xd9, 0x39, 0x5f, 0xbf, 0x73, 0xcf, 0x3d, 0xf7, 0xcc, 0xef, 0x1a, 0xd4, 0xa6, 0x63,
-	0x1f, 0x91, 0x86, 0xd1, 0xa8, 0x91, 0xca, 0x21, 0xf1, 0x0c, 0xd3, 0xf0, 0x8c, 0xca, 0xd1, 0x6a,
-	0xc5, 0xbb, 0xb5, 0xd2, 0x74, 0x6c, 0xcf, 0xc6, 0x73, 0x91, 0xc0, 0x4a, 0x20, 0xb0, 0x72, 0xb4,
-	0xaa, 0xcc, 0xd4, 0xed, 0xba, 0x4d, 0x45, 0x2a, 0xfe, 0xff, 0x98, 0xb4, 0x72, 0x2e, 0xc1, 0x5c,
-	0xa8, 0xc9, 0xc4, 0x96, 0x12, 0xc4, 0xec, 0xeb, 0xdf, 0x27, 0x35, 0xcf, 0xf5, 0x6c, 0x87, 0x70,
-	0xc9, 0xb3, 0x09, 0x92, 0xcd, 0x0d, 0xe2, 0xff, 0xe3, 0x52, 0x5a, 0x82, 0x94, 0x5b, 0xb3, 0x9b,
-	0x81, 0xcc, 0x72, 0x92, 0x4c, 0x93, 0xd4, 0xac, 0x7d, 0xab, 0x66, 0x78, 0x96, 0xdd, 0x60, 0xb2,
-	0xda, 0x03, 0x04, 0x33, 0x3b, 0x6e, 0xfd, 0x5d, 0xc7, 0xf2, 0xc8, 0xae, 0x6f, 0x43, 0x27, 0xef,
-	0xb7, 0x88, 0xeb, 0xe1, 0xd7, 0xe0, 0x14, 0xb5, 0x59, 0x42, 0x8b, 0x68, 0x69, 0x74, 0xed, 0xcc,
-	0x4a, 0x7c, 0x76, 0x56, 0xa8, 0x52, 0xf5, 0x99, 0xcf, 0xdb, 0xea, 0x80, 0xce, 0x34, 0x70, 0x09,
-	0x86, 0x5d, 0xab, 0xde, 0x20, 0x8e, 0x5b, 0x2a, 0x2c, 0x0e, 0x2e, 0x15, 0xf5, 0xe0, 0x11, 0xaf,
-	0x03, 0x50, 0x91, 0xbd, 0x56, 0xcb, 0x32, 0x4b, 0x83, 0x8b, 0x68, 0xa9, 0x58, 0x9d, 0xed, 0xb4,
-	0xd5, 0xa9, 0xdb, 0xc6, 0xe1, 0xc1, 0xa6, 0x16, 0xbd, 0xd3, 0xf4, 0x22, 0x7d, 0x78, 0xbb, 0x65,
-	0x99, 0x78, 0x15, 0x8a, 0x7e, 0xe8, 0x4c, 0xe9, 0x19, 0xaa, 0x34, 0xd3, 0x69, 0xab, 0x93, 0x5c,
-	0x29, 0x78, 0xa5, 0xe9, 0x23, 0xfe, 0xff, 0x7d, 0x95, 0xcd, 0xb1, 0x9f, 0xde, 0x55, 0x07, 0x7e,
-	0x71, 0x57, 0x45, 0xff, 0xba, 0xab, 0x0e, 0x68, 0x77, 0x60, 0xb6, 0x0b, 0xa3, 0xdb, 0xb4, 0x1b,
-	0x2e, 0xc1, 0x06, 0x8c, 0x33, 0x9f, 0x96, 0xb9, 0x67, 0x35, 0xf6, 0x6d, 0x0e, 0xf6, 0xf9, 0x54,
-	0xb0, 0xdb, 0xe6, 0x76, 0x63, 0xdf, 0xae, 0x96, 0x3a, 0x6d, 0x75, 0x46, 0x8c, 0x9b, 0xdb, 0xd0,
-	0xf4, 0x51, 0x37, 0x12, 0xd3, 0x7e, 0x82, 0xa8, 0xf3, 0xcb, 0xe4, 0x80, 0x74, 0x65, 0xf8, 0xeb,
-	0x30, 0x12, 0x28, 0x52, 0xbf, 0x63, 0xd5, 0x65, 0x3f, 0x8b, 0x7f, 0x6b, 0xab, 0x13, 0x3b, 0xdc,
-	0xe7, 0x96, 0x69, 0x3a, 0xc4, 0x75, 0x3b, 0x6d, 0x75, 0x42, 0xf6, 0xa4, 0xe9, 0xc3, 0xdc, 0x49,
-	0x72, 0xb6, 0xbb, 0x92, 0x50, 0x82, 0xb9, 0xee, 0x38, 0x58, 0x16, 0xb4, 0x3f, 0x22, 0x78, 0x6e,
-	0xc7, 0xad, 0x6f, 0x99, 0x26, 0xfd, 0xfd, 0xb2, 0xef, 0xb8, 0x56, 0x23, 0xae, 0xfb, 0x98, 0x23,
-	0x7d, 0x15, 0x46, 0x7d, 0xd1, 0x3d, 0x83, 0x1a, 0x67, 0xd1, 0x56, 0xe7, 0x3a, 0x6d, 0x15, 0x33,
-	0x15, 0xe1, 0xa5, 0xa6, 0x83, 0x19, 0x86, 0x21, 0x42, 0x1c, 0x4c, 0x83, 0xa8, 0xc2, 0x99, 0x04,
-	0x1c, 0x1c, 0xe9, 0x9f, 0x10, 0xa8, 0x72, 0x12, 0xfe, 0x77, 0xc1, 0x6a, 0xb0, 0x98, 0x0c, 0x85,
-	0xe3, 0xfd, 0x0b, 0x82, 0x79, 0x21, 0x23, 0x57, 0x6f, 0x36, 0x88, 0xf3, 0x98, 0x71, 0xbe, 0x09,
-	0x43, 0xf6, 0xcd, 0xb0, 0xfa, 0x52, 0x1a, 0xc5, 0x35, 0xc3, 0xf1, 0x6e, 0x57, 0x67, 0x7d, 0x1f,
-	0x9d, 0xb6, 0x3a, 0xce, 0x0c, 0x32, 0x55, 0x4d, 0xe7, 0x36, 0x72, 0x83, 0x57, 0xa0, 0xd4, 0x8b,
-	0x8b, 0x83, 0xfe, 0x3d, 0x02, 0x45, 0xce, 0xcc, 0x93, 0xc0, 0xfd, 0x92, 0x84, 0xbb, 0x58, 0x9d,
-	0x7a, 0x74, 0x50, 0x67, 0xe0, 0x74, 0x6c, 0xdc, 0x1c, 0xd7, 0x3f, 0x10, 0x7d, 0xff, 0x76, 0xd3,
-	0x34, 0x3c, 0xf2, 0x8e, 0x71, 0xd0, 0x62, 0xef, 0xc3, 0xc2, 0xbd, 0x02, 0xc5, 0x20, 0x4e, 0xb7,
-	0x84, 0x16, 0x07, 0x97, 0xc6, 0xaa, 0xe7, 0x93, 0x91, 0x4d, 0xca, 0xc8, 0x5c, 0xbf, 0x7b, 0x32,
-	0x68, 0x2e, 0xfe, 0x36, 0x4c, 0x1f, 0xf9, 0xf6, 0xf7, 0x28, 0x80, 0x3d, 0x83, 0x29, 0x95, 0x0a,
-	0xb4, 0xf5, 0x96, 0x3b, 0x6d, 0x55, 0x61, 0xca, 0x31, 0x42, 0x9a, 0x3e, 0x75, 0x14, 0x86, 0xc6,
-	0xbd, 0xe5, 0x4e, 0x40, 0x99, 0xf6, 0xa1, 0x18, 0x80, 0x3c, 0x03, 0x3f, 0xa4, 0x09, 0xd8, 0xb1,
-	0xea, 0x8e, 0x24, 0x10, 0x24, 0x40, 0x81, 0x11, 0x72, 0xcb, 0x72, 0x3d, 0xab, 0x51, 0xa7, 0x2b,
-	0x5b, 0xd4, 0xc3, 0x67, 0xff, 0x5d, 0xd3, 0xb1, 0x9b, 0xb6, 0x4b, 0x4c, 0x86, 0x43, 0x0f, 0x9f,
-	0xfb, 0x0c, 0x2f, 0xc6, 0x3d, 0x0f, 0xef, 0x0f, 0x05, 0xda, 0x62, 0xd9, 0x39, 0x43, 0x5c, 0xd7,
-	0xb2, 0x1b, 0x41, 0x68, 0x5f, 0x85, 0x61, 0x97, 0xfd, 0xc2, 0x8f, 0x18, 0x35, 0xf1, 0x88, 0x61,
-	0x62, 0xfc, 0x44, 0x0d, 0xb4, 0x52, 0xce, 0xd4, 0x0f, 0x11, 0xcc, 0x72, 0x29, 0xff, 0x08, 0xaa,
-	0xd9, 0x87, 0x4d, 0xbb, 0x41, 0x1a, 0x9e, 0x4b, 0xcf, 0xd7, 0xd1, 0xb5, 0xf3, 0x19, 0x9e, 0xb6,
-	0xcd, 0x37, 0x42, 0x95, 0xea, 0x62, 0xa7, 0xad, 0x3e, 0xc7, 0x2b, 0x23, 0xce, 0xa6, 0xa6, 0x4f,
-	0xbb, 0xbd, 0x6a, 0x8f, 0x7e, 0x42, 0xff, 0x19, 0xc1, 0x74, 0x4c, 0x3c, 0xf8, 0xcb, 0xd2, 0xc0,
-	0x80, 0x52, 0x06, 0x86, 0x2b, 0x03, 0xe2, 0xc8, 0x10, 0xea, 0xf9, 0x65, 0xc9, 0x0b, 0xb7, 0x47,
-	0xcf, 0x7f, 0x17, 0xe9, 0xf9, 0xc5, 0x8a, 0x37, 0x61, 0x2c, 0xc0, 0x2d, 0x8c, 0x28, 0xf3, 0x9d,
-	0xb6, 0x3a, 0x2d, 0x67, 0x85, 0xc1, 0x19, 0xe5, 0x8f, 0xbe, 0xcf, 0x2a, 0x86, 0xc9, 0x60, 0x37,
-	0x91, 0x86, 0x67, 0xed, 0x5b, 0xc4, 0xd1, 0x7e, 0xcc, 0x1a, 0xb0, 0x5c, 0x12, 0x7c, 0xf8, 0xb0,
-	0x60, 0x42, 0xc8, 0xb1, 0x30, 0x7e, 0x9c, 0xcb, 0x5c, 0x31, 0x3a, 0x80, 0x28, 0x9d, 0xb6, 0x3a,
-	0xd7, 0xb3, 0x56, 0x6c, 0x04, 0x19, 0x77, 0x45, 0x51, 0xed, 0x67, 0x83, 0xd1, 0x04, 0xa4, 0x93,
-	0x9a, 0xed, 0x98, 0x41, 0x61, 0x5e, 0x84, 0x21, 0x87, 0xfe, 0xc0, 0x7d, 0x97, 0x93, 0x7c, 0x33,
-	0x35, 0x5e, 0x96, 0x5c, 0xe7, 0x29, 0xaf, 0xca, 0x6f, 0x01, 0xae, 0xd9, 0x0d, 0xcf, 0x31, 0x6a,
-	0xde, 0x5e, 0x77, 0x79, 0x9e, 0xe9, 0xb4, 0xd5, 0x05, 0x66, 0xb2, 0x57, 0x46, 0xd3, 0x27, 0x83,
-	0x1f, 0x77, 0x79, 0xbd, 0xe2, 0x4b, 0x30, 0xdc, 0x34, 0x1c, 0xcf, 0x22, 0x6e, 0xe9, 0x54, 0x9e,
-	0x83, 0x8e, 0xef, 0x5f, 0xae, 0xd3, 0x55, 0xee, 0x1f, 0x44, 0x8d, 0x22, 0x58, 0x0e, 0x5e, 0x14,
-	0x04, 0x9e, 0x65, 0xb9, 0xed, 0xaa, 0x89, 0xb3, 0xe9, 0xeb, 0xc2, 0x4b, 0x62, 0xa1, 0xd3, 0x56,
-	0x67, 0x19, 0x2a, 0xd9, 0x8a, 0xa6, 0x8f, 0x39, 0x82, 0xa0, 0xf6, 0x11, 0x12, 0xa6, 0x41, 0xb9,
-	0x22, 0xae, 0x40, 0x31, 0xd4, 0xe5, 0x07, 0x64, 0xf6, 0x31, 0x12, 0x6a, 0x68, 0xfa, 0x48, 0xe0,
-	0x28, 0xf7, 0x64, 0xba, 0x40, 0xf7, 0x88, 0x1c, 0x4b, 0x34, 0xc0, 0x7c, 0x41, 0x1a, 0xdd, 0x77,
-	0xc5, 0x6f, 0x98, 0x20, 0xe4, 0x77, 0x60, 0x5c, 0xfa, 0xb6, 0xe1, 0x39, 0x5b, 0x4e, 0x1d, 0xe3,
-	0x25, 0x4b, 0x7c, 0xb9, 0x64, 0x33, 0x29, 0xe5, 0x2d, 0x35, 0xbc, 0xc1, 0x63, 0x34, 0xbc, 0x4f,
-	0x11, 0x68, 0x69, 0xc0, 0x78, 0x39, 0xb8, 0x80, 0x59, 0x4f, 0xa1, 0x26, 0xe5, 0x92, 0x78, 0x31,
-	0x13, 0x1e, 0xaf, 0x0a, 0xa1, 0xd6, 0x7b, 0x8d, 0x69, 0xfa, 0x84, 0x2b, 0xcb, 0x6b, 0xbf, 0x61,
-	0xb1, 0x09, 0x83, 0x48, 0x6c, 0xd6, 0xbf, 0x07, 0x93, 0x52, 0xba, 0xa2, 0x7a, 0x59, 0x4b, 0xae,
-	0x97, 0xf9, 0x28, 0x43, 0xa2, 0xa2, 0x1f, 0x85, 0xf8, 0x53, 0x1f, 0xd5, 0x73, 0x0e, 0x9e, 0x4f,
-	0x0d, 0x96, 0x57, 0xd2, 0xdf, 0x11, 0x9c, 0x0d, 0x12, 0xfe, 0x86, 0xb0, 0xb9, 0x7b, 0x60, 0x7d,
-	0x27, 0xbe, 0x98, 0x2e, 0x24, 0x65, 0x3b, 0xd6, 0xd8, 0x89, 0xd7, 0xd3, 0x3d, 0x04, 0xe7, 0x32,
-	0xe0, 0xf1, 0x92, 0xfa, 0x00, 0x66, 0xe5, 0x8e, 0x27, 0x57, 0xd5, 0x72, 0x1e, 0x9c, 0xbc, 0xb0,
-	0x84, 0xbe, 0x1c, 0x6b, 0x52, 0xd3, 0x71, 0xad, 0x47, 0x4b, 0xbb, 0x57, 0xa0, 0x2b, 0xb1, 0x65,
-	0x9a, 0xa2, 0xc9, 0xb7, 0xec, 0x70, 0xf1, 0x82, 0x95, 0x68, 0xc0, 0x82, 0x64, 0xf6, 0x31, 0x55,
-	0xda, 0x7c, 0x2d, 0x2e, 0x3f, 0xdb, 0x26, 0xbe, 0x01, 0x73, 0xd1, 0xfe, 0x90, 0x9c, 0x15, 0x8e,
-	0xed, 0x6c, 0xc6, 0xed, 0x29, 0xc9, 0xed, 0xfc, 0x13, 0xe7, 0x8b, 0x74, 0x51, 0xd3, 0x32, 0xc5,
-	0xab, 0xfb, 0x77, 0x05, 0x78, 0x29, 0xdc, 0x05, 0xa2, 0xf0, 0x37, 0x1c, 0xfb, 0xf0, 0xff, 0x89,
-	0xed, 0x49, 0xec, 0xcb, 0xb0, 0x9c, 0x27, 0x5d, 0x3c, 0xbb, 0x9f, 0xb1, 0xcd, 0xd5, 0x2b, 0xfe,
-	0xb4, 0xf6, 0xc4, 0x25, 0x78, 0x21, 0x2b, 0x5e, 0x0e, 0xad, 0x23, 0x9c, 0x43, 0xec, 0xec, 0x8d,
-	0xc5, 0xf5, 0x6e, 0x7c, 0x53, 0x3c, 0x9f, 0x3e, 0x95, 0x3c, 0x52, 0x4b, 0x8c, 0x9f, 0xde, 0x06,
-	0x8f, 0x35, 0xbd, 0x75, 0xa5, 0xe7, 0x57, 0x88, 0x9e, 0x19, 0xc9, 0xa0, 0x79, 0xab, 0xbc, 0x09,
-	0xd3, 0x7c, 0xb0, 0x89, 0x69, 0x94, 0x4b, 0xd9, 0xd8, 0x79, 0x9b, 0x14, 0xbe, 0x98, 0x63, 0xcc,
-	0x69, 0xfa, 0xa4, 0xd3, 0xa5, 0xa1, 0xfd, 0x16, 0x09, 0x87, 0x5a, 0xca, 0xb2, 0x3c, 0x25, 0xe5,
-	0xf6, 0x02, 0x6d, 0xe8, 0x29, 0xd1, 0xf2, 0x62, 0xb3, 0xe9, 0xa0, 0x57, 0xb5, 0x1a, 0xe6, 0xd5,
-	0xdd, 0x37, 0xed, 0x9a, 0xe1, 0xd9, 0xe1, 0xb7, 0xfb, 0x37, 0x61, 0xf8, 0x80, 0xfd, 0x92, 0x75,
-	0x0e, 0x5d, 0xa5, 0xcc, 0xf9, 0xae, 0x67, 0x3b, 0x84, 0xdb, 0x08, 0x66, 0x6d, 0x6e, 0x60, 0x73,
-	0xc4, 0x0f, 0x8e, 0x06, 0xb6, 0x4f, 0x69, 0xa2, 0x2e, 0x87, 0x7c, 0x71, 0x1f, 0xa3, 0x47, 0xed,
-	0x7d, 0x58, 0x08, 0x13, 0x70, 0x42, 0xd0, 0x6e, 0x08, 0x24, 0xd7, 0x49, 0x80, 0xdb, 0xb1, 0x4d,
-	0x6b, 0xff, 0xf6, 0x89, 0x82, 0xeb, 0x71, 0xf9, 0x04, 0xc0, 0x7d, 0x86, 0x68, 0x89, 0xec, 0x12,
-	0x6f, 0xab, 0x56, 0xb3, 0x5b, 0x0d, 0xef, 0xb2, 0xe1, 0x19, 0x01, 0xb8, 0xb7, 0x60, 0x3c, 0xb0,
-	0xc6, 0x88, 0x04, 0xb6, 0xb7, 0x2a, 0xc9, 0x7b, 0x8b, 0x5f, 0x08, 0x48, 0x5a, 0x9a, 0x3e, 0x76,
-	0x28, 0x08, 0xe2, 0x19, 0x38, 0x45, 0x29, 0x32, 0xce, 0x43, 0xb1, 0x87, 0xdc, 0x27, 0xd7, 0x69,
-	0xba, 0x1a, 0xdd, 0xf1, 0xf2, 0x0d, 0xf6, 0x09, 0x82, 0x72, 0xd0, 0xd8, 0xae, 0x6d, 0x48, 0xad,
-	0x3f, 0xc0, 0xa4, 0xc3, 0x58, 0xd0, 0x1d, 0xfd, 0xed, 0x9e, 0xd5, 0xcc, 0x9a, 0x1b, 0x44, 0x9a,
-	0xfc, 0x78, 0xfe, 0x24, 0x1b, 0x29, 0x7d, 0x62, 0xc8, 0x8f, 0xbd, 0x84, 0xb4, 0x5f, 0x17, 0x28,
-	0xf1, 0x1e, 0x1f, 0xd8, 0x53, 0x32, 0x98, 0xe2, 0x3b, 0x30, 0x13, 0xd3, 0x9f, 0x03, 0x62, 0x3b,
-	0x7f, 0xbf, 0x57, 0x3b, 0x6d, 0xf5, 0x74, 0x62, 0xbf, 0x77, 0x35, 0x7d, 0xaa, 0xbb, 0xe1, 0x47,
-	0x89, 0xfa, 0x4f, 0x81, 0xd2, 0xfa, 0xd7, 0x36, 0xc8, 0x0e, 0x39, 0xb4, 0x1d, 0xcb, 0x38, 0xb0,
-	0xee, 0x84, 0xe9, 0x0a, 0xd6, 0x70, 0xa1, 0x8b, 0xc2, 0x2e, 0x46, 0xb4, 0xf4, 0x02, 0x8c, 0xd4,
-	0x1d, 0xbb, 0xd5, 0x0c, 0x86, 0xab, 0xa2, 0x3e, 0x4c, 0x9f, 0xb7, 0x4d, 0xbc, 0x9e, 0x38, 0x85,
-	0xd1, 0x43, 0x35, 0x61, 0xa2, 0xfa, 0x1a, 0xf8, 0x1f, 0xf4, 0x96, 0x67, 0x1c, 0xb8, 0x94, 0x3a,
-	0x49, 0xa1, 0x22, 0xfc, 0x5a, 0xd1, 0xb9, 0xac, 0x1e, 0x6a, 0xf9, 0x16, 0x82, 0x64, 0x97, 0x4e,
-	0x65, 0x5b, 0x08, 0xc1, 0x86, 0x5a, 0xf8, 0x0a, 0x80, 0x5f, 0x50, 0x86, 0xd7, 0x72, 0x88, 0x5b,
-	0x1a, 0xca, 0xae, 0xd8, 0xdd, 0x40, 0x7a, 0x97, 0x78, 0xba, 0xa0, 0xeb, 0x57, 0xaa, 0xd5, 0x38,
-	0xb2, 0xdf, 0x23, 0x4e, 0x69, 0x98, 0x65, 0x87, 0x3f, 0x86, 0x0b, 0xf0, 0xb0, 0x40, 0x19, 0x87,
-	0xa4, 0x05, 0x38, 0xb1, 0x8b, 0xc3, 0x38, 0x7a, 0xb0, 0xf0, 0x64, 0xe8, 0x41, 0x7c, 0x03, 0x26,
-	0x64, 0xba, 0x88, 0xf5, 0xa0, 0xbc, 0xac, 0x93, 0xe0, 0xa9, 0xcb, 0x8c, 0xa6, 0x8f, 0x8b, 0xb4,
-	0x53, 0x58, 0xe6, 0x6b, 0xff, 0x9e, 0x87, 0xc1, 0x1d, 0xb7, 0x8e, 0x2d, 0x80, 0x88, 0x02, 0xc1,
-	0x2f, 0x27, 0xb9, 0x8b, 0xbb, 0xa1, 0x56, 0x2e, 0xe4, 0x94, 0xe6, 0x4b, 0x76, 0x00, 0xa3, 0x02,
-	0x49, 0x80, 0xd3, 0xb4, 0x7b, 0x2f, 0x6b, 0x95, 0x95, 0xbc, 0xe2, 0xdc, 0xdb, 0x87, 0x08, 0x70,
-	0xef, 0x45, 0x24, 0x5e, 0x4f, 0x31, 0x93, 0x78, 0xff, 0xaa, 0x7c, 0xa9, 0x4f, 0x2d, 0x1e, 0xc3,
-	0x47, 0x08, 0x66, 0x63, 0xef, 0x07, 0xf1, 0xab, 0xf9, 0xd0, 0xf4, 0x46, 0xb2, 0xd1, 0xbf, 0x22,
-	0x0f, 0xc6, 0x81, 0x71, 0xe9, 0xba, 0x0e, 0x57, 0x72, 0x80, 0x12, 0xaf, 0x77, 0x94, 0x2f, 0xe6,
-	0x57, 0xe0, 0x3e, 0x7f, 0x00, 0x93, 0xdd, 0xb7, 0x69, 0x78, 0x2d, 0x1f, 0x02, 0xc9, 0xf3, 0x2b,
-	0x7d, 0xe9, 0x70, 0xe7, 0x3f, 0x82, 0xa9, 0x9e, 0x9b, 0x2c, 0x9c, 0x66, 0x29, 0xe9, 0x62, 0x4f,
-	0x59, 0xef, 0x4f, 0x29, 0xf2, 0xdf, 0x73, 0x55, 0x95, 0xea, 0x3f, 0xe9, 0x5e, 0x2d, 0xd5, 0x7f,
-	0xe2, 0x6d, 0x18, 0xb6, 0x61, 0x4c, 0xbc, 0xf6, 0xc0, 0x2b, 0x99, 0xdb, 0x55, 0xba, 0x32, 0x53,
-	0x2a, 0xb9, 0xe5, 0xa3, 0x0d, 0x2e, 0x7c, 0xd1, 0xe1, 0xcc, 0xf6, 0x20, 0xd1, 0xde, 0xca, 0x4a,
-	0x5e, 0xf1, 0x08, 0x9e, 0xf8, 0xc1, 0x83, 0xb3, 0x1b, 0x84, 0xec, 0xaf, 0x92, 0x5b, 0x9e, 0x3b,
-	0xfc, 0x18, 0xc1, 0x7c, 0x02, 0x5d, 0x8c, 0x5f, 0xcb, 0xd5, 0x0a, 0xe3, 0x3e, 0x21, 0x95, 0xcd,
-	0xe3, 0xa8, 0xf2, 0x90, 0x7e, 0x8e, 0xa0, 0x94, 0x44, 0xbc, 0xe2, 0xcd, 0x7c, 0x9b, 0x26, 0x36,
-	0xa8, 0xd7, 0x8f, 0xa5, 0xcb, 0xa3, 0xfa, 0x14, 0x81, 0x92, 0xcc, 0x83, 0xe2, 0x8b, 0x59, 0x80,
-	0xd3, 0x08, 0x1e, 0xe5, 0xd2, 0x31, 0xb5, 0x79, 0x6c, 0xbf, 0x44, 0x70, 0x3a, 0x85, 0x96, 0xc1,
-	0x97, 0x32, 0x81, 0xa7, 0x46, 0xf7, 0x95, 0xe3, 0xaa, 0x0b, 0xa9, 0x4b, 0x66, 0x1b, 0x53, 0x53,
-	0x97, 0x49, 0xe7, 0xa6, 0xa6, 0x2e, 0x9b, 0xe2, 0xc4, 0xf7, 0x10, 0xa8, 0x19, 0x84, 0x1d, 0xde,
-	0xea, 0x0b, 0x7f, 0x1c, 0x37, 0xaa, 0x54, 0x1f, 0xc5, 0x84, 0xb0, 0x2f, 0x92, 0xc8, 0x25, 0xbc,
-	0x99, 0xaf, 0xd1, 0xf4, 0xbd, 0x2f, 0x32, 0xd9, 0xac, 0x4f, 0x10, 0x2c, 0x24, 0x72, 0x34, 0xf8,
-	0xf5, 0x9c, 0xfd, 0x28, 0x36, 0xae, 0x8b, 0xc7, 0x53, 0x8e, 0x46, 0x03, 0x89, 0xa2, 0x49, 0x1d,
-	0x0d, 0xe2, 0xd8, 0xa3, 0xd4, 0xd1, 0x20, 0x9e, 0xfd, 0xb9, 0x05, 0x13, 0x5d, 0xdc, 0x09, 0x5e,
-	0xcd, 0x04, 0xd1, 0xe3, 0x77, 0xad, 0x1f, 0x95, 0xc8, 0x73, 0x17, 0xb1, 0x91, 0xea, 0x39, 0x9e,
-	0x77, 0x49, 0xf5, 0x9c, 0xc4, 0x9b, 0xb4, 0xe0, 0x59, 0x99, 0x37, 0xc0, 0x69, 0x79, 0x8b, 0xa5,
-	0x44, 0x94, 0xd5, 0x3e, 0x34, 0x98, 0xdb, 0xea, 0x7b, 0x9f, 0x3f, 0x28, 0xa3, 0xfb, 0x0f, 0xca,
-	0xe8, 0x9f, 0x0f, 0xca, 0xe8, 0xe3, 0x87, 0xe5, 0x81, 0xfb, 0x0f, 0xcb, 0x03, 0x7f, 0x7d, 0x58,
-	0x1e, 0x80, 0x05, 0xcb, 0x4e, 0x30, 0x77, 0x0d, 0x7d, 0x77, 0xbd, 0x6e, 0x79, 0x37, 0x5a, 0xd7,
-	0x57, 0x6a, 0xf6, 0x61, 0x25, 0x12, 0xba, 0x60, 0xd9, 0xc2, 0x53, 0xe5, 0x56, 0xf4, 0x07, 0xae,
-	0xde, 0xed, 0x26, 0x71, 0xaf, 0x0f, 0xd1, 0x3f, 0x6b, 0x7d, 0xe5, 0xbf, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x3c, 0x58, 0xfd, 0xe8, 0xee, 0x2b, 0x00, 0x00,
+	// 2894 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0xdd, 0x6b, 0x1c, 0xd7,
+	0x15, 0xd7, 0xac, 0x62, 0x4b, 0x7b, 0x24, 0x45, 0xf2, 0xd5, 0xd7, 0x6a, 0x64, 0x6b, 0x94, 0x89,
+	0x9d, 0x28, 0x72, 0xbc, 0x1b, 0xc9, 0x72, 0xec, 0x28, 0x76, 0x5a, 0xad, 0x5d, 0x63, 0xb5, 0x56,
+	0x6d, 0x46, 0x76, 0x42, 0x0b, 0x45, 0x8c, 0x66, 0x47, 0xeb, 0xa9, 0x77, 0xe7, 0x6e, 0xe6, 0xce,
+	0xca, 0x96, 0x4b, 0x1b, 0x52, 0x42, 0x29, 0x81, 0x80, 0xa1, 0x10, 0x9a, 0x12, 0x8a, 0x9f, 0x8a,
+	0xe9, 0x47, 0x9e, 0x4a, 0xe9, 0x5f, 0x50, 0xd2, 0xb7, 0xbc, 0xa4, 0x84, 0x52, 0xd6, 0xc5, 0xa6,
+	0xd0, 0xe7, 0x7d, 0x2e, 0xa5, 0xcc, 0xdc, 0x3b, 0xdf, 0x1f, 0x3b, 0x2b, 0x4b, 0xaa, 0x0b, 0x7d,
+	0x30, 0xde, 0x99, 0x39, 0xbf, 0xf3, 0x75, 0xcf, 0x3d, 0xf7, 0xdc, 0x73, 0xaf, 0x40, 0x68, 0x18,
+	0x78, 0x5b, 0xd5, 0x65, 0x5d, 0x51, 0x4b, 0x75, 0xd5, 0x94, 0x2b, 0xb2, 0x29, 0x97, 0xb6, 0x17,
+	0x4a, 0xe6, 0xdd, 0x62, 0xc3, 0xc0, 0x26, 0x46, 0x13, 0x1e, 0x41, 0xd1, 0x21, 0x28, 0x6e, 0x2f,
+	0xf0, 0x33, 0x0a, 0x26, 0x75, 0x4c, 0x4a, 0x9b, 0x32, 0x51, 0x4b, 0xdb, 0x0b, 0x9b, 0xaa, 0x29,
+	0x2f, 0x94, 0x14, 0xac, 0xe9, 0x14, 0xc7, 0x8f, 0x55, 0x71, 0x15, 0xdb, 0x3f, 0x4b, 0xd6, 0x2f,
+	0xf6, 0x56, 0xa8, 0x62, 0x5c, 0xad, 0xa9, 0x25, 0xfb, 0x69, 0xb3, 0xb9, 0x55, 0x32, 0xb5, 0xba,
+	0x4a, 0x4c, 0xb9, 0xde, 0x60, 0x04, 0x27, 0x12, 0xf4, 0x71, 0x45, 0x53, 0xb2, 0xb9, 0x04, 0x32,
+	0xbc, 0xf9, 0x7d, 0x55, 0x31, 0x89, 0x89, 0x0d, 0x95, 0x51, 0x1e, 0x4f, 0xa0, 0x6c, 0x9c, 0x53,
+	0xad, 0x7f, 0x8c, 0x4a, 0x4c, 0xa0, 0x22, 0x0a, 0x6e, 0x38, 0x34, 0xf3, 0x49, 0x34, 0x0d, 0x55,
+	0xd1, 0xb6, 0x34, 0x45, 0x36, 0x35, 0xcc, 0xac, 0x17, 0x1f, 0x73, 0x30, 0xb6, 0x46, 0xaa, 0xef,
+	0x18, 0x9a, 0xa9, 0xae, 0x5b, 0x3c, 0x24, 0xf5, 0xdd, 0xa6, 0x4a, 0x4c, 0xf4, 0x06, 0x1c, 0xb2,
+	0x79, 0x16, 0xb8, 0x59, 0x6e, 0x6e, 0x60, 0xf1, 0x58, 0x31, 0xde, 0xbd, 0x45, 0x1b, 0x54, 0x7e,
+	0xee, 0xf3, 0x96, 0xd0, 0x23, 0x51, 0x04, 0x2a, 0x40, 0x1f, 0xd1, 0xaa, 0xba, 0x6a, 0x90, 0x42,
+	0x6e, 0xb6, 0x77, 0x2e, 0x2f, 0x39, 0x8f, 0x68, 0x09, 0xc0, 0x26, 0xd9, 0x68, 0x36, 0xb5, 0x4a,
+	0xa1, 0x77, 0x96, 0x9b, 0xcb, 0x97, 0xc7, 0xdb, 0x2d, 0xe1, 0xc8, 0x8e, 0x5c, 0xaf, 0x2d, 0x8b,
+	0xde, 0x37, 0x51, 0xca, 0xdb, 0x0f, 0x37, 0x9b, 0x5a, 0x05, 0x2d, 0x40, 0xde, 0x52, 0x9d, 0x82,
+	0x9e, 0xb3, 0x41, 0x63, 0xed, 0x96, 0x30, 0xc2, 0x40, 0xce, 0x27, 0x51, 0xea, 0xb7, 0x7e, 0x5b,
+	0x90, 0xe5, 0xc1, 0x9f, 0x3e, 0x10, 0x7a, 0x7e, 0xfe, 0x40, 0xe0, 0xfe, 0xf9, 0x40, 0xe8, 0x11,
+	0xef, 0xc1, 0x78, 0xc8, 0x46, 0xd2, 0xc0, 0x3a, 0x51, 0x91, 0x0c, 0x43, 0x54, 0xa6, 0x56, 0xd9,
+	0xd0, 0xf4, 0x2d, 0xcc, 0x8c, 0x7d, 0x31, 0xd5, 0xd8, 0xd5, 0xca, 0xaa, 0xbe, 0x85, 0xcb, 0x85,
+	0x76, 0x4b, 0x18, 0xf3, 0xeb, 0xcd, 0x78, 0x88, 0xd2, 0x00, 0xf1, 0xc8, 0xc4, 0x9f, 0x70, 0xb6,
+	0xf0, 0x4b, 0x6a, 0x4d, 0x0d, 0x79, 0xf8, 0x1b, 0xd0, 0xef, 0x00, 0x6d, 0xb9, 0x83, 0xe5, 0x79,
+	0xcb, 0x8b, 0x7f, 0x6d, 0x09, 0xc3, 0x6b, 0x4c, 0xe6, 0x4a, 0xa5, 0x62, 0xa8, 0x84, 0xb4, 0x5b,
+	0xc2, 0x70, 0x50, 0x92, 0x28, 0xf5, 0x31, 0x21, 0xc9, 0xde, 0x0e, 0x39, 0xa1, 0x00, 0x13, 0x61,
+	0x3d, 0xa8, 0x17, 0xc4, 0x3f, 0x71, 0x70, 0x74, 0x8d, 0x54, 0x57, 0x2a, 0x15, 0xfb, 0xfd, 0x25,
+	0x4b, 0xb0, 0xa2, 0xa8, 0x84, 0xec, 0xb1, 0xa6, 0x67, 0x61, 0xc0, 0x22, 0xdd, 0x90, 0x6d, 0xe6,
+	0x54, 0xdb, 0xf2, 0x44, 0xbb, 0x25, 0x20, 0x0a, 0xf1, 0x7d, 0x14, 0x25, 0xa8, 0xb8, 0x6a, 0xf8,
+	0x4d, 0xec, 0x4d, 0x33, 0x51, 0x80, 0x63, 0x09, 0x76, 0x30, 0x4b, 0xff, 0xcc, 0x81, 0x10, 0x74,
+	0xc2, 0xff, 0xae, 0xb1, 0x22, 0xcc, 0x26, 0x9b, 0xc2, 0xec, 0xfd, 0x0b, 0x07, 0x93, 0x3e, 0x8f,
+	0x5c, 0xbb, 0xa3, 0xab, 0xc6, 0x1e, 0xdb, 0x79, 0x15, 0x0e, 0xe3, 0x3b, 0x6e, 0xf4, 0xa5, 0x24,
+	0x8a, 0xeb, 0xb2, 0x61, 0xee, 0x94, 0xc7, 0x2d, 0x19, 0xed, 0x96, 0x30, 0x44, 0x19, 0x52, 0xa8,
+	0x28, 0x31, 0x1e, 0x99, 0x8d, 0xe7, 0xa1, 0x10, 0xb5, 0x8b, 0x19, 0xfd, 0x07, 0x0e, 0xf8, 0xa0,
+	0x67, 0xf6, 0xc3, 0xee, 0x57, 0x02, 0x76, 0xe7, 0xcb, 0x47, 0x9e, 0xde, 0xa8, 0x63, 0x30, 0x1d,
+	0xab, 0x37, 0xb3, 0xeb, 0x11, 0x67, 0x7f, 0xbf, 0xd9, 0xa8, 0xc8, 0xa6, 0xfa, 0xb6, 0x5c, 0x6b,
+	0xd2, 0xef, 0x6e, 0xe0, 0x5e, 0x81, 0xbc, 0xa3, 0x27, 0x29, 0x70, 0xb3, 0xbd, 0x73, 0x83, 0xe5,
+	0x93, 0xc9, 0x96, 0x8d, 0x04, 0x2d, 0x23, 0x56, 0xf6, 0xa4, 0xa6, 0x11, 0xf4, 0x6d, 0x18, 0xdd,
+	0xb6, 0xf8, 0x6f, 0xd8, 0x06, 0x6c, 0xc8, 0x14, 0x54, 0xc8, 0xd9, 0xa9, 0x77, 0xa6, 0xdd, 0x12,
+	0x78, 0x0a, 0x8e, 0x21, 0x12, 0xa5, 0x23, 0xdb, 0xae, 0x6a, 0x4c, 0x5a, 0x66, 0x07, 0xcc, 0xc0,
+	0xd1, 0x78, 0x03, 0x99, 0x07, 0x7e, 0x68, 0x3b, 0x60, 0x4d, 0xab, 0x1a, 0x01, 0x02, 0xc7, 0x01,
+	0x3c, 0xf4, 0xab, 0x77, 0x35, 0x62, 0x6a, 0x7a, 0xd5, 0x1e, 0xd9, 0xbc, 0xe4, 0x3e, 0x5b, 0xdf,
+	0x1a, 0x06, 0x6e, 0x60, 0xa2, 0x56, 0xa8, 0x1d, 0x92, 0xfb, 0xdc, 0xa5, 0x7a, 0x31, 0xe2, 0x99,
+	0x7a, 0xbf, 0xcf, 0xd9, 0x81, 0x77, 0x55, 0x23, 0xa6, 0x3d, 0x7c, 0x97, 0xb1, 0xb1, 0x2e, 0xd7,
+	0xf6, 0x3a, 0xdf, 0xcb, 0x70, 0xa8, 0x61, 0x68, 0x8a, 0xca, 0xe6, 0xdb, 0x54, 0x91, 0xd6, 0x37,
+	0x45, 0xab, 0xbe, 0x29, 0xb2, 0xfa, 0xa6, 0x78, 0x11, 0x6b, 0x7a, 0xf9, 0x35, 0x8b, 0xfd, 0xaf,
+	0x1f, 0x09, 0x73, 0x55, 0xcd, 0xbc, 0xd5, 0xdc, 0x2c, 0x2a, 0xb8, 0x5e, 0x62, 0xc5, 0x10, 0xfd,
+	0xef, 0x14, 0xa9, 0xdc, 0x2e, 0x99, 0x3b, 0x0d, 0x95, 0xd8, 0x00, 0x22, 0x51, 0xce, 0xe8, 0x12,
+	0x80, 0x7a, 0xb7, 0xa1, 0x19, 0x76, 0xa1, 0x60, 0x2f, 0xd3, 0x03, 0x8b, 0x7c, 0x91, 0x56, 0x44,
+	0x45, 0xa7, 0x22, 0x2a, 0xde, 0x70, 0x2a, 0xa2, 0x72, 0xbf, 0x25, 0xe8, 0xfe, 0x23, 0x81, 0x93,
+	0x7c, 0x38, 0xbf, 0x5b, 0x9f, 0xeb, 0x1c, 0xf6, 0x51, 0xaf, 0x31, 0xaf, 0x7e, 0x44, 0x57, 0xa7,
+	0x8b, 0xb2, 0xae, 0xa8, 0x35, 0x9b, 0xe2, 0x2a, 0x1d, 0xd3, 0xff, 0xd2, 0x3a, 0x4a, 0x17, 0x99,
+	0x38, 0x75, 0x98, 0xc2, 0xff, 0xe2, 0x00, 0xad, 0x91, 0x6a, 0xb9, 0xb9, 0xb3, 0x1f, 0xcb, 0xfd,
+	0x18, 0x1c, 0xda, 0x6c, 0xee, 0xa8, 0x06, 0x8b, 0x62, 0xfa, 0xe0, 0x05, 0x45, 0xef, 0xbe, 0x05,
+	0x45, 0xd6, 0xe1, 0x1c, 0x87, 0xd1, 0x80, 0xf5, 0xcc, 0x2b, 0x9f, 0x72, 0xf6, 0xfb, 0xab, 0x58,
+	0xb9, 0xbd, 0x1f, 0x6e, 0x99, 0x80, 0xc3, 0x86, 0x2a, 0x13, 0xac, 0x33, 0xbf, 0xb0, 0xa7, 0xcc,
+	0x73, 0x7b, 0x02, 0xc6, 0x82, 0xda, 0x31, 0xb5, 0x59, 0xf9, 0x76, 0x53, 0xaf, 0xed, 0x93, 0xe2,
+	0xdd, 0x95, 0x6f, 0x01, 0x3d, 0x98, 0x8a, 0x7f, 0xcc, 0xc1, 0x84, 0x5b, 0xde, 0xaa, 0x84, 0x68,
+	0x58, 0x77, 0x74, 0xfc, 0x1a, 0xf4, 0x11, 0xfa, 0x86, 0x55, 0xb6, 0x42, 0x62, 0x65, 0x4b, 0xc9,
+	0x58, 0x21, 0xef, 0xa0, 0x52, 0x4a, 0xf9, 0xf7, 0x39, 0x18, 0x67, 0x54, 0x56, 0xe5, 0xab, 0xe0,
+	0x7a, 0x03, 0xeb, 0xaa, 0x6e, 0x12, 0x96, 0x2f, 0x4e, 0x76, 0x90, 0xb4, 0x5a, 0xb9, 0xe8, 0x42,
+	0xca, 0xb3, 0xed, 0x96, 0x70, 0x94, 0xb9, 0x28, 0x8e, 0xa7, 0x28, 0x8d, 0x92, 0x28, 0xec, 0xe9,
+	0x37, 0x06, 0x5f, 0x72, 0x30, 0x1a, 0xa3, 0x0f, 0x7a, 0x3d, 0xb0, 0x4f, 0xe1, 0x52, 0xf6, 0x29,
+	0x57, 0x7a, 0xfc, 0x3b, 0x15, 0x17, 0x67, 0xad, 0x86, 0x85, 0x5c, 0x3c, 0xce, 0xfa, 0xe6, 0xe1,
+	0xac, 0x18, 0x41, 0xcb, 0x30, 0xe8, 0xd8, 0xed, 0xdb, 0x19, 0x4d, 0xb6, 0x5b, 0xc2, 0x68, 0xd0,
+	0x2b, 0xd4, 0x9c, 0x01, 0xf6, 0x68, 0xc9, 0x2c, 0x23, 0x18, 0x71, 0xc2, 0x4a, 0xd5, 0x4d, 0x6d,
+	0x4b, 0x53, 0x0d, 0xf1, 0x03, 0x5a, 0xf7, 0x05, 0x43, 0x82, 0xed, 0x79, 0x34, 0x18, 0xf6, 0xf9,
+	0xd8, 0xb7, 0xeb, 0x39, 0xd1, 0x71, 0xc4, 0xec, 0x7d, 0x0f, 0xdf, 0x6e, 0x09, 0x13, 0x91, 0xb1,
+	0xa2, 0x3b, 0x9f, 0x21, 0xe2, 0x27, 0x15, 0x3f, 0xea, 0xf5, 0x36, 0x5e, 0x92, 0xaa, 0x60, 0xa3,
+	0xe2, 0x04, 0xe6, 0x79, 0x6b, 0xba, 0x5a, 0x2f, 0x98, 0xec, 0x99, 0x24, 0xd9, 0x14, 0xc6, 0xc2,
+	0x92, 0x61, 0x9e, 0xf1, 0xa8, 0xfc, 0x16, 0x20, 0x05, 0xeb, 0xa6, 0x21, 0x2b, 0xe6, 0x46, 0x38,
+	0x3c, 0x8f, 0xb5, 0x5b, 0xc2, 0x14, 0x65, 0x19, 0xa5, 0x11, 0xa5, 0x11, 0xe7, 0xe5, 0x3a, 0x8b,
+	0x57, 0x74, 0x01, 0xfa, 0x1a, 0xb2, 0x61, 0x6a, 0x2a, 0x29, 0x1c, 0xca, 0x52, 0x5f, 0xb3, 0xf9,
+	0xcb, 0x30, 0xa1, 0x70, 0x7f, 0xcf, 0x4b, 0x14, 0xce, 0x70, 0xb0, 0xa0, 0x50, 0xe1, 0x79, 0xea,
+	0xdb, 0x50, 0x4c, 0x1c, 0x4f, 0x1f, 0x17, 0x16, 0x12, 0x53, 0xed, 0x96, 0x30, 0x4e, 0xad, 0x0a,
+	0x72, 0x11, 0xa5, 0x41, 0xc3, 0x47, 0x28, 0x7e, 0xc8, 0xf9, 0x36, 0xa1, 0xc1, 0x88, 0xb8, 0x02,
+	0x79, 0x17, 0xcb, 0xf2, 0x69, 0xe7, 0xea, 0xd5, 0x45, 0x88, 0x52, 0xbf, 0x23, 0x28, 0x73, 0x46,
+	0x9d, 0x82, 0xc9, 0x88, 0x2e, 0x2c, 0xa5, 0xfe, 0x98, 0x56, 0x72, 0x5e, 0xc7, 0xa0, 0xdc, 0xd4,
+	0x2b, 0xb5, 0xbd, 0xe8, 0x8d, 0xac, 0x40, 0x3f, 0x8b, 0x19, 0x67, 0xc3, 0x94, 0x31, 0x25, 0xbb,
+	0x30, 0xf4, 0x16, 0xf4, 0x51, 0x5b, 0x09, 0x5b, 0xed, 0xb3, 0x4d, 0x1e, 0x07, 0x94, 0x79, 0x21,
+	0xff, 0x2a, 0x07, 0xd3, 0xb1, 0x4e, 0x38, 0xb0, 0xe6, 0x09, 0xaa, 0xc1, 0x48, 0x28, 0xc7, 0x38,
+	0x5e, 0xcb, 0x98, 0xac, 0xa6, 0xdb, 0x2d, 0x61, 0x32, 0x36, 0x59, 0x11, 0x51, 0x7a, 0x3e, 0x90,
+	0xad, 0x08, 0xba, 0x05, 0xc3, 0xc1, 0xf0, 0x75, 0x1c, 0x9c, 0x6d, 0x16, 0xf8, 0x12, 0x63, 0x88,
+	0x8d, 0x28, 0x0d, 0xf9, 0xa7, 0x01, 0xb1, 0xf6, 0xe5, 0x2f, 0x04, 0x5c, 0xbb, 0xee, 0x6f, 0xcd,
+	0x39, 0x61, 0xf6, 0x36, 0x0c, 0x05, 0x5a, 0x76, 0xcc, 0xc1, 0xf3, 0xa9, 0x0e, 0x0e, 0x70, 0x62,
+	0x43, 0x1f, 0x64, 0x93, 0x92, 0x3e, 0x03, 0x0b, 0x6a, 0xef, 0x2e, 0x16, 0xd4, 0x4f, 0x38, 0x10,
+	0xd3, 0x0c, 0x63, 0xa1, 0x43, 0x00, 0xd1, 0x61, 0xb7, 0x59, 0x06, 0xe3, 0xe7, 0xe5, 0x8e, 0xe6,
+	0x31, 0x7f, 0xfb, 0x72, 0x69, 0x94, 0x99, 0x28, 0x0d, 0x93, 0x20, 0xbd, 0xf8, 0x1b, 0xaa, 0x9b,
+	0x6f, 0x7f, 0x1d, 0xeb, 0xf5, 0xef, 0xc1, 0x48, 0xc0, 0x5d, 0x5e, 0x3e, 0x5a, 0x4c, 0xce, 0x47,
+	0x93, 0x9e, 0x87, 0xfc, 0x40, 0x4b, 0x0b, 0xff, 0xab, 0x2e, 0xb2, 0xd3, 0x09, 0x78, 0x31, 0x55,
+	0x59, 0x96, 0xa9, 0xfe, 0x91, 0x83, 0x63, 0xde, 0xa6, 0xd4, 0x26, 0x24, 0x37, 0xb0, 0x45, 0xeb,
+	0xd8, 0x73, 0x1b, 0xc6, 0xb7, 0x0c, 0x5c, 0xdf, 0x48, 0x30, 0xea, 0x6c, 0xb2, 0x51, 0x6c, 0xed,
+	0x8b, 0x45, 0x8b, 0xd2, 0xa8, 0xf5, 0x7e, 0x3d, 0x64, 0x9d, 0x0a, 0xa3, 0x26, 0x8e, 0x8a, 0xca,
+	0xd9, 0xa2, 0xce, 0x24, 0x8b, 0x62, 0x0d, 0x85, 0x18, 0xac, 0x28, 0x1d, 0x31, 0x71, 0x58, 0x4c,
+	0xa0, 0xd5, 0xd1, 0xfb, 0x34, 0xad, 0x8e, 0xac, 0xc9, 0xf0, 0x4b, 0x0e, 0x66, 0x92, 0xfc, 0xcc,
+	0x82, 0x7a, 0x13, 0x50, 0x9d, 0x7e, 0xae, 0x6c, 0x84, 0x1b, 0x31, 0x4b, 0xc9, 0xda, 0xb1, 0x10,
+	0x8e, 0x42, 0x45, 0x69, 0xc4, 0x79, 0xb9, 0xee, 0xa8, 0x7b, 0x0d, 0xfa, 0xb7, 0x64, 0xad, 0xd6,
+	0x34, 0x54, 0x27, 0x11, 0x9e, 0x4a, 0x9d, 0x2e, 0x54, 0x5f, 0x0d, 0xeb, 0x97, 0x29, 0xca, 0x59,
+	0x4c, 0x1c, 0x26, 0xe2, 0x36, 0x8c, 0xc7, 0x12, 0xee, 0xf3, 0xbe, 0x4c, 0xfc, 0x1b, 0x07, 0xc7,
+	0x9d, 0x44, 0x71, 0xd1, 0x57, 0xf4, 0x44, 0xa6, 0xe3, 0x77, 0xe2, 0x93, 0x60, 0xa2, 0xd9, 0xb1,
+	0xcc, 0x0e, 0x3c, 0x0f, 0x3e, 0xe4, 0xe0, 0x44, 0x07, 0xf3, 0x58, 0xd4, 0xbc, 0x07, 0xe3, 0xc1,
+	0x4a, 0x30, 0x98, 0x0d, 0xe7, 0xb3, 0xd8, 0xc9, 0x12, 0xa2, 0xaf, 0x5e, 0x8d, 0x65, 0x29, 0x4a,
+	0x48, 0x89, 0xa0, 0xc4, 0x87, 0x39, 0x7b, 0x24, 0x56, 0x2a, 0x15, 0x3f, 0xcb, 0x1b, 0xd8, 0x4d,
+	0x3a, 0xce, 0x48, 0xe8, 0x30, 0x15, 0x60, 0xbb, 0x47, 0x19, 0x72, 0x52, 0x89, 0xf3, 0xcf, 0x6a,
+	0x05, 0xdd, 0x82, 0x09, 0x2f, 0xaf, 0xc7, 0xa4, 0x93, 0xdd, 0x08, 0x1b, 0x23, 0x91, 0x54, 0xba,
+	0x9a, 0xbd, 0x01, 0xf8, 0x32, 0x9c, 0xe8, 0xe0, 0x29, 0x96, 0x95, 0x7f, 0x97, 0x83, 0x57, 0xdc,
+	0xec, 0xed, 0x27, 0xbe, 0x6c, 0xe5, 0xcc, 0xff, 0x3b, 0x36, 0xec, 0xd8, 0x57, 0x61, 0x3e, 0x8b,
+	0xbb, 0x98, 0x77, 0x3f, 0xa3, 0x93, 0x2b, 0x4a, 0xfe, 0xac, 0xae, 0xe5, 0x73, 0xf0, 0x52, 0x27,
+	0x7d, 0x99, 0x69, 0x6d, 0x5f, 0xfd, 0x44, 0x8b, 0xcb, 0x58, 0xbb, 0xde, 0x89, 0x4f, 0x8a, 0x27,
+	0xd3, 0xeb, 0xd4, 0xa7, 0x4a, 0x89, 0xf1, 0xbb, 0xda, 0xde, 0x5d, 0xed, 0x6a, 0x43, 0xee, 0xf9,
+	0x25, 0x07, 0x2f, 0xa6, 0x1a, 0xcd, 0x52, 0xe5, 0x1d, 0x18, 0x65, 0x85, 0x75, 0x4c, 0xa2, 0x9c,
+	0xeb, 0x6c, 0x3b, 0x4b, 0x93, 0xbe, 0x03, 0x8c, 0x18, 0x76, 0xa2, 0x34, 0x62, 0x84, 0x10, 0xe2,
+	0x6f, 0x39, 0x5f, 0x31, 0x96, 0x32, 0x2c, 0xcf, 0x48, 0xb8, 0xbd, 0x04, 0xc7, 0xd3, 0xb5, 0x65,
+	0xc1, 0x86, 0xed, 0x0d, 0x70, 0x59, 0xd3, 0x2b, 0xd7, 0xd6, 0xaf, 0x62, 0x45, 0x36, 0xb1, 0x7b,
+	0x94, 0xf2, 0x4d, 0xe8, 0xab, 0xd1, 0x37, 0x9d, 0xd6, 0xa1, 0x6b, 0xf6, 0x45, 0x86, 0x75, 0x13,
+	0x1b, 0x2a, 0xe3, 0xe1, 0xec, 0x37, 0x19, 0x83, 0xe5, 0x7e, 0x4b, 0x39, 0x5b, 0xb1, 0x2d, 0x28,
+	0x44, 0x05, 0xb2, 0xc1, 0xdd, 0x43, 0x89, 0xe2, 0xbb, 0x30, 0xe5, 0x3a, 0xe0, 0x80, 0x4c, 0xbb,
+	0xe5, 0x3b, 0x73, 0x3c, 0x08, 0xe3, 0xd6, 0x70, 0x45, 0xdb, 0xda, 0x39, 0x50, 0xe3, 0x22, 0x22,
+	0xf7, 0xc1, 0xb8, 0xcf, 0x38, 0x3b, 0x44, 0xd6, 0x55, 0x73, 0x45, 0x51, 0x70, 0x53, 0x37, 0xad,
+	0x43, 0x6d, 0xc7, 0xb8, 0x1b, 0x30, 0xe4, 0x70, 0xa3, 0x0d, 0x56, 0x3a, 0xb7, 0x4a, 0xc9, 0x73,
+	0x8b, 0xb5, 0x18, 0x02, 0x28, 0x51, 0x1a, 0xac, 0xfb, 0x08, 0xad, 0x03, 0x15, 0xfb, 0xc4, 0xd2,
+	0x39, 0x50, 0xb1, 0x1f, 0x32, 0xaf, 0x5c, 0xd3, 0x30, 0x15, 0xa3, 0xaf, 0xd3, 0x46, 0xa2, 0xed,
+	0x2e, 0x7a, 0xa0, 0x79, 0x5d, 0x36, 0xe4, 0xba, 0x7b, 0x58, 0x7b, 0x14, 0xf2, 0x72, 0xd3, 0xbc,
+	0x85, 0x0d, 0xcd, 0xdc, 0x61, 0x87, 0x95, 0xde, 0x0b, 0xab, 0x3d, 0xda, 0xb0, 0xc9, 0x6d, 0xa5,
+	0x52, 0x3a, 0x3c, 0x94, 0xa9, 0xd3, 0x1e, 0xa5, 0x98, 0xd8, 0x36, 0x57, 0x50, 0x07, 0xa6, 0xdf,
+	0xc7, 0x74, 0x53, 0x63, 0x27, 0xde, 0xeb, 0xe7, 0x02, 0x4b, 0x93, 0xa3, 0xa7, 0x04, 0x83, 0x4e,
+	0xf6, 0x26, 0x0d, 0x55, 0xe9, 0x94, 0x6c, 0xad, 0x8b, 0x4a, 0x7e, 0x36, 0x4c, 0xb3, 0x00, 0x8f,
+	0x94, 0x3c, 0x76, 0xd8, 0xd2, 0xbc, 0xc0, 0x89, 0xbf, 0xca, 0x81, 0x90, 0xa8, 0xd8, 0x33, 0x52,
+	0x38, 0xa3, 0x7b, 0x30, 0x16, 0xb3, 0x7e, 0x38, 0xfb, 0xb2, 0xec, 0xeb, 0x91, 0xd0, 0x6e, 0x09,
+	0xd3, 0x89, 0xeb, 0x91, 0x75, 0xa2, 0x1e, 0x5e, 0x90, 0x3c, 0x47, 0xfd, 0x3b, 0x67, 0xdf, 0x02,
+	0xb9, 0x7e, 0x4e, 0x5d, 0x53, 0xeb, 0xd8, 0xd0, 0xe4, 0x9a, 0x76, 0xcf, 0x75, 0x97, 0x33, 0x86,
+	0x53, 0xa1, 0xad, 0x5c, 0xde, 0xdb, 0x9e, 0x4d, 0x41, 0x7f, 0xd5, 0xc0, 0xcd, 0x86, 0x53, 0xfc,
+	0xe5, 0xa5, 0x3e, 0xfb, 0x79, 0xb5, 0x82, 0x96, 0x12, 0xab, 0x44, 0x7b, 0xd1, 0x4f, 0xa8, 0xf8,
+	0xbe, 0x0e, 0x56, 0x23, 0x56, 0x33, 0xe5, 0x1a, 0xb1, 0x5b, 0xde, 0x29, 0xcd, 0x33, 0x2b, 0x56,
+	0x24, 0x46, 0x2b, 0xb9, 0x28, 0x8b, 0x83, 0xe3, 0xec, 0xc2, 0xa1, 0xce, 0x1c, 0x5c, 0x63, 0x5d,
+	0x14, 0xba, 0x02, 0x60, 0x05, 0x94, 0x6c, 0xda, 0xdb, 0xe4, 0xc3, 0x9d, 0x23, 0x76, 0xdd, 0xa1,
+	0x5e, 0x57, 0x4d, 0xc9, 0x87, 0xb5, 0x22, 0x55, 0xd3, 0xb7, 0xf1, 0x6d, 0xd5, 0x28, 0xf4, 0x51,
+	0xef, 0xb0, 0x47, 0x77, 0x00, 0x9e, 0xe4, 0xe0, 0x85, 0x94, 0x01, 0x38, 0xb8, 0x56, 0x69, 0xcc,
+	0xb1, 0x4e, 0x6e, 0x7f, 0x8e, 0x75, 0x0e, 0xae, 0x4f, 0xea, 0x78, 0x79, 0xf1, 0x17, 0x02, 0xf4,
+	0xae, 0x91, 0x2a, 0xd2, 0x00, 0xbc, 0xd6, 0x22, 0x7a, 0x35, 0x49, 0x5c, 0xdc, 0x85, 0x46, 0xfe,
+	0x54, 0x46, 0x6a, 0x36, 0x64, 0x35, 0x18, 0xf0, 0x35, 0xdf, 0x50, 0x1a, 0x3a, 0x7a, 0xb7, 0x8f,
+	0x2f, 0x66, 0x25, 0x67, 0xd2, 0xde, 0xe7, 0x00, 0x45, 0xef, 0xad, 0xa1, 0xa5, 0x14, 0x36, 0x89,
+	0xd7, 0xf5, 0xf8, 0x33, 0x5d, 0xa2, 0x98, 0x0e, 0x1f, 0x72, 0x30, 0x1e, 0x7b, 0x9d, 0x0c, 0x9d,
+	0xcd, 0x66, 0x4d, 0x54, 0x93, 0x73, 0xdd, 0x03, 0x99, 0x32, 0x06, 0x0c, 0x05, 0x6e, 0x77, 0xa1,
+	0x52, 0x06, 0xa3, 0xfc, 0xb7, 0x81, 0xf8, 0xd7, 0xb2, 0x03, 0x98, 0xcc, 0x1f, 0xc0, 0x48, 0xf8,
+	0xf2, 0x15, 0x5a, 0xcc, 0x66, 0x41, 0x40, 0xf2, 0xe9, 0xae, 0x30, 0x4c, 0xf8, 0x8f, 0xe0, 0x48,
+	0xe4, 0xe2, 0x13, 0x4a, 0xe3, 0x94, 0x74, 0x0f, 0x8c, 0x5f, 0xea, 0x0e, 0xe4, 0xc9, 0x8f, 0xdc,
+	0x6c, 0x4a, 0x95, 0x9f, 0x74, 0x0d, 0x8b, 0x5f, 0xea, 0x0e, 0xe4, 0x39, 0x3f, 0x7c, 0x05, 0x28,
+	0xd5, 0xf9, 0x09, 0xb7, 0xac, 0xf8, 0xd3, 0x5d, 0x61, 0x7c, 0xd3, 0x2f, 0x7a, 0xa3, 0x27, 0x75,
+	0xfa, 0x25, 0xde, 0x47, 0xe2, 0xcf, 0x74, 0x89, 0x62, 0x3a, 0x28, 0xd0, 0xef, 0x5c, 0x9a, 0x41,
+	0xf3, 0x29, 0x2c, 0x42, 0xf7, 0x8a, 0xf8, 0x93, 0x99, 0x68, 0x99, 0x90, 0x2d, 0xc8, 0xbb, 0x77,
+	0x5c, 0x50, 0x1a, 0x32, 0x7c, 0x4f, 0x87, 0x7f, 0x35, 0x1b, 0xb1, 0x97, 0x3d, 0x7d, 0x57, 0x55,
+	0x52, 0xb3, 0x67, 0xf4, 0x6a, 0x0d, 0x5f, 0xcc, 0x4a, 0xce, 0xa4, 0x61, 0x18, 0xf4, 0x5f, 0x75,
+	0x40, 0xc5, 0x8e, 0xa9, 0x3e, 0x70, 0x4d, 0x86, 0x2f, 0x65, 0xa6, 0xf7, 0xcc, 0xf3, 0x75, 0x2b,
+	0x50, 0xc7, 0xa5, 0x25, 0x70, 0xd4, 0xcd, 0x17, 0xb3, 0x92, 0x7b, 0xe6, 0xf9, 0x37, 0xf3, 0xa8,
+	0xf3, 0xe2, 0x12, 0x94, 0x57, 0xca, 0x4c, 0xef, 0xcd, 0xc5, 0xf0, 0xa9, 0x6f, 0xea, 0x5c, 0x4c,
+	0x38, 0x27, 0xe7, 0x4f, 0x77, 0x85, 0x61, 0xc2, 0xef, 0x73, 0x30, 0x99, 0x70, 0x7e, 0x88, 0xde,
+	0xc8, 0xc4, 0x30, 0xae, 0x37, 0xc3, 0x2f, 0xef, 0x06, 0xca, 0x54, 0xfa, 0x19, 0x07, 0x85, 0xa4,
+	0x93, 0x38, 0xb4, 0x9c, 0x2d, 0xdb, 0xc7, 0x2a, 0xf5, 0xe6, 0xae, 0xb0, 0x4c, 0xab, 0x0f, 0xac,
+	0x1b, 0x75, 0xd1, 0xf3, 0x28, 0x74, 0xa6, 0x73, 0xfe, 0x8d, 0x39, 0x27, 0xe4, 0x5f, 0xef, 0x16,
+	0xc6, 0xd4, 0xf8, 0x84, 0x03, 0x3e, 0xf9, 0x9c, 0x03, 0x9d, 0xef, 0xe4, 0xf7, 0xb4, 0x06, 0x2e,
+	0x7f, 0x61, 0x97, 0x68, 0xa6, 0xdb, 0xa7, 0x1c, 0x4c, 0xa7, 0xb4, 0x5d, 0xd1, 0x85, 0x8e, 0xfe,
+	0x4f, 0xd5, 0xee, 0xad, 0xdd, 0xc2, 0x7d, 0xae, 0x4b, 0x3e, 0x4d, 0x48, 0x75, 0x5d, 0xc7, 0xe3,
+	0x1a, 0xfe, 0xc2, 0x2e, 0xd1, 0x4c, 0xb7, 0x87, 0x1c, 0x08, 0x1d, 0x1a, 0xf2, 0x68, 0xa5, 0x2b,
+	0xfb, 0xe3, 0xce, 0x3e, 0xf8, 0xf2, 0xd3, 0xb0, 0xf0, 0x4d, 0xcf, 0xa4, 0xe6, 0x31, 0x5a, 0xce,
+	0x96, 0x6c, 0xbb, 0x9e, 0x9e, 0x1d, 0xbb, 0xd5, 0x1f, 0x73, 0x30, 0x95, 0xd8, 0x83, 0x45, 0x6f,
+	0x66, 0xcc, 0xc9, 0xb1, 0x7a, 0x9d, 0xdf, 0x1d, 0xd8, 0x2b, 0xad, 0x03, 0x2d, 0xd8, 0xd4, 0xd2,
+	0x3a, 0xae, 0x3b, 0xcc, 0xbf, 0x96, 0x1d, 0xc0, 0x64, 0xde, 0x85, 0xe1, 0x50, 0x6f, 0x14, 0x2d,
+	0x74, 0x34, 0x22, 0x22, 0x77, 0xb1, 0x1b, 0x88, 0x27, 0x39, 0xd4, 0xb8, 0x4c, 0x95, 0x1c, 0xdf,
+	0x57, 0xe5, 0x17, 0xbb, 0x81, 0x30, 0xc9, 0x4d, 0x78, 0x3e, 0xd8, 0x17, 0x44, 0x69, 0x7e, 0x8b,
+	0x6d, 0x79, 0xf2, 0x0b, 0x5d, 0x20, 0xbc, 0x6a, 0xc1, 0xdf, 0xec, 0x4b, 0xad, 0x16, 0x62, 0x3a,
+	0x93, 0x7c, 0x29, 0x33, 0x3d, 0x15, 0x58, 0xbe, 0xfd, 0xf9, 0xe3, 0x19, 0xee, 0x8b, 0xc7, 0x33,
+	0xdc, 0xdf, 0x1f, 0xcf, 0x70, 0xf7, 0x9f, 0xcc, 0xf4, 0x7c, 0xf1, 0x64, 0xa6, 0xe7, 0xab, 0x27,
+	0x33, 0x3d, 0x30, 0xa5, 0xe1, 0x04, 0x66, 0xd7, 0xb9, 0xef, 0x2e, 0xf9, 0x2e, 0xa0, 0x7b, 0x44,
+	0xa7, 0x34, 0xec, 0x7b, 0x2a, 0xdd, 0xf5, 0xfe, 0x80, 0xd1, 0xbe, 0x92, 0xbe, 0x79, 0xd8, 0xfe,
+	0xf3, 0x83, 0xd3, 0xff, 0x19, 0x00, 0xac, 0x7f, 0xa4, 0xee, 0x0f, 0x3a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2480,16 +3279,30 @@ type MsgClient interface {
 	UpdateValueOwners(ctx context.Context, in *MsgUpdateValueOwnersRequest, opts ...grpc.CallOption) (*MsgUpdateValueOwnersResponse, error)
 	// MigrateValueOwner updates all scopes that have one value owner to have a another value owner.
 	MigrateValueOwner(ctx context.Context, in *MsgMigrateValueOwnerRequest, opts ...grpc.CallOption) (*MsgMigrateValueOwnerResponse, error)
+	// ListScopeForSale offers the value ownership of a scope for a price.
+	ListScopeForSale(ctx context.Context, in *MsgListScopeForSaleRequest, opts ...grpc.CallOption) (*MsgListScopeForSaleResponse, error)
+	// CancelScopeListing removes an offer to sell the value ownership of a scope.
+	CancelScopeListing(ctx context.Context, in *MsgCancelScopeListingRequest, opts ...grpc.CallOption) (*MsgCancelScopeListingResponse, error)
+	// BuyScope pays the listing price of a scope to its seller and makes the buyer the scope's value owner.
+	BuyScope(ctx context.Context, in *MsgBuyScopeRequest, opts ...grpc.CallOption) (*MsgBuyScopeResponse, error)
+	// LockScope places a legal hold on a scope, preventing changes to its owners, value owner, sessions and records.
+	LockScope(ctx context.Context, in *MsgLockScopeRequest, opts ...grpc.CallOption) (*MsgLockScopeResponse, error)
+	// UnlockScope removes the legal hold from a scope.
+	UnlockScope(ctx context.Context, in *MsgUnlockScopeRequest, opts ...grpc.CallOption) (*MsgUnlockScopeResponse, error)
 	// WriteSession adds or updates a session context.
 	WriteSession(ctx context.Context, in *MsgWriteSessionRequest, opts ...grpc.CallOption) (*MsgWriteSessionResponse, error)
 	// WriteRecord adds or updates a record.
 	WriteRecord(ctx context.Context, in *MsgWriteRecordRequest, opts ...grpc.CallOption) (*MsgWriteRecordResponse, error)
 	// DeleteRecord deletes a record.
 	DeleteRecord(ctx context.Context, in *MsgDeleteRecordRequest, opts ...grpc.CallOption) (*MsgDeleteRecordResponse, error)
+	// WriteScopeBundle adds or updates a scope along with sessions and records in it, all at once.
+	WriteScopeBundle(ctx context.Context, in *MsgWriteScopeBundleRequest, opts ...grpc.CallOption) (*MsgWriteScopeBundleResponse, error)
 	// WriteScopeSpecification adds or updates a scope specification.
 	WriteScopeSpecification(ctx context.Context, in *MsgWriteScopeSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteScopeSpecificationResponse, error)
 	// DeleteScopeSpecification deletes a scope specification.
 	DeleteScopeSpecification(ctx context.Context, in *MsgDeleteScopeSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteScopeSpecificationResponse, error)
+	// MigrateScopesToSpec moves scopes from one scope specification to another.
+	MigrateScopesToSpec(ctx context.Context, in *MsgMigrateScopesToSpecRequest, opts ...grpc.CallOption) (*MsgMigrateScopesToSpecResponse, error)
 	// WriteContractSpecification adds or updates a contract specification.
 	WriteContractSpecification(ctx context.Context, in *MsgWriteContractSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteContractSpecificationResponse, error)
 	// DeleteContractSpecification deletes a contract specification.
@@ -2511,6 +3324,8 @@ type MsgClient interface {
 	// SetAccountData associates some basic data with a metadata address.
 	// Currently, only scope ids are supported.
 	SetAccountData(ctx context.Context, in *MsgSetAccountDataRequest, opts ...grpc.CallOption) (*MsgSetAccountDataResponse, error)
+	// UpdateParams is a governance proposal endpoint for updating the metadata module's params.
+	UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -2593,63 +3408,126 @@ func (c *msgClient) MigrateValueOwner(ctx context.Context, in *MsgMigrateValueOw
 	return out, nil
 }
 
-func (c *msgClient) WriteSession(ctx context.Context, in *MsgWriteSessionRequest, opts ...grpc.CallOption) (*MsgWriteSessionResponse, error) {
-	out := new(MsgWriteSessionResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteSession", in, out, opts...)
+func (c *msgClient) ListScopeForSale(ctx context.Context, in *MsgListScopeForSaleRequest, opts ...grpc.CallOption) (*MsgListScopeForSaleResponse, error) {
+	out := new(MsgListScopeForSaleResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/ListScopeForSale", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *msgClient) WriteRecord(ctx context.Context, in *MsgWriteRecordRequest, opts ...grpc.CallOption) (*MsgWriteRecordResponse, error) {
-	out := new(MsgWriteRecordResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteRecord", in, out, opts...)
+func (c *msgClient) CancelScopeListing(ctx context.Context, in *MsgCancelScopeListingRequest, opts ...grpc.CallOption) (*MsgCancelScopeListingResponse, error) {
+	out := new(MsgCancelScopeListingResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/CancelScopeListing", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *msgClient) DeleteRecord(ctx context.Context, in *MsgDeleteRecordRequest, opts ...grpc.CallOption) (*MsgDeleteRecordResponse, error) {
-	out := new(MsgDeleteRecordResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteRecord", in, out, opts...)
+func (c *msgClient) BuyScope(ctx context.Context, in *MsgBuyScopeRequest, opts ...grpc.CallOption) (*MsgBuyScopeResponse, error) {
+	out := new(MsgBuyScopeResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/BuyScope", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *msgClient) WriteScopeSpecification(ctx context.Context, in *MsgWriteScopeSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteScopeSpecificationResponse, error) {
-	out := new(MsgWriteScopeSpecificationResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteScopeSpecification", in, out, opts...)
+func (c *msgClient) LockScope(ctx context.Context, in *MsgLockScopeRequest, opts ...grpc.CallOption) (*MsgLockScopeResponse, error) {
+	out := new(MsgLockScopeResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/LockScope", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *msgClient) DeleteScopeSpecification(ctx context.Context, in *MsgDeleteScopeSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteScopeSpecificationResponse, error) {
-	out := new(MsgDeleteScopeSpecificationResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteScopeSpecification", in, out, opts...)
+func (c *msgClient) UnlockScope(ctx context.Context, in *MsgUnlockScopeRequest, opts ...grpc.CallOption) (*MsgUnlockScopeResponse, error) {
+	out := new(MsgUnlockScopeResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/UnlockScope", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *msgClient) WriteContractSpecification(ctx context.Context, in *MsgWriteContractSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteContractSpecificationResponse, error) {
-	out := new(MsgWriteContractSpecificationResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteContractSpecification", in, out, opts...)
+func (c *msgClient) WriteSession(ctx context.Context, in *MsgWriteSessionRequest, opts ...grpc.CallOption) (*MsgWriteSessionResponse, error) {
+	out := new(MsgWriteSessionResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteSession", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *msgClient) DeleteContractSpecification(ctx context.Context, in *MsgDeleteContractSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteContractSpecificationResponse, error) {
-	out := new(MsgDeleteContractSpecificationResponse)
-	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteContractSpecification", in, out, opts...)
+func (c *msgClient) WriteRecord(ctx context.Context, in *MsgWriteRecordRequest, opts ...grpc.CallOption) (*MsgWriteRecordResponse, error) {
+	out := new(MsgWriteRecordResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteRecord(ctx context.Context, in *MsgDeleteRecordRequest, opts ...grpc.CallOption) (*MsgDeleteRecordResponse, error) {
+	out := new(MsgDeleteRecordResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WriteScopeBundle(ctx context.Context, in *MsgWriteScopeBundleRequest, opts ...grpc.CallOption) (*MsgWriteScopeBundleResponse, error) {
+	out := new(MsgWriteScopeBundleResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteScopeBundle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WriteScopeSpecification(ctx context.Context, in *MsgWriteScopeSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteScopeSpecificationResponse, error) {
+	out := new(MsgWriteScopeSpecificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteScopeSpecification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteScopeSpecification(ctx context.Context, in *MsgDeleteScopeSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteScopeSpecificationResponse, error) {
+	out := new(MsgDeleteScopeSpecificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteScopeSpecification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MigrateScopesToSpec(ctx context.Context, in *MsgMigrateScopesToSpecRequest, opts ...grpc.CallOption) (*MsgMigrateScopesToSpecResponse, error) {
+	out := new(MsgMigrateScopesToSpecResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/MigrateScopesToSpec", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WriteContractSpecification(ctx context.Context, in *MsgWriteContractSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteContractSpecificationResponse, error) {
+	out := new(MsgWriteContractSpecificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteContractSpecification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteContractSpecification(ctx context.Context, in *MsgDeleteContractSpecificationRequest, opts ...grpc.CallOption) (*MsgDeleteContractSpecificationResponse, error) {
+	out := new(MsgDeleteContractSpecificationResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/DeleteContractSpecification", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2728,6 +3606,15 @@ func (c *msgClient) SetAccountData(ctx context.Context, in *MsgSetAccountDataReq
 	return out, nil
 }
 
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// WriteScope adds or updates a scope.
@@ -2746,16 +3633,30 @@ type MsgServer interface {
 	UpdateValueOwners(context.Context, *MsgUpdateValueOwnersRequest) (*MsgUpdateValueOwnersResponse, error)
 	// MigrateValueOwner updates all scopes that have one value owner to have a another value owner.
 	MigrateValueOwner(context.Context, *MsgMigrateValueOwnerRequest) (*MsgMigrateValueOwnerResponse, error)
+	// ListScopeForSale offers the value ownership of a scope for a price.
+	ListScopeForSale(context.Context, *MsgListScopeForSaleRequest) (*MsgListScopeForSaleResponse, error)
+	// CancelScopeListing removes an offer to sell the value ownership of a scope.
+	CancelScopeListing(context.Context, *MsgCancelScopeListingRequest) (*MsgCancelScopeListingResponse, error)
+	// BuyScope pays the listing price of a scope to its seller and makes the buyer the scope's value owner.
+	BuyScope(context.Context, *MsgBuyScopeRequest) (*MsgBuyScopeResponse, error)
+	// LockScope places a legal hold on a scope, preventing changes to its owners, value owner, sessions and records.
+	LockScope(context.Context, *MsgLockScopeRequest) (*MsgLockScopeResponse, error)
+	// UnlockScope removes the legal hold from a scope.
+	UnlockScope(context.Context, *MsgUnlockScopeRequest) (*MsgUnlockScopeResponse, error)
 	// WriteSession adds or updates a session context.
 	WriteSession(context.Context, *MsgWriteSessionRequest) (*MsgWriteSessionResponse, error)
 	// WriteRecord adds or updates a record.
 	WriteRecord(context.Context, *MsgWriteRecordRequest) (*MsgWriteRecordResponse, error)
 	// DeleteRecord deletes a record.
 	DeleteRecord(context.Context, *MsgDeleteRecordRequest) (*MsgDeleteRecordResponse, error)
+	// WriteScopeBundle adds or updates a scope along with sessions and records in it, all at once.
+	WriteScopeBundle(context.Context, *MsgWriteScopeBundleRequest) (*MsgWriteScopeBundleResponse, error)
 	// WriteScopeSpecification adds or updates a scope specification.
 	WriteScopeSpecification(context.Context, *MsgWriteScopeSpecificationRequest) (*MsgWriteScopeSpecificationResponse, error)
 	// DeleteScopeSpecification deletes a scope specification.
 	DeleteScopeSpecification(context.Context, *MsgDeleteScopeSpecificationRequest) (*MsgDeleteScopeSpecificationResponse, error)
+	// MigrateScopesToSpec moves scopes from one scope specification to another.
+	MigrateScopesToSpec(context.Context, *MsgMigrateScopesToSpecRequest) (*MsgMigrateScopesToSpecResponse, error)
 	// WriteContractSpecification adds or updates a contract specification.
 	WriteContractSpecification(context.Context, *MsgWriteContractSpecificationRequest) (*MsgWriteContractSpecificationResponse, error)
 	// DeleteContractSpecification deletes a contract specification.
@@ -2777,6 +3678,8 @@ type MsgServer interface {
 	// SetAccountData associates some basic data with a metadata address.
 	// Currently, only scope ids are supported.
 	SetAccountData(context.Context, *MsgSetAccountDataRequest) (*MsgSetAccountDataResponse, error)
+	// UpdateParams is a governance proposal endpoint for updating the metadata module's params.
+	UpdateParams(context.Context, *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -2807,6 +3710,21 @@ func (*UnimplementedMsgServer) UpdateValueOwners(ctx context.Context, req *MsgUp
 func (*UnimplementedMsgServer) MigrateValueOwner(ctx context.Context, req *MsgMigrateValueOwnerRequest) (*MsgMigrateValueOwnerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MigrateValueOwner not implemented")
 }
+func (*UnimplementedMsgServer) ListScopeForSale(ctx context.Context, req *MsgListScopeForSaleRequest) (*MsgListScopeForSaleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScopeForSale not implemented")
+}
+func (*UnimplementedMsgServer) CancelScopeListing(ctx context.Context, req *MsgCancelScopeListingRequest) (*MsgCancelScopeListingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScopeListing not implemented")
+}
+func (*UnimplementedMsgServer) BuyScope(ctx context.Context, req *MsgBuyScopeRequest) (*MsgBuyScopeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuyScope not implemented")
+}
+func (*UnimplementedMsgServer) LockScope(ctx context.Context, req *MsgLockScopeRequest) (*MsgLockScopeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockScope not implemented")
+}
+func (*UnimplementedMsgServer) UnlockScope(ctx context.Context, req *MsgUnlockScopeRequest) (*MsgUnlockScopeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockScope not implemented")
+}
 func (*UnimplementedMsgServer) WriteSession(ctx context.Context, req *MsgWriteSessionRequest) (*MsgWriteSessionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WriteSession not implemented")
 }
@@ -2816,12 +3734,18 @@ func (*UnimplementedMsgServer) WriteRecord(ctx context.Context, req *MsgWriteRec
 func (*UnimplementedMsgServer) DeleteRecord(ctx context.Context, req *MsgDeleteRecordRequest) (*MsgDeleteRecordResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecord not implemented")
 }
+func (*UnimplementedMsgServer) WriteScopeBundle(ctx context.Context, req *MsgWriteScopeBundleRequest) (*MsgWriteScopeBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteScopeBundle not implemented")
+}
 func (*UnimplementedMsgServer) WriteScopeSpecification(ctx context.Context, req *MsgWriteScopeSpecificationRequest) (*MsgWriteScopeSpecificationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WriteScopeSpecification not implemented")
 }
 func (*UnimplementedMsgServer) DeleteScopeSpecification(ctx context.Context, req *MsgDeleteScopeSpecificationRequest) (*MsgDeleteScopeSpecificationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteScopeSpecification not implemented")
 }
+func (*UnimplementedMsgServer) MigrateScopesToSpec(ctx context.Context, req *MsgMigrateScopesToSpecRequest) (*MsgMigrateScopesToSpecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateScopesToSpec not implemented")
+}
 func (*UnimplementedMsgServer) WriteContractSpecification(ctx context.Context, req *MsgWriteContractSpecificationRequest) (*MsgWriteContractSpecificationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WriteContractSpecification not implemented")
 }
@@ -2852,6 +3776,9 @@ func (*UnimplementedMsgServer) ModifyOSLocator(ctx context.Context, req *MsgModi
 func (*UnimplementedMsgServer) SetAccountData(ctx context.Context, req *MsgSetAccountDataRequest) (*MsgSetAccountDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetAccountData not implemented")
 }
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -3001,6 +3928,96 @@ func _Msg_MigrateValueOwner_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ListScopeForSale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgListScopeForSaleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ListScopeForSale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/ListScopeForSale",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ListScopeForSale(ctx, req.(*MsgListScopeForSaleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelScopeListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScopeListingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScopeListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/CancelScopeListing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScopeListing(ctx, req.(*MsgCancelScopeListingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BuyScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBuyScopeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BuyScope(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/BuyScope",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BuyScope(ctx, req.(*MsgBuyScopeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_LockScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLockScopeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LockScope(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/LockScope",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LockScope(ctx, req.(*MsgLockScopeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnlockScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnlockScopeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnlockScope(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/UnlockScope",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnlockScope(ctx, req.(*MsgUnlockScopeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_WriteSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgWriteSessionRequest)
 	if err := dec(in); err != nil {
@@ -3055,6 +4072,24 @@ func _Msg_DeleteRecord_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_WriteScopeBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWriteScopeBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WriteScopeBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/WriteScopeBundle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WriteScopeBundle(ctx, req.(*MsgWriteScopeBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_WriteScopeSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgWriteScopeSpecificationRequest)
 	if err := dec(in); err != nil {
@@ -3091,6 +4126,24 @@ func _Msg_DeleteScopeSpecification_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_MigrateScopesToSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateScopesToSpecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateScopesToSpec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/MigrateScopesToSpec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateScopesToSpec(ctx, req.(*MsgMigrateScopesToSpecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_WriteContractSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgWriteContractSpecificationRequest)
 	if err := dec(in); err != nil {
@@ -3271,6 +4324,24 @@ func _Msg_SetAccountData_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "provenance.metadata.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -3307,6 +4378,26 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MigrateValueOwner",
 			Handler:    _Msg_MigrateValueOwner_Handler,
 		},
+		{
+			MethodName: "ListScopeForSale",
+			Handler:    _Msg_ListScopeForSale_Handler,
+		},
+		{
+			MethodName: "CancelScopeListing",
+			Handler:    _Msg_CancelScopeListing_Handler,
+		},
+		{
+			MethodName: "BuyScope",
+			Handler:    _Msg_BuyScope_Handler,
+		},
+		{
+			MethodName: "LockScope",
+			Handler:    _Msg_LockScope_Handler,
+		},
+		{
+			MethodName: "UnlockScope",
+			Handler:    _Msg_UnlockScope_Handler,
+		},
 		{
 			MethodName: "WriteSession",
 			Handler:    _Msg_WriteSession_Handler,
@@ -3319,6 +4410,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteRecord",
 			Handler:    _Msg_DeleteRecord_Handler,
 		},
+		{
+			MethodName: "WriteScopeBundle",
+			Handler:    _Msg_WriteScopeBundle_Handler,
+		},
 		{
 			MethodName: "WriteScopeSpecification",
 			Handler:    _Msg_WriteScopeSpecification_Handler,
@@ -3327,6 +4422,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteScopeSpecification",
 			Handler:    _Msg_DeleteScopeSpecification_Handler,
 		},
+		{
+			MethodName: "MigrateScopesToSpec",
+			Handler:    _Msg_MigrateScopesToSpec_Handler,
+		},
 		{
 			MethodName: "WriteContractSpecification",
 			Handler:    _Msg_WriteContractSpecification_Handler,
@@ -3367,6 +4466,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetAccountData",
 			Handler:    _Msg_SetAccountData_Handler,
 		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/metadata/v1/tx.proto",
@@ -3974,7 +5077,7 @@ func (m *MsgMigrateValueOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteSessionRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgListScopeForSaleRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3984,50 +5087,53 @@ func (m *MsgWriteSessionRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgWriteSessionRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgListScopeForSaleRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWriteSessionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgListScopeForSaleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SpecUuid) > 0 {
-		i -= len(m.SpecUuid)
-		copy(dAtA[i:], m.SpecUuid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SpecUuid)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.SessionIdComponents != nil {
-		{
-			size, err := m.SessionIdComponents.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
 	if len(m.Signers) > 0 {
 		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Signers[iNdEx])
 			copy(dAtA[i:], m.Signers[iNdEx])
 			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
 			i--
+			dAtA[i] = 0x22
+		}
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Price) > 0 {
+		for iNdEx := len(m.Price) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Price[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
 			dAtA[i] = 0x12
 		}
 	}
 	{
-		size, err := m.Session.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.ScopeId.Size()
+		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
@@ -4035,7 +5141,7 @@ func (m *MsgWriteSessionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *SessionIdComponents) Marshal() (dAtA []byte, err error) {
+func (m *MsgListScopeForSaleResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4045,99 +5151,20 @@ func (m *SessionIdComponents) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SessionIdComponents) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgListScopeForSaleResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SessionIdComponents) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgListScopeForSaleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SessionUuid) > 0 {
-		i -= len(m.SessionUuid)
-		copy(dAtA[i:], m.SessionUuid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SessionUuid)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.ScopeIdentifier != nil {
-		{
-			size := m.ScopeIdentifier.Size()
-			i -= size
-			if _, err := m.ScopeIdentifier.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SessionIdComponents_ScopeUuid) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SessionIdComponents_ScopeUuid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.ScopeUuid)
-	copy(dAtA[i:], m.ScopeUuid)
-	i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeUuid)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-func (m *SessionIdComponents_ScopeAddr) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SessionIdComponents_ScopeAddr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.ScopeAddr)
-	copy(dAtA[i:], m.ScopeAddr)
-	i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeAddr)))
-	i--
-	dAtA[i] = 0x12
-	return len(dAtA) - i, nil
-}
-func (m *MsgWriteSessionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgWriteSessionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgWriteSessionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SessionIdInfo != nil {
-		{
-			size, err := m.SessionIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgWriteRecordRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgCancelScopeListingRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4147,49 +5174,16 @@ func (m *MsgWriteRecordRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgWriteRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCancelScopeListingRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWriteRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCancelScopeListingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Parties) > 0 {
-		for iNdEx := len(m.Parties) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Parties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.ContractSpecUuid) > 0 {
-		i -= len(m.ContractSpecUuid)
-		copy(dAtA[i:], m.ContractSpecUuid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractSpecUuid)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.SessionIdComponents != nil {
-		{
-			size, err := m.SessionIdComponents.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
 	if len(m.Signers) > 0 {
 		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Signers[iNdEx])
@@ -4200,11 +5194,11 @@ func (m *MsgWriteRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	{
-		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.ScopeId.Size()
+		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
@@ -4212,7 +5206,7 @@ func (m *MsgWriteRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteRecordResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCancelScopeListingResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4222,32 +5216,20 @@ func (m *MsgWriteRecordResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgWriteRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCancelScopeListingResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWriteRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCancelScopeListingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.RecordIdInfo != nil {
-		{
-			size, err := m.RecordIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteRecordRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgBuyScopeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4257,12 +5239,12 @@ func (m *MsgDeleteRecordRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgBuyScopeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgBuyScopeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4273,13 +5255,34 @@ func (m *MsgDeleteRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			copy(dAtA[i:], m.Signers[iNdEx])
 			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Price) > 0 {
+		for iNdEx := len(m.Price) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Price[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x12
+	}
 	{
-		size := m.RecordId.Size()
+		size := m.ScopeId.Size()
 		i -= size
-		if _, err := m.RecordId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintTx(dAtA, i, uint64(size))
@@ -4289,7 +5292,7 @@ func (m *MsgDeleteRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteRecordResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgBuyScopeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4299,12 +5302,12 @@ func (m *MsgDeleteRecordResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgBuyScopeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgBuyScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4312,7 +5315,7 @@ func (m *MsgDeleteRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteScopeSpecificationRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgLockScopeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4322,38 +5325,38 @@ func (m *MsgWriteScopeSpecificationRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgWriteScopeSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgLockScopeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWriteScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgLockScopeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SpecUuid) > 0 {
-		i -= len(m.SpecUuid)
-		copy(dAtA[i:], m.SpecUuid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SpecUuid)))
-		i--
-		dAtA[i] = 0x1a
-	}
 	if len(m.Signers) > 0 {
 		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Signers[iNdEx])
 			copy(dAtA[i:], m.Signers[iNdEx])
 			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x1a
 		}
 	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
 	{
-		size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.ScopeId.Size()
+		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
@@ -4361,7 +5364,7 @@ func (m *MsgWriteScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteScopeSpecificationResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgLockScopeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4371,32 +5374,20 @@ func (m *MsgWriteScopeSpecificationResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgWriteScopeSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgLockScopeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWriteScopeSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgLockScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ScopeSpecIdInfo != nil {
-		{
-			size, err := m.ScopeSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteScopeSpecificationRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgUnlockScopeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4406,12 +5397,12 @@ func (m *MsgDeleteScopeSpecificationRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteScopeSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUnlockScopeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUnlockScopeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4426,9 +5417,9 @@ func (m *MsgDeleteScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (
 		}
 	}
 	{
-		size := m.SpecificationId.Size()
+		size := m.ScopeId.Size()
 		i -= size
-		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintTx(dAtA, i, uint64(size))
@@ -4438,7 +5429,7 @@ func (m *MsgDeleteScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteScopeSpecificationResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgUnlockScopeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4448,12 +5439,12 @@ func (m *MsgDeleteScopeSpecificationResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteScopeSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUnlockScopeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteScopeSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUnlockScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4461,7 +5452,7 @@ func (m *MsgDeleteScopeSpecificationResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteContractSpecificationRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgWriteSessionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4471,12 +5462,12 @@ func (m *MsgWriteContractSpecificationRequest) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *MsgWriteContractSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWriteSessionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWriteContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWriteSessionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4486,6 +5477,18 @@ func (m *MsgWriteContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte)
 		copy(dAtA[i:], m.SpecUuid)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.SpecUuid)))
 		i--
+		dAtA[i] = 0x22
+	}
+	if m.SessionIdComponents != nil {
+		{
+			size, err := m.SessionIdComponents.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
 		dAtA[i] = 0x1a
 	}
 	if len(m.Signers) > 0 {
@@ -4498,7 +5501,7 @@ func (m *MsgWriteContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte)
 		}
 	}
 	{
-		size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Session.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -4510,7 +5513,7 @@ func (m *MsgWriteContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteContractSpecificationResponse) Marshal() (dAtA []byte, err error) {
+func (m *SessionIdComponents) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4520,84 +5523,64 @@ func (m *MsgWriteContractSpecificationResponse) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *MsgWriteContractSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SessionIdComponents) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWriteContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SessionIdComponents) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ContractSpecIdInfo != nil {
+	if len(m.SessionUuid) > 0 {
+		i -= len(m.SessionUuid)
+		copy(dAtA[i:], m.SessionUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SessionUuid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ScopeIdentifier != nil {
 		{
-			size, err := m.ContractSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
+			size := m.ScopeIdentifier.Size()
+			i -= size
+			if _, err := m.ScopeIdentifier.MarshalTo(dAtA[i:]); err != nil {
 				return 0, err
 			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddContractSpecToScopeSpecRequest) Marshal() (dAtA []byte, err error) {
+func (m *SessionIdComponents_ScopeUuid) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddContractSpecToScopeSpecRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SessionIdComponents_ScopeUuid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.ScopeUuid)
+	copy(dAtA[i:], m.ScopeUuid)
+	i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeUuid)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+func (m *SessionIdComponents_ScopeAddr) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddContractSpecToScopeSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SessionIdComponents_ScopeAddr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signers[iNdEx])
-			copy(dAtA[i:], m.Signers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	{
-		size := m.ScopeSpecificationId.Size()
-		i -= size
-		if _, err := m.ScopeSpecificationId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
+	i -= len(m.ScopeAddr)
+	copy(dAtA[i:], m.ScopeAddr)
+	i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeAddr)))
 	i--
 	dAtA[i] = 0x12
-	{
-		size := m.ContractSpecificationId.Size()
-		i -= size
-		if _, err := m.ContractSpecificationId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
-
-func (m *MsgAddContractSpecToScopeSpecResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgWriteSessionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4607,20 +5590,32 @@ func (m *MsgAddContractSpecToScopeSpecResponse) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *MsgAddContractSpecToScopeSpecResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWriteSessionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddContractSpecToScopeSpecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWriteSessionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.SessionIdInfo != nil {
+		{
+			size, err := m.SessionIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteContractSpecFromScopeSpecRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgWriteRecordRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4630,41 +5625,64 @@ func (m *MsgDeleteContractSpecFromScopeSpecRequest) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteContractSpecFromScopeSpecRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWriteRecordRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteContractSpecFromScopeSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWriteRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Parties) > 0 {
+		for iNdEx := len(m.Parties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Parties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ContractSpecUuid) > 0 {
+		i -= len(m.ContractSpecUuid)
+		copy(dAtA[i:], m.ContractSpecUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractSpecUuid)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SessionIdComponents != nil {
+		{
+			size, err := m.SessionIdComponents.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Signers) > 0 {
 		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Signers[iNdEx])
 			copy(dAtA[i:], m.Signers[iNdEx])
 			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x12
 		}
 	}
 	{
-		size := m.ScopeSpecificationId.Size()
-		i -= size
-		if _, err := m.ScopeSpecificationId.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.ContractSpecificationId.Size()
 		i -= size
-		if _, err := m.ContractSpecificationId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
@@ -4672,7 +5690,7 @@ func (m *MsgDeleteContractSpecFromScopeSpecRequest) MarshalToSizedBuffer(dAtA []
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteContractSpecFromScopeSpecResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgWriteRecordResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4682,20 +5700,32 @@ func (m *MsgDeleteContractSpecFromScopeSpecResponse) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteContractSpecFromScopeSpecResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWriteRecordResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteContractSpecFromScopeSpecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWriteRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.RecordIdInfo != nil {
+		{
+			size, err := m.RecordIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteContractSpecificationRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteRecordRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4705,12 +5735,12 @@ func (m *MsgDeleteContractSpecificationRequest) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteContractSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteRecordRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4725,9 +5755,9 @@ func (m *MsgDeleteContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte
 		}
 	}
 	{
-		size := m.SpecificationId.Size()
+		size := m.RecordId.Size()
 		i -= size
-		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.RecordId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintTx(dAtA, i, uint64(size))
@@ -4737,7 +5767,7 @@ func (m *MsgDeleteContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteContractSpecificationResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteRecordResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4747,12 +5777,12 @@ func (m *MsgDeleteContractSpecificationResponse) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteContractSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteRecordResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4760,7 +5790,7 @@ func (m *MsgDeleteContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteRecordSpecificationRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgWriteScopeBundleRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4770,34 +5800,55 @@ func (m *MsgWriteRecordSpecificationRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgWriteRecordSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWriteScopeBundleRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWriteRecordSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWriteScopeBundleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ContractSpecUuid) > 0 {
-		i -= len(m.ContractSpecUuid)
-		copy(dAtA[i:], m.ContractSpecUuid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractSpecUuid)))
-		i--
-		dAtA[i] = 0x1a
-	}
 	if len(m.Signers) > 0 {
 		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Signers[iNdEx])
 			copy(dAtA[i:], m.Signers[iNdEx])
 			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
 			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Sessions) > 0 {
+		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
 			dAtA[i] = 0x12
 		}
 	}
 	{
-		size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -4809,7 +5860,7 @@ func (m *MsgWriteRecordSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteRecordSpecificationResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgWriteScopeBundleResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4819,19 +5870,47 @@ func (m *MsgWriteRecordSpecificationResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgWriteRecordSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWriteScopeBundleResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWriteRecordSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWriteScopeBundleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.RecordSpecIdInfo != nil {
+	if len(m.RecordIdInfos) > 0 {
+		for iNdEx := len(m.RecordIdInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordIdInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SessionIdInfos) > 0 {
+		for iNdEx := len(m.SessionIdInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SessionIdInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ScopeIdInfo != nil {
 		{
-			size, err := m.RecordSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ScopeIdInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4844,7 +5923,7 @@ func (m *MsgWriteRecordSpecificationResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteRecordSpecificationRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgWriteScopeSpecificationRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4854,16 +5933,23 @@ func (m *MsgDeleteRecordSpecificationRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteRecordSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWriteScopeSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteRecordSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWriteScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.SpecUuid) > 0 {
+		i -= len(m.SpecUuid)
+		copy(dAtA[i:], m.SpecUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SpecUuid)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Signers) > 0 {
 		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Signers[iNdEx])
@@ -4874,11 +5960,11 @@ func (m *MsgDeleteRecordSpecificationRequest) MarshalToSizedBuffer(dAtA []byte)
 		}
 	}
 	{
-		size := m.SpecificationId.Size()
-		i -= size
-		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
@@ -4886,7 +5972,7 @@ func (m *MsgDeleteRecordSpecificationRequest) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteRecordSpecificationResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgWriteScopeSpecificationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4896,20 +5982,32 @@ func (m *MsgDeleteRecordSpecificationResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteRecordSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWriteScopeSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteRecordSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWriteScopeSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.ScopeSpecIdInfo != nil {
+		{
+			size, err := m.ScopeSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgBindOSLocatorRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteScopeSpecificationRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4919,22 +6017,31 @@ func (m *MsgBindOSLocatorRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgBindOSLocatorRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBindOSLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
-		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.SpecificationId.Size()
+		i -= size
+		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
@@ -4942,7 +6049,7 @@ func (m *MsgBindOSLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgBindOSLocatorResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteScopeSpecificationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4952,22 +6059,78 @@ func (m *MsgBindOSLocatorResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgBindOSLocatorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBindOSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateScopesToSpecRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateScopesToSpecRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateScopesToSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ScopeIds) > 0 {
+		for iNdEx := len(m.ScopeIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.ScopeIds[iNdEx].Size()
+				i -= size
+				if _, err := m.ScopeIds[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
-		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.ToSpecificationId.Size()
+		i -= size
+		if _, err := m.ToSpecificationId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.FromSpecificationId.Size()
 		i -= size
+		if _, err := m.FromSpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
@@ -4975,7 +6138,7 @@ func (m *MsgBindOSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteOSLocatorRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgMigrateScopesToSpecResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4985,30 +6148,48 @@ func (m *MsgDeleteOSLocatorRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteOSLocatorRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgMigrateScopesToSpecResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteOSLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgMigrateScopesToSpecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Failures) > 0 {
+		for iNdEx := len(m.Failures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Failures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MigratedScopeIds) > 0 {
+		for iNdEx := len(m.MigratedScopeIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.MigratedScopeIds[iNdEx].Size()
+				i -= size
+				if _, err := m.MigratedScopeIds[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteOSLocatorResponse) Marshal() (dAtA []byte, err error) {
+func (m *ScopeMigrationFailure) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5018,22 +6199,29 @@ func (m *MsgDeleteOSLocatorResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteOSLocatorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScopeMigrationFailure) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteOSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScopeMigrationFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
 	{
-		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.ScopeId.Size()
+		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
@@ -5041,7 +6229,7 @@ func (m *MsgDeleteOSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgModifyOSLocatorRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgWriteContractSpecificationRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5051,18 +6239,34 @@ func (m *MsgModifyOSLocatorRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgModifyOSLocatorRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWriteContractSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgModifyOSLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWriteContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.SpecUuid) > 0 {
+		i -= len(m.SpecUuid)
+		copy(dAtA[i:], m.SpecUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SpecUuid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
-		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -5074,7 +6278,7 @@ func (m *MsgModifyOSLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgModifyOSLocatorResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgWriteContractSpecificationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5084,30 +6288,32 @@ func (m *MsgModifyOSLocatorResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgModifyOSLocatorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWriteContractSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgModifyOSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWriteContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.ContractSpecIdInfo != nil {
+		{
+			size, err := m.ContractSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetAccountDataRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddContractSpecToScopeSpecRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5117,12 +6323,12 @@ func (m *MsgSetAccountDataRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetAccountDataRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddContractSpecToScopeSpecRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetAccountDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddContractSpecToScopeSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5136,17 +6342,20 @@ func (m *MsgSetAccountDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
+	{
+		size := m.ScopeSpecificationId.Size()
+		i -= size
+		if _, err := m.ScopeSpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x12
 	{
-		size := m.MetadataAddr.Size()
+		size := m.ContractSpecificationId.Size()
 		i -= size
-		if _, err := m.MetadataAddr.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ContractSpecificationId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintTx(dAtA, i, uint64(size))
@@ -5156,7 +6365,7 @@ func (m *MsgSetAccountDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetAccountDataResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddContractSpecToScopeSpecResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5166,12 +6375,12 @@ func (m *MsgSetAccountDataResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetAccountDataResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddContractSpecToScopeSpecResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetAccountDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddContractSpecToScopeSpecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5179,7 +6388,7 @@ func (m *MsgSetAccountDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteP8EContractSpecRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteContractSpecFromScopeSpecRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5189,12 +6398,12 @@ func (m *MsgWriteP8EContractSpecRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgWriteP8EContractSpecRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteContractSpecFromScopeSpecRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWriteP8EContractSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteContractSpecFromScopeSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5205,15 +6414,25 @@ func (m *MsgWriteP8EContractSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 			copy(dAtA[i:], m.Signers[iNdEx])
 			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x1a
 		}
 	}
 	{
-		size, err := m.Contractspec.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.ScopeSpecificationId.Size()
+		i -= size
+		if _, err := m.ScopeSpecificationId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.ContractSpecificationId.Size()
 		i -= size
+		if _, err := m.ContractSpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
@@ -5221,7 +6440,7 @@ func (m *MsgWriteP8EContractSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteP8EContractSpecResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteContractSpecFromScopeSpecResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5231,46 +6450,20 @@ func (m *MsgWriteP8EContractSpecResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgWriteP8EContractSpecResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteContractSpecFromScopeSpecResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWriteP8EContractSpecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteContractSpecFromScopeSpecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RecordSpecIdInfos) > 0 {
-		for iNdEx := len(m.RecordSpecIdInfos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RecordSpecIdInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.ContractSpecIdInfo != nil {
-		{
-			size, err := m.ContractSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgP8EMemorializeContractRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteContractSpecificationRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5280,84 +6473,62 @@ func (m *MsgP8EMemorializeContractRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgP8EMemorializeContractRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteContractSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgP8EMemorializeContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Invoker) > 0 {
-		i -= len(m.Invoker)
-		copy(dAtA[i:], m.Invoker)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Invoker)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.Signatures != nil {
-		{
-			size, err := m.Signatures.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Contract != nil {
-		{
-			size, err := m.Contract.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
-		i--
-		dAtA[i] = 0x2a
 	}
-	if m.Recitals != nil {
-		{
-			size, err := m.Recitals.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+	{
+		size := m.SpecificationId.Size()
+		i -= size
+		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ScopeSpecificationId) > 0 {
-		i -= len(m.ScopeSpecificationId)
-		copy(dAtA[i:], m.ScopeSpecificationId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeSpecificationId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.GroupId) > 0 {
-		i -= len(m.GroupId)
-		copy(dAtA[i:], m.GroupId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupId)))
-		i--
-		dAtA[i] = 0x12
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if len(m.ScopeId) > 0 {
-		i -= len(m.ScopeId)
-		copy(dAtA[i:], m.ScopeId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeId)))
-		i--
-		dAtA[i] = 0xa
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteContractSpecificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteContractSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgP8EMemorializeContractResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgWriteRecordSpecificationRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5367,45 +6538,68 @@ func (m *MsgP8EMemorializeContractResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgP8EMemorializeContractResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWriteRecordSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgP8EMemorializeContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWriteRecordSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RecordIdInfos) > 0 {
-		for iNdEx := len(m.RecordIdInfos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RecordIdInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
+	if len(m.ContractSpecUuid) > 0 {
+		i -= len(m.ContractSpecUuid)
+		copy(dAtA[i:], m.ContractSpecUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractSpecUuid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x12
 		}
 	}
-	if m.SessionIdInfo != nil {
-		{
-			size, err := m.SessionIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+	{
+		size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x12
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.ScopeIdInfo != nil {
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWriteRecordSpecificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWriteRecordSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWriteRecordSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecordSpecIdInfo != nil {
 		{
-			size, err := m.ScopeIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.RecordSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5418,486 +6612,661 @@ func (m *MsgP8EMemorializeContractResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgDeleteRecordSpecificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgWriteScopeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgDeleteRecordSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteRecordSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Scope.Size()
-	n += 1 + l + sovTx(uint64(l))
 	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	l = len(m.ScopeUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.SpecUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.SpecificationId.Size()
+		i -= size
+		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteScopeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeleteRecordSpecificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteRecordSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteRecordSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ScopeIdInfo != nil {
-		l = m.ScopeIdInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteScopeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgBindOSLocatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.ScopeId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteScopeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgBindOSLocatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddScopeDataAccessRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgBindOSLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ScopeId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.DataAccess) > 0 {
-		for _, s := range m.DataAccess {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddScopeDataAccessResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgBindOSLocatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteScopeDataAccessRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgBindOSLocatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBindOSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ScopeId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.DataAccess) > 0 {
-		for _, s := range m.DataAccess {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteScopeDataAccessResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeleteOSLocatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgAddScopeOwnerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDeleteOSLocatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteOSLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ScopeId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Owners) > 0 {
-		for _, e := range m.Owners {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddScopeOwnerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeleteOSLocatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteScopeOwnerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDeleteOSLocatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteOSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ScopeId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Owners) > 0 {
-		for _, s := range m.Owners {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteScopeOwnerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgModifyOSLocatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateValueOwnersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgModifyOSLocatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgModifyOSLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ScopeIds) > 0 {
-		for _, e := range m.ScopeIds {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	l = len(m.ValueOwnerAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateValueOwnersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgModifyOSLocatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgMigrateValueOwnerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+func (m *MsgModifyOSLocatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgModifyOSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
 	_ = l
-	l = len(m.Existing)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Proposed)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgMigrateValueOwnerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetAccountDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgWriteSessionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetAccountDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAccountDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Session.Size()
-	n += 1 + l + sovTx(uint64(l))
 	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if m.SessionIdComponents != nil {
-		l = m.SessionIdComponents.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.SpecUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.MetadataAddr.Size()
+		i -= size
+		if _, err := m.MetadataAddr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *SessionIdComponents) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ScopeIdentifier != nil {
-		n += m.ScopeIdentifier.Size()
-	}
-	l = len(m.SessionUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetAccountDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SessionIdComponents_ScopeUuid) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ScopeUuid)
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgSetAccountDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *SessionIdComponents_ScopeAddr) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSetAccountDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ScopeAddr)
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *MsgWriteSessionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SessionIdInfo != nil {
-		l = m.SessionIdInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
+
+func (m *MsgUpdateParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgWriteRecordRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Record.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.SessionIdComponents != nil {
-		l = m.SessionIdComponents.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ContractSpecUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Parties) > 0 {
-		for _, e := range m.Parties {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteRecordResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RecordIdInfo != nil {
-		l = m.RecordIdInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteRecordRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.RecordId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteRecordResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteScopeSpecificationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgWriteP8EContractSpecRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWriteP8EContractSpecRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWriteP8EContractSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Specification.Size()
-	n += 1 + l + sovTx(uint64(l))
 	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	l = len(m.SpecUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Contractspec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteScopeSpecificationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgWriteP8EContractSpecResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWriteP8EContractSpecResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWriteP8EContractSpecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ScopeSpecIdInfo != nil {
-		l = m.ScopeSpecIdInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.RecordSpecIdInfos) > 0 {
+		for iNdEx := len(m.RecordSpecIdInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordSpecIdInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	return n
+	if m.ContractSpecIdInfo != nil {
+		{
+			size, err := m.ContractSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteScopeSpecificationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgP8EMemorializeContractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgP8EMemorializeContractRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgP8EMemorializeContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.SpecificationId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.Invoker) > 0 {
+		i -= len(m.Invoker)
+		copy(dAtA[i:], m.Invoker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Invoker)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Signatures != nil {
+		{
+			size, err := m.Signatures.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x32
 	}
-	return n
+	if m.Contract != nil {
+		{
+			size, err := m.Contract.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Recitals != nil {
+		{
+			size, err := m.Recitals.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ScopeSpecificationId) > 0 {
+		i -= len(m.ScopeSpecificationId)
+		copy(dAtA[i:], m.ScopeSpecificationId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeSpecificationId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.GroupId) > 0 {
+		i -= len(m.GroupId)
+		copy(dAtA[i:], m.GroupId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteScopeSpecificationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgP8EMemorializeContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgP8EMemorializeContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgP8EMemorializeContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.RecordIdInfos) > 0 {
+		for iNdEx := len(m.RecordIdInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordIdInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.SessionIdInfo != nil {
+		{
+			size, err := m.SessionIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ScopeIdInfo != nil {
+		{
+			size, err := m.ScopeIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteContractSpecificationRequest) Size() (n int) {
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgWriteScopeRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Specification.Size()
+	l = m.Scope.Size()
 	n += 1 + l + sovTx(uint64(l))
 	if len(m.Signers) > 0 {
 		for _, s := range m.Signers {
@@ -5905,6 +7274,10 @@ func (m *MsgWriteContractSpecificationRequest) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	l = len(m.ScopeUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	l = len(m.SpecUuid)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
@@ -5912,28 +7285,26 @@ func (m *MsgWriteContractSpecificationRequest) Size() (n int) {
 	return n
 }
 
-func (m *MsgWriteContractSpecificationResponse) Size() (n int) {
+func (m *MsgWriteScopeResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ContractSpecIdInfo != nil {
-		l = m.ContractSpecIdInfo.Size()
+	if m.ScopeIdInfo != nil {
+		l = m.ScopeIdInfo.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
 	return n
 }
 
-func (m *MsgAddContractSpecToScopeSpecRequest) Size() (n int) {
+func (m *MsgDeleteScopeRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ContractSpecificationId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.ScopeSpecificationId.Size()
+	l = m.ScopeId.Size()
 	n += 1 + l + sovTx(uint64(l))
 	if len(m.Signers) > 0 {
 		for _, s := range m.Signers {
@@ -5944,7 +7315,7 @@ func (m *MsgAddContractSpecToScopeSpecRequest) Size() (n int) {
 	return n
 }
 
-func (m *MsgAddContractSpecToScopeSpecResponse) Size() (n int) {
+func (m *MsgDeleteScopeResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5953,16 +7324,20 @@ func (m *MsgAddContractSpecToScopeSpecResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgDeleteContractSpecFromScopeSpecRequest) Size() (n int) {
+func (m *MsgAddScopeDataAccessRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ContractSpecificationId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.ScopeSpecificationId.Size()
+	l = m.ScopeId.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if len(m.DataAccess) > 0 {
+		for _, s := range m.DataAccess {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	if len(m.Signers) > 0 {
 		for _, s := range m.Signers {
 			l = len(s)
@@ -5972,7 +7347,7 @@ func (m *MsgDeleteContractSpecFromScopeSpecRequest) Size() (n int) {
 	return n
 }
 
-func (m *MsgDeleteContractSpecFromScopeSpecResponse) Size() (n int) {
+func (m *MsgAddScopeDataAccessResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5981,14 +7356,20 @@ func (m *MsgDeleteContractSpecFromScopeSpecResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgDeleteContractSpecificationRequest) Size() (n int) {
+func (m *MsgDeleteScopeDataAccessRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.SpecificationId.Size()
+	l = m.ScopeId.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if len(m.DataAccess) > 0 {
+		for _, s := range m.DataAccess {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	if len(m.Signers) > 0 {
 		for _, s := range m.Signers {
 			l = len(s)
@@ -5998,7 +7379,7 @@ func (m *MsgDeleteContractSpecificationRequest) Size() (n int) {
 	return n
 }
 
-func (m *MsgDeleteContractSpecificationResponse) Size() (n int) {
+func (m *MsgDeleteScopeDataAccessResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6007,48 +7388,86 @@ func (m *MsgDeleteContractSpecificationResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgWriteRecordSpecificationRequest) Size() (n int) {
+func (m *MsgAddScopeOwnerRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Specification.Size()
+	l = m.ScopeId.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if len(m.Owners) > 0 {
+		for _, e := range m.Owners {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	if len(m.Signers) > 0 {
 		for _, s := range m.Signers {
 			l = len(s)
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	l = len(m.ContractSpecUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAddScopeOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
+	var l int
+	_ = l
 	return n
 }
 
-func (m *MsgWriteRecordSpecificationResponse) Size() (n int) {
+func (m *MsgDeleteScopeOwnerRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.RecordSpecIdInfo != nil {
-		l = m.RecordSpecIdInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
+	l = m.ScopeId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Owners) > 0 {
+		for _, s := range m.Owners {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *MsgDeleteRecordSpecificationRequest) Size() (n int) {
+func (m *MsgDeleteScopeOwnerResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.SpecificationId.Size()
-	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateValueOwnersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScopeIds) > 0 {
+		for _, e := range m.ScopeIds {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.ValueOwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	if len(m.Signers) > 0 {
 		for _, s := range m.Signers {
 			l = len(s)
@@ -6058,7 +7477,7 @@ func (m *MsgDeleteRecordSpecificationRequest) Size() (n int) {
 	return n
 }
 
-func (m *MsgDeleteRecordSpecificationResponse) Size() (n int) {
+func (m *MsgUpdateValueOwnersResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6067,84 +7486,116 @@ func (m *MsgDeleteRecordSpecificationResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgBindOSLocatorRequest) Size() (n int) {
+func (m *MsgMigrateValueOwnerRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Locator.Size()
-	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Existing)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Proposed)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *MsgBindOSLocatorResponse) Size() (n int) {
+func (m *MsgMigrateValueOwnerResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Locator.Size()
-	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
-func (m *MsgDeleteOSLocatorRequest) Size() (n int) {
+func (m *MsgListScopeForSaleRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Locator.Size()
+	l = m.ScopeId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Price) > 0 {
+		for _, e := range m.Price {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration)
 	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *MsgDeleteOSLocatorResponse) Size() (n int) {
+func (m *MsgListScopeForSaleResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Locator.Size()
-	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
-func (m *MsgModifyOSLocatorRequest) Size() (n int) {
+func (m *MsgCancelScopeListingRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Locator.Size()
+	l = m.ScopeId.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *MsgModifyOSLocatorResponse) Size() (n int) {
+func (m *MsgCancelScopeListingResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Locator.Size()
-	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
-func (m *MsgSetAccountDataRequest) Size() (n int) {
+func (m *MsgBuyScopeRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.MetadataAddr.Size()
+	l = m.ScopeId.Size()
 	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Value)
+	l = len(m.Buyer)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.Price) > 0 {
+		for _, e := range m.Price {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	if len(m.Signers) > 0 {
 		for _, s := range m.Signers {
 			l = len(s)
@@ -6154,7 +7605,7 @@ func (m *MsgSetAccountDataRequest) Size() (n int) {
 	return n
 }
 
-func (m *MsgSetAccountDataResponse) Size() (n int) {
+func (m *MsgBuyScopeResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6163,14 +7614,18 @@ func (m *MsgSetAccountDataResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgWriteP8EContractSpecRequest) Size() (n int) {
+func (m *MsgLockScopeRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Contractspec.Size()
+	l = m.ScopeId.Size()
 	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	if len(m.Signers) > 0 {
 		for _, s := range m.Signers {
 			l = len(s)
@@ -6180,92 +7635,2374 @@ func (m *MsgWriteP8EContractSpecRequest) Size() (n int) {
 	return n
 }
 
-func (m *MsgWriteP8EContractSpecResponse) Size() (n int) {
+func (m *MsgLockScopeResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ContractSpecIdInfo != nil {
-		l = m.ContractSpecIdInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUnlockScopeRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if len(m.RecordSpecIdInfos) > 0 {
-		for _, e := range m.RecordSpecIdInfos {
-			l = e.Size()
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
 	return n
 }
 
-func (m *MsgP8EMemorializeContractRequest) Size() (n int) {
+func (m *MsgUnlockScopeResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ScopeId)
-	if l > 0 {
+	return n
+}
+
+func (m *MsgWriteSessionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Session.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.SessionIdComponents != nil {
+		l = m.SessionIdComponents.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.GroupId)
+	l = len(m.SpecUuid)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.ScopeSpecificationId)
+	return n
+}
+
+func (m *SessionIdComponents) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScopeIdentifier != nil {
+		n += m.ScopeIdentifier.Size()
+	}
+	l = len(m.SessionUuid)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.Recitals != nil {
-		l = m.Recitals.Size()
-		n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *SessionIdComponents_ScopeUuid) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.Contract != nil {
-		l = m.Contract.Size()
-		n += 1 + l + sovTx(uint64(l))
+	var l int
+	_ = l
+	l = len(m.ScopeUuid)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+func (m *SessionIdComponents_ScopeAddr) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.Signatures != nil {
-		l = m.Signatures.Size()
-		n += 1 + l + sovTx(uint64(l))
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+func (m *MsgWriteSessionResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	l = len(m.Invoker)
-	if l > 0 {
+	var l int
+	_ = l
+	if m.SessionIdInfo != nil {
+		l = m.SessionIdInfo.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgWriteRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Record.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.SessionIdComponents != nil {
+		l = m.SessionIdComponents.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractSpecUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Parties) > 0 {
+		for _, e := range m.Parties {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWriteRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecordIdInfo != nil {
+		l = m.RecordIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RecordId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWriteScopeBundleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Scope.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Sessions) > 0 {
+		for _, e := range m.Sessions {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWriteScopeBundleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScopeIdInfo != nil {
+		l = m.ScopeIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.SessionIdInfos) > 0 {
+		for _, e := range m.SessionIdInfos {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.RecordIdInfos) > 0 {
+		for _, e := range m.RecordIdInfos {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWriteScopeSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Specification.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.SpecUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWriteScopeSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScopeSpecIdInfo != nil {
+		l = m.ScopeSpecIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteScopeSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteScopeSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMigrateScopesToSpecRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FromSpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ToSpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.ScopeIds) > 0 {
+		for _, e := range m.ScopeIds {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgMigrateScopesToSpecResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MigratedScopeIds) > 0 {
+		for _, e := range m.MigratedScopeIds {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Failures) > 0 {
+		for _, e := range m.Failures {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ScopeMigrationFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWriteContractSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Specification.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.SpecUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWriteContractSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractSpecIdInfo != nil {
+		l = m.ContractSpecIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddContractSpecToScopeSpecRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ContractSpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ScopeSpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddContractSpecToScopeSpecResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteContractSpecFromScopeSpecRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ContractSpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ScopeSpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteContractSpecFromScopeSpecResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteContractSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteContractSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWriteRecordSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Specification.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.ContractSpecUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWriteRecordSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecordSpecIdInfo != nil {
+		l = m.RecordSpecIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteRecordSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteRecordSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBindOSLocatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Locator.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBindOSLocatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Locator.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDeleteOSLocatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Locator.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDeleteOSLocatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Locator.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgModifyOSLocatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Locator.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgModifyOSLocatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Locator.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetAccountDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MetadataAddr.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetAccountDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWriteP8EContractSpecRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Contractspec.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWriteP8EContractSpecResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractSpecIdInfo != nil {
+		l = m.ContractSpecIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.RecordSpecIdInfos) > 0 {
+		for _, e := range m.RecordSpecIdInfos {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgP8EMemorializeContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GroupId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ScopeSpecificationId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Recitals != nil {
+		l = m.Recitals.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Contract != nil {
+		l = m.Contract.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Signatures != nil {
+		l = m.Signatures.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Invoker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgP8EMemorializeContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScopeIdInfo != nil {
+		l = m.ScopeIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SessionIdInfo != nil {
+		l = m.SessionIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.RecordIdInfos) > 0 {
+		for _, e := range m.RecordIdInfos {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgWriteScopeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWriteScopeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWriteScopeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpecUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWriteScopeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWriteScopeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWriteScopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIdInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScopeIdInfo == nil {
+				m.ScopeIdInfo = &ScopeIdInfo{}
+			}
+			if err := m.ScopeIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteScopeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteScopeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteScopeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteScopeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteScopeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteScopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddScopeDataAccessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddScopeDataAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataAccess", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataAccess = append(m.DataAccess, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddScopeDataAccessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddScopeDataAccessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddScopeDataAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteScopeDataAccessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteScopeDataAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataAccess", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataAccess = append(m.DataAccess, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteScopeDataAccessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteScopeDataAccessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteScopeDataAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddScopeOwnerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddScopeOwnerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddScopeOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owners = append(m.Owners, Party{})
+			if err := m.Owners[len(m.Owners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddScopeOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddScopeOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddScopeOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteScopeOwnerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteScopeOwnerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteScopeOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owners = append(m.Owners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteScopeOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteScopeOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteScopeOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateValueOwnersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateValueOwnersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateValueOwnersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIds", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v MetadataAddress
+			m.ScopeIds = append(m.ScopeIds, v)
+			if err := m.ScopeIds[len(m.ScopeIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueOwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueOwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateValueOwnersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateValueOwnersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateValueOwnersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMigrateValueOwnerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateValueOwnerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateValueOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Existing", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Existing = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposed = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgP8EMemorializeContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ScopeIdInfo != nil {
-		l = m.ScopeIdInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.SessionIdInfo != nil {
-		l = m.SessionIdInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if len(m.RecordIdInfos) > 0 {
-		for _, e := range m.RecordIdInfos {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+func (m *MsgMigrateValueOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateValueOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateValueOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgWriteScopeRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgListScopeForSaleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6288,17 +10025,17 @@ func (m *MsgWriteScopeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteScopeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgListScopeForSaleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteScopeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgListScopeForSaleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6308,30 +10045,30 @@ func (m *MsgWriteScopeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6341,29 +10078,31 @@ func (m *MsgWriteScopeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			m.Price = append(m.Price, types.Coin{})
+			if err := m.Price[len(m.Price)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeUuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6373,27 +10112,28 @@ func (m *MsgWriteScopeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeUuid = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecUuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6421,7 +10161,7 @@ func (m *MsgWriteScopeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpecUuid = string(dAtA[iNdEx:postIndex])
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6444,7 +10184,7 @@ func (m *MsgWriteScopeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWriteScopeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgListScopeForSaleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6467,48 +10207,12 @@ func (m *MsgWriteScopeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteScopeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgListScopeForSaleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteScopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgListScopeForSaleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIdInfo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ScopeIdInfo == nil {
-				m.ScopeIdInfo = &ScopeIdInfo{}
-			}
-			if err := m.ScopeIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6530,7 +10234,7 @@ func (m *MsgWriteScopeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteScopeRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelScopeListingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6553,10 +10257,10 @@ func (m *MsgDeleteScopeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteScopeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelScopeListingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteScopeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelScopeListingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6645,7 +10349,7 @@ func (m *MsgDeleteScopeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteScopeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelScopeListingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6668,10 +10372,10 @@ func (m *MsgDeleteScopeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteScopeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelScopeListingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteScopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelScopeListingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6695,7 +10399,7 @@ func (m *MsgDeleteScopeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgBuyScopeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6718,10 +10422,10 @@ func (m *MsgAddScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddScopeDataAccessRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBuyScopeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddScopeDataAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBuyScopeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6759,7 +10463,7 @@ func (m *MsgAddScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataAccess", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6787,9 +10491,43 @@ func (m *MsgAddScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataAccess = append(m.DataAccess, string(dAtA[iNdEx:postIndex]))
+			m.Buyer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = append(m.Price, types.Coin{})
+			if err := m.Price[len(m.Price)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
@@ -6842,7 +10580,7 @@ func (m *MsgAddScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddScopeDataAccessResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBuyScopeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6865,10 +10603,10 @@ func (m *MsgAddScopeDataAccessResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddScopeDataAccessResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBuyScopeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddScopeDataAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBuyScopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6892,7 +10630,7 @@ func (m *MsgAddScopeDataAccessResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgLockScopeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6915,10 +10653,10 @@ func (m *MsgDeleteScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteScopeDataAccessRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLockScopeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteScopeDataAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLockScopeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6956,7 +10694,7 @@ func (m *MsgDeleteScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataAccess", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6984,7 +10722,7 @@ func (m *MsgDeleteScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataAccess = append(m.DataAccess, string(dAtA[iNdEx:postIndex]))
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -7039,7 +10777,7 @@ func (m *MsgDeleteScopeDataAccessRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteScopeDataAccessResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgLockScopeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7062,10 +10800,10 @@ func (m *MsgDeleteScopeDataAccessResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteScopeDataAccessResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLockScopeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteScopeDataAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLockScopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7089,7 +10827,7 @@ func (m *MsgDeleteScopeDataAccessResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddScopeOwnerRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgUnlockScopeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7112,10 +10850,10 @@ func (m *MsgAddScopeOwnerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddScopeOwnerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnlockScopeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddScopeOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnlockScopeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7152,40 +10890,6 @@ func (m *MsgAddScopeOwnerRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Owners = append(m.Owners, Party{})
-			if err := m.Owners[len(m.Owners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
@@ -7238,7 +10942,7 @@ func (m *MsgAddScopeOwnerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddScopeOwnerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnlockScopeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7261,10 +10965,10 @@ func (m *MsgAddScopeOwnerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddScopeOwnerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnlockScopeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddScopeOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnlockScopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7288,7 +10992,7 @@ func (m *MsgAddScopeOwnerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteScopeOwnerRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgWriteSessionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7311,17 +11015,17 @@ func (m *MsgDeleteScopeOwnerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteScopeOwnerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWriteSessionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteScopeOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWriteSessionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Session", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7331,28 +11035,28 @@ func (m *MsgDeleteScopeOwnerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Session.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7380,13 +11084,13 @@ func (m *MsgDeleteScopeOwnerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owners = append(m.Owners, string(dAtA[iNdEx:postIndex]))
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionIdComponents", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7396,74 +11100,60 @@ func (m *MsgDeleteScopeOwnerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
+			if m.SessionIdComponents == nil {
+				m.SessionIdComponents = &SessionIdComponents{}
+			}
+			if err := m.SessionIdComponents.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecUuid", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgDeleteScopeOwnerResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteScopeOwnerResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteScopeOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.SpecUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7485,7 +11175,7 @@ func (m *MsgDeleteScopeOwnerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateValueOwnersRequest) Unmarshal(dAtA []byte) error {
+func (m *SessionIdComponents) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7508,17 +11198,17 @@ func (m *MsgUpdateValueOwnersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateValueOwnersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SessionIdComponents: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateValueOwnersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SessionIdComponents: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeUuid", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7528,30 +11218,27 @@ func (m *MsgUpdateValueOwnersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v MetadataAddress
-			m.ScopeIds = append(m.ScopeIds, v)
-			if err := m.ScopeIds[len(m.ScopeIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ScopeIdentifier = &SessionIdComponents_ScopeUuid{string(dAtA[iNdEx:postIndex])}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueOwnerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7579,11 +11266,11 @@ func (m *MsgUpdateValueOwnersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValueOwnerAddress = string(dAtA[iNdEx:postIndex])
+			m.ScopeIdentifier = &SessionIdComponents_ScopeAddr{string(dAtA[iNdEx:postIndex])}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionUuid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7611,7 +11298,7 @@ func (m *MsgUpdateValueOwnersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			m.SessionUuid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7634,7 +11321,7 @@ func (m *MsgUpdateValueOwnersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateValueOwnersResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWriteSessionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7657,12 +11344,48 @@ func (m *MsgUpdateValueOwnersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateValueOwnersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWriteSessionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateValueOwnersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWriteSessionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionIdInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SessionIdInfo == nil {
+				m.SessionIdInfo = &SessionIdInfo{}
+			}
+			if err := m.SessionIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7684,7 +11407,7 @@ func (m *MsgUpdateValueOwnersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMigrateValueOwnerRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgWriteRecordRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7707,15 +11430,48 @@ func (m *MsgMigrateValueOwnerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMigrateValueOwnerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWriteRecordRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMigrateValueOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWriteRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Existing", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7743,11 +11499,47 @@ func (m *MsgMigrateValueOwnerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Existing = string(dAtA[iNdEx:postIndex])
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionIdComponents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SessionIdComponents == nil {
+				m.SessionIdComponents = &SessionIdComponents{}
+			}
+			if err := m.SessionIdComponents.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecUuid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7775,13 +11567,13 @@ func (m *MsgMigrateValueOwnerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposed = string(dAtA[iNdEx:postIndex])
+			m.ContractSpecUuid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parties", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7791,23 +11583,25 @@ func (m *MsgMigrateValueOwnerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			m.Parties = append(m.Parties, Party{})
+			if err := m.Parties[len(m.Parties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7830,7 +11624,7 @@ func (m *MsgMigrateValueOwnerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMigrateValueOwnerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWriteRecordResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7853,12 +11647,48 @@ func (m *MsgMigrateValueOwnerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMigrateValueOwnerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWriteRecordResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMigrateValueOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWriteRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordIdInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RecordIdInfo == nil {
+				m.RecordIdInfo = &RecordIdInfo{}
+			}
+			if err := m.RecordIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7880,7 +11710,7 @@ func (m *MsgMigrateValueOwnerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWriteSessionRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteRecordRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7903,17 +11733,17 @@ func (m *MsgWriteSessionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteSessionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteRecordRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteSessionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Session", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7923,22 +11753,22 @@ func (m *MsgWriteSessionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Session.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RecordId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7974,74 +11804,56 @@ func (m *MsgWriteSessionRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionIdComponents", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SessionIdComponents == nil {
-				m.SessionIdComponents = &SessionIdComponents{}
-			}
-			if err := m.SessionIdComponents.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecUuid", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpecUuid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8063,7 +11875,7 @@ func (m *MsgWriteSessionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SessionIdComponents) Unmarshal(dAtA []byte) error {
+func (m *MsgWriteScopeBundleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8086,17 +11898,17 @@ func (m *SessionIdComponents) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SessionIdComponents: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWriteScopeBundleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SessionIdComponents: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWriteScopeBundleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeUuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8106,29 +11918,30 @@ func (m *SessionIdComponents) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeIdentifier = &SessionIdComponents_ScopeUuid{string(dAtA[iNdEx:postIndex])}
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8138,27 +11951,63 @@ func (m *SessionIdComponents) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeIdentifier = &SessionIdComponents_ScopeAddr{string(dAtA[iNdEx:postIndex])}
+			m.Sessions = append(m.Sessions, Session{})
+			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionUuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, Record{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8186,7 +12035,7 @@ func (m *SessionIdComponents) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionUuid = string(dAtA[iNdEx:postIndex])
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8209,7 +12058,7 @@ func (m *SessionIdComponents) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWriteSessionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWriteScopeBundleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8232,15 +12081,15 @@ func (m *MsgWriteSessionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteSessionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWriteScopeBundleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteSessionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWriteScopeBundleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIdInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8267,10 +12116,78 @@ func (m *MsgWriteSessionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SessionIdInfo == nil {
-				m.SessionIdInfo = &SessionIdInfo{}
+			if m.ScopeIdInfo == nil {
+				m.ScopeIdInfo = &ScopeIdInfo{}
 			}
-			if err := m.SessionIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ScopeIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionIdInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionIdInfos = append(m.SessionIdInfos, &SessionIdInfo{})
+			if err := m.SessionIdInfos[len(m.SessionIdInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordIdInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordIdInfos = append(m.RecordIdInfos, &RecordIdInfo{})
+			if err := m.RecordIdInfos[len(m.RecordIdInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8295,7 +12212,7 @@ func (m *MsgWriteSessionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWriteRecordRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgWriteScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8318,15 +12235,15 @@ func (m *MsgWriteRecordRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteRecordRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWriteScopeSpecificationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWriteScopeSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Specification", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8353,7 +12270,7 @@ func (m *MsgWriteRecordRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Specification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8391,43 +12308,7 @@ func (m *MsgWriteRecordRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionIdComponents", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.SessionIdComponents == nil {
-				m.SessionIdComponents = &SessionIdComponents{}
-			}
-			if err := m.SessionIdComponents.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpecUuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecUuid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8455,41 +12336,7 @@ func (m *MsgWriteRecordRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractSpecUuid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parties", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Parties = append(m.Parties, Party{})
-			if err := m.Parties[len(m.Parties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SpecUuid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8512,7 +12359,7 @@ func (m *MsgWriteRecordRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWriteRecordResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWriteScopeSpecificationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8535,15 +12382,15 @@ func (m *MsgWriteRecordResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteRecordResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWriteScopeSpecificationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWriteScopeSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecIdInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8570,10 +12417,10 @@ func (m *MsgWriteRecordResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RecordIdInfo == nil {
-				m.RecordIdInfo = &RecordIdInfo{}
+			if m.ScopeSpecIdInfo == nil {
+				m.ScopeSpecIdInfo = &ScopeSpecIdInfo{}
 			}
-			if err := m.RecordIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ScopeSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8598,7 +12445,7 @@ func (m *MsgWriteRecordResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteRecordRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8621,15 +12468,15 @@ func (m *MsgDeleteRecordRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteRecordRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteScopeSpecificationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteScopeSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -8656,7 +12503,7 @@ func (m *MsgDeleteRecordRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RecordId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8713,7 +12560,7 @@ func (m *MsgDeleteRecordRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteRecordResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteScopeSpecificationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8736,10 +12583,10 @@ func (m *MsgDeleteRecordResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteRecordResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteScopeSpecificationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteScopeSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8763,7 +12610,7 @@ func (m *MsgDeleteRecordResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWriteScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateScopesToSpecRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8786,17 +12633,17 @@ func (m *MsgWriteScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteScopeSpecificationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateScopesToSpecRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteScopeSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateScopesToSpecRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Specification", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromSpecificationId", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8806,30 +12653,30 @@ func (m *MsgWriteScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Specification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FromSpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToSpecificationId", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8839,27 +12686,63 @@ func (m *MsgWriteScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			if err := m.ToSpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecUuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIds", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v MetadataAddress
+			m.ScopeIds = append(m.ScopeIds, v)
+			if err := m.ScopeIds[len(m.ScopeIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8887,7 +12770,7 @@ func (m *MsgWriteScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpecUuid = string(dAtA[iNdEx:postIndex])
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8910,7 +12793,7 @@ func (m *MsgWriteScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWriteScopeSpecificationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateScopesToSpecResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8933,15 +12816,50 @@ func (m *MsgWriteScopeSpecificationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteScopeSpecificationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateScopesToSpecResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteScopeSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateScopesToSpecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeSpecIdInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MigratedScopeIds", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v MetadataAddress
+			m.MigratedScopeIds = append(m.MigratedScopeIds, v)
+			if err := m.MigratedScopeIds[len(m.MigratedScopeIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8968,10 +12886,8 @@ func (m *MsgWriteScopeSpecificationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScopeSpecIdInfo == nil {
-				m.ScopeSpecIdInfo = &ScopeSpecIdInfo{}
-			}
-			if err := m.ScopeSpecIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Failures = append(m.Failures, ScopeMigrationFailure{})
+			if err := m.Failures[len(m.Failures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8996,7 +12912,7 @@ func (m *MsgWriteScopeSpecificationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
+func (m *ScopeMigrationFailure) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9019,15 +12935,15 @@ func (m *MsgDeleteScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteScopeSpecificationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeMigrationFailure: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteScopeSpecificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeMigrationFailure: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecificationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -9054,13 +12970,13 @@ func (m *MsgDeleteScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SpecificationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9088,7 +13004,7 @@ func (m *MsgDeleteScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9111,56 +13027,6 @@ func (m *MsgDeleteScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteScopeSpecificationResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteScopeSpecificationResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteScopeSpecificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
 func (m *MsgWriteContractSpecificationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -11048,6 +14914,171 @@ func (m *MsgSetAccountDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgWriteP8EContractSpecRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
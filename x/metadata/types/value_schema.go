@@ -0,0 +1,365 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// MaxRecordValueLength is the maximum length (in bytes) of the json of an inline record value.
+	MaxRecordValueLength = 4096
+	// MaxValueSchemaLength is the maximum length (in bytes) of a value schema's json schema.
+	MaxValueSchemaLength = 16384
+)
+
+// NewRecordValue creates a new RecordValue instance.
+func NewRecordValue(typeName, json string) *RecordValue {
+	return &RecordValue{TypeName: typeName, Json: json}
+}
+
+// ValidateBasic performs static checking of a RecordValue.
+func (v RecordValue) ValidateBasic() error {
+	if len(v.TypeName) == 0 {
+		return errors.New("type name cannot be empty")
+	}
+	if len(v.Json) > MaxRecordValueLength {
+		return fmt.Errorf("json exceeds maximum length (expected <= %d got: %d)", MaxRecordValueLength, len(v.Json))
+	}
+	if !json.Valid([]byte(v.Json)) {
+		return errors.New("json is not valid")
+	}
+	return nil
+}
+
+// NewValueSchema creates a new ValueSchema instance.
+func NewValueSchema(typeName, jsonSchema string) *ValueSchema {
+	return &ValueSchema{TypeName: typeName, JsonSchema: jsonSchema}
+}
+
+// ValidateBasic performs static checking of a ValueSchema, making sure that its json schema can be used.
+func (s ValueSchema) ValidateBasic() error {
+	if len(s.TypeName) == 0 {
+		return errors.New("type name cannot be empty")
+	}
+	if len(s.JsonSchema) > MaxValueSchemaLength {
+		return fmt.Errorf("json schema exceeds maximum length (expected <= %d got: %d)", MaxValueSchemaLength, len(s.JsonSchema))
+	}
+	if _, err := parseJSONSchema(s.JsonSchema); err != nil {
+		return fmt.Errorf("invalid json schema: %w", err)
+	}
+	return nil
+}
+
+// ValidateValue returns an error if the provided value json does not satisfy this schema.
+func (s ValueSchema) ValidateValue(value string) error {
+	schema, err := parseJSONSchema(s.JsonSchema)
+	if err != nil {
+		return fmt.Errorf("invalid json schema: %w", err)
+	}
+	var v interface{}
+	if err = json.Unmarshal([]byte(value), &v); err != nil {
+		return fmt.Errorf("invalid json: %w", err)
+	}
+	return schema.validate("$", v)
+}
+
+// String implements stringer interface
+func (s ValueSchema) String() string {
+	out, _ := yaml.Marshal(s)
+	return string(out)
+}
+
+// jsonSchema is the subset of a json schema that can be used to validate inline record values.
+type jsonSchema struct {
+	types                []string
+	enum                 []interface{}
+	properties           map[string]*jsonSchema
+	required             []string
+	additionalProperties *bool
+	items                *jsonSchema
+	minimum              *float64
+	maximum              *float64
+	minLength            *int
+	maxLength            *int
+	pattern              *regexp.Regexp
+	minItems             *int
+	maxItems             *int
+}
+
+// jsonSchemaTypes are the allowed values of the "type" keyword.
+var jsonSchemaTypes = map[string]bool{
+	"object": true, "array": true, "string": true, "number": true, "integer": true, "boolean": true, "null": true,
+}
+
+// jsonSchemaAnnotations are keywords that are allowed in a schema but have no effect on validation.
+var jsonSchemaAnnotations = map[string]bool{
+	"$schema": true, "$id": true, "$comment": true, "title": true, "description": true, "examples": true, "default": true,
+}
+
+// parseJSONSchema parses the provided json schema.
+func parseJSONSchema(schema string) (*jsonSchema, error) {
+	var raw interface{}
+	if err := json.Unmarshal([]byte(schema), &raw); err != nil {
+		return nil, err
+	}
+	return newJSONSchema("$", raw)
+}
+
+// newJSONSchema creates a jsonSchema from the decoded json of a schema.
+// An error is returned if the schema uses a keyword that isn't supported, so that
+// a schema is never assumed to be enforcing something that it isn't.
+func newJSONSchema(path string, raw interface{}) (*jsonSchema, error) {
+	obj, ok := raw.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s: schema must be an object", path)
+	}
+
+	// Sort the keywords so that the same error is always returned for an invalid schema.
+	keywords := make([]string, 0, len(obj))
+	for keyword := range obj {
+		keywords = append(keywords, keyword)
+	}
+	sort.Strings(keywords)
+
+	rv := &jsonSchema{}
+	var err error
+	for _, keyword := range keywords {
+		val := obj[keyword]
+		kwPath := path + "." + keyword
+		switch keyword {
+		case "type":
+			rv.types, err = jsonSchemaStrings(kwPath, val, true)
+			if err == nil {
+				for _, t := range rv.types {
+					if !jsonSchemaTypes[t] {
+						err = fmt.Errorf("%s: unknown type %q", kwPath, t)
+						break
+					}
+				}
+			}
+		case "enum":
+			var ok bool
+			if rv.enum, ok = val.([]interface{}); !ok || len(rv.enum) == 0 {
+				err = fmt.Errorf("%s: must be a non-empty array", kwPath)
+			}
+		case "const":
+			rv.enum = []interface{}{val}
+		case "properties":
+			props, ok := val.(map[string]interface{})
+			if !ok {
+				err = fmt.Errorf("%s: must be an object", kwPath)
+				break
+			}
+			// Sort the property names too so that the first invalid property is always the one reported.
+			names := make([]string, 0, len(props))
+			for name := range props {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			rv.properties = make(map[string]*jsonSchema, len(props))
+			for _, name := range names {
+				if rv.properties[name], err = newJSONSchema(kwPath+"."+name, props[name]); err != nil {
+					break
+				}
+			}
+		case "required":
+			rv.required, err = jsonSchemaStrings(kwPath, val, false)
+		case "additionalProperties":
+			b, ok := val.(bool)
+			if !ok {
+				err = fmt.Errorf("%s: only boolean values are supported", kwPath)
+			}
+			rv.additionalProperties = &b
+		case "items":
+			rv.items, err = newJSONSchema(kwPath, val)
+		case "minimum":
+			rv.minimum, err = jsonSchemaNumber(kwPath, val)
+		case "maximum":
+			rv.maximum, err = jsonSchemaNumber(kwPath, val)
+		case "minLength":
+			rv.minLength, err = jsonSchemaCount(kwPath, val)
+		case "maxLength":
+			rv.maxLength, err = jsonSchemaCount(kwPath, val)
+		case "minItems":
+			rv.minItems, err = jsonSchemaCount(kwPath, val)
+		case "maxItems":
+			rv.maxItems, err = jsonSchemaCount(kwPath, val)
+		case "pattern":
+			str, ok := val.(string)
+			if !ok {
+				err = fmt.Errorf("%s: must be a string", kwPath)
+				break
+			}
+			if rv.pattern, err = regexp.Compile(str); err != nil {
+				err = fmt.Errorf("%s: %w", kwPath, err)
+			}
+		default:
+			if !jsonSchemaAnnotations[keyword] {
+				err = fmt.Errorf("%s: unsupported keyword", kwPath)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return rv, nil
+}
+
+// jsonSchemaStrings gets a list of strings from a schema keyword value.
+// If allowSingle is true, a single string is also allowed.
+func jsonSchemaStrings(path string, val interface{}, allowSingle bool) ([]string, error) {
+	if str, ok := val.(string); ok && allowSingle {
+		return []string{str}, nil
+	}
+	list, ok := val.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s: must be an array of strings", path)
+	}
+	rv := make([]string, len(list))
+	for i, entry := range list {
+		if rv[i], ok = entry.(string); !ok {
+			return nil, fmt.Errorf("%s: must be an array of strings", path)
+		}
+	}
+	return rv, nil
+}
+
+// jsonSchemaNumber gets a number from a schema keyword value.
+func jsonSchemaNumber(path string, val interface{}) (*float64, error) {
+	num, ok := val.(float64)
+	if !ok {
+		return nil, fmt.Errorf("%s: must be a number", path)
+	}
+	return &num, nil
+}
+
+// jsonSchemaCount gets a non-negative integer from a schema keyword value.
+func jsonSchemaCount(path string, val interface{}) (*int, error) {
+	num, ok := val.(float64)
+	if !ok || num < 0 || num != math.Trunc(num) || num > math.MaxInt32 {
+		return nil, fmt.Errorf("%s: must be a non-negative integer", path)
+	}
+	count := int(num)
+	return &count, nil
+}
+
+// jsonType gets the json schema type name of a decoded json value.
+func jsonType(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		if val == math.Trunc(val) {
+			return "integer"
+		}
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+// validate returns an error if the provided decoded json value does not satisfy this schema.
+func (s *jsonSchema) validate(path string, v interface{}) error {
+	if len(s.types) > 0 {
+		vType := jsonType(v)
+		ok := false
+		for _, t := range s.types {
+			if t == vType || (t == "number" && vType == "integer") {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return fmt.Errorf("%s: expected %s, got %s", path, strings.Join(s.types, " or "), vType)
+		}
+	}
+
+	if len(s.enum) > 0 {
+		ok := false
+		for _, e := range s.enum {
+			if reflect.DeepEqual(e, v) {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return fmt.Errorf("%s: value is not one of the allowed values", path)
+		}
+	}
+
+	switch val := v.(type) {
+	case float64:
+		if s.minimum != nil && val < *s.minimum {
+			return fmt.Errorf("%s: %v is less than the minimum %v", path, val, *s.minimum)
+		}
+		if s.maximum != nil && val > *s.maximum {
+			return fmt.Errorf("%s: %v is more than the maximum %v", path, val, *s.maximum)
+		}
+	case string:
+		length := utf8.RuneCountInString(val)
+		if s.minLength != nil && length < *s.minLength {
+			return fmt.Errorf("%s: length %d is less than the minimum %d", path, length, *s.minLength)
+		}
+		if s.maxLength != nil && length > *s.maxLength {
+			return fmt.Errorf("%s: length %d is more than the maximum %d", path, length, *s.maxLength)
+		}
+		if s.pattern != nil && !s.pattern.MatchString(val) {
+			return fmt.Errorf("%s: does not match pattern %q", path, s.pattern.String())
+		}
+	case []interface{}:
+		if s.minItems != nil && len(val) < *s.minItems {
+			return fmt.Errorf("%s: %d items is less than the minimum %d", path, len(val), *s.minItems)
+		}
+		if s.maxItems != nil && len(val) > *s.maxItems {
+			return fmt.Errorf("%s: %d items is more than the maximum %d", path, len(val), *s.maxItems)
+		}
+		if s.items != nil {
+			for i, item := range val {
+				if err := s.items.validate(fmt.Sprintf("%s[%d]", path, i), item); err != nil {
+					return err
+				}
+			}
+		}
+	case map[string]interface{}:
+		for _, name := range s.required {
+			if _, ok := val[name]; !ok {
+				return fmt.Errorf("%s: missing required property %q", path, name)
+			}
+		}
+		names := make([]string, 0, len(val))
+		for name := range val {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			prop, known := s.properties[name]
+			if !known {
+				if s.additionalProperties != nil && !*s.additionalProperties {
+					return fmt.Errorf("%s: unknown property %q", path, name)
+				}
+				continue
+			}
+			if err := prop.validate(path+"."+name, val[name]); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
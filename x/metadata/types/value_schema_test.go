@@ -0,0 +1,187 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordValueValidateBasic(t *testing.T) {
+	tests := []struct {
+		name  string
+		value RecordValue
+		exp   string
+	}{
+		{name: "control", value: *NewRecordValue("type", `{"a":1}`), exp: ""},
+		{name: "scalar", value: *NewRecordValue("type", `"just a string"`), exp: ""},
+		{name: "no type name", value: *NewRecordValue("", `{}`), exp: "type name cannot be empty"},
+		{name: "invalid json", value: *NewRecordValue("type", `{"a":}`), exp: "json is not valid"},
+		{name: "empty json", value: *NewRecordValue("type", ""), exp: "json is not valid"},
+		{
+			name:  "too long",
+			value: *NewRecordValue("type", `"`+strings.Repeat("a", MaxRecordValueLength)+`"`),
+			exp:   "json exceeds maximum length (expected <= 4096 got: 4098)",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.value.ValidateBasic()
+			if len(tc.exp) > 0 {
+				assert.EqualError(t, err, tc.exp, "ValidateBasic")
+			} else {
+				assert.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestValueSchemaValidateBasic(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		exp    string
+	}{
+		{name: "empty schema", schema: `{}`, exp: ""},
+		{
+			name:   "all keywords",
+			schema: `{"$schema":"https://json-schema.org/draft/2020-12/schema","title":"loan","type":"object","required":["id"],"additionalProperties":false,"properties":{"id":{"type":"string","pattern":"^[a-z]+$","minLength":1,"maxLength":10},"amount":{"type":["integer","null"],"minimum":0,"maximum":100},"tags":{"type":"array","items":{"enum":["a","b"]},"minItems":1,"maxItems":2},"kind":{"const":"loan"}}}`,
+			exp:    "",
+		},
+		{name: "not json", schema: `{`, exp: "invalid json schema: unexpected end of JSON input"},
+		{name: "not an object", schema: `[]`, exp: "invalid json schema: $: schema must be an object"},
+		{name: "unknown type", schema: `{"type":"date"}`, exp: `invalid json schema: $.type: unknown type "date"`},
+		{name: "unsupported keyword", schema: `{"$ref":"#/defs/thing"}`, exp: "invalid json schema: $.$ref: unsupported keyword"},
+		{name: "nested unsupported keyword", schema: `{"properties":{"a":{"oneOf":[]}}}`, exp: "invalid json schema: $.properties.a.oneOf: unsupported keyword"},
+		{name: "several invalid properties", schema: `{"properties":{"d":{"not":{}},"b":{"allOf":[]},"c":{"anyOf":[]},"a":{"type":"date"}}}`, exp: `invalid json schema: $.properties.a.type: unknown type "date"`},
+		{name: "additionalProperties schema", schema: `{"additionalProperties":{}}`, exp: "invalid json schema: $.additionalProperties: only boolean values are supported"},
+		{name: "negative minLength", schema: `{"minLength":-1}`, exp: "invalid json schema: $.minLength: must be a non-negative integer"},
+		{name: "bad pattern", schema: `{"pattern":"("}`, exp: "invalid json schema: $.pattern: error parsing regexp: missing closing ): `(`"},
+		{name: "empty enum", schema: `{"enum":[]}`, exp: "invalid json schema: $.enum: must be a non-empty array"},
+		{name: "required not strings", schema: `{"required":[1]}`, exp: "invalid json schema: $.required: must be an array of strings"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := NewValueSchema("type", tc.schema).ValidateBasic()
+			if len(tc.exp) > 0 {
+				assert.EqualError(t, err, tc.exp, "ValidateBasic")
+			} else {
+				assert.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+
+	assert.EqualError(t, NewValueSchema("", "{}").ValidateBasic(), "type name cannot be empty", "ValidateBasic without a type name")
+}
+
+func TestValueSchemaKeywords(t *testing.T) {
+	tests := []struct {
+		keyword string
+		value   string
+		exp     string
+	}{
+		{keyword: "type", value: `"string"`},
+		{keyword: "enum", value: `["a"]`},
+		{keyword: "const", value: `"a"`},
+		{keyword: "properties", value: `{"a":{}}`},
+		{keyword: "required", value: `["a"]`},
+		{keyword: "additionalProperties", value: `false`},
+		{keyword: "items", value: `{}`},
+		{keyword: "minItems", value: `1`},
+		{keyword: "maxItems", value: `1`},
+		{keyword: "minimum", value: `1`},
+		{keyword: "maximum", value: `1`},
+		{keyword: "minLength", value: `1`},
+		{keyword: "maxLength", value: `1`},
+		{keyword: "pattern", value: `"^a$"`},
+		{keyword: "$schema", value: `"https://json-schema.org/draft/2020-12/schema"`},
+		{keyword: "$id", value: `"https://example.com/loan"`},
+		{keyword: "$comment", value: `"comment"`},
+		{keyword: "title", value: `"title"`},
+		{keyword: "description", value: `"description"`},
+		{keyword: "examples", value: `["a"]`},
+		{keyword: "default", value: `"a"`},
+
+		{keyword: "$ref", value: `"#/$defs/a"`, exp: "unsupported keyword"},
+		{keyword: "$defs", value: `{"a":{}}`, exp: "unsupported keyword"},
+		{keyword: "allOf", value: `[{}]`, exp: "unsupported keyword"},
+		{keyword: "anyOf", value: `[{}]`, exp: "unsupported keyword"},
+		{keyword: "oneOf", value: `[{}]`, exp: "unsupported keyword"},
+		{keyword: "not", value: `{}`, exp: "unsupported keyword"},
+		{keyword: "if", value: `{}`, exp: "unsupported keyword"},
+		{keyword: "then", value: `{}`, exp: "unsupported keyword"},
+		{keyword: "else", value: `{}`, exp: "unsupported keyword"},
+		{keyword: "format", value: `"date"`, exp: "unsupported keyword"},
+		{keyword: "multipleOf", value: `2`, exp: "unsupported keyword"},
+		{keyword: "exclusiveMinimum", value: `1`, exp: "unsupported keyword"},
+		{keyword: "exclusiveMaximum", value: `1`, exp: "unsupported keyword"},
+		{keyword: "uniqueItems", value: `true`, exp: "unsupported keyword"},
+		{keyword: "contains", value: `{}`, exp: "unsupported keyword"},
+		{keyword: "prefixItems", value: `[{}]`, exp: "unsupported keyword"},
+		{keyword: "patternProperties", value: `{"^a":{}}`, exp: "unsupported keyword"},
+		{keyword: "dependentRequired", value: `{"a":["b"]}`, exp: "unsupported keyword"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.keyword, func(t *testing.T) {
+			schema := `{"properties":{"a":{"` + tc.keyword + `":` + tc.value + `}}}`
+			err := NewValueSchema("type", schema).ValidateBasic()
+			if len(tc.exp) > 0 {
+				assert.EqualError(t, err, "invalid json schema: $.properties.a."+tc.keyword+": "+tc.exp, "ValidateBasic(%s)", schema)
+			} else {
+				assert.NoError(t, err, "ValidateBasic(%s)", schema)
+			}
+		})
+	}
+}
+
+func TestValueSchemaValidateValue(t *testing.T) {
+	schema := NewValueSchema("loan", `{
+		"type": "object",
+		"required": ["id", "amount"],
+		"additionalProperties": false,
+		"properties": {
+			"id": {"type": "string", "pattern": "^[a-z]+$", "maxLength": 5},
+			"amount": {"type": "integer", "minimum": 1, "maximum": 100},
+			"rate": {"type": "number"},
+			"tags": {"type": "array", "items": {"enum": ["new", "old"]}, "maxItems": 2},
+			"note": {"type": ["string", "null"], "minLength": 2}
+		}
+	}`)
+
+	tests := []struct {
+		name  string
+		value string
+		exp   string
+	}{
+		{name: "control", value: `{"id":"abc","amount":5}`, exp: ""},
+		{name: "all fields", value: `{"id":"abc","amount":5,"rate":1.5,"tags":["new","old"],"note":null}`, exp: ""},
+		{name: "integer as number", value: `{"id":"abc","amount":5,"rate":2}`, exp: ""},
+		{name: "not an object", value: `[]`, exp: "$: expected object, got array"},
+		{name: "missing required", value: `{"id":"abc"}`, exp: `$: missing required property "amount"`},
+		{name: "unknown property", value: `{"id":"abc","amount":5,"other":1}`, exp: `$: unknown property "other"`},
+		{name: "wrong type", value: `{"id":1,"amount":5}`, exp: "$.id: expected string, got integer"},
+		{name: "not an integer", value: `{"id":"abc","amount":1.5}`, exp: "$.amount: expected integer, got number"},
+		{name: "below minimum", value: `{"id":"abc","amount":0}`, exp: "$.amount: 0 is less than the minimum 1"},
+		{name: "above maximum", value: `{"id":"abc","amount":101}`, exp: "$.amount: 101 is more than the maximum 100"},
+		{name: "too long", value: `{"id":"abcdef","amount":5}`, exp: "$.id: length 6 is more than the maximum 5"},
+		{name: "too short", value: `{"id":"abc","amount":5,"note":"a"}`, exp: "$.note: length 1 is less than the minimum 2"},
+		{name: "pattern", value: `{"id":"ABC","amount":5}`, exp: `$.id: does not match pattern "^[a-z]+$"`},
+		{name: "enum", value: `{"id":"abc","amount":5,"tags":["new","bad"]}`, exp: "$.tags[1]: value is not one of the allowed values"},
+		{name: "too many items", value: `{"id":"abc","amount":5,"tags":["new","new","old"]}`, exp: "$.tags: 3 items is more than the maximum 2"},
+		{name: "invalid json", value: `{"id":`, exp: "invalid json: unexpected end of JSON input"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := schema.ValidateValue(tc.value)
+			if len(tc.exp) > 0 {
+				assert.EqualError(t, err, tc.exp, "ValidateValue")
+			} else {
+				assert.NoError(t, err, "ValidateValue")
+			}
+		})
+	}
+}
@@ -15,6 +15,42 @@ import (
 type MetadataMsgParams struct {
 	// Params for encoding a MsgWriteScopeRequest
 	WriteScope *WriteScope `json:"write_scope,omitempty"`
+	// Params for encoding a MsgDeleteScopeRequest
+	DeleteScope *DeleteScope `json:"delete_scope,omitempty"`
+	// Params for encoding a MsgAddScopeOwnerRequest
+	AddScopeOwner *AddScopeOwner `json:"add_scope_owner,omitempty"`
+	// Params for encoding a MsgDeleteScopeOwnerRequest
+	DeleteScopeOwner *DeleteScopeOwner `json:"delete_scope_owner,omitempty"`
+	// Params for encoding a MsgAddScopeDataAccessRequest
+	AddScopeDataAccess *AddScopeDataAccess `json:"add_scope_data_access,omitempty"`
+	// Params for encoding a MsgDeleteScopeDataAccessRequest
+	DeleteScopeDataAccess *DeleteScopeDataAccess `json:"delete_scope_data_access,omitempty"`
+	// Params for encoding a MsgUpdateValueOwnersRequest
+	UpdateValueOwners *UpdateValueOwners `json:"update_value_owners,omitempty"`
+	// Params for encoding a MsgMigrateValueOwnerRequest
+	MigrateValueOwner *MigrateValueOwner `json:"migrate_value_owner,omitempty"`
+	// Params for encoding a MsgWriteSessionRequest
+	WriteSession *WriteSession `json:"write_session,omitempty"`
+	// Params for encoding a MsgWriteRecordRequest
+	WriteRecord *WriteRecord `json:"write_record,omitempty"`
+	// Params for encoding a MsgDeleteRecordRequest
+	DeleteRecord *DeleteRecord `json:"delete_record,omitempty"`
+	// Params for encoding a MsgWriteScopeSpecificationRequest
+	WriteScopeSpecification *WriteScopeSpecification `json:"write_scope_specification,omitempty"`
+	// Params for encoding a MsgDeleteScopeSpecificationRequest
+	DeleteScopeSpecification *DeleteScopeSpecification `json:"delete_scope_specification,omitempty"`
+	// Params for encoding a MsgWriteContractSpecificationRequest
+	WriteContractSpecification *WriteContractSpecification `json:"write_contract_specification,omitempty"`
+	// Params for encoding a MsgDeleteContractSpecificationRequest
+	DeleteContractSpecification *DeleteContractSpecification `json:"delete_contract_specification,omitempty"`
+	// Params for encoding a MsgAddContractSpecToScopeSpecRequest
+	AddContractSpecToScopeSpec *AddContractSpecToScopeSpec `json:"add_contract_spec_to_scope_spec,omitempty"`
+	// Params for encoding a MsgDeleteContractSpecFromScopeSpecRequest
+	DeleteContractSpecFromScopeSpec *DeleteContractSpecFromScopeSpec `json:"delete_contract_spec_from_scope_spec,omitempty"`
+	// Params for encoding a MsgWriteRecordSpecificationRequest
+	WriteRecordSpecification *WriteRecordSpecification `json:"write_record_specification,omitempty"`
+	// Params for encoding a MsgDeleteRecordSpecificationRequest
+	DeleteRecordSpecification *DeleteRecordSpecification `json:"delete_record_specification,omitempty"`
 }
 
 // WriteScope are params for encoding a MsgWriteScopeRequest.
@@ -25,6 +61,168 @@ type WriteScope struct {
 	Signers []string `json:"signers"`
 }
 
+// DeleteScope are params for encoding a MsgDeleteScopeRequest.
+type DeleteScope struct {
+	// The bech32 address of the scope to delete.
+	ScopeID string `json:"scope_id"`
+	// The signers' addresses.
+	Signers []string `json:"signers"`
+}
+
+// AddScopeOwner are params for encoding a MsgAddScopeOwnerRequest.
+type AddScopeOwner struct {
+	// The bech32 address of the scope to update.
+	ScopeID string `json:"scope_id"`
+	// The owners to add to the scope.
+	Owners []*Party `json:"owners"`
+	// The signers' addresses.
+	Signers []string `json:"signers"`
+}
+
+// DeleteScopeOwner are params for encoding a MsgDeleteScopeOwnerRequest.
+type DeleteScopeOwner struct {
+	// The bech32 address of the scope to update.
+	ScopeID string `json:"scope_id"`
+	// The addresses of the owners to remove from the scope.
+	Owners []string `json:"owners"`
+	// The signers' addresses.
+	Signers []string `json:"signers"`
+}
+
+// AddScopeDataAccess are params for encoding a MsgAddScopeDataAccessRequest.
+type AddScopeDataAccess struct {
+	// The bech32 address of the scope to update.
+	ScopeID string `json:"scope_id"`
+	// The addresses to add to the scope's data access list.
+	DataAccess []string `json:"data_access"`
+	// The signers' addresses.
+	Signers []string `json:"signers"`
+}
+
+// DeleteScopeDataAccess are params for encoding a MsgDeleteScopeDataAccessRequest.
+type DeleteScopeDataAccess struct {
+	// The bech32 address of the scope to update.
+	ScopeID string `json:"scope_id"`
+	// The addresses to remove from the scope's data access list.
+	DataAccess []string `json:"data_access"`
+	// The signers' addresses.
+	Signers []string `json:"signers"`
+}
+
+// UpdateValueOwners are params for encoding a MsgUpdateValueOwnersRequest.
+type UpdateValueOwners struct {
+	// The bech32 addresses of the scopes to update.
+	ScopeIDs []string `json:"scope_ids"`
+	// The new value owner of the scopes.
+	ValueOwnerAddress string `json:"value_owner_address"`
+	// The signers' addresses.
+	Signers []string `json:"signers"`
+}
+
+// MigrateValueOwner are params for encoding a MsgMigrateValueOwnerRequest.
+type MigrateValueOwner struct {
+	// The current value owner address.
+	Existing string `json:"existing"`
+	// The new value owner address.
+	Proposed string `json:"proposed"`
+	// The signers' addresses.
+	Signers []string `json:"signers"`
+}
+
+// WriteSession are params for encoding a MsgWriteSessionRequest.
+type WriteSession struct {
+	// The session we want to create/update.
+	Session Session `json:"session"`
+	// The signers' addresses.
+	Signers []string `json:"signers"`
+}
+
+// WriteRecord are params for encoding a MsgWriteRecordRequest.
+type WriteRecord struct {
+	// The record we want to create/update.
+	Record Record `json:"record"`
+	// The signers' addresses.
+	Signers []string `json:"signers"`
+	// The parties involved in the record (optional).
+	Parties []*Party `json:"parties,omitempty"`
+}
+
+// DeleteRecord are params for encoding a MsgDeleteRecordRequest.
+type DeleteRecord struct {
+	// The bech32 address of the record to delete.
+	RecordID string `json:"record_id"`
+	// The signers' addresses.
+	Signers []string `json:"signers"`
+}
+
+// WriteScopeSpecification are params for encoding a MsgWriteScopeSpecificationRequest.
+type WriteScopeSpecification struct {
+	// The scope specification we want to create/update.
+	Specification ScopeSpecification `json:"specification"`
+	// The signers' addresses.
+	Signers []string `json:"signers"`
+}
+
+// WriteContractSpecification are params for encoding a MsgWriteContractSpecificationRequest.
+type WriteContractSpecification struct {
+	// The contract specification we want to create/update.
+	Specification ContractSpecification `json:"specification"`
+	// The signers' addresses.
+	Signers []string `json:"signers"`
+}
+
+// WriteRecordSpecification are params for encoding a MsgWriteRecordSpecificationRequest.
+type WriteRecordSpecification struct {
+	// The record specification we want to create/update.
+	Specification RecordSpecification `json:"specification"`
+	// The signers' addresses.
+	Signers []string `json:"signers"`
+}
+
+// DeleteScopeSpecification are params for encoding a MsgDeleteScopeSpecificationRequest.
+type DeleteScopeSpecification struct {
+	// The bech32 address of the scope specification to delete.
+	SpecificationID string `json:"specification_id"`
+	// The signers' addresses.
+	Signers []string `json:"signers"`
+}
+
+// DeleteContractSpecification are params for encoding a MsgDeleteContractSpecificationRequest.
+type DeleteContractSpecification struct {
+	// The bech32 address of the contract specification to delete.
+	SpecificationID string `json:"specification_id"`
+	// The signers' addresses.
+	Signers []string `json:"signers"`
+}
+
+// DeleteRecordSpecification are params for encoding a MsgDeleteRecordSpecificationRequest.
+type DeleteRecordSpecification struct {
+	// The bech32 address of the record specification to delete.
+	SpecificationID string `json:"specification_id"`
+	// The signers' addresses.
+	Signers []string `json:"signers"`
+}
+
+// AddContractSpecToScopeSpec are params for encoding a MsgAddContractSpecToScopeSpecRequest.
+type AddContractSpecToScopeSpec struct {
+	// The bech32 address of the contract specification to add.
+	ContractSpecificationID string `json:"contract_specification_id"`
+	// The bech32 address of the scope specification to update.
+	ScopeSpecificationID string `json:"scope_specification_id"`
+	// The signers' addresses.
+	Signers []string `json:"signers"`
+}
+
+// DeleteContractSpecFromScopeSpec are params for encoding a MsgDeleteContractSpecFromScopeSpecRequest.
+type DeleteContractSpecFromScopeSpec struct {
+	// The bech32 address of the contract specification to remove.
+	ContractSpecificationID string `json:"contract_specification_id"`
+	// The bech32 address of the scope specification to update.
+	ScopeSpecificationID string `json:"scope_specification_id"`
+	// The signers' addresses.
+	Signers []string `json:"signers"`
+}
+
 // Encoder returns a smart contract message encoder for the metadata module.
 func Encoder(_ sdk.AccAddress, msg json.RawMessage, _ string) ([]sdk.Msg, error) {
 	wrapper := struct {
@@ -40,19 +238,51 @@ func Encoder(_ sdk.AccAddress, msg json.RawMessage, _ string) ([]sdk.Msg, error)
 	switch {
 	case params.WriteScope != nil:
 		return params.WriteScope.Encode()
+	case params.DeleteScope != nil:
+		return params.DeleteScope.Encode()
+	case params.AddScopeOwner != nil:
+		return params.AddScopeOwner.Encode()
+	case params.DeleteScopeOwner != nil:
+		return params.DeleteScopeOwner.Encode()
+	case params.AddScopeDataAccess != nil:
+		return params.AddScopeDataAccess.Encode()
+	case params.DeleteScopeDataAccess != nil:
+		return params.DeleteScopeDataAccess.Encode()
+	case params.UpdateValueOwners != nil:
+		return params.UpdateValueOwners.Encode()
+	case params.MigrateValueOwner != nil:
+		return params.MigrateValueOwner.Encode()
+	case params.WriteSession != nil:
+		return params.WriteSession.Encode()
+	case params.WriteRecord != nil:
+		return params.WriteRecord.Encode()
+	case params.DeleteRecord != nil:
+		return params.DeleteRecord.Encode()
+	case params.WriteScopeSpecification != nil:
+		return params.WriteScopeSpecification.Encode()
+	case params.DeleteScopeSpecification != nil:
+		return params.DeleteScopeSpecification.Encode()
+	case params.WriteContractSpecification != nil:
+		return params.WriteContractSpecification.Encode()
+	case params.DeleteContractSpecification != nil:
+		return params.DeleteContractSpecification.Encode()
+	case params.AddContractSpecToScopeSpec != nil:
+		return params.AddContractSpecToScopeSpec.Encode()
+	case params.DeleteContractSpecFromScopeSpec != nil:
+		return params.DeleteContractSpecFromScopeSpec.Encode()
+	case params.WriteRecordSpecification != nil:
+		return params.WriteRecordSpecification.Encode()
+	case params.DeleteRecordSpecification != nil:
+		return params.DeleteRecordSpecification.Encode()
 	default:
 		return nil, fmt.Errorf("wasm: invalid metadata encode request: %s", string(msg))
 	}
 }
 
-// Encode creates a MsgAddScopeDataAccessRequest.
+// Encode creates a MsgWriteScopeRequest.
 func (params *WriteScope) Encode() ([]sdk.Msg, error) {
-	// verify the signer addresses are valid
-	for _, addr := range params.Signers {
-		_, err := sdk.AccAddressFromBech32(addr)
-		if err != nil {
-			return nil, fmt.Errorf("wasm: signer address must be a Bech32 string: %w", err)
-		}
+	if err := validateSigners(params.Signers); err != nil {
+		return nil, err
 	}
 	scope, err := params.Scope.convertToBaseType()
 	if err != nil {
@@ -63,3 +293,289 @@ func (params *WriteScope) Encode() ([]sdk.Msg, error) {
 
 	return []sdk.Msg{msg}, nil
 }
+
+// Encode creates a MsgDeleteScopeRequest.
+func (params *DeleteScope) Encode() ([]sdk.Msg, error) {
+	if err := validateSigners(params.Signers); err != nil {
+		return nil, err
+	}
+	scopeID, err := types.MetadataAddressFromBech32(params.ScopeID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'scope id': %w", err)
+	}
+	msg := types.NewMsgDeleteScopeRequest(scopeID, params.Signers)
+	return validateMsg(msg)
+}
+
+// Encode creates a MsgAddScopeOwnerRequest.
+func (params *AddScopeOwner) Encode() ([]sdk.Msg, error) {
+	if err := validateSigners(params.Signers); err != nil {
+		return nil, err
+	}
+	scopeID, err := types.MetadataAddressFromBech32(params.ScopeID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'scope id': %w", err)
+	}
+	owners, err := convertPartiesToBaseType(params.Owners)
+	if err != nil {
+		return nil, err
+	}
+	msg := types.NewMsgAddScopeOwnerRequest(scopeID, owners, params.Signers)
+	return validateMsg(msg)
+}
+
+// Encode creates a MsgDeleteScopeOwnerRequest.
+func (params *DeleteScopeOwner) Encode() ([]sdk.Msg, error) {
+	if err := validateSigners(params.Signers); err != nil {
+		return nil, err
+	}
+	scopeID, err := types.MetadataAddressFromBech32(params.ScopeID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'scope id': %w", err)
+	}
+	msg := types.NewMsgDeleteScopeOwnerRequest(scopeID, params.Owners, params.Signers)
+	return validateMsg(msg)
+}
+
+// Encode creates a MsgAddScopeDataAccessRequest.
+func (params *AddScopeDataAccess) Encode() ([]sdk.Msg, error) {
+	if err := validateSigners(params.Signers); err != nil {
+		return nil, err
+	}
+	scopeID, err := types.MetadataAddressFromBech32(params.ScopeID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'scope id': %w", err)
+	}
+	msg := types.NewMsgAddScopeDataAccessRequest(scopeID, params.DataAccess, params.Signers)
+	return validateMsg(msg)
+}
+
+// Encode creates a MsgDeleteScopeDataAccessRequest.
+func (params *DeleteScopeDataAccess) Encode() ([]sdk.Msg, error) {
+	if err := validateSigners(params.Signers); err != nil {
+		return nil, err
+	}
+	scopeID, err := types.MetadataAddressFromBech32(params.ScopeID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'scope id': %w", err)
+	}
+	msg := types.NewMsgDeleteScopeDataAccessRequest(scopeID, params.DataAccess, params.Signers)
+	return validateMsg(msg)
+}
+
+// Encode creates a MsgUpdateValueOwnersRequest.
+func (params *UpdateValueOwners) Encode() ([]sdk.Msg, error) {
+	if err := validateSigners(params.Signers); err != nil {
+		return nil, err
+	}
+	scopeIDs := make([]types.MetadataAddress, len(params.ScopeIDs))
+	for i, id := range params.ScopeIDs {
+		scopeID, err := types.MetadataAddressFromBech32(id)
+		if err != nil {
+			return nil, fmt.Errorf("wasm: invalid 'scope id': %w", err)
+		}
+		scopeIDs[i] = scopeID
+	}
+	valueOwner, err := sdk.AccAddressFromBech32(params.ValueOwnerAddress)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'value_owner_address': %w", err)
+	}
+	msg := types.NewMsgUpdateValueOwnersRequest(scopeIDs, valueOwner, params.Signers)
+	return validateMsg(msg)
+}
+
+// Encode creates a MsgMigrateValueOwnerRequest.
+func (params *MigrateValueOwner) Encode() ([]sdk.Msg, error) {
+	if err := validateSigners(params.Signers); err != nil {
+		return nil, err
+	}
+	existing, err := sdk.AccAddressFromBech32(params.Existing)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'existing' address: %w", err)
+	}
+	proposed, err := sdk.AccAddressFromBech32(params.Proposed)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'proposed' address: %w", err)
+	}
+	msg := types.NewMsgMigrateValueOwnerRequest(existing, proposed, params.Signers)
+	return validateMsg(msg)
+}
+
+// Encode creates a MsgWriteSessionRequest.
+func (params *WriteSession) Encode() ([]sdk.Msg, error) {
+	if err := validateSigners(params.Signers); err != nil {
+		return nil, err
+	}
+	session, err := params.Session.convertToBaseType()
+	if err != nil {
+		return nil, err
+	}
+	msg := types.NewMsgWriteSessionRequest(*session, params.Signers)
+	return validateMsg(msg)
+}
+
+// Encode creates a MsgWriteRecordRequest.
+func (params *WriteRecord) Encode() ([]sdk.Msg, error) {
+	if err := validateSigners(params.Signers); err != nil {
+		return nil, err
+	}
+	record, err := params.Record.convertToBaseType()
+	if err != nil {
+		return nil, err
+	}
+	parties, err := convertPartiesToBaseType(params.Parties)
+	if err != nil {
+		return nil, err
+	}
+	msg := types.NewMsgWriteRecordRequest(*record, nil, "", params.Signers, parties)
+	return validateMsg(msg)
+}
+
+// Encode creates a MsgDeleteRecordRequest.
+func (params *DeleteRecord) Encode() ([]sdk.Msg, error) {
+	if err := validateSigners(params.Signers); err != nil {
+		return nil, err
+	}
+	recordID, err := types.MetadataAddressFromBech32(params.RecordID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'record id': %w", err)
+	}
+	msg := types.NewMsgDeleteRecordRequest(recordID, params.Signers)
+	return validateMsg(msg)
+}
+
+// Encode creates a MsgWriteScopeSpecificationRequest.
+func (params *WriteScopeSpecification) Encode() ([]sdk.Msg, error) {
+	if err := validateSigners(params.Signers); err != nil {
+		return nil, err
+	}
+	spec, err := params.Specification.convertToBaseType()
+	if err != nil {
+		return nil, err
+	}
+	msg := types.NewMsgWriteScopeSpecificationRequest(*spec, params.Signers)
+	return validateMsg(msg)
+}
+
+// Encode creates a MsgWriteContractSpecificationRequest.
+func (params *WriteContractSpecification) Encode() ([]sdk.Msg, error) {
+	if err := validateSigners(params.Signers); err != nil {
+		return nil, err
+	}
+	spec, err := params.Specification.convertToBaseType()
+	if err != nil {
+		return nil, err
+	}
+	msg := types.NewMsgWriteContractSpecificationRequest(*spec, params.Signers)
+	return validateMsg(msg)
+}
+
+// Encode creates a MsgWriteRecordSpecificationRequest.
+func (params *WriteRecordSpecification) Encode() ([]sdk.Msg, error) {
+	if err := validateSigners(params.Signers); err != nil {
+		return nil, err
+	}
+	spec, err := params.Specification.convertToBaseType()
+	if err != nil {
+		return nil, err
+	}
+	msg := types.NewMsgWriteRecordSpecificationRequest(*spec, params.Signers)
+	return validateMsg(msg)
+}
+
+// Encode creates a MsgDeleteScopeSpecificationRequest.
+func (params *DeleteScopeSpecification) Encode() ([]sdk.Msg, error) {
+	if err := validateSigners(params.Signers); err != nil {
+		return nil, err
+	}
+	specID, err := types.MetadataAddressFromBech32(params.SpecificationID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'specification id': %w", err)
+	}
+	msg := types.NewMsgDeleteScopeSpecificationRequest(specID, params.Signers)
+	return validateMsg(msg)
+}
+
+// Encode creates a MsgDeleteContractSpecificationRequest.
+func (params *DeleteContractSpecification) Encode() ([]sdk.Msg, error) {
+	if err := validateSigners(params.Signers); err != nil {
+		return nil, err
+	}
+	specID, err := types.MetadataAddressFromBech32(params.SpecificationID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'specification id': %w", err)
+	}
+	msg := types.NewMsgDeleteContractSpecificationRequest(specID, params.Signers)
+	return validateMsg(msg)
+}
+
+// Encode creates a MsgDeleteRecordSpecificationRequest.
+func (params *DeleteRecordSpecification) Encode() ([]sdk.Msg, error) {
+	if err := validateSigners(params.Signers); err != nil {
+		return nil, err
+	}
+	specID, err := types.MetadataAddressFromBech32(params.SpecificationID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'specification id': %w", err)
+	}
+	msg := types.NewMsgDeleteRecordSpecificationRequest(specID, params.Signers)
+	return validateMsg(msg)
+}
+
+// Encode creates a MsgAddContractSpecToScopeSpecRequest.
+func (params *AddContractSpecToScopeSpec) Encode() ([]sdk.Msg, error) {
+	if err := validateSigners(params.Signers); err != nil {
+		return nil, err
+	}
+	contractSpecID, scopeSpecID, err := parseSpecIDs(params.ContractSpecificationID, params.ScopeSpecificationID)
+	if err != nil {
+		return nil, err
+	}
+	msg := types.NewMsgAddContractSpecToScopeSpecRequest(contractSpecID, scopeSpecID, params.Signers)
+	return validateMsg(msg)
+}
+
+// Encode creates a MsgDeleteContractSpecFromScopeSpecRequest.
+func (params *DeleteContractSpecFromScopeSpec) Encode() ([]sdk.Msg, error) {
+	if err := validateSigners(params.Signers); err != nil {
+		return nil, err
+	}
+	contractSpecID, scopeSpecID, err := parseSpecIDs(params.ContractSpecificationID, params.ScopeSpecificationID)
+	if err != nil {
+		return nil, err
+	}
+	msg := types.NewMsgDeleteContractSpecFromScopeSpecRequest(contractSpecID, scopeSpecID, params.Signers)
+	return validateMsg(msg)
+}
+
+// parseSpecIDs converts the bech32 contract and scope specification ids into metadata addresses.
+func parseSpecIDs(contractSpecID, scopeSpecID string) (types.MetadataAddress, types.MetadataAddress, error) {
+	contractSpecAddr, err := types.MetadataAddressFromBech32(contractSpecID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("wasm: invalid 'contract specification id': %w", err)
+	}
+	scopeSpecAddr, err := types.MetadataAddressFromBech32(scopeSpecID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("wasm: invalid 'scope specification id': %w", err)
+	}
+	return contractSpecAddr, scopeSpecAddr, nil
+}
+
+// validateSigners verifies that the signer addresses are valid.
+func validateSigners(signers []string) error {
+	for _, addr := range signers {
+		_, err := sdk.AccAddressFromBech32(addr)
+		if err != nil {
+			return fmt.Errorf("wasm: signer address must be a Bech32 string: %w", err)
+		}
+	}
+	return nil
+}
+
+// validateMsg runs the stateless validation of an encoded message.
+func validateMsg(msg sdk.Msg) ([]sdk.Msg, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("wasm: invalid %s: %w", sdk.MsgTypeURL(msg), err)
+	}
+	return []sdk.Msg{msg}, nil
+}
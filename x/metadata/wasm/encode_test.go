@@ -0,0 +1,391 @@
+package wasm_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+	"github.com/provenance-io/provenance/x/metadata/wasm"
+)
+
+func TestEncoder(t *testing.T) {
+	contract := sdk.AccAddress("contract____________")
+	owner := sdk.AccAddress("owner_______________").String()
+	other := sdk.AccAddress("other_______________").String()
+
+	scopeUUID := uuid.New()
+	scopeID := types.ScopeMetadataAddress(scopeUUID)
+	sessionID := types.SessionMetadataAddress(scopeUUID, uuid.New())
+	recordID := types.RecordMetadataAddress(scopeUUID, "record")
+	scopeSpecID := types.ScopeSpecMetadataAddress(uuid.New())
+	contractSpecUUID := uuid.New()
+	contractSpecID := types.ContractSpecMetadataAddress(contractSpecUUID)
+	recordSpecID := types.RecordSpecMetadataAddress(contractSpecUUID, "record")
+
+	ownerParty := types.Party{Address: owner, Role: types.PartyType_PARTY_TYPE_OWNER}
+	ownerPartyJSON := fmt.Sprintf(`{"address":"%s","role":"owner"}`, owner)
+
+	tests := []struct {
+		name    string
+		msg     string
+		expErr  string
+		expMsgs []sdk.Msg
+	}{
+		{
+			name:   "invalid json",
+			msg:    `{"metadata":`,
+			expErr: "wasm: failed to unmarshal metadata encode params",
+		},
+		{
+			name:   "no metadata params",
+			msg:    `{}`,
+			expErr: "wasm: nil metadata encode params",
+		},
+		{
+			name:   "unknown message",
+			msg:    `{"metadata":{"unknown":{}}}`,
+			expErr: "wasm: invalid metadata encode request",
+		},
+		{
+			name: "write scope",
+			msg: fmt.Sprintf(`{"metadata":{"write_scope":{"scope":{"scope_id":"%s","specification_id":"%s","owners":[%s],"data_access":["%s"],"value_owner_address":"%s"},"signers":["%s"]}}}`,
+				scopeID, scopeSpecID, ownerPartyJSON, other, owner, owner),
+			expMsgs: []sdk.Msg{types.NewMsgWriteScopeRequest(types.Scope{
+				ScopeId:           scopeID,
+				SpecificationId:   scopeSpecID,
+				Owners:            []types.Party{ownerParty},
+				DataAccess:        []string{other},
+				ValueOwnerAddress: owner,
+			}, []string{owner})},
+		},
+		{
+			name:   "write scope with an invalid signer",
+			msg:    fmt.Sprintf(`{"metadata":{"write_scope":{"scope":{"scope_id":"%s","specification_id":"%s"},"signers":["bad"]}}}`, scopeID, scopeSpecID),
+			expErr: "wasm: signer address must be a Bech32 string",
+		},
+		{
+			name:   "write scope with an invalid scope id",
+			msg:    fmt.Sprintf(`{"metadata":{"write_scope":{"scope":{"scope_id":"bad","specification_id":"%s"},"signers":["%s"]}}}`, scopeSpecID, owner),
+			expErr: "wasm: invalid 'scope id'",
+		},
+		{
+			name:   "write scope with an invalid data access address",
+			msg:    fmt.Sprintf(`{"metadata":{"write_scope":{"scope":{"scope_id":"%s","specification_id":"%s","data_access":["bad"]},"signers":["%s"]}}}`, scopeID, scopeSpecID, owner),
+			expErr: "wasm: invalid 'data_access' address",
+		},
+		{
+			name:    "delete scope",
+			msg:     fmt.Sprintf(`{"metadata":{"delete_scope":{"scope_id":"%s","signers":["%s"]}}}`, scopeID, owner),
+			expMsgs: []sdk.Msg{types.NewMsgDeleteScopeRequest(scopeID, []string{owner})},
+		},
+		{
+			name:   "delete scope with an invalid scope id",
+			msg:    fmt.Sprintf(`{"metadata":{"delete_scope":{"scope_id":"bad","signers":["%s"]}}}`, owner),
+			expErr: "wasm: invalid 'scope id'",
+		},
+		{
+			name:   "delete scope without signers",
+			msg:    fmt.Sprintf(`{"metadata":{"delete_scope":{"scope_id":"%s"}}}`, scopeID),
+			expErr: "wasm: invalid /provenance.metadata.v1.MsgDeleteScopeRequest",
+		},
+		{
+			name:    "add scope owner",
+			msg:     fmt.Sprintf(`{"metadata":{"add_scope_owner":{"scope_id":"%s","owners":[%s],"signers":["%s"]}}}`, scopeID, ownerPartyJSON, owner),
+			expMsgs: []sdk.Msg{types.NewMsgAddScopeOwnerRequest(scopeID, []types.Party{ownerParty}, []string{owner})},
+		},
+		{
+			name:   "add scope owner with an invalid owner address",
+			msg:    fmt.Sprintf(`{"metadata":{"add_scope_owner":{"scope_id":"%s","owners":[{"address":"bad","role":"owner"}],"signers":["%s"]}}}`, scopeID, owner),
+			expErr: "wasm: invalid 'data_access' address",
+		},
+		{
+			name:   "add scope owner without owners",
+			msg:    fmt.Sprintf(`{"metadata":{"add_scope_owner":{"scope_id":"%s","signers":["%s"]}}}`, scopeID, owner),
+			expErr: "wasm: invalid /provenance.metadata.v1.MsgAddScopeOwnerRequest",
+		},
+		{
+			name:    "delete scope owner",
+			msg:     fmt.Sprintf(`{"metadata":{"delete_scope_owner":{"scope_id":"%s","owners":["%s"],"signers":["%s"]}}}`, scopeID, other, owner),
+			expMsgs: []sdk.Msg{types.NewMsgDeleteScopeOwnerRequest(scopeID, []string{other}, []string{owner})},
+		},
+		{
+			name:   "delete scope owner with a record id as the scope id",
+			msg:    fmt.Sprintf(`{"metadata":{"delete_scope_owner":{"scope_id":"%s","owners":["%s"],"signers":["%s"]}}}`, recordID, other, owner),
+			expErr: "wasm: invalid /provenance.metadata.v1.MsgDeleteScopeOwnerRequest",
+		},
+		{
+			name:    "add scope data access",
+			msg:     fmt.Sprintf(`{"metadata":{"add_scope_data_access":{"scope_id":"%s","data_access":["%s"],"signers":["%s"]}}}`, scopeID, other, owner),
+			expMsgs: []sdk.Msg{types.NewMsgAddScopeDataAccessRequest(scopeID, []string{other}, []string{owner})},
+		},
+		{
+			name:   "add scope data access without addresses",
+			msg:    fmt.Sprintf(`{"metadata":{"add_scope_data_access":{"scope_id":"%s","signers":["%s"]}}}`, scopeID, owner),
+			expErr: "wasm: invalid /provenance.metadata.v1.MsgAddScopeDataAccessRequest",
+		},
+		{
+			name:    "delete scope data access",
+			msg:     fmt.Sprintf(`{"metadata":{"delete_scope_data_access":{"scope_id":"%s","data_access":["%s"],"signers":["%s"]}}}`, scopeID, other, owner),
+			expMsgs: []sdk.Msg{types.NewMsgDeleteScopeDataAccessRequest(scopeID, []string{other}, []string{owner})},
+		},
+		{
+			name:   "delete scope data access with an invalid scope id",
+			msg:    fmt.Sprintf(`{"metadata":{"delete_scope_data_access":{"scope_id":"bad","data_access":["%s"],"signers":["%s"]}}}`, other, owner),
+			expErr: "wasm: invalid 'scope id'",
+		},
+		{
+			name: "update value owners",
+			msg:  fmt.Sprintf(`{"metadata":{"update_value_owners":{"scope_ids":["%s"],"value_owner_address":"%s","signers":["%s"]}}}`, scopeID, other, owner),
+			expMsgs: []sdk.Msg{types.NewMsgUpdateValueOwnersRequest([]types.MetadataAddress{scopeID},
+				sdk.MustAccAddressFromBech32(other), []string{owner})},
+		},
+		{
+			name:   "update value owners with an invalid scope id",
+			msg:    fmt.Sprintf(`{"metadata":{"update_value_owners":{"scope_ids":["bad"],"value_owner_address":"%s","signers":["%s"]}}}`, other, owner),
+			expErr: "wasm: invalid 'scope id'",
+		},
+		{
+			name:   "update value owners with an invalid value owner",
+			msg:    fmt.Sprintf(`{"metadata":{"update_value_owners":{"scope_ids":["%s"],"value_owner_address":"bad","signers":["%s"]}}}`, scopeID, owner),
+			expErr: "wasm: invalid 'value_owner_address'",
+		},
+		{
+			name: "migrate value owner",
+			msg:  fmt.Sprintf(`{"metadata":{"migrate_value_owner":{"existing":"%s","proposed":"%s","signers":["%s"]}}}`, owner, other, owner),
+			expMsgs: []sdk.Msg{types.NewMsgMigrateValueOwnerRequest(sdk.MustAccAddressFromBech32(owner),
+				sdk.MustAccAddressFromBech32(other), []string{owner})},
+		},
+		{
+			name:   "migrate value owner with an invalid existing address",
+			msg:    fmt.Sprintf(`{"metadata":{"migrate_value_owner":{"existing":"bad","proposed":"%s","signers":["%s"]}}}`, other, owner),
+			expErr: "wasm: invalid 'existing' address",
+		},
+		{
+			name:   "migrate value owner with an invalid proposed address",
+			msg:    fmt.Sprintf(`{"metadata":{"migrate_value_owner":{"existing":"%s","proposed":"bad","signers":["%s"]}}}`, owner, owner),
+			expErr: "wasm: invalid 'proposed' address",
+		},
+		{
+			name: "write session",
+			msg: fmt.Sprintf(`{"metadata":{"write_session":{"session":{"session_id":"%s","specification_id":"%s","name":"session","parties":[%s]},"signers":["%s"]}}}`,
+				sessionID, contractSpecID, ownerPartyJSON, owner),
+			expMsgs: []sdk.Msg{types.NewMsgWriteSessionRequest(types.Session{
+				SessionId:       sessionID,
+				SpecificationId: contractSpecID,
+				Name:            "session",
+				Parties:         []types.Party{ownerParty},
+			}, []string{owner})},
+		},
+		{
+			name:   "write session with an invalid session id",
+			msg:    fmt.Sprintf(`{"metadata":{"write_session":{"session":{"session_id":"bad","specification_id":"%s","name":"session"},"signers":["%s"]}}}`, contractSpecID, owner),
+			expErr: "wasm: invalid 'session id'",
+		},
+		{
+			name: "write session without parties",
+			msg: fmt.Sprintf(`{"metadata":{"write_session":{"session":{"session_id":"%s","specification_id":"%s","name":"session"},"signers":["%s"]}}}`,
+				sessionID, contractSpecID, owner),
+			expErr: "wasm: invalid /provenance.metadata.v1.MsgWriteSessionRequest",
+		},
+		{
+			name: "write record",
+			msg: fmt.Sprintf(`{"metadata":{"write_record":{"record":{"session_id":"%s","specification_id":"%s","name":"record",`+
+				`"process":{"process_id":{"hash":{"hash":"processhash"}},"name":"process","method":"method"},`+
+				`"inputs":[{"name":"input","type_name":"string","source":{"hash":{"hash":"inputhash"}},"status":"proposed"}],`+
+				`"outputs":[{"hash":"outputhash","status":"pass"}]},"signers":["%s"],"parties":[%s]}}}`,
+				sessionID, recordSpecID, owner, ownerPartyJSON),
+			expMsgs: []sdk.Msg{types.NewMsgWriteRecordRequest(types.Record{
+				Name:            "record",
+				SessionId:       sessionID,
+				Process:         types.Process{ProcessId: &types.Process_Hash{Hash: "processhash"}, Name: "process", Method: "method"},
+				Inputs:          []types.RecordInput{{Name: "input", TypeName: "string", Source: &types.RecordInput_Hash{Hash: "inputhash"}, Status: types.RecordInputStatus_Proposed}},
+				Outputs:         []types.RecordOutput{{Hash: "outputhash", Status: types.ResultStatus_RESULT_STATUS_PASS}},
+				SpecificationId: recordSpecID,
+			}, nil, "", []string{owner}, []types.Party{ownerParty})},
+		},
+		{
+			name:   "write record without a process",
+			msg:    fmt.Sprintf(`{"metadata":{"write_record":{"record":{"session_id":"%s","specification_id":"%s","name":"record"},"signers":["%s"]}}}`, sessionID, recordSpecID, owner),
+			expErr: "wasm: a process must be defined for a record",
+		},
+		{
+			name: "write record with a process id address and hash",
+			msg: fmt.Sprintf(`{"metadata":{"write_record":{"record":{"session_id":"%s","specification_id":"%s","name":"record",`+
+				`"process":{"process_id":{"hash":{"hash":"processhash"},"address":{"address":"%s"}},"name":"process","method":"method"}},"signers":["%s"]}}}`,
+				sessionID, recordSpecID, owner, owner),
+			expErr: "wasm: address or hash (but not both) must be defined for a process id",
+		},
+		{
+			name: "write record with an input without a source",
+			msg: fmt.Sprintf(`{"metadata":{"write_record":{"record":{"session_id":"%s","specification_id":"%s","name":"record",`+
+				`"process":{"process_id":{"hash":{"hash":"processhash"}},"name":"process","method":"method"},`+
+				`"inputs":[{"name":"input","type_name":"string","status":"proposed"}]},"signers":["%s"]}}}`,
+				sessionID, recordSpecID, owner),
+			expErr: "wasm: hash or record id (but not both) must be defined for a source",
+		},
+		{
+			name:    "delete record",
+			msg:     fmt.Sprintf(`{"metadata":{"delete_record":{"record_id":"%s","signers":["%s"]}}}`, recordID, owner),
+			expMsgs: []sdk.Msg{types.NewMsgDeleteRecordRequest(recordID, []string{owner})},
+		},
+		{
+			name:   "delete record with an invalid record id",
+			msg:    fmt.Sprintf(`{"metadata":{"delete_record":{"record_id":"bad","signers":["%s"]}}}`, owner),
+			expErr: "wasm: invalid 'record id'",
+		},
+		{
+			name:   "delete record without signers",
+			msg:    fmt.Sprintf(`{"metadata":{"delete_record":{"record_id":"%s"}}}`, recordID),
+			expErr: "wasm: invalid /provenance.metadata.v1.MsgDeleteRecordRequest",
+		},
+		{
+			name: "write scope specification",
+			msg: fmt.Sprintf(`{"metadata":{"write_scope_specification":{"specification":{"specification_id":"%s","description":{"name":"scope spec"},`+
+				`"owner_addresses":["%s"],"parties_involved":["owner"],"contract_spec_ids":["%s"]},"signers":["%s"]}}}`,
+				scopeSpecID, owner, contractSpecID, owner),
+			expMsgs: []sdk.Msg{types.NewMsgWriteScopeSpecificationRequest(types.ScopeSpecification{
+				SpecificationId: scopeSpecID,
+				Description:     &types.Description{Name: "scope spec"},
+				OwnerAddresses:  []string{owner},
+				PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+				ContractSpecIds: []types.MetadataAddress{contractSpecID},
+			}, []string{owner})},
+		},
+		{
+			name: "write scope specification with an invalid contract spec id",
+			msg: fmt.Sprintf(`{"metadata":{"write_scope_specification":{"specification":{"specification_id":"%s",`+
+				`"owner_addresses":["%s"],"parties_involved":["owner"],"contract_spec_ids":["bad"]},"signers":["%s"]}}}`,
+				scopeSpecID, owner, owner),
+			expErr: "wasm: invalid 'contract spec id'",
+		},
+		{
+			name: "write scope specification without owners",
+			msg: fmt.Sprintf(`{"metadata":{"write_scope_specification":{"specification":{"specification_id":"%s",`+
+				`"parties_involved":["owner"],"contract_spec_ids":["%s"]},"signers":["%s"]}}}`,
+				scopeSpecID, contractSpecID, owner),
+			expErr: "wasm: invalid /provenance.metadata.v1.MsgWriteScopeSpecificationRequest",
+		},
+		{
+			name:    "delete scope specification",
+			msg:     fmt.Sprintf(`{"metadata":{"delete_scope_specification":{"specification_id":"%s","signers":["%s"]}}}`, scopeSpecID, owner),
+			expMsgs: []sdk.Msg{types.NewMsgDeleteScopeSpecificationRequest(scopeSpecID, []string{owner})},
+		},
+		{
+			name:   "delete scope specification with an invalid id",
+			msg:    fmt.Sprintf(`{"metadata":{"delete_scope_specification":{"specification_id":"bad","signers":["%s"]}}}`, owner),
+			expErr: "wasm: invalid 'specification id'",
+		},
+		{
+			name: "write contract specification",
+			msg: fmt.Sprintf(`{"metadata":{"write_contract_specification":{"specification":{"specification_id":"%s",`+
+				`"owner_addresses":["%s"],"parties_involved":["owner"],"source":{"hash":"sourcehash"},"class_name":"class"},"signers":["%s"]}}}`,
+				contractSpecID, owner, owner),
+			expMsgs: []sdk.Msg{types.NewMsgWriteContractSpecificationRequest(types.ContractSpecification{
+				SpecificationId: contractSpecID,
+				OwnerAddresses:  []string{owner},
+				PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+				Source:          &types.ContractSpecification_Hash{Hash: "sourcehash"},
+				ClassName:       "class",
+			}, []string{owner})},
+		},
+		{
+			name: "write contract specification without a source",
+			msg: fmt.Sprintf(`{"metadata":{"write_contract_specification":{"specification":{"specification_id":"%s",`+
+				`"owner_addresses":["%s"],"parties_involved":["owner"],"class_name":"class"},"signers":["%s"]}}}`,
+				contractSpecID, owner, owner),
+			expErr: "wasm: resource id or hash (but not both) must be defined for a contract specification source",
+		},
+		{
+			name: "write contract specification with an invalid resource id",
+			msg: fmt.Sprintf(`{"metadata":{"write_contract_specification":{"specification":{"specification_id":"%s",`+
+				`"owner_addresses":["%s"],"parties_involved":["owner"],"source":{"resource_id":"bad"},"class_name":"class"},"signers":["%s"]}}}`,
+				contractSpecID, owner, owner),
+			expErr: "wasm: invalid 'resource id'",
+		},
+		{
+			name:    "delete contract specification",
+			msg:     fmt.Sprintf(`{"metadata":{"delete_contract_specification":{"specification_id":"%s","signers":["%s"]}}}`, contractSpecID, owner),
+			expMsgs: []sdk.Msg{types.NewMsgDeleteContractSpecificationRequest(contractSpecID, []string{owner})},
+		},
+		{
+			name:   "delete contract specification without signers",
+			msg:    fmt.Sprintf(`{"metadata":{"delete_contract_specification":{"specification_id":"%s"}}}`, contractSpecID),
+			expErr: "wasm: invalid /provenance.metadata.v1.MsgDeleteContractSpecificationRequest",
+		},
+		{
+			name: "add contract spec to scope spec",
+			msg: fmt.Sprintf(`{"metadata":{"add_contract_spec_to_scope_spec":{"contract_specification_id":"%s","scope_specification_id":"%s","signers":["%s"]}}}`,
+				contractSpecID, scopeSpecID, owner),
+			expMsgs: []sdk.Msg{types.NewMsgAddContractSpecToScopeSpecRequest(contractSpecID, scopeSpecID, []string{owner})},
+		},
+		{
+			name: "add contract spec to scope spec with an invalid contract spec id",
+			msg: fmt.Sprintf(`{"metadata":{"add_contract_spec_to_scope_spec":{"contract_specification_id":"bad","scope_specification_id":"%s","signers":["%s"]}}}`,
+				scopeSpecID, owner),
+			expErr: "wasm: invalid 'contract specification id'",
+		},
+		{
+			name: "delete contract spec from scope spec",
+			msg: fmt.Sprintf(`{"metadata":{"delete_contract_spec_from_scope_spec":{"contract_specification_id":"%s","scope_specification_id":"%s","signers":["%s"]}}}`,
+				contractSpecID, scopeSpecID, owner),
+			expMsgs: []sdk.Msg{types.NewMsgDeleteContractSpecFromScopeSpecRequest(contractSpecID, scopeSpecID, []string{owner})},
+		},
+		{
+			name: "delete contract spec from scope spec with an invalid scope spec id",
+			msg: fmt.Sprintf(`{"metadata":{"delete_contract_spec_from_scope_spec":{"contract_specification_id":"%s","scope_specification_id":"bad","signers":["%s"]}}}`,
+				contractSpecID, owner),
+			expErr: "wasm: invalid 'scope specification id'",
+		},
+		{
+			name: "write record specification",
+			msg: fmt.Sprintf(`{"metadata":{"write_record_specification":{"specification":{"specification_id":"%s","name":"record",`+
+				`"inputs":[{"name":"input","type_name":"string","source":{"hash":{"hash":"inputhash"}}}],`+
+				`"type_name":"string","result_type":"record","responsible_parties":["owner"]},"signers":["%s"]}}}`,
+				recordSpecID, owner),
+			expMsgs: []sdk.Msg{types.NewMsgWriteRecordSpecificationRequest(types.RecordSpecification{
+				SpecificationId:    recordSpecID,
+				Name:               "record",
+				Inputs:             []*types.InputSpecification{{Name: "input", TypeName: "string", Source: &types.InputSpecification_Hash{Hash: "inputhash"}}},
+				TypeName:           "string",
+				ResultType:         types.DefinitionType_DEFINITION_TYPE_RECORD,
+				ResponsibleParties: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+			}, []string{owner})},
+		},
+		{
+			name: "write record specification with an input without a source",
+			msg: fmt.Sprintf(`{"metadata":{"write_record_specification":{"specification":{"specification_id":"%s","name":"record",`+
+				`"inputs":[{"name":"input","type_name":"string"}],"type_name":"string","result_type":"record","responsible_parties":["owner"]},"signers":["%s"]}}}`,
+				recordSpecID, owner),
+			expErr: "wasm: hash or record id (but not both) must be defined for an input specification source",
+		},
+		{
+			name:    "delete record specification",
+			msg:     fmt.Sprintf(`{"metadata":{"delete_record_specification":{"specification_id":"%s","signers":["%s"]}}}`, recordSpecID, owner),
+			expMsgs: []sdk.Msg{types.NewMsgDeleteRecordSpecificationRequest(recordSpecID, []string{owner})},
+		},
+		{
+			name:   "delete record specification with an invalid signer",
+			msg:    fmt.Sprintf(`{"metadata":{"delete_record_specification":{"specification_id":"%s","signers":["bad"]}}}`, recordSpecID),
+			expErr: "wasm: signer address must be a Bech32 string",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msgs, err := wasm.Encoder(contract, []byte(tc.msg), "")
+			if len(tc.expErr) > 0 {
+				require.ErrorContains(t, err, tc.expErr, "Encoder error")
+				assert.Nil(t, msgs, "Encoder msgs")
+				return
+			}
+			require.NoError(t, err, "Encoder error")
+			assert.Equal(t, tc.expMsgs, msgs, "Encoder msgs")
+		})
+	}
+}
@@ -22,6 +22,16 @@ type MetadataQueryParams struct {
 	GetSessions *GetSessionsParams `json:"get_sessions,omitempty"`
 	// Get records by scope ID and name (optional).
 	GetRecords *GetRecordsParams `json:"get_records,omitempty"`
+	// Get a scope specification by ID.
+	GetScopeSpecification *GetScopeSpecificationParams `json:"get_scope_specification,omitempty"`
+	// Get a contract specification by ID.
+	GetContractSpecification *GetContractSpecificationParams `json:"get_contract_specification,omitempty"`
+	// Get the record specifications of a contract specification.
+	GetRecordSpecifications *GetRecordSpecificationsParams `json:"get_record_specifications,omitempty"`
+	// Get the scopes an address is an owner of.
+	GetScopesByOwner *GetScopesByOwnerParams `json:"get_scopes_by_owner,omitempty"`
+	// Get the scopes an address is the value owner of.
+	GetScopesByValueOwner *GetScopesByValueOwnerParams `json:"get_scopes_by_value_owner,omitempty"`
 }
 
 // GetScopeParams are the inputs for a scope query.
@@ -44,6 +54,36 @@ type GetRecordsParams struct {
 	Name string `json:"name,omitempty"`
 }
 
+// GetScopeSpecificationParams are the inputs for a scope specification query.
+type GetScopeSpecificationParams struct {
+	// The bech32 address of the scope specification we want to get.
+	SpecificationID string `json:"specification_id"`
+}
+
+// GetContractSpecificationParams are the inputs for a contract specification query.
+type GetContractSpecificationParams struct {
+	// The bech32 address of the contract specification we want to get.
+	SpecificationID string `json:"specification_id"`
+}
+
+// GetRecordSpecificationsParams are the inputs for a record specifications query.
+type GetRecordSpecificationsParams struct {
+	// The bech32 address of the contract specification we want to get record specifications for.
+	ContractSpecificationID string `json:"contract_specification_id"`
+}
+
+// GetScopesByOwnerParams are the inputs for a scope ownership query.
+type GetScopesByOwnerParams struct {
+	// The bech32 address of the owner.
+	Address string `json:"address"`
+}
+
+// GetScopesByValueOwnerParams are the inputs for a scope value ownership query.
+type GetScopesByValueOwnerParams struct {
+	// The bech32 address of the value owner.
+	Address string `json:"address"`
+}
+
 // Querier returns a smart contract querier for the metadata module.
 func Querier(keeper keeper.Keeper) provwasm.Querier {
 	return func(ctx sdk.Context, query json.RawMessage, version string) ([]byte, error) {
@@ -64,6 +104,16 @@ func Querier(keeper keeper.Keeper) provwasm.Querier {
 			return params.GetSessions.Run(ctx, keeper)
 		case params.GetRecords != nil:
 			return params.GetRecords.Run(ctx, keeper)
+		case params.GetScopeSpecification != nil:
+			return params.GetScopeSpecification.Run(ctx, keeper)
+		case params.GetContractSpecification != nil:
+			return params.GetContractSpecification.Run(ctx, keeper)
+		case params.GetRecordSpecifications != nil:
+			return params.GetRecordSpecifications.Run(ctx, keeper)
+		case params.GetScopesByOwner != nil:
+			return params.GetScopesByOwner.Run(ctx, keeper)
+		case params.GetScopesByValueOwner != nil:
+			return params.GetScopesByValueOwner.Run(ctx, keeper)
 		default:
 			return nil, fmt.Errorf("wasm: invalid metadata query: %s", string(query))
 		}
@@ -112,3 +162,75 @@ func (params *GetRecordsParams) Run(ctx sdk.Context, keeper keeper.Keeper) ([]by
 	}
 	return createRecordsResponse(records)
 }
+
+// Run gets a scope specification by ID.
+func (params *GetScopeSpecificationParams) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	specID, err := types.MetadataAddressFromBech32(params.SpecificationID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid scope specification ID: %w", err)
+	}
+	spec, found := keeper.GetScopeSpecification(ctx, specID)
+	if !found {
+		return nil, fmt.Errorf("wasm: scope specification not found: %s", params.SpecificationID)
+	}
+	return createScopeSpecificationResponse(spec)
+}
+
+// Run gets a contract specification by ID.
+func (params *GetContractSpecificationParams) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	specID, err := types.MetadataAddressFromBech32(params.SpecificationID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid contract specification ID: %w", err)
+	}
+	spec, found := keeper.GetContractSpecification(ctx, specID)
+	if !found {
+		return nil, fmt.Errorf("wasm: contract specification not found: %s", params.SpecificationID)
+	}
+	return createContractSpecificationResponse(spec)
+}
+
+// Run gets the record specifications of a contract specification.
+func (params *GetRecordSpecificationsParams) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	specID, err := types.MetadataAddressFromBech32(params.ContractSpecificationID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid contract specification ID: %w", err)
+	}
+	specs, err := keeper.GetRecordSpecificationsForContractSpecificationID(ctx, specID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: unable to get record specifications: %w", err)
+	}
+	return createRecordSpecificationsResponse(specs)
+}
+
+// Run gets the scopes an address is an owner of.
+func (params *GetScopesByOwnerParams) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	address, err := sdk.AccAddressFromBech32(params.Address)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid owner address: %w", err)
+	}
+	var scopeIDs []types.MetadataAddress
+	err = keeper.IterateScopesForAddress(ctx, address, func(scopeID types.MetadataAddress) bool {
+		scopeIDs = append(scopeIDs, scopeID)
+		return false
+	})
+	if err != nil {
+		return nil, fmt.Errorf("wasm: %w", err)
+	}
+	return createScopeIDsResponse(scopeIDs)
+}
+
+// Run gets the scopes an address is the value owner of.
+func (params *GetScopesByValueOwnerParams) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	if _, err := sdk.AccAddressFromBech32(params.Address); err != nil {
+		return nil, fmt.Errorf("wasm: invalid value owner address: %w", err)
+	}
+	var scopeIDs []types.MetadataAddress
+	err := keeper.IterateScopesForValueOwner(ctx, params.Address, func(scopeID types.MetadataAddress) bool {
+		scopeIDs = append(scopeIDs, scopeID)
+		return false
+	})
+	if err != nil {
+		return nil, fmt.Errorf("wasm: %w", err)
+	}
+	return createScopeIDsResponse(scopeIDs)
+}
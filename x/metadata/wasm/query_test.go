@@ -0,0 +1,294 @@
+package wasm_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/metadata/types"
+	"github.com/provenance-io/provenance/x/metadata/wasm"
+)
+
+func TestQuerier(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	owner := sdk.AccAddress("owner_______________").String()
+	valueOwner := sdk.AccAddress("value_owner_________").String()
+	nobody := sdk.AccAddress("nobody______________").String()
+
+	scopeUUID := uuid.New()
+	scopeID := types.ScopeMetadataAddress(scopeUUID)
+	sessionID := types.SessionMetadataAddress(scopeUUID, uuid.New())
+	scopeSpecID := types.ScopeSpecMetadataAddress(uuid.New())
+	contractSpecUUID := uuid.New()
+	contractSpecID := types.ContractSpecMetadataAddress(contractSpecUUID)
+	recordSpecID := types.RecordSpecMetadataAddress(contractSpecUUID, "record")
+	unknownScopeID := types.ScopeMetadataAddress(uuid.New())
+	unknownScopeSpecID := types.ScopeSpecMetadataAddress(uuid.New())
+	unknownContractSpecID := types.ContractSpecMetadataAddress(uuid.New())
+
+	ownerParties := []types.Party{{Address: owner, Role: types.PartyType_PARTY_TYPE_OWNER}}
+	app.MetadataKeeper.SetScopeSpecification(ctx, types.ScopeSpecification{
+		SpecificationId: scopeSpecID,
+		Description:     &types.Description{Name: "scope spec"},
+		OwnerAddresses:  []string{owner},
+		PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		ContractSpecIds: []types.MetadataAddress{contractSpecID},
+	})
+	app.MetadataKeeper.SetContractSpecification(ctx, types.ContractSpecification{
+		SpecificationId: contractSpecID,
+		OwnerAddresses:  []string{owner},
+		PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		Source:          &types.ContractSpecification_Hash{Hash: "sourcehash"},
+		ClassName:       "class",
+	})
+	app.MetadataKeeper.SetRecordSpecification(ctx, types.RecordSpecification{
+		SpecificationId:    recordSpecID,
+		Name:               "record",
+		Inputs:             []*types.InputSpecification{{Name: "input", TypeName: "string", Source: &types.InputSpecification_Hash{Hash: "inputhash"}}},
+		TypeName:           "string",
+		ResultType:         types.DefinitionType_DEFINITION_TYPE_RECORD,
+		ResponsibleParties: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+	})
+	app.MetadataKeeper.SetScope(ctx, types.Scope{
+		ScopeId:           scopeID,
+		SpecificationId:   scopeSpecID,
+		Owners:            ownerParties,
+		ValueOwnerAddress: valueOwner,
+	})
+	app.MetadataKeeper.SetSession(ctx, types.Session{
+		SessionId:       sessionID,
+		SpecificationId: contractSpecID,
+		Parties:         ownerParties,
+		Name:            "session",
+	})
+	app.MetadataKeeper.SetRecord(ctx, types.Record{
+		Name:            "record",
+		SessionId:       sessionID,
+		Process:         types.Process{ProcessId: &types.Process_Hash{Hash: "processhash"}, Name: "process", Method: "method"},
+		Inputs:          []types.RecordInput{{Name: "input", TypeName: "string", Source: &types.RecordInput_Hash{Hash: "inputhash"}, Status: types.RecordInputStatus_Proposed}},
+		Outputs:         []types.RecordOutput{{Hash: "outputhash", Status: types.ResultStatus_RESULT_STATUS_PASS}},
+		SpecificationId: recordSpecID,
+	})
+
+	ownerParty := []*wasm.Party{{Address: owner, Role: wasm.PartyTypeOwner}}
+
+	tests := []struct {
+		name    string
+		query   string
+		expErr  string
+		resp    interface{}
+		expResp interface{}
+	}{
+		{
+			name:   "invalid json",
+			query:  `{"metadata":`,
+			expErr: "wasm: invalid metadata query params",
+		},
+		{
+			name:   "no metadata params",
+			query:  `{}`,
+			expErr: "wasm: nil metadata query params",
+		},
+		{
+			name:   "unknown query",
+			query:  `{"metadata":{"unknown":{}}}`,
+			expErr: "wasm: invalid metadata query",
+		},
+		{
+			name:  "scope",
+			query: fmt.Sprintf(`{"metadata":{"get_scope":{"scope_id":"%s"}}}`, scopeID),
+			resp:  &wasm.Scope{},
+			expResp: &wasm.Scope{
+				ScopeID:           scopeID.String(),
+				SpecificationID:   scopeSpecID.String(),
+				Owners:            ownerParty,
+				ValueOwnerAddress: valueOwner,
+			},
+		},
+		{
+			name:   "scope with an invalid id",
+			query:  `{"metadata":{"get_scope":{"scope_id":"bad"}}}`,
+			expErr: "wasm: invalid scope ID",
+		},
+		{
+			name:   "scope not found",
+			query:  fmt.Sprintf(`{"metadata":{"get_scope":{"scope_id":"%s"}}}`, unknownScopeID),
+			expErr: "wasm: scope not found: " + unknownScopeID.String(),
+		},
+		{
+			name:  "sessions",
+			query: fmt.Sprintf(`{"metadata":{"get_sessions":{"scope_id":"%s"}}}`, scopeID),
+			resp:  &wasm.Sessions{},
+			expResp: &wasm.Sessions{Sessions: []*wasm.Session{{
+				SessionID:       sessionID.String(),
+				SpecificationID: contractSpecID.String(),
+				Name:            "session",
+				Parties:         ownerParty,
+			}}},
+		},
+		{
+			name:    "sessions of an unknown scope",
+			query:   fmt.Sprintf(`{"metadata":{"get_sessions":{"scope_id":"%s"}}}`, unknownScopeID),
+			resp:    &wasm.Sessions{},
+			expResp: &wasm.Sessions{Sessions: []*wasm.Session{}},
+		},
+		{
+			name:   "sessions with an invalid scope id",
+			query:  `{"metadata":{"get_sessions":{"scope_id":"bad"}}}`,
+			expErr: "wasm: invalid scope ID",
+		},
+		{
+			name:  "records",
+			query: fmt.Sprintf(`{"metadata":{"get_records":{"scope_id":"%s"}}}`, scopeID),
+			resp:  &wasm.Records{},
+			expResp: &wasm.Records{Records: []*wasm.Record{{
+				SessionID:       sessionID.String(),
+				SpecificationID: recordSpecID.String(),
+				Name:            "record",
+				Process:         &wasm.Process{ProcessID: &wasm.ProcessID{Hash: &wasm.ProcessIDHash{Hash: "processhash"}}, Name: "process", Method: "method"},
+				Inputs: []*wasm.RecordInput{{Name: "input", TypeName: "string",
+					Source: &wasm.RecordInputSource{Hash: &wasm.RecordInputSourceHash{Hash: "inputhash"}}, Status: wasm.InputStatusProposed}},
+				Outputs: []*wasm.RecordOutput{{Hash: "outputhash", Status: wasm.ResultStatusPass}},
+			}}},
+		},
+		{
+			name:    "records with an unknown name",
+			query:   fmt.Sprintf(`{"metadata":{"get_records":{"scope_id":"%s","name":"other"}}}`, scopeID),
+			resp:    &wasm.Records{},
+			expResp: &wasm.Records{Records: []*wasm.Record{}},
+		},
+		{
+			name:   "records with an invalid scope id",
+			query:  `{"metadata":{"get_records":{"scope_id":"bad"}}}`,
+			expErr: "wasm: invalid scope ID",
+		},
+		{
+			name:  "scope specification",
+			query: fmt.Sprintf(`{"metadata":{"get_scope_specification":{"specification_id":"%s"}}}`, scopeSpecID),
+			resp:  &wasm.ScopeSpecification{},
+			expResp: &wasm.ScopeSpecification{
+				SpecificationID: scopeSpecID.String(),
+				Description:     &wasm.Description{Name: "scope spec"},
+				OwnerAddresses:  []string{owner},
+				PartiesInvolved: []wasm.PartyType{wasm.PartyTypeOwner},
+				ContractSpecIDs: []string{contractSpecID.String()},
+			},
+		},
+		{
+			name:   "scope specification with an invalid id",
+			query:  `{"metadata":{"get_scope_specification":{"specification_id":"bad"}}}`,
+			expErr: "wasm: invalid scope specification ID",
+		},
+		{
+			name:   "scope specification not found",
+			query:  fmt.Sprintf(`{"metadata":{"get_scope_specification":{"specification_id":"%s"}}}`, unknownScopeSpecID),
+			expErr: "wasm: scope specification not found: " + unknownScopeSpecID.String(),
+		},
+		{
+			name:  "contract specification",
+			query: fmt.Sprintf(`{"metadata":{"get_contract_specification":{"specification_id":"%s"}}}`, contractSpecID),
+			resp:  &wasm.ContractSpecification{},
+			expResp: &wasm.ContractSpecification{
+				SpecificationID: contractSpecID.String(),
+				OwnerAddresses:  []string{owner},
+				PartiesInvolved: []wasm.PartyType{wasm.PartyTypeOwner},
+				Source:          &wasm.ContractSpecificationSource{Hash: "sourcehash"},
+				ClassName:       "class",
+			},
+		},
+		{
+			name:   "contract specification with an invalid id",
+			query:  `{"metadata":{"get_contract_specification":{"specification_id":"bad"}}}`,
+			expErr: "wasm: invalid contract specification ID",
+		},
+		{
+			name:   "contract specification not found",
+			query:  fmt.Sprintf(`{"metadata":{"get_contract_specification":{"specification_id":"%s"}}}`, unknownContractSpecID),
+			expErr: "wasm: contract specification not found: " + unknownContractSpecID.String(),
+		},
+		{
+			name:  "record specifications",
+			query: fmt.Sprintf(`{"metadata":{"get_record_specifications":{"contract_specification_id":"%s"}}}`, contractSpecID),
+			resp:  &wasm.RecordSpecifications{},
+			expResp: &wasm.RecordSpecifications{RecordSpecifications: []*wasm.RecordSpecification{{
+				SpecificationID: recordSpecID.String(),
+				Name:            "record",
+				Inputs: []*wasm.InputSpecification{{Name: "input", TypeName: "string",
+					Source: &wasm.RecordInputSource{Hash: &wasm.RecordInputSourceHash{Hash: "inputhash"}}}},
+				TypeName:           "string",
+				ResultType:         wasm.DefinitionTypeRecord,
+				ResponsibleParties: []wasm.PartyType{wasm.PartyTypeOwner},
+			}}},
+		},
+		{
+			name:   "record specifications with an invalid id",
+			query:  `{"metadata":{"get_record_specifications":{"contract_specification_id":"bad"}}}`,
+			expErr: "wasm: invalid contract specification ID",
+		},
+		{
+			name:   "record specifications with a scope specification id",
+			query:  fmt.Sprintf(`{"metadata":{"get_record_specifications":{"contract_specification_id":"%s"}}}`, scopeSpecID),
+			expErr: "wasm: unable to get record specifications",
+		},
+		{
+			name:    "scopes by owner",
+			query:   fmt.Sprintf(`{"metadata":{"get_scopes_by_owner":{"address":"%s"}}}`, owner),
+			resp:    &wasm.ScopeIDs{},
+			expResp: &wasm.ScopeIDs{ScopeIDs: []string{scopeID.String()}},
+		},
+		{
+			name:    "scopes by owner without scopes",
+			query:   fmt.Sprintf(`{"metadata":{"get_scopes_by_owner":{"address":"%s"}}}`, nobody),
+			resp:    &wasm.ScopeIDs{},
+			expResp: &wasm.ScopeIDs{ScopeIDs: []string{}},
+		},
+		{
+			name:   "scopes by owner with an invalid address",
+			query:  `{"metadata":{"get_scopes_by_owner":{"address":"bad"}}}`,
+			expErr: "wasm: invalid owner address",
+		},
+		{
+			name:    "scopes by value owner",
+			query:   fmt.Sprintf(`{"metadata":{"get_scopes_by_value_owner":{"address":"%s"}}}`, valueOwner),
+			resp:    &wasm.ScopeIDs{},
+			expResp: &wasm.ScopeIDs{ScopeIDs: []string{scopeID.String()}},
+		},
+		{
+			name:    "scopes by value owner that is only an owner",
+			query:   fmt.Sprintf(`{"metadata":{"get_scopes_by_value_owner":{"address":"%s"}}}`, owner),
+			resp:    &wasm.ScopeIDs{},
+			expResp: &wasm.ScopeIDs{ScopeIDs: []string{}},
+		},
+		{
+			name:   "scopes by value owner with an invalid address",
+			query:  `{"metadata":{"get_scopes_by_value_owner":{"address":"bad"}}}`,
+			expErr: "wasm: invalid value owner address",
+		},
+	}
+
+	querier := wasm.Querier(app.MetadataKeeper)
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			bz, err := querier(ctx, []byte(tc.query), "")
+			if len(tc.expErr) > 0 {
+				require.ErrorContains(t, err, tc.expErr, "querier error")
+				assert.Nil(t, bz, "querier response")
+				return
+			}
+			require.NoError(t, err, "querier error")
+			require.NoError(t, json.Unmarshal(bz, tc.resp), "unmarshal querier response")
+			assert.Equal(t, tc.expResp, tc.resp, "querier response")
+		})
+	}
+}
@@ -66,8 +66,9 @@ const (
 
 // Party is an address with an associated role.
 type Party struct {
-	Address string    `json:"address"`
-	Role    PartyType `json:"role"`
+	Address  string    `json:"address"`
+	Role     PartyType `json:"role"`
+	Optional bool      `json:"optional,omitempty"`
 }
 
 // Records is a group of records.
@@ -164,6 +165,82 @@ const (
 	ResultStatusUnspecified ResultStatus = "unspecified"
 )
 
+// ScopeSpecification defines the required parties, resources, conditions, and consideration outputs for a contract.
+type ScopeSpecification struct {
+	SpecificationID string       `json:"specification_id"`
+	Description     *Description `json:"description,omitempty"`
+	OwnerAddresses  []string     `json:"owner_addresses,omitempty"`
+	PartiesInvolved []PartyType  `json:"parties_involved,omitempty"`
+	ContractSpecIDs []string     `json:"contract_spec_ids,omitempty"`
+}
+
+// ContractSpecification defines the required parties, resources, conditions, and consideration outputs for a
+// contract.
+type ContractSpecification struct {
+	SpecificationID string                       `json:"specification_id"`
+	Description     *Description                 `json:"description,omitempty"`
+	OwnerAddresses  []string                     `json:"owner_addresses,omitempty"`
+	PartiesInvolved []PartyType                  `json:"parties_involved,omitempty"`
+	Source          *ContractSpecificationSource `json:"source"`
+	ClassName       string                       `json:"class_name"`
+}
+
+// ContractSpecificationSource is the source of a contract specification. Either resource id or hash should be set,
+// but not both.
+type ContractSpecificationSource struct {
+	ResourceID string `json:"resource_id,omitempty"`
+	Hash       string `json:"hash,omitempty"`
+}
+
+// RecordSpecifications is a group of record specifications.
+type RecordSpecifications struct {
+	RecordSpecifications []*RecordSpecification `json:"record_specifications"`
+}
+
+// RecordSpecification defines the specification for a Record including allowed/required inputs/outputs.
+type RecordSpecification struct {
+	SpecificationID    string                `json:"specification_id"`
+	Name               string                `json:"name"`
+	Inputs             []*InputSpecification `json:"inputs,omitempty"`
+	TypeName           string                `json:"type_name"`
+	ResultType         DefinitionType        `json:"result_type"`
+	ResponsibleParties []PartyType           `json:"responsible_parties,omitempty"`
+}
+
+// InputSpecification defines a name, type_name, and source reference for a record input.
+type InputSpecification struct {
+	Name     string             `json:"name"`
+	TypeName string             `json:"type_name"`
+	Source   *RecordInputSource `json:"source"`
+}
+
+// Description holds general information that is handy to associate with a structure.
+type Description struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	WebsiteURL  string `json:"website_url,omitempty"`
+	IconURL     string `json:"icon_url,omitempty"`
+}
+
+// DefinitionType defines the types of record results.
+type DefinitionType string
+
+const (
+	// DefinitionTypeProposed is a concrete definition type.
+	DefinitionTypeProposed DefinitionType = "proposed"
+	// DefinitionTypeRecord is a concrete definition type.
+	DefinitionTypeRecord DefinitionType = "record"
+	// DefinitionTypeRecordList is a concrete definition type.
+	DefinitionTypeRecordList DefinitionType = "record_list"
+	// DefinitionTypeUnspecified is a concrete definition type.
+	DefinitionTypeUnspecified DefinitionType = "unspecified"
+)
+
+// ScopeIDs is a group of scope addresses.
+type ScopeIDs struct {
+	ScopeIDs []string `json:"scope_ids"`
+}
+
 // A slightly modified, non-panicing version of MetadataAddress.String(). Panics across FFI
 // boundaries can crash the chain, so just fail the query.
 func bech32Address(ma types.MetadataAddress) (string, error) {
@@ -223,11 +300,280 @@ func (party *Party) convertToBaseType() (*types.Party, error) {
 		return nil, fmt.Errorf("wasm: invalid 'data_access' address: %w", err)
 	}
 	return &types.Party{
-		Address: party.Address,
-		Role:    party.Role.convertToBaseType(),
+		Address:  party.Address,
+		Role:     party.Role.convertToBaseType(),
+		Optional: party.Optional,
+	}, nil
+}
+
+// Convert a slice of provwasm parties into baseType parties.
+func convertPartiesToBaseType(parties []*Party) ([]types.Party, error) {
+	if len(parties) == 0 {
+		return nil, nil
+	}
+	baseTypes := make([]types.Party, len(parties))
+	for i, p := range parties {
+		party, err := p.convertToBaseType()
+		if err != nil {
+			return nil, err
+		}
+		baseTypes[i] = *party
+	}
+	return baseTypes, nil
+}
+
+// Convert a slice of provwasm party types into baseType party types.
+func convertPartyTypesToBaseType(partyTypes []PartyType) []types.PartyType {
+	baseTypes := make([]types.PartyType, len(partyTypes))
+	for i := range partyTypes {
+		baseTypes[i] = partyTypes[i].convertToBaseType()
+	}
+	return baseTypes
+}
+
+// Convert a provwasm session into the baseType session.
+func (session *Session) convertToBaseType() (*types.Session, error) {
+	sessionID, err := types.MetadataAddressFromBech32(session.SessionID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'session id': %w", err)
+	}
+	specificationID, err := types.MetadataAddressFromBech32(session.SpecificationID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'specification id': %w", err)
+	}
+	parties, err := convertPartiesToBaseType(session.Parties)
+	if err != nil {
+		return nil, err
+	}
+	return &types.Session{
+		SessionId:       sessionID,
+		SpecificationId: specificationID,
+		Parties:         parties,
+		Name:            session.Name,
+		Context:         session.Context,
 	}, nil
 }
 
+// Convert a provwasm record into the baseType record.
+func (record *Record) convertToBaseType() (*types.Record, error) {
+	sessionID, err := types.MetadataAddressFromBech32(record.SessionID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'session id': %w", err)
+	}
+	specificationID, err := types.MetadataAddressFromBech32(record.SpecificationID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'specification id': %w", err)
+	}
+	if record.Process == nil {
+		return nil, fmt.Errorf("wasm: a process must be defined for a record")
+	}
+	process, err := record.Process.convertToBaseType()
+	if err != nil {
+		return nil, err
+	}
+	baseType := &types.Record{
+		Name:            record.Name,
+		SessionId:       sessionID,
+		Process:         *process,
+		Inputs:          make([]types.RecordInput, len(record.Inputs)),
+		Outputs:         make([]types.RecordOutput, len(record.Outputs)),
+		SpecificationId: specificationID,
+	}
+	for i, in := range record.Inputs {
+		input, err := in.convertToBaseType()
+		if err != nil {
+			return nil, err
+		}
+		baseType.Inputs[i] = *input
+	}
+	for i, out := range record.Outputs {
+		baseType.Outputs[i] = types.RecordOutput{
+			Hash:   out.Hash,
+			Status: out.Status.convertToBaseType(),
+		}
+	}
+	return baseType, nil
+}
+
+// Convert a provwasm process into the baseType process.
+func (process *Process) convertToBaseType() (*types.Process, error) {
+	baseType := &types.Process{
+		Name:   process.Name,
+		Method: process.Method,
+	}
+	switch {
+	case process.ProcessID != nil && process.ProcessID.Address != nil && process.ProcessID.Hash == nil:
+		baseType.ProcessId = &types.Process_Address{Address: process.ProcessID.Address.Address}
+	case process.ProcessID != nil && process.ProcessID.Hash != nil && process.ProcessID.Address == nil:
+		baseType.ProcessId = &types.Process_Hash{Hash: process.ProcessID.Hash.Hash}
+	default:
+		return nil, fmt.Errorf("wasm: address or hash (but not both) must be defined for a process id")
+	}
+	return baseType, nil
+}
+
+// Convert a provwasm record input into the baseType record input.
+func (input *RecordInput) convertToBaseType() (*types.RecordInput, error) {
+	baseType := &types.RecordInput{
+		Name:     input.Name,
+		TypeName: input.TypeName,
+		Status:   input.Status.convertToBaseType(),
+	}
+	switch {
+	case input.Source != nil && input.Source.Record != nil && input.Source.Hash == nil:
+		recordID, err := types.MetadataAddressFromBech32(input.Source.Record.RecordID)
+		if err != nil {
+			return nil, fmt.Errorf("wasm: invalid 'record id': %w", err)
+		}
+		baseType.Source = &types.RecordInput_RecordId{RecordId: recordID}
+	case input.Source != nil && input.Source.Hash != nil && input.Source.Record == nil:
+		baseType.Source = &types.RecordInput_Hash{Hash: input.Source.Hash.Hash}
+	default:
+		return nil, fmt.Errorf("wasm: hash or record id (but not both) must be defined for a source")
+	}
+	return baseType, nil
+}
+
+// Convert a provwasm record input status into the baseType record input status.
+func (status InputStatus) convertToBaseType() types.RecordInputStatus {
+	switch status {
+	case InputStatusProposed:
+		return types.RecordInputStatus_Proposed
+	case InputStatusRecord:
+		return types.RecordInputStatus_Record
+	default:
+		return types.RecordInputStatus_Unknown
+	}
+}
+
+// Convert a provwasm result status into the baseType result status.
+func (status ResultStatus) convertToBaseType() types.ResultStatus {
+	switch status {
+	case ResultStatusPass:
+		return types.ResultStatus_RESULT_STATUS_PASS
+	case ResultStatusFail:
+		return types.ResultStatus_RESULT_STATUS_FAIL
+	case ResultStatusSkip:
+		return types.ResultStatus_RESULT_STATUS_SKIP
+	default:
+		return types.ResultStatus_RESULT_STATUS_UNSPECIFIED
+	}
+}
+
+// Convert a provwasm scope specification into the baseType scope specification.
+func (spec *ScopeSpecification) convertToBaseType() (*types.ScopeSpecification, error) {
+	specificationID, err := types.MetadataAddressFromBech32(spec.SpecificationID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'specification id': %w", err)
+	}
+	baseType := &types.ScopeSpecification{
+		SpecificationId: specificationID,
+		Description:     spec.Description.convertToBaseType(),
+		OwnerAddresses:  spec.OwnerAddresses,
+		PartiesInvolved: convertPartyTypesToBaseType(spec.PartiesInvolved),
+		ContractSpecIds: make([]types.MetadataAddress, len(spec.ContractSpecIDs)),
+	}
+	for i, id := range spec.ContractSpecIDs {
+		contractSpecID, err := types.MetadataAddressFromBech32(id)
+		if err != nil {
+			return nil, fmt.Errorf("wasm: invalid 'contract spec id': %w", err)
+		}
+		baseType.ContractSpecIds[i] = contractSpecID
+	}
+	return baseType, nil
+}
+
+// Convert a provwasm contract specification into the baseType contract specification.
+func (spec *ContractSpecification) convertToBaseType() (*types.ContractSpecification, error) {
+	specificationID, err := types.MetadataAddressFromBech32(spec.SpecificationID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'specification id': %w", err)
+	}
+	baseType := &types.ContractSpecification{
+		SpecificationId: specificationID,
+		Description:     spec.Description.convertToBaseType(),
+		OwnerAddresses:  spec.OwnerAddresses,
+		PartiesInvolved: convertPartyTypesToBaseType(spec.PartiesInvolved),
+		ClassName:       spec.ClassName,
+	}
+	switch {
+	case spec.Source != nil && len(spec.Source.ResourceID) > 0 && len(spec.Source.Hash) == 0:
+		resourceID, err := sdk.AccAddressFromBech32(spec.Source.ResourceID)
+		if err != nil {
+			return nil, fmt.Errorf("wasm: invalid 'resource id': %w", err)
+		}
+		baseType.Source = &types.ContractSpecification_ResourceId{ResourceId: resourceID}
+	case spec.Source != nil && len(spec.Source.Hash) > 0 && len(spec.Source.ResourceID) == 0:
+		baseType.Source = &types.ContractSpecification_Hash{Hash: spec.Source.Hash}
+	default:
+		return nil, fmt.Errorf("wasm: resource id or hash (but not both) must be defined for a contract specification source")
+	}
+	return baseType, nil
+}
+
+// Convert a provwasm record specification into the baseType record specification.
+func (spec *RecordSpecification) convertToBaseType() (*types.RecordSpecification, error) {
+	specificationID, err := types.MetadataAddressFromBech32(spec.SpecificationID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'specification id': %w", err)
+	}
+	baseType := &types.RecordSpecification{
+		SpecificationId:    specificationID,
+		Name:               spec.Name,
+		Inputs:             make([]*types.InputSpecification, len(spec.Inputs)),
+		TypeName:           spec.TypeName,
+		ResultType:         spec.ResultType.convertToBaseType(),
+		ResponsibleParties: convertPartyTypesToBaseType(spec.ResponsibleParties),
+	}
+	for i, in := range spec.Inputs {
+		input := &types.InputSpecification{
+			Name:     in.Name,
+			TypeName: in.TypeName,
+		}
+		switch {
+		case in.Source != nil && in.Source.Record != nil && in.Source.Hash == nil:
+			recordID, err := types.MetadataAddressFromBech32(in.Source.Record.RecordID)
+			if err != nil {
+				return nil, fmt.Errorf("wasm: invalid 'record id': %w", err)
+			}
+			input.Source = &types.InputSpecification_RecordId{RecordId: recordID}
+		case in.Source != nil && in.Source.Hash != nil && in.Source.Record == nil:
+			input.Source = &types.InputSpecification_Hash{Hash: in.Source.Hash.Hash}
+		default:
+			return nil, fmt.Errorf("wasm: hash or record id (but not both) must be defined for an input specification source")
+		}
+		baseType.Inputs[i] = input
+	}
+	return baseType, nil
+}
+
+// Convert a provwasm description into the baseType description.
+func (description *Description) convertToBaseType() *types.Description {
+	if description == nil {
+		return nil
+	}
+	return &types.Description{
+		Name:        description.Name,
+		Description: description.Description,
+		WebsiteUrl:  description.WebsiteURL,
+		IconUrl:     description.IconURL,
+	}
+}
+
+// Convert a provwasm definition type into the baseType definition type.
+func (definitionType DefinitionType) convertToBaseType() types.DefinitionType {
+	switch definitionType {
+	case DefinitionTypeProposed:
+		return types.DefinitionType_DEFINITION_TYPE_PROPOSED
+	case DefinitionTypeRecord:
+		return types.DefinitionType_DEFINITION_TYPE_RECORD
+	case DefinitionTypeRecordList:
+		return types.DefinitionType_DEFINITION_TYPE_RECORD_LIST
+	default:
+		return types.DefinitionType_DEFINITION_TYPE_UNSPECIFIED
+	}
+}
+
 // Convert a provwasm partytype into the baseType partytype.
 func (partyType *PartyType) convertToBaseType() types.PartyType {
 	switch *partyType {
@@ -437,8 +783,9 @@ func createSource(baseType types.RecordInput) (*RecordInputSource, error) {
 // Convert a party to its provwasm type.
 func createParty(baseType types.Party) *Party {
 	return &Party{
-		Address: baseType.Address,
-		Role:    createRole(baseType.Role),
+		Address:  baseType.Address,
+		Role:     createRole(baseType.Role),
+		Optional: baseType.Optional,
 	}
 }
 
@@ -495,3 +842,168 @@ func createResultStatus(baseType types.ResultStatus) ResultStatus {
 		return ResultStatusUnspecified
 	}
 }
+
+// Convert a scope specification into provwasm JSON format.
+func createScopeSpecificationResponse(baseType types.ScopeSpecification) ([]byte, error) {
+	specificationID, err := bech32Address(baseType.SpecificationId)
+	if err != nil {
+		return nil, err
+	}
+	spec := &ScopeSpecification{
+		SpecificationID: specificationID,
+		Description:     createDescription(baseType.Description),
+		OwnerAddresses:  baseType.OwnerAddresses,
+		PartiesInvolved: createRoles(baseType.PartiesInvolved),
+		ContractSpecIDs: make([]string, len(baseType.ContractSpecIds)),
+	}
+	for i, id := range baseType.ContractSpecIds {
+		if spec.ContractSpecIDs[i], err = bech32Address(id); err != nil {
+			return nil, err
+		}
+	}
+	bz, err := json.Marshal(spec)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: marshal scope specification failed: %w", err)
+	}
+	return bz, nil
+}
+
+// Convert a contract specification into provwasm JSON format.
+func createContractSpecificationResponse(baseType types.ContractSpecification) ([]byte, error) {
+	specificationID, err := bech32Address(baseType.SpecificationId)
+	if err != nil {
+		return nil, err
+	}
+	spec := &ContractSpecification{
+		SpecificationID: specificationID,
+		Description:     createDescription(baseType.Description),
+		OwnerAddresses:  baseType.OwnerAddresses,
+		PartiesInvolved: createRoles(baseType.PartiesInvolved),
+		Source:          &ContractSpecificationSource{},
+		ClassName:       baseType.ClassName,
+	}
+	switch source := baseType.Source.(type) {
+	case *types.ContractSpecification_ResourceId:
+		spec.Source.ResourceID = source.ResourceId.String()
+	case *types.ContractSpecification_Hash:
+		spec.Source.Hash = source.Hash
+	default:
+		return nil, fmt.Errorf("wasm: resource id or hash must be defined for a contract specification source")
+	}
+	bz, err := json.Marshal(spec)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: marshal contract specification failed: %w", err)
+	}
+	return bz, nil
+}
+
+// Convert a slice of record specifications into provwasm JSON format.
+func createRecordSpecificationsResponse(baseTypeSlice []*types.RecordSpecification) ([]byte, error) {
+	specs := &RecordSpecifications{
+		RecordSpecifications: make([]*RecordSpecification, len(baseTypeSlice)),
+	}
+	for i, baseType := range baseTypeSlice {
+		spec, err := createRecordSpecification(baseType)
+		if err != nil {
+			return nil, err
+		}
+		specs.RecordSpecifications[i] = spec
+	}
+	bz, err := json.Marshal(specs)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: marshal record specifications failed: %w", err)
+	}
+	return bz, nil
+}
+
+// Convert a record specification into its provwasm type.
+func createRecordSpecification(baseType *types.RecordSpecification) (*RecordSpecification, error) {
+	specificationID, err := bech32Address(baseType.SpecificationId)
+	if err != nil {
+		return nil, err
+	}
+	spec := &RecordSpecification{
+		SpecificationID:    specificationID,
+		Name:               baseType.Name,
+		Inputs:             make([]*InputSpecification, len(baseType.Inputs)),
+		TypeName:           baseType.TypeName,
+		ResultType:         createDefinitionType(baseType.ResultType),
+		ResponsibleParties: createRoles(baseType.ResponsibleParties),
+	}
+	for i, in := range baseType.Inputs {
+		input := &InputSpecification{
+			Name:     in.Name,
+			TypeName: in.TypeName,
+			Source:   &RecordInputSource{},
+		}
+		switch source := in.Source.(type) {
+		case *types.InputSpecification_RecordId:
+			recordID, err := bech32Address(source.RecordId)
+			if err != nil {
+				return nil, err
+			}
+			input.Source.Record = &RecordInputSourceRecord{RecordID: recordID}
+		case *types.InputSpecification_Hash:
+			input.Source.Hash = &RecordInputSourceHash{Hash: source.Hash}
+		default:
+			return nil, fmt.Errorf("wasm: hash or record id must be defined for an input specification source")
+		}
+		spec.Inputs[i] = input
+	}
+	return spec, nil
+}
+
+// Convert a slice of scope addresses into provwasm JSON format.
+func createScopeIDsResponse(baseTypeSlice []types.MetadataAddress) ([]byte, error) {
+	scopeIDs := &ScopeIDs{
+		ScopeIDs: make([]string, len(baseTypeSlice)),
+	}
+	for i, baseType := range baseTypeSlice {
+		scopeID, err := bech32Address(baseType)
+		if err != nil {
+			return nil, err
+		}
+		scopeIDs.ScopeIDs[i] = scopeID
+	}
+	bz, err := json.Marshal(scopeIDs)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: marshal scope ids failed: %w", err)
+	}
+	return bz, nil
+}
+
+// Convert a description to its provwasm type.
+func createDescription(baseType *types.Description) *Description {
+	if baseType == nil {
+		return nil
+	}
+	return &Description{
+		Name:        baseType.Name,
+		Description: baseType.Description,
+		WebsiteURL:  baseType.WebsiteUrl,
+		IconURL:     baseType.IconUrl,
+	}
+}
+
+// Convert a slice of party types to their provwasm types.
+func createRoles(baseTypes []types.PartyType) []PartyType {
+	roles := make([]PartyType, len(baseTypes))
+	for i, baseType := range baseTypes {
+		roles[i] = createRole(baseType)
+	}
+	return roles
+}
+
+// Convert a definition type to its provwasm type.
+func createDefinitionType(baseType types.DefinitionType) DefinitionType {
+	switch baseType {
+	case types.DefinitionType_DEFINITION_TYPE_PROPOSED:
+		return DefinitionTypeProposed
+	case types.DefinitionType_DEFINITION_TYPE_RECORD:
+		return DefinitionTypeRecord
+	case types.DefinitionType_DEFINITION_TYPE_RECORD_LIST:
+		return DefinitionTypeRecordList
+	default:
+		return DefinitionTypeUnspecified
+	}
+}
@@ -35,6 +35,7 @@ type Keeper struct {
 	txDecoder        sdk.TxDecoder
 	registry         cdctypes.InterfaceRegistry
 	authority        string
+	markerKeeper     types.MarkerKeeper
 }
 
 // NewKeeper returns a AdditionalFeeKeeper. It handles:
@@ -66,6 +67,13 @@ func NewKeeper(
 	}
 }
 
+// WithMarkerKeeper returns a copy of this keeper that uses the provided marker keeper to convert fees
+// denominated in marker coins using their net asset values.
+func (k Keeper) WithMarkerKeeper(markerKeeper types.MarkerKeeper) Keeper {
+	k.markerKeeper = markerKeeper
+	return k
+}
+
 // GetAuthority is signer of the proposal
 func (k Keeper) GetAuthority() string {
 	return k.authority
@@ -209,7 +217,8 @@ func (k Keeper) DeductFeesDistributions(bankKeeper bankkeeper.Keeper, ctx sdk.Co
 }
 
 // ConvertDenomToHash converts usd coin to nhash coin using nhash per usd mil.
-// Currently, usd is only supported with nhash to usd mil coming from params
+// Currently, usd is only supported with nhash to usd mil coming from params.
+// Coins of a marker denom are converted using the latest net asset value of the marker.
 func (k Keeper) ConvertDenomToHash(ctx sdk.Context, coin sdk.Coin) (sdk.Coin, error) {
 	conversionDenom := k.GetConversionFeeDenom(ctx)
 	switch coin.Denom {
@@ -221,8 +230,29 @@ func (k Keeper) ConvertDenomToHash(ctx sdk.Context, coin sdk.Coin) (sdk.Coin, er
 	case conversionDenom:
 		return coin, nil
 	default:
+		return k.convertMarkerDenomToHash(ctx, coin, conversionDenom)
+	}
+}
+
+// convertMarkerDenomToHash converts a coin of a marker denom using the latest net asset value of the marker.
+// A net asset value priced in the conversion denom is used when available, otherwise one priced in usd (mils).
+func (k Keeper) convertMarkerDenomToHash(ctx sdk.Context, coin sdk.Coin, conversionDenom string) (sdk.Coin, error) {
+	if k.markerKeeper == nil {
 		return sdk.Coin{}, sdkerrors.ErrInvalidType.Wrapf("denom not supported for conversion %s", coin.Denom)
 	}
+	for _, priceDenom := range []string{conversionDenom, types.UsdDenom} {
+		nav, err := k.markerKeeper.GetNetAssetValue(ctx, coin.Denom, priceDenom)
+		if err != nil || nav == nil || nav.Volume == 0 {
+			continue
+		}
+		amount := coin.Amount.Mul(nav.Price.Amount)
+		if priceDenom == types.UsdDenom {
+			amount = amount.Mul(sdk.NewIntFromUint64(k.GetNhashPerUsdMil(ctx)))
+		}
+		amount = amount.Quo(sdk.NewIntFromUint64(nav.Volume))
+		return sdk.NewCoin(conversionDenom, amount), nil
+	}
+	return sdk.Coin{}, sdkerrors.ErrInvalidType.Wrapf("denom not supported for conversion %s", coin.Denom)
 }
 
 // CalculateAdditionalFeesToBePaid computes the additional fees to be paid for the provided messages.
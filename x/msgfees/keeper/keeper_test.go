@@ -18,6 +18,7 @@ import (
 
 	simapp "github.com/provenance-io/provenance/app"
 	"github.com/provenance-io/provenance/internal/pioconfig"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
 	"github.com/provenance-io/provenance/x/msgfees/types"
 )
 
@@ -105,6 +106,33 @@ func (s *TestSuite) TestConvertDenomToHash() {
 	s.Assert().Equal(sdk.Coin{}, nhash)
 }
 
+func (s *TestSuite) TestConvertMarkerDenomToHash() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	feeDenom := pioconfig.GetProvenanceConfig().FeeDenom
+
+	usdMarker := markertypes.NewEmptyMarkerAccount("usdnavcoin", addrs[0].String(), nil)
+	s.Require().NoError(app.MarkerKeeper.AddMarkerAccount(ctx, usdMarker), "AddMarkerAccount usdnavcoin")
+	hashMarker := markertypes.NewEmptyMarkerAccount("hashnavcoin", addrs[0].String(), nil)
+	s.Require().NoError(app.MarkerKeeper.AddMarkerAccount(ctx, hashMarker), "AddMarkerAccount hashnavcoin")
+
+	nhash, err := app.MsgFeesKeeper.ConvertDenomToHash(ctx, sdk.NewInt64Coin("usdnavcoin", 10))
+	s.Assert().EqualError(err, "denom not supported for conversion usdnavcoin: invalid type", "usdnavcoin without net asset value")
+	s.Assert().Equal(sdk.Coin{}, nhash)
+
+	// 4 usdnavcoin are worth $1.00 (1,000 usd mils).
+	app.MarkerKeeper.AddNetAssetValue(ctx, usdMarker.GetAddress(), markertypes.NewNetAssetValue(sdk.NewInt64Coin(types.UsdDenom, 1_000), 4, "test"))
+	nhash, err = app.MsgFeesKeeper.ConvertDenomToHash(ctx, sdk.NewInt64Coin("usdnavcoin", 10))
+	s.Assert().NoError(err)
+	s.Assert().Equal(sdk.NewInt64Coin(feeDenom, 62_500_000_000), nhash, "usdnavcoin priced in usd")
+
+	// 3 hashnavcoin are worth 5 hash. A value priced in the fee denom is used over one priced in usd.
+	app.MarkerKeeper.AddNetAssetValue(ctx, hashMarker.GetAddress(), markertypes.NewNetAssetValue(sdk.NewInt64Coin(types.UsdDenom, 1), 1, "test"))
+	app.MarkerKeeper.AddNetAssetValue(ctx, hashMarker.GetAddress(), markertypes.NewNetAssetValue(sdk.NewInt64Coin(feeDenom, 5_000_000_000), 3, "test"))
+	nhash, err = app.MsgFeesKeeper.ConvertDenomToHash(ctx, sdk.NewInt64Coin("hashnavcoin", 6))
+	s.Assert().NoError(err)
+	s.Assert().Equal(sdk.NewInt64Coin(feeDenom, 10_000_000_000), nhash, "hashnavcoin priced in nhash")
+}
+
 func (s *TestSuite) TestDeductFeesDistributions() {
 	app, ctx, addrs := s.app, s.ctx, s.addrs
 	var err error
@@ -2,7 +2,10 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -12,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/provenance-io/provenance/internal/antewrapper"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
 	"github.com/provenance-io/provenance/x/msgfees/types"
 )
 
@@ -55,7 +59,7 @@ func (k Keeper) QueryAllMsgFees(c context.Context, req *types.QueryAllMsgFeesReq
 func (k Keeper) CalculateTxFees(goCtx context.Context, request *types.CalculateTxFeesRequest) (*types.CalculateTxFeesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	gasInfo, _, txCtx, err := k.simulateFunc(request.TxBytes)
+	gasInfo, result, txCtx, err := k.simulateFunc(request.TxBytes)
 	if err != nil {
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
@@ -78,9 +82,43 @@ func (k Keeper) CalculateTxFees(goCtx context.Context, request *types.CalculateT
 	gasUsed := sdk.NewInt(int64(float64(gasInfo.GasUsed) * float64(gasAdjustment)))
 	totalFees := gasMeter.FeeConsumed().Add(sdk.NewCoin(baseDenom, minGasPrice.Amount.Mul(gasUsed)))
 
+	var transferFees sdk.Coins
+	if result != nil {
+		transferFees, err = transferFeesFromEvents(result.Events)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &types.CalculateTxFeesResponse{
 		AdditionalFees: gasMeter.FeeConsumed(),
 		TotalFees:      totalFees,
 		EstimatedGas:   gasUsed.Uint64(),
+		TransferFees:   transferFees,
 	}, nil
 }
+
+// transferFeesFromEvents sums up the marker transfer fees paid according to the provided events.
+func transferFeesFromEvents(events []abci.Event) (sdk.Coins, error) {
+	eventType := proto.MessageName(&markertypes.EventMarkerTransferFee{})
+	fees := sdk.NewCoins()
+	for _, event := range events {
+		if event.Type != eventType {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			return nil, err
+		}
+		feeEvent, ok := msg.(*markertypes.EventMarkerTransferFee)
+		if !ok {
+			return nil, fmt.Errorf("unexpected %s event type: %T", eventType, msg)
+		}
+		paid, err := sdk.ParseCoinsNormalized(feeEvent.Fees)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s fees %q: %w", eventType, feeEvent.Fees, err)
+		}
+		fees = fees.Add(paid...)
+	}
+	return fees, nil
+}
@@ -189,6 +189,37 @@ func (s *QueryServerTestSuite) TestCalculateTxFeesWithAssessCustomFees() {
 	s.Assert().Equal(fmt.Sprintf("%s,%s", additionalAccessedFeesCoin.String(), expectedGasFees.String()), response.TotalFees.String())
 }
 
+func (s *QueryServerTestSuite) TestCalculateTxFeesWithMarkerTransferFee() {
+	server := markerkeeper.NewMsgServerImpl(s.app.MarkerKeeper)
+
+	feeDenom := "feedog"
+	_, err := server.AddFinalizeActivateMarker(sdk.WrapSDKContext(s.ctx), markertypes.NewMsgAddFinalizeActivateMarkerRequest(
+		feeDenom, sdk.NewInt(1000), s.user1Addr, s.user1Addr, markertypes.MarkerType_RestrictedCoin, true, true, false, []string{},
+		[]markertypes.AccessGrant{{Address: s.user1, Permissions: markertypes.AccessListByNames("MINT,WITHDRAW,TRANSFER,ADMIN")}},
+	))
+	s.Require().NoError(err)
+	_, err = server.Withdraw(sdk.WrapSDKContext(s.ctx), markertypes.NewMsgWithdrawRequest(s.user1Addr, s.user1Addr, feeDenom, sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 1000))))
+	s.Require().NoError(err)
+
+	issuer := sdk.AccAddress("issuer______________")
+	flatFee := sdk.NewInt64Coin(s.cfg.BondDenom, 5)
+	_, err = server.SetTransferFee(sdk.WrapSDKContext(s.ctx), markertypes.NewMsgSetTransferFeeRequest(feeDenom, issuer.String(), 100, sdk.NewCoins(flatFee), s.user1))
+	s.Require().NoError(err)
+
+	bankSend := banktypes.NewMsgSend(s.user1Addr, s.user2Addr, sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 500)))
+	transfer := markertypes.NewMsgTransferRequest(s.user1Addr, s.user1Addr, s.user2Addr, sdk.NewInt64Coin(feeDenom, 200))
+	simulateReq := s.createTxFeesRequest(s.pubkey1, s.privkey1, s.acct1, bankSend, transfer)
+	response, err := s.queryClient.CalculateTxFees(s.ctx.Context(), &simulateReq)
+	s.Require().NoError(err)
+	s.Require().NotNil(response)
+	s.Assert().True(response.AdditionalFees.Empty())
+	expectedTotalFees := sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(int64(response.EstimatedGas)*s.minGasPrice.Amount.Int64())))
+	s.Assert().Equal(expectedTotalFees.String(), response.TotalFees.String(), "total fees")
+	expectedTransferFees := sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 7), flatFee.Add(flatFee))
+	s.Assert().Equal(expectedTransferFees.String(), response.TransferFees.String(), "transfer fees")
+	s.Assert().True(s.app.BankKeeper.GetAllBalances(s.ctx, issuer).IsZero(), "issuer balance after simulation")
+}
+
 func (s *QueryServerTestSuite) createTxFeesRequest(pubKey cryptotypes.PubKey, privKey cryptotypes.PrivKey, acct authtypes.AccountI, msgs ...sdk.Msg) types.CalculateTxFeesRequest {
 	theTx := s.cfg.TxConfig.NewTxBuilder()
 	s.Require().NoError(theTx.SetMsgs(msgs...))
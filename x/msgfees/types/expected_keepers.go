@@ -5,6 +5,8 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
 )
 
 // AccountKeeper defines the expected account keeper (noalias)
@@ -31,3 +33,8 @@ type FeegrantKeeper interface {
 	GetAllowance(ctx sdk.Context, granter sdk.AccAddress, grantee sdk.AccAddress) (feegrant.FeeAllowanceI, error)
 	UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
 }
+
+// MarkerKeeper defines the expected marker keeper.
+type MarkerKeeper interface {
+	GetNetAssetValue(ctx sdk.Context, markerDenom, priceDenom string) (*markertypes.NetAssetValue, error)
+}
@@ -290,6 +290,9 @@ type CalculateTxFeesResponse struct {
 	TotalFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_fees,json=totalFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_fees"`
 	// estimated_gas is the amount of gas needed for the transaction
 	EstimatedGas uint64 `protobuf:"varint,3,opt,name=estimated_gas,json=estimatedGas,proto3" json:"estimated_gas,omitempty"`
+	// transfer_fees are the marker transfer fees paid to marker issuers by the senders of the transaction's transfers.
+	// note: these are not part of total_fees since they are paid by the senders instead of the fee payer.
+	TransferFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=transfer_fees,json=transferFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"transfer_fees"`
 }
 
 func (m *CalculateTxFeesResponse) Reset()         { *m = CalculateTxFeesResponse{} }
@@ -346,6 +349,13 @@ func (m *CalculateTxFeesResponse) GetEstimatedGas() uint64 {
 	return 0
 }
 
+func (m *CalculateTxFeesResponse) GetTransferFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TransferFees
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "provenance.msgfees.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "provenance.msgfees.v1.QueryParamsResponse")
@@ -358,50 +368,51 @@ func init() {
 func init() { proto.RegisterFile("provenance/msgfees/v1/query.proto", fileDescriptor_73f2d53a5aebf81b) }
 
 var fileDescriptor_73f2d53a5aebf81b = []byte{
-	// 682 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xbf, 0x53, 0x13, 0x4f,
-	0x18, 0xc6, 0xb3, 0x81, 0x2f, 0x3f, 0xf6, 0xcb, 0x0f, 0x67, 0x45, 0x08, 0x19, 0x38, 0xf0, 0x18,
-	0x14, 0x18, 0xb9, 0x35, 0x60, 0xe1, 0x68, 0x45, 0x70, 0xa0, 0x72, 0x06, 0x6f, 0xac, 0x6c, 0xce,
-	0x4d, 0x6e, 0x59, 0x0f, 0xef, 0x6e, 0x8f, 0xec, 0x26, 0x13, 0x5a, 0x0b, 0xc7, 0xd2, 0x19, 0x2d,
-	0x2d, 0xac, 0x2c, 0xfc, 0x4b, 0x28, 0x99, 0xb1, 0xb1, 0x52, 0x07, 0xfc, 0x43, 0x9c, 0xdd, 0xbd,
-	0x24, 0x17, 0x92, 0x20, 0x85, 0x15, 0x64, 0xf7, 0x79, 0xf7, 0xf9, 0xbc, 0x6f, 0x9e, 0x37, 0xf0,
-	0x76, 0x52, 0xe3, 0x0d, 0x1a, 0x93, 0xb8, 0x4a, 0x71, 0x24, 0xd8, 0x21, 0xa5, 0x02, 0x37, 0x4a,
-	0xf8, 0xb8, 0x4e, 0x6b, 0x27, 0x4e, 0x52, 0xe3, 0x92, 0xa3, 0x5b, 0x1d, 0x89, 0x93, 0x4a, 0x9c,
-	0x46, 0xa9, 0x38, 0xc3, 0x38, 0xe3, 0x5a, 0x81, 0xd5, 0x7f, 0x46, 0x5c, 0x5c, 0x60, 0x9c, 0xb3,
-	0x90, 0x62, 0x92, 0x04, 0x98, 0xc4, 0x31, 0x97, 0x44, 0x06, 0x3c, 0x16, 0xe9, 0xed, 0x4a, 0x7f,
-	0xb7, 0xd6, 0xab, 0x46, 0x64, 0x55, 0xb9, 0x88, 0xb8, 0xc0, 0x15, 0x22, 0x28, 0x6e, 0x94, 0x2a,
-	0x54, 0x92, 0x12, 0xae, 0xf2, 0x20, 0x4e, 0xef, 0x37, 0xb2, 0xf7, 0x1a, 0xb4, 0xad, 0x4a, 0x08,
-	0x0b, 0x62, 0xed, 0x68, 0xb4, 0xf6, 0x0c, 0x44, 0xcf, 0x94, 0xe2, 0x80, 0xd4, 0x48, 0x24, 0x5c,
-	0x7a, 0x5c, 0xa7, 0x42, 0xda, 0x2e, 0xbc, 0xd9, 0x75, 0x2a, 0x12, 0x1e, 0x0b, 0x8a, 0x1e, 0xc3,
-	0x91, 0x44, 0x9f, 0x14, 0xc0, 0x32, 0x58, 0xfb, 0x7f, 0x6b, 0xd1, 0xe9, 0xdb, 0xb9, 0x63, 0xca,
-	0xca, 0xc3, 0xa7, 0x3f, 0x96, 0x72, 0x6e, 0x5a, 0x62, 0xbf, 0x84, 0xb3, 0xfa, 0xcd, 0x9d, 0x30,
-	0x7c, 0x2a, 0xd8, 0x1e, 0xa5, 0x2d, 0x37, 0xb4, 0x07, 0x61, 0x87, 0xab, 0x90, 0xd7, 0x4f, 0xdf,
-	0x71, 0x4c, 0x13, 0x8e, 0x6a, 0xc2, 0x31, 0xd3, 0x4e, 0x9b, 0x70, 0x0e, 0x08, 0xa3, 0x69, 0xad,
-	0x9b, 0xa9, 0xb4, 0x3f, 0x01, 0x38, 0xd7, 0x63, 0x91, 0xa2, 0x3f, 0x84, 0x63, 0x91, 0x60, 0x9e,
-	0x22, 0x2c, 0x80, 0xe5, 0xa1, 0x2b, 0xe0, 0x4d, 0xa5, 0x3b, 0x1a, 0x99, 0x17, 0xd0, 0x7e, 0x1f,
-	0xba, 0xbb, 0x7f, 0xa5, 0x33, 0xb6, 0x5d, 0x78, 0xef, 0x00, 0x9c, 0xdd, 0x25, 0x61, 0xb5, 0x1e,
-	0x12, 0x49, 0x9f, 0x37, 0xb3, 0x13, 0x98, 0x87, 0x63, 0xb2, 0xe9, 0x55, 0x4e, 0x24, 0x35, 0xa3,
-	0x9d, 0x70, 0x47, 0x65, 0xb3, 0xac, 0x3e, 0xa2, 0x7b, 0x10, 0xf9, 0xf4, 0x90, 0xd4, 0x43, 0xe9,
-	0x29, 0x33, 0xcf, 0xa7, 0x31, 0x8f, 0x34, 0xc6, 0xb8, 0x7b, 0x23, 0xbd, 0x29, 0x13, 0x41, 0x9f,
-	0xa8, 0x73, 0xb4, 0x0a, 0xa7, 0x18, 0x11, 0x1e, 0xf1, 0x8f, 0xea, 0x42, 0x46, 0x34, 0x96, 0x85,
-	0xa1, 0x65, 0xb0, 0x96, 0x77, 0x27, 0x19, 0x11, 0x3b, 0xed, 0x43, 0xfb, 0x73, 0x1e, 0xce, 0xf5,
-	0xa0, 0xa4, 0x93, 0x92, 0x70, 0x9a, 0xf8, 0x7e, 0xa0, 0x90, 0x49, 0x98, 0x1d, 0xd8, 0x7c, 0x57,
-	0xd3, 0xad, 0x76, 0x77, 0x79, 0x10, 0x97, 0xef, 0xab, 0x6f, 0xfa, 0xeb, 0xcf, 0xa5, 0x35, 0x16,
-	0xc8, 0x57, 0xf5, 0x8a, 0x53, 0xe5, 0x11, 0x4e, 0x43, 0x68, 0xfe, 0x6c, 0x0a, 0xff, 0x35, 0x96,
-	0x27, 0x09, 0x15, 0xba, 0x40, 0xb8, 0x53, 0x1d, 0x0f, 0x3d, 0xe5, 0x23, 0x08, 0x25, 0x97, 0x2d,
-	0xc3, 0xfc, 0xbf, 0x37, 0x1c, 0xd7, 0xcf, 0x6b, 0xaf, 0x15, 0x38, 0x49, 0x85, 0x0c, 0x22, 0x22,
-	0xa9, 0xef, 0x31, 0x22, 0xf4, 0x8c, 0x86, 0xdd, 0x89, 0xf6, 0xe1, 0x3e, 0x11, 0x5b, 0x67, 0x43,
-	0xf0, 0x3f, 0x1d, 0x26, 0xf4, 0x16, 0xc0, 0x11, 0x93, 0x68, 0xb4, 0x3e, 0x20, 0x33, 0xbd, 0x2b,
-	0x54, 0xdc, 0xb8, 0x8e, 0xd4, 0x8c, 0xdc, 0x5e, 0x7d, 0xf3, 0xed, 0xf7, 0x87, 0xfc, 0x12, 0x5a,
-	0xc4, 0xfd, 0xd7, 0xdf, 0x6c, 0x10, 0xfa, 0x08, 0xe0, 0xf4, 0xa5, 0x7c, 0xa3, 0xcd, 0xab, 0x6c,
-	0x7a, 0x56, 0xad, 0xe8, 0x5c, 0x57, 0x9e, 0x92, 0xd9, 0x9a, 0x6c, 0x01, 0x15, 0x07, 0x90, 0x91,
-	0x30, 0x44, 0x5f, 0x00, 0x9c, 0xbe, 0x14, 0xa6, 0x81, 0x58, 0xfd, 0xf3, 0x3f, 0x10, 0x6b, 0x40,
-	0x46, 0xed, 0x07, 0x1a, 0xcb, 0xb1, 0xd7, 0xb3, 0x58, 0xb2, 0xa9, 0x88, 0xaa, 0xad, 0x12, 0x4f,
-	0xed, 0xbb, 0x4a, 0x8e, 0xaf, 0x32, 0xf5, 0x08, 0x6c, 0x94, 0x83, 0xd3, 0x73, 0x0b, 0x9c, 0x9d,
-	0x5b, 0xe0, 0xd7, 0xb9, 0x05, 0xde, 0x5f, 0x58, 0xb9, 0xb3, 0x0b, 0x2b, 0xf7, 0xfd, 0xc2, 0xca,
-	0xc1, 0x42, 0xc0, 0xfb, 0x13, 0x1c, 0x80, 0x17, 0xdb, 0x99, 0x88, 0x75, 0x34, 0x9b, 0x01, 0xcf,
-	0x7a, 0x37, 0xdb, 0x43, 0xd1, 0x99, 0xab, 0x8c, 0xe8, 0x5f, 0xd7, 0xed, 0x3f, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x5b, 0x00, 0x28, 0xc7, 0x3e, 0x06, 0x00, 0x00,
+	// 696 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xbf, 0x53, 0x13, 0x41,
+	0x14, 0xc7, 0xb3, 0x09, 0xf2, 0x63, 0xe5, 0x87, 0xb3, 0x22, 0x84, 0x0c, 0x1c, 0x78, 0x0c, 0x0a,
+	0x8c, 0xec, 0x19, 0xb0, 0x70, 0xb4, 0x22, 0x38, 0x50, 0x39, 0x83, 0x37, 0x56, 0x36, 0xe7, 0x26,
+	0xb7, 0xac, 0x87, 0x77, 0xb7, 0x47, 0x76, 0x93, 0x09, 0xad, 0x85, 0x63, 0xe9, 0xa8, 0xa5, 0xb5,
+	0x85, 0x7f, 0x09, 0x25, 0x33, 0x36, 0x56, 0xea, 0x80, 0x7f, 0x88, 0xb3, 0xbb, 0x97, 0xe4, 0x20,
+	0x01, 0x29, 0xa8, 0x20, 0xbb, 0xdf, 0xf7, 0xbe, 0x9f, 0xf7, 0xee, 0xbd, 0x85, 0x77, 0x93, 0x3a,
+	0x6f, 0xd2, 0x98, 0xc4, 0x35, 0xea, 0x44, 0x82, 0xed, 0x51, 0x2a, 0x9c, 0x66, 0xd9, 0x39, 0x68,
+	0xd0, 0xfa, 0x21, 0x4e, 0xea, 0x5c, 0x72, 0x74, 0xa7, 0x2b, 0xc1, 0xa9, 0x04, 0x37, 0xcb, 0xa5,
+	0x49, 0xc6, 0x19, 0xd7, 0x0a, 0x47, 0xfd, 0x67, 0xc4, 0xa5, 0x59, 0xc6, 0x39, 0x0b, 0xa9, 0x43,
+	0x92, 0xc0, 0x21, 0x71, 0xcc, 0x25, 0x91, 0x01, 0x8f, 0x45, 0x7a, 0xbb, 0xd8, 0xdf, 0xad, 0x9d,
+	0xd5, 0x88, 0xac, 0x1a, 0x17, 0x11, 0x17, 0x4e, 0x95, 0x08, 0xea, 0x34, 0xcb, 0x55, 0x2a, 0x49,
+	0xd9, 0xa9, 0xf1, 0x20, 0x4e, 0xef, 0x57, 0xb3, 0xf7, 0x1a, 0xb4, 0xa3, 0x4a, 0x08, 0x0b, 0x62,
+	0xed, 0x68, 0xb4, 0xf6, 0x24, 0x44, 0x2f, 0x94, 0x62, 0x97, 0xd4, 0x49, 0x24, 0x5c, 0x7a, 0xd0,
+	0xa0, 0x42, 0xda, 0x2e, 0xbc, 0x7d, 0xe6, 0x54, 0x24, 0x3c, 0x16, 0x14, 0x3d, 0x85, 0x83, 0x89,
+	0x3e, 0x29, 0x82, 0x05, 0xb0, 0x7c, 0x73, 0x7d, 0x0e, 0xf7, 0xad, 0x1c, 0x9b, 0xb0, 0xca, 0xc0,
+	0xd1, 0xaf, 0xf9, 0x9c, 0x9b, 0x86, 0xd8, 0xaf, 0xe1, 0x94, 0xce, 0xb9, 0x19, 0x86, 0xcf, 0x05,
+	0xdb, 0xa6, 0xb4, 0xed, 0x86, 0xb6, 0x21, 0xec, 0x72, 0x15, 0xf3, 0x3a, 0xf5, 0x3d, 0x6c, 0x8a,
+	0xc0, 0xaa, 0x08, 0x6c, 0xba, 0x9d, 0x16, 0x81, 0x77, 0x09, 0xa3, 0x69, 0xac, 0x9b, 0x89, 0xb4,
+	0xbf, 0x02, 0x38, 0xdd, 0x63, 0x91, 0xa2, 0x3f, 0x86, 0xc3, 0x91, 0x60, 0x9e, 0x22, 0x2c, 0x82,
+	0x85, 0xc2, 0x25, 0xf0, 0x26, 0xd2, 0x1d, 0x8a, 0x4c, 0x06, 0xb4, 0xd3, 0x87, 0xee, 0xfe, 0x7f,
+	0xe9, 0x8c, 0xed, 0x19, 0xbc, 0x0f, 0x00, 0x4e, 0x6d, 0x91, 0xb0, 0xd6, 0x08, 0x89, 0xa4, 0x2f,
+	0x5b, 0xd9, 0x0e, 0xcc, 0xc0, 0x61, 0xd9, 0xf2, 0xaa, 0x87, 0x92, 0x9a, 0xd6, 0x8e, 0xba, 0x43,
+	0xb2, 0x55, 0x51, 0x3f, 0xd1, 0x03, 0x88, 0x7c, 0xba, 0x47, 0x1a, 0xa1, 0xf4, 0x94, 0x99, 0xe7,
+	0xd3, 0x98, 0x47, 0x1a, 0x63, 0xc4, 0xbd, 0x95, 0xde, 0x54, 0x88, 0xa0, 0xcf, 0xd4, 0x39, 0x5a,
+	0x82, 0xe3, 0x8c, 0x08, 0x8f, 0xf8, 0xfb, 0x0d, 0x21, 0x23, 0x1a, 0xcb, 0x62, 0x61, 0x01, 0x2c,
+	0xe7, 0xdd, 0x31, 0x46, 0xc4, 0x66, 0xe7, 0xd0, 0xfe, 0x54, 0x80, 0xd3, 0x3d, 0x28, 0x69, 0xa7,
+	0x24, 0x9c, 0x20, 0xbe, 0x1f, 0x28, 0x64, 0x12, 0x66, 0x1b, 0x36, 0x73, 0xa6, 0xe8, 0x76, 0xb9,
+	0x5b, 0x3c, 0x88, 0x2b, 0x0f, 0xd5, 0x97, 0xfe, 0xfe, 0x7b, 0x7e, 0x99, 0x05, 0xf2, 0x4d, 0xa3,
+	0x8a, 0x6b, 0x3c, 0x72, 0xd2, 0x21, 0x34, 0x7f, 0xd6, 0x84, 0xff, 0xd6, 0x91, 0x87, 0x09, 0x15,
+	0x3a, 0x40, 0xb8, 0xe3, 0x5d, 0x0f, 0xdd, 0xe5, 0x7d, 0x08, 0x25, 0x97, 0x6d, 0xc3, 0xfc, 0xf5,
+	0x1b, 0x8e, 0xe8, 0xf4, 0xda, 0x6b, 0x11, 0x8e, 0x51, 0x21, 0x83, 0x88, 0x48, 0xea, 0x7b, 0x8c,
+	0x08, 0xdd, 0xa3, 0x01, 0x77, 0xb4, 0x73, 0xb8, 0x43, 0x04, 0x4a, 0xe0, 0x98, 0xac, 0x93, 0x58,
+	0xec, 0xd1, 0xba, 0x61, 0x1a, 0xb8, 0x7e, 0xa6, 0xd1, 0xb6, 0x83, 0xc2, 0x5a, 0x3f, 0x2e, 0xc0,
+	0x1b, 0x7a, 0x7c, 0xd1, 0x7b, 0x00, 0x07, 0xcd, 0x0e, 0xa1, 0x95, 0x0b, 0xa6, 0xb4, 0x77, 0x69,
+	0x4b, 0xab, 0x57, 0x91, 0x9a, 0x8f, 0x6c, 0x2f, 0xbd, 0xfb, 0xf1, 0xf7, 0x73, 0x7e, 0x1e, 0xcd,
+	0x39, 0xfd, 0x1f, 0x1c, 0xb3, 0xb3, 0xe8, 0x0b, 0x80, 0x13, 0xe7, 0x36, 0x0a, 0xad, 0x5d, 0x66,
+	0xd3, 0xb3, 0xdc, 0x25, 0x7c, 0x55, 0x79, 0x4a, 0x66, 0x6b, 0xb2, 0x59, 0x54, 0xba, 0x80, 0x8c,
+	0x84, 0x21, 0xfa, 0x06, 0xe0, 0xc4, 0xb9, 0xf1, 0xbd, 0x10, 0xab, 0xff, 0xc6, 0x95, 0xf0, 0x55,
+	0xe5, 0x29, 0xd6, 0x23, 0x8d, 0x85, 0x9f, 0x80, 0x55, 0x7b, 0x25, 0x4b, 0x26, 0x5b, 0x0a, 0xaa,
+	0xd6, 0x8e, 0xf2, 0xd4, 0x23, 0xa3, 0x46, 0xc3, 0x57, 0x43, 0x53, 0x09, 0x8e, 0x4e, 0x2c, 0x70,
+	0x7c, 0x62, 0x81, 0x3f, 0x27, 0x16, 0xf8, 0x78, 0x6a, 0xe5, 0x8e, 0x4f, 0xad, 0xdc, 0xcf, 0x53,
+	0x2b, 0x07, 0x8b, 0x01, 0xef, 0x4f, 0xb0, 0x0b, 0x5e, 0x6d, 0x64, 0x06, 0xa8, 0xab, 0x59, 0x0b,
+	0x78, 0xd6, 0xb8, 0xd5, 0x69, 0x8a, 0x9e, 0xa8, 0xea, 0xa0, 0x7e, 0xcf, 0x37, 0xfe, 0x0d, 0x00,
+	0x25, 0xad, 0x8f, 0xd4, 0xb0, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -765,6 +776,20 @@ func (m *CalculateTxFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.TransferFees) > 0 {
+		for iNdEx := len(m.TransferFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.EstimatedGas != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.EstimatedGas))
 		i--
@@ -905,6 +930,12 @@ func (m *CalculateTxFeesResponse) Size() (n int) {
 	if m.EstimatedGas != 0 {
 		n += 1 + sovQuery(uint64(m.EstimatedGas))
 	}
+	if len(m.TransferFees) > 0 {
+		for _, e := range m.TransferFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1496,6 +1527,40 @@ func (m *CalculateTxFeesResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferFees = append(m.TransferFees, types.Coin{})
+			if err := m.TransferFees[len(m.TransferFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])